@@ -25,6 +25,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type PointCloudCompression int32
+
+const (
+	PointCloudCompression_POINT_CLOUD_COMPRESSION_UNSPECIFIED PointCloudCompression = 0
+	PointCloudCompression_POINT_CLOUD_COMPRESSION_NONE        PointCloudCompression = 1
+	PointCloudCompression_POINT_CLOUD_COMPRESSION_ZSTD        PointCloudCompression = 2
+	PointCloudCompression_POINT_CLOUD_COMPRESSION_GZIP        PointCloudCompression = 3
+)
+
+// Enum value maps for PointCloudCompression.
+var (
+	PointCloudCompression_name = map[int32]string{
+		0: "POINT_CLOUD_COMPRESSION_UNSPECIFIED",
+		1: "POINT_CLOUD_COMPRESSION_NONE",
+		2: "POINT_CLOUD_COMPRESSION_ZSTD",
+		3: "POINT_CLOUD_COMPRESSION_GZIP",
+	}
+	PointCloudCompression_value = map[string]int32{
+		"POINT_CLOUD_COMPRESSION_UNSPECIFIED": 0,
+		"POINT_CLOUD_COMPRESSION_NONE":        1,
+		"POINT_CLOUD_COMPRESSION_ZSTD":        2,
+		"POINT_CLOUD_COMPRESSION_GZIP":        3,
+	}
+)
+
+func (x PointCloudCompression) Enum() *PointCloudCompression {
+	p := new(PointCloudCompression)
+	*p = x
+	return p
+}
+
+func (x PointCloudCompression) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PointCloudCompression) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_v1_common_proto_enumTypes[0].Descriptor()
+}
+
+func (PointCloudCompression) Type() protoreflect.EnumType {
+	return &file_common_v1_common_proto_enumTypes[0]
+}
+
+func (x PointCloudCompression) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PointCloudCompression.Descriptor instead.
+func (PointCloudCompression) EnumDescriptor() ([]byte, []int) {
+	return file_common_v1_common_proto_rawDescGZIP(), []int{0}
+}
+
 type KinematicsFileFormat int32
 
 const (
@@ -58,11 +110,11 @@ func (x KinematicsFileFormat) String() string {
 }
 
 func (KinematicsFileFormat) Descriptor() protoreflect.EnumDescriptor {
-	return file_common_v1_common_proto_enumTypes[0].Descriptor()
+	return file_common_v1_common_proto_enumTypes[1].Descriptor()
 }
 
 func (KinematicsFileFormat) Type() protoreflect.EnumType {
-	return &file_common_v1_common_proto_enumTypes[0]
+	return &file_common_v1_common_proto_enumTypes[1]
 }
 
 func (x KinematicsFileFormat) Number() protoreflect.EnumNumber {
@@ -71,7 +123,7 @@ func (x KinematicsFileFormat) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use KinematicsFileFormat.Descriptor instead.
 func (KinematicsFileFormat) EnumDescriptor() ([]byte, []int) {
-	return file_common_v1_common_proto_rawDescGZIP(), []int{0}
+	return file_common_v1_common_proto_rawDescGZIP(), []int{1}
 }
 
 type ResourceName struct {
@@ -207,6 +259,13 @@ type AnalogStatus struct {
 
 	// Current value of the analog reader of a robot's board
 	Value int32 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	// Voltage corresponding to value, in volts
+	Voltage float32 `protobuf:"fixed32,2,opt,name=voltage,proto3" json:"voltage,omitempty"`
+	// Lowest and highest voltage the reader can measure, in volts
+	MinRange float32 `protobuf:"fixed32,3,opt,name=min_range,json=minRange,proto3" json:"min_range,omitempty"`
+	MaxRange float32 `protobuf:"fixed32,4,opt,name=max_range,json=maxRange,proto3" json:"max_range,omitempty"`
+	// Voltage difference between consecutive raw values, in volts
+	StepSize float32 `protobuf:"fixed32,5,opt,name=step_size,json=stepSize,proto3" json:"step_size,omitempty"`
 }
 
 func (x *AnalogStatus) Reset() {
@@ -248,6 +307,34 @@ func (x *AnalogStatus) GetValue() int32 {
 	return 0
 }
 
+func (x *AnalogStatus) GetVoltage() float32 {
+	if x != nil {
+		return x.Voltage
+	}
+	return 0
+}
+
+func (x *AnalogStatus) GetMinRange() float32 {
+	if x != nil {
+		return x.MinRange
+	}
+	return 0
+}
+
+func (x *AnalogStatus) GetMaxRange() float32 {
+	if x != nil {
+		return x.MaxRange
+	}
+	return 0
+}
+
+func (x *AnalogStatus) GetStepSize() float32 {
+	if x != nil {
+		return x.StepSize
+	}
+	return 0
+}
+
 type DigitalInterruptStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -933,7 +1020,7 @@ type PointCloudObject struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// image frame expressed in bytes
+	// image frame expressed in bytes, in the format of the MIME type of the response
 	PointCloud []byte `protobuf:"bytes,1,opt,name=point_cloud,json=pointCloud,proto3" json:"point_cloud,omitempty"`
 	// volume of a given geometry
 	Geometries *GeometriesInFrame `protobuf:"bytes,2,opt,name=geometries,proto3" json:"geometries,omitempty"`
@@ -985,6 +1072,113 @@ func (x *PointCloudObject) GetGeometries() *GeometriesInFrame {
 	return nil
 }
 
+// PointCloud is a point cloud with typed per-point fields, so that clients do not need to parse PCD.
+// APIs which transfer point clouds as bytes alongside a MIME type return a serialized PointCloud when
+// the MIME type "pointcloud/vnd.viam.pointcloud" is requested, and PCD when "pointcloud/pcd" is requested.
+// Optional fields are either empty or have an entry per point in the same order as positions.
+type PointCloud struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of points in the cloud
+	NumPoints uint64 `protobuf:"varint,1,opt,name=num_points,json=numPoints,proto3" json:"num_points,omitempty"`
+	// Interleaved x, y, z coordinates of each point in millimeters, 3 values per point
+	PositionsMm []float32 `protobuf:"fixed32,2,rep,packed,name=positions_mm,json=positionsMm,proto3" json:"positions_mm,omitempty"`
+	// Optional color of each point, encoded as 0xRRGGBB
+	Colors []uint32 `protobuf:"varint,3,rep,packed,name=colors,proto3" json:"colors,omitempty"`
+	// Optional intensity of each point
+	Intensities []float32 `protobuf:"fixed32,4,rep,packed,name=intensities,proto3" json:"intensities,omitempty"`
+	// Optional interleaved x, y, z components of the unit normal of each point, 3 values per point
+	Normals []float32 `protobuf:"fixed32,5,rep,packed,name=normals,proto3" json:"normals,omitempty"`
+	// When unspecified or NONE, the fields above are set inline and compressed_data is empty.
+	// When ZSTD or GZIP, every field above other than num_points is empty, and compressed_data holds them
+	// as a serialized PointCloud compressed with that algorithm
+	Compression    PointCloudCompression `protobuf:"varint,6,opt,name=compression,proto3,enum=viam.common.v1.PointCloudCompression" json:"compression,omitempty"`
+	CompressedData []byte                `protobuf:"bytes,7,opt,name=compressed_data,json=compressedData,proto3" json:"compressed_data,omitempty"`
+}
+
+func (x *PointCloud) Reset() {
+	*x = PointCloud{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_common_v1_common_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PointCloud) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PointCloud) ProtoMessage() {}
+
+func (x *PointCloud) ProtoReflect() protoreflect.Message {
+	mi := &file_common_v1_common_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PointCloud.ProtoReflect.Descriptor instead.
+func (*PointCloud) Descriptor() ([]byte, []int) {
+	return file_common_v1_common_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PointCloud) GetNumPoints() uint64 {
+	if x != nil {
+		return x.NumPoints
+	}
+	return 0
+}
+
+func (x *PointCloud) GetPositionsMm() []float32 {
+	if x != nil {
+		return x.PositionsMm
+	}
+	return nil
+}
+
+func (x *PointCloud) GetColors() []uint32 {
+	if x != nil {
+		return x.Colors
+	}
+	return nil
+}
+
+func (x *PointCloud) GetIntensities() []float32 {
+	if x != nil {
+		return x.Intensities
+	}
+	return nil
+}
+
+func (x *PointCloud) GetNormals() []float32 {
+	if x != nil {
+		return x.Normals
+	}
+	return nil
+}
+
+func (x *PointCloud) GetCompression() PointCloudCompression {
+	if x != nil {
+		return x.Compression
+	}
+	return PointCloudCompression_POINT_CLOUD_COMPRESSION_UNSPECIFIED
+}
+
+func (x *PointCloud) GetCompressedData() []byte {
+	if x != nil {
+		return x.CompressedData
+	}
+	return nil
+}
+
 type GeoPoint struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -997,7 +1191,7 @@ type GeoPoint struct {
 func (x *GeoPoint) Reset() {
 	*x = GeoPoint{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_v1_common_proto_msgTypes[14]
+		mi := &file_common_v1_common_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1010,7 +1204,7 @@ func (x *GeoPoint) String() string {
 func (*GeoPoint) ProtoMessage() {}
 
 func (x *GeoPoint) ProtoReflect() protoreflect.Message {
-	mi := &file_common_v1_common_proto_msgTypes[14]
+	mi := &file_common_v1_common_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1023,7 +1217,7 @@ func (x *GeoPoint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GeoPoint.ProtoReflect.Descriptor instead.
 func (*GeoPoint) Descriptor() ([]byte, []int) {
-	return file_common_v1_common_proto_rawDescGZIP(), []int{14}
+	return file_common_v1_common_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GeoPoint) GetLatitude() float64 {
@@ -1056,7 +1250,7 @@ type GeoObstacle struct {
 func (x *GeoObstacle) Reset() {
 	*x = GeoObstacle{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_v1_common_proto_msgTypes[15]
+		mi := &file_common_v1_common_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1069,7 +1263,7 @@ func (x *GeoObstacle) String() string {
 func (*GeoObstacle) ProtoMessage() {}
 
 func (x *GeoObstacle) ProtoReflect() protoreflect.Message {
-	mi := &file_common_v1_common_proto_msgTypes[15]
+	mi := &file_common_v1_common_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1082,7 +1276,7 @@ func (x *GeoObstacle) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GeoObstacle.ProtoReflect.Descriptor instead.
 func (*GeoObstacle) Descriptor() ([]byte, []int) {
-	return file_common_v1_common_proto_rawDescGZIP(), []int{15}
+	return file_common_v1_common_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GeoObstacle) GetLocation() *GeoPoint {
@@ -1117,7 +1311,7 @@ type Transform struct {
 func (x *Transform) Reset() {
 	*x = Transform{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_v1_common_proto_msgTypes[16]
+		mi := &file_common_v1_common_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1130,7 +1324,7 @@ func (x *Transform) String() string {
 func (*Transform) ProtoMessage() {}
 
 func (x *Transform) ProtoReflect() protoreflect.Message {
-	mi := &file_common_v1_common_proto_msgTypes[16]
+	mi := &file_common_v1_common_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1143,7 +1337,7 @@ func (x *Transform) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Transform.ProtoReflect.Descriptor instead.
 func (*Transform) Descriptor() ([]byte, []int) {
-	return file_common_v1_common_proto_rawDescGZIP(), []int{16}
+	return file_common_v1_common_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *Transform) GetReferenceFrame() string {
@@ -1184,7 +1378,7 @@ type WorldState struct {
 func (x *WorldState) Reset() {
 	*x = WorldState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_v1_common_proto_msgTypes[17]
+		mi := &file_common_v1_common_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1197,7 +1391,7 @@ func (x *WorldState) String() string {
 func (*WorldState) ProtoMessage() {}
 
 func (x *WorldState) ProtoReflect() protoreflect.Message {
-	mi := &file_common_v1_common_proto_msgTypes[17]
+	mi := &file_common_v1_common_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1210,7 +1404,7 @@ func (x *WorldState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorldState.ProtoReflect.Descriptor instead.
 func (*WorldState) Descriptor() ([]byte, []int) {
-	return file_common_v1_common_proto_rawDescGZIP(), []int{17}
+	return file_common_v1_common_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *WorldState) GetObstacles() []*GeometriesInFrame {
@@ -1239,7 +1433,7 @@ type ActuatorStatus struct {
 func (x *ActuatorStatus) Reset() {
 	*x = ActuatorStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_v1_common_proto_msgTypes[18]
+		mi := &file_common_v1_common_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1252,7 +1446,7 @@ func (x *ActuatorStatus) String() string {
 func (*ActuatorStatus) ProtoMessage() {}
 
 func (x *ActuatorStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_common_v1_common_proto_msgTypes[18]
+	mi := &file_common_v1_common_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1265,7 +1459,7 @@ func (x *ActuatorStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActuatorStatus.ProtoReflect.Descriptor instead.
 func (*ActuatorStatus) Descriptor() ([]byte, []int) {
-	return file_common_v1_common_proto_rawDescGZIP(), []int{18}
+	return file_common_v1_common_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ActuatorStatus) GetIsMoving() bool {
@@ -1290,7 +1484,7 @@ type ResponseMetadata struct {
 func (x *ResponseMetadata) Reset() {
 	*x = ResponseMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_v1_common_proto_msgTypes[19]
+		mi := &file_common_v1_common_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1303,7 +1497,7 @@ func (x *ResponseMetadata) String() string {
 func (*ResponseMetadata) ProtoMessage() {}
 
 func (x *ResponseMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_common_v1_common_proto_msgTypes[19]
+	mi := &file_common_v1_common_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1316,7 +1510,7 @@ func (x *ResponseMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResponseMetadata.ProtoReflect.Descriptor instead.
 func (*ResponseMetadata) Descriptor() ([]byte, []int) {
-	return file_common_v1_common_proto_rawDescGZIP(), []int{19}
+	return file_common_v1_common_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ResponseMetadata) GetCapturedAt() *timestamppb.Timestamp {
@@ -1339,7 +1533,7 @@ type DoCommandRequest struct {
 func (x *DoCommandRequest) Reset() {
 	*x = DoCommandRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_v1_common_proto_msgTypes[20]
+		mi := &file_common_v1_common_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1352,7 +1546,7 @@ func (x *DoCommandRequest) String() string {
 func (*DoCommandRequest) ProtoMessage() {}
 
 func (x *DoCommandRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_common_v1_common_proto_msgTypes[20]
+	mi := &file_common_v1_common_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1365,7 +1559,7 @@ func (x *DoCommandRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DoCommandRequest.ProtoReflect.Descriptor instead.
 func (*DoCommandRequest) Descriptor() ([]byte, []int) {
-	return file_common_v1_common_proto_rawDescGZIP(), []int{20}
+	return file_common_v1_common_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *DoCommandRequest) GetName() string {
@@ -1394,7 +1588,7 @@ type DoCommandResponse struct {
 func (x *DoCommandResponse) Reset() {
 	*x = DoCommandResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_v1_common_proto_msgTypes[21]
+		mi := &file_common_v1_common_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1407,7 +1601,7 @@ func (x *DoCommandResponse) String() string {
 func (*DoCommandResponse) ProtoMessage() {}
 
 func (x *DoCommandResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_common_v1_common_proto_msgTypes[21]
+	mi := &file_common_v1_common_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1420,7 +1614,7 @@ func (x *DoCommandResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DoCommandResponse.ProtoReflect.Descriptor instead.
 func (*DoCommandResponse) Descriptor() ([]byte, []int) {
-	return file_common_v1_common_proto_rawDescGZIP(), []int{21}
+	return file_common_v1_common_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *DoCommandResponse) GetResult() *structpb.Struct {
@@ -1444,7 +1638,7 @@ type GetKinematicsRequest struct {
 func (x *GetKinematicsRequest) Reset() {
 	*x = GetKinematicsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_v1_common_proto_msgTypes[22]
+		mi := &file_common_v1_common_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1457,7 +1651,7 @@ func (x *GetKinematicsRequest) String() string {
 func (*GetKinematicsRequest) ProtoMessage() {}
 
 func (x *GetKinematicsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_common_v1_common_proto_msgTypes[22]
+	mi := &file_common_v1_common_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1470,7 +1664,7 @@ func (x *GetKinematicsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKinematicsRequest.ProtoReflect.Descriptor instead.
 func (*GetKinematicsRequest) Descriptor() ([]byte, []int) {
-	return file_common_v1_common_proto_rawDescGZIP(), []int{22}
+	return file_common_v1_common_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GetKinematicsRequest) GetName() string {
@@ -1502,7 +1696,7 @@ type GetKinematicsResponse struct {
 func (x *GetKinematicsResponse) Reset() {
 	*x = GetKinematicsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_v1_common_proto_msgTypes[23]
+		mi := &file_common_v1_common_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1515,7 +1709,7 @@ func (x *GetKinematicsResponse) String() string {
 func (*GetKinematicsResponse) ProtoMessage() {}
 
 func (x *GetKinematicsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_common_v1_common_proto_msgTypes[23]
+	mi := &file_common_v1_common_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1528,7 +1722,7 @@ func (x *GetKinematicsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKinematicsResponse.ProtoReflect.Descriptor instead.
 func (*GetKinematicsResponse) Descriptor() ([]byte, []int) {
-	return file_common_v1_common_proto_rawDescGZIP(), []int{23}
+	return file_common_v1_common_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetKinematicsResponse) GetFormat() KinematicsFileFormat {
@@ -1559,7 +1753,7 @@ type GetGeometriesRequest struct {
 func (x *GetGeometriesRequest) Reset() {
 	*x = GetGeometriesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_v1_common_proto_msgTypes[24]
+		mi := &file_common_v1_common_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1572,7 +1766,7 @@ func (x *GetGeometriesRequest) String() string {
 func (*GetGeometriesRequest) ProtoMessage() {}
 
 func (x *GetGeometriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_common_v1_common_proto_msgTypes[24]
+	mi := &file_common_v1_common_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1585,7 +1779,7 @@ func (x *GetGeometriesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGeometriesRequest.ProtoReflect.Descriptor instead.
 func (*GetGeometriesRequest) Descriptor() ([]byte, []int) {
-	return file_common_v1_common_proto_rawDescGZIP(), []int{24}
+	return file_common_v1_common_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GetGeometriesRequest) GetName() string {
@@ -1614,7 +1808,7 @@ type GetGeometriesResponse struct {
 func (x *GetGeometriesResponse) Reset() {
 	*x = GetGeometriesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_v1_common_proto_msgTypes[25]
+		mi := &file_common_v1_common_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1627,7 +1821,7 @@ func (x *GetGeometriesResponse) String() string {
 func (*GetGeometriesResponse) ProtoMessage() {}
 
 func (x *GetGeometriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_common_v1_common_proto_msgTypes[25]
+	mi := &file_common_v1_common_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1640,7 +1834,7 @@ func (x *GetGeometriesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGeometriesResponse.ProtoReflect.Descriptor instead.
 func (*GetGeometriesResponse) Descriptor() ([]byte, []int) {
-	return file_common_v1_common_proto_rawDescGZIP(), []int{25}
+	return file_common_v1_common_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetGeometriesResponse) GetGeometries() []*Geometry {
@@ -1713,174 +1907,209 @@ var file_common_v1_common_proto_rawDesc = []byte{
 	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d,
 	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x49, 0x6e,
 	0x74, 0x65, 0x72, 0x72, 0x75, 0x70, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x24, 0x0a, 0x0c, 0x41, 0x6e, 0x61, 0x6c,
-	0x6f, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2e,
-	0x0a, 0x16, 0x44, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x72, 0x75,
-	0x70, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x79,
-	0x0a, 0x04, 0x50, 0x6f, 0x73, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x01, 0x79, 0x12, 0x0c, 0x0a, 0x01, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x7a,
-	0x12, 0x0f, 0x0a, 0x03, 0x6f, 0x5f, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x6f,
-	0x58, 0x12, 0x0f, 0x0a, 0x03, 0x6f, 0x5f, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02,
-	0x6f, 0x59, 0x12, 0x0f, 0x0a, 0x03, 0x6f, 0x5f, 0x7a, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x02, 0x6f, 0x5a, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x68, 0x65, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x05, 0x74, 0x68, 0x65, 0x74, 0x61, 0x22, 0x56, 0x0a, 0x0b, 0x4f, 0x72, 0x69,
-	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0f, 0x0a, 0x03, 0x6f, 0x5f, 0x78, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x6f, 0x58, 0x12, 0x0f, 0x0a, 0x03, 0x6f, 0x5f, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x6f, 0x59, 0x12, 0x0f, 0x0a, 0x03, 0x6f, 0x5f,
-	0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x6f, 0x5a, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x68, 0x65, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x74, 0x68, 0x65, 0x74,
-	0x61, 0x22, 0x60, 0x0a, 0x0b, 0x50, 0x6f, 0x73, 0x65, 0x49, 0x6e, 0x46, 0x72, 0x61, 0x6d, 0x65,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x95, 0x01, 0x0a, 0x0c, 0x41, 0x6e, 0x61,
+	0x6c, 0x6f, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x6f, 0x6c, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x07, 0x76, 0x6f, 0x6c, 0x74, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e,
+	0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x6d, 0x69,
+	0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61,
+	0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x73, 0x74, 0x65, 0x70, 0x53, 0x69, 0x7a, 0x65,
+	0x22, 0x2e, 0x0a, 0x16, 0x44, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x72, 0x75, 0x70, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x79, 0x0a, 0x04, 0x50, 0x6f, 0x73, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a, 0x01, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x01, 0x7a, 0x12, 0x0f, 0x0a, 0x03, 0x6f, 0x5f, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x02, 0x6f, 0x58, 0x12, 0x0f, 0x0a, 0x03, 0x6f, 0x5f, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x02, 0x6f, 0x59, 0x12, 0x0f, 0x0a, 0x03, 0x6f, 0x5f, 0x7a, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x02, 0x6f, 0x5a, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x68, 0x65, 0x74, 0x61, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x74, 0x68, 0x65, 0x74, 0x61, 0x22, 0x56, 0x0a, 0x0b, 0x4f,
+	0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0f, 0x0a, 0x03, 0x6f, 0x5f,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x6f, 0x58, 0x12, 0x0f, 0x0a, 0x03, 0x6f,
+	0x5f, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x6f, 0x59, 0x12, 0x0f, 0x0a, 0x03,
+	0x6f, 0x5f, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x6f, 0x5a, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x68, 0x65, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x74, 0x68,
+	0x65, 0x74, 0x61, 0x22, 0x60, 0x0a, 0x0b, 0x50, 0x6f, 0x73, 0x65, 0x49, 0x6e, 0x46, 0x72, 0x61,
+	0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f,
+	0x66, 0x72, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x70,
+	0x6f, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x69, 0x61, 0x6d,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x65, 0x52,
+	0x04, 0x70, 0x6f, 0x73, 0x65, 0x22, 0x33, 0x0a, 0x07, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x33,
+	0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x78, 0x12, 0x0c,
+	0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a, 0x01,
+	0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x7a, 0x22, 0x25, 0x0a, 0x06, 0x53, 0x70,
+	0x68, 0x65, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x5f, 0x6d,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x4d,
+	0x6d, 0x22, 0x43, 0x0a, 0x07, 0x43, 0x61, 0x70, 0x73, 0x75, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x5f, 0x6d, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x4d, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x5f, 0x6d, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x4d, 0x6d, 0x22, 0x44, 0x0a, 0x10, 0x52, 0x65, 0x63, 0x74, 0x61, 0x6e,
+	0x67, 0x75, 0x6c, 0x61, 0x72, 0x50, 0x72, 0x69, 0x73, 0x6d, 0x12, 0x30, 0x0a, 0x07, 0x64, 0x69,
+	0x6d, 0x73, 0x5f, 0x6d, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x69,
+	0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x33, 0x52, 0x06, 0x64, 0x69, 0x6d, 0x73, 0x4d, 0x6d, 0x22, 0xfc, 0x01, 0x0a,
+	0x08, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x2c, 0x0a, 0x06, 0x63, 0x65, 0x6e,
+	0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x69, 0x61, 0x6d,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x65, 0x52,
+	0x06, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x70, 0x68, 0x65, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x68, 0x65, 0x72, 0x65, 0x48,
+	0x00, 0x52, 0x06, 0x73, 0x70, 0x68, 0x65, 0x72, 0x65, 0x12, 0x34, 0x0a, 0x03, 0x62, 0x6f, 0x78,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x74, 0x61, 0x6e, 0x67, 0x75,
+	0x6c, 0x61, 0x72, 0x50, 0x72, 0x69, 0x73, 0x6d, 0x48, 0x00, 0x52, 0x03, 0x62, 0x6f, 0x78, 0x12,
+	0x33, 0x0a, 0x07, 0x63, 0x61, 0x70, 0x73, 0x75, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x61, 0x70, 0x73, 0x75, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x07, 0x63, 0x61, 0x70,
+	0x73, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x42, 0x0f, 0x0a, 0x0d, 0x67, 0x65,
+	0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x76, 0x0a, 0x11, 0x47,
+	0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x46, 0x72, 0x61, 0x6d, 0x65,
 	0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x72,
 	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x70, 0x6f, 0x73,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x65, 0x52, 0x04, 0x70,
-	0x6f, 0x73, 0x65, 0x22, 0x33, 0x0a, 0x07, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x33, 0x12, 0x0c,
-	0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01,
-	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a, 0x01, 0x7a, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x7a, 0x22, 0x25, 0x0a, 0x06, 0x53, 0x70, 0x68, 0x65,
-	0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x5f, 0x6d, 0x6d, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x4d, 0x6d, 0x22,
-	0x43, 0x0a, 0x07, 0x43, 0x61, 0x70, 0x73, 0x75, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61,
-	0x64, 0x69, 0x75, 0x73, 0x5f, 0x6d, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x72,
-	0x61, 0x64, 0x69, 0x75, 0x73, 0x4d, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x6e, 0x67, 0x74,
-	0x68, 0x5f, 0x6d, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x65, 0x6e, 0x67,
-	0x74, 0x68, 0x4d, 0x6d, 0x22, 0x44, 0x0a, 0x10, 0x52, 0x65, 0x63, 0x74, 0x61, 0x6e, 0x67, 0x75,
-	0x6c, 0x61, 0x72, 0x50, 0x72, 0x69, 0x73, 0x6d, 0x12, 0x30, 0x0a, 0x07, 0x64, 0x69, 0x6d, 0x73,
-	0x5f, 0x6d, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x69, 0x61, 0x6d,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x33, 0x52, 0x06, 0x64, 0x69, 0x6d, 0x73, 0x4d, 0x6d, 0x22, 0xfc, 0x01, 0x0a, 0x08, 0x47,
-	0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x2c, 0x0a, 0x06, 0x63, 0x65, 0x6e, 0x74, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x65, 0x52, 0x06, 0x63,
-	0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x70, 0x68, 0x65, 0x72, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x68, 0x65, 0x72, 0x65, 0x48, 0x00, 0x52,
-	0x06, 0x73, 0x70, 0x68, 0x65, 0x72, 0x65, 0x12, 0x34, 0x0a, 0x03, 0x62, 0x6f, 0x78, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x74, 0x61, 0x6e, 0x67, 0x75, 0x6c, 0x61,
-	0x72, 0x50, 0x72, 0x69, 0x73, 0x6d, 0x48, 0x00, 0x52, 0x03, 0x62, 0x6f, 0x78, 0x12, 0x33, 0x0a,
-	0x07, 0x63, 0x61, 0x70, 0x73, 0x75, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x65, 0x6e, 0x63, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x0a, 0x67, 0x65, 0x6f,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x67, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x22, 0x76, 0x0a, 0x10, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x75,
+	0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x5f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x12, 0x41, 0x0a, 0x0a, 0x67, 0x65, 0x6f, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x76,
+	0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52,
+	0x0a, 0x67, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x94, 0x02, 0x0a, 0x0a,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75,
+	0x6d, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x6e, 0x75, 0x6d, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x6d, 0x6d, 0x18, 0x02, 0x20, 0x03, 0x28, 0x02, 0x52,
+	0x0b, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4d, 0x6d, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x63, 0x6f,
+	0x6c, 0x6f, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x02, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x02, 0x52, 0x07, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x73,
+	0x12, 0x47, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x75,
+	0x64, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x44, 0x61,
+	0x74, 0x61, 0x22, 0x44, 0x0a, 0x08, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f,
+	0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c,
+	0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x22, 0x7d, 0x0a, 0x0b, 0x47, 0x65, 0x6f, 0x4f,
+	0x62, 0x73, 0x74, 0x61, 0x63, 0x6c, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x69, 0x61, 0x6d,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a,
+	0x0a, 0x67, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x67, 0x65, 0x6f,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0xe2, 0x01, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x5f, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x50,
+	0x0a, 0x16, 0x70, 0x6f, 0x73, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
 	0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x61, 0x70, 0x73, 0x75, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x07, 0x63, 0x61, 0x70, 0x73, 0x75,
-	0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x42, 0x0f, 0x0a, 0x0d, 0x67, 0x65, 0x6f, 0x6d,
-	0x65, 0x74, 0x72, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x76, 0x0a, 0x11, 0x47, 0x65, 0x6f,
-	0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x27,
-	0x0a, 0x0f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x72, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x0a, 0x67, 0x65, 0x6f, 0x6d, 0x65,
-	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x69,
-	0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6f,
-	0x6d, 0x65, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x67, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65,
-	0x73, 0x22, 0x76, 0x0a, 0x10, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x4f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x63,
-	0x6c, 0x6f, 0x75, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x12, 0x41, 0x0a, 0x0a, 0x67, 0x65, 0x6f, 0x6d, 0x65, 0x74,
-	0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x76, 0x69, 0x61,
-	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6f, 0x6d,
-	0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x0a, 0x67,
-	0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x44, 0x0a, 0x08, 0x47, 0x65, 0x6f,
-	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x22,
-	0x7d, 0x0a, 0x0b, 0x47, 0x65, 0x6f, 0x4f, 0x62, 0x73, 0x74, 0x61, 0x63, 0x6c, 0x65, 0x12, 0x34,
-	0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x0a, 0x67, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74,
-	0x72, 0x79, 0x52, 0x0a, 0x67, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0xe2,
-	0x01, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x27, 0x0a, 0x0f,
-	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x50, 0x0a, 0x16, 0x70, 0x6f, 0x73, 0x65, 0x5f, 0x69, 0x6e,
-	0x5f, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x65, 0x49, 0x6e, 0x46, 0x72, 0x61,
-	0x6d, 0x65, 0x52, 0x13, 0x70, 0x6f, 0x73, 0x65, 0x49, 0x6e, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x46, 0x0a, 0x0f, 0x70, 0x68, 0x79, 0x73, 0x69,
-	0x63, 0x61, 0x6c, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x68,
-	0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x88, 0x01, 0x01, 0x42,
-	0x12, 0x0a, 0x10, 0x5f, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x6f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x22, 0x88, 0x01, 0x0a, 0x0a, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x6f, 0x62, 0x73, 0x74, 0x61, 0x63, 0x6c, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65,
-	0x73, 0x49, 0x6e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x09, 0x6f, 0x62, 0x73, 0x74, 0x61, 0x63,
-	0x6c, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
-	0x72, 0x6d, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x22, 0x2d,
-	0x0a, 0x0e, 0x41, 0x63, 0x74, 0x75, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x22, 0x64, 0x0a,
-	0x10, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x40, 0x0a, 0x0b, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x41, 0x74,
-	0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x22, 0x59, 0x0a, 0x10, 0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x63,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
+	0x50, 0x6f, 0x73, 0x65, 0x49, 0x6e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x13, 0x70, 0x6f, 0x73,
+	0x65, 0x49, 0x6e, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x46, 0x72, 0x61, 0x6d, 0x65,
+	0x12, 0x46, 0x0a, 0x0f, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x6f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x69, 0x61, 0x6d,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6f, 0x6d, 0x65,
+	0x74, 0x72, 0x79, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x70, 0x68, 0x79,
+	0x73, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x88, 0x01, 0x0a,
+	0x0a, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x6f,
+	0x62, 0x73, 0x74, 0x61, 0x63, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x46, 0x72, 0x61, 0x6d,
+	0x65, 0x52, 0x09, 0x6f, 0x62, 0x73, 0x74, 0x61, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0a,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x0a, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x22, 0x2d, 0x0a, 0x0e, 0x41, 0x63, 0x74, 0x75, 0x61,
+	0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f,
+	0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73,
+	0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x22, 0x64, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x40, 0x0a, 0x0b, 0x63, 0x61,
+	0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0a, 0x63,
+	0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x59, 0x0a, 0x10,
+	0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x44, 0x0a, 0x11, 0x44, 0x6f, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x44,
-	0x0a, 0x11, 0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x59, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x4b, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74,
+	0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x7e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4b,
+	0x69, 0x6e, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3c, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x24, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x4b, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x73, 0x46, 0x69, 0x6c,
+	0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
+	0x27, 0x0a, 0x0f, 0x6b, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x73, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x6b, 0x69, 0x6e, 0x65, 0x6d, 0x61,
+	0x74, 0x69, 0x63, 0x73, 0x44, 0x61, 0x74, 0x61, 0x22, 0x59, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x47,
+	0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x06, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x22, 0x59, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4b, 0x69, 0x6e, 0x65, 0x6d,
-	0x61, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22,
-	0x7e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4b, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d,
-	0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x69, 0x6e, 0x65, 0x6d, 0x61,
-	0x74, 0x69, 0x63, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06,
-	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6b, 0x69, 0x6e, 0x65, 0x6d, 0x61,
-	0x74, 0x69, 0x63, 0x73, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x0e, 0x6b, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x73, 0x44, 0x61, 0x74, 0x61, 0x22,
-	0x59, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x65,
-	0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x51, 0x0a, 0x15, 0x47, 0x65,
-	0x74, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0a, 0x67, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72,
-	0x79, 0x52, 0x0a, 0x67, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2a, 0x7f, 0x0a,
-	0x14, 0x4b, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x46,
-	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x26, 0x0a, 0x22, 0x4b, 0x49, 0x4e, 0x45, 0x4d, 0x41, 0x54,
-	0x49, 0x43, 0x53, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a,
-	0x1a, 0x4b, 0x49, 0x4e, 0x45, 0x4d, 0x41, 0x54, 0x49, 0x43, 0x53, 0x5f, 0x46, 0x49, 0x4c, 0x45,
-	0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x53, 0x56, 0x41, 0x10, 0x01, 0x12, 0x1f, 0x0a,
-	0x1b, 0x4b, 0x49, 0x4e, 0x45, 0x4d, 0x41, 0x54, 0x49, 0x43, 0x53, 0x5f, 0x46, 0x49, 0x4c, 0x45,
-	0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x52, 0x44, 0x46, 0x10, 0x02, 0x3a, 0x61,
-	0x0a, 0x1a, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
-	0x61, 0x74, 0x5f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x1e, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d,
-	0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xa4, 0x92, 0x05,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x48, 0x65, 0x61, 0x72,
-	0x74, 0x62, 0x65, 0x61, 0x74, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x88, 0x01,
-	0x01, 0x42, 0x2f, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x5a, 0x19, 0x67, 0x6f, 0x2e, 0x76, 0x69, 0x61, 0x6d,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f,
-	0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x22, 0x51, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0a,
+	0x67, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x67, 0x65, 0x6f, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2a, 0xa6, 0x01, 0x0a, 0x15, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x43, 0x6c, 0x6f, 0x75, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x27, 0x0a, 0x23, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f,
+	0x43, 0x4f, 0x4d, 0x50, 0x52, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x4f, 0x49,
+	0x4e, 0x54, 0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x52, 0x45, 0x53,
+	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x50,
+	0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x52,
+	0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x5a, 0x53, 0x54, 0x44, 0x10, 0x02, 0x12, 0x20, 0x0a,
+	0x1c, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x43, 0x4f, 0x4d,
+	0x50, 0x52, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x47, 0x5a, 0x49, 0x50, 0x10, 0x03, 0x2a,
+	0x7f, 0x0a, 0x14, 0x4b, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x73, 0x46, 0x69, 0x6c,
+	0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x26, 0x0a, 0x22, 0x4b, 0x49, 0x4e, 0x45, 0x4d,
+	0x41, 0x54, 0x49, 0x43, 0x53, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41,
+	0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x1e, 0x0a, 0x1a, 0x4b, 0x49, 0x4e, 0x45, 0x4d, 0x41, 0x54, 0x49, 0x43, 0x53, 0x5f, 0x46, 0x49,
+	0x4c, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x53, 0x56, 0x41, 0x10, 0x01, 0x12,
+	0x1f, 0x0a, 0x1b, 0x4b, 0x49, 0x4e, 0x45, 0x4d, 0x41, 0x54, 0x49, 0x43, 0x53, 0x5f, 0x46, 0x49,
+	0x4c, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x52, 0x44, 0x46, 0x10, 0x02,
+	0x3a, 0x61, 0x0a, 0x1a, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x68, 0x65, 0x61, 0x72, 0x74,
+	0x62, 0x65, 0x61, 0x74, 0x5f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x1e,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xa4,
+	0x92, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x48, 0x65,
+	0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65, 0x64,
+	0x88, 0x01, 0x01, 0x42, 0x2f, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x5a, 0x19, 0x67, 0x6f, 0x2e, 0x76, 0x69,
+	0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1895,74 +2124,77 @@ func file_common_v1_common_proto_rawDescGZIP() []byte {
 	return file_common_v1_common_proto_rawDescData
 }
 
-var file_common_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_common_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_common_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_common_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_common_v1_common_proto_goTypes = []interface{}{
-	(KinematicsFileFormat)(0),          // 0: viam.common.v1.KinematicsFileFormat
-	(*ResourceName)(nil),               // 1: viam.common.v1.ResourceName
-	(*BoardStatus)(nil),                // 2: viam.common.v1.BoardStatus
-	(*AnalogStatus)(nil),               // 3: viam.common.v1.AnalogStatus
-	(*DigitalInterruptStatus)(nil),     // 4: viam.common.v1.DigitalInterruptStatus
-	(*Pose)(nil),                       // 5: viam.common.v1.Pose
-	(*Orientation)(nil),                // 6: viam.common.v1.Orientation
-	(*PoseInFrame)(nil),                // 7: viam.common.v1.PoseInFrame
-	(*Vector3)(nil),                    // 8: viam.common.v1.Vector3
-	(*Sphere)(nil),                     // 9: viam.common.v1.Sphere
-	(*Capsule)(nil),                    // 10: viam.common.v1.Capsule
-	(*RectangularPrism)(nil),           // 11: viam.common.v1.RectangularPrism
-	(*Geometry)(nil),                   // 12: viam.common.v1.Geometry
-	(*GeometriesInFrame)(nil),          // 13: viam.common.v1.GeometriesInFrame
-	(*PointCloudObject)(nil),           // 14: viam.common.v1.PointCloudObject
-	(*GeoPoint)(nil),                   // 15: viam.common.v1.GeoPoint
-	(*GeoObstacle)(nil),                // 16: viam.common.v1.GeoObstacle
-	(*Transform)(nil),                  // 17: viam.common.v1.Transform
-	(*WorldState)(nil),                 // 18: viam.common.v1.WorldState
-	(*ActuatorStatus)(nil),             // 19: viam.common.v1.ActuatorStatus
-	(*ResponseMetadata)(nil),           // 20: viam.common.v1.ResponseMetadata
-	(*DoCommandRequest)(nil),           // 21: viam.common.v1.DoCommandRequest
-	(*DoCommandResponse)(nil),          // 22: viam.common.v1.DoCommandResponse
-	(*GetKinematicsRequest)(nil),       // 23: viam.common.v1.GetKinematicsRequest
-	(*GetKinematicsResponse)(nil),      // 24: viam.common.v1.GetKinematicsResponse
-	(*GetGeometriesRequest)(nil),       // 25: viam.common.v1.GetGeometriesRequest
-	(*GetGeometriesResponse)(nil),      // 26: viam.common.v1.GetGeometriesResponse
-	nil,                                // 27: viam.common.v1.BoardStatus.AnalogsEntry
-	nil,                                // 28: viam.common.v1.BoardStatus.DigitalInterruptsEntry
-	(*timestamppb.Timestamp)(nil),      // 29: google.protobuf.Timestamp
-	(*structpb.Struct)(nil),            // 30: google.protobuf.Struct
-	(*descriptorpb.MethodOptions)(nil), // 31: google.protobuf.MethodOptions
+	(PointCloudCompression)(0),         // 0: viam.common.v1.PointCloudCompression
+	(KinematicsFileFormat)(0),          // 1: viam.common.v1.KinematicsFileFormat
+	(*ResourceName)(nil),               // 2: viam.common.v1.ResourceName
+	(*BoardStatus)(nil),                // 3: viam.common.v1.BoardStatus
+	(*AnalogStatus)(nil),               // 4: viam.common.v1.AnalogStatus
+	(*DigitalInterruptStatus)(nil),     // 5: viam.common.v1.DigitalInterruptStatus
+	(*Pose)(nil),                       // 6: viam.common.v1.Pose
+	(*Orientation)(nil),                // 7: viam.common.v1.Orientation
+	(*PoseInFrame)(nil),                // 8: viam.common.v1.PoseInFrame
+	(*Vector3)(nil),                    // 9: viam.common.v1.Vector3
+	(*Sphere)(nil),                     // 10: viam.common.v1.Sphere
+	(*Capsule)(nil),                    // 11: viam.common.v1.Capsule
+	(*RectangularPrism)(nil),           // 12: viam.common.v1.RectangularPrism
+	(*Geometry)(nil),                   // 13: viam.common.v1.Geometry
+	(*GeometriesInFrame)(nil),          // 14: viam.common.v1.GeometriesInFrame
+	(*PointCloudObject)(nil),           // 15: viam.common.v1.PointCloudObject
+	(*PointCloud)(nil),                 // 16: viam.common.v1.PointCloud
+	(*GeoPoint)(nil),                   // 17: viam.common.v1.GeoPoint
+	(*GeoObstacle)(nil),                // 18: viam.common.v1.GeoObstacle
+	(*Transform)(nil),                  // 19: viam.common.v1.Transform
+	(*WorldState)(nil),                 // 20: viam.common.v1.WorldState
+	(*ActuatorStatus)(nil),             // 21: viam.common.v1.ActuatorStatus
+	(*ResponseMetadata)(nil),           // 22: viam.common.v1.ResponseMetadata
+	(*DoCommandRequest)(nil),           // 23: viam.common.v1.DoCommandRequest
+	(*DoCommandResponse)(nil),          // 24: viam.common.v1.DoCommandResponse
+	(*GetKinematicsRequest)(nil),       // 25: viam.common.v1.GetKinematicsRequest
+	(*GetKinematicsResponse)(nil),      // 26: viam.common.v1.GetKinematicsResponse
+	(*GetGeometriesRequest)(nil),       // 27: viam.common.v1.GetGeometriesRequest
+	(*GetGeometriesResponse)(nil),      // 28: viam.common.v1.GetGeometriesResponse
+	nil,                                // 29: viam.common.v1.BoardStatus.AnalogsEntry
+	nil,                                // 30: viam.common.v1.BoardStatus.DigitalInterruptsEntry
+	(*timestamppb.Timestamp)(nil),      // 31: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),            // 32: google.protobuf.Struct
+	(*descriptorpb.MethodOptions)(nil), // 33: google.protobuf.MethodOptions
 }
 var file_common_v1_common_proto_depIdxs = []int32{
-	27, // 0: viam.common.v1.BoardStatus.analogs:type_name -> viam.common.v1.BoardStatus.AnalogsEntry
-	28, // 1: viam.common.v1.BoardStatus.digital_interrupts:type_name -> viam.common.v1.BoardStatus.DigitalInterruptsEntry
-	5,  // 2: viam.common.v1.PoseInFrame.pose:type_name -> viam.common.v1.Pose
-	8,  // 3: viam.common.v1.RectangularPrism.dims_mm:type_name -> viam.common.v1.Vector3
-	5,  // 4: viam.common.v1.Geometry.center:type_name -> viam.common.v1.Pose
-	9,  // 5: viam.common.v1.Geometry.sphere:type_name -> viam.common.v1.Sphere
-	11, // 6: viam.common.v1.Geometry.box:type_name -> viam.common.v1.RectangularPrism
-	10, // 7: viam.common.v1.Geometry.capsule:type_name -> viam.common.v1.Capsule
-	12, // 8: viam.common.v1.GeometriesInFrame.geometries:type_name -> viam.common.v1.Geometry
-	13, // 9: viam.common.v1.PointCloudObject.geometries:type_name -> viam.common.v1.GeometriesInFrame
-	15, // 10: viam.common.v1.GeoObstacle.location:type_name -> viam.common.v1.GeoPoint
-	12, // 11: viam.common.v1.GeoObstacle.geometries:type_name -> viam.common.v1.Geometry
-	7,  // 12: viam.common.v1.Transform.pose_in_observer_frame:type_name -> viam.common.v1.PoseInFrame
-	12, // 13: viam.common.v1.Transform.physical_object:type_name -> viam.common.v1.Geometry
-	13, // 14: viam.common.v1.WorldState.obstacles:type_name -> viam.common.v1.GeometriesInFrame
-	17, // 15: viam.common.v1.WorldState.transforms:type_name -> viam.common.v1.Transform
-	29, // 16: viam.common.v1.ResponseMetadata.captured_at:type_name -> google.protobuf.Timestamp
-	30, // 17: viam.common.v1.DoCommandRequest.command:type_name -> google.protobuf.Struct
-	30, // 18: viam.common.v1.DoCommandResponse.result:type_name -> google.protobuf.Struct
-	30, // 19: viam.common.v1.GetKinematicsRequest.extra:type_name -> google.protobuf.Struct
-	0,  // 20: viam.common.v1.GetKinematicsResponse.format:type_name -> viam.common.v1.KinematicsFileFormat
-	30, // 21: viam.common.v1.GetGeometriesRequest.extra:type_name -> google.protobuf.Struct
-	12, // 22: viam.common.v1.GetGeometriesResponse.geometries:type_name -> viam.common.v1.Geometry
-	3,  // 23: viam.common.v1.BoardStatus.AnalogsEntry.value:type_name -> viam.common.v1.AnalogStatus
-	4,  // 24: viam.common.v1.BoardStatus.DigitalInterruptsEntry.value:type_name -> viam.common.v1.DigitalInterruptStatus
-	31, // 25: viam.common.v1.safety_heartbeat_monitored:extendee -> google.protobuf.MethodOptions
-	26, // [26:26] is the sub-list for method output_type
-	26, // [26:26] is the sub-list for method input_type
-	26, // [26:26] is the sub-list for extension type_name
-	25, // [25:26] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	29, // 0: viam.common.v1.BoardStatus.analogs:type_name -> viam.common.v1.BoardStatus.AnalogsEntry
+	30, // 1: viam.common.v1.BoardStatus.digital_interrupts:type_name -> viam.common.v1.BoardStatus.DigitalInterruptsEntry
+	6,  // 2: viam.common.v1.PoseInFrame.pose:type_name -> viam.common.v1.Pose
+	9,  // 3: viam.common.v1.RectangularPrism.dims_mm:type_name -> viam.common.v1.Vector3
+	6,  // 4: viam.common.v1.Geometry.center:type_name -> viam.common.v1.Pose
+	10, // 5: viam.common.v1.Geometry.sphere:type_name -> viam.common.v1.Sphere
+	12, // 6: viam.common.v1.Geometry.box:type_name -> viam.common.v1.RectangularPrism
+	11, // 7: viam.common.v1.Geometry.capsule:type_name -> viam.common.v1.Capsule
+	13, // 8: viam.common.v1.GeometriesInFrame.geometries:type_name -> viam.common.v1.Geometry
+	14, // 9: viam.common.v1.PointCloudObject.geometries:type_name -> viam.common.v1.GeometriesInFrame
+	0,  // 10: viam.common.v1.PointCloud.compression:type_name -> viam.common.v1.PointCloudCompression
+	17, // 11: viam.common.v1.GeoObstacle.location:type_name -> viam.common.v1.GeoPoint
+	13, // 12: viam.common.v1.GeoObstacle.geometries:type_name -> viam.common.v1.Geometry
+	8,  // 13: viam.common.v1.Transform.pose_in_observer_frame:type_name -> viam.common.v1.PoseInFrame
+	13, // 14: viam.common.v1.Transform.physical_object:type_name -> viam.common.v1.Geometry
+	14, // 15: viam.common.v1.WorldState.obstacles:type_name -> viam.common.v1.GeometriesInFrame
+	19, // 16: viam.common.v1.WorldState.transforms:type_name -> viam.common.v1.Transform
+	31, // 17: viam.common.v1.ResponseMetadata.captured_at:type_name -> google.protobuf.Timestamp
+	32, // 18: viam.common.v1.DoCommandRequest.command:type_name -> google.protobuf.Struct
+	32, // 19: viam.common.v1.DoCommandResponse.result:type_name -> google.protobuf.Struct
+	32, // 20: viam.common.v1.GetKinematicsRequest.extra:type_name -> google.protobuf.Struct
+	1,  // 21: viam.common.v1.GetKinematicsResponse.format:type_name -> viam.common.v1.KinematicsFileFormat
+	32, // 22: viam.common.v1.GetGeometriesRequest.extra:type_name -> google.protobuf.Struct
+	13, // 23: viam.common.v1.GetGeometriesResponse.geometries:type_name -> viam.common.v1.Geometry
+	4,  // 24: viam.common.v1.BoardStatus.AnalogsEntry.value:type_name -> viam.common.v1.AnalogStatus
+	5,  // 25: viam.common.v1.BoardStatus.DigitalInterruptsEntry.value:type_name -> viam.common.v1.DigitalInterruptStatus
+	33, // 26: viam.common.v1.safety_heartbeat_monitored:extendee -> google.protobuf.MethodOptions
+	27, // [27:27] is the sub-list for method output_type
+	27, // [27:27] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	26, // [26:27] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_common_v1_common_proto_init() }
@@ -2140,7 +2372,7 @@ func file_common_v1_common_proto_init() {
 			}
 		}
 		file_common_v1_common_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GeoPoint); i {
+			switch v := v.(*PointCloud); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2152,7 +2384,7 @@ func file_common_v1_common_proto_init() {
 			}
 		}
 		file_common_v1_common_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GeoObstacle); i {
+			switch v := v.(*GeoPoint); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2164,7 +2396,7 @@ func file_common_v1_common_proto_init() {
 			}
 		}
 		file_common_v1_common_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Transform); i {
+			switch v := v.(*GeoObstacle); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2176,7 +2408,7 @@ func file_common_v1_common_proto_init() {
 			}
 		}
 		file_common_v1_common_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WorldState); i {
+			switch v := v.(*Transform); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2188,7 +2420,7 @@ func file_common_v1_common_proto_init() {
 			}
 		}
 		file_common_v1_common_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActuatorStatus); i {
+			switch v := v.(*WorldState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2200,7 +2432,7 @@ func file_common_v1_common_proto_init() {
 			}
 		}
 		file_common_v1_common_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResponseMetadata); i {
+			switch v := v.(*ActuatorStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2212,7 +2444,7 @@ func file_common_v1_common_proto_init() {
 			}
 		}
 		file_common_v1_common_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DoCommandRequest); i {
+			switch v := v.(*ResponseMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2224,7 +2456,7 @@ func file_common_v1_common_proto_init() {
 			}
 		}
 		file_common_v1_common_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DoCommandResponse); i {
+			switch v := v.(*DoCommandRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2236,7 +2468,7 @@ func file_common_v1_common_proto_init() {
 			}
 		}
 		file_common_v1_common_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetKinematicsRequest); i {
+			switch v := v.(*DoCommandResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2248,7 +2480,7 @@ func file_common_v1_common_proto_init() {
 			}
 		}
 		file_common_v1_common_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetKinematicsResponse); i {
+			switch v := v.(*GetKinematicsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2260,7 +2492,7 @@ func file_common_v1_common_proto_init() {
 			}
 		}
 		file_common_v1_common_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetGeometriesRequest); i {
+			switch v := v.(*GetKinematicsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2272,6 +2504,18 @@ func file_common_v1_common_proto_init() {
 			}
 		}
 		file_common_v1_common_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGeometriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_common_v1_common_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetGeometriesResponse); i {
 			case 0:
 				return &v.state
@@ -2289,15 +2533,15 @@ func file_common_v1_common_proto_init() {
 		(*Geometry_Box)(nil),
 		(*Geometry_Capsule)(nil),
 	}
-	file_common_v1_common_proto_msgTypes[16].OneofWrappers = []interface{}{}
-	file_common_v1_common_proto_msgTypes[19].OneofWrappers = []interface{}{}
+	file_common_v1_common_proto_msgTypes[17].OneofWrappers = []interface{}{}
+	file_common_v1_common_proto_msgTypes[20].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_common_v1_common_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   28,
+			NumEnums:      2,
+			NumMessages:   29,
 			NumExtensions: 1,
 			NumServices:   0,
 		},
@@ -23,6 +23,61 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type JointType int32
+
+const (
+	JointType_JOINT_TYPE_UNSPECIFIED JointType = 0
+	// A joint that rotates within position limits
+	JointType_JOINT_TYPE_REVOLUTE JointType = 1
+	// A joint that slides along an axis within position limits
+	JointType_JOINT_TYPE_PRISMATIC JointType = 2
+	// A joint that rotates without position limits
+	JointType_JOINT_TYPE_CONTINUOUS JointType = 3
+)
+
+// Enum value maps for JointType.
+var (
+	JointType_name = map[int32]string{
+		0: "JOINT_TYPE_UNSPECIFIED",
+		1: "JOINT_TYPE_REVOLUTE",
+		2: "JOINT_TYPE_PRISMATIC",
+		3: "JOINT_TYPE_CONTINUOUS",
+	}
+	JointType_value = map[string]int32{
+		"JOINT_TYPE_UNSPECIFIED": 0,
+		"JOINT_TYPE_REVOLUTE":    1,
+		"JOINT_TYPE_PRISMATIC":   2,
+		"JOINT_TYPE_CONTINUOUS":  3,
+	}
+)
+
+func (x JointType) Enum() *JointType {
+	p := new(JointType)
+	*p = x
+	return p
+}
+
+func (x JointType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JointType) Descriptor() protoreflect.EnumDescriptor {
+	return file_component_arm_v1_arm_proto_enumTypes[0].Descriptor()
+}
+
+func (JointType) Type() protoreflect.EnumType {
+	return &file_component_arm_v1_arm_proto_enumTypes[0]
+}
+
+func (x JointType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JointType.Descriptor instead.
+func (JointType) EnumDescriptor() ([]byte, []int) {
+	return file_component_arm_v1_arm_proto_rawDescGZIP(), []int{0}
+}
+
 type GetEndPositionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -284,6 +339,204 @@ func (x *GetJointPositionsResponse) GetPositions() *JointPositions {
 	return nil
 }
 
+type GetJointPropertiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of an arm
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Additional arguments to the method
+	Extra *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
+}
+
+func (x *GetJointPropertiesRequest) Reset() {
+	*x = GetJointPropertiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_arm_v1_arm_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetJointPropertiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJointPropertiesRequest) ProtoMessage() {}
+
+func (x *GetJointPropertiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_arm_v1_arm_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJointPropertiesRequest.ProtoReflect.Descriptor instead.
+func (*GetJointPropertiesRequest) Descriptor() ([]byte, []int) {
+	return file_component_arm_v1_arm_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetJointPropertiesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetJointPropertiesRequest) GetExtra() *structpb.Struct {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+type GetJointPropertiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A list of joint properties, with 1 entry per joint DOF ordered spatially from the base toward the end effector
+	// in the same order as JointPositions
+	Joints []*JointProperties `protobuf:"bytes,1,rep,name=joints,proto3" json:"joints,omitempty"`
+}
+
+func (x *GetJointPropertiesResponse) Reset() {
+	*x = GetJointPropertiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_arm_v1_arm_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetJointPropertiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJointPropertiesResponse) ProtoMessage() {}
+
+func (x *GetJointPropertiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_arm_v1_arm_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJointPropertiesResponse.ProtoReflect.Descriptor instead.
+func (*GetJointPropertiesResponse) Descriptor() ([]byte, []int) {
+	return file_component_arm_v1_arm_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetJointPropertiesResponse) GetJoints() []*JointProperties {
+	if x != nil {
+		return x.Joints
+	}
+	return nil
+}
+
+type JointProperties struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the joint as it appears in the arm's kinematics
+	Name string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type JointType `protobuf:"varint,2,opt,name=type,proto3,enum=viam.component.arm.v1.JointType" json:"type,omitempty"`
+	// Position limits of the joint. Rotation values are in degrees, translational values in mm.
+	// Unset for joints without position limits
+	MinPosition *float64 `protobuf:"fixed64,3,opt,name=min_position,json=minPosition,proto3,oneof" json:"min_position,omitempty"`
+	MaxPosition *float64 `protobuf:"fixed64,4,opt,name=max_position,json=maxPosition,proto3,oneof" json:"max_position,omitempty"`
+	// Maximum velocity of the joint, in degrees per second or mm per second
+	MaxVelocity *float64 `protobuf:"fixed64,5,opt,name=max_velocity,json=maxVelocity,proto3,oneof" json:"max_velocity,omitempty"`
+	// Maximum acceleration of the joint, in degrees per second squared or mm per second squared
+	MaxAcceleration *float64 `protobuf:"fixed64,6,opt,name=max_acceleration,json=maxAcceleration,proto3,oneof" json:"max_acceleration,omitempty"`
+}
+
+func (x *JointProperties) Reset() {
+	*x = JointProperties{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_arm_v1_arm_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JointProperties) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JointProperties) ProtoMessage() {}
+
+func (x *JointProperties) ProtoReflect() protoreflect.Message {
+	mi := &file_component_arm_v1_arm_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JointProperties.ProtoReflect.Descriptor instead.
+func (*JointProperties) Descriptor() ([]byte, []int) {
+	return file_component_arm_v1_arm_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *JointProperties) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *JointProperties) GetType() JointType {
+	if x != nil {
+		return x.Type
+	}
+	return JointType_JOINT_TYPE_UNSPECIFIED
+}
+
+func (x *JointProperties) GetMinPosition() float64 {
+	if x != nil && x.MinPosition != nil {
+		return *x.MinPosition
+	}
+	return 0
+}
+
+func (x *JointProperties) GetMaxPosition() float64 {
+	if x != nil && x.MaxPosition != nil {
+		return *x.MaxPosition
+	}
+	return 0
+}
+
+func (x *JointProperties) GetMaxVelocity() float64 {
+	if x != nil && x.MaxVelocity != nil {
+		return *x.MaxVelocity
+	}
+	return 0
+}
+
+func (x *JointProperties) GetMaxAcceleration() float64 {
+	if x != nil && x.MaxAcceleration != nil {
+		return *x.MaxAcceleration
+	}
+	return 0
+}
+
 // Moves an arm to the specified pose that is within the reference frame of the arm.
 // Move request in Motion API has the same behavior except that it performs obstacle avoidance when a world_state
 // message is specified.
@@ -303,7 +556,7 @@ type MoveToPositionRequest struct {
 func (x *MoveToPositionRequest) Reset() {
 	*x = MoveToPositionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_arm_v1_arm_proto_msgTypes[5]
+		mi := &file_component_arm_v1_arm_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -316,7 +569,7 @@ func (x *MoveToPositionRequest) String() string {
 func (*MoveToPositionRequest) ProtoMessage() {}
 
 func (x *MoveToPositionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_component_arm_v1_arm_proto_msgTypes[5]
+	mi := &file_component_arm_v1_arm_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -329,7 +582,7 @@ func (x *MoveToPositionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MoveToPositionRequest.ProtoReflect.Descriptor instead.
 func (*MoveToPositionRequest) Descriptor() ([]byte, []int) {
-	return file_component_arm_v1_arm_proto_rawDescGZIP(), []int{5}
+	return file_component_arm_v1_arm_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *MoveToPositionRequest) GetName() string {
@@ -362,7 +615,7 @@ type MoveToPositionResponse struct {
 func (x *MoveToPositionResponse) Reset() {
 	*x = MoveToPositionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_arm_v1_arm_proto_msgTypes[6]
+		mi := &file_component_arm_v1_arm_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -375,7 +628,7 @@ func (x *MoveToPositionResponse) String() string {
 func (*MoveToPositionResponse) ProtoMessage() {}
 
 func (x *MoveToPositionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_component_arm_v1_arm_proto_msgTypes[6]
+	mi := &file_component_arm_v1_arm_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -388,7 +641,7 @@ func (x *MoveToPositionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MoveToPositionResponse.ProtoReflect.Descriptor instead.
 func (*MoveToPositionResponse) Descriptor() ([]byte, []int) {
-	return file_component_arm_v1_arm_proto_rawDescGZIP(), []int{6}
+	return file_component_arm_v1_arm_proto_rawDescGZIP(), []int{9}
 }
 
 type MoveToJointPositionsRequest struct {
@@ -408,7 +661,7 @@ type MoveToJointPositionsRequest struct {
 func (x *MoveToJointPositionsRequest) Reset() {
 	*x = MoveToJointPositionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_arm_v1_arm_proto_msgTypes[7]
+		mi := &file_component_arm_v1_arm_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -421,7 +674,7 @@ func (x *MoveToJointPositionsRequest) String() string {
 func (*MoveToJointPositionsRequest) ProtoMessage() {}
 
 func (x *MoveToJointPositionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_component_arm_v1_arm_proto_msgTypes[7]
+	mi := &file_component_arm_v1_arm_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -434,7 +687,7 @@ func (x *MoveToJointPositionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MoveToJointPositionsRequest.ProtoReflect.Descriptor instead.
 func (*MoveToJointPositionsRequest) Descriptor() ([]byte, []int) {
-	return file_component_arm_v1_arm_proto_rawDescGZIP(), []int{7}
+	return file_component_arm_v1_arm_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *MoveToJointPositionsRequest) GetName() string {
@@ -467,7 +720,7 @@ type MoveToJointPositionsResponse struct {
 func (x *MoveToJointPositionsResponse) Reset() {
 	*x = MoveToJointPositionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_arm_v1_arm_proto_msgTypes[8]
+		mi := &file_component_arm_v1_arm_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -480,7 +733,7 @@ func (x *MoveToJointPositionsResponse) String() string {
 func (*MoveToJointPositionsResponse) ProtoMessage() {}
 
 func (x *MoveToJointPositionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_component_arm_v1_arm_proto_msgTypes[8]
+	mi := &file_component_arm_v1_arm_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -493,7 +746,188 @@ func (x *MoveToJointPositionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MoveToJointPositionsResponse.ProtoReflect.Descriptor instead.
 func (*MoveToJointPositionsResponse) Descriptor() ([]byte, []int) {
-	return file_component_arm_v1_arm_proto_rawDescGZIP(), []int{8}
+	return file_component_arm_v1_arm_proto_rawDescGZIP(), []int{11}
+}
+
+type MoveThroughJointPositionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of an arm
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// An ordered list of joint positions to move through; the last entry is the final destination.
+	// Each entry should have 1 value per joint DOF, ordered spatially from the base toward the end effector
+	Positions []*JointPositions `protobuf:"bytes,2,rep,name=positions,proto3" json:"positions,omitempty"`
+	// Limits applied to the whole motion when no per-waypoint limits are given
+	Options *MoveOptions `protobuf:"bytes,3,opt,name=options,proto3,oneof" json:"options,omitempty"`
+	// Optional per-waypoint limits. If specified, there should be 1 entry per entry in positions, and each
+	// entry applies to the segment of the motion ending at the matching waypoint
+	WaypointOptions []*MoveOptions `protobuf:"bytes,4,rep,name=waypoint_options,json=waypointOptions,proto3" json:"waypoint_options,omitempty"`
+	// Additional arguments to the method
+	Extra *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
+}
+
+func (x *MoveThroughJointPositionsRequest) Reset() {
+	*x = MoveThroughJointPositionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_arm_v1_arm_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveThroughJointPositionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveThroughJointPositionsRequest) ProtoMessage() {}
+
+func (x *MoveThroughJointPositionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_arm_v1_arm_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveThroughJointPositionsRequest.ProtoReflect.Descriptor instead.
+func (*MoveThroughJointPositionsRequest) Descriptor() ([]byte, []int) {
+	return file_component_arm_v1_arm_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MoveThroughJointPositionsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MoveThroughJointPositionsRequest) GetPositions() []*JointPositions {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+func (x *MoveThroughJointPositionsRequest) GetOptions() *MoveOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *MoveThroughJointPositionsRequest) GetWaypointOptions() []*MoveOptions {
+	if x != nil {
+		return x.WaypointOptions
+	}
+	return nil
+}
+
+func (x *MoveThroughJointPositionsRequest) GetExtra() *structpb.Struct {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+type MoveThroughJointPositionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MoveThroughJointPositionsResponse) Reset() {
+	*x = MoveThroughJointPositionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_arm_v1_arm_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveThroughJointPositionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveThroughJointPositionsResponse) ProtoMessage() {}
+
+func (x *MoveThroughJointPositionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_arm_v1_arm_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveThroughJointPositionsResponse.ProtoReflect.Descriptor instead.
+func (*MoveThroughJointPositionsResponse) Descriptor() ([]byte, []int) {
+	return file_component_arm_v1_arm_proto_rawDescGZIP(), []int{13}
+}
+
+type MoveOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Maximum allowable velocity of any arm joint, in degrees per second
+	MaxVelDegsPerSec *float64 `protobuf:"fixed64,1,opt,name=max_vel_degs_per_sec,json=maxVelDegsPerSec,proto3,oneof" json:"max_vel_degs_per_sec,omitempty"`
+	// Maximum allowable acceleration of any arm joint, in degrees per second squared
+	MaxAccDegsPerSec2 *float64 `protobuf:"fixed64,2,opt,name=max_acc_degs_per_sec2,json=maxAccDegsPerSec2,proto3,oneof" json:"max_acc_degs_per_sec2,omitempty"`
+}
+
+func (x *MoveOptions) Reset() {
+	*x = MoveOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_arm_v1_arm_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveOptions) ProtoMessage() {}
+
+func (x *MoveOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_component_arm_v1_arm_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveOptions.ProtoReflect.Descriptor instead.
+func (*MoveOptions) Descriptor() ([]byte, []int) {
+	return file_component_arm_v1_arm_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MoveOptions) GetMaxVelDegsPerSec() float64 {
+	if x != nil && x.MaxVelDegsPerSec != nil {
+		return *x.MaxVelDegsPerSec
+	}
+	return 0
+}
+
+func (x *MoveOptions) GetMaxAccDegsPerSec2() float64 {
+	if x != nil && x.MaxAccDegsPerSec2 != nil {
+		return *x.MaxAccDegsPerSec2
+	}
+	return 0
 }
 
 type StopRequest struct {
@@ -510,7 +944,7 @@ type StopRequest struct {
 func (x *StopRequest) Reset() {
 	*x = StopRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_arm_v1_arm_proto_msgTypes[9]
+		mi := &file_component_arm_v1_arm_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -523,7 +957,7 @@ func (x *StopRequest) String() string {
 func (*StopRequest) ProtoMessage() {}
 
 func (x *StopRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_component_arm_v1_arm_proto_msgTypes[9]
+	mi := &file_component_arm_v1_arm_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -536,7 +970,7 @@ func (x *StopRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopRequest.ProtoReflect.Descriptor instead.
 func (*StopRequest) Descriptor() ([]byte, []int) {
-	return file_component_arm_v1_arm_proto_rawDescGZIP(), []int{9}
+	return file_component_arm_v1_arm_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *StopRequest) GetName() string {
@@ -562,7 +996,7 @@ type StopResponse struct {
 func (x *StopResponse) Reset() {
 	*x = StopResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_arm_v1_arm_proto_msgTypes[10]
+		mi := &file_component_arm_v1_arm_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -575,7 +1009,7 @@ func (x *StopResponse) String() string {
 func (*StopResponse) ProtoMessage() {}
 
 func (x *StopResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_component_arm_v1_arm_proto_msgTypes[10]
+	mi := &file_component_arm_v1_arm_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -588,7 +1022,7 @@ func (x *StopResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopResponse.ProtoReflect.Descriptor instead.
 func (*StopResponse) Descriptor() ([]byte, []int) {
-	return file_component_arm_v1_arm_proto_rawDescGZIP(), []int{10}
+	return file_component_arm_v1_arm_proto_rawDescGZIP(), []int{16}
 }
 
 type Status struct {
@@ -604,7 +1038,7 @@ type Status struct {
 func (x *Status) Reset() {
 	*x = Status{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_arm_v1_arm_proto_msgTypes[11]
+		mi := &file_component_arm_v1_arm_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -617,7 +1051,7 @@ func (x *Status) String() string {
 func (*Status) ProtoMessage() {}
 
 func (x *Status) ProtoReflect() protoreflect.Message {
-	mi := &file_component_arm_v1_arm_proto_msgTypes[11]
+	mi := &file_component_arm_v1_arm_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -630,7 +1064,7 @@ func (x *Status) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Status.ProtoReflect.Descriptor instead.
 func (*Status) Descriptor() ([]byte, []int) {
-	return file_component_arm_v1_arm_proto_rawDescGZIP(), []int{11}
+	return file_component_arm_v1_arm_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *Status) GetEndPosition() *v1.Pose {
@@ -665,7 +1099,7 @@ type IsMovingRequest struct {
 func (x *IsMovingRequest) Reset() {
 	*x = IsMovingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_arm_v1_arm_proto_msgTypes[12]
+		mi := &file_component_arm_v1_arm_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -678,7 +1112,7 @@ func (x *IsMovingRequest) String() string {
 func (*IsMovingRequest) ProtoMessage() {}
 
 func (x *IsMovingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_component_arm_v1_arm_proto_msgTypes[12]
+	mi := &file_component_arm_v1_arm_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -691,7 +1125,7 @@ func (x *IsMovingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsMovingRequest.ProtoReflect.Descriptor instead.
 func (*IsMovingRequest) Descriptor() ([]byte, []int) {
-	return file_component_arm_v1_arm_proto_rawDescGZIP(), []int{12}
+	return file_component_arm_v1_arm_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *IsMovingRequest) GetName() string {
@@ -712,7 +1146,7 @@ type IsMovingResponse struct {
 func (x *IsMovingResponse) Reset() {
 	*x = IsMovingResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_arm_v1_arm_proto_msgTypes[13]
+		mi := &file_component_arm_v1_arm_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -725,7 +1159,7 @@ func (x *IsMovingResponse) String() string {
 func (*IsMovingResponse) ProtoMessage() {}
 
 func (x *IsMovingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_component_arm_v1_arm_proto_msgTypes[13]
+	mi := &file_component_arm_v1_arm_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -738,7 +1172,7 @@ func (x *IsMovingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsMovingResponse.ProtoReflect.Descriptor instead.
 func (*IsMovingResponse) Descriptor() ([]byte, []int) {
-	return file_component_arm_v1_arm_proto_rawDescGZIP(), []int{13}
+	return file_component_arm_v1_arm_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *IsMovingResponse) GetIsMoving() bool {
@@ -784,145 +1218,245 @@ var file_component_arm_v1_arm_proto_rawDesc = []byte{
 	0x32, 0x25, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
 	0x74, 0x2e, 0x61, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x6f,
 	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x15, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x50, 0x6f, 0x73,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x24, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76,
-	0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f,
-	0x73, 0x65, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18,
-	0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05,
-	0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x18, 0x0a, 0x16, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x50,
-	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0xa5, 0x01, 0x0a, 0x1b, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x4a, 0x6f, 0x69, 0x6e, 0x74, 0x50,
-	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x6e, 0x73, 0x22, 0x5e, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f,
-	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4a,
-	0x6f, 0x69, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x09, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72,
-	0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
-	0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x1e, 0x0a, 0x1c, 0x4d, 0x6f, 0x76, 0x65, 0x54,
-	0x6f, 0x4a, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x50, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x70, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78,
-	0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75,
-	0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x0e, 0x0a, 0x0c, 0x53, 0x74, 0x6f,
-	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xae, 0x01, 0x0a, 0x06, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x37, 0x0a, 0x0c, 0x65, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x69, 0x61,
-	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x65,
-	0x52, 0x0b, 0x65, 0x6e, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4e, 0x0a,
-	0x0f, 0x6a, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f,
+	0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74,
+	0x72, 0x61, 0x22, 0x5c, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3e, 0x0a, 0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x2e, 0x61, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x22, 0xcb, 0x02, 0x0a, 0x0f, 0x4a, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f,
 	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4a,
-	0x6f, 0x69, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0e, 0x6a,
-	0x6f, 0x69, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x0a,
-	0x09, 0x69, 0x73, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x08, 0x69, 0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x22, 0x25, 0x0a, 0x0f, 0x49, 0x73,
-	0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x22, 0x2f, 0x0a, 0x10, 0x49, 0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6d, 0x6f, 0x76, 0x69,
-	0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4d, 0x6f, 0x76, 0x69,
-	0x6e, 0x67, 0x32, 0x94, 0x0b, 0x0a, 0x0a, 0x41, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0xa1, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x64, 0x50, 0x6f, 0x73, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x26,
+	0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x0b,
+	0x6d, 0x61, 0x78, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x26,
+	0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x48, 0x02, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x56, 0x65, 0x6c, 0x6f, 0x63,
+	0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x63,
+	0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01,
+	0x48, 0x03, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x41, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6d, 0x61, 0x78,
+	0x5f, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6d, 0x61,
+	0x78, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x80,
+	0x01, 0x0a, 0x15, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x65, 0x52, 0x02,
+	0x74, 0x6f, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72,
+	0x61, 0x22, 0x18, 0x0a, 0x16, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa5, 0x01, 0x0a, 0x1b,
+	0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x4a, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x43, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x2e, 0x61, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x74,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x22, 0x1e, 0x0a, 0x1c, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x4a, 0x6f, 0x69,
+	0x6e, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xc8, 0x02, 0x0a, 0x20, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x68, 0x72, 0x6f,
+	0x75, 0x67, 0x68, 0x4a, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x09,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x2e, 0x61, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x41, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x2e, 0x61, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x4d, 0x0a, 0x10, 0x77, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e,
+	0x61, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x0f, 0x77, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74,
+	0x72, 0x61, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x23,
+	0x0a, 0x21, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x4a, 0x6f, 0x69,
+	0x6e, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xac, 0x01, 0x0a, 0x0b, 0x4d, 0x6f, 0x76, 0x65, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x33, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x65, 0x6c, 0x5f, 0x64,
+	0x65, 0x67, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x01, 0x48, 0x00, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x56, 0x65, 0x6c, 0x44, 0x65, 0x67, 0x73, 0x50,
+	0x65, 0x72, 0x53, 0x65, 0x63, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f,
+	0x61, 0x63, 0x63, 0x5f, 0x64, 0x65, 0x67, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63,
+	0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x41, 0x63,
+	0x63, 0x44, 0x65, 0x67, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x32, 0x88, 0x01, 0x01, 0x42,
+	0x17, 0x0a, 0x15, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x65, 0x6c, 0x5f, 0x64, 0x65, 0x67, 0x73,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x6d, 0x61, 0x78,
+	0x5f, 0x61, 0x63, 0x63, 0x5f, 0x64, 0x65, 0x67, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65,
+	0x63, 0x32, 0x22, 0x50, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65,
+	0x78, 0x74, 0x72, 0x61, 0x22, 0x0e, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xae, 0x01, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x37, 0x0a, 0x0c, 0x65, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x65, 0x52, 0x0b, 0x65, 0x6e, 0x64,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4e, 0x0a, 0x0f, 0x6a, 0x6f, 0x69, 0x6e,
+	0x74, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x2e, 0x61, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x74, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0e, 0x6a, 0x6f, 0x69, 0x6e, 0x74, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6d,
+	0x6f, 0x76, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4d,
+	0x6f, 0x76, 0x69, 0x6e, 0x67, 0x22, 0x25, 0x0a, 0x0f, 0x49, 0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2f, 0x0a, 0x10,
+	0x49, 0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x2a, 0x75, 0x0a,
+	0x09, 0x4a, 0x6f, 0x69, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x4a, 0x4f,
+	0x49, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x4a, 0x4f, 0x49, 0x4e, 0x54, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x56, 0x4f, 0x4c, 0x55, 0x54, 0x45, 0x10, 0x01, 0x12,
+	0x18, 0x0a, 0x14, 0x4a, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x52,
+	0x49, 0x53, 0x4d, 0x41, 0x54, 0x49, 0x43, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x4a, 0x4f, 0x49,
+	0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x49, 0x4e, 0x55, 0x4f,
+	0x55, 0x53, 0x10, 0x03, 0x32, 0xa7, 0x0e, 0x0a, 0x0a, 0x41, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0xa1, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x64, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x45, 0x6e, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70,
 	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x45, 0x6e, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
-	0x65, 0x6e, 0x74, 0x2e, 0x61, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e,
-	0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x76, 0x69, 0x61, 0x6d,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
-	0x74, 0x2f, 0x61, 0x72, 0x6d, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0xa5, 0x01, 0x0a, 0x0e, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f,
-	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e,
+	0x45, 0x6e, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x76, 0x69,
+	0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x2f, 0x61, 0x72, 0x6d, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0xa5, 0x01, 0x0a, 0x0e, 0x4d, 0x6f, 0x76, 0x65,
+	0x54, 0x6f, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x2e, 0x76, 0x69, 0x61,
+	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x72, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e,
 	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x72, 0x6d, 0x2e, 0x76, 0x31,
 	0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f,
-	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x6f, 0x76, 0x65, 0x54, 0x6f, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0xa0, 0x92, 0x29, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x2c, 0x1a, 0x2a, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
-	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x72, 0x6d, 0x2f, 0x7b, 0x6e,
-	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0xb1, 0x01,
-	0x0a, 0x11, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
-	0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4a,
-	0x6f, 0x69, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x4a, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x31,
-	0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d,
-	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x72, 0x6d, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65,
-	0x7d, 0x2f, 0x6a, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0xbe, 0x01, 0x0a, 0x14, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x4a, 0x6f, 0x69, 0x6e,
-	0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x32, 0x2e, 0x76, 0x69, 0x61,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0xa0, 0x92, 0x29, 0x01, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x2c, 0x1a, 0x2a, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x72, 0x6d, 0x2f,
+	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0xb1, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33,
+	0x12, 0x31, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x72, 0x6d, 0x2f, 0x7b, 0x6e, 0x61,
+	0x6d, 0x65, 0x7d, 0x2f, 0x6a, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0xb5, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x74,
+	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x30, 0x2e, 0x76, 0x69, 0x61,
 	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x72, 0x6d, 0x2e,
-	0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x4a, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x6f,
-	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33,
-	0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e,
-	0x61, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x4a, 0x6f, 0x69,
-	0x6e, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x3d, 0xa0, 0x92, 0x29, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x1a,
-	0x31, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f,
-	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x72, 0x6d, 0x2f, 0x7b, 0x6e, 0x61, 0x6d,
-	0x65, 0x7d, 0x2f, 0x6a, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x7f, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x22, 0x2e, 0x76, 0x69, 0x61,
-	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x72, 0x6d, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
-	0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e,
-	0x61, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x22, 0x26, 0x2f, 0x76, 0x69,
-	0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
-	0x65, 0x6e, 0x74, 0x2f, 0x61, 0x72, 0x6d, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73,
-	0x74, 0x6f, 0x70, 0x12, 0x90, 0x01, 0x0a, 0x08, 0x49, 0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67,
-	0x12, 0x26, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
-	0x74, 0x2e, 0x61, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x76,
+	0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x72,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x12, 0x32, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f,
+	0x61, 0x72, 0x6d, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6a, 0x6f, 0x69, 0x6e, 0x74,
+	0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0xbe, 0x01, 0x0a, 0x14,
+	0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x4a, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x32, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76,
+	0x65, 0x54, 0x6f, 0x4a, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e,
 	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x72, 0x6d, 0x2e, 0x76, 0x31,
-	0x2e, 0x49, 0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x76, 0x69, 0x61, 0x6d,
+	0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x4a, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0xa0,
+	0x92, 0x29, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x1a, 0x31, 0x2f, 0x76, 0x69, 0x61, 0x6d,
 	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
-	0x74, 0x2f, 0x61, 0x72, 0x6d, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x69, 0x73, 0x5f,
-	0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x12, 0x86, 0x01, 0x0a, 0x09, 0x44, 0x6f, 0x43, 0x6f, 0x6d,
-	0x6d, 0x61, 0x6e, 0x64, 0x12, 0x20, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x2e, 0x22, 0x2c, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
-	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x72, 0x6d, 0x2f, 0x7b, 0x6e,
-	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x6f, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12,
-	0x92, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4b, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63,
-	0x73, 0x12, 0x24, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x69, 0x6e, 0x65,
-	0x6d, 0x61, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70,
+	0x74, 0x2f, 0x61, 0x72, 0x6d, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6a, 0x6f, 0x69,
+	0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xd8, 0x01, 0x0a,
+	0x19, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x4a, 0x6f, 0x69, 0x6e,
+	0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x2e, 0x76, 0x69, 0x61,
+	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x72, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x4a, 0x6f,
+	0x69, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65,
+	0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x4a, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0xa0,
+	0x92, 0x29, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3e, 0x1a, 0x3c, 0x2f, 0x76, 0x69, 0x61, 0x6d,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x2f, 0x61, 0x72, 0x6d, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6a, 0x6f, 0x69,
+	0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x74, 0x72, 0x61,
+	0x6a, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x7f, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x12,
+	0x22, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x2e, 0x61, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28,
+	0x22, 0x26, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x72, 0x6d, 0x2f, 0x7b, 0x6e, 0x61,
+	0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x74, 0x6f, 0x70, 0x12, 0x90, 0x01, 0x0a, 0x08, 0x49, 0x73, 0x4d,
+	0x6f, 0x76, 0x69, 0x6e, 0x67, 0x12, 0x26, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73,
+	0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x61,
+	0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b,
+	0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x72, 0x6d, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65,
+	0x7d, 0x2f, 0x69, 0x73, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x12, 0x86, 0x01, 0x0a, 0x09,
+	0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x20, 0x2e, 0x76, 0x69, 0x61, 0x6d,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76, 0x69,
+	0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x22, 0x2c, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70,
 	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x61,
-	0x72, 0x6d, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6b, 0x69, 0x6e, 0x65, 0x6d, 0x61,
-	0x74, 0x69, 0x63, 0x73, 0x12, 0x92, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6f, 0x6d,
-	0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6f, 0x6d, 0x65,
-	0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x76,
+	0x72, 0x6d, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x6f, 0x5f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x12, 0x92, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4b, 0x69, 0x6e, 0x65,
+	0x6d, 0x61, 0x74, 0x69, 0x63, 0x73, 0x12, 0x24, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x69, 0x6e, 0x65, 0x6d,
+	0x61, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x76,
 	0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x74, 0x4b, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x76, 0x69,
 	0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
-	0x65, 0x6e, 0x74, 0x2f, 0x61, 0x72, 0x6d, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x67,
-	0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x42, 0x3d, 0x0a, 0x19, 0x63, 0x6f, 0x6d,
-	0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e,
-	0x61, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x5a, 0x20, 0x67, 0x6f, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
-	0x74, 0x2f, 0x61, 0x72, 0x6d, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x6e, 0x74, 0x2f, 0x61, 0x72, 0x6d, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6b,
+	0x69, 0x6e, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x73, 0x12, 0x92, 0x01, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x76, 0x69,
+	0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e,
+	0x12, 0x2c, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x72, 0x6d, 0x2f, 0x7b, 0x6e, 0x61,
+	0x6d, 0x65, 0x7d, 0x2f, 0x67, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x42, 0x3d,
+	0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x5a, 0x20, 0x67, 0x6f, 0x2e,
+	0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x72, 0x6d, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -937,66 +1471,85 @@ func file_component_arm_v1_arm_proto_rawDescGZIP() []byte {
 	return file_component_arm_v1_arm_proto_rawDescData
 }
 
-var file_component_arm_v1_arm_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_component_arm_v1_arm_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_component_arm_v1_arm_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_component_arm_v1_arm_proto_goTypes = []interface{}{
-	(*GetEndPositionRequest)(nil),        // 0: viam.component.arm.v1.GetEndPositionRequest
-	(*GetEndPositionResponse)(nil),       // 1: viam.component.arm.v1.GetEndPositionResponse
-	(*JointPositions)(nil),               // 2: viam.component.arm.v1.JointPositions
-	(*GetJointPositionsRequest)(nil),     // 3: viam.component.arm.v1.GetJointPositionsRequest
-	(*GetJointPositionsResponse)(nil),    // 4: viam.component.arm.v1.GetJointPositionsResponse
-	(*MoveToPositionRequest)(nil),        // 5: viam.component.arm.v1.MoveToPositionRequest
-	(*MoveToPositionResponse)(nil),       // 6: viam.component.arm.v1.MoveToPositionResponse
-	(*MoveToJointPositionsRequest)(nil),  // 7: viam.component.arm.v1.MoveToJointPositionsRequest
-	(*MoveToJointPositionsResponse)(nil), // 8: viam.component.arm.v1.MoveToJointPositionsResponse
-	(*StopRequest)(nil),                  // 9: viam.component.arm.v1.StopRequest
-	(*StopResponse)(nil),                 // 10: viam.component.arm.v1.StopResponse
-	(*Status)(nil),                       // 11: viam.component.arm.v1.Status
-	(*IsMovingRequest)(nil),              // 12: viam.component.arm.v1.IsMovingRequest
-	(*IsMovingResponse)(nil),             // 13: viam.component.arm.v1.IsMovingResponse
-	(*structpb.Struct)(nil),              // 14: google.protobuf.Struct
-	(*v1.Pose)(nil),                      // 15: viam.common.v1.Pose
-	(*v1.DoCommandRequest)(nil),          // 16: viam.common.v1.DoCommandRequest
-	(*v1.GetKinematicsRequest)(nil),      // 17: viam.common.v1.GetKinematicsRequest
-	(*v1.GetGeometriesRequest)(nil),      // 18: viam.common.v1.GetGeometriesRequest
-	(*v1.DoCommandResponse)(nil),         // 19: viam.common.v1.DoCommandResponse
-	(*v1.GetKinematicsResponse)(nil),     // 20: viam.common.v1.GetKinematicsResponse
-	(*v1.GetGeometriesResponse)(nil),     // 21: viam.common.v1.GetGeometriesResponse
+	(JointType)(0),                            // 0: viam.component.arm.v1.JointType
+	(*GetEndPositionRequest)(nil),             // 1: viam.component.arm.v1.GetEndPositionRequest
+	(*GetEndPositionResponse)(nil),            // 2: viam.component.arm.v1.GetEndPositionResponse
+	(*JointPositions)(nil),                    // 3: viam.component.arm.v1.JointPositions
+	(*GetJointPositionsRequest)(nil),          // 4: viam.component.arm.v1.GetJointPositionsRequest
+	(*GetJointPositionsResponse)(nil),         // 5: viam.component.arm.v1.GetJointPositionsResponse
+	(*GetJointPropertiesRequest)(nil),         // 6: viam.component.arm.v1.GetJointPropertiesRequest
+	(*GetJointPropertiesResponse)(nil),        // 7: viam.component.arm.v1.GetJointPropertiesResponse
+	(*JointProperties)(nil),                   // 8: viam.component.arm.v1.JointProperties
+	(*MoveToPositionRequest)(nil),             // 9: viam.component.arm.v1.MoveToPositionRequest
+	(*MoveToPositionResponse)(nil),            // 10: viam.component.arm.v1.MoveToPositionResponse
+	(*MoveToJointPositionsRequest)(nil),       // 11: viam.component.arm.v1.MoveToJointPositionsRequest
+	(*MoveToJointPositionsResponse)(nil),      // 12: viam.component.arm.v1.MoveToJointPositionsResponse
+	(*MoveThroughJointPositionsRequest)(nil),  // 13: viam.component.arm.v1.MoveThroughJointPositionsRequest
+	(*MoveThroughJointPositionsResponse)(nil), // 14: viam.component.arm.v1.MoveThroughJointPositionsResponse
+	(*MoveOptions)(nil),                       // 15: viam.component.arm.v1.MoveOptions
+	(*StopRequest)(nil),                       // 16: viam.component.arm.v1.StopRequest
+	(*StopResponse)(nil),                      // 17: viam.component.arm.v1.StopResponse
+	(*Status)(nil),                            // 18: viam.component.arm.v1.Status
+	(*IsMovingRequest)(nil),                   // 19: viam.component.arm.v1.IsMovingRequest
+	(*IsMovingResponse)(nil),                  // 20: viam.component.arm.v1.IsMovingResponse
+	(*structpb.Struct)(nil),                   // 21: google.protobuf.Struct
+	(*v1.Pose)(nil),                           // 22: viam.common.v1.Pose
+	(*v1.DoCommandRequest)(nil),               // 23: viam.common.v1.DoCommandRequest
+	(*v1.GetKinematicsRequest)(nil),           // 24: viam.common.v1.GetKinematicsRequest
+	(*v1.GetGeometriesRequest)(nil),           // 25: viam.common.v1.GetGeometriesRequest
+	(*v1.DoCommandResponse)(nil),              // 26: viam.common.v1.DoCommandResponse
+	(*v1.GetKinematicsResponse)(nil),          // 27: viam.common.v1.GetKinematicsResponse
+	(*v1.GetGeometriesResponse)(nil),          // 28: viam.common.v1.GetGeometriesResponse
 }
 var file_component_arm_v1_arm_proto_depIdxs = []int32{
-	14, // 0: viam.component.arm.v1.GetEndPositionRequest.extra:type_name -> google.protobuf.Struct
-	15, // 1: viam.component.arm.v1.GetEndPositionResponse.pose:type_name -> viam.common.v1.Pose
-	14, // 2: viam.component.arm.v1.GetJointPositionsRequest.extra:type_name -> google.protobuf.Struct
-	2,  // 3: viam.component.arm.v1.GetJointPositionsResponse.positions:type_name -> viam.component.arm.v1.JointPositions
-	15, // 4: viam.component.arm.v1.MoveToPositionRequest.to:type_name -> viam.common.v1.Pose
-	14, // 5: viam.component.arm.v1.MoveToPositionRequest.extra:type_name -> google.protobuf.Struct
-	2,  // 6: viam.component.arm.v1.MoveToJointPositionsRequest.positions:type_name -> viam.component.arm.v1.JointPositions
-	14, // 7: viam.component.arm.v1.MoveToJointPositionsRequest.extra:type_name -> google.protobuf.Struct
-	14, // 8: viam.component.arm.v1.StopRequest.extra:type_name -> google.protobuf.Struct
-	15, // 9: viam.component.arm.v1.Status.end_position:type_name -> viam.common.v1.Pose
-	2,  // 10: viam.component.arm.v1.Status.joint_positions:type_name -> viam.component.arm.v1.JointPositions
-	0,  // 11: viam.component.arm.v1.ArmService.GetEndPosition:input_type -> viam.component.arm.v1.GetEndPositionRequest
-	5,  // 12: viam.component.arm.v1.ArmService.MoveToPosition:input_type -> viam.component.arm.v1.MoveToPositionRequest
-	3,  // 13: viam.component.arm.v1.ArmService.GetJointPositions:input_type -> viam.component.arm.v1.GetJointPositionsRequest
-	7,  // 14: viam.component.arm.v1.ArmService.MoveToJointPositions:input_type -> viam.component.arm.v1.MoveToJointPositionsRequest
-	9,  // 15: viam.component.arm.v1.ArmService.Stop:input_type -> viam.component.arm.v1.StopRequest
-	12, // 16: viam.component.arm.v1.ArmService.IsMoving:input_type -> viam.component.arm.v1.IsMovingRequest
-	16, // 17: viam.component.arm.v1.ArmService.DoCommand:input_type -> viam.common.v1.DoCommandRequest
-	17, // 18: viam.component.arm.v1.ArmService.GetKinematics:input_type -> viam.common.v1.GetKinematicsRequest
-	18, // 19: viam.component.arm.v1.ArmService.GetGeometries:input_type -> viam.common.v1.GetGeometriesRequest
-	1,  // 20: viam.component.arm.v1.ArmService.GetEndPosition:output_type -> viam.component.arm.v1.GetEndPositionResponse
-	6,  // 21: viam.component.arm.v1.ArmService.MoveToPosition:output_type -> viam.component.arm.v1.MoveToPositionResponse
-	4,  // 22: viam.component.arm.v1.ArmService.GetJointPositions:output_type -> viam.component.arm.v1.GetJointPositionsResponse
-	8,  // 23: viam.component.arm.v1.ArmService.MoveToJointPositions:output_type -> viam.component.arm.v1.MoveToJointPositionsResponse
-	10, // 24: viam.component.arm.v1.ArmService.Stop:output_type -> viam.component.arm.v1.StopResponse
-	13, // 25: viam.component.arm.v1.ArmService.IsMoving:output_type -> viam.component.arm.v1.IsMovingResponse
-	19, // 26: viam.component.arm.v1.ArmService.DoCommand:output_type -> viam.common.v1.DoCommandResponse
-	20, // 27: viam.component.arm.v1.ArmService.GetKinematics:output_type -> viam.common.v1.GetKinematicsResponse
-	21, // 28: viam.component.arm.v1.ArmService.GetGeometries:output_type -> viam.common.v1.GetGeometriesResponse
-	20, // [20:29] is the sub-list for method output_type
-	11, // [11:20] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	21, // 0: viam.component.arm.v1.GetEndPositionRequest.extra:type_name -> google.protobuf.Struct
+	22, // 1: viam.component.arm.v1.GetEndPositionResponse.pose:type_name -> viam.common.v1.Pose
+	21, // 2: viam.component.arm.v1.GetJointPositionsRequest.extra:type_name -> google.protobuf.Struct
+	3,  // 3: viam.component.arm.v1.GetJointPositionsResponse.positions:type_name -> viam.component.arm.v1.JointPositions
+	21, // 4: viam.component.arm.v1.GetJointPropertiesRequest.extra:type_name -> google.protobuf.Struct
+	8,  // 5: viam.component.arm.v1.GetJointPropertiesResponse.joints:type_name -> viam.component.arm.v1.JointProperties
+	0,  // 6: viam.component.arm.v1.JointProperties.type:type_name -> viam.component.arm.v1.JointType
+	22, // 7: viam.component.arm.v1.MoveToPositionRequest.to:type_name -> viam.common.v1.Pose
+	21, // 8: viam.component.arm.v1.MoveToPositionRequest.extra:type_name -> google.protobuf.Struct
+	3,  // 9: viam.component.arm.v1.MoveToJointPositionsRequest.positions:type_name -> viam.component.arm.v1.JointPositions
+	21, // 10: viam.component.arm.v1.MoveToJointPositionsRequest.extra:type_name -> google.protobuf.Struct
+	3,  // 11: viam.component.arm.v1.MoveThroughJointPositionsRequest.positions:type_name -> viam.component.arm.v1.JointPositions
+	15, // 12: viam.component.arm.v1.MoveThroughJointPositionsRequest.options:type_name -> viam.component.arm.v1.MoveOptions
+	15, // 13: viam.component.arm.v1.MoveThroughJointPositionsRequest.waypoint_options:type_name -> viam.component.arm.v1.MoveOptions
+	21, // 14: viam.component.arm.v1.MoveThroughJointPositionsRequest.extra:type_name -> google.protobuf.Struct
+	21, // 15: viam.component.arm.v1.StopRequest.extra:type_name -> google.protobuf.Struct
+	22, // 16: viam.component.arm.v1.Status.end_position:type_name -> viam.common.v1.Pose
+	3,  // 17: viam.component.arm.v1.Status.joint_positions:type_name -> viam.component.arm.v1.JointPositions
+	1,  // 18: viam.component.arm.v1.ArmService.GetEndPosition:input_type -> viam.component.arm.v1.GetEndPositionRequest
+	9,  // 19: viam.component.arm.v1.ArmService.MoveToPosition:input_type -> viam.component.arm.v1.MoveToPositionRequest
+	4,  // 20: viam.component.arm.v1.ArmService.GetJointPositions:input_type -> viam.component.arm.v1.GetJointPositionsRequest
+	6,  // 21: viam.component.arm.v1.ArmService.GetJointProperties:input_type -> viam.component.arm.v1.GetJointPropertiesRequest
+	11, // 22: viam.component.arm.v1.ArmService.MoveToJointPositions:input_type -> viam.component.arm.v1.MoveToJointPositionsRequest
+	13, // 23: viam.component.arm.v1.ArmService.MoveThroughJointPositions:input_type -> viam.component.arm.v1.MoveThroughJointPositionsRequest
+	16, // 24: viam.component.arm.v1.ArmService.Stop:input_type -> viam.component.arm.v1.StopRequest
+	19, // 25: viam.component.arm.v1.ArmService.IsMoving:input_type -> viam.component.arm.v1.IsMovingRequest
+	23, // 26: viam.component.arm.v1.ArmService.DoCommand:input_type -> viam.common.v1.DoCommandRequest
+	24, // 27: viam.component.arm.v1.ArmService.GetKinematics:input_type -> viam.common.v1.GetKinematicsRequest
+	25, // 28: viam.component.arm.v1.ArmService.GetGeometries:input_type -> viam.common.v1.GetGeometriesRequest
+	2,  // 29: viam.component.arm.v1.ArmService.GetEndPosition:output_type -> viam.component.arm.v1.GetEndPositionResponse
+	10, // 30: viam.component.arm.v1.ArmService.MoveToPosition:output_type -> viam.component.arm.v1.MoveToPositionResponse
+	5,  // 31: viam.component.arm.v1.ArmService.GetJointPositions:output_type -> viam.component.arm.v1.GetJointPositionsResponse
+	7,  // 32: viam.component.arm.v1.ArmService.GetJointProperties:output_type -> viam.component.arm.v1.GetJointPropertiesResponse
+	12, // 33: viam.component.arm.v1.ArmService.MoveToJointPositions:output_type -> viam.component.arm.v1.MoveToJointPositionsResponse
+	14, // 34: viam.component.arm.v1.ArmService.MoveThroughJointPositions:output_type -> viam.component.arm.v1.MoveThroughJointPositionsResponse
+	17, // 35: viam.component.arm.v1.ArmService.Stop:output_type -> viam.component.arm.v1.StopResponse
+	20, // 36: viam.component.arm.v1.ArmService.IsMoving:output_type -> viam.component.arm.v1.IsMovingResponse
+	26, // 37: viam.component.arm.v1.ArmService.DoCommand:output_type -> viam.common.v1.DoCommandResponse
+	27, // 38: viam.component.arm.v1.ArmService.GetKinematics:output_type -> viam.common.v1.GetKinematicsResponse
+	28, // 39: viam.component.arm.v1.ArmService.GetGeometries:output_type -> viam.common.v1.GetGeometriesResponse
+	29, // [29:40] is the sub-list for method output_type
+	18, // [18:29] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_component_arm_v1_arm_proto_init() }
@@ -1066,7 +1619,7 @@ func file_component_arm_v1_arm_proto_init() {
 			}
 		}
 		file_component_arm_v1_arm_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MoveToPositionRequest); i {
+			switch v := v.(*GetJointPropertiesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1078,7 +1631,7 @@ func file_component_arm_v1_arm_proto_init() {
 			}
 		}
 		file_component_arm_v1_arm_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MoveToPositionResponse); i {
+			switch v := v.(*GetJointPropertiesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1090,7 +1643,7 @@ func file_component_arm_v1_arm_proto_init() {
 			}
 		}
 		file_component_arm_v1_arm_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MoveToJointPositionsRequest); i {
+			switch v := v.(*JointProperties); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1102,7 +1655,7 @@ func file_component_arm_v1_arm_proto_init() {
 			}
 		}
 		file_component_arm_v1_arm_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MoveToJointPositionsResponse); i {
+			switch v := v.(*MoveToPositionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1114,7 +1667,7 @@ func file_component_arm_v1_arm_proto_init() {
 			}
 		}
 		file_component_arm_v1_arm_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopRequest); i {
+			switch v := v.(*MoveToPositionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1126,7 +1679,7 @@ func file_component_arm_v1_arm_proto_init() {
 			}
 		}
 		file_component_arm_v1_arm_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopResponse); i {
+			switch v := v.(*MoveToJointPositionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1138,7 +1691,7 @@ func file_component_arm_v1_arm_proto_init() {
 			}
 		}
 		file_component_arm_v1_arm_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Status); i {
+			switch v := v.(*MoveToJointPositionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1150,7 +1703,7 @@ func file_component_arm_v1_arm_proto_init() {
 			}
 		}
 		file_component_arm_v1_arm_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsMovingRequest); i {
+			switch v := v.(*MoveThroughJointPositionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1162,6 +1715,78 @@ func file_component_arm_v1_arm_proto_init() {
 			}
 		}
 		file_component_arm_v1_arm_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveThroughJointPositionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_arm_v1_arm_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_arm_v1_arm_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_arm_v1_arm_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_arm_v1_arm_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Status); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_arm_v1_arm_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsMovingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_arm_v1_arm_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IsMovingResponse); i {
 			case 0:
 				return &v.state
@@ -1174,18 +1799,22 @@ func file_component_arm_v1_arm_proto_init() {
 			}
 		}
 	}
+	file_component_arm_v1_arm_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_component_arm_v1_arm_proto_msgTypes[12].OneofWrappers = []interface{}{}
+	file_component_arm_v1_arm_proto_msgTypes[14].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_component_arm_v1_arm_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   14,
+			NumEnums:      1,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_component_arm_v1_arm_proto_goTypes,
 		DependencyIndexes: file_component_arm_v1_arm_proto_depIdxs,
+		EnumInfos:         file_component_arm_v1_arm_proto_enumTypes,
 		MessageInfos:      file_component_arm_v1_arm_proto_msgTypes,
 	}.Build()
 	File_component_arm_v1_arm_proto = out.File
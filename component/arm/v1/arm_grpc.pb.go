@@ -30,9 +30,15 @@ type ArmServiceClient interface {
 	MoveToPosition(ctx context.Context, in *MoveToPositionRequest, opts ...grpc.CallOption) (*MoveToPositionResponse, error)
 	// GetJointPositions lists the joint positions (in degrees) of every joint on a robot
 	GetJointPositions(ctx context.Context, in *GetJointPositionsRequest, opts ...grpc.CallOption) (*GetJointPositionsResponse, error)
+	// GetJointProperties lists the limits and type of every joint on a robot's arm
+	GetJointProperties(ctx context.Context, in *GetJointPropertiesRequest, opts ...grpc.CallOption) (*GetJointPropertiesResponse, error)
 	// MoveToJointPositions moves every joint on a robot's arm to specified angles which are expressed in degrees
 	// This will block until done or a new operation cancels this one
 	MoveToJointPositions(ctx context.Context, in *MoveToJointPositionsRequest, opts ...grpc.CallOption) (*MoveToJointPositionsResponse, error)
+	// MoveThroughJointPositions moves every joint on a robot's arm through the specified list of joint positions
+	// as one continuous motion, without stopping at intermediate waypoints.
+	// This will block until done or a new operation cancels this one
+	MoveThroughJointPositions(ctx context.Context, in *MoveThroughJointPositionsRequest, opts ...grpc.CallOption) (*MoveThroughJointPositionsResponse, error)
 	// Stop stops a robot's arm
 	Stop(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error)
 	// IsMoving reports if a component is in motion
@@ -80,6 +86,15 @@ func (c *armServiceClient) GetJointPositions(ctx context.Context, in *GetJointPo
 	return out, nil
 }
 
+func (c *armServiceClient) GetJointProperties(ctx context.Context, in *GetJointPropertiesRequest, opts ...grpc.CallOption) (*GetJointPropertiesResponse, error) {
+	out := new(GetJointPropertiesResponse)
+	err := c.cc.Invoke(ctx, "/viam.component.arm.v1.ArmService/GetJointProperties", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *armServiceClient) MoveToJointPositions(ctx context.Context, in *MoveToJointPositionsRequest, opts ...grpc.CallOption) (*MoveToJointPositionsResponse, error) {
 	out := new(MoveToJointPositionsResponse)
 	err := c.cc.Invoke(ctx, "/viam.component.arm.v1.ArmService/MoveToJointPositions", in, out, opts...)
@@ -89,6 +104,15 @@ func (c *armServiceClient) MoveToJointPositions(ctx context.Context, in *MoveToJ
 	return out, nil
 }
 
+func (c *armServiceClient) MoveThroughJointPositions(ctx context.Context, in *MoveThroughJointPositionsRequest, opts ...grpc.CallOption) (*MoveThroughJointPositionsResponse, error) {
+	out := new(MoveThroughJointPositionsResponse)
+	err := c.cc.Invoke(ctx, "/viam.component.arm.v1.ArmService/MoveThroughJointPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *armServiceClient) Stop(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error) {
 	out := new(StopResponse)
 	err := c.cc.Invoke(ctx, "/viam.component.arm.v1.ArmService/Stop", in, out, opts...)
@@ -145,9 +169,15 @@ type ArmServiceServer interface {
 	MoveToPosition(context.Context, *MoveToPositionRequest) (*MoveToPositionResponse, error)
 	// GetJointPositions lists the joint positions (in degrees) of every joint on a robot
 	GetJointPositions(context.Context, *GetJointPositionsRequest) (*GetJointPositionsResponse, error)
+	// GetJointProperties lists the limits and type of every joint on a robot's arm
+	GetJointProperties(context.Context, *GetJointPropertiesRequest) (*GetJointPropertiesResponse, error)
 	// MoveToJointPositions moves every joint on a robot's arm to specified angles which are expressed in degrees
 	// This will block until done or a new operation cancels this one
 	MoveToJointPositions(context.Context, *MoveToJointPositionsRequest) (*MoveToJointPositionsResponse, error)
+	// MoveThroughJointPositions moves every joint on a robot's arm through the specified list of joint positions
+	// as one continuous motion, without stopping at intermediate waypoints.
+	// This will block until done or a new operation cancels this one
+	MoveThroughJointPositions(context.Context, *MoveThroughJointPositionsRequest) (*MoveThroughJointPositionsResponse, error)
 	// Stop stops a robot's arm
 	Stop(context.Context, *StopRequest) (*StopResponse, error)
 	// IsMoving reports if a component is in motion
@@ -174,9 +204,15 @@ func (UnimplementedArmServiceServer) MoveToPosition(context.Context, *MoveToPosi
 func (UnimplementedArmServiceServer) GetJointPositions(context.Context, *GetJointPositionsRequest) (*GetJointPositionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetJointPositions not implemented")
 }
+func (UnimplementedArmServiceServer) GetJointProperties(context.Context, *GetJointPropertiesRequest) (*GetJointPropertiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJointProperties not implemented")
+}
 func (UnimplementedArmServiceServer) MoveToJointPositions(context.Context, *MoveToJointPositionsRequest) (*MoveToJointPositionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MoveToJointPositions not implemented")
 }
+func (UnimplementedArmServiceServer) MoveThroughJointPositions(context.Context, *MoveThroughJointPositionsRequest) (*MoveThroughJointPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveThroughJointPositions not implemented")
+}
 func (UnimplementedArmServiceServer) Stop(context.Context, *StopRequest) (*StopResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Stop not implemented")
 }
@@ -259,6 +295,24 @@ func _ArmService_GetJointPositions_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArmService_GetJointProperties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJointPropertiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArmServiceServer).GetJointProperties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/viam.component.arm.v1.ArmService/GetJointProperties",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArmServiceServer).GetJointProperties(ctx, req.(*GetJointPropertiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArmService_MoveToJointPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MoveToJointPositionsRequest)
 	if err := dec(in); err != nil {
@@ -277,6 +331,24 @@ func _ArmService_MoveToJointPositions_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArmService_MoveThroughJointPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveThroughJointPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArmServiceServer).MoveThroughJointPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/viam.component.arm.v1.ArmService/MoveThroughJointPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArmServiceServer).MoveThroughJointPositions(ctx, req.(*MoveThroughJointPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArmService_Stop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StopRequest)
 	if err := dec(in); err != nil {
@@ -386,10 +458,18 @@ var ArmService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetJointPositions",
 			Handler:    _ArmService_GetJointPositions_Handler,
 		},
+		{
+			MethodName: "GetJointProperties",
+			Handler:    _ArmService_GetJointProperties_Handler,
+		},
 		{
 			MethodName: "MoveToJointPositions",
 			Handler:    _ArmService_MoveToJointPositions_Handler,
 		},
+		{
+			MethodName: "MoveThroughJointPositions",
+			Handler:    _ArmService_MoveThroughJointPositions_Handler,
+		},
 		{
 			MethodName: "Stop",
 			Handler:    _ArmService_Stop_Handler,
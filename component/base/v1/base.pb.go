@@ -12,6 +12,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -249,6 +250,365 @@ func (*SpinResponse) Descriptor() ([]byte, []int) {
 	return file_component_base_v1_base_proto_rawDescGZIP(), []int{3}
 }
 
+type MoveArcRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of a base
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Radius of the arc in millimeters. Positive values turn left, negative values turn right
+	// The magnitude should not be smaller than turning_radius_meters * 1000, where turning_radius_meters is reported by
+	// GetProperties
+	RadiusMm float64 `protobuf:"fixed64,2,opt,name=radius_mm,json=radiusMm,proto3" json:"radius_mm,omitempty"`
+	// How far to travel along the arc. Must not be negative; the direction of travel is set by the sign of mm_per_sec
+	//
+	// Types that are assignable to Extent:
+	//
+	//	*MoveArcRequest_ArcLengthMm
+	//	*MoveArcRequest_AngleDeg
+	Extent isMoveArcRequest_Extent `protobuf_oneof:"extent"`
+	// Desired travel velocity in millimeters/second. Negative values drive backwards
+	MmPerSec float64 `protobuf:"fixed64,5,opt,name=mm_per_sec,json=mmPerSec,proto3" json:"mm_per_sec,omitempty"`
+	// Additional arguments to the method
+	Extra *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
+}
+
+func (x *MoveArcRequest) Reset() {
+	*x = MoveArcRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_base_v1_base_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveArcRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveArcRequest) ProtoMessage() {}
+
+func (x *MoveArcRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_base_v1_base_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveArcRequest.ProtoReflect.Descriptor instead.
+func (*MoveArcRequest) Descriptor() ([]byte, []int) {
+	return file_component_base_v1_base_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MoveArcRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MoveArcRequest) GetRadiusMm() float64 {
+	if x != nil {
+		return x.RadiusMm
+	}
+	return 0
+}
+
+func (m *MoveArcRequest) GetExtent() isMoveArcRequest_Extent {
+	if m != nil {
+		return m.Extent
+	}
+	return nil
+}
+
+func (x *MoveArcRequest) GetArcLengthMm() float64 {
+	if x, ok := x.GetExtent().(*MoveArcRequest_ArcLengthMm); ok {
+		return x.ArcLengthMm
+	}
+	return 0
+}
+
+func (x *MoveArcRequest) GetAngleDeg() float64 {
+	if x, ok := x.GetExtent().(*MoveArcRequest_AngleDeg); ok {
+		return x.AngleDeg
+	}
+	return 0
+}
+
+func (x *MoveArcRequest) GetMmPerSec() float64 {
+	if x != nil {
+		return x.MmPerSec
+	}
+	return 0
+}
+
+func (x *MoveArcRequest) GetExtra() *structpb.Struct {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+type isMoveArcRequest_Extent interface {
+	isMoveArcRequest_Extent()
+}
+
+type MoveArcRequest_ArcLengthMm struct {
+	// Desired travel distance along the arc in millimeters
+	ArcLengthMm float64 `protobuf:"fixed64,3,opt,name=arc_length_mm,json=arcLengthMm,proto3,oneof"`
+}
+
+type MoveArcRequest_AngleDeg struct {
+	// Desired change in heading in degrees, as an unsigned magnitude
+	AngleDeg float64 `protobuf:"fixed64,4,opt,name=angle_deg,json=angleDeg,proto3,oneof"`
+}
+
+func (*MoveArcRequest_ArcLengthMm) isMoveArcRequest_Extent() {}
+
+func (*MoveArcRequest_AngleDeg) isMoveArcRequest_Extent() {}
+
+type MoveArcResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MoveArcResponse) Reset() {
+	*x = MoveArcResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_base_v1_base_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveArcResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveArcResponse) ProtoMessage() {}
+
+func (x *MoveArcResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_base_v1_base_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveArcResponse.ProtoReflect.Descriptor instead.
+func (*MoveArcResponse) Descriptor() ([]byte, []int) {
+	return file_component_base_v1_base_proto_rawDescGZIP(), []int{5}
+}
+
+// PathPoint is a 2D pose on the ground plane, expressed in the frame of the base when FollowPath was called
+type PathPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Millimeters forward of the base
+	XMm float64 `protobuf:"fixed64,1,opt,name=x_mm,json=xMm,proto3" json:"x_mm,omitempty"`
+	// Millimeters to the left of the base
+	YMm float64 `protobuf:"fixed64,2,opt,name=y_mm,json=yMm,proto3" json:"y_mm,omitempty"`
+	// Desired heading at this point, in degrees counterclockwise from forward. If unset, the heading is unconstrained
+	ThetaDeg *float64 `protobuf:"fixed64,3,opt,name=theta_deg,json=thetaDeg,proto3,oneof" json:"theta_deg,omitempty"`
+	// Desired travel velocity in millimeters/second when reaching this point. If unset, the request's mm_per_sec is used
+	MmPerSec *float64 `protobuf:"fixed64,4,opt,name=mm_per_sec,json=mmPerSec,proto3,oneof" json:"mm_per_sec,omitempty"`
+}
+
+func (x *PathPoint) Reset() {
+	*x = PathPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_base_v1_base_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PathPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PathPoint) ProtoMessage() {}
+
+func (x *PathPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_component_base_v1_base_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PathPoint.ProtoReflect.Descriptor instead.
+func (*PathPoint) Descriptor() ([]byte, []int) {
+	return file_component_base_v1_base_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PathPoint) GetXMm() float64 {
+	if x != nil {
+		return x.XMm
+	}
+	return 0
+}
+
+func (x *PathPoint) GetYMm() float64 {
+	if x != nil {
+		return x.YMm
+	}
+	return 0
+}
+
+func (x *PathPoint) GetThetaDeg() float64 {
+	if x != nil && x.ThetaDeg != nil {
+		return *x.ThetaDeg
+	}
+	return 0
+}
+
+func (x *PathPoint) GetMmPerSec() float64 {
+	if x != nil && x.MmPerSec != nil {
+		return *x.MmPerSec
+	}
+	return 0
+}
+
+type FollowPathRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of a base
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Ordered list of points to travel through; the last point is the final destination
+	Path []*PathPoint `protobuf:"bytes,2,rep,name=path,proto3" json:"path,omitempty"`
+	// Default travel velocity in millimeters/second for points without their own velocity
+	MmPerSec float64 `protobuf:"fixed64,3,opt,name=mm_per_sec,json=mmPerSec,proto3" json:"mm_per_sec,omitempty"`
+	// Maximum linear acceleration in millimeters/second^2 when changing velocity. If unset, the base's default is used
+	MaxAccMmPerSec2 *float64 `protobuf:"fixed64,4,opt,name=max_acc_mm_per_sec2,json=maxAccMmPerSec2,proto3,oneof" json:"max_acc_mm_per_sec2,omitempty"`
+	// Additional arguments to the method
+	Extra *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
+}
+
+func (x *FollowPathRequest) Reset() {
+	*x = FollowPathRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_base_v1_base_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FollowPathRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowPathRequest) ProtoMessage() {}
+
+func (x *FollowPathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_base_v1_base_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowPathRequest.ProtoReflect.Descriptor instead.
+func (*FollowPathRequest) Descriptor() ([]byte, []int) {
+	return file_component_base_v1_base_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FollowPathRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FollowPathRequest) GetPath() []*PathPoint {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *FollowPathRequest) GetMmPerSec() float64 {
+	if x != nil {
+		return x.MmPerSec
+	}
+	return 0
+}
+
+func (x *FollowPathRequest) GetMaxAccMmPerSec2() float64 {
+	if x != nil && x.MaxAccMmPerSec2 != nil {
+		return *x.MaxAccMmPerSec2
+	}
+	return 0
+}
+
+func (x *FollowPathRequest) GetExtra() *structpb.Struct {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+type FollowPathResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FollowPathResponse) Reset() {
+	*x = FollowPathResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_base_v1_base_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FollowPathResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowPathResponse) ProtoMessage() {}
+
+func (x *FollowPathResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_base_v1_base_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowPathResponse.ProtoReflect.Descriptor instead.
+func (*FollowPathResponse) Descriptor() ([]byte, []int) {
+	return file_component_base_v1_base_proto_rawDescGZIP(), []int{8}
+}
+
 type StopRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -263,7 +623,7 @@ type StopRequest struct {
 func (x *StopRequest) Reset() {
 	*x = StopRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_base_v1_base_proto_msgTypes[4]
+		mi := &file_component_base_v1_base_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -276,7 +636,7 @@ func (x *StopRequest) String() string {
 func (*StopRequest) ProtoMessage() {}
 
 func (x *StopRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_component_base_v1_base_proto_msgTypes[4]
+	mi := &file_component_base_v1_base_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -289,7 +649,7 @@ func (x *StopRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopRequest.ProtoReflect.Descriptor instead.
 func (*StopRequest) Descriptor() ([]byte, []int) {
-	return file_component_base_v1_base_proto_rawDescGZIP(), []int{4}
+	return file_component_base_v1_base_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *StopRequest) GetName() string {
@@ -315,7 +675,7 @@ type StopResponse struct {
 func (x *StopResponse) Reset() {
 	*x = StopResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_base_v1_base_proto_msgTypes[5]
+		mi := &file_component_base_v1_base_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -328,7 +688,7 @@ func (x *StopResponse) String() string {
 func (*StopResponse) ProtoMessage() {}
 
 func (x *StopResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_component_base_v1_base_proto_msgTypes[5]
+	mi := &file_component_base_v1_base_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -341,7 +701,7 @@ func (x *StopResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopResponse.ProtoReflect.Descriptor instead.
 func (*StopResponse) Descriptor() ([]byte, []int) {
-	return file_component_base_v1_base_proto_rawDescGZIP(), []int{5}
+	return file_component_base_v1_base_proto_rawDescGZIP(), []int{10}
 }
 
 type SetPowerRequest struct {
@@ -351,31 +711,253 @@ type SetPowerRequest struct {
 
 	// Name of a base
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Desired linear power percentage as -1 -> 1
-	Linear *v1.Vector3 `protobuf:"bytes,2,opt,name=linear,proto3" json:"linear,omitempty"`
-	// Desired angular power percentage % as -1 -> 1
-	Angular *v1.Vector3 `protobuf:"bytes,3,opt,name=angular,proto3" json:"angular,omitempty"`
+	// Desired linear power percentage as -1 -> 1
+	Linear *v1.Vector3 `protobuf:"bytes,2,opt,name=linear,proto3" json:"linear,omitempty"`
+	// Desired angular power percentage % as -1 -> 1
+	Angular *v1.Vector3 `protobuf:"bytes,3,opt,name=angular,proto3" json:"angular,omitempty"`
+	// Additional arguments to the method
+	Extra *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
+}
+
+func (x *SetPowerRequest) Reset() {
+	*x = SetPowerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_base_v1_base_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPowerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPowerRequest) ProtoMessage() {}
+
+func (x *SetPowerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_base_v1_base_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPowerRequest.ProtoReflect.Descriptor instead.
+func (*SetPowerRequest) Descriptor() ([]byte, []int) {
+	return file_component_base_v1_base_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetPowerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetPowerRequest) GetLinear() *v1.Vector3 {
+	if x != nil {
+		return x.Linear
+	}
+	return nil
+}
+
+func (x *SetPowerRequest) GetAngular() *v1.Vector3 {
+	if x != nil {
+		return x.Angular
+	}
+	return nil
+}
+
+func (x *SetPowerRequest) GetExtra() *structpb.Struct {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+type SetPowerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetPowerResponse) Reset() {
+	*x = SetPowerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_base_v1_base_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPowerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPowerResponse) ProtoMessage() {}
+
+func (x *SetPowerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_base_v1_base_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPowerResponse.ProtoReflect.Descriptor instead.
+func (*SetPowerResponse) Descriptor() ([]byte, []int) {
+	return file_component_base_v1_base_proto_rawDescGZIP(), []int{12}
+}
+
+type SetVelocityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of a base
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Desired linear velocity in mm per second
+	Linear *v1.Vector3 `protobuf:"bytes,2,opt,name=linear,proto3" json:"linear,omitempty"`
+	// Desired angular velocity in degrees per second
+	Angular *v1.Vector3 `protobuf:"bytes,3,opt,name=angular,proto3" json:"angular,omitempty"`
+	// Additional arguments to the method
+	Extra *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
+}
+
+func (x *SetVelocityRequest) Reset() {
+	*x = SetVelocityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_base_v1_base_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetVelocityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVelocityRequest) ProtoMessage() {}
+
+func (x *SetVelocityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_base_v1_base_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVelocityRequest.ProtoReflect.Descriptor instead.
+func (*SetVelocityRequest) Descriptor() ([]byte, []int) {
+	return file_component_base_v1_base_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SetVelocityRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetVelocityRequest) GetLinear() *v1.Vector3 {
+	if x != nil {
+		return x.Linear
+	}
+	return nil
+}
+
+func (x *SetVelocityRequest) GetAngular() *v1.Vector3 {
+	if x != nil {
+		return x.Angular
+	}
+	return nil
+}
+
+func (x *SetVelocityRequest) GetExtra() *structpb.Struct {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+type SetVelocityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetVelocityResponse) Reset() {
+	*x = SetVelocityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_base_v1_base_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetVelocityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVelocityResponse) ProtoMessage() {}
+
+func (x *SetVelocityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_base_v1_base_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVelocityResponse.ProtoReflect.Descriptor instead.
+func (*SetVelocityResponse) Descriptor() ([]byte, []int) {
+	return file_component_base_v1_base_proto_rawDescGZIP(), []int{14}
+}
+
+type GetOdometryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of a base
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// Additional arguments to the method
 	Extra *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
 }
 
-func (x *SetPowerRequest) Reset() {
-	*x = SetPowerRequest{}
+func (x *GetOdometryRequest) Reset() {
+	*x = GetOdometryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_base_v1_base_proto_msgTypes[6]
+		mi := &file_component_base_v1_base_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetPowerRequest) String() string {
+func (x *GetOdometryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetPowerRequest) ProtoMessage() {}
+func (*GetOdometryRequest) ProtoMessage() {}
 
-func (x *SetPowerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_component_base_v1_base_proto_msgTypes[6]
+func (x *GetOdometryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_base_v1_base_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -386,62 +968,59 @@ func (x *SetPowerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetPowerRequest.ProtoReflect.Descriptor instead.
-func (*SetPowerRequest) Descriptor() ([]byte, []int) {
-	return file_component_base_v1_base_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use GetOdometryRequest.ProtoReflect.Descriptor instead.
+func (*GetOdometryRequest) Descriptor() ([]byte, []int) {
+	return file_component_base_v1_base_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *SetPowerRequest) GetName() string {
+func (x *GetOdometryRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *SetPowerRequest) GetLinear() *v1.Vector3 {
-	if x != nil {
-		return x.Linear
-	}
-	return nil
-}
-
-func (x *SetPowerRequest) GetAngular() *v1.Vector3 {
-	if x != nil {
-		return x.Angular
-	}
-	return nil
-}
-
-func (x *SetPowerRequest) GetExtra() *structpb.Struct {
+func (x *GetOdometryRequest) GetExtra() *structpb.Struct {
 	if x != nil {
 		return x.Extra
 	}
 	return nil
 }
 
-type SetPowerResponse struct {
+type GetOdometryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Change in pose of the base since odometry was last reset, in the frame of the base at the time of the reset
+	Pose *v1.Pose `protobuf:"bytes,1,opt,name=pose,proto3" json:"pose,omitempty"`
+	// Current measured linear velocity in mm per second, in the frame of the base
+	LinearVelocity *v1.Vector3 `protobuf:"bytes,2,opt,name=linear_velocity,json=linearVelocity,proto3" json:"linear_velocity,omitempty"`
+	// Current measured angular velocity in degrees per second, in the frame of the base
+	AngularVelocity *v1.Vector3 `protobuf:"bytes,3,opt,name=angular_velocity,json=angularVelocity,proto3" json:"angular_velocity,omitempty"`
+	// Time at which the pose and velocities were measured
+	MeasuredAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=measured_at,json=measuredAt,proto3" json:"measured_at,omitempty"`
+	// Time at which odometry was last reset
+	ResetAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=reset_at,json=resetAt,proto3" json:"reset_at,omitempty"`
 }
 
-func (x *SetPowerResponse) Reset() {
-	*x = SetPowerResponse{}
+func (x *GetOdometryResponse) Reset() {
+	*x = GetOdometryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_base_v1_base_proto_msgTypes[7]
+		mi := &file_component_base_v1_base_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetPowerResponse) String() string {
+func (x *GetOdometryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetPowerResponse) ProtoMessage() {}
+func (*GetOdometryResponse) ProtoMessage() {}
 
-func (x *SetPowerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_component_base_v1_base_proto_msgTypes[7]
+func (x *GetOdometryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_base_v1_base_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -452,43 +1031,74 @@ func (x *SetPowerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetPowerResponse.ProtoReflect.Descriptor instead.
-func (*SetPowerResponse) Descriptor() ([]byte, []int) {
-	return file_component_base_v1_base_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use GetOdometryResponse.ProtoReflect.Descriptor instead.
+func (*GetOdometryResponse) Descriptor() ([]byte, []int) {
+	return file_component_base_v1_base_proto_rawDescGZIP(), []int{16}
 }
 
-type SetVelocityRequest struct {
+func (x *GetOdometryResponse) GetPose() *v1.Pose {
+	if x != nil {
+		return x.Pose
+	}
+	return nil
+}
+
+func (x *GetOdometryResponse) GetLinearVelocity() *v1.Vector3 {
+	if x != nil {
+		return x.LinearVelocity
+	}
+	return nil
+}
+
+func (x *GetOdometryResponse) GetAngularVelocity() *v1.Vector3 {
+	if x != nil {
+		return x.AngularVelocity
+	}
+	return nil
+}
+
+func (x *GetOdometryResponse) GetMeasuredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MeasuredAt
+	}
+	return nil
+}
+
+func (x *GetOdometryResponse) GetResetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResetAt
+	}
+	return nil
+}
+
+type ResetOdometryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Name of a base
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Desired linear velocity in mm per second
-	Linear *v1.Vector3 `protobuf:"bytes,2,opt,name=linear,proto3" json:"linear,omitempty"`
-	// Desired angular velocity in degrees per second
-	Angular *v1.Vector3 `protobuf:"bytes,3,opt,name=angular,proto3" json:"angular,omitempty"`
 	// Additional arguments to the method
 	Extra *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
 }
 
-func (x *SetVelocityRequest) Reset() {
-	*x = SetVelocityRequest{}
+func (x *ResetOdometryRequest) Reset() {
+	*x = ResetOdometryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_base_v1_base_proto_msgTypes[8]
+		mi := &file_component_base_v1_base_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetVelocityRequest) String() string {
+func (x *ResetOdometryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetVelocityRequest) ProtoMessage() {}
+func (*ResetOdometryRequest) ProtoMessage() {}
 
-func (x *SetVelocityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_component_base_v1_base_proto_msgTypes[8]
+func (x *ResetOdometryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_base_v1_base_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -499,62 +1109,48 @@ func (x *SetVelocityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetVelocityRequest.ProtoReflect.Descriptor instead.
-func (*SetVelocityRequest) Descriptor() ([]byte, []int) {
-	return file_component_base_v1_base_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ResetOdometryRequest.ProtoReflect.Descriptor instead.
+func (*ResetOdometryRequest) Descriptor() ([]byte, []int) {
+	return file_component_base_v1_base_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *SetVelocityRequest) GetName() string {
+func (x *ResetOdometryRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *SetVelocityRequest) GetLinear() *v1.Vector3 {
-	if x != nil {
-		return x.Linear
-	}
-	return nil
-}
-
-func (x *SetVelocityRequest) GetAngular() *v1.Vector3 {
-	if x != nil {
-		return x.Angular
-	}
-	return nil
-}
-
-func (x *SetVelocityRequest) GetExtra() *structpb.Struct {
+func (x *ResetOdometryRequest) GetExtra() *structpb.Struct {
 	if x != nil {
 		return x.Extra
 	}
 	return nil
 }
 
-type SetVelocityResponse struct {
+type ResetOdometryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *SetVelocityResponse) Reset() {
-	*x = SetVelocityResponse{}
+func (x *ResetOdometryResponse) Reset() {
+	*x = ResetOdometryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_base_v1_base_proto_msgTypes[9]
+		mi := &file_component_base_v1_base_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetVelocityResponse) String() string {
+func (x *ResetOdometryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetVelocityResponse) ProtoMessage() {}
+func (*ResetOdometryResponse) ProtoMessage() {}
 
-func (x *SetVelocityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_component_base_v1_base_proto_msgTypes[9]
+func (x *ResetOdometryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_base_v1_base_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -565,9 +1161,9 @@ func (x *SetVelocityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetVelocityResponse.ProtoReflect.Descriptor instead.
-func (*SetVelocityResponse) Descriptor() ([]byte, []int) {
-	return file_component_base_v1_base_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ResetOdometryResponse.ProtoReflect.Descriptor instead.
+func (*ResetOdometryResponse) Descriptor() ([]byte, []int) {
+	return file_component_base_v1_base_proto_rawDescGZIP(), []int{18}
 }
 
 type IsMovingRequest struct {
@@ -581,7 +1177,7 @@ type IsMovingRequest struct {
 func (x *IsMovingRequest) Reset() {
 	*x = IsMovingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_base_v1_base_proto_msgTypes[10]
+		mi := &file_component_base_v1_base_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -594,7 +1190,7 @@ func (x *IsMovingRequest) String() string {
 func (*IsMovingRequest) ProtoMessage() {}
 
 func (x *IsMovingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_component_base_v1_base_proto_msgTypes[10]
+	mi := &file_component_base_v1_base_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -607,7 +1203,7 @@ func (x *IsMovingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsMovingRequest.ProtoReflect.Descriptor instead.
 func (*IsMovingRequest) Descriptor() ([]byte, []int) {
-	return file_component_base_v1_base_proto_rawDescGZIP(), []int{10}
+	return file_component_base_v1_base_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *IsMovingRequest) GetName() string {
@@ -628,7 +1224,7 @@ type IsMovingResponse struct {
 func (x *IsMovingResponse) Reset() {
 	*x = IsMovingResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_base_v1_base_proto_msgTypes[11]
+		mi := &file_component_base_v1_base_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -641,7 +1237,7 @@ func (x *IsMovingResponse) String() string {
 func (*IsMovingResponse) ProtoMessage() {}
 
 func (x *IsMovingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_component_base_v1_base_proto_msgTypes[11]
+	mi := &file_component_base_v1_base_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -654,7 +1250,7 @@ func (x *IsMovingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsMovingResponse.ProtoReflect.Descriptor instead.
 func (*IsMovingResponse) Descriptor() ([]byte, []int) {
-	return file_component_base_v1_base_proto_rawDescGZIP(), []int{11}
+	return file_component_base_v1_base_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *IsMovingResponse) GetIsMoving() bool {
@@ -677,7 +1273,7 @@ type GetPropertiesRequest struct {
 func (x *GetPropertiesRequest) Reset() {
 	*x = GetPropertiesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_base_v1_base_proto_msgTypes[12]
+		mi := &file_component_base_v1_base_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -690,7 +1286,7 @@ func (x *GetPropertiesRequest) String() string {
 func (*GetPropertiesRequest) ProtoMessage() {}
 
 func (x *GetPropertiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_component_base_v1_base_proto_msgTypes[12]
+	mi := &file_component_base_v1_base_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -703,7 +1299,7 @@ func (x *GetPropertiesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPropertiesRequest.ProtoReflect.Descriptor instead.
 func (*GetPropertiesRequest) Descriptor() ([]byte, []int) {
-	return file_component_base_v1_base_proto_rawDescGZIP(), []int{12}
+	return file_component_base_v1_base_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetPropertiesRequest) GetName() string {
@@ -733,7 +1329,7 @@ type GetPropertiesResponse struct {
 func (x *GetPropertiesResponse) Reset() {
 	*x = GetPropertiesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_base_v1_base_proto_msgTypes[13]
+		mi := &file_component_base_v1_base_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -746,7 +1342,7 @@ func (x *GetPropertiesResponse) String() string {
 func (*GetPropertiesResponse) ProtoMessage() {}
 
 func (x *GetPropertiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_component_base_v1_base_proto_msgTypes[13]
+	mi := &file_component_base_v1_base_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -759,7 +1355,7 @@ func (x *GetPropertiesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPropertiesResponse.ProtoReflect.Descriptor instead.
 func (*GetPropertiesResponse) Descriptor() ([]byte, []int) {
-	return file_component_base_v1_base_proto_rawDescGZIP(), []int{13}
+	return file_component_base_v1_base_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *GetPropertiesResponse) GetWidthMeters() float64 {
@@ -794,174 +1390,290 @@ var file_component_base_v1_base_proto_rawDesc = []byte{
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74,
-	0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x97, 0x01, 0x0a, 0x13, 0x4d,
-	0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x61, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x97, 0x01, 0x0a, 0x13,
+	0x4d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x61, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6d, 0x12, 0x1c, 0x0a, 0x0a, 0x6d, 0x6d, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x6d,
+	0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18,
+	0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05,
+	0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x16, 0x0a, 0x14, 0x4d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x72,
+	0x61, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8f, 0x01,
+	0x0a, 0x0b, 0x53, 0x70, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x64, 0x65, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x44, 0x65, 0x67, 0x12, 0x20,
+	0x0a, 0x0c, 0x64, 0x65, 0x67, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x64, 0x65, 0x67, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63,
+	0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22,
+	0x0e, 0x0a, 0x0c, 0x53, 0x70, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xdd, 0x01, 0x0a, 0x0e, 0x4d, 0x6f, 0x76, 0x65, 0x41, 0x72, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x5f, 0x6d, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x69, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6d, 0x12, 0x1c, 0x0a, 0x0a, 0x6d, 0x6d, 0x5f, 0x70, 0x65,
-	0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x6d, 0x50,
-	0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73,
+	0x5f, 0x6d, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x72, 0x61, 0x64, 0x69, 0x75,
+	0x73, 0x4d, 0x6d, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x72, 0x63, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x5f, 0x6d, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x72,
+	0x63, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x4d, 0x6d, 0x12, 0x1d, 0x0a, 0x09, 0x61, 0x6e, 0x67,
+	0x6c, 0x65, 0x5f, 0x64, 0x65, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x08,
+	0x61, 0x6e, 0x67, 0x6c, 0x65, 0x44, 0x65, 0x67, 0x12, 0x1c, 0x0a, 0x0a, 0x6d, 0x6d, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x6d,
+	0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18,
+	0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05,
+	0x65, 0x78, 0x74, 0x72, 0x61, 0x42, 0x08, 0x0a, 0x06, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x74, 0x22,
+	0x11, 0x0a, 0x0f, 0x4d, 0x6f, 0x76, 0x65, 0x41, 0x72, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x09, 0x50, 0x61, 0x74, 0x68, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x12, 0x11, 0x0a, 0x04, 0x78, 0x5f, 0x6d, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03,
+	0x78, 0x4d, 0x6d, 0x12, 0x11, 0x0a, 0x04, 0x79, 0x5f, 0x6d, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x03, 0x79, 0x4d, 0x6d, 0x12, 0x20, 0x0a, 0x09, 0x74, 0x68, 0x65, 0x74, 0x61, 0x5f,
+	0x64, 0x65, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x08, 0x74, 0x68, 0x65,
+	0x74, 0x61, 0x44, 0x65, 0x67, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0a, 0x6d, 0x6d, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x08,
+	0x6d, 0x6d, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x74, 0x68, 0x65, 0x74, 0x61, 0x5f, 0x64, 0x65, 0x67, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x6d,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x22, 0xf6, 0x01, 0x0a, 0x11, 0x46, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x35, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x0a, 0x6d, 0x6d, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d,
+	0x6d, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x31, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x61,
+	0x63, 0x63, 0x5f, 0x6d, 0x6d, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x32, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x41, 0x63, 0x63, 0x4d, 0x6d,
+	0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x32, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x6d, 0x61,
+	0x78, 0x5f, 0x61, 0x63, 0x63, 0x5f, 0x6d, 0x6d, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63,
+	0x32, 0x22, 0x14, 0x0a, 0x12, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x50, 0x61, 0x74, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x50, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x0e, 0x0a, 0x0c, 0x53, 0x74, 0x6f,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb8, 0x01, 0x0a, 0x0f, 0x53, 0x65,
+	0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x2f, 0x0a, 0x06, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x33, 0x52, 0x06, 0x6c, 0x69, 0x6e, 0x65,
+	0x61, 0x72, 0x12, 0x31, 0x0a, 0x07, 0x61, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x33, 0x52, 0x07, 0x61, 0x6e,
+	0x67, 0x75, 0x6c, 0x61, 0x72, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65,
-	0x78, 0x74, 0x72, 0x61, 0x22, 0x16, 0x0a, 0x14, 0x4d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x61,
-	0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8f, 0x01, 0x0a,
-	0x0b, 0x53, 0x70, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x64, 0x65, 0x67, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x44, 0x65, 0x67, 0x12, 0x20, 0x0a,
-	0x0c, 0x64, 0x65, 0x67, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x0a, 0x64, 0x65, 0x67, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12,
-	0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x0e,
-	0x0a, 0x0c, 0x53, 0x70, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x50,
-	0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61,
-	0x22, 0x0e, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0xb8, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x6c, 0x69, 0x6e, 0x65,
-	0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x33, 0x52, 0x06, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x12, 0x31, 0x0a, 0x07, 0x61, 0x6e, 0x67,
-	0x75, 0x6c, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x69, 0x61,
-	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x33, 0x52, 0x07, 0x61, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x12, 0x2d, 0x0a, 0x05,
-	0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
-	0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x12, 0x0a, 0x10, 0x53,
-	0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0xbb, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x6c, 0x69,
-	0x6e, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x69, 0x61,
-	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x33, 0x52, 0x06, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x12, 0x31, 0x0a, 0x07, 0x61,
-	0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76,
-	0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x33, 0x52, 0x07, 0x61, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x12, 0x2d,
+	0x78, 0x74, 0x72, 0x61, 0x22, 0x12, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbb, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x74,
+	0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x33, 0x52, 0x06, 0x6c, 0x69,
+	0x6e, 0x65, 0x61, 0x72, 0x12, 0x31, 0x0a, 0x07, 0x61, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x33, 0x52, 0x07,
+	0x61, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61,
+	0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52,
+	0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x56, 0x65, 0x6c,
+	0x6f, 0x63, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x4f, 0x64, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61,
+	0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52,
+	0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0xb9, 0x02, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4f, 0x64,
+	0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28,
+	0x0a, 0x04, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76,
+	0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f,
+	0x73, 0x65, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0f, 0x6c, 0x69, 0x6e, 0x65,
+	0x61, 0x72, 0x5f, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x33, 0x52, 0x0e, 0x6c, 0x69, 0x6e, 0x65,
+	0x61, 0x72, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x12, 0x42, 0x0a, 0x10, 0x61, 0x6e,
+	0x67, 0x75, 0x6c, 0x61, 0x72, 0x5f, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x33, 0x52, 0x0f, 0x61,
+	0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x12, 0x3b,
+	0x0a, 0x0b, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0a, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x72, 0x65, 0x73, 0x65, 0x74,
+	0x41, 0x74, 0x22, 0x59, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4f, 0x64, 0x6f, 0x6d, 0x65,
+	0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d,
 	0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x15, 0x0a,
-	0x13, 0x53, 0x65, 0x74, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x0a, 0x0f, 0x49, 0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2f, 0x0a, 0x10, 0x49,
-	0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x22, 0x59, 0x0a, 0x14,
-	0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72,
-	0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
-	0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0xac, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50,
-	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x69, 0x64, 0x74, 0x68, 0x5f, 0x6d, 0x65, 0x74, 0x65, 0x72,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x77, 0x69, 0x64, 0x74, 0x68, 0x4d, 0x65,
-	0x74, 0x65, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x74, 0x75, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
-	0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x5f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x13, 0x74, 0x75, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x64, 0x69,
-	0x75, 0x73, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x3c, 0x0a, 0x1a, 0x77, 0x68, 0x65, 0x65,
-	0x6c, 0x5f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x6d, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f,
-	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x18, 0x77, 0x68,
-	0x65, 0x65, 0x6c, 0x43, 0x69, 0x72, 0x63, 0x75, 0x6d, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x4d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x32, 0xe1, 0x0a, 0x0a, 0x0b, 0x42, 0x61, 0x73, 0x65, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xa7, 0x01, 0x0a, 0x0c, 0x4d, 0x6f, 0x76, 0x65, 0x53,
-	0x74, 0x72, 0x61, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2b, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63,
-	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x61, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f,
-	0x76, 0x65, 0x53, 0x74, 0x72, 0x61, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x3c, 0xa0, 0x92, 0x29, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x22, 0x30,
-	0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d,
-	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d,
-	0x65, 0x7d, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x69, 0x67, 0x68, 0x74,
-	0x12, 0x86, 0x01, 0x0a, 0x04, 0x53, 0x70, 0x69, 0x6e, 0x12, 0x23, 0x2e, 0x76, 0x69, 0x61, 0x6d,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x17, 0x0a,
+	0x15, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4f, 0x64, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x0a, 0x0f, 0x49, 0x73, 0x4d, 0x6f, 0x76, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2f, 0x0a,
+	0x10, 0x49, 0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x22, 0x59,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0xac, 0x01, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x69, 0x64, 0x74, 0x68, 0x5f, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x77, 0x69, 0x64, 0x74, 0x68,
+	0x4d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x74, 0x75, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x5f, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x5f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x74, 0x75, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x61,
+	0x64, 0x69, 0x75, 0x73, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x3c, 0x0a, 0x1a, 0x77, 0x68,
+	0x65, 0x65, 0x6c, 0x5f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x6d, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x5f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x18,
+	0x77, 0x68, 0x65, 0x65, 0x6c, 0x43, 0x69, 0x72, 0x63, 0x75, 0x6d, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x32, 0xe1, 0x0f, 0x0a, 0x0b, 0x42, 0x61, 0x73,
+	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xa7, 0x01, 0x0a, 0x0c, 0x4d, 0x6f, 0x76,
+	0x65, 0x53, 0x74, 0x72, 0x61, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2b, 0x2e, 0x76, 0x69, 0x61, 0x6d,
 	0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x70, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
-	0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0xa0, 0x92, 0x29, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29,
-	0x22, 0x27, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63,
-	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x7b, 0x6e,
-	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x70, 0x69, 0x6e, 0x12, 0x97, 0x01, 0x0a, 0x08, 0x53, 0x65,
-	0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x27, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f,
+	0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x61, 0x69, 0x67, 0x68, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f,
 	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x28, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
-	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0xa0, 0x92, 0x29, 0x01, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x22, 0x2c, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x62, 0x61,
-	0x73, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x65, 0x74, 0x5f, 0x70, 0x6f,
-	0x77, 0x65, 0x72, 0x12, 0xa3, 0x01, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x56, 0x65, 0x6c, 0x6f, 0x63,
-	0x69, 0x74, 0x79, 0x12, 0x2a, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
-	0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74,
-	0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2b, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
-	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x56, 0x65, 0x6c, 0x6f,
-	0x63, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0xa0, 0x92,
-	0x29, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x22, 0x2f, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
-	0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x65, 0x74,
-	0x5f, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x12, 0x82, 0x01, 0x0a, 0x04, 0x53, 0x74,
-	0x6f, 0x70, 0x12, 0x23, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
-	0x65, 0x6e, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x70,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63,
+	0x4d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x61, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0xa0, 0x92, 0x29, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32,
+	0x22, 0x30, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x7b, 0x6e,
+	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x86, 0x01, 0x0a, 0x04, 0x53, 0x70, 0x69, 0x6e, 0x12, 0x23, 0x2e, 0x76, 0x69,
+	0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x69, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0xa0, 0x92, 0x29, 0x01, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x29, 0x22, 0x27, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f,
+	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x70, 0x69, 0x6e, 0x12, 0x93, 0x01, 0x0a, 0x07,
+	0x4d, 0x6f, 0x76, 0x65, 0x41, 0x72, 0x63, 0x12, 0x26, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63,
 	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x29, 0x22, 0x27, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x62, 0x61,
-	0x73, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x74, 0x6f, 0x70, 0x12, 0x93,
-	0x01, 0x0a, 0x08, 0x49, 0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x2e, 0x76, 0x69,
+	0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x41, 0x72, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x41, 0x72, 0x63,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0xa0, 0x92, 0x29, 0x01, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2d, 0x22, 0x2b, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x62, 0x61, 0x73,
+	0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x61, 0x72,
+	0x63, 0x12, 0x9f, 0x01, 0x0a, 0x0a, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x29, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x76, 0x69,
 	0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73,
-	0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x62,
-	0x61, 0x73, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x69, 0x73, 0x5f, 0x6d, 0x6f,
-	0x76, 0x69, 0x6e, 0x67, 0x12, 0x87, 0x01, 0x0a, 0x09, 0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
-	0x6e, 0x64, 0x12, 0x20, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x22,
-	0x2d, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x50, 0x61, 0x74, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0xa0, 0x92, 0x29, 0x01, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x30, 0x22, 0x2e, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x62, 0x61, 0x73, 0x65,
+	0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x97, 0x01, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72,
+	0x12, 0x27, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x77,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x76, 0x69, 0x61, 0x6d,
+	0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x38, 0xa0, 0x92, 0x29, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x22,
+	0x2c, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f,
 	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x7b, 0x6e, 0x61,
-	0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x6f, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x93,
-	0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73,
-	0x12, 0x24, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6f, 0x6d, 0x65,
-	0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x62, 0x61,
-	0x73, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x67, 0x65, 0x6f, 0x6d, 0x65, 0x74,
-	0x72, 0x69, 0x65, 0x73, 0x12, 0xa3, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70,
-	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x2c, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f,
+	0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x65, 0x74, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x12, 0xa3, 0x01,
+	0x0a, 0x0b, 0x53, 0x65, 0x74, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x12, 0x2a, 0x2e,
+	0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x76, 0x69, 0x61, 0x6d,
+	0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0xa0, 0x92, 0x29, 0x01, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x31, 0x22, 0x2f, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f,
+	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x65, 0x74, 0x5f, 0x76, 0x65, 0x6c, 0x6f, 0x63,
+	0x69, 0x74, 0x79, 0x12, 0x9b, 0x01, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4f, 0x64, 0x6f, 0x6d, 0x65,
+	0x74, 0x72, 0x79, 0x12, 0x2a, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x4f, 0x64, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2b, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x64, 0x6f, 0x6d,
+	0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x62, 0x61, 0x73,
+	0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6f, 0x64, 0x6f, 0x6d, 0x65, 0x74, 0x72,
+	0x79, 0x12, 0xa7, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4f, 0x64, 0x6f, 0x6d, 0x65,
+	0x74, 0x72, 0x79, 0x12, 0x2c, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x4f, 0x64, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2d, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x4f, 0x64, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x22, 0x31, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6f, 0x64, 0x6f,
+	0x6d, 0x65, 0x74, 0x72, 0x79, 0x2f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x82, 0x01, 0x0a, 0x04,
+	0x53, 0x74, 0x6f, 0x70, 0x12, 0x23, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x76, 0x69, 0x61, 0x6d,
+	0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x22, 0x27, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f,
+	0x62, 0x61, 0x73, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x74, 0x6f, 0x70,
+	0x12, 0x93, 0x01, 0x0a, 0x08, 0x49, 0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x2e,
+	0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f,
 	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x76, 0x69,
-	0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
-	0x65, 0x6e, 0x74, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f,
-	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x42, 0x3f, 0x0a, 0x1a, 0x63, 0x6f,
-	0x6d, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
-	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x5a, 0x21, 0x67, 0x6f, 0x2e, 0x76, 0x69, 0x61,
-	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
-	0x65, 0x6e, 0x74, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x49, 0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x69, 0x73, 0x5f,
+	0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x12, 0x87, 0x01, 0x0a, 0x09, 0x44, 0x6f, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x12, 0x20, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x2f, 0x22, 0x2d, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x7b,
+	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x6f, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x12, 0x93, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x24, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6f,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f,
+	0x62, 0x61, 0x73, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x67, 0x65, 0x6f, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0xa3, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x2c, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f,
+	0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65,
+	0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x42, 0x3f, 0x0a, 0x1a,
+	0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x5a, 0x21, 0x67, 0x6f, 0x2e, 0x76,
+	0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -976,63 +1688,92 @@ func file_component_base_v1_base_proto_rawDescGZIP() []byte {
 	return file_component_base_v1_base_proto_rawDescData
 }
 
-var file_component_base_v1_base_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_component_base_v1_base_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_component_base_v1_base_proto_goTypes = []interface{}{
 	(*MoveStraightRequest)(nil),      // 0: viam.component.base.v1.MoveStraightRequest
 	(*MoveStraightResponse)(nil),     // 1: viam.component.base.v1.MoveStraightResponse
 	(*SpinRequest)(nil),              // 2: viam.component.base.v1.SpinRequest
 	(*SpinResponse)(nil),             // 3: viam.component.base.v1.SpinResponse
-	(*StopRequest)(nil),              // 4: viam.component.base.v1.StopRequest
-	(*StopResponse)(nil),             // 5: viam.component.base.v1.StopResponse
-	(*SetPowerRequest)(nil),          // 6: viam.component.base.v1.SetPowerRequest
-	(*SetPowerResponse)(nil),         // 7: viam.component.base.v1.SetPowerResponse
-	(*SetVelocityRequest)(nil),       // 8: viam.component.base.v1.SetVelocityRequest
-	(*SetVelocityResponse)(nil),      // 9: viam.component.base.v1.SetVelocityResponse
-	(*IsMovingRequest)(nil),          // 10: viam.component.base.v1.IsMovingRequest
-	(*IsMovingResponse)(nil),         // 11: viam.component.base.v1.IsMovingResponse
-	(*GetPropertiesRequest)(nil),     // 12: viam.component.base.v1.GetPropertiesRequest
-	(*GetPropertiesResponse)(nil),    // 13: viam.component.base.v1.GetPropertiesResponse
-	(*structpb.Struct)(nil),          // 14: google.protobuf.Struct
-	(*v1.Vector3)(nil),               // 15: viam.common.v1.Vector3
-	(*v1.DoCommandRequest)(nil),      // 16: viam.common.v1.DoCommandRequest
-	(*v1.GetGeometriesRequest)(nil),  // 17: viam.common.v1.GetGeometriesRequest
-	(*v1.DoCommandResponse)(nil),     // 18: viam.common.v1.DoCommandResponse
-	(*v1.GetGeometriesResponse)(nil), // 19: viam.common.v1.GetGeometriesResponse
+	(*MoveArcRequest)(nil),           // 4: viam.component.base.v1.MoveArcRequest
+	(*MoveArcResponse)(nil),          // 5: viam.component.base.v1.MoveArcResponse
+	(*PathPoint)(nil),                // 6: viam.component.base.v1.PathPoint
+	(*FollowPathRequest)(nil),        // 7: viam.component.base.v1.FollowPathRequest
+	(*FollowPathResponse)(nil),       // 8: viam.component.base.v1.FollowPathResponse
+	(*StopRequest)(nil),              // 9: viam.component.base.v1.StopRequest
+	(*StopResponse)(nil),             // 10: viam.component.base.v1.StopResponse
+	(*SetPowerRequest)(nil),          // 11: viam.component.base.v1.SetPowerRequest
+	(*SetPowerResponse)(nil),         // 12: viam.component.base.v1.SetPowerResponse
+	(*SetVelocityRequest)(nil),       // 13: viam.component.base.v1.SetVelocityRequest
+	(*SetVelocityResponse)(nil),      // 14: viam.component.base.v1.SetVelocityResponse
+	(*GetOdometryRequest)(nil),       // 15: viam.component.base.v1.GetOdometryRequest
+	(*GetOdometryResponse)(nil),      // 16: viam.component.base.v1.GetOdometryResponse
+	(*ResetOdometryRequest)(nil),     // 17: viam.component.base.v1.ResetOdometryRequest
+	(*ResetOdometryResponse)(nil),    // 18: viam.component.base.v1.ResetOdometryResponse
+	(*IsMovingRequest)(nil),          // 19: viam.component.base.v1.IsMovingRequest
+	(*IsMovingResponse)(nil),         // 20: viam.component.base.v1.IsMovingResponse
+	(*GetPropertiesRequest)(nil),     // 21: viam.component.base.v1.GetPropertiesRequest
+	(*GetPropertiesResponse)(nil),    // 22: viam.component.base.v1.GetPropertiesResponse
+	(*structpb.Struct)(nil),          // 23: google.protobuf.Struct
+	(*v1.Vector3)(nil),               // 24: viam.common.v1.Vector3
+	(*v1.Pose)(nil),                  // 25: viam.common.v1.Pose
+	(*timestamppb.Timestamp)(nil),    // 26: google.protobuf.Timestamp
+	(*v1.DoCommandRequest)(nil),      // 27: viam.common.v1.DoCommandRequest
+	(*v1.GetGeometriesRequest)(nil),  // 28: viam.common.v1.GetGeometriesRequest
+	(*v1.DoCommandResponse)(nil),     // 29: viam.common.v1.DoCommandResponse
+	(*v1.GetGeometriesResponse)(nil), // 30: viam.common.v1.GetGeometriesResponse
 }
 var file_component_base_v1_base_proto_depIdxs = []int32{
-	14, // 0: viam.component.base.v1.MoveStraightRequest.extra:type_name -> google.protobuf.Struct
-	14, // 1: viam.component.base.v1.SpinRequest.extra:type_name -> google.protobuf.Struct
-	14, // 2: viam.component.base.v1.StopRequest.extra:type_name -> google.protobuf.Struct
-	15, // 3: viam.component.base.v1.SetPowerRequest.linear:type_name -> viam.common.v1.Vector3
-	15, // 4: viam.component.base.v1.SetPowerRequest.angular:type_name -> viam.common.v1.Vector3
-	14, // 5: viam.component.base.v1.SetPowerRequest.extra:type_name -> google.protobuf.Struct
-	15, // 6: viam.component.base.v1.SetVelocityRequest.linear:type_name -> viam.common.v1.Vector3
-	15, // 7: viam.component.base.v1.SetVelocityRequest.angular:type_name -> viam.common.v1.Vector3
-	14, // 8: viam.component.base.v1.SetVelocityRequest.extra:type_name -> google.protobuf.Struct
-	14, // 9: viam.component.base.v1.GetPropertiesRequest.extra:type_name -> google.protobuf.Struct
-	0,  // 10: viam.component.base.v1.BaseService.MoveStraight:input_type -> viam.component.base.v1.MoveStraightRequest
-	2,  // 11: viam.component.base.v1.BaseService.Spin:input_type -> viam.component.base.v1.SpinRequest
-	6,  // 12: viam.component.base.v1.BaseService.SetPower:input_type -> viam.component.base.v1.SetPowerRequest
-	8,  // 13: viam.component.base.v1.BaseService.SetVelocity:input_type -> viam.component.base.v1.SetVelocityRequest
-	4,  // 14: viam.component.base.v1.BaseService.Stop:input_type -> viam.component.base.v1.StopRequest
-	10, // 15: viam.component.base.v1.BaseService.IsMoving:input_type -> viam.component.base.v1.IsMovingRequest
-	16, // 16: viam.component.base.v1.BaseService.DoCommand:input_type -> viam.common.v1.DoCommandRequest
-	17, // 17: viam.component.base.v1.BaseService.GetGeometries:input_type -> viam.common.v1.GetGeometriesRequest
-	12, // 18: viam.component.base.v1.BaseService.GetProperties:input_type -> viam.component.base.v1.GetPropertiesRequest
-	1,  // 19: viam.component.base.v1.BaseService.MoveStraight:output_type -> viam.component.base.v1.MoveStraightResponse
-	3,  // 20: viam.component.base.v1.BaseService.Spin:output_type -> viam.component.base.v1.SpinResponse
-	7,  // 21: viam.component.base.v1.BaseService.SetPower:output_type -> viam.component.base.v1.SetPowerResponse
-	9,  // 22: viam.component.base.v1.BaseService.SetVelocity:output_type -> viam.component.base.v1.SetVelocityResponse
-	5,  // 23: viam.component.base.v1.BaseService.Stop:output_type -> viam.component.base.v1.StopResponse
-	11, // 24: viam.component.base.v1.BaseService.IsMoving:output_type -> viam.component.base.v1.IsMovingResponse
-	18, // 25: viam.component.base.v1.BaseService.DoCommand:output_type -> viam.common.v1.DoCommandResponse
-	19, // 26: viam.component.base.v1.BaseService.GetGeometries:output_type -> viam.common.v1.GetGeometriesResponse
-	13, // 27: viam.component.base.v1.BaseService.GetProperties:output_type -> viam.component.base.v1.GetPropertiesResponse
-	19, // [19:28] is the sub-list for method output_type
-	10, // [10:19] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	23, // 0: viam.component.base.v1.MoveStraightRequest.extra:type_name -> google.protobuf.Struct
+	23, // 1: viam.component.base.v1.SpinRequest.extra:type_name -> google.protobuf.Struct
+	23, // 2: viam.component.base.v1.MoveArcRequest.extra:type_name -> google.protobuf.Struct
+	6,  // 3: viam.component.base.v1.FollowPathRequest.path:type_name -> viam.component.base.v1.PathPoint
+	23, // 4: viam.component.base.v1.FollowPathRequest.extra:type_name -> google.protobuf.Struct
+	23, // 5: viam.component.base.v1.StopRequest.extra:type_name -> google.protobuf.Struct
+	24, // 6: viam.component.base.v1.SetPowerRequest.linear:type_name -> viam.common.v1.Vector3
+	24, // 7: viam.component.base.v1.SetPowerRequest.angular:type_name -> viam.common.v1.Vector3
+	23, // 8: viam.component.base.v1.SetPowerRequest.extra:type_name -> google.protobuf.Struct
+	24, // 9: viam.component.base.v1.SetVelocityRequest.linear:type_name -> viam.common.v1.Vector3
+	24, // 10: viam.component.base.v1.SetVelocityRequest.angular:type_name -> viam.common.v1.Vector3
+	23, // 11: viam.component.base.v1.SetVelocityRequest.extra:type_name -> google.protobuf.Struct
+	23, // 12: viam.component.base.v1.GetOdometryRequest.extra:type_name -> google.protobuf.Struct
+	25, // 13: viam.component.base.v1.GetOdometryResponse.pose:type_name -> viam.common.v1.Pose
+	24, // 14: viam.component.base.v1.GetOdometryResponse.linear_velocity:type_name -> viam.common.v1.Vector3
+	24, // 15: viam.component.base.v1.GetOdometryResponse.angular_velocity:type_name -> viam.common.v1.Vector3
+	26, // 16: viam.component.base.v1.GetOdometryResponse.measured_at:type_name -> google.protobuf.Timestamp
+	26, // 17: viam.component.base.v1.GetOdometryResponse.reset_at:type_name -> google.protobuf.Timestamp
+	23, // 18: viam.component.base.v1.ResetOdometryRequest.extra:type_name -> google.protobuf.Struct
+	23, // 19: viam.component.base.v1.GetPropertiesRequest.extra:type_name -> google.protobuf.Struct
+	0,  // 20: viam.component.base.v1.BaseService.MoveStraight:input_type -> viam.component.base.v1.MoveStraightRequest
+	2,  // 21: viam.component.base.v1.BaseService.Spin:input_type -> viam.component.base.v1.SpinRequest
+	4,  // 22: viam.component.base.v1.BaseService.MoveArc:input_type -> viam.component.base.v1.MoveArcRequest
+	7,  // 23: viam.component.base.v1.BaseService.FollowPath:input_type -> viam.component.base.v1.FollowPathRequest
+	11, // 24: viam.component.base.v1.BaseService.SetPower:input_type -> viam.component.base.v1.SetPowerRequest
+	13, // 25: viam.component.base.v1.BaseService.SetVelocity:input_type -> viam.component.base.v1.SetVelocityRequest
+	15, // 26: viam.component.base.v1.BaseService.GetOdometry:input_type -> viam.component.base.v1.GetOdometryRequest
+	17, // 27: viam.component.base.v1.BaseService.ResetOdometry:input_type -> viam.component.base.v1.ResetOdometryRequest
+	9,  // 28: viam.component.base.v1.BaseService.Stop:input_type -> viam.component.base.v1.StopRequest
+	19, // 29: viam.component.base.v1.BaseService.IsMoving:input_type -> viam.component.base.v1.IsMovingRequest
+	27, // 30: viam.component.base.v1.BaseService.DoCommand:input_type -> viam.common.v1.DoCommandRequest
+	28, // 31: viam.component.base.v1.BaseService.GetGeometries:input_type -> viam.common.v1.GetGeometriesRequest
+	21, // 32: viam.component.base.v1.BaseService.GetProperties:input_type -> viam.component.base.v1.GetPropertiesRequest
+	1,  // 33: viam.component.base.v1.BaseService.MoveStraight:output_type -> viam.component.base.v1.MoveStraightResponse
+	3,  // 34: viam.component.base.v1.BaseService.Spin:output_type -> viam.component.base.v1.SpinResponse
+	5,  // 35: viam.component.base.v1.BaseService.MoveArc:output_type -> viam.component.base.v1.MoveArcResponse
+	8,  // 36: viam.component.base.v1.BaseService.FollowPath:output_type -> viam.component.base.v1.FollowPathResponse
+	12, // 37: viam.component.base.v1.BaseService.SetPower:output_type -> viam.component.base.v1.SetPowerResponse
+	14, // 38: viam.component.base.v1.BaseService.SetVelocity:output_type -> viam.component.base.v1.SetVelocityResponse
+	16, // 39: viam.component.base.v1.BaseService.GetOdometry:output_type -> viam.component.base.v1.GetOdometryResponse
+	18, // 40: viam.component.base.v1.BaseService.ResetOdometry:output_type -> viam.component.base.v1.ResetOdometryResponse
+	10, // 41: viam.component.base.v1.BaseService.Stop:output_type -> viam.component.base.v1.StopResponse
+	20, // 42: viam.component.base.v1.BaseService.IsMoving:output_type -> viam.component.base.v1.IsMovingResponse
+	29, // 43: viam.component.base.v1.BaseService.DoCommand:output_type -> viam.common.v1.DoCommandResponse
+	30, // 44: viam.component.base.v1.BaseService.GetGeometries:output_type -> viam.common.v1.GetGeometriesResponse
+	22, // 45: viam.component.base.v1.BaseService.GetProperties:output_type -> viam.component.base.v1.GetPropertiesResponse
+	33, // [33:46] is the sub-list for method output_type
+	20, // [20:33] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_component_base_v1_base_proto_init() }
@@ -1090,7 +1831,7 @@ func file_component_base_v1_base_proto_init() {
 			}
 		}
 		file_component_base_v1_base_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopRequest); i {
+			switch v := v.(*MoveArcRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1102,7 +1843,7 @@ func file_component_base_v1_base_proto_init() {
 			}
 		}
 		file_component_base_v1_base_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopResponse); i {
+			switch v := v.(*MoveArcResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1114,7 +1855,7 @@ func file_component_base_v1_base_proto_init() {
 			}
 		}
 		file_component_base_v1_base_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetPowerRequest); i {
+			switch v := v.(*PathPoint); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1126,7 +1867,7 @@ func file_component_base_v1_base_proto_init() {
 			}
 		}
 		file_component_base_v1_base_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetPowerResponse); i {
+			switch v := v.(*FollowPathRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1138,7 +1879,7 @@ func file_component_base_v1_base_proto_init() {
 			}
 		}
 		file_component_base_v1_base_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetVelocityRequest); i {
+			switch v := v.(*FollowPathResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1150,7 +1891,7 @@ func file_component_base_v1_base_proto_init() {
 			}
 		}
 		file_component_base_v1_base_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetVelocityResponse); i {
+			switch v := v.(*StopRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1162,7 +1903,7 @@ func file_component_base_v1_base_proto_init() {
 			}
 		}
 		file_component_base_v1_base_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsMovingRequest); i {
+			switch v := v.(*StopResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1174,7 +1915,7 @@ func file_component_base_v1_base_proto_init() {
 			}
 		}
 		file_component_base_v1_base_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsMovingResponse); i {
+			switch v := v.(*SetPowerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1186,7 +1927,7 @@ func file_component_base_v1_base_proto_init() {
 			}
 		}
 		file_component_base_v1_base_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPropertiesRequest); i {
+			switch v := v.(*SetPowerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1198,6 +1939,114 @@ func file_component_base_v1_base_proto_init() {
 			}
 		}
 		file_component_base_v1_base_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetVelocityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_base_v1_base_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetVelocityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_base_v1_base_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOdometryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_base_v1_base_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOdometryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_base_v1_base_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetOdometryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_base_v1_base_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetOdometryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_base_v1_base_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsMovingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_base_v1_base_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsMovingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_base_v1_base_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPropertiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_base_v1_base_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPropertiesResponse); i {
 			case 0:
 				return &v.state
@@ -1210,13 +2059,19 @@ func file_component_base_v1_base_proto_init() {
 			}
 		}
 	}
+	file_component_base_v1_base_proto_msgTypes[4].OneofWrappers = []interface{}{
+		(*MoveArcRequest_ArcLengthMm)(nil),
+		(*MoveArcRequest_AngleDeg)(nil),
+	}
+	file_component_base_v1_base_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	file_component_base_v1_base_proto_msgTypes[7].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_component_base_v1_base_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
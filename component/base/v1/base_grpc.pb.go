@@ -31,11 +31,23 @@ type BaseServiceClient interface {
 	// angular speed, expressed in degrees per second
 	// This method blocks until completed or cancelled
 	Spin(ctx context.Context, in *SpinRequest, opts ...grpc.CallOption) (*SpinResponse, error)
+	// MoveArc moves a robot's base along a circular arc of a given radius, expressed in millimeters,
+	// for a given arc length or angle at a given speed, expressed in millimeters per second
+	// This method blocks until completed or cancelled
+	MoveArc(ctx context.Context, in *MoveArcRequest, opts ...grpc.CallOption) (*MoveArcResponse, error)
+	// FollowPath moves a robot's base through a list of poses, expressed in the frame of the base when the call is made
+	// This method blocks until completed or cancelled
+	FollowPath(ctx context.Context, in *FollowPathRequest, opts ...grpc.CallOption) (*FollowPathResponse, error)
 	// SetPower sets the linear and angular power of a base
 	// -1 -> 1 in terms of power for each direction
 	SetPower(ctx context.Context, in *SetPowerRequest, opts ...grpc.CallOption) (*SetPowerResponse, error)
 	// SetVelocity sets the linear and angular velocity of a base
 	SetVelocity(ctx context.Context, in *SetVelocityRequest, opts ...grpc.CallOption) (*SetVelocityResponse, error)
+	// GetOdometry returns the change in pose of a base since its odometry was last reset,
+	// along with its current measured linear and angular velocity
+	GetOdometry(ctx context.Context, in *GetOdometryRequest, opts ...grpc.CallOption) (*GetOdometryResponse, error)
+	// ResetOdometry resets the odometry of a base so that its current pose becomes the origin
+	ResetOdometry(ctx context.Context, in *ResetOdometryRequest, opts ...grpc.CallOption) (*ResetOdometryResponse, error)
 	// Stop stops a robot's base
 	Stop(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error)
 	// IsMoving reports if a component is in motion
@@ -74,6 +86,24 @@ func (c *baseServiceClient) Spin(ctx context.Context, in *SpinRequest, opts ...g
 	return out, nil
 }
 
+func (c *baseServiceClient) MoveArc(ctx context.Context, in *MoveArcRequest, opts ...grpc.CallOption) (*MoveArcResponse, error) {
+	out := new(MoveArcResponse)
+	err := c.cc.Invoke(ctx, "/viam.component.base.v1.BaseService/MoveArc", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *baseServiceClient) FollowPath(ctx context.Context, in *FollowPathRequest, opts ...grpc.CallOption) (*FollowPathResponse, error) {
+	out := new(FollowPathResponse)
+	err := c.cc.Invoke(ctx, "/viam.component.base.v1.BaseService/FollowPath", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *baseServiceClient) SetPower(ctx context.Context, in *SetPowerRequest, opts ...grpc.CallOption) (*SetPowerResponse, error) {
 	out := new(SetPowerResponse)
 	err := c.cc.Invoke(ctx, "/viam.component.base.v1.BaseService/SetPower", in, out, opts...)
@@ -92,6 +122,24 @@ func (c *baseServiceClient) SetVelocity(ctx context.Context, in *SetVelocityRequ
 	return out, nil
 }
 
+func (c *baseServiceClient) GetOdometry(ctx context.Context, in *GetOdometryRequest, opts ...grpc.CallOption) (*GetOdometryResponse, error) {
+	out := new(GetOdometryResponse)
+	err := c.cc.Invoke(ctx, "/viam.component.base.v1.BaseService/GetOdometry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *baseServiceClient) ResetOdometry(ctx context.Context, in *ResetOdometryRequest, opts ...grpc.CallOption) (*ResetOdometryResponse, error) {
+	out := new(ResetOdometryResponse)
+	err := c.cc.Invoke(ctx, "/viam.component.base.v1.BaseService/ResetOdometry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *baseServiceClient) Stop(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error) {
 	out := new(StopResponse)
 	err := c.cc.Invoke(ctx, "/viam.component.base.v1.BaseService/Stop", in, out, opts...)
@@ -149,11 +197,23 @@ type BaseServiceServer interface {
 	// angular speed, expressed in degrees per second
 	// This method blocks until completed or cancelled
 	Spin(context.Context, *SpinRequest) (*SpinResponse, error)
+	// MoveArc moves a robot's base along a circular arc of a given radius, expressed in millimeters,
+	// for a given arc length or angle at a given speed, expressed in millimeters per second
+	// This method blocks until completed or cancelled
+	MoveArc(context.Context, *MoveArcRequest) (*MoveArcResponse, error)
+	// FollowPath moves a robot's base through a list of poses, expressed in the frame of the base when the call is made
+	// This method blocks until completed or cancelled
+	FollowPath(context.Context, *FollowPathRequest) (*FollowPathResponse, error)
 	// SetPower sets the linear and angular power of a base
 	// -1 -> 1 in terms of power for each direction
 	SetPower(context.Context, *SetPowerRequest) (*SetPowerResponse, error)
 	// SetVelocity sets the linear and angular velocity of a base
 	SetVelocity(context.Context, *SetVelocityRequest) (*SetVelocityResponse, error)
+	// GetOdometry returns the change in pose of a base since its odometry was last reset,
+	// along with its current measured linear and angular velocity
+	GetOdometry(context.Context, *GetOdometryRequest) (*GetOdometryResponse, error)
+	// ResetOdometry resets the odometry of a base so that its current pose becomes the origin
+	ResetOdometry(context.Context, *ResetOdometryRequest) (*ResetOdometryResponse, error)
 	// Stop stops a robot's base
 	Stop(context.Context, *StopRequest) (*StopResponse, error)
 	// IsMoving reports if a component is in motion
@@ -177,12 +237,24 @@ func (UnimplementedBaseServiceServer) MoveStraight(context.Context, *MoveStraigh
 func (UnimplementedBaseServiceServer) Spin(context.Context, *SpinRequest) (*SpinResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Spin not implemented")
 }
+func (UnimplementedBaseServiceServer) MoveArc(context.Context, *MoveArcRequest) (*MoveArcResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveArc not implemented")
+}
+func (UnimplementedBaseServiceServer) FollowPath(context.Context, *FollowPathRequest) (*FollowPathResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FollowPath not implemented")
+}
 func (UnimplementedBaseServiceServer) SetPower(context.Context, *SetPowerRequest) (*SetPowerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetPower not implemented")
 }
 func (UnimplementedBaseServiceServer) SetVelocity(context.Context, *SetVelocityRequest) (*SetVelocityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetVelocity not implemented")
 }
+func (UnimplementedBaseServiceServer) GetOdometry(context.Context, *GetOdometryRequest) (*GetOdometryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOdometry not implemented")
+}
+func (UnimplementedBaseServiceServer) ResetOdometry(context.Context, *ResetOdometryRequest) (*ResetOdometryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetOdometry not implemented")
+}
 func (UnimplementedBaseServiceServer) Stop(context.Context, *StopRequest) (*StopResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Stop not implemented")
 }
@@ -247,6 +319,42 @@ func _BaseService_Spin_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BaseService_MoveArc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveArcRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BaseServiceServer).MoveArc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/viam.component.base.v1.BaseService/MoveArc",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BaseServiceServer).MoveArc(ctx, req.(*MoveArcRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BaseService_FollowPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BaseServiceServer).FollowPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/viam.component.base.v1.BaseService/FollowPath",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BaseServiceServer).FollowPath(ctx, req.(*FollowPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BaseService_SetPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetPowerRequest)
 	if err := dec(in); err != nil {
@@ -283,6 +391,42 @@ func _BaseService_SetVelocity_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BaseService_GetOdometry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOdometryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BaseServiceServer).GetOdometry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/viam.component.base.v1.BaseService/GetOdometry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BaseServiceServer).GetOdometry(ctx, req.(*GetOdometryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BaseService_ResetOdometry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetOdometryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BaseServiceServer).ResetOdometry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/viam.component.base.v1.BaseService/ResetOdometry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BaseServiceServer).ResetOdometry(ctx, req.(*ResetOdometryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BaseService_Stop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StopRequest)
 	if err := dec(in); err != nil {
@@ -388,6 +532,14 @@ var BaseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Spin",
 			Handler:    _BaseService_Spin_Handler,
 		},
+		{
+			MethodName: "MoveArc",
+			Handler:    _BaseService_MoveArc_Handler,
+		},
+		{
+			MethodName: "FollowPath",
+			Handler:    _BaseService_FollowPath_Handler,
+		},
 		{
 			MethodName: "SetPower",
 			Handler:    _BaseService_SetPower_Handler,
@@ -396,6 +548,14 @@ var BaseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetVelocity",
 			Handler:    _BaseService_SetVelocity_Handler,
 		},
+		{
+			MethodName: "GetOdometry",
+			Handler:    _BaseService_GetOdometry_Handler,
+		},
+		{
+			MethodName: "ResetOdometry",
+			Handler:    _BaseService_ResetOdometry_Handler,
+		},
 		{
 			MethodName: "Stop",
 			Handler:    _BaseService_Stop_Handler,
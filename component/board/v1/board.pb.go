@@ -13,6 +13,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -24,12 +25,71 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Edge int32
+
+const (
+	Edge_EDGE_UNSPECIFIED Edge = 0
+	// A change from low to high
+	Edge_EDGE_RISING Edge = 1
+	// A change from high to low
+	Edge_EDGE_FALLING Edge = 2
+	// Any change
+	Edge_EDGE_BOTH Edge = 3
+)
+
+// Enum value maps for Edge.
+var (
+	Edge_name = map[int32]string{
+		0: "EDGE_UNSPECIFIED",
+		1: "EDGE_RISING",
+		2: "EDGE_FALLING",
+		3: "EDGE_BOTH",
+	}
+	Edge_value = map[string]int32{
+		"EDGE_UNSPECIFIED": 0,
+		"EDGE_RISING":      1,
+		"EDGE_FALLING":     2,
+		"EDGE_BOTH":        3,
+	}
+)
+
+func (x Edge) Enum() *Edge {
+	p := new(Edge)
+	*p = x
+	return p
+}
+
+func (x Edge) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Edge) Descriptor() protoreflect.EnumDescriptor {
+	return file_component_board_v1_board_proto_enumTypes[0].Descriptor()
+}
+
+func (Edge) Type() protoreflect.EnumType {
+	return &file_component_board_v1_board_proto_enumTypes[0]
+}
+
+func (x Edge) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Edge.Descriptor instead.
+func (Edge) EnumDescriptor() ([]byte, []int) {
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{0}
+}
+
 type PowerMode int32
 
 const (
 	PowerMode_POWER_MODE_UNSPECIFIED  PowerMode = 0
 	PowerMode_POWER_MODE_NORMAL       PowerMode = 1
 	PowerMode_POWER_MODE_OFFLINE_DEEP PowerMode = 2
+	// The CPU is suspended but memory and network connections are kept; execution resumes where it stopped on wake
+	PowerMode_POWER_MODE_OFFLINE_LIGHT PowerMode = 3
+	// The board stays online with its CPU frequency reduced
+	PowerMode_POWER_MODE_THROTTLED PowerMode = 4
 )
 
 // Enum value maps for PowerMode.
@@ -38,11 +98,15 @@ var (
 		0: "POWER_MODE_UNSPECIFIED",
 		1: "POWER_MODE_NORMAL",
 		2: "POWER_MODE_OFFLINE_DEEP",
+		3: "POWER_MODE_OFFLINE_LIGHT",
+		4: "POWER_MODE_THROTTLED",
 	}
 	PowerMode_value = map[string]int32{
-		"POWER_MODE_UNSPECIFIED":  0,
-		"POWER_MODE_NORMAL":       1,
-		"POWER_MODE_OFFLINE_DEEP": 2,
+		"POWER_MODE_UNSPECIFIED":   0,
+		"POWER_MODE_NORMAL":        1,
+		"POWER_MODE_OFFLINE_DEEP":  2,
+		"POWER_MODE_OFFLINE_LIGHT": 3,
+		"POWER_MODE_THROTTLED":     4,
 	}
 )
 
@@ -57,11 +121,11 @@ func (x PowerMode) String() string {
 }
 
 func (PowerMode) Descriptor() protoreflect.EnumDescriptor {
-	return file_component_board_v1_board_proto_enumTypes[0].Descriptor()
+	return file_component_board_v1_board_proto_enumTypes[1].Descriptor()
 }
 
 func (PowerMode) Type() protoreflect.EnumType {
-	return &file_component_board_v1_board_proto_enumTypes[0]
+	return &file_component_board_v1_board_proto_enumTypes[1]
 }
 
 func (x PowerMode) Number() protoreflect.EnumNumber {
@@ -70,7 +134,67 @@ func (x PowerMode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PowerMode.Descriptor instead.
 func (PowerMode) EnumDescriptor() ([]byte, []int) {
-	return file_component_board_v1_board_proto_rawDescGZIP(), []int{0}
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{1}
+}
+
+type WakeReason int32
+
+const (
+	WakeReason_WAKE_REASON_UNSPECIFIED WakeReason = 0
+	// The board was powered on or reset
+	WakeReason_WAKE_REASON_POWER_ON WakeReason = 1
+	// The requested duration elapsed
+	WakeReason_WAKE_REASON_DURATION WakeReason = 2
+	WakeReason_WAKE_REASON_GPIO     WakeReason = 3
+	WakeReason_WAKE_REASON_RTC      WakeReason = 4
+	WakeReason_WAKE_REASON_ANALOG   WakeReason = 5
+)
+
+// Enum value maps for WakeReason.
+var (
+	WakeReason_name = map[int32]string{
+		0: "WAKE_REASON_UNSPECIFIED",
+		1: "WAKE_REASON_POWER_ON",
+		2: "WAKE_REASON_DURATION",
+		3: "WAKE_REASON_GPIO",
+		4: "WAKE_REASON_RTC",
+		5: "WAKE_REASON_ANALOG",
+	}
+	WakeReason_value = map[string]int32{
+		"WAKE_REASON_UNSPECIFIED": 0,
+		"WAKE_REASON_POWER_ON":    1,
+		"WAKE_REASON_DURATION":    2,
+		"WAKE_REASON_GPIO":        3,
+		"WAKE_REASON_RTC":         4,
+		"WAKE_REASON_ANALOG":      5,
+	}
+)
+
+func (x WakeReason) Enum() *WakeReason {
+	p := new(WakeReason)
+	*p = x
+	return p
+}
+
+func (x WakeReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WakeReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_component_board_v1_board_proto_enumTypes[2].Descriptor()
+}
+
+func (WakeReason) Type() protoreflect.EnumType {
+	return &file_component_board_v1_board_proto_enumTypes[2]
+}
+
+func (x WakeReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WakeReason.Descriptor instead.
+func (WakeReason) EnumDescriptor() ([]byte, []int) {
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{2}
 }
 
 type StatusRequest struct {
@@ -397,6 +521,146 @@ func (x *GetGPIOResponse) GetHigh() bool {
 	return false
 }
 
+type StreamGPIOEdgesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Pins to stream edges of
+	Pins []string `protobuf:"bytes,2,rep,name=pins,proto3" json:"pins,omitempty"`
+	// Which edges to stream. Must not be unspecified
+	Edge Edge `protobuf:"varint,3,opt,name=edge,proto3,enum=viam.component.board.v1.Edge" json:"edge,omitempty"`
+	// Additional arguments to the method
+	Extra *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
+}
+
+func (x *StreamGPIOEdgesRequest) Reset() {
+	*x = StreamGPIOEdgesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_board_v1_board_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamGPIOEdgesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamGPIOEdgesRequest) ProtoMessage() {}
+
+func (x *StreamGPIOEdgesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_board_v1_board_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamGPIOEdgesRequest.ProtoReflect.Descriptor instead.
+func (*StreamGPIOEdgesRequest) Descriptor() ([]byte, []int) {
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StreamGPIOEdgesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StreamGPIOEdgesRequest) GetPins() []string {
+	if x != nil {
+		return x.Pins
+	}
+	return nil
+}
+
+func (x *StreamGPIOEdgesRequest) GetEdge() Edge {
+	if x != nil {
+		return x.Edge
+	}
+	return Edge_EDGE_UNSPECIFIED
+}
+
+func (x *StreamGPIOEdgesRequest) GetExtra() *structpb.Struct {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+type StreamGPIOEdgesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Pin which changed level
+	Pin string `protobuf:"bytes,1,opt,name=pin,proto3" json:"pin,omitempty"`
+	// True if the pin went high, false if it went low
+	High bool `protobuf:"varint,2,opt,name=high,proto3" json:"high,omitempty"`
+	// Time of the level change
+	Time *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *StreamGPIOEdgesResponse) Reset() {
+	*x = StreamGPIOEdgesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_board_v1_board_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamGPIOEdgesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamGPIOEdgesResponse) ProtoMessage() {}
+
+func (x *StreamGPIOEdgesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_board_v1_board_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamGPIOEdgesResponse.ProtoReflect.Descriptor instead.
+func (*StreamGPIOEdgesResponse) Descriptor() ([]byte, []int) {
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StreamGPIOEdgesResponse) GetPin() string {
+	if x != nil {
+		return x.Pin
+	}
+	return ""
+}
+
+func (x *StreamGPIOEdgesResponse) GetHigh() bool {
+	if x != nil {
+		return x.High
+	}
+	return false
+}
+
+func (x *StreamGPIOEdgesResponse) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
 type PWMRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -411,7 +675,7 @@ type PWMRequest struct {
 func (x *PWMRequest) Reset() {
 	*x = PWMRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_board_v1_board_proto_msgTypes[6]
+		mi := &file_component_board_v1_board_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -424,7 +688,7 @@ func (x *PWMRequest) String() string {
 func (*PWMRequest) ProtoMessage() {}
 
 func (x *PWMRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_component_board_v1_board_proto_msgTypes[6]
+	mi := &file_component_board_v1_board_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -437,7 +701,7 @@ func (x *PWMRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PWMRequest.ProtoReflect.Descriptor instead.
 func (*PWMRequest) Descriptor() ([]byte, []int) {
-	return file_component_board_v1_board_proto_rawDescGZIP(), []int{6}
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *PWMRequest) GetName() string {
@@ -472,7 +736,7 @@ type PWMResponse struct {
 func (x *PWMResponse) Reset() {
 	*x = PWMResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_board_v1_board_proto_msgTypes[7]
+		mi := &file_component_board_v1_board_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -485,7 +749,7 @@ func (x *PWMResponse) String() string {
 func (*PWMResponse) ProtoMessage() {}
 
 func (x *PWMResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_component_board_v1_board_proto_msgTypes[7]
+	mi := &file_component_board_v1_board_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -498,7 +762,7 @@ func (x *PWMResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PWMResponse.ProtoReflect.Descriptor instead.
 func (*PWMResponse) Descriptor() ([]byte, []int) {
-	return file_component_board_v1_board_proto_rawDescGZIP(), []int{7}
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *PWMResponse) GetDutyCyclePct() float64 {
@@ -523,7 +787,7 @@ type SetPWMRequest struct {
 func (x *SetPWMRequest) Reset() {
 	*x = SetPWMRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_board_v1_board_proto_msgTypes[8]
+		mi := &file_component_board_v1_board_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -536,7 +800,7 @@ func (x *SetPWMRequest) String() string {
 func (*SetPWMRequest) ProtoMessage() {}
 
 func (x *SetPWMRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_component_board_v1_board_proto_msgTypes[8]
+	mi := &file_component_board_v1_board_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -549,7 +813,7 @@ func (x *SetPWMRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetPWMRequest.ProtoReflect.Descriptor instead.
 func (*SetPWMRequest) Descriptor() ([]byte, []int) {
-	return file_component_board_v1_board_proto_rawDescGZIP(), []int{8}
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *SetPWMRequest) GetName() string {
@@ -589,7 +853,7 @@ type SetPWMResponse struct {
 func (x *SetPWMResponse) Reset() {
 	*x = SetPWMResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_board_v1_board_proto_msgTypes[9]
+		mi := &file_component_board_v1_board_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -602,7 +866,7 @@ func (x *SetPWMResponse) String() string {
 func (*SetPWMResponse) ProtoMessage() {}
 
 func (x *SetPWMResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_component_board_v1_board_proto_msgTypes[9]
+	mi := &file_component_board_v1_board_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -615,7 +879,7 @@ func (x *SetPWMResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetPWMResponse.ProtoReflect.Descriptor instead.
 func (*SetPWMResponse) Descriptor() ([]byte, []int) {
-	return file_component_board_v1_board_proto_rawDescGZIP(), []int{9}
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{11}
 }
 
 type PWMFrequencyRequest struct {
@@ -632,7 +896,7 @@ type PWMFrequencyRequest struct {
 func (x *PWMFrequencyRequest) Reset() {
 	*x = PWMFrequencyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_board_v1_board_proto_msgTypes[10]
+		mi := &file_component_board_v1_board_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -645,7 +909,7 @@ func (x *PWMFrequencyRequest) String() string {
 func (*PWMFrequencyRequest) ProtoMessage() {}
 
 func (x *PWMFrequencyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_component_board_v1_board_proto_msgTypes[10]
+	mi := &file_component_board_v1_board_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -658,7 +922,7 @@ func (x *PWMFrequencyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PWMFrequencyRequest.ProtoReflect.Descriptor instead.
 func (*PWMFrequencyRequest) Descriptor() ([]byte, []int) {
-	return file_component_board_v1_board_proto_rawDescGZIP(), []int{10}
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *PWMFrequencyRequest) GetName() string {
@@ -693,7 +957,7 @@ type PWMFrequencyResponse struct {
 func (x *PWMFrequencyResponse) Reset() {
 	*x = PWMFrequencyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_board_v1_board_proto_msgTypes[11]
+		mi := &file_component_board_v1_board_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -706,7 +970,7 @@ func (x *PWMFrequencyResponse) String() string {
 func (*PWMFrequencyResponse) ProtoMessage() {}
 
 func (x *PWMFrequencyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_component_board_v1_board_proto_msgTypes[11]
+	mi := &file_component_board_v1_board_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -719,7 +983,7 @@ func (x *PWMFrequencyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PWMFrequencyResponse.ProtoReflect.Descriptor instead.
 func (*PWMFrequencyResponse) Descriptor() ([]byte, []int) {
-	return file_component_board_v1_board_proto_rawDescGZIP(), []int{11}
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *PWMFrequencyResponse) GetFrequencyHz() uint64 {
@@ -744,7 +1008,7 @@ type SetPWMFrequencyRequest struct {
 func (x *SetPWMFrequencyRequest) Reset() {
 	*x = SetPWMFrequencyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_board_v1_board_proto_msgTypes[12]
+		mi := &file_component_board_v1_board_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -757,7 +1021,7 @@ func (x *SetPWMFrequencyRequest) String() string {
 func (*SetPWMFrequencyRequest) ProtoMessage() {}
 
 func (x *SetPWMFrequencyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_component_board_v1_board_proto_msgTypes[12]
+	mi := &file_component_board_v1_board_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -770,7 +1034,7 @@ func (x *SetPWMFrequencyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetPWMFrequencyRequest.ProtoReflect.Descriptor instead.
 func (*SetPWMFrequencyRequest) Descriptor() ([]byte, []int) {
-	return file_component_board_v1_board_proto_rawDescGZIP(), []int{12}
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *SetPWMFrequencyRequest) GetName() string {
@@ -810,7 +1074,7 @@ type SetPWMFrequencyResponse struct {
 func (x *SetPWMFrequencyResponse) Reset() {
 	*x = SetPWMFrequencyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_board_v1_board_proto_msgTypes[13]
+		mi := &file_component_board_v1_board_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -823,7 +1087,7 @@ func (x *SetPWMFrequencyResponse) String() string {
 func (*SetPWMFrequencyResponse) ProtoMessage() {}
 
 func (x *SetPWMFrequencyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_component_board_v1_board_proto_msgTypes[13]
+	mi := &file_component_board_v1_board_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -836,7 +1100,7 @@ func (x *SetPWMFrequencyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetPWMFrequencyResponse.ProtoReflect.Descriptor instead.
 func (*SetPWMFrequencyResponse) Descriptor() ([]byte, []int) {
-	return file_component_board_v1_board_proto_rawDescGZIP(), []int{13}
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{15}
 }
 
 type ReadAnalogReaderRequest struct {
@@ -853,7 +1117,7 @@ type ReadAnalogReaderRequest struct {
 func (x *ReadAnalogReaderRequest) Reset() {
 	*x = ReadAnalogReaderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_board_v1_board_proto_msgTypes[14]
+		mi := &file_component_board_v1_board_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -866,7 +1130,7 @@ func (x *ReadAnalogReaderRequest) String() string {
 func (*ReadAnalogReaderRequest) ProtoMessage() {}
 
 func (x *ReadAnalogReaderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_component_board_v1_board_proto_msgTypes[14]
+	mi := &file_component_board_v1_board_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -879,7 +1143,7 @@ func (x *ReadAnalogReaderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadAnalogReaderRequest.ProtoReflect.Descriptor instead.
 func (*ReadAnalogReaderRequest) Descriptor() ([]byte, []int) {
-	return file_component_board_v1_board_proto_rawDescGZIP(), []int{14}
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ReadAnalogReaderRequest) GetBoardName() string {
@@ -908,13 +1172,23 @@ type ReadAnalogReaderResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// Raw value read by the ADC
 	Value int32 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	// Voltage corresponding to value, in volts
+	Voltage float32 `protobuf:"fixed32,2,opt,name=voltage,proto3" json:"voltage,omitempty"`
+	// Lowest and highest voltage the reader can measure, in volts
+	MinRange float32 `protobuf:"fixed32,3,opt,name=min_range,json=minRange,proto3" json:"min_range,omitempty"`
+	MaxRange float32 `protobuf:"fixed32,4,opt,name=max_range,json=maxRange,proto3" json:"max_range,omitempty"`
+	// Voltage difference between consecutive raw values, in volts
+	StepSize float32 `protobuf:"fixed32,5,opt,name=step_size,json=stepSize,proto3" json:"step_size,omitempty"`
+	// Time at which the value was read
+	Time *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`
 }
 
 func (x *ReadAnalogReaderResponse) Reset() {
 	*x = ReadAnalogReaderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_board_v1_board_proto_msgTypes[15]
+		mi := &file_component_board_v1_board_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -927,7 +1201,7 @@ func (x *ReadAnalogReaderResponse) String() string {
 func (*ReadAnalogReaderResponse) ProtoMessage() {}
 
 func (x *ReadAnalogReaderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_component_board_v1_board_proto_msgTypes[15]
+	mi := &file_component_board_v1_board_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -940,7 +1214,7 @@ func (x *ReadAnalogReaderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadAnalogReaderResponse.ProtoReflect.Descriptor instead.
 func (*ReadAnalogReaderResponse) Descriptor() ([]byte, []int) {
-	return file_component_board_v1_board_proto_rawDescGZIP(), []int{15}
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ReadAnalogReaderResponse) GetValue() int32 {
@@ -950,34 +1224,275 @@ func (x *ReadAnalogReaderResponse) GetValue() int32 {
 	return 0
 }
 
-type GetDigitalInterruptValueRequest struct {
+func (x *ReadAnalogReaderResponse) GetVoltage() float32 {
+	if x != nil {
+		return x.Voltage
+	}
+	return 0
+}
+
+func (x *ReadAnalogReaderResponse) GetMinRange() float32 {
+	if x != nil {
+		return x.MinRange
+	}
+	return 0
+}
+
+func (x *ReadAnalogReaderResponse) GetMaxRange() float32 {
+	if x != nil {
+		return x.MaxRange
+	}
+	return 0
+}
+
+func (x *ReadAnalogReaderResponse) GetStepSize() float32 {
+	if x != nil {
+		return x.StepSize
+	}
+	return 0
+}
+
+func (x *ReadAnalogReaderResponse) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+type StreamAnalogReadersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BoardName            string `protobuf:"bytes,1,opt,name=board_name,json=boardName,proto3" json:"board_name,omitempty"`
-	DigitalInterruptName string `protobuf:"bytes,2,opt,name=digital_interrupt_name,json=digitalInterruptName,proto3" json:"digital_interrupt_name,omitempty"`
+	BoardName string `protobuf:"bytes,1,opt,name=board_name,json=boardName,proto3" json:"board_name,omitempty"`
+	// Names of the analog readers to sample
+	AnalogReaderNames []string `protobuf:"bytes,2,rep,name=analog_reader_names,json=analogReaderNames,proto3" json:"analog_reader_names,omitempty"`
+	// Rate at which to sample each reader. 0 will use each reader's configured sampling rate
+	SampleRateHz float64 `protobuf:"fixed64,3,opt,name=sample_rate_hz,json=sampleRateHz,proto3" json:"sample_rate_hz,omitempty"`
+	// Number of samples of each reader to send in each response
+	SamplesPerBatch uint32 `protobuf:"varint,4,opt,name=samples_per_batch,json=samplesPerBatch,proto3" json:"samples_per_batch,omitempty"`
 	// Additional arguments to the method
 	Extra *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
 }
 
-func (x *GetDigitalInterruptValueRequest) Reset() {
-	*x = GetDigitalInterruptValueRequest{}
+func (x *StreamAnalogReadersRequest) Reset() {
+	*x = StreamAnalogReadersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_board_v1_board_proto_msgTypes[16]
+		mi := &file_component_board_v1_board_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetDigitalInterruptValueRequest) String() string {
+func (x *StreamAnalogReadersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDigitalInterruptValueRequest) ProtoMessage() {}
+func (*StreamAnalogReadersRequest) ProtoMessage() {}
 
-func (x *GetDigitalInterruptValueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_component_board_v1_board_proto_msgTypes[16]
+func (x *StreamAnalogReadersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_board_v1_board_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamAnalogReadersRequest.ProtoReflect.Descriptor instead.
+func (*StreamAnalogReadersRequest) Descriptor() ([]byte, []int) {
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *StreamAnalogReadersRequest) GetBoardName() string {
+	if x != nil {
+		return x.BoardName
+	}
+	return ""
+}
+
+func (x *StreamAnalogReadersRequest) GetAnalogReaderNames() []string {
+	if x != nil {
+		return x.AnalogReaderNames
+	}
+	return nil
+}
+
+func (x *StreamAnalogReadersRequest) GetSampleRateHz() float64 {
+	if x != nil {
+		return x.SampleRateHz
+	}
+	return 0
+}
+
+func (x *StreamAnalogReadersRequest) GetSamplesPerBatch() uint32 {
+	if x != nil {
+		return x.SamplesPerBatch
+	}
+	return 0
+}
+
+func (x *StreamAnalogReadersRequest) GetExtra() *structpb.Struct {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+type StreamAnalogReadersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Samples of every requested reader, in the order they were taken
+	Samples []*AnalogSample `protobuf:"bytes,1,rep,name=samples,proto3" json:"samples,omitempty"`
+}
+
+func (x *StreamAnalogReadersResponse) Reset() {
+	*x = StreamAnalogReadersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_board_v1_board_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamAnalogReadersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamAnalogReadersResponse) ProtoMessage() {}
+
+func (x *StreamAnalogReadersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_board_v1_board_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamAnalogReadersResponse.ProtoReflect.Descriptor instead.
+func (*StreamAnalogReadersResponse) Descriptor() ([]byte, []int) {
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *StreamAnalogReadersResponse) GetSamples() []*AnalogSample {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+type AnalogSample struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the analog reader which was sampled
+	AnalogReaderName string `protobuf:"bytes,1,opt,name=analog_reader_name,json=analogReaderName,proto3" json:"analog_reader_name,omitempty"`
+	// Raw value read by the ADC
+	Value int32 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Voltage corresponding to value, in volts
+	Voltage float32 `protobuf:"fixed32,3,opt,name=voltage,proto3" json:"voltage,omitempty"`
+	// Time at which the sample was taken
+	Time *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *AnalogSample) Reset() {
+	*x = AnalogSample{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_board_v1_board_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalogSample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalogSample) ProtoMessage() {}
+
+func (x *AnalogSample) ProtoReflect() protoreflect.Message {
+	mi := &file_component_board_v1_board_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalogSample.ProtoReflect.Descriptor instead.
+func (*AnalogSample) Descriptor() ([]byte, []int) {
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AnalogSample) GetAnalogReaderName() string {
+	if x != nil {
+		return x.AnalogReaderName
+	}
+	return ""
+}
+
+func (x *AnalogSample) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *AnalogSample) GetVoltage() float32 {
+	if x != nil {
+		return x.Voltage
+	}
+	return 0
+}
+
+func (x *AnalogSample) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+type GetDigitalInterruptValueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BoardName            string `protobuf:"bytes,1,opt,name=board_name,json=boardName,proto3" json:"board_name,omitempty"`
+	DigitalInterruptName string `protobuf:"bytes,2,opt,name=digital_interrupt_name,json=digitalInterruptName,proto3" json:"digital_interrupt_name,omitempty"`
+	// Additional arguments to the method
+	Extra *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
+}
+
+func (x *GetDigitalInterruptValueRequest) Reset() {
+	*x = GetDigitalInterruptValueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_board_v1_board_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDigitalInterruptValueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDigitalInterruptValueRequest) ProtoMessage() {}
+
+func (x *GetDigitalInterruptValueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_board_v1_board_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -990,7 +1505,7 @@ func (x *GetDigitalInterruptValueRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDigitalInterruptValueRequest.ProtoReflect.Descriptor instead.
 func (*GetDigitalInterruptValueRequest) Descriptor() ([]byte, []int) {
-	return file_component_board_v1_board_proto_rawDescGZIP(), []int{16}
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetDigitalInterruptValueRequest) GetBoardName() string {
@@ -1025,7 +1540,7 @@ type GetDigitalInterruptValueResponse struct {
 func (x *GetDigitalInterruptValueResponse) Reset() {
 	*x = GetDigitalInterruptValueResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_board_v1_board_proto_msgTypes[17]
+		mi := &file_component_board_v1_board_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1038,7 +1553,7 @@ func (x *GetDigitalInterruptValueResponse) String() string {
 func (*GetDigitalInterruptValueResponse) ProtoMessage() {}
 
 func (x *GetDigitalInterruptValueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_component_board_v1_board_proto_msgTypes[17]
+	mi := &file_component_board_v1_board_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1051,7 +1566,7 @@ func (x *GetDigitalInterruptValueResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDigitalInterruptValueResponse.ProtoReflect.Descriptor instead.
 func (*GetDigitalInterruptValueResponse) Descriptor() ([]byte, []int) {
-	return file_component_board_v1_board_proto_rawDescGZIP(), []int{17}
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *GetDigitalInterruptValueResponse) GetValue() int64 {
@@ -1061,38 +1576,35 @@ func (x *GetDigitalInterruptValueResponse) GetValue() int64 {
 	return 0
 }
 
-type SetPowerModeRequest struct {
+type StreamTicksRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// name of board
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Requested power mode
-	PowerMode PowerMode `protobuf:"varint,2,opt,name=power_mode,json=powerMode,proto3,enum=viam.component.board.v1.PowerMode" json:"power_mode,omitempty"`
-	// Requested duration to stay in `power_mode`
-	Duration *durationpb.Duration `protobuf:"bytes,3,opt,name=duration,proto3,oneof" json:"duration,omitempty"`
+	BoardName string `protobuf:"bytes,1,opt,name=board_name,json=boardName,proto3" json:"board_name,omitempty"`
+	// Names of the digital interrupts to stream ticks of
+	DigitalInterruptNames []string `protobuf:"bytes,2,rep,name=digital_interrupt_names,json=digitalInterruptNames,proto3" json:"digital_interrupt_names,omitempty"`
 	// Additional arguments to the method
 	Extra *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
 }
 
-func (x *SetPowerModeRequest) Reset() {
-	*x = SetPowerModeRequest{}
+func (x *StreamTicksRequest) Reset() {
+	*x = StreamTicksRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_board_v1_board_proto_msgTypes[18]
+		mi := &file_component_board_v1_board_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetPowerModeRequest) String() string {
+func (x *StreamTicksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetPowerModeRequest) ProtoMessage() {}
+func (*StreamTicksRequest) ProtoMessage() {}
 
-func (x *SetPowerModeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_component_board_v1_board_proto_msgTypes[18]
+func (x *StreamTicksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_board_v1_board_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1103,62 +1615,140 @@ func (x *SetPowerModeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetPowerModeRequest.ProtoReflect.Descriptor instead.
-func (*SetPowerModeRequest) Descriptor() ([]byte, []int) {
-	return file_component_board_v1_board_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use StreamTicksRequest.ProtoReflect.Descriptor instead.
+func (*StreamTicksRequest) Descriptor() ([]byte, []int) {
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *SetPowerModeRequest) GetName() string {
+func (x *StreamTicksRequest) GetBoardName() string {
 	if x != nil {
-		return x.Name
+		return x.BoardName
 	}
 	return ""
 }
 
-func (x *SetPowerModeRequest) GetPowerMode() PowerMode {
+func (x *StreamTicksRequest) GetDigitalInterruptNames() []string {
 	if x != nil {
-		return x.PowerMode
+		return x.DigitalInterruptNames
 	}
-	return PowerMode_POWER_MODE_UNSPECIFIED
+	return nil
 }
 
-func (x *SetPowerModeRequest) GetDuration() *durationpb.Duration {
+func (x *StreamTicksRequest) GetExtra() *structpb.Struct {
 	if x != nil {
-		return x.Duration
+		return x.Extra
 	}
 	return nil
 }
 
-func (x *SetPowerModeRequest) GetExtra() *structpb.Struct {
+type StreamTicksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the digital interrupt which ticked
+	DigitalInterruptName string `protobuf:"bytes,1,opt,name=digital_interrupt_name,json=digitalInterruptName,proto3" json:"digital_interrupt_name,omitempty"`
+	// True if the interrupt went high, false if it went low
+	High bool `protobuf:"varint,2,opt,name=high,proto3" json:"high,omitempty"`
+	// Time of the tick
+	Time *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	// Value of the interrupt after the tick, as returned by GetDigitalInterruptValue
+	Value int64 `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *StreamTicksResponse) Reset() {
+	*x = StreamTicksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_board_v1_board_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamTicksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamTicksResponse) ProtoMessage() {}
+
+func (x *StreamTicksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_board_v1_board_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamTicksResponse.ProtoReflect.Descriptor instead.
+func (*StreamTicksResponse) Descriptor() ([]byte, []int) {
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *StreamTicksResponse) GetDigitalInterruptName() string {
 	if x != nil {
-		return x.Extra
+		return x.DigitalInterruptName
+	}
+	return ""
+}
+
+func (x *StreamTicksResponse) GetHigh() bool {
+	if x != nil {
+		return x.High
+	}
+	return false
+}
+
+func (x *StreamTicksResponse) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
 	}
 	return nil
 }
 
-type SetPowerModeResponse struct {
+func (x *StreamTicksResponse) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type I2CReadWriteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	BoardName string `protobuf:"bytes,1,opt,name=board_name,json=boardName,proto3" json:"board_name,omitempty"`
+	// Name of the I2C bus in the board's config
+	I2CName string `protobuf:"bytes,2,opt,name=i2c_name,json=i2cName,proto3" json:"i2c_name,omitempty"`
+	// 7-bit address of the device on the bus
+	Address uint32 `protobuf:"varint,3,opt,name=address,proto3" json:"address,omitempty"`
+	// Operations to perform in order, as a single transaction
+	Operations []*I2COperation `protobuf:"bytes,4,rep,name=operations,proto3" json:"operations,omitempty"`
+	// Additional arguments to the method
+	Extra *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
 }
 
-func (x *SetPowerModeResponse) Reset() {
-	*x = SetPowerModeResponse{}
+func (x *I2CReadWriteRequest) Reset() {
+	*x = I2CReadWriteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_board_v1_board_proto_msgTypes[19]
+		mi := &file_component_board_v1_board_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetPowerModeResponse) String() string {
+func (x *I2CReadWriteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetPowerModeResponse) ProtoMessage() {}
+func (*I2CReadWriteRequest) ProtoMessage() {}
 
-func (x *SetPowerModeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_component_board_v1_board_proto_msgTypes[19]
+func (x *I2CReadWriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_board_v1_board_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1169,230 +1759,1437 @@ func (x *SetPowerModeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetPowerModeResponse.ProtoReflect.Descriptor instead.
-func (*SetPowerModeResponse) Descriptor() ([]byte, []int) {
-	return file_component_board_v1_board_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use I2CReadWriteRequest.ProtoReflect.Descriptor instead.
+func (*I2CReadWriteRequest) Descriptor() ([]byte, []int) {
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{25}
 }
 
-var File_component_board_v1_board_proto protoreflect.FileDescriptor
+func (x *I2CReadWriteRequest) GetBoardName() string {
+	if x != nil {
+		return x.BoardName
+	}
+	return ""
+}
 
-var file_component_board_v1_board_proto_rawDesc = []byte{
-	0x0a, 0x1e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x62, 0x6f, 0x61, 0x72,
-	0x64, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x17, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
-	0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x1a, 0x16, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+func (x *I2CReadWriteRequest) GetI2CName() string {
+	if x != nil {
+		return x.I2CName
+	}
+	return ""
+}
+
+func (x *I2CReadWriteRequest) GetAddress() uint32 {
+	if x != nil {
+		return x.Address
+	}
+	return 0
+}
+
+func (x *I2CReadWriteRequest) GetOperations() []*I2COperation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *I2CReadWriteRequest) GetExtra() *structpb.Struct {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+type I2COperation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Operation:
+	//
+	//	*I2COperation_Write
+	//	*I2COperation_ReadLength
+	Operation isI2COperation_Operation `protobuf_oneof:"operation"`
+}
+
+func (x *I2COperation) Reset() {
+	*x = I2COperation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_board_v1_board_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *I2COperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*I2COperation) ProtoMessage() {}
+
+func (x *I2COperation) ProtoReflect() protoreflect.Message {
+	mi := &file_component_board_v1_board_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use I2COperation.ProtoReflect.Descriptor instead.
+func (*I2COperation) Descriptor() ([]byte, []int) {
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{26}
+}
+
+func (m *I2COperation) GetOperation() isI2COperation_Operation {
+	if m != nil {
+		return m.Operation
+	}
+	return nil
+}
+
+func (x *I2COperation) GetWrite() []byte {
+	if x, ok := x.GetOperation().(*I2COperation_Write); ok {
+		return x.Write
+	}
+	return nil
+}
+
+func (x *I2COperation) GetReadLength() uint32 {
+	if x, ok := x.GetOperation().(*I2COperation_ReadLength); ok {
+		return x.ReadLength
+	}
+	return 0
+}
+
+type isI2COperation_Operation interface {
+	isI2COperation_Operation()
+}
+
+type I2COperation_Write struct {
+	// Bytes to write to the device
+	Write []byte `protobuf:"bytes,1,opt,name=write,proto3,oneof"`
+}
+
+type I2COperation_ReadLength struct {
+	// Number of bytes to read from the device
+	ReadLength uint32 `protobuf:"varint,2,opt,name=read_length,json=readLength,proto3,oneof"`
+}
+
+func (*I2COperation_Write) isI2COperation_Operation() {}
+
+func (*I2COperation_ReadLength) isI2COperation_Operation() {}
+
+type I2CReadWriteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Bytes read, with 1 entry per read operation in the order they were requested
+	Reads [][]byte `protobuf:"bytes,1,rep,name=reads,proto3" json:"reads,omitempty"`
+}
+
+func (x *I2CReadWriteResponse) Reset() {
+	*x = I2CReadWriteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_board_v1_board_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *I2CReadWriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*I2CReadWriteResponse) ProtoMessage() {}
+
+func (x *I2CReadWriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_board_v1_board_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use I2CReadWriteResponse.ProtoReflect.Descriptor instead.
+func (*I2CReadWriteResponse) Descriptor() ([]byte, []int) {
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *I2CReadWriteResponse) GetReads() [][]byte {
+	if x != nil {
+		return x.Reads
+	}
+	return nil
+}
+
+type SPITransferRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BoardName string `protobuf:"bytes,1,opt,name=board_name,json=boardName,proto3" json:"board_name,omitempty"`
+	// Name of the SPI bus in the board's config
+	SpiName string `protobuf:"bytes,2,opt,name=spi_name,json=spiName,proto3" json:"spi_name,omitempty"`
+	// Chip select pin of the device on the bus
+	ChipSelect string `protobuf:"bytes,3,opt,name=chip_select,json=chipSelect,proto3" json:"chip_select,omitempty"`
+	// SPI mode, 0-3
+	Mode uint32 `protobuf:"varint,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	// Clock frequency of the transfer. 0 will use the bus's default frequency
+	BaudHz uint64 `protobuf:"varint,5,opt,name=baud_hz,json=baudHz,proto3" json:"baud_hz,omitempty"`
+	// Bytes to write; the same number of bytes is read back
+	Data []byte `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	// Additional arguments to the method
+	Extra *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
+}
+
+func (x *SPITransferRequest) Reset() {
+	*x = SPITransferRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_board_v1_board_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SPITransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SPITransferRequest) ProtoMessage() {}
+
+func (x *SPITransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_board_v1_board_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SPITransferRequest.ProtoReflect.Descriptor instead.
+func (*SPITransferRequest) Descriptor() ([]byte, []int) {
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SPITransferRequest) GetBoardName() string {
+	if x != nil {
+		return x.BoardName
+	}
+	return ""
+}
+
+func (x *SPITransferRequest) GetSpiName() string {
+	if x != nil {
+		return x.SpiName
+	}
+	return ""
+}
+
+func (x *SPITransferRequest) GetChipSelect() string {
+	if x != nil {
+		return x.ChipSelect
+	}
+	return ""
+}
+
+func (x *SPITransferRequest) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *SPITransferRequest) GetBaudHz() uint64 {
+	if x != nil {
+		return x.BaudHz
+	}
+	return 0
+}
+
+func (x *SPITransferRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SPITransferRequest) GetExtra() *structpb.Struct {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+type SPITransferResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Bytes read during the transfer
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *SPITransferResponse) Reset() {
+	*x = SPITransferResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_board_v1_board_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SPITransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SPITransferResponse) ProtoMessage() {}
+
+func (x *SPITransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_board_v1_board_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SPITransferResponse.ProtoReflect.Descriptor instead.
+func (*SPITransferResponse) Descriptor() ([]byte, []int) {
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SPITransferResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SerialStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BoardName string `protobuf:"bytes,1,opt,name=board_name,json=boardName,proto3" json:"board_name,omitempty"`
+	// Name of the serial port in the board's config
+	SerialName string `protobuf:"bytes,2,opt,name=serial_name,json=serialName,proto3" json:"serial_name,omitempty"`
+	// Baud rate to open the port with. 0 will use the port's configured baud rate
+	BaudRate uint32 `protobuf:"varint,3,opt,name=baud_rate,json=baudRate,proto3" json:"baud_rate,omitempty"`
+	// Bytes to write to the port
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	// Additional arguments to the method
+	Extra *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
+}
+
+func (x *SerialStreamRequest) Reset() {
+	*x = SerialStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_board_v1_board_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SerialStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SerialStreamRequest) ProtoMessage() {}
+
+func (x *SerialStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_board_v1_board_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SerialStreamRequest.ProtoReflect.Descriptor instead.
+func (*SerialStreamRequest) Descriptor() ([]byte, []int) {
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SerialStreamRequest) GetBoardName() string {
+	if x != nil {
+		return x.BoardName
+	}
+	return ""
+}
+
+func (x *SerialStreamRequest) GetSerialName() string {
+	if x != nil {
+		return x.SerialName
+	}
+	return ""
+}
+
+func (x *SerialStreamRequest) GetBaudRate() uint32 {
+	if x != nil {
+		return x.BaudRate
+	}
+	return 0
+}
+
+func (x *SerialStreamRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SerialStreamRequest) GetExtra() *structpb.Struct {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+type SerialStreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Bytes read from the port
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *SerialStreamResponse) Reset() {
+	*x = SerialStreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_board_v1_board_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SerialStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SerialStreamResponse) ProtoMessage() {}
+
+func (x *SerialStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_board_v1_board_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SerialStreamResponse.ProtoReflect.Descriptor instead.
+func (*SerialStreamResponse) Descriptor() ([]byte, []int) {
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SerialStreamResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SetPowerModeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name of board
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Requested power mode
+	PowerMode PowerMode `protobuf:"varint,2,opt,name=power_mode,json=powerMode,proto3,enum=viam.component.board.v1.PowerMode" json:"power_mode,omitempty"`
+	// Requested duration to stay in `power_mode`
+	Duration *durationpb.Duration `protobuf:"bytes,3,opt,name=duration,proto3,oneof" json:"duration,omitempty"`
+	// Events which return the board to `POWER_MODE_NORMAL` before `duration` elapses
+	WakeSources []*WakeSource `protobuf:"bytes,4,rep,name=wake_sources,json=wakeSources,proto3" json:"wake_sources,omitempty"`
+	// Additional arguments to the method
+	Extra *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
+}
+
+func (x *SetPowerModeRequest) Reset() {
+	*x = SetPowerModeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_board_v1_board_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPowerModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPowerModeRequest) ProtoMessage() {}
+
+func (x *SetPowerModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_board_v1_board_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPowerModeRequest.ProtoReflect.Descriptor instead.
+func (*SetPowerModeRequest) Descriptor() ([]byte, []int) {
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SetPowerModeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetPowerModeRequest) GetPowerMode() PowerMode {
+	if x != nil {
+		return x.PowerMode
+	}
+	return PowerMode_POWER_MODE_UNSPECIFIED
+}
+
+func (x *SetPowerModeRequest) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *SetPowerModeRequest) GetWakeSources() []*WakeSource {
+	if x != nil {
+		return x.WakeSources
+	}
+	return nil
+}
+
+func (x *SetPowerModeRequest) GetExtra() *structpb.Struct {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+type SetPowerModeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetPowerModeResponse) Reset() {
+	*x = SetPowerModeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_board_v1_board_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPowerModeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPowerModeResponse) ProtoMessage() {}
+
+func (x *SetPowerModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_board_v1_board_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPowerModeResponse.ProtoReflect.Descriptor instead.
+func (*SetPowerModeResponse) Descriptor() ([]byte, []int) {
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{33}
+}
+
+// WakeSource is an event which can wake a board from an offline power mode
+type WakeSource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Source:
+	//
+	//	*WakeSource_Gpio
+	//	*WakeSource_Rtc
+	//	*WakeSource_Analog
+	Source isWakeSource_Source `protobuf_oneof:"source"`
+}
+
+func (x *WakeSource) Reset() {
+	*x = WakeSource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_board_v1_board_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WakeSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WakeSource) ProtoMessage() {}
+
+func (x *WakeSource) ProtoReflect() protoreflect.Message {
+	mi := &file_component_board_v1_board_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WakeSource.ProtoReflect.Descriptor instead.
+func (*WakeSource) Descriptor() ([]byte, []int) {
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{34}
+}
+
+func (m *WakeSource) GetSource() isWakeSource_Source {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (x *WakeSource) GetGpio() *GPIOWakeSource {
+	if x, ok := x.GetSource().(*WakeSource_Gpio); ok {
+		return x.Gpio
+	}
+	return nil
+}
+
+func (x *WakeSource) GetRtc() *RTCWakeSource {
+	if x, ok := x.GetSource().(*WakeSource_Rtc); ok {
+		return x.Rtc
+	}
+	return nil
+}
+
+func (x *WakeSource) GetAnalog() *AnalogWakeSource {
+	if x, ok := x.GetSource().(*WakeSource_Analog); ok {
+		return x.Analog
+	}
+	return nil
+}
+
+type isWakeSource_Source interface {
+	isWakeSource_Source()
+}
+
+type WakeSource_Gpio struct {
+	Gpio *GPIOWakeSource `protobuf:"bytes,1,opt,name=gpio,proto3,oneof"`
+}
+
+type WakeSource_Rtc struct {
+	Rtc *RTCWakeSource `protobuf:"bytes,2,opt,name=rtc,proto3,oneof"`
+}
+
+type WakeSource_Analog struct {
+	Analog *AnalogWakeSource `protobuf:"bytes,3,opt,name=analog,proto3,oneof"`
+}
+
+func (*WakeSource_Gpio) isWakeSource_Source() {}
+
+func (*WakeSource_Rtc) isWakeSource_Source() {}
+
+func (*WakeSource_Analog) isWakeSource_Source() {}
+
+// Wakes the board on an edge of a GPIO pin
+type GPIOWakeSource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pin string `protobuf:"bytes,1,opt,name=pin,proto3" json:"pin,omitempty"`
+	// Which edges wake the board. Must not be unspecified
+	Edge Edge `protobuf:"varint,2,opt,name=edge,proto3,enum=viam.component.board.v1.Edge" json:"edge,omitempty"`
+}
+
+func (x *GPIOWakeSource) Reset() {
+	*x = GPIOWakeSource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_board_v1_board_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GPIOWakeSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GPIOWakeSource) ProtoMessage() {}
+
+func (x *GPIOWakeSource) ProtoReflect() protoreflect.Message {
+	mi := &file_component_board_v1_board_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GPIOWakeSource.ProtoReflect.Descriptor instead.
+func (*GPIOWakeSource) Descriptor() ([]byte, []int) {
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GPIOWakeSource) GetPin() string {
+	if x != nil {
+		return x.Pin
+	}
+	return ""
+}
+
+func (x *GPIOWakeSource) GetEdge() Edge {
+	if x != nil {
+		return x.Edge
+	}
+	return Edge_EDGE_UNSPECIFIED
+}
+
+// Wakes the board at a given time
+type RTCWakeSource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *RTCWakeSource) Reset() {
+	*x = RTCWakeSource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_board_v1_board_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RTCWakeSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RTCWakeSource) ProtoMessage() {}
+
+func (x *RTCWakeSource) ProtoReflect() protoreflect.Message {
+	mi := &file_component_board_v1_board_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RTCWakeSource.ProtoReflect.Descriptor instead.
+func (*RTCWakeSource) Descriptor() ([]byte, []int) {
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RTCWakeSource) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+// Wakes the board when the voltage of an analog reader crosses a threshold
+type AnalogWakeSource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AnalogReaderName string `protobuf:"bytes,1,opt,name=analog_reader_name,json=analogReaderName,proto3" json:"analog_reader_name,omitempty"`
+	// Threshold in volts
+	ThresholdVoltage float32 `protobuf:"fixed32,2,opt,name=threshold_voltage,json=thresholdVoltage,proto3" json:"threshold_voltage,omitempty"`
+	// If true, wakes when the voltage rises above the threshold, otherwise when it falls below it
+	Rising bool `protobuf:"varint,3,opt,name=rising,proto3" json:"rising,omitempty"`
+}
+
+func (x *AnalogWakeSource) Reset() {
+	*x = AnalogWakeSource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_board_v1_board_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalogWakeSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalogWakeSource) ProtoMessage() {}
+
+func (x *AnalogWakeSource) ProtoReflect() protoreflect.Message {
+	mi := &file_component_board_v1_board_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalogWakeSource.ProtoReflect.Descriptor instead.
+func (*AnalogWakeSource) Descriptor() ([]byte, []int) {
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AnalogWakeSource) GetAnalogReaderName() string {
+	if x != nil {
+		return x.AnalogReaderName
+	}
+	return ""
+}
+
+func (x *AnalogWakeSource) GetThresholdVoltage() float32 {
+	if x != nil {
+		return x.ThresholdVoltage
+	}
+	return 0
+}
+
+func (x *AnalogWakeSource) GetRising() bool {
+	if x != nil {
+		return x.Rising
+	}
+	return false
+}
+
+type GetPowerModeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name of board
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Additional arguments to the method
+	Extra *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
+}
+
+func (x *GetPowerModeRequest) Reset() {
+	*x = GetPowerModeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_board_v1_board_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPowerModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPowerModeRequest) ProtoMessage() {}
+
+func (x *GetPowerModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_board_v1_board_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPowerModeRequest.ProtoReflect.Descriptor instead.
+func (*GetPowerModeRequest) Descriptor() ([]byte, []int) {
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetPowerModeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetPowerModeRequest) GetExtra() *structpb.Struct {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+type GetPowerModeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Current power mode
+	PowerMode PowerMode `protobuf:"varint,1,opt,name=power_mode,json=powerMode,proto3,enum=viam.component.board.v1.PowerMode" json:"power_mode,omitempty"`
+	// Reason the board last returned to `POWER_MODE_NORMAL`
+	LastWakeReason WakeReason `protobuf:"varint,2,opt,name=last_wake_reason,json=lastWakeReason,proto3,enum=viam.component.board.v1.WakeReason" json:"last_wake_reason,omitempty"`
+	// The wake source which last woke the board, if the board was woken by one
+	LastWakeSource *WakeSource `protobuf:"bytes,3,opt,name=last_wake_source,json=lastWakeSource,proto3,oneof" json:"last_wake_source,omitempty"`
+	// Time at which the board last returned to `POWER_MODE_NORMAL`
+	LastWakeTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_wake_time,json=lastWakeTime,proto3" json:"last_wake_time,omitempty"`
+}
+
+func (x *GetPowerModeResponse) Reset() {
+	*x = GetPowerModeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_board_v1_board_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPowerModeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPowerModeResponse) ProtoMessage() {}
+
+func (x *GetPowerModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_board_v1_board_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPowerModeResponse.ProtoReflect.Descriptor instead.
+func (*GetPowerModeResponse) Descriptor() ([]byte, []int) {
+	return file_component_board_v1_board_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetPowerModeResponse) GetPowerMode() PowerMode {
+	if x != nil {
+		return x.PowerMode
+	}
+	return PowerMode_POWER_MODE_UNSPECIFIED
+}
+
+func (x *GetPowerModeResponse) GetLastWakeReason() WakeReason {
+	if x != nil {
+		return x.LastWakeReason
+	}
+	return WakeReason_WAKE_REASON_UNSPECIFIED
+}
+
+func (x *GetPowerModeResponse) GetLastWakeSource() *WakeSource {
+	if x != nil {
+		return x.LastWakeSource
+	}
+	return nil
+}
+
+func (x *GetPowerModeResponse) GetLastWakeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastWakeTime
+	}
+	return nil
+}
+
+var File_component_board_v1_board_proto protoreflect.FileDescriptor
+
+var file_component_board_v1_board_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x17, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x1a, 0x16, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
 	0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e,
 	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x52, 0x0a,
-	0x0d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72,
-	0x61, 0x22, 0x45, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x79, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x47,
-	0x50, 0x49, 0x4f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10,
-	0x0a, 0x03, 0x70, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x69, 0x6e,
-	0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x67, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
-	0x68, 0x69, 0x67, 0x68, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78,
-	0x74, 0x72, 0x61, 0x22, 0x11, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x47, 0x50, 0x49, 0x4f, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x65, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x47, 0x50, 0x49,
-	0x4f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03,
-	0x70, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x69, 0x6e, 0x12, 0x2d,
-	0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x25, 0x0a,
-	0x0f, 0x47, 0x65, 0x74, 0x47, 0x50, 0x49, 0x4f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x67, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
-	0x68, 0x69, 0x67, 0x68, 0x22, 0x61, 0x0a, 0x0a, 0x50, 0x57, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x69, 0x6e, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72,
-	0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
-	0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x33, 0x0a, 0x0b, 0x50, 0x57, 0x4d, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x64, 0x75, 0x74, 0x79, 0x5f, 0x63,
-	0x79, 0x63, 0x6c, 0x65, 0x5f, 0x70, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c,
-	0x64, 0x75, 0x74, 0x79, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x50, 0x63, 0x74, 0x22, 0x8a, 0x01, 0x0a,
-	0x0d, 0x53, 0x65, 0x74, 0x50, 0x57, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x70, 0x69, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x64, 0x75, 0x74, 0x79, 0x5f, 0x63, 0x79, 0x63,
-	0x6c, 0x65, 0x5f, 0x70, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x64, 0x75,
-	0x74, 0x79, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x50, 0x63, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78,
-	0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75,
-	0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x10, 0x0a, 0x0e, 0x53, 0x65, 0x74,
-	0x50, 0x57, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6a, 0x0a, 0x13, 0x50,
-	0x57, 0x4d, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x69, 0x6e, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72,
-	0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
-	0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x39, 0x0a, 0x14, 0x50, 0x57, 0x4d, 0x46, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x21, 0x0a, 0x0c, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x68, 0x7a, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79,
-	0x48, 0x7a, 0x22, 0x90, 0x01, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x50, 0x57, 0x4d, 0x46, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x70, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79,
-	0x5f, 0x68, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x66, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x6e, 0x63, 0x79, 0x48, 0x7a, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18,
-	0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05,
-	0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x19, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x50, 0x57, 0x4d, 0x46,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x95, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6e, 0x61, 0x6c, 0x6f, 0x67, 0x52,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x61,
-	0x6e, 0x61, 0x6c, 0x6f, 0x67, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x6e, 0x61, 0x6c, 0x6f, 0x67, 0x52,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74,
-	0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
-	0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x30, 0x0a, 0x18, 0x52, 0x65, 0x61, 0x64,
-	0x41, 0x6e, 0x61, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa5, 0x01, 0x0a, 0x1f, 0x47,
-	0x65, 0x74, 0x44, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x72, 0x75,
-	0x70, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a,
-	0x16, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x72, 0x75,
-	0x70, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x64,
-	0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x72, 0x75, 0x70, 0x74, 0x4e,
+	0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x52,
+	0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
 	0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74,
-	0x72, 0x61, 0x22, 0x38, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x44, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x72, 0x75, 0x70, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xe4, 0x01, 0x0a,
-	0x13, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
+	0x72, 0x61, 0x22, 0x45, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x79, 0x0a, 0x0e, 0x53, 0x65, 0x74,
+	0x47, 0x50, 0x49, 0x4f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x70, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x69,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x67, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x68, 0x69, 0x67, 0x68, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65,
+	0x78, 0x74, 0x72, 0x61, 0x22, 0x11, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x47, 0x50, 0x49, 0x4f, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x65, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x47, 0x50,
+	0x49, 0x4f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x70, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x69, 0x6e, 0x12,
+	0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x25,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x47, 0x50, 0x49, 0x4f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x67, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x68, 0x69, 0x67, 0x68, 0x22, 0xa2, 0x01, 0x0a, 0x16, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x47, 0x50, 0x49, 0x4f, 0x45, 0x64, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x69, 0x6e, 0x73, 0x12, 0x31, 0x0a, 0x04, 0x65, 0x64, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x64, 0x67, 0x65, 0x52, 0x04, 0x65, 0x64, 0x67, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x65,
+	0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x6f, 0x0a, 0x17, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x47, 0x50, 0x49, 0x4f, 0x45, 0x64, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x70, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x67, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x68, 0x69, 0x67, 0x68, 0x12, 0x2e, 0x0a, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x61, 0x0a, 0x0a, 0x50,
+	0x57, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x70, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x69, 0x6e, 0x12,
+	0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x33,
+	0x0a, 0x0b, 0x50, 0x57, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a,
+	0x0e, 0x64, 0x75, 0x74, 0x79, 0x5f, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x5f, 0x70, 0x63, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x64, 0x75, 0x74, 0x79, 0x43, 0x79, 0x63, 0x6c, 0x65,
+	0x50, 0x63, 0x74, 0x22, 0x8a, 0x01, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x50, 0x57, 0x4d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x69, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x64,
+	0x75, 0x74, 0x79, 0x5f, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x5f, 0x70, 0x63, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0c, 0x64, 0x75, 0x74, 0x79, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x50, 0x63,
+	0x74, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61,
+	0x22, 0x10, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x50, 0x57, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x6a, 0x0a, 0x13, 0x50, 0x57, 0x4d, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x70, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x69, 0x6e, 0x12,
+	0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x39,
+	0x0a, 0x14, 0x50, 0x57, 0x4d, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x68, 0x7a, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x66, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x48, 0x7a, 0x22, 0x90, 0x01, 0x0a, 0x16, 0x53, 0x65,
+	0x74, 0x50, 0x57, 0x4d, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x70, 0x6f, 0x77, 0x65,
-	0x72, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x76,
-	0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f,
-	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65,
-	0x52, 0x09, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x64,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61,
-	0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52,
-	0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x16, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4d,
-	0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x5b, 0x0a, 0x09, 0x50,
-	0x6f, 0x77, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x4f, 0x57, 0x45,
-	0x52, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x4d, 0x4f,
-	0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x50,
-	0x4f, 0x57, 0x45, 0x52, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4f, 0x46, 0x46, 0x4c, 0x49, 0x4e,
-	0x45, 0x5f, 0x44, 0x45, 0x45, 0x50, 0x10, 0x02, 0x32, 0xb5, 0x0f, 0x0a, 0x0c, 0x42, 0x6f, 0x61,
-	0x72, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x8d, 0x01, 0x0a, 0x06, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x76,
-	0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f,
-	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f,
-	0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f, 0x7b, 0x6e, 0x61, 0x6d,
-	0x65, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x8e, 0x01, 0x0a, 0x07, 0x53, 0x65,
-	0x74, 0x47, 0x50, 0x49, 0x4f, 0x12, 0x27, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x68, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x48, 0x7a, 0x12, 0x2d, 0x0a,
+	0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x19, 0x0a, 0x17,
+	0x53, 0x65, 0x74, 0x50, 0x57, 0x4d, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x95, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x61, 0x64,
+	0x41, 0x6e, 0x61, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x6e, 0x61, 0x6c, 0x6f, 0x67, 0x5f, 0x72, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x61, 0x6e, 0x61, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22,
+	0xd1, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6e, 0x61, 0x6c, 0x6f, 0x67, 0x52, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x6f, 0x6c, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x07, 0x76, 0x6f, 0x6c, 0x74, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x08, 0x6d, 0x69, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78,
+	0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x6d, 0x61,
+	0x78, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x73, 0x74, 0x65, 0x70, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x22, 0xec, 0x01, 0x0a, 0x1a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x6e,
+	0x61, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x6e, 0x61, 0x6c, 0x6f, 0x67, 0x5f, 0x72, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11,
+	0x61, 0x6e, 0x61, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x5f, 0x68, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x73, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x52, 0x61, 0x74, 0x65, 0x48, 0x7a, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x50, 0x65, 0x72, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74,
+	0x72, 0x61, 0x22, 0x5e, 0x0a, 0x1b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x6e, 0x61, 0x6c,
+	0x6f, 0x67, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3f, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x61,
+	0x6c, 0x6f, 0x67, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x73, 0x22, 0x9c, 0x01, 0x0a, 0x0c, 0x41, 0x6e, 0x61, 0x6c, 0x6f, 0x67, 0x53, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x6e, 0x61, 0x6c, 0x6f, 0x67, 0x5f, 0x72, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x61, 0x6e, 0x61, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x6f, 0x6c, 0x74, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x76, 0x6f, 0x6c, 0x74, 0x61, 0x67,
+	0x65, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x22, 0xa5, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x44, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x72, 0x75, 0x70, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x72, 0x75, 0x70, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x72, 0x75, 0x70, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x38, 0x0a, 0x20, 0x47, 0x65, 0x74,
+	0x44, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x72, 0x75, 0x70, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x9a, 0x01, 0x0a, 0x12, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x69,
+	0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x64, 0x69, 0x67,
+	0x69, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x72, 0x75, 0x70, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x64, 0x69, 0x67, 0x69,
+	0x74, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x72, 0x75, 0x70, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61,
+	0x22, 0xa5, 0x01, 0x0a, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x69, 0x63, 0x6b, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x64, 0x69, 0x67, 0x69,
+	0x74, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x72, 0x75, 0x70, 0x74, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61,
+	0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x72, 0x75, 0x70, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x69, 0x67, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x68, 0x69,
+	0x67, 0x68, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xdf, 0x01, 0x0a, 0x13, 0x49, 0x32, 0x43,
+	0x52, 0x65, 0x61, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x69, 0x32, 0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x69, 0x32, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x45, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x32, 0x43, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x65,
+	0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x56, 0x0a, 0x0c, 0x49, 0x32,
+	0x43, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x05, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x05, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0a, 0x72, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x42, 0x0b, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x2c, 0x0a, 0x14, 0x49, 0x32, 0x43, 0x52, 0x65, 0x61, 0x64, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65,
+	0x61, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x73,
+	0x22, 0xdf, 0x01, 0x0a, 0x12, 0x53, 0x50, 0x49, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x70, 0x69, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x70, 0x69, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x69, 0x70, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x69, 0x70, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x61, 0x75, 0x64, 0x5f, 0x68,
+	0x7a, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x62, 0x61, 0x75, 0x64, 0x48, 0x7a, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74,
+	0x72, 0x61, 0x22, 0x29, 0x0a, 0x13, 0x53, 0x50, 0x49, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xb5, 0x01,
+	0x0a, 0x13, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x75, 0x64, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x62, 0x61, 0x75, 0x64, 0x52, 0x61,
+	0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18,
+	0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05,
+	0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x2a, 0x0a, 0x14, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0xac, 0x02, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x41, 0x0a,
+	0x0a, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x22, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x77, 0x65,
+	0x72, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x3a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52,
+	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x0c,
+	0x77, 0x61, 0x6b, 0x65, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x6b,
+	0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0b, 0x77, 0x61, 0x6b, 0x65, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x16, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd6, 0x01, 0x0a, 0x0a, 0x57, 0x61, 0x6b,
+	0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x04, 0x67, 0x70, 0x69, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x50, 0x49, 0x4f, 0x57, 0x61, 0x6b, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00,
+	0x52, 0x04, 0x67, 0x70, 0x69, 0x6f, 0x12, 0x3a, 0x0a, 0x03, 0x72, 0x74, 0x63, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x54,
+	0x43, 0x57, 0x61, 0x6b, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x03, 0x72,
+	0x74, 0x63, 0x12, 0x43, 0x0a, 0x06, 0x61, 0x6e, 0x61, 0x6c, 0x6f, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x61,
+	0x6c, 0x6f, 0x67, 0x57, 0x61, 0x6b, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52,
+	0x06, 0x61, 0x6e, 0x61, 0x6c, 0x6f, 0x67, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x22, 0x55, 0x0a, 0x0e, 0x47, 0x50, 0x49, 0x4f, 0x57, 0x61, 0x6b, 0x65, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x70, 0x69, 0x6e, 0x12, 0x31, 0x0a, 0x04, 0x65, 0x64, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64,
+	0x67, 0x65, 0x52, 0x04, 0x65, 0x64, 0x67, 0x65, 0x22, 0x3f, 0x0a, 0x0d, 0x52, 0x54, 0x43, 0x57,
+	0x61, 0x6b, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x10, 0x41, 0x6e,
+	0x61, 0x6c, 0x6f, 0x67, 0x57, 0x61, 0x6b, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2c,
+	0x0a, 0x12, 0x61, 0x6e, 0x61, 0x6c, 0x6f, 0x67, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x6e, 0x61, 0x6c,
+	0x6f, 0x67, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x76, 0x6f, 0x6c, 0x74, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x10, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x56, 0x6f, 0x6c, 0x74, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x69, 0x73,
+	0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x69, 0x73, 0x69, 0x6e,
+	0x67, 0x22, 0x58, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4d, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x05,
+	0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0xd3, 0x02, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x6d, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x4d, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x77, 0x61, 0x6b, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x23, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x6b, 0x65,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x57, 0x61, 0x6b, 0x65,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x52, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x77,
+	0x61, 0x6b, 0x65, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x6b, 0x65, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x57, 0x61, 0x6b,
+	0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0e, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x77, 0x61, 0x6b, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c,
+	0x6c, 0x61, 0x73, 0x74, 0x57, 0x61, 0x6b, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x13, 0x0a, 0x11,
+	0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x77, 0x61, 0x6b, 0x65, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x2a, 0x4e, 0x0a, 0x04, 0x45, 0x64, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x44, 0x47,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x0f, 0x0a, 0x0b, 0x45, 0x44, 0x47, 0x45, 0x5f, 0x52, 0x49, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x01,
+	0x12, 0x10, 0x0a, 0x0c, 0x45, 0x44, 0x47, 0x45, 0x5f, 0x46, 0x41, 0x4c, 0x4c, 0x49, 0x4e, 0x47,
+	0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x45, 0x44, 0x47, 0x45, 0x5f, 0x42, 0x4f, 0x54, 0x48, 0x10,
+	0x03, 0x2a, 0x93, 0x01, 0x0a, 0x09, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x1a, 0x0a, 0x16, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x50,
+	0x4f, 0x57, 0x45, 0x52, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c,
+	0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
+	0x5f, 0x4f, 0x46, 0x46, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x44, 0x45, 0x45, 0x50, 0x10, 0x02, 0x12,
+	0x1c, 0x0a, 0x18, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4f, 0x46,
+	0x46, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x4c, 0x49, 0x47, 0x48, 0x54, 0x10, 0x03, 0x12, 0x18, 0x0a,
+	0x14, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x54, 0x48, 0x52, 0x4f,
+	0x54, 0x54, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x2a, 0xa0, 0x01, 0x0a, 0x0a, 0x57, 0x61, 0x6b, 0x65,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x17, 0x57, 0x41, 0x4b, 0x45, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x57, 0x41, 0x4b, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x18, 0x0a,
+	0x14, 0x57, 0x41, 0x4b, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x44, 0x55, 0x52,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x57, 0x41, 0x4b, 0x45, 0x5f,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x47, 0x50, 0x49, 0x4f, 0x10, 0x03, 0x12, 0x13, 0x0a,
+	0x0f, 0x57, 0x41, 0x4b, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x52, 0x54, 0x43,
+	0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x57, 0x41, 0x4b, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x41, 0x4e, 0x41, 0x4c, 0x4f, 0x47, 0x10, 0x05, 0x32, 0xf3, 0x18, 0x0a, 0x0c, 0x42,
+	0x6f, 0x61, 0x72, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x8d, 0x01, 0x0a, 0x06,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12,
+	0x2a, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f, 0x7b, 0x6e,
+	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x8e, 0x01, 0x0a, 0x07,
+	0x53, 0x65, 0x74, 0x47, 0x50, 0x49, 0x4f, 0x12, 0x27, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x74, 0x47, 0x50, 0x49, 0x4f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x28, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x47, 0x50,
+	0x49, 0x4f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x2a, 0x1a, 0x28, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x67, 0x70, 0x69, 0x6f, 0x12, 0x8e, 0x01, 0x0a,
+	0x07, 0x47, 0x65, 0x74, 0x47, 0x50, 0x49, 0x4f, 0x12, 0x27, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x50, 0x49, 0x4f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x28, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47,
+	0x50, 0x49, 0x4f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x67, 0x70, 0x69, 0x6f, 0x12, 0xae, 0x01,
+	0x0a, 0x0f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x47, 0x50, 0x49, 0x4f, 0x45, 0x64, 0x67, 0x65,
+	0x73, 0x12, 0x2f, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x47, 0x50, 0x49, 0x4f, 0x45, 0x64, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x30, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x47, 0x50, 0x49, 0x4f, 0x45, 0x64, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x12, 0x2e, 0x2f, 0x76,
+	0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65,
+	0x7d, 0x2f, 0x67, 0x70, 0x69, 0x6f, 0x2f, 0x65, 0x64, 0x67, 0x65, 0x73, 0x30, 0x01, 0x12, 0x81,
+	0x01, 0x0a, 0x03, 0x50, 0x57, 0x4d, 0x12, 0x23, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x57, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x76, 0x69,
+	0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x57, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x76, 0x69, 0x61, 0x6d,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x2f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x70,
+	0x77, 0x6d, 0x12, 0x8a, 0x01, 0x0a, 0x06, 0x53, 0x65, 0x74, 0x50, 0x57, 0x4d, 0x12, 0x26, 0x2e,
+	0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x57, 0x4d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d,
 	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x65, 0x74, 0x47, 0x50, 0x49, 0x4f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
-	0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e,
-	0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x47, 0x50, 0x49, 0x4f,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a,
-	0x1a, 0x28, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63,
-	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f, 0x7b,
-	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x67, 0x70, 0x69, 0x6f, 0x12, 0x8e, 0x01, 0x0a, 0x07, 0x47,
-	0x65, 0x74, 0x47, 0x50, 0x49, 0x4f, 0x12, 0x27, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f,
-	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x47, 0x50, 0x49, 0x4f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x28, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
-	0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x50, 0x49,
-	0x4f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x2a, 0x12, 0x28, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
-	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f,
-	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x67, 0x70, 0x69, 0x6f, 0x12, 0x81, 0x01, 0x0a, 0x03,
-	0x50, 0x57, 0x4d, 0x12, 0x23, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
-	0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x57,
-	0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e,
-	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x57, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70,
+	0x53, 0x65, 0x74, 0x50, 0x57, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x1a, 0x27, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70,
 	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x62,
 	0x6f, 0x61, 0x72, 0x64, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x70, 0x77, 0x6d, 0x12,
-	0x8a, 0x01, 0x0a, 0x06, 0x53, 0x65, 0x74, 0x50, 0x57, 0x4d, 0x12, 0x26, 0x2e, 0x76, 0x69, 0x61,
-	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72,
-	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x57, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
-	0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74,
-	0x50, 0x57, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x29, 0x1a, 0x27, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
-	0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x62, 0x6f, 0x61, 0x72,
-	0x64, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x70, 0x77, 0x6d, 0x12, 0xa1, 0x01, 0x0a,
-	0x0c, 0x50, 0x57, 0x4d, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x2c, 0x2e,
-	0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62,
-	0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x57, 0x4d, 0x46, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x76, 0x69,
-	0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61,
-	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x57, 0x4d, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0xa1, 0x01, 0x0a, 0x0c, 0x50, 0x57, 0x4d, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79,
+	0x12, 0x2c, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x57, 0x4d, 0x46, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d,
+	0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x57, 0x4d, 0x46, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x70, 0x77, 0x6d, 0x5f, 0x66,
+	0x72, 0x65, 0x71, 0x12, 0xaa, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x50, 0x57, 0x4d, 0x46, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x2f, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x57, 0x4d, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x57, 0x4d, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e,
 	0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x02, 0x2e, 0x1a, 0x2c, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
 	0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x62, 0x6f, 0x61, 0x72, 0x64,
 	0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x70, 0x77, 0x6d, 0x5f, 0x66, 0x72, 0x65, 0x71,
-	0x12, 0xaa, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x50, 0x57, 0x4d, 0x46, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x6e, 0x63, 0x79, 0x12, 0x2f, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x65, 0x74, 0x50, 0x57, 0x4d, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d,
-	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x65, 0x74, 0x50, 0x57, 0x4d, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x1a,
-	0x2c, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f,
-	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f, 0x7b, 0x6e,
-	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x70, 0x77, 0x6d, 0x5f, 0x66, 0x72, 0x65, 0x71, 0x12, 0x88, 0x01,
-	0x0a, 0x09, 0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x20, 0x2e, 0x76, 0x69,
-	0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x43,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
-	0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44,
-	0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x22, 0x2e, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
-	0x2f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x6f,
-	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0xd2, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x61,
-	0x64, 0x41, 0x6e, 0x61, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x30, 0x2e,
+	0x12, 0x88, 0x01, 0x0a, 0x09, 0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x20,
+	0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x22, 0x2e, 0x2f, 0x76, 0x69,
+	0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x2f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
+	0x2f, 0x64, 0x6f, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0xd2, 0x01, 0x0a, 0x10,
+	0x52, 0x65, 0x61, 0x64, 0x41, 0x6e, 0x61, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x30, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x41,
+	0x6e, 0x61, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x31, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61,
+	0x64, 0x41, 0x6e, 0x61, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x59, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x53, 0x12, 0x51, 0x2f,
+	0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f, 0x7b, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x61, 0x6e, 0x61, 0x6c, 0x6f, 0x67, 0x5f,
+	0x72, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2f, 0x7b, 0x61, 0x6e, 0x61, 0x6c, 0x6f, 0x67, 0x5f, 0x72,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x65, 0x61, 0x64,
+	0x12, 0xca, 0x01, 0x0a, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x6e, 0x61, 0x6c, 0x6f,
+	0x67, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x33, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x6e, 0x61, 0x6c, 0x6f, 0x67, 0x52,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e,
 	0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62,
-	0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6e, 0x61, 0x6c,
-	0x6f, 0x67, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x31, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
-	0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6e,
-	0x61, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x59, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x53, 0x12, 0x51, 0x2f, 0x76, 0x69, 0x61,
-	0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
-	0x6e, 0x74, 0x2f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f, 0x7b, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x61, 0x6e, 0x61, 0x6c, 0x6f, 0x67, 0x5f, 0x72, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x2f, 0x7b, 0x61, 0x6e, 0x61, 0x6c, 0x6f, 0x67, 0x5f, 0x72, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x65, 0x61, 0x64, 0x12, 0xf3, 0x01,
+	0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x6e,
+	0x61, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x46, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x40, 0x12, 0x3e, 0x2f, 0x76, 0x69,
+	0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x2f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f, 0x7b, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x61, 0x6e, 0x61, 0x6c, 0x6f, 0x67, 0x5f, 0x72, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x30, 0x01, 0x12, 0xf3, 0x01,
 	0x0a, 0x18, 0x47, 0x65, 0x74, 0x44, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65,
 	0x72, 0x72, 0x75, 0x70, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x38, 0x2e, 0x76, 0x69, 0x61,
 	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72,
@@ -1408,14 +3205,66 @@ var file_component_board_v1_board_proto_rawDesc = []byte{
 	0x65, 0x7d, 0x2f, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
 	0x72, 0x75, 0x70, 0x74, 0x2f, 0x7b, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x6e,
 	0x74, 0x65, 0x72, 0x72, 0x75, 0x70, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0xa3, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72,
+	0x6c, 0x75, 0x65, 0x12, 0xb5, 0x01, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x69,
+	0x63, 0x6b, 0x73, 0x12, 0x2b, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x54, 0x69, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2c, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x54, 0x69, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x43, 0x12, 0x41, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x2f, 0x7b, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x7d, 0x2f, 0x64, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x72,
+	0x75, 0x70, 0x74, 0x2f, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x30, 0x01, 0x12, 0xb8, 0x01, 0x0a, 0x0c,
+	0x49, 0x32, 0x43, 0x52, 0x65, 0x61, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x2c, 0x2e, 0x76,
+	0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x32, 0x43, 0x52, 0x65, 0x61, 0x64, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x76, 0x69, 0x61,
+	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x32, 0x43, 0x52, 0x65, 0x61, 0x64, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x45, 0x22, 0x43, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f,
+	0x7b, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x69, 0x32, 0x63,
+	0x2f, 0x7b, 0x69, 0x32, 0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x65, 0x61, 0x64,
+	0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0xb3, 0x01, 0x0a, 0x0b, 0x53, 0x50, 0x49, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x2b, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x50, 0x49, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x50,
+	0x49, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x49, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x43, 0x22, 0x41, 0x2f, 0x76, 0x69, 0x61, 0x6d,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x2f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f, 0x7b, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x70, 0x69, 0x2f, 0x7b, 0x73, 0x70, 0x69, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x7d, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x6f, 0x0a, 0x0c,
+	0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x2c, 0x2e, 0x76,
+	0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x76, 0x69, 0x61,
+	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0xa3, 0x01,
+	0x0a, 0x0c, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x2c,
+	0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65,
+	0x72, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x76,
+	0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4d,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x30, 0x1a, 0x2e, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x12, 0xa3, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72,
 	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x2c, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47,
 	0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
-	0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
 	0x50, 0x6f, 0x77, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x1a, 0x2e, 0x2f, 0x76, 0x69, 0x61, 0x6d,
+	0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x12, 0x2e, 0x2f, 0x76, 0x69, 0x61, 0x6d,
 	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
 	0x74, 0x2f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x70,
 	0x6f, 0x77, 0x65, 0x72, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x94, 0x01, 0x0a, 0x0d, 0x47, 0x65,
@@ -1447,81 +3296,142 @@ func file_component_board_v1_board_proto_rawDescGZIP() []byte {
 	return file_component_board_v1_board_proto_rawDescData
 }
 
-var file_component_board_v1_board_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_component_board_v1_board_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_component_board_v1_board_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_component_board_v1_board_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
 var file_component_board_v1_board_proto_goTypes = []interface{}{
-	(PowerMode)(0),                           // 0: viam.component.board.v1.PowerMode
-	(*StatusRequest)(nil),                    // 1: viam.component.board.v1.StatusRequest
-	(*StatusResponse)(nil),                   // 2: viam.component.board.v1.StatusResponse
-	(*SetGPIORequest)(nil),                   // 3: viam.component.board.v1.SetGPIORequest
-	(*SetGPIOResponse)(nil),                  // 4: viam.component.board.v1.SetGPIOResponse
-	(*GetGPIORequest)(nil),                   // 5: viam.component.board.v1.GetGPIORequest
-	(*GetGPIOResponse)(nil),                  // 6: viam.component.board.v1.GetGPIOResponse
-	(*PWMRequest)(nil),                       // 7: viam.component.board.v1.PWMRequest
-	(*PWMResponse)(nil),                      // 8: viam.component.board.v1.PWMResponse
-	(*SetPWMRequest)(nil),                    // 9: viam.component.board.v1.SetPWMRequest
-	(*SetPWMResponse)(nil),                   // 10: viam.component.board.v1.SetPWMResponse
-	(*PWMFrequencyRequest)(nil),              // 11: viam.component.board.v1.PWMFrequencyRequest
-	(*PWMFrequencyResponse)(nil),             // 12: viam.component.board.v1.PWMFrequencyResponse
-	(*SetPWMFrequencyRequest)(nil),           // 13: viam.component.board.v1.SetPWMFrequencyRequest
-	(*SetPWMFrequencyResponse)(nil),          // 14: viam.component.board.v1.SetPWMFrequencyResponse
-	(*ReadAnalogReaderRequest)(nil),          // 15: viam.component.board.v1.ReadAnalogReaderRequest
-	(*ReadAnalogReaderResponse)(nil),         // 16: viam.component.board.v1.ReadAnalogReaderResponse
-	(*GetDigitalInterruptValueRequest)(nil),  // 17: viam.component.board.v1.GetDigitalInterruptValueRequest
-	(*GetDigitalInterruptValueResponse)(nil), // 18: viam.component.board.v1.GetDigitalInterruptValueResponse
-	(*SetPowerModeRequest)(nil),              // 19: viam.component.board.v1.SetPowerModeRequest
-	(*SetPowerModeResponse)(nil),             // 20: viam.component.board.v1.SetPowerModeResponse
-	(*structpb.Struct)(nil),                  // 21: google.protobuf.Struct
-	(*v1.BoardStatus)(nil),                   // 22: viam.common.v1.BoardStatus
-	(*durationpb.Duration)(nil),              // 23: google.protobuf.Duration
-	(*v1.DoCommandRequest)(nil),              // 24: viam.common.v1.DoCommandRequest
-	(*v1.GetGeometriesRequest)(nil),          // 25: viam.common.v1.GetGeometriesRequest
-	(*v1.DoCommandResponse)(nil),             // 26: viam.common.v1.DoCommandResponse
-	(*v1.GetGeometriesResponse)(nil),         // 27: viam.common.v1.GetGeometriesResponse
+	(Edge)(0),                                // 0: viam.component.board.v1.Edge
+	(PowerMode)(0),                           // 1: viam.component.board.v1.PowerMode
+	(WakeReason)(0),                          // 2: viam.component.board.v1.WakeReason
+	(*StatusRequest)(nil),                    // 3: viam.component.board.v1.StatusRequest
+	(*StatusResponse)(nil),                   // 4: viam.component.board.v1.StatusResponse
+	(*SetGPIORequest)(nil),                   // 5: viam.component.board.v1.SetGPIORequest
+	(*SetGPIOResponse)(nil),                  // 6: viam.component.board.v1.SetGPIOResponse
+	(*GetGPIORequest)(nil),                   // 7: viam.component.board.v1.GetGPIORequest
+	(*GetGPIOResponse)(nil),                  // 8: viam.component.board.v1.GetGPIOResponse
+	(*StreamGPIOEdgesRequest)(nil),           // 9: viam.component.board.v1.StreamGPIOEdgesRequest
+	(*StreamGPIOEdgesResponse)(nil),          // 10: viam.component.board.v1.StreamGPIOEdgesResponse
+	(*PWMRequest)(nil),                       // 11: viam.component.board.v1.PWMRequest
+	(*PWMResponse)(nil),                      // 12: viam.component.board.v1.PWMResponse
+	(*SetPWMRequest)(nil),                    // 13: viam.component.board.v1.SetPWMRequest
+	(*SetPWMResponse)(nil),                   // 14: viam.component.board.v1.SetPWMResponse
+	(*PWMFrequencyRequest)(nil),              // 15: viam.component.board.v1.PWMFrequencyRequest
+	(*PWMFrequencyResponse)(nil),             // 16: viam.component.board.v1.PWMFrequencyResponse
+	(*SetPWMFrequencyRequest)(nil),           // 17: viam.component.board.v1.SetPWMFrequencyRequest
+	(*SetPWMFrequencyResponse)(nil),          // 18: viam.component.board.v1.SetPWMFrequencyResponse
+	(*ReadAnalogReaderRequest)(nil),          // 19: viam.component.board.v1.ReadAnalogReaderRequest
+	(*ReadAnalogReaderResponse)(nil),         // 20: viam.component.board.v1.ReadAnalogReaderResponse
+	(*StreamAnalogReadersRequest)(nil),       // 21: viam.component.board.v1.StreamAnalogReadersRequest
+	(*StreamAnalogReadersResponse)(nil),      // 22: viam.component.board.v1.StreamAnalogReadersResponse
+	(*AnalogSample)(nil),                     // 23: viam.component.board.v1.AnalogSample
+	(*GetDigitalInterruptValueRequest)(nil),  // 24: viam.component.board.v1.GetDigitalInterruptValueRequest
+	(*GetDigitalInterruptValueResponse)(nil), // 25: viam.component.board.v1.GetDigitalInterruptValueResponse
+	(*StreamTicksRequest)(nil),               // 26: viam.component.board.v1.StreamTicksRequest
+	(*StreamTicksResponse)(nil),              // 27: viam.component.board.v1.StreamTicksResponse
+	(*I2CReadWriteRequest)(nil),              // 28: viam.component.board.v1.I2CReadWriteRequest
+	(*I2COperation)(nil),                     // 29: viam.component.board.v1.I2COperation
+	(*I2CReadWriteResponse)(nil),             // 30: viam.component.board.v1.I2CReadWriteResponse
+	(*SPITransferRequest)(nil),               // 31: viam.component.board.v1.SPITransferRequest
+	(*SPITransferResponse)(nil),              // 32: viam.component.board.v1.SPITransferResponse
+	(*SerialStreamRequest)(nil),              // 33: viam.component.board.v1.SerialStreamRequest
+	(*SerialStreamResponse)(nil),             // 34: viam.component.board.v1.SerialStreamResponse
+	(*SetPowerModeRequest)(nil),              // 35: viam.component.board.v1.SetPowerModeRequest
+	(*SetPowerModeResponse)(nil),             // 36: viam.component.board.v1.SetPowerModeResponse
+	(*WakeSource)(nil),                       // 37: viam.component.board.v1.WakeSource
+	(*GPIOWakeSource)(nil),                   // 38: viam.component.board.v1.GPIOWakeSource
+	(*RTCWakeSource)(nil),                    // 39: viam.component.board.v1.RTCWakeSource
+	(*AnalogWakeSource)(nil),                 // 40: viam.component.board.v1.AnalogWakeSource
+	(*GetPowerModeRequest)(nil),              // 41: viam.component.board.v1.GetPowerModeRequest
+	(*GetPowerModeResponse)(nil),             // 42: viam.component.board.v1.GetPowerModeResponse
+	(*structpb.Struct)(nil),                  // 43: google.protobuf.Struct
+	(*v1.BoardStatus)(nil),                   // 44: viam.common.v1.BoardStatus
+	(*timestamppb.Timestamp)(nil),            // 45: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),              // 46: google.protobuf.Duration
+	(*v1.DoCommandRequest)(nil),              // 47: viam.common.v1.DoCommandRequest
+	(*v1.GetGeometriesRequest)(nil),          // 48: viam.common.v1.GetGeometriesRequest
+	(*v1.DoCommandResponse)(nil),             // 49: viam.common.v1.DoCommandResponse
+	(*v1.GetGeometriesResponse)(nil),         // 50: viam.common.v1.GetGeometriesResponse
 }
 var file_component_board_v1_board_proto_depIdxs = []int32{
-	21, // 0: viam.component.board.v1.StatusRequest.extra:type_name -> google.protobuf.Struct
-	22, // 1: viam.component.board.v1.StatusResponse.status:type_name -> viam.common.v1.BoardStatus
-	21, // 2: viam.component.board.v1.SetGPIORequest.extra:type_name -> google.protobuf.Struct
-	21, // 3: viam.component.board.v1.GetGPIORequest.extra:type_name -> google.protobuf.Struct
-	21, // 4: viam.component.board.v1.PWMRequest.extra:type_name -> google.protobuf.Struct
-	21, // 5: viam.component.board.v1.SetPWMRequest.extra:type_name -> google.protobuf.Struct
-	21, // 6: viam.component.board.v1.PWMFrequencyRequest.extra:type_name -> google.protobuf.Struct
-	21, // 7: viam.component.board.v1.SetPWMFrequencyRequest.extra:type_name -> google.protobuf.Struct
-	21, // 8: viam.component.board.v1.ReadAnalogReaderRequest.extra:type_name -> google.protobuf.Struct
-	21, // 9: viam.component.board.v1.GetDigitalInterruptValueRequest.extra:type_name -> google.protobuf.Struct
-	0,  // 10: viam.component.board.v1.SetPowerModeRequest.power_mode:type_name -> viam.component.board.v1.PowerMode
-	23, // 11: viam.component.board.v1.SetPowerModeRequest.duration:type_name -> google.protobuf.Duration
-	21, // 12: viam.component.board.v1.SetPowerModeRequest.extra:type_name -> google.protobuf.Struct
-	1,  // 13: viam.component.board.v1.BoardService.Status:input_type -> viam.component.board.v1.StatusRequest
-	3,  // 14: viam.component.board.v1.BoardService.SetGPIO:input_type -> viam.component.board.v1.SetGPIORequest
-	5,  // 15: viam.component.board.v1.BoardService.GetGPIO:input_type -> viam.component.board.v1.GetGPIORequest
-	7,  // 16: viam.component.board.v1.BoardService.PWM:input_type -> viam.component.board.v1.PWMRequest
-	9,  // 17: viam.component.board.v1.BoardService.SetPWM:input_type -> viam.component.board.v1.SetPWMRequest
-	11, // 18: viam.component.board.v1.BoardService.PWMFrequency:input_type -> viam.component.board.v1.PWMFrequencyRequest
-	13, // 19: viam.component.board.v1.BoardService.SetPWMFrequency:input_type -> viam.component.board.v1.SetPWMFrequencyRequest
-	24, // 20: viam.component.board.v1.BoardService.DoCommand:input_type -> viam.common.v1.DoCommandRequest
-	15, // 21: viam.component.board.v1.BoardService.ReadAnalogReader:input_type -> viam.component.board.v1.ReadAnalogReaderRequest
-	17, // 22: viam.component.board.v1.BoardService.GetDigitalInterruptValue:input_type -> viam.component.board.v1.GetDigitalInterruptValueRequest
-	19, // 23: viam.component.board.v1.BoardService.SetPowerMode:input_type -> viam.component.board.v1.SetPowerModeRequest
-	25, // 24: viam.component.board.v1.BoardService.GetGeometries:input_type -> viam.common.v1.GetGeometriesRequest
-	2,  // 25: viam.component.board.v1.BoardService.Status:output_type -> viam.component.board.v1.StatusResponse
-	4,  // 26: viam.component.board.v1.BoardService.SetGPIO:output_type -> viam.component.board.v1.SetGPIOResponse
-	6,  // 27: viam.component.board.v1.BoardService.GetGPIO:output_type -> viam.component.board.v1.GetGPIOResponse
-	8,  // 28: viam.component.board.v1.BoardService.PWM:output_type -> viam.component.board.v1.PWMResponse
-	10, // 29: viam.component.board.v1.BoardService.SetPWM:output_type -> viam.component.board.v1.SetPWMResponse
-	12, // 30: viam.component.board.v1.BoardService.PWMFrequency:output_type -> viam.component.board.v1.PWMFrequencyResponse
-	14, // 31: viam.component.board.v1.BoardService.SetPWMFrequency:output_type -> viam.component.board.v1.SetPWMFrequencyResponse
-	26, // 32: viam.component.board.v1.BoardService.DoCommand:output_type -> viam.common.v1.DoCommandResponse
-	16, // 33: viam.component.board.v1.BoardService.ReadAnalogReader:output_type -> viam.component.board.v1.ReadAnalogReaderResponse
-	18, // 34: viam.component.board.v1.BoardService.GetDigitalInterruptValue:output_type -> viam.component.board.v1.GetDigitalInterruptValueResponse
-	20, // 35: viam.component.board.v1.BoardService.SetPowerMode:output_type -> viam.component.board.v1.SetPowerModeResponse
-	27, // 36: viam.component.board.v1.BoardService.GetGeometries:output_type -> viam.common.v1.GetGeometriesResponse
-	25, // [25:37] is the sub-list for method output_type
-	13, // [13:25] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	43, // 0: viam.component.board.v1.StatusRequest.extra:type_name -> google.protobuf.Struct
+	44, // 1: viam.component.board.v1.StatusResponse.status:type_name -> viam.common.v1.BoardStatus
+	43, // 2: viam.component.board.v1.SetGPIORequest.extra:type_name -> google.protobuf.Struct
+	43, // 3: viam.component.board.v1.GetGPIORequest.extra:type_name -> google.protobuf.Struct
+	0,  // 4: viam.component.board.v1.StreamGPIOEdgesRequest.edge:type_name -> viam.component.board.v1.Edge
+	43, // 5: viam.component.board.v1.StreamGPIOEdgesRequest.extra:type_name -> google.protobuf.Struct
+	45, // 6: viam.component.board.v1.StreamGPIOEdgesResponse.time:type_name -> google.protobuf.Timestamp
+	43, // 7: viam.component.board.v1.PWMRequest.extra:type_name -> google.protobuf.Struct
+	43, // 8: viam.component.board.v1.SetPWMRequest.extra:type_name -> google.protobuf.Struct
+	43, // 9: viam.component.board.v1.PWMFrequencyRequest.extra:type_name -> google.protobuf.Struct
+	43, // 10: viam.component.board.v1.SetPWMFrequencyRequest.extra:type_name -> google.protobuf.Struct
+	43, // 11: viam.component.board.v1.ReadAnalogReaderRequest.extra:type_name -> google.protobuf.Struct
+	45, // 12: viam.component.board.v1.ReadAnalogReaderResponse.time:type_name -> google.protobuf.Timestamp
+	43, // 13: viam.component.board.v1.StreamAnalogReadersRequest.extra:type_name -> google.protobuf.Struct
+	23, // 14: viam.component.board.v1.StreamAnalogReadersResponse.samples:type_name -> viam.component.board.v1.AnalogSample
+	45, // 15: viam.component.board.v1.AnalogSample.time:type_name -> google.protobuf.Timestamp
+	43, // 16: viam.component.board.v1.GetDigitalInterruptValueRequest.extra:type_name -> google.protobuf.Struct
+	43, // 17: viam.component.board.v1.StreamTicksRequest.extra:type_name -> google.protobuf.Struct
+	45, // 18: viam.component.board.v1.StreamTicksResponse.time:type_name -> google.protobuf.Timestamp
+	29, // 19: viam.component.board.v1.I2CReadWriteRequest.operations:type_name -> viam.component.board.v1.I2COperation
+	43, // 20: viam.component.board.v1.I2CReadWriteRequest.extra:type_name -> google.protobuf.Struct
+	43, // 21: viam.component.board.v1.SPITransferRequest.extra:type_name -> google.protobuf.Struct
+	43, // 22: viam.component.board.v1.SerialStreamRequest.extra:type_name -> google.protobuf.Struct
+	1,  // 23: viam.component.board.v1.SetPowerModeRequest.power_mode:type_name -> viam.component.board.v1.PowerMode
+	46, // 24: viam.component.board.v1.SetPowerModeRequest.duration:type_name -> google.protobuf.Duration
+	37, // 25: viam.component.board.v1.SetPowerModeRequest.wake_sources:type_name -> viam.component.board.v1.WakeSource
+	43, // 26: viam.component.board.v1.SetPowerModeRequest.extra:type_name -> google.protobuf.Struct
+	38, // 27: viam.component.board.v1.WakeSource.gpio:type_name -> viam.component.board.v1.GPIOWakeSource
+	39, // 28: viam.component.board.v1.WakeSource.rtc:type_name -> viam.component.board.v1.RTCWakeSource
+	40, // 29: viam.component.board.v1.WakeSource.analog:type_name -> viam.component.board.v1.AnalogWakeSource
+	0,  // 30: viam.component.board.v1.GPIOWakeSource.edge:type_name -> viam.component.board.v1.Edge
+	45, // 31: viam.component.board.v1.RTCWakeSource.time:type_name -> google.protobuf.Timestamp
+	43, // 32: viam.component.board.v1.GetPowerModeRequest.extra:type_name -> google.protobuf.Struct
+	1,  // 33: viam.component.board.v1.GetPowerModeResponse.power_mode:type_name -> viam.component.board.v1.PowerMode
+	2,  // 34: viam.component.board.v1.GetPowerModeResponse.last_wake_reason:type_name -> viam.component.board.v1.WakeReason
+	37, // 35: viam.component.board.v1.GetPowerModeResponse.last_wake_source:type_name -> viam.component.board.v1.WakeSource
+	45, // 36: viam.component.board.v1.GetPowerModeResponse.last_wake_time:type_name -> google.protobuf.Timestamp
+	3,  // 37: viam.component.board.v1.BoardService.Status:input_type -> viam.component.board.v1.StatusRequest
+	5,  // 38: viam.component.board.v1.BoardService.SetGPIO:input_type -> viam.component.board.v1.SetGPIORequest
+	7,  // 39: viam.component.board.v1.BoardService.GetGPIO:input_type -> viam.component.board.v1.GetGPIORequest
+	9,  // 40: viam.component.board.v1.BoardService.StreamGPIOEdges:input_type -> viam.component.board.v1.StreamGPIOEdgesRequest
+	11, // 41: viam.component.board.v1.BoardService.PWM:input_type -> viam.component.board.v1.PWMRequest
+	13, // 42: viam.component.board.v1.BoardService.SetPWM:input_type -> viam.component.board.v1.SetPWMRequest
+	15, // 43: viam.component.board.v1.BoardService.PWMFrequency:input_type -> viam.component.board.v1.PWMFrequencyRequest
+	17, // 44: viam.component.board.v1.BoardService.SetPWMFrequency:input_type -> viam.component.board.v1.SetPWMFrequencyRequest
+	47, // 45: viam.component.board.v1.BoardService.DoCommand:input_type -> viam.common.v1.DoCommandRequest
+	19, // 46: viam.component.board.v1.BoardService.ReadAnalogReader:input_type -> viam.component.board.v1.ReadAnalogReaderRequest
+	21, // 47: viam.component.board.v1.BoardService.StreamAnalogReaders:input_type -> viam.component.board.v1.StreamAnalogReadersRequest
+	24, // 48: viam.component.board.v1.BoardService.GetDigitalInterruptValue:input_type -> viam.component.board.v1.GetDigitalInterruptValueRequest
+	26, // 49: viam.component.board.v1.BoardService.StreamTicks:input_type -> viam.component.board.v1.StreamTicksRequest
+	28, // 50: viam.component.board.v1.BoardService.I2CReadWrite:input_type -> viam.component.board.v1.I2CReadWriteRequest
+	31, // 51: viam.component.board.v1.BoardService.SPITransfer:input_type -> viam.component.board.v1.SPITransferRequest
+	33, // 52: viam.component.board.v1.BoardService.SerialStream:input_type -> viam.component.board.v1.SerialStreamRequest
+	35, // 53: viam.component.board.v1.BoardService.SetPowerMode:input_type -> viam.component.board.v1.SetPowerModeRequest
+	41, // 54: viam.component.board.v1.BoardService.GetPowerMode:input_type -> viam.component.board.v1.GetPowerModeRequest
+	48, // 55: viam.component.board.v1.BoardService.GetGeometries:input_type -> viam.common.v1.GetGeometriesRequest
+	4,  // 56: viam.component.board.v1.BoardService.Status:output_type -> viam.component.board.v1.StatusResponse
+	6,  // 57: viam.component.board.v1.BoardService.SetGPIO:output_type -> viam.component.board.v1.SetGPIOResponse
+	8,  // 58: viam.component.board.v1.BoardService.GetGPIO:output_type -> viam.component.board.v1.GetGPIOResponse
+	10, // 59: viam.component.board.v1.BoardService.StreamGPIOEdges:output_type -> viam.component.board.v1.StreamGPIOEdgesResponse
+	12, // 60: viam.component.board.v1.BoardService.PWM:output_type -> viam.component.board.v1.PWMResponse
+	14, // 61: viam.component.board.v1.BoardService.SetPWM:output_type -> viam.component.board.v1.SetPWMResponse
+	16, // 62: viam.component.board.v1.BoardService.PWMFrequency:output_type -> viam.component.board.v1.PWMFrequencyResponse
+	18, // 63: viam.component.board.v1.BoardService.SetPWMFrequency:output_type -> viam.component.board.v1.SetPWMFrequencyResponse
+	49, // 64: viam.component.board.v1.BoardService.DoCommand:output_type -> viam.common.v1.DoCommandResponse
+	20, // 65: viam.component.board.v1.BoardService.ReadAnalogReader:output_type -> viam.component.board.v1.ReadAnalogReaderResponse
+	22, // 66: viam.component.board.v1.BoardService.StreamAnalogReaders:output_type -> viam.component.board.v1.StreamAnalogReadersResponse
+	25, // 67: viam.component.board.v1.BoardService.GetDigitalInterruptValue:output_type -> viam.component.board.v1.GetDigitalInterruptValueResponse
+	27, // 68: viam.component.board.v1.BoardService.StreamTicks:output_type -> viam.component.board.v1.StreamTicksResponse
+	30, // 69: viam.component.board.v1.BoardService.I2CReadWrite:output_type -> viam.component.board.v1.I2CReadWriteResponse
+	32, // 70: viam.component.board.v1.BoardService.SPITransfer:output_type -> viam.component.board.v1.SPITransferResponse
+	34, // 71: viam.component.board.v1.BoardService.SerialStream:output_type -> viam.component.board.v1.SerialStreamResponse
+	36, // 72: viam.component.board.v1.BoardService.SetPowerMode:output_type -> viam.component.board.v1.SetPowerModeResponse
+	42, // 73: viam.component.board.v1.BoardService.GetPowerMode:output_type -> viam.component.board.v1.GetPowerModeResponse
+	50, // 74: viam.component.board.v1.BoardService.GetGeometries:output_type -> viam.common.v1.GetGeometriesResponse
+	56, // [56:75] is the sub-list for method output_type
+	37, // [37:56] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_component_board_v1_board_proto_init() }
@@ -1603,7 +3513,7 @@ func file_component_board_v1_board_proto_init() {
 			}
 		}
 		file_component_board_v1_board_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PWMRequest); i {
+			switch v := v.(*StreamGPIOEdgesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1615,7 +3525,7 @@ func file_component_board_v1_board_proto_init() {
 			}
 		}
 		file_component_board_v1_board_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PWMResponse); i {
+			switch v := v.(*StreamGPIOEdgesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1627,7 +3537,7 @@ func file_component_board_v1_board_proto_init() {
 			}
 		}
 		file_component_board_v1_board_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetPWMRequest); i {
+			switch v := v.(*PWMRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1639,7 +3549,7 @@ func file_component_board_v1_board_proto_init() {
 			}
 		}
 		file_component_board_v1_board_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetPWMResponse); i {
+			switch v := v.(*PWMResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1651,7 +3561,7 @@ func file_component_board_v1_board_proto_init() {
 			}
 		}
 		file_component_board_v1_board_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PWMFrequencyRequest); i {
+			switch v := v.(*SetPWMRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1663,7 +3573,7 @@ func file_component_board_v1_board_proto_init() {
 			}
 		}
 		file_component_board_v1_board_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PWMFrequencyResponse); i {
+			switch v := v.(*SetPWMResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1675,7 +3585,7 @@ func file_component_board_v1_board_proto_init() {
 			}
 		}
 		file_component_board_v1_board_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetPWMFrequencyRequest); i {
+			switch v := v.(*PWMFrequencyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1687,7 +3597,7 @@ func file_component_board_v1_board_proto_init() {
 			}
 		}
 		file_component_board_v1_board_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetPWMFrequencyResponse); i {
+			switch v := v.(*PWMFrequencyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1699,7 +3609,7 @@ func file_component_board_v1_board_proto_init() {
 			}
 		}
 		file_component_board_v1_board_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadAnalogReaderRequest); i {
+			switch v := v.(*SetPWMFrequencyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1711,7 +3621,7 @@ func file_component_board_v1_board_proto_init() {
 			}
 		}
 		file_component_board_v1_board_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadAnalogReaderResponse); i {
+			switch v := v.(*SetPWMFrequencyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1723,7 +3633,7 @@ func file_component_board_v1_board_proto_init() {
 			}
 		}
 		file_component_board_v1_board_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDigitalInterruptValueRequest); i {
+			switch v := v.(*ReadAnalogReaderRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1735,7 +3645,7 @@ func file_component_board_v1_board_proto_init() {
 			}
 		}
 		file_component_board_v1_board_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDigitalInterruptValueResponse); i {
+			switch v := v.(*ReadAnalogReaderResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1747,7 +3657,7 @@ func file_component_board_v1_board_proto_init() {
 			}
 		}
 		file_component_board_v1_board_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetPowerModeRequest); i {
+			switch v := v.(*StreamAnalogReadersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1759,6 +3669,174 @@ func file_component_board_v1_board_proto_init() {
 			}
 		}
 		file_component_board_v1_board_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamAnalogReadersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_board_v1_board_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnalogSample); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_board_v1_board_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDigitalInterruptValueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_board_v1_board_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDigitalInterruptValueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_board_v1_board_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamTicksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_board_v1_board_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamTicksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_board_v1_board_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*I2CReadWriteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_board_v1_board_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*I2COperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_board_v1_board_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*I2CReadWriteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_board_v1_board_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SPITransferRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_board_v1_board_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SPITransferResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_board_v1_board_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SerialStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_board_v1_board_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SerialStreamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_board_v1_board_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPowerModeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_board_v1_board_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetPowerModeResponse); i {
 			case 0:
 				return &v.state
@@ -1770,15 +3848,97 @@ func file_component_board_v1_board_proto_init() {
 				return nil
 			}
 		}
+		file_component_board_v1_board_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WakeSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_board_v1_board_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GPIOWakeSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_board_v1_board_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RTCWakeSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_board_v1_board_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnalogWakeSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_board_v1_board_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPowerModeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_board_v1_board_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPowerModeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_component_board_v1_board_proto_msgTypes[26].OneofWrappers = []interface{}{
+		(*I2COperation_Write)(nil),
+		(*I2COperation_ReadLength)(nil),
+	}
+	file_component_board_v1_board_proto_msgTypes[32].OneofWrappers = []interface{}{}
+	file_component_board_v1_board_proto_msgTypes[34].OneofWrappers = []interface{}{
+		(*WakeSource_Gpio)(nil),
+		(*WakeSource_Rtc)(nil),
+		(*WakeSource_Analog)(nil),
 	}
-	file_component_board_v1_board_proto_msgTypes[18].OneofWrappers = []interface{}{}
+	file_component_board_v1_board_proto_msgTypes[39].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_component_board_v1_board_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   20,
+			NumEnums:      3,
+			NumMessages:   40,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
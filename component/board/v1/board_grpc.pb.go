@@ -27,6 +27,8 @@ type BoardServiceClient interface {
 	SetGPIO(ctx context.Context, in *SetGPIORequest, opts ...grpc.CallOption) (*SetGPIOResponse, error)
 	// GetGPIO gets the high/low state of the given pin of a board of the underlying robot.
 	GetGPIO(ctx context.Context, in *GetGPIORequest, opts ...grpc.CallOption) (*GetGPIOResponse, error)
+	// StreamGPIOEdges streams timestamped level changes of the given pins of a board of the underlying robot.
+	StreamGPIOEdges(ctx context.Context, in *StreamGPIOEdgesRequest, opts ...grpc.CallOption) (BoardService_StreamGPIOEdgesClient, error)
 	// PWM gets the duty cycle of the given pin of a board of the underlying robot.
 	PWM(ctx context.Context, in *PWMRequest, opts ...grpc.CallOption) (*PWMResponse, error)
 	// SetPWM sets the given pin of a board of the underlying robot to the given duty cycle.
@@ -39,10 +41,27 @@ type BoardServiceClient interface {
 	DoCommand(ctx context.Context, in *v1.DoCommandRequest, opts ...grpc.CallOption) (*v1.DoCommandResponse, error)
 	// ReadAnalogReader reads off the current value of an analog reader of a board of the underlying robot.
 	ReadAnalogReader(ctx context.Context, in *ReadAnalogReaderRequest, opts ...grpc.CallOption) (*ReadAnalogReaderResponse, error)
+	// StreamAnalogReaders streams batches of timestamped samples of the given analog readers of a board of the underlying robot
+	// at the requested rate.
+	StreamAnalogReaders(ctx context.Context, in *StreamAnalogReadersRequest, opts ...grpc.CallOption) (BoardService_StreamAnalogReadersClient, error)
 	// GetDigitalInterruptValue returns the current value of the interrupt which is based on the type of interrupt.
 	GetDigitalInterruptValue(ctx context.Context, in *GetDigitalInterruptValueRequest, opts ...grpc.CallOption) (*GetDigitalInterruptValueResponse, error)
+	// StreamTicks streams the timestamped ticks of the given digital interrupts of a board of the underlying robot.
+	StreamTicks(ctx context.Context, in *StreamTicksRequest, opts ...grpc.CallOption) (BoardService_StreamTicksClient, error)
+	// I2CReadWrite performs the given reads and writes on an I2C bus of a board of the underlying robot
+	// as a single transaction, without other traffic on the bus in between.
+	I2CReadWrite(ctx context.Context, in *I2CReadWriteRequest, opts ...grpc.CallOption) (*I2CReadWriteResponse, error)
+	// SPITransfer performs a full duplex transfer on an SPI bus of a board of the underlying robot,
+	// holding the chip select for the whole transfer.
+	SPITransfer(ctx context.Context, in *SPITransferRequest, opts ...grpc.CallOption) (*SPITransferResponse, error)
+	// SerialStream opens a serial port of a board of the underlying robot, writes the data of each request to it,
+	// and streams back the data read from it until the stream is closed.
+	// The first request must set the board and serial port names; they are ignored on later requests.
+	SerialStream(ctx context.Context, opts ...grpc.CallOption) (BoardService_SerialStreamClient, error)
 	// `SetPowerMode` sets the power consumption mode of the board to the requested setting for the given duration.
 	SetPowerMode(ctx context.Context, in *SetPowerModeRequest, opts ...grpc.CallOption) (*SetPowerModeResponse, error)
+	// `GetPowerMode` returns the current power consumption mode of the board and the reason it last woke up.
+	GetPowerMode(ctx context.Context, in *GetPowerModeRequest, opts ...grpc.CallOption) (*GetPowerModeResponse, error)
 	// GetGeometries returns the geometries of the component in their current configuration
 	GetGeometries(ctx context.Context, in *v1.GetGeometriesRequest, opts ...grpc.CallOption) (*v1.GetGeometriesResponse, error)
 }
@@ -82,6 +101,38 @@ func (c *boardServiceClient) GetGPIO(ctx context.Context, in *GetGPIORequest, op
 	return out, nil
 }
 
+func (c *boardServiceClient) StreamGPIOEdges(ctx context.Context, in *StreamGPIOEdgesRequest, opts ...grpc.CallOption) (BoardService_StreamGPIOEdgesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BoardService_ServiceDesc.Streams[0], "/viam.component.board.v1.BoardService/StreamGPIOEdges", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &boardServiceStreamGPIOEdgesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BoardService_StreamGPIOEdgesClient interface {
+	Recv() (*StreamGPIOEdgesResponse, error)
+	grpc.ClientStream
+}
+
+type boardServiceStreamGPIOEdgesClient struct {
+	grpc.ClientStream
+}
+
+func (x *boardServiceStreamGPIOEdgesClient) Recv() (*StreamGPIOEdgesResponse, error) {
+	m := new(StreamGPIOEdgesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *boardServiceClient) PWM(ctx context.Context, in *PWMRequest, opts ...grpc.CallOption) (*PWMResponse, error) {
 	out := new(PWMResponse)
 	err := c.cc.Invoke(ctx, "/viam.component.board.v1.BoardService/PWM", in, out, opts...)
@@ -136,6 +187,38 @@ func (c *boardServiceClient) ReadAnalogReader(ctx context.Context, in *ReadAnalo
 	return out, nil
 }
 
+func (c *boardServiceClient) StreamAnalogReaders(ctx context.Context, in *StreamAnalogReadersRequest, opts ...grpc.CallOption) (BoardService_StreamAnalogReadersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BoardService_ServiceDesc.Streams[1], "/viam.component.board.v1.BoardService/StreamAnalogReaders", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &boardServiceStreamAnalogReadersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BoardService_StreamAnalogReadersClient interface {
+	Recv() (*StreamAnalogReadersResponse, error)
+	grpc.ClientStream
+}
+
+type boardServiceStreamAnalogReadersClient struct {
+	grpc.ClientStream
+}
+
+func (x *boardServiceStreamAnalogReadersClient) Recv() (*StreamAnalogReadersResponse, error) {
+	m := new(StreamAnalogReadersResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *boardServiceClient) GetDigitalInterruptValue(ctx context.Context, in *GetDigitalInterruptValueRequest, opts ...grpc.CallOption) (*GetDigitalInterruptValueResponse, error) {
 	out := new(GetDigitalInterruptValueResponse)
 	err := c.cc.Invoke(ctx, "/viam.component.board.v1.BoardService/GetDigitalInterruptValue", in, out, opts...)
@@ -145,6 +228,87 @@ func (c *boardServiceClient) GetDigitalInterruptValue(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *boardServiceClient) StreamTicks(ctx context.Context, in *StreamTicksRequest, opts ...grpc.CallOption) (BoardService_StreamTicksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BoardService_ServiceDesc.Streams[2], "/viam.component.board.v1.BoardService/StreamTicks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &boardServiceStreamTicksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BoardService_StreamTicksClient interface {
+	Recv() (*StreamTicksResponse, error)
+	grpc.ClientStream
+}
+
+type boardServiceStreamTicksClient struct {
+	grpc.ClientStream
+}
+
+func (x *boardServiceStreamTicksClient) Recv() (*StreamTicksResponse, error) {
+	m := new(StreamTicksResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *boardServiceClient) I2CReadWrite(ctx context.Context, in *I2CReadWriteRequest, opts ...grpc.CallOption) (*I2CReadWriteResponse, error) {
+	out := new(I2CReadWriteResponse)
+	err := c.cc.Invoke(ctx, "/viam.component.board.v1.BoardService/I2CReadWrite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardServiceClient) SPITransfer(ctx context.Context, in *SPITransferRequest, opts ...grpc.CallOption) (*SPITransferResponse, error) {
+	out := new(SPITransferResponse)
+	err := c.cc.Invoke(ctx, "/viam.component.board.v1.BoardService/SPITransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardServiceClient) SerialStream(ctx context.Context, opts ...grpc.CallOption) (BoardService_SerialStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BoardService_ServiceDesc.Streams[3], "/viam.component.board.v1.BoardService/SerialStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &boardServiceSerialStreamClient{stream}
+	return x, nil
+}
+
+type BoardService_SerialStreamClient interface {
+	Send(*SerialStreamRequest) error
+	Recv() (*SerialStreamResponse, error)
+	grpc.ClientStream
+}
+
+type boardServiceSerialStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *boardServiceSerialStreamClient) Send(m *SerialStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *boardServiceSerialStreamClient) Recv() (*SerialStreamResponse, error) {
+	m := new(SerialStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *boardServiceClient) SetPowerMode(ctx context.Context, in *SetPowerModeRequest, opts ...grpc.CallOption) (*SetPowerModeResponse, error) {
 	out := new(SetPowerModeResponse)
 	err := c.cc.Invoke(ctx, "/viam.component.board.v1.BoardService/SetPowerMode", in, out, opts...)
@@ -154,6 +318,15 @@ func (c *boardServiceClient) SetPowerMode(ctx context.Context, in *SetPowerModeR
 	return out, nil
 }
 
+func (c *boardServiceClient) GetPowerMode(ctx context.Context, in *GetPowerModeRequest, opts ...grpc.CallOption) (*GetPowerModeResponse, error) {
+	out := new(GetPowerModeResponse)
+	err := c.cc.Invoke(ctx, "/viam.component.board.v1.BoardService/GetPowerMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *boardServiceClient) GetGeometries(ctx context.Context, in *v1.GetGeometriesRequest, opts ...grpc.CallOption) (*v1.GetGeometriesResponse, error) {
 	out := new(v1.GetGeometriesResponse)
 	err := c.cc.Invoke(ctx, "/viam.component.board.v1.BoardService/GetGeometries", in, out, opts...)
@@ -171,6 +344,8 @@ type BoardServiceServer interface {
 	SetGPIO(context.Context, *SetGPIORequest) (*SetGPIOResponse, error)
 	// GetGPIO gets the high/low state of the given pin of a board of the underlying robot.
 	GetGPIO(context.Context, *GetGPIORequest) (*GetGPIOResponse, error)
+	// StreamGPIOEdges streams timestamped level changes of the given pins of a board of the underlying robot.
+	StreamGPIOEdges(*StreamGPIOEdgesRequest, BoardService_StreamGPIOEdgesServer) error
 	// PWM gets the duty cycle of the given pin of a board of the underlying robot.
 	PWM(context.Context, *PWMRequest) (*PWMResponse, error)
 	// SetPWM sets the given pin of a board of the underlying robot to the given duty cycle.
@@ -183,10 +358,27 @@ type BoardServiceServer interface {
 	DoCommand(context.Context, *v1.DoCommandRequest) (*v1.DoCommandResponse, error)
 	// ReadAnalogReader reads off the current value of an analog reader of a board of the underlying robot.
 	ReadAnalogReader(context.Context, *ReadAnalogReaderRequest) (*ReadAnalogReaderResponse, error)
+	// StreamAnalogReaders streams batches of timestamped samples of the given analog readers of a board of the underlying robot
+	// at the requested rate.
+	StreamAnalogReaders(*StreamAnalogReadersRequest, BoardService_StreamAnalogReadersServer) error
 	// GetDigitalInterruptValue returns the current value of the interrupt which is based on the type of interrupt.
 	GetDigitalInterruptValue(context.Context, *GetDigitalInterruptValueRequest) (*GetDigitalInterruptValueResponse, error)
+	// StreamTicks streams the timestamped ticks of the given digital interrupts of a board of the underlying robot.
+	StreamTicks(*StreamTicksRequest, BoardService_StreamTicksServer) error
+	// I2CReadWrite performs the given reads and writes on an I2C bus of a board of the underlying robot
+	// as a single transaction, without other traffic on the bus in between.
+	I2CReadWrite(context.Context, *I2CReadWriteRequest) (*I2CReadWriteResponse, error)
+	// SPITransfer performs a full duplex transfer on an SPI bus of a board of the underlying robot,
+	// holding the chip select for the whole transfer.
+	SPITransfer(context.Context, *SPITransferRequest) (*SPITransferResponse, error)
+	// SerialStream opens a serial port of a board of the underlying robot, writes the data of each request to it,
+	// and streams back the data read from it until the stream is closed.
+	// The first request must set the board and serial port names; they are ignored on later requests.
+	SerialStream(BoardService_SerialStreamServer) error
 	// `SetPowerMode` sets the power consumption mode of the board to the requested setting for the given duration.
 	SetPowerMode(context.Context, *SetPowerModeRequest) (*SetPowerModeResponse, error)
+	// `GetPowerMode` returns the current power consumption mode of the board and the reason it last woke up.
+	GetPowerMode(context.Context, *GetPowerModeRequest) (*GetPowerModeResponse, error)
 	// GetGeometries returns the geometries of the component in their current configuration
 	GetGeometries(context.Context, *v1.GetGeometriesRequest) (*v1.GetGeometriesResponse, error)
 	mustEmbedUnimplementedBoardServiceServer()
@@ -205,6 +397,9 @@ func (UnimplementedBoardServiceServer) SetGPIO(context.Context, *SetGPIORequest)
 func (UnimplementedBoardServiceServer) GetGPIO(context.Context, *GetGPIORequest) (*GetGPIOResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGPIO not implemented")
 }
+func (UnimplementedBoardServiceServer) StreamGPIOEdges(*StreamGPIOEdgesRequest, BoardService_StreamGPIOEdgesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamGPIOEdges not implemented")
+}
 func (UnimplementedBoardServiceServer) PWM(context.Context, *PWMRequest) (*PWMResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PWM not implemented")
 }
@@ -223,12 +418,30 @@ func (UnimplementedBoardServiceServer) DoCommand(context.Context, *v1.DoCommandR
 func (UnimplementedBoardServiceServer) ReadAnalogReader(context.Context, *ReadAnalogReaderRequest) (*ReadAnalogReaderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadAnalogReader not implemented")
 }
+func (UnimplementedBoardServiceServer) StreamAnalogReaders(*StreamAnalogReadersRequest, BoardService_StreamAnalogReadersServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamAnalogReaders not implemented")
+}
 func (UnimplementedBoardServiceServer) GetDigitalInterruptValue(context.Context, *GetDigitalInterruptValueRequest) (*GetDigitalInterruptValueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDigitalInterruptValue not implemented")
 }
+func (UnimplementedBoardServiceServer) StreamTicks(*StreamTicksRequest, BoardService_StreamTicksServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamTicks not implemented")
+}
+func (UnimplementedBoardServiceServer) I2CReadWrite(context.Context, *I2CReadWriteRequest) (*I2CReadWriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method I2CReadWrite not implemented")
+}
+func (UnimplementedBoardServiceServer) SPITransfer(context.Context, *SPITransferRequest) (*SPITransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SPITransfer not implemented")
+}
+func (UnimplementedBoardServiceServer) SerialStream(BoardService_SerialStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method SerialStream not implemented")
+}
 func (UnimplementedBoardServiceServer) SetPowerMode(context.Context, *SetPowerModeRequest) (*SetPowerModeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetPowerMode not implemented")
 }
+func (UnimplementedBoardServiceServer) GetPowerMode(context.Context, *GetPowerModeRequest) (*GetPowerModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPowerMode not implemented")
+}
 func (UnimplementedBoardServiceServer) GetGeometries(context.Context, *v1.GetGeometriesRequest) (*v1.GetGeometriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGeometries not implemented")
 }
@@ -299,6 +512,27 @@ func _BoardService_GetGPIO_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BoardService_StreamGPIOEdges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamGPIOEdgesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BoardServiceServer).StreamGPIOEdges(m, &boardServiceStreamGPIOEdgesServer{stream})
+}
+
+type BoardService_StreamGPIOEdgesServer interface {
+	Send(*StreamGPIOEdgesResponse) error
+	grpc.ServerStream
+}
+
+type boardServiceStreamGPIOEdgesServer struct {
+	grpc.ServerStream
+}
+
+func (x *boardServiceStreamGPIOEdgesServer) Send(m *StreamGPIOEdgesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _BoardService_PWM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PWMRequest)
 	if err := dec(in); err != nil {
@@ -407,6 +641,27 @@ func _BoardService_ReadAnalogReader_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BoardService_StreamAnalogReaders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamAnalogReadersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BoardServiceServer).StreamAnalogReaders(m, &boardServiceStreamAnalogReadersServer{stream})
+}
+
+type BoardService_StreamAnalogReadersServer interface {
+	Send(*StreamAnalogReadersResponse) error
+	grpc.ServerStream
+}
+
+type boardServiceStreamAnalogReadersServer struct {
+	grpc.ServerStream
+}
+
+func (x *boardServiceStreamAnalogReadersServer) Send(m *StreamAnalogReadersResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _BoardService_GetDigitalInterruptValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetDigitalInterruptValueRequest)
 	if err := dec(in); err != nil {
@@ -425,6 +680,89 @@ func _BoardService_GetDigitalInterruptValue_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BoardService_StreamTicks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamTicksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BoardServiceServer).StreamTicks(m, &boardServiceStreamTicksServer{stream})
+}
+
+type BoardService_StreamTicksServer interface {
+	Send(*StreamTicksResponse) error
+	grpc.ServerStream
+}
+
+type boardServiceStreamTicksServer struct {
+	grpc.ServerStream
+}
+
+func (x *boardServiceStreamTicksServer) Send(m *StreamTicksResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BoardService_I2CReadWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(I2CReadWriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).I2CReadWrite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/viam.component.board.v1.BoardService/I2CReadWrite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).I2CReadWrite(ctx, req.(*I2CReadWriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoardService_SPITransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SPITransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).SPITransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/viam.component.board.v1.BoardService/SPITransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).SPITransfer(ctx, req.(*SPITransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoardService_SerialStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BoardServiceServer).SerialStream(&boardServiceSerialStreamServer{stream})
+}
+
+type BoardService_SerialStreamServer interface {
+	Send(*SerialStreamResponse) error
+	Recv() (*SerialStreamRequest, error)
+	grpc.ServerStream
+}
+
+type boardServiceSerialStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *boardServiceSerialStreamServer) Send(m *SerialStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *boardServiceSerialStreamServer) Recv() (*SerialStreamRequest, error) {
+	m := new(SerialStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _BoardService_SetPowerMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetPowerModeRequest)
 	if err := dec(in); err != nil {
@@ -443,6 +781,24 @@ func _BoardService_SetPowerMode_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BoardService_GetPowerMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPowerModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).GetPowerMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/viam.component.board.v1.BoardService/GetPowerMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).GetPowerMode(ctx, req.(*GetPowerModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BoardService_GetGeometries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v1.GetGeometriesRequest)
 	if err := dec(in); err != nil {
@@ -508,15 +864,49 @@ var BoardService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDigitalInterruptValue",
 			Handler:    _BoardService_GetDigitalInterruptValue_Handler,
 		},
+		{
+			MethodName: "I2CReadWrite",
+			Handler:    _BoardService_I2CReadWrite_Handler,
+		},
+		{
+			MethodName: "SPITransfer",
+			Handler:    _BoardService_SPITransfer_Handler,
+		},
 		{
 			MethodName: "SetPowerMode",
 			Handler:    _BoardService_SetPowerMode_Handler,
 		},
+		{
+			MethodName: "GetPowerMode",
+			Handler:    _BoardService_GetPowerMode_Handler,
+		},
 		{
 			MethodName: "GetGeometries",
 			Handler:    _BoardService_GetGeometries_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamGPIOEdges",
+			Handler:       _BoardService_StreamGPIOEdges_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamAnalogReaders",
+			Handler:       _BoardService_StreamAnalogReaders_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamTicks",
+			Handler:       _BoardService_StreamTicks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SerialStream",
+			Handler:       _BoardService_SerialStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "component/board/v1/board.proto",
 }
@@ -13,6 +13,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -79,6 +80,131 @@ func (Format) EnumDescriptor() ([]byte, []int) {
 	return file_component_camera_v1_camera_proto_rawDescGZIP(), []int{0}
 }
 
+type VideoCodec int32
+
+const (
+	VideoCodec_VIDEO_CODEC_UNSPECIFIED VideoCodec = 0
+	// Each frame is an independent JPEG image
+	VideoCodec_VIDEO_CODEC_MJPEG VideoCodec = 1
+	VideoCodec_VIDEO_CODEC_H264  VideoCodec = 2
+	// Each frame is uncompressed RGBA pixel data
+	VideoCodec_VIDEO_CODEC_RAW_RGBA VideoCodec = 3
+)
+
+// Enum value maps for VideoCodec.
+var (
+	VideoCodec_name = map[int32]string{
+		0: "VIDEO_CODEC_UNSPECIFIED",
+		1: "VIDEO_CODEC_MJPEG",
+		2: "VIDEO_CODEC_H264",
+		3: "VIDEO_CODEC_RAW_RGBA",
+	}
+	VideoCodec_value = map[string]int32{
+		"VIDEO_CODEC_UNSPECIFIED": 0,
+		"VIDEO_CODEC_MJPEG":       1,
+		"VIDEO_CODEC_H264":        2,
+		"VIDEO_CODEC_RAW_RGBA":    3,
+	}
+)
+
+func (x VideoCodec) Enum() *VideoCodec {
+	p := new(VideoCodec)
+	*p = x
+	return p
+}
+
+func (x VideoCodec) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VideoCodec) Descriptor() protoreflect.EnumDescriptor {
+	return file_component_camera_v1_camera_proto_enumTypes[1].Descriptor()
+}
+
+func (VideoCodec) Type() protoreflect.EnumType {
+	return &file_component_camera_v1_camera_proto_enumTypes[1]
+}
+
+func (x VideoCodec) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VideoCodec.Descriptor instead.
+func (VideoCodec) EnumDescriptor() ([]byte, []int) {
+	return file_component_camera_v1_camera_proto_rawDescGZIP(), []int{1}
+}
+
+type ControlType int32
+
+const (
+	ControlType_CONTROL_TYPE_UNSPECIFIED ControlType = 0
+	// Exposure time in microseconds
+	ControlType_CONTROL_TYPE_EXPOSURE ControlType = 1
+	// Gain in dB
+	ControlType_CONTROL_TYPE_GAIN ControlType = 2
+	// White balance color temperature in kelvin
+	ControlType_CONTROL_TYPE_WHITE_BALANCE ControlType = 3
+	// Focus position in the camera's units, as described by its range
+	ControlType_CONTROL_TYPE_FOCUS ControlType = 4
+	// Zoom level in the camera's units, as described by its range
+	ControlType_CONTROL_TYPE_ZOOM ControlType = 5
+	// Frame rate in fps
+	ControlType_CONTROL_TYPE_FRAME_RATE ControlType = 6
+	// Image resolution in px
+	ControlType_CONTROL_TYPE_RESOLUTION ControlType = 7
+)
+
+// Enum value maps for ControlType.
+var (
+	ControlType_name = map[int32]string{
+		0: "CONTROL_TYPE_UNSPECIFIED",
+		1: "CONTROL_TYPE_EXPOSURE",
+		2: "CONTROL_TYPE_GAIN",
+		3: "CONTROL_TYPE_WHITE_BALANCE",
+		4: "CONTROL_TYPE_FOCUS",
+		5: "CONTROL_TYPE_ZOOM",
+		6: "CONTROL_TYPE_FRAME_RATE",
+		7: "CONTROL_TYPE_RESOLUTION",
+	}
+	ControlType_value = map[string]int32{
+		"CONTROL_TYPE_UNSPECIFIED":   0,
+		"CONTROL_TYPE_EXPOSURE":      1,
+		"CONTROL_TYPE_GAIN":          2,
+		"CONTROL_TYPE_WHITE_BALANCE": 3,
+		"CONTROL_TYPE_FOCUS":         4,
+		"CONTROL_TYPE_ZOOM":          5,
+		"CONTROL_TYPE_FRAME_RATE":    6,
+		"CONTROL_TYPE_RESOLUTION":    7,
+	}
+)
+
+func (x ControlType) Enum() *ControlType {
+	p := new(ControlType)
+	*p = x
+	return p
+}
+
+func (x ControlType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ControlType) Descriptor() protoreflect.EnumDescriptor {
+	return file_component_camera_v1_camera_proto_enumTypes[2].Descriptor()
+}
+
+func (ControlType) Type() protoreflect.EnumType {
+	return &file_component_camera_v1_camera_proto_enumTypes[2]
+}
+
+func (x ControlType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ControlType.Descriptor instead.
+func (ControlType) EnumDescriptor() ([]byte, []int) {
+	return file_component_camera_v1_camera_proto_rawDescGZIP(), []int{2}
+}
+
 type GetImageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -209,6 +335,14 @@ type GetImagesRequest struct {
 
 	// Name of a camera
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Names of the sensors to return images from. If empty, images from every sensor are returned
+	FilterSourceNames []string `protobuf:"bytes,2,rep,name=filter_source_names,json=filterSourceNames,proto3" json:"filter_source_names,omitempty"`
+	// Requested format of the image from each sensor, keyed by sensor name. May not necessarily be the same one returned
+	Formats map[string]Format `protobuf:"bytes,3,rep,name=formats,proto3" json:"formats,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=viam.component.camera.v1.Format"`
+	// If true, depth images are registered to the frame of the color image so that their pixels are aligned
+	AlignDepthToColor bool `protobuf:"varint,4,opt,name=align_depth_to_color,json=alignDepthToColor,proto3" json:"align_depth_to_color,omitempty"`
+	// Additional arguments to the method
+	Extra *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
 }
 
 func (x *GetImagesRequest) Reset() {
@@ -250,6 +384,34 @@ func (x *GetImagesRequest) GetName() string {
 	return ""
 }
 
+func (x *GetImagesRequest) GetFilterSourceNames() []string {
+	if x != nil {
+		return x.FilterSourceNames
+	}
+	return nil
+}
+
+func (x *GetImagesRequest) GetFormats() map[string]Format {
+	if x != nil {
+		return x.Formats
+	}
+	return nil
+}
+
+func (x *GetImagesRequest) GetAlignDepthToColor() bool {
+	if x != nil {
+		return x.AlignDepthToColor
+	}
+	return false
+}
+
+func (x *GetImagesRequest) GetExtra() *structpb.Struct {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
 type GetImagesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -318,6 +480,10 @@ type Image struct {
 	Format Format `protobuf:"varint,2,opt,name=format,proto3,enum=viam.component.camera.v1.Format" json:"format,omitempty"`
 	// image in bytes
 	Image []byte `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	// the time at which the sensor captured this image
+	CapturedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=captured_at,json=capturedAt,proto3,oneof" json:"captured_at,omitempty"`
+	// the name of the sensor whose frame this image was registered to, if it was aligned
+	AlignedToSourceName string `protobuf:"bytes,5,opt,name=aligned_to_source_name,json=alignedToSourceName,proto3" json:"aligned_to_source_name,omitempty"`
 }
 
 func (x *Image) Reset() {
@@ -373,6 +539,222 @@ func (x *Image) GetImage() []byte {
 	return nil
 }
 
+func (x *Image) GetCapturedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CapturedAt
+	}
+	return nil
+}
+
+func (x *Image) GetAlignedToSourceName() string {
+	if x != nil {
+		return x.AlignedToSourceName
+	}
+	return ""
+}
+
+type StreamImagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of a camera
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Acceptable codecs, in order of preference. If empty, the camera chooses the codec
+	Codecs []VideoCodec `protobuf:"varint,2,rep,packed,name=codecs,proto3,enum=viam.component.camera.v1.VideoCodec" json:"codecs,omitempty"`
+	// Requested frame rate in fps. 0 will use the camera's frame rate
+	TargetFps float32 `protobuf:"fixed32,3,opt,name=target_fps,json=targetFps,proto3" json:"target_fps,omitempty"`
+	// Requested resolution in px. 0 will use the camera's resolution
+	WidthPx  uint32 `protobuf:"varint,4,opt,name=width_px,json=widthPx,proto3" json:"width_px,omitempty"`
+	HeightPx uint32 `protobuf:"varint,5,opt,name=height_px,json=heightPx,proto3" json:"height_px,omitempty"`
+	// Requested number of frames between keyframes for inter-frame codecs. 0 will use the encoder's default
+	KeyframeInterval uint32 `protobuf:"varint,6,opt,name=keyframe_interval,json=keyframeInterval,proto3" json:"keyframe_interval,omitempty"`
+	// Additional arguments to the method
+	Extra *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
+}
+
+func (x *StreamImagesRequest) Reset() {
+	*x = StreamImagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_camera_v1_camera_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamImagesRequest) ProtoMessage() {}
+
+func (x *StreamImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_camera_v1_camera_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamImagesRequest.ProtoReflect.Descriptor instead.
+func (*StreamImagesRequest) Descriptor() ([]byte, []int) {
+	return file_component_camera_v1_camera_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StreamImagesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StreamImagesRequest) GetCodecs() []VideoCodec {
+	if x != nil {
+		return x.Codecs
+	}
+	return nil
+}
+
+func (x *StreamImagesRequest) GetTargetFps() float32 {
+	if x != nil {
+		return x.TargetFps
+	}
+	return 0
+}
+
+func (x *StreamImagesRequest) GetWidthPx() uint32 {
+	if x != nil {
+		return x.WidthPx
+	}
+	return 0
+}
+
+func (x *StreamImagesRequest) GetHeightPx() uint32 {
+	if x != nil {
+		return x.HeightPx
+	}
+	return 0
+}
+
+func (x *StreamImagesRequest) GetKeyframeInterval() uint32 {
+	if x != nil {
+		return x.KeyframeInterval
+	}
+	return 0
+}
+
+func (x *StreamImagesRequest) GetExtra() *structpb.Struct {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+type StreamImagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Codec the frame is encoded with; the same for every frame of a stream
+	Codec VideoCodec `protobuf:"varint,1,opt,name=codec,proto3,enum=viam.component.camera.v1.VideoCodec" json:"codec,omitempty"`
+	// The encoded frame. For VIDEO_CODEC_H264 this is the Annex B NAL units of one access unit
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// Resolution of the frame in px
+	WidthPx  uint32 `protobuf:"varint,3,opt,name=width_px,json=widthPx,proto3" json:"width_px,omitempty"`
+	HeightPx uint32 `protobuf:"varint,4,opt,name=height_px,json=heightPx,proto3" json:"height_px,omitempty"`
+	// True if the frame can be decoded without any previous frames
+	Keyframe bool `protobuf:"varint,5,opt,name=keyframe,proto3" json:"keyframe,omitempty"`
+	// Number of the frame within the stream, starting from 0
+	SequenceNumber uint64 `protobuf:"varint,6,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	// contains the capture timestamp of the frame
+	ResponseMetadata *v1.ResponseMetadata `protobuf:"bytes,84260,opt,name=response_metadata,json=responseMetadata,proto3" json:"response_metadata,omitempty"`
+}
+
+func (x *StreamImagesResponse) Reset() {
+	*x = StreamImagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_camera_v1_camera_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamImagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamImagesResponse) ProtoMessage() {}
+
+func (x *StreamImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_camera_v1_camera_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamImagesResponse.ProtoReflect.Descriptor instead.
+func (*StreamImagesResponse) Descriptor() ([]byte, []int) {
+	return file_component_camera_v1_camera_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StreamImagesResponse) GetCodec() VideoCodec {
+	if x != nil {
+		return x.Codec
+	}
+	return VideoCodec_VIDEO_CODEC_UNSPECIFIED
+}
+
+func (x *StreamImagesResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *StreamImagesResponse) GetWidthPx() uint32 {
+	if x != nil {
+		return x.WidthPx
+	}
+	return 0
+}
+
+func (x *StreamImagesResponse) GetHeightPx() uint32 {
+	if x != nil {
+		return x.HeightPx
+	}
+	return 0
+}
+
+func (x *StreamImagesResponse) GetKeyframe() bool {
+	if x != nil {
+		return x.Keyframe
+	}
+	return false
+}
+
+func (x *StreamImagesResponse) GetSequenceNumber() uint64 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *StreamImagesResponse) GetResponseMetadata() *v1.ResponseMetadata {
+	if x != nil {
+		return x.ResponseMetadata
+	}
+	return nil
+}
+
 type RenderFrameRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -389,7 +771,7 @@ type RenderFrameRequest struct {
 func (x *RenderFrameRequest) Reset() {
 	*x = RenderFrameRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_camera_v1_camera_proto_msgTypes[5]
+		mi := &file_component_camera_v1_camera_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -402,7 +784,7 @@ func (x *RenderFrameRequest) String() string {
 func (*RenderFrameRequest) ProtoMessage() {}
 
 func (x *RenderFrameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_component_camera_v1_camera_proto_msgTypes[5]
+	mi := &file_component_camera_v1_camera_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -415,7 +797,7 @@ func (x *RenderFrameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RenderFrameRequest.ProtoReflect.Descriptor instead.
 func (*RenderFrameRequest) Descriptor() ([]byte, []int) {
-	return file_component_camera_v1_camera_proto_rawDescGZIP(), []int{5}
+	return file_component_camera_v1_camera_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *RenderFrameRequest) GetName() string {
@@ -455,7 +837,7 @@ type GetPointCloudRequest struct {
 func (x *GetPointCloudRequest) Reset() {
 	*x = GetPointCloudRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_camera_v1_camera_proto_msgTypes[6]
+		mi := &file_component_camera_v1_camera_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -468,7 +850,7 @@ func (x *GetPointCloudRequest) String() string {
 func (*GetPointCloudRequest) ProtoMessage() {}
 
 func (x *GetPointCloudRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_component_camera_v1_camera_proto_msgTypes[6]
+	mi := &file_component_camera_v1_camera_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -481,7 +863,7 @@ func (x *GetPointCloudRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPointCloudRequest.ProtoReflect.Descriptor instead.
 func (*GetPointCloudRequest) Descriptor() ([]byte, []int) {
-	return file_component_camera_v1_camera_proto_rawDescGZIP(), []int{6}
+	return file_component_camera_v1_camera_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetPointCloudRequest) GetName() string {
@@ -519,7 +901,7 @@ type GetPointCloudResponse struct {
 func (x *GetPointCloudResponse) Reset() {
 	*x = GetPointCloudResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_camera_v1_camera_proto_msgTypes[7]
+		mi := &file_component_camera_v1_camera_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -532,7 +914,7 @@ func (x *GetPointCloudResponse) String() string {
 func (*GetPointCloudResponse) ProtoMessage() {}
 
 func (x *GetPointCloudResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_component_camera_v1_camera_proto_msgTypes[7]
+	mi := &file_component_camera_v1_camera_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -545,7 +927,7 @@ func (x *GetPointCloudResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPointCloudResponse.ProtoReflect.Descriptor instead.
 func (*GetPointCloudResponse) Descriptor() ([]byte, []int) {
-	return file_component_camera_v1_camera_proto_rawDescGZIP(), []int{7}
+	return file_component_camera_v1_camera_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetPointCloudResponse) GetMimeType() string {
@@ -555,39 +937,656 @@ func (x *GetPointCloudResponse) GetMimeType() string {
 	return ""
 }
 
-func (x *GetPointCloudResponse) GetPointCloud() []byte {
+func (x *GetPointCloudResponse) GetPointCloud() []byte {
+	if x != nil {
+		return x.PointCloud
+	}
+	return nil
+}
+
+type GetPointCloudStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of a camera
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Requested MIME type of response
+	MimeType string `protobuf:"bytes,2,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	// If true, stream successive scans until the stream is closed, otherwise stream a single scan
+	Continuous bool `protobuf:"varint,3,opt,name=continuous,proto3" json:"continuous,omitempty"`
+	// Maximum size of each chunk in bytes. 0 will use the camera's default chunk size
+	MaxChunkSizeBytes uint32 `protobuf:"varint,4,opt,name=max_chunk_size_bytes,json=maxChunkSizeBytes,proto3" json:"max_chunk_size_bytes,omitempty"`
+	// Additional arguments to the method
+	Extra *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
+}
+
+func (x *GetPointCloudStreamRequest) Reset() {
+	*x = GetPointCloudStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_camera_v1_camera_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPointCloudStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPointCloudStreamRequest) ProtoMessage() {}
+
+func (x *GetPointCloudStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_camera_v1_camera_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPointCloudStreamRequest.ProtoReflect.Descriptor instead.
+func (*GetPointCloudStreamRequest) Descriptor() ([]byte, []int) {
+	return file_component_camera_v1_camera_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetPointCloudStreamRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetPointCloudStreamRequest) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *GetPointCloudStreamRequest) GetContinuous() bool {
+	if x != nil {
+		return x.Continuous
+	}
+	return false
+}
+
+func (x *GetPointCloudStreamRequest) GetMaxChunkSizeBytes() uint32 {
+	if x != nil {
+		return x.MaxChunkSizeBytes
+	}
+	return 0
+}
+
+func (x *GetPointCloudStreamRequest) GetExtra() *structpb.Struct {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+type GetPointCloudStreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Actual MIME type of response
+	MimeType string `protobuf:"bytes,1,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	// One chunk of a point cloud. Concatenating the chunks of a scan in the order received
+	// results in the complete point cloud
+	PointCloudChunk []byte `protobuf:"bytes,2,opt,name=point_cloud_chunk,json=pointCloudChunk,proto3" json:"point_cloud_chunk,omitempty"`
+	// Number of the scan this chunk belongs to within the stream, starting from 0
+	ScanNumber uint64 `protobuf:"varint,3,opt,name=scan_number,json=scanNumber,proto3" json:"scan_number,omitempty"`
+	// True if this is the final chunk of the scan
+	LastChunk bool `protobuf:"varint,4,opt,name=last_chunk,json=lastChunk,proto3" json:"last_chunk,omitempty"`
+	// Pose of the sensor when the scan was captured, in the robot's world frame
+	// Only set on the first chunk of each scan
+	SensorPose *v1.PoseInFrame `protobuf:"bytes,5,opt,name=sensor_pose,json=sensorPose,proto3" json:"sensor_pose,omitempty"`
+	// contains the capture timestamp of the scan
+	// Only set on the first chunk of each scan
+	ResponseMetadata *v1.ResponseMetadata `protobuf:"bytes,84260,opt,name=response_metadata,json=responseMetadata,proto3" json:"response_metadata,omitempty"`
+}
+
+func (x *GetPointCloudStreamResponse) Reset() {
+	*x = GetPointCloudStreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_camera_v1_camera_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPointCloudStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPointCloudStreamResponse) ProtoMessage() {}
+
+func (x *GetPointCloudStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_camera_v1_camera_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPointCloudStreamResponse.ProtoReflect.Descriptor instead.
+func (*GetPointCloudStreamResponse) Descriptor() ([]byte, []int) {
+	return file_component_camera_v1_camera_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetPointCloudStreamResponse) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *GetPointCloudStreamResponse) GetPointCloudChunk() []byte {
+	if x != nil {
+		return x.PointCloudChunk
+	}
+	return nil
+}
+
+func (x *GetPointCloudStreamResponse) GetScanNumber() uint64 {
+	if x != nil {
+		return x.ScanNumber
+	}
+	return 0
+}
+
+func (x *GetPointCloudStreamResponse) GetLastChunk() bool {
+	if x != nil {
+		return x.LastChunk
+	}
+	return false
+}
+
+func (x *GetPointCloudStreamResponse) GetSensorPose() *v1.PoseInFrame {
+	if x != nil {
+		return x.SensorPose
+	}
+	return nil
+}
+
+func (x *GetPointCloudStreamResponse) GetResponseMetadata() *v1.ResponseMetadata {
+	if x != nil {
+		return x.ResponseMetadata
+	}
+	return nil
+}
+
+type GetPropertiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of a camera
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *GetPropertiesRequest) Reset() {
+	*x = GetPropertiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_camera_v1_camera_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPropertiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPropertiesRequest) ProtoMessage() {}
+
+func (x *GetPropertiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_camera_v1_camera_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPropertiesRequest.ProtoReflect.Descriptor instead.
+func (*GetPropertiesRequest) Descriptor() ([]byte, []int) {
+	return file_component_camera_v1_camera_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetPropertiesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetPropertiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A boolean property determining whether the camera supports the return of pointcloud data
+	SupportsPcd bool `protobuf:"varint,1,opt,name=supports_pcd,json=supportsPcd,proto3" json:"supports_pcd,omitempty"`
+	// Parameters for doing a perspective of a 3D scene to a 2D plane
+	// If camera does not provide intrinsic parameters, leave the field empty
+	// Initializing the parameters with 0-values is considered an error
+	IntrinsicParameters *IntrinsicParameters `protobuf:"bytes,2,opt,name=intrinsic_parameters,json=intrinsicParameters,proto3" json:"intrinsic_parameters,omitempty"`
+	// Parameters for modeling lens distortion in cameras
+	// If camera does not provide distortion parameters, leave the field empty
+	// Initializing the parameters with 0-values is considered an error
+	DistortionParameters *DistortionParameters `protobuf:"bytes,3,opt,name=distortion_parameters,json=distortionParameters,proto3" json:"distortion_parameters,omitempty"`
+}
+
+func (x *GetPropertiesResponse) Reset() {
+	*x = GetPropertiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_camera_v1_camera_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPropertiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPropertiesResponse) ProtoMessage() {}
+
+func (x *GetPropertiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_camera_v1_camera_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPropertiesResponse.ProtoReflect.Descriptor instead.
+func (*GetPropertiesResponse) Descriptor() ([]byte, []int) {
+	return file_component_camera_v1_camera_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetPropertiesResponse) GetSupportsPcd() bool {
+	if x != nil {
+		return x.SupportsPcd
+	}
+	return false
+}
+
+func (x *GetPropertiesResponse) GetIntrinsicParameters() *IntrinsicParameters {
+	if x != nil {
+		return x.IntrinsicParameters
+	}
+	return nil
+}
+
+func (x *GetPropertiesResponse) GetDistortionParameters() *DistortionParameters {
+	if x != nil {
+		return x.DistortionParameters
+	}
+	return nil
+}
+
+type GetControlsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of a camera
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Additional arguments to the method
+	Extra *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
+}
+
+func (x *GetControlsRequest) Reset() {
+	*x = GetControlsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_camera_v1_camera_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetControlsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetControlsRequest) ProtoMessage() {}
+
+func (x *GetControlsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_camera_v1_camera_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetControlsRequest.ProtoReflect.Descriptor instead.
+func (*GetControlsRequest) Descriptor() ([]byte, []int) {
+	return file_component_camera_v1_camera_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetControlsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetControlsRequest) GetExtra() *structpb.Struct {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+type GetControlsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Controls supported by the camera, with their current values and ranges
+	Controls []*ControlInfo `protobuf:"bytes,1,rep,name=controls,proto3" json:"controls,omitempty"`
+}
+
+func (x *GetControlsResponse) Reset() {
+	*x = GetControlsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_camera_v1_camera_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetControlsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetControlsResponse) ProtoMessage() {}
+
+func (x *GetControlsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_camera_v1_camera_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetControlsResponse.ProtoReflect.Descriptor instead.
+func (*GetControlsResponse) Descriptor() ([]byte, []int) {
+	return file_component_camera_v1_camera_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetControlsResponse) GetControls() []*ControlInfo {
+	if x != nil {
+		return x.Controls
+	}
+	return nil
+}
+
+type SetControlsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of a camera
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Controls to set
+	Controls []*Control `protobuf:"bytes,2,rep,name=controls,proto3" json:"controls,omitempty"`
+	// Additional arguments to the method
+	Extra *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
+}
+
+func (x *SetControlsRequest) Reset() {
+	*x = SetControlsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_camera_v1_camera_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetControlsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetControlsRequest) ProtoMessage() {}
+
+func (x *SetControlsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_camera_v1_camera_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetControlsRequest.ProtoReflect.Descriptor instead.
+func (*SetControlsRequest) Descriptor() ([]byte, []int) {
+	return file_component_camera_v1_camera_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SetControlsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetControlsRequest) GetControls() []*Control {
+	if x != nil {
+		return x.Controls
+	}
+	return nil
+}
+
+func (x *SetControlsRequest) GetExtra() *structpb.Struct {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+type SetControlsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Values of the given controls after they were set, which may be adjusted to the camera's supported values
+	Controls []*Control `protobuf:"bytes,1,rep,name=controls,proto3" json:"controls,omitempty"`
+}
+
+func (x *SetControlsResponse) Reset() {
+	*x = SetControlsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_camera_v1_camera_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetControlsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetControlsResponse) ProtoMessage() {}
+
+func (x *SetControlsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_camera_v1_camera_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetControlsResponse.ProtoReflect.Descriptor instead.
+func (*SetControlsResponse) Descriptor() ([]byte, []int) {
+	return file_component_camera_v1_camera_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetControlsResponse) GetControls() []*Control {
+	if x != nil {
+		return x.Controls
+	}
+	return nil
+}
+
+type Control struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type ControlType `protobuf:"varint,1,opt,name=type,proto3,enum=viam.component.camera.v1.ControlType" json:"type,omitempty"`
+	// Types that are assignable to Value:
+	//
+	//	*Control_Number
+	//	*Control_Resolution
+	Value isControl_Value `protobuf_oneof:"value"`
+	// If true, the camera sets the value automatically and the given value is ignored
+	AutoMode bool `protobuf:"varint,4,opt,name=auto_mode,json=autoMode,proto3" json:"auto_mode,omitempty"`
+}
+
+func (x *Control) Reset() {
+	*x = Control{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_camera_v1_camera_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Control) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Control) ProtoMessage() {}
+
+func (x *Control) ProtoReflect() protoreflect.Message {
+	mi := &file_component_camera_v1_camera_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Control.ProtoReflect.Descriptor instead.
+func (*Control) Descriptor() ([]byte, []int) {
+	return file_component_camera_v1_camera_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Control) GetType() ControlType {
+	if x != nil {
+		return x.Type
+	}
+	return ControlType_CONTROL_TYPE_UNSPECIFIED
+}
+
+func (m *Control) GetValue() isControl_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *Control) GetNumber() float64 {
+	if x, ok := x.GetValue().(*Control_Number); ok {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Control) GetResolution() *Resolution {
+	if x, ok := x.GetValue().(*Control_Resolution); ok {
+		return x.Resolution
+	}
+	return nil
+}
+
+func (x *Control) GetAutoMode() bool {
 	if x != nil {
-		return x.PointCloud
+		return x.AutoMode
 	}
-	return nil
+	return false
 }
 
-type GetPropertiesRequest struct {
+type isControl_Value interface {
+	isControl_Value()
+}
+
+type Control_Number struct {
+	// Value of every control except CONTROL_TYPE_RESOLUTION
+	Number float64 `protobuf:"fixed64,2,opt,name=number,proto3,oneof"`
+}
+
+type Control_Resolution struct {
+	// Value of CONTROL_TYPE_RESOLUTION
+	Resolution *Resolution `protobuf:"bytes,3,opt,name=resolution,proto3,oneof"`
+}
+
+func (*Control_Number) isControl_Value() {}
+
+func (*Control_Resolution) isControl_Value() {}
+
+type Resolution struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Name of a camera
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	WidthPx  uint32 `protobuf:"varint,1,opt,name=width_px,json=widthPx,proto3" json:"width_px,omitempty"`
+	HeightPx uint32 `protobuf:"varint,2,opt,name=height_px,json=heightPx,proto3" json:"height_px,omitempty"`
 }
 
-func (x *GetPropertiesRequest) Reset() {
-	*x = GetPropertiesRequest{}
+func (x *Resolution) Reset() {
+	*x = Resolution{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_camera_v1_camera_proto_msgTypes[8]
+		mi := &file_component_camera_v1_camera_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPropertiesRequest) String() string {
+func (x *Resolution) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPropertiesRequest) ProtoMessage() {}
+func (*Resolution) ProtoMessage() {}
 
-func (x *GetPropertiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_component_camera_v1_camera_proto_msgTypes[8]
+func (x *Resolution) ProtoReflect() protoreflect.Message {
+	mi := &file_component_camera_v1_camera_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -598,52 +1597,59 @@ func (x *GetPropertiesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPropertiesRequest.ProtoReflect.Descriptor instead.
-func (*GetPropertiesRequest) Descriptor() ([]byte, []int) {
-	return file_component_camera_v1_camera_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use Resolution.ProtoReflect.Descriptor instead.
+func (*Resolution) Descriptor() ([]byte, []int) {
+	return file_component_camera_v1_camera_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GetPropertiesRequest) GetName() string {
+func (x *Resolution) GetWidthPx() uint32 {
 	if x != nil {
-		return x.Name
+		return x.WidthPx
 	}
-	return ""
+	return 0
 }
 
-type GetPropertiesResponse struct {
+func (x *Resolution) GetHeightPx() uint32 {
+	if x != nil {
+		return x.HeightPx
+	}
+	return 0
+}
+
+type ControlInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// A boolean property determining whether the camera supports the return of pointcloud data
-	SupportsPcd bool `protobuf:"varint,1,opt,name=supports_pcd,json=supportsPcd,proto3" json:"supports_pcd,omitempty"`
-	// Parameters for doing a perspective of a 3D scene to a 2D plane
-	// If camera does not provide intrinsic parameters, leave the field empty
-	// Initializing the parameters with 0-values is considered an error
-	IntrinsicParameters *IntrinsicParameters `protobuf:"bytes,2,opt,name=intrinsic_parameters,json=intrinsicParameters,proto3" json:"intrinsic_parameters,omitempty"`
-	// Parameters for modeling lens distortion in cameras
-	// If camera does not provide distortion parameters, leave the field empty
-	// Initializing the parameters with 0-values is considered an error
-	DistortionParameters *DistortionParameters `protobuf:"bytes,3,opt,name=distortion_parameters,json=distortionParameters,proto3" json:"distortion_parameters,omitempty"`
+	// The current value of the control
+	Control *Control `protobuf:"bytes,1,opt,name=control,proto3" json:"control,omitempty"`
+	// Lowest, highest and step between supported values. Unset for CONTROL_TYPE_RESOLUTION
+	Min  float64 `protobuf:"fixed64,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max  float64 `protobuf:"fixed64,3,opt,name=max,proto3" json:"max,omitempty"`
+	Step float64 `protobuf:"fixed64,4,opt,name=step,proto3" json:"step,omitempty"`
+	// Supported values of CONTROL_TYPE_RESOLUTION
+	Resolutions []*Resolution `protobuf:"bytes,5,rep,name=resolutions,proto3" json:"resolutions,omitempty"`
+	// True if the camera can set the value of the control automatically
+	SupportsAuto bool `protobuf:"varint,6,opt,name=supports_auto,json=supportsAuto,proto3" json:"supports_auto,omitempty"`
 }
 
-func (x *GetPropertiesResponse) Reset() {
-	*x = GetPropertiesResponse{}
+func (x *ControlInfo) Reset() {
+	*x = ControlInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_camera_v1_camera_proto_msgTypes[9]
+		mi := &file_component_camera_v1_camera_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPropertiesResponse) String() string {
+func (x *ControlInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPropertiesResponse) ProtoMessage() {}
+func (*ControlInfo) ProtoMessage() {}
 
-func (x *GetPropertiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_component_camera_v1_camera_proto_msgTypes[9]
+func (x *ControlInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_component_camera_v1_camera_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -654,32 +1660,53 @@ func (x *GetPropertiesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPropertiesResponse.ProtoReflect.Descriptor instead.
-func (*GetPropertiesResponse) Descriptor() ([]byte, []int) {
-	return file_component_camera_v1_camera_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ControlInfo.ProtoReflect.Descriptor instead.
+func (*ControlInfo) Descriptor() ([]byte, []int) {
+	return file_component_camera_v1_camera_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetPropertiesResponse) GetSupportsPcd() bool {
+func (x *ControlInfo) GetControl() *Control {
 	if x != nil {
-		return x.SupportsPcd
+		return x.Control
 	}
-	return false
+	return nil
 }
 
-func (x *GetPropertiesResponse) GetIntrinsicParameters() *IntrinsicParameters {
+func (x *ControlInfo) GetMin() float64 {
 	if x != nil {
-		return x.IntrinsicParameters
+		return x.Min
 	}
-	return nil
+	return 0
 }
 
-func (x *GetPropertiesResponse) GetDistortionParameters() *DistortionParameters {
+func (x *ControlInfo) GetMax() float64 {
 	if x != nil {
-		return x.DistortionParameters
+		return x.Max
+	}
+	return 0
+}
+
+func (x *ControlInfo) GetStep() float64 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *ControlInfo) GetResolutions() []*Resolution {
+	if x != nil {
+		return x.Resolutions
 	}
 	return nil
 }
 
+func (x *ControlInfo) GetSupportsAuto() bool {
+	if x != nil {
+		return x.SupportsAuto
+	}
+	return false
+}
+
 type Webcams struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -691,7 +1718,7 @@ type Webcams struct {
 func (x *Webcams) Reset() {
 	*x = Webcams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_camera_v1_camera_proto_msgTypes[10]
+		mi := &file_component_camera_v1_camera_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -704,7 +1731,7 @@ func (x *Webcams) String() string {
 func (*Webcams) ProtoMessage() {}
 
 func (x *Webcams) ProtoReflect() protoreflect.Message {
-	mi := &file_component_camera_v1_camera_proto_msgTypes[10]
+	mi := &file_component_camera_v1_camera_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -717,7 +1744,7 @@ func (x *Webcams) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Webcams.ProtoReflect.Descriptor instead.
 func (*Webcams) Descriptor() ([]byte, []int) {
-	return file_component_camera_v1_camera_proto_rawDescGZIP(), []int{10}
+	return file_component_camera_v1_camera_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *Webcams) GetWebcams() []*Webcam {
@@ -747,7 +1774,7 @@ type Webcam struct {
 func (x *Webcam) Reset() {
 	*x = Webcam{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_camera_v1_camera_proto_msgTypes[11]
+		mi := &file_component_camera_v1_camera_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -760,7 +1787,7 @@ func (x *Webcam) String() string {
 func (*Webcam) ProtoMessage() {}
 
 func (x *Webcam) ProtoReflect() protoreflect.Message {
-	mi := &file_component_camera_v1_camera_proto_msgTypes[11]
+	mi := &file_component_camera_v1_camera_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -773,7 +1800,7 @@ func (x *Webcam) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Webcam.ProtoReflect.Descriptor instead.
 func (*Webcam) Descriptor() ([]byte, []int) {
-	return file_component_camera_v1_camera_proto_rawDescGZIP(), []int{11}
+	return file_component_camera_v1_camera_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *Webcam) GetLabel() string {
@@ -829,7 +1856,7 @@ type Property struct {
 func (x *Property) Reset() {
 	*x = Property{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_camera_v1_camera_proto_msgTypes[12]
+		mi := &file_component_camera_v1_camera_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -842,7 +1869,7 @@ func (x *Property) String() string {
 func (*Property) ProtoMessage() {}
 
 func (x *Property) ProtoReflect() protoreflect.Message {
-	mi := &file_component_camera_v1_camera_proto_msgTypes[12]
+	mi := &file_component_camera_v1_camera_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -855,7 +1882,7 @@ func (x *Property) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Property.ProtoReflect.Descriptor instead.
 func (*Property) Descriptor() ([]byte, []int) {
-	return file_component_camera_v1_camera_proto_rawDescGZIP(), []int{12}
+	return file_component_camera_v1_camera_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *Property) GetWidthPx() int32 {
@@ -902,7 +1929,7 @@ type IntrinsicParameters struct {
 func (x *IntrinsicParameters) Reset() {
 	*x = IntrinsicParameters{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_camera_v1_camera_proto_msgTypes[13]
+		mi := &file_component_camera_v1_camera_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -915,7 +1942,7 @@ func (x *IntrinsicParameters) String() string {
 func (*IntrinsicParameters) ProtoMessage() {}
 
 func (x *IntrinsicParameters) ProtoReflect() protoreflect.Message {
-	mi := &file_component_camera_v1_camera_proto_msgTypes[13]
+	mi := &file_component_camera_v1_camera_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -928,7 +1955,7 @@ func (x *IntrinsicParameters) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IntrinsicParameters.ProtoReflect.Descriptor instead.
 func (*IntrinsicParameters) Descriptor() ([]byte, []int) {
-	return file_component_camera_v1_camera_proto_rawDescGZIP(), []int{13}
+	return file_component_camera_v1_camera_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *IntrinsicParameters) GetWidthPx() uint32 {
@@ -985,7 +2012,7 @@ type DistortionParameters struct {
 func (x *DistortionParameters) Reset() {
 	*x = DistortionParameters{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_camera_v1_camera_proto_msgTypes[14]
+		mi := &file_component_camera_v1_camera_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -998,7 +2025,7 @@ func (x *DistortionParameters) String() string {
 func (*DistortionParameters) ProtoMessage() {}
 
 func (x *DistortionParameters) ProtoReflect() protoreflect.Message {
-	mi := &file_component_camera_v1_camera_proto_msgTypes[14]
+	mi := &file_component_camera_v1_camera_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1011,7 +2038,7 @@ func (x *DistortionParameters) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DistortionParameters.ProtoReflect.Descriptor instead.
 func (*DistortionParameters) Descriptor() ([]byte, []int) {
-	return file_component_camera_v1_camera_proto_rawDescGZIP(), []int{14}
+	return file_component_camera_v1_camera_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *DistortionParameters) GetModel() string {
@@ -1041,78 +2068,237 @@ var file_component_camera_v1_camera_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68,
 	0x74, 0x74, 0x70, 0x62, 0x6f, 0x64, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x71, 0x0a, 0x0f, 0x47,
-	0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x45,
-	0x0a, 0x10, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
-	0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0x26, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x9d, 0x01,
-	0x0a, 0x11, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
-	0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x49,
-	0x6d, 0x61, 0x67, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x4f, 0x0a, 0x11,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0xa4, 0x92, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x76, 0x69, 0x61, 0x6d,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x10, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x78, 0x0a,
-	0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x71, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22,
+	0x45, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0xe7, 0x02, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x2e, 0x0a, 0x13, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12,
+	0x51, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x37, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x2e, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x61, 0x6c, 0x69, 0x67, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x74,
+	0x68, 0x5f, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x11, 0x61, 0x6c, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x70, 0x74, 0x68, 0x54, 0x6f, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74,
+	0x72, 0x61, 0x1a, 0x5c, 0x0a, 0x0c, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x36, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x9d, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12,
+	0x4f, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0xa4, 0x92, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x76,
+	0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x10,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0xff, 0x01, 0x0a, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x76, 0x69,
+	0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d,
+	0x65, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x63,
+	0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0a,
+	0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a,
+	0x16, 0x61, 0x6c, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x61,
+	0x6c, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x22, 0x9a, 0x02, 0x0a, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3c,
+	0x0a, 0x06, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x24,
+	0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e,
+	0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x43,
+	0x6f, 0x64, 0x65, 0x63, 0x52, 0x06, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x66, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x46, 0x70, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x77,
+	0x69, 0x64, 0x74, 0x68, 0x5f, 0x70, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x77,
+	0x69, 0x64, 0x74, 0x68, 0x50, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x5f, 0x70, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x50, 0x78, 0x12, 0x2b, 0x0a, 0x11, 0x6b, 0x65, 0x79, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10,
+	0x6b, 0x65, 0x79, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22,
+	0xb4, 0x02, 0x0a, 0x14, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65,
+	0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63,
 	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2e,
-	0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0x74, 0x0a, 0x12, 0x52, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2d,
-	0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x76, 0x0a,
-	0x14, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6d,
-	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69,
-	0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18,
-	0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05,
-	0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x55, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x69, 0x6e,
-	0x74, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b,
-	0x0a, 0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0a, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x22, 0x2a, 0x0a, 0x14,
-	0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x76, 0x31, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x52, 0x05, 0x63,
+	0x6f, 0x64, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x69, 0x64, 0x74,
+	0x68, 0x5f, 0x70, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x77, 0x69, 0x64, 0x74,
+	0x68, 0x50, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x70, 0x78,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x50, 0x78,
+	0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x4f, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0xa4, 0x92, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x74, 0x0a, 0x12, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2d, 0x0a,
+	0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x76, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x81, 0x02, 0x0a, 0x15, 0x47, 0x65, 0x74,
-	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x70,
-	0x63, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72,
-	0x74, 0x73, 0x50, 0x63, 0x64, 0x12, 0x60, 0x0a, 0x14, 0x69, 0x6e, 0x74, 0x72, 0x69, 0x6e, 0x73,
-	0x69, 0x63, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
-	0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x49,
-	0x6e, 0x74, 0x72, 0x69, 0x6e, 0x73, 0x69, 0x63, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
-	0x72, 0x73, 0x52, 0x13, 0x69, 0x6e, 0x74, 0x72, 0x69, 0x6e, 0x73, 0x69, 0x63, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x63, 0x0a, 0x15, 0x64, 0x69, 0x73, 0x74, 0x6f,
-	0x72, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f,
-	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x14, 0x64, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x74, 0x69,
-	0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0x45, 0x0a, 0x07,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6d, 0x65,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65,
+	0x78, 0x74, 0x72, 0x61, 0x22, 0x55, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x43, 0x6c, 0x6f, 0x75, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x5f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0a, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x22, 0xcd, 0x01, 0x0a, 0x1a,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x6f, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x6f, 0x75, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x6d,
+	0x61, 0x78, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x43, 0x68,
+	0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x05,
+	0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0xb5, 0x02, 0x0a, 0x1b,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x5f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x61, 0x6e, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x63, 0x61, 0x6e, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x12, 0x3c, 0x0a, 0x0b, 0x73, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x5f, 0x70,
+	0x6f, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x69, 0x61, 0x6d,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x65, 0x49,
+	0x6e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x0a, 0x73, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x50, 0x6f,
+	0x73, 0x65, 0x12, 0x4f, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0xa4, 0x92, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x52, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0x2a, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x81, 0x02, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x75, 0x70,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x70, 0x63, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x50, 0x63, 0x64, 0x12, 0x60, 0x0a, 0x14,
+	0x69, 0x6e, 0x74, 0x72, 0x69, 0x6e, 0x73, 0x69, 0x63, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x76, 0x69, 0x61,
+	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d, 0x65,
+	0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x72, 0x69, 0x6e, 0x73, 0x69, 0x63, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x13, 0x69, 0x6e, 0x74, 0x72, 0x69,
+	0x6e, 0x73, 0x69, 0x63, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x63,
+	0x0a, 0x15, 0x64, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
+	0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x63,
+	0x61, 0x6d, 0x65, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x14, 0x64,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x22, 0x57, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a,
+	0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x58, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x3d, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73,
+	0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22,
+	0x54, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x08, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x73, 0x22, 0xcc, 0x01, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x12, 0x39, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x25, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x2e, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x06,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x06,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x76, 0x69, 0x61,
+	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d, 0x65,
+	0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x00, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b,
+	0x0a, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x61, 0x75, 0x74, 0x6f, 0x4d, 0x6f, 0x64, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x44, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x69, 0x64, 0x74, 0x68, 0x5f, 0x70, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x77, 0x69, 0x64, 0x74, 0x68, 0x50, 0x78, 0x12, 0x1b, 0x0a,
+	0x09, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x70, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x50, 0x78, 0x22, 0xef, 0x01, 0x0a, 0x0b, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3b, 0x0a, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x76, 0x69,
+	0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d,
+	0x65, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x74, 0x65, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12,
+	0x46, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f,
+	0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x70, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x41, 0x75, 0x74, 0x6f, 0x22, 0x45, 0x0a, 0x07,
 	0x57, 0x65, 0x62, 0x63, 0x61, 0x6d, 0x73, 0x12, 0x3a, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x63, 0x61,
 	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e,
 	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61,
@@ -1160,47 +2346,92 @@ var file_component_camera_v1_camera_proto_rawDesc = []byte{
 	0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x52, 0x41, 0x57, 0x5f, 0x44, 0x45, 0x50, 0x54, 0x48,
 	0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4a, 0x50, 0x45,
 	0x47, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x50, 0x4e,
-	0x47, 0x10, 0x04, 0x32, 0xcf, 0x08, 0x0a, 0x0d, 0x43, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x95, 0x01, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x12, 0x29, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
-	0x65, 0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+	0x47, 0x10, 0x04, 0x2a, 0x70, 0x0a, 0x0a, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x6f, 0x64, 0x65,
+	0x63, 0x12, 0x1b, 0x0a, 0x17, 0x56, 0x49, 0x44, 0x45, 0x4f, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x43,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15,
+	0x0a, 0x11, 0x56, 0x49, 0x44, 0x45, 0x4f, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x43, 0x5f, 0x4d, 0x4a,
+	0x50, 0x45, 0x47, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x49, 0x44, 0x45, 0x4f, 0x5f, 0x43,
+	0x4f, 0x44, 0x45, 0x43, 0x5f, 0x48, 0x32, 0x36, 0x34, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x56,
+	0x49, 0x44, 0x45, 0x4f, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x43, 0x5f, 0x52, 0x41, 0x57, 0x5f, 0x52,
+	0x47, 0x42, 0x41, 0x10, 0x03, 0x2a, 0xe6, 0x01, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x53, 0x55, 0x52, 0x45, 0x10, 0x01, 0x12, 0x15,
+	0x0a, 0x11, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47,
+	0x41, 0x49, 0x4e, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x57, 0x48, 0x49, 0x54, 0x45, 0x5f, 0x42, 0x41, 0x4c, 0x41,
+	0x4e, 0x43, 0x45, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x4f, 0x43, 0x55, 0x53, 0x10, 0x04, 0x12, 0x15, 0x0a,
+	0x11, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x5a, 0x4f,
+	0x4f, 0x4d, 0x10, 0x05, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x10,
+	0x06, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x07, 0x32, 0x8c,
+	0x0e, 0x0a, 0x0d, 0x43, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x95, 0x01, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x29, 0x2e,
 	0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x63,
 	0x61, 0x6d, 0x65, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x2c, 0x12, 0x2a, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
-	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61,
-	0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x99, 0x01,
-	0x0a, 0x09, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x2a, 0x2e, 0x76, 0x69,
-	0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d,
-	0x65, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63,
-	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x76,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x76,
 	0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
 	0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2f, 0x7b, 0x6e, 0x61, 0x6d,
-	0x65, 0x7d, 0x2f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x8c, 0x01, 0x0a, 0x0b, 0x52, 0x65,
-	0x6e, 0x64, 0x65, 0x72, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x2e, 0x76, 0x69, 0x61, 0x6d,
-	0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d, 0x65, 0x72,
-	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x46, 0x72, 0x61, 0x6d, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x22, 0x39, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x31, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x61,
-	0x6d, 0x65, 0x72, 0x61, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x65, 0x6e, 0x64,
-	0x65, 0x72, 0x5f, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x12, 0xaa, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74,
-	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x12, 0x2e, 0x2e, 0x76, 0x69, 0x61,
-	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d, 0x65,
-	0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x43, 0x6c,
-	0x6f, 0x75, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x76, 0x69, 0x61,
-	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d, 0x65,
-	0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x43, 0x6c,
-	0x6f, 0x75, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x32, 0x12, 0x30, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
-	0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x61, 0x6d, 0x65,
-	0x72, 0x61, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f,
-	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x12, 0xa9, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x65, 0x7d, 0x2f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x99, 0x01, 0x0a, 0x09, 0x47, 0x65, 0x74,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x2a, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f,
+	0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x73, 0x12, 0xaa, 0x01, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x2d, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x31, 0x2f, 0x76,
+	0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2f, 0x7b, 0x6e, 0x61, 0x6d,
+	0x65, 0x7d, 0x2f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x30,
+	0x01, 0x12, 0x8c, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x46, 0x72, 0x61, 0x6d,
+	0x65, 0x12, 0x2c, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x74, 0x74,
+	0x70, 0x42, 0x6f, 0x64, 0x79, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x31, 0x2f,
+	0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2f, 0x7b, 0x6e, 0x61,
+	0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x66, 0x72, 0x61, 0x6d, 0x65,
+	0x12, 0xaa, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x43, 0x6c, 0x6f,
+	0x75, 0x64, 0x12, 0x2e, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x12, 0x30, 0x2f, 0x76, 0x69,
+	0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x2f, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65,
+	0x7d, 0x2f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x12, 0xc5, 0x01,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x34, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x76, 0x69,
+	0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d,
+	0x65, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x43,
+	0x6c, 0x6f, 0x75, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x3f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x39, 0x12, 0x37, 0x2f, 0x76, 0x69, 0x61,
+	0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x2f, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
+	0x2f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x30, 0x01, 0x12, 0xa9, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
 	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x2e, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63,
 	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2e,
 	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
@@ -1211,30 +2442,50 @@ var file_component_camera_v1_camera_proto_rawDesc = []byte{
 	0x12, 0x2f, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63,
 	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2f,
 	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65,
-	0x73, 0x12, 0x89, 0x01, 0x0a, 0x09, 0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12,
-	0x20, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x21, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x22, 0x2f, 0x2f, 0x76,
+	0x73, 0x12, 0xa1, 0x01, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x73, 0x12, 0x2c, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2d, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x2e, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x63,
+	0x61, 0x6d, 0x65, 0x72, 0x61, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x73, 0x12, 0xa1, 0x01, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x73, 0x12, 0x2c, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x1a, 0x2d, 0x2f, 0x76, 0x69, 0x61,
+	0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x2f, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
+	0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x12, 0x89, 0x01, 0x0a, 0x09, 0x44, 0x6f,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x20, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76, 0x69, 0x61, 0x6d,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x31, 0x22, 0x2f, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x61, 0x6d,
+	0x65, 0x72, 0x61, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x6f, 0x5f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x95, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6f,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6f, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f, 0x76,
 	0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
 	0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2f, 0x7b, 0x6e, 0x61, 0x6d,
-	0x65, 0x7d, 0x2f, 0x64, 0x6f, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x95, 0x01,
-	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12,
-	0x24, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74,
-	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x61, 0x6d,
-	0x65, 0x72, 0x61, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x67, 0x65, 0x6f, 0x6d, 0x65,
-	0x74, 0x72, 0x69, 0x65, 0x73, 0x42, 0x43, 0x0a, 0x1c, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x69, 0x61,
-	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d, 0x65,
-	0x72, 0x61, 0x2e, 0x76, 0x31, 0x5a, 0x23, 0x67, 0x6f, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
-	0x2f, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x65, 0x7d, 0x2f, 0x67, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x42, 0x43, 0x0a,
+	0x1c, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x2e, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2e, 0x76, 0x31, 0x5a, 0x23, 0x67,
+	0x6f, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x2f,
+	0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1249,63 +2500,107 @@ func file_component_camera_v1_camera_proto_rawDescGZIP() []byte {
 	return file_component_camera_v1_camera_proto_rawDescData
 }
 
-var file_component_camera_v1_camera_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_component_camera_v1_camera_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_component_camera_v1_camera_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_component_camera_v1_camera_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_component_camera_v1_camera_proto_goTypes = []interface{}{
-	(Format)(0),                      // 0: viam.component.camera.v1.Format
-	(*GetImageRequest)(nil),          // 1: viam.component.camera.v1.GetImageRequest
-	(*GetImageResponse)(nil),         // 2: viam.component.camera.v1.GetImageResponse
-	(*GetImagesRequest)(nil),         // 3: viam.component.camera.v1.GetImagesRequest
-	(*GetImagesResponse)(nil),        // 4: viam.component.camera.v1.GetImagesResponse
-	(*Image)(nil),                    // 5: viam.component.camera.v1.Image
-	(*RenderFrameRequest)(nil),       // 6: viam.component.camera.v1.RenderFrameRequest
-	(*GetPointCloudRequest)(nil),     // 7: viam.component.camera.v1.GetPointCloudRequest
-	(*GetPointCloudResponse)(nil),    // 8: viam.component.camera.v1.GetPointCloudResponse
-	(*GetPropertiesRequest)(nil),     // 9: viam.component.camera.v1.GetPropertiesRequest
-	(*GetPropertiesResponse)(nil),    // 10: viam.component.camera.v1.GetPropertiesResponse
-	(*Webcams)(nil),                  // 11: viam.component.camera.v1.Webcams
-	(*Webcam)(nil),                   // 12: viam.component.camera.v1.Webcam
-	(*Property)(nil),                 // 13: viam.component.camera.v1.Property
-	(*IntrinsicParameters)(nil),      // 14: viam.component.camera.v1.IntrinsicParameters
-	(*DistortionParameters)(nil),     // 15: viam.component.camera.v1.DistortionParameters
-	(*structpb.Struct)(nil),          // 16: google.protobuf.Struct
-	(*v1.ResponseMetadata)(nil),      // 17: viam.common.v1.ResponseMetadata
-	(*v1.DoCommandRequest)(nil),      // 18: viam.common.v1.DoCommandRequest
-	(*v1.GetGeometriesRequest)(nil),  // 19: viam.common.v1.GetGeometriesRequest
-	(*httpbody.HttpBody)(nil),        // 20: google.api.HttpBody
-	(*v1.DoCommandResponse)(nil),     // 21: viam.common.v1.DoCommandResponse
-	(*v1.GetGeometriesResponse)(nil), // 22: viam.common.v1.GetGeometriesResponse
+	(Format)(0),                         // 0: viam.component.camera.v1.Format
+	(VideoCodec)(0),                     // 1: viam.component.camera.v1.VideoCodec
+	(ControlType)(0),                    // 2: viam.component.camera.v1.ControlType
+	(*GetImageRequest)(nil),             // 3: viam.component.camera.v1.GetImageRequest
+	(*GetImageResponse)(nil),            // 4: viam.component.camera.v1.GetImageResponse
+	(*GetImagesRequest)(nil),            // 5: viam.component.camera.v1.GetImagesRequest
+	(*GetImagesResponse)(nil),           // 6: viam.component.camera.v1.GetImagesResponse
+	(*Image)(nil),                       // 7: viam.component.camera.v1.Image
+	(*StreamImagesRequest)(nil),         // 8: viam.component.camera.v1.StreamImagesRequest
+	(*StreamImagesResponse)(nil),        // 9: viam.component.camera.v1.StreamImagesResponse
+	(*RenderFrameRequest)(nil),          // 10: viam.component.camera.v1.RenderFrameRequest
+	(*GetPointCloudRequest)(nil),        // 11: viam.component.camera.v1.GetPointCloudRequest
+	(*GetPointCloudResponse)(nil),       // 12: viam.component.camera.v1.GetPointCloudResponse
+	(*GetPointCloudStreamRequest)(nil),  // 13: viam.component.camera.v1.GetPointCloudStreamRequest
+	(*GetPointCloudStreamResponse)(nil), // 14: viam.component.camera.v1.GetPointCloudStreamResponse
+	(*GetPropertiesRequest)(nil),        // 15: viam.component.camera.v1.GetPropertiesRequest
+	(*GetPropertiesResponse)(nil),       // 16: viam.component.camera.v1.GetPropertiesResponse
+	(*GetControlsRequest)(nil),          // 17: viam.component.camera.v1.GetControlsRequest
+	(*GetControlsResponse)(nil),         // 18: viam.component.camera.v1.GetControlsResponse
+	(*SetControlsRequest)(nil),          // 19: viam.component.camera.v1.SetControlsRequest
+	(*SetControlsResponse)(nil),         // 20: viam.component.camera.v1.SetControlsResponse
+	(*Control)(nil),                     // 21: viam.component.camera.v1.Control
+	(*Resolution)(nil),                  // 22: viam.component.camera.v1.Resolution
+	(*ControlInfo)(nil),                 // 23: viam.component.camera.v1.ControlInfo
+	(*Webcams)(nil),                     // 24: viam.component.camera.v1.Webcams
+	(*Webcam)(nil),                      // 25: viam.component.camera.v1.Webcam
+	(*Property)(nil),                    // 26: viam.component.camera.v1.Property
+	(*IntrinsicParameters)(nil),         // 27: viam.component.camera.v1.IntrinsicParameters
+	(*DistortionParameters)(nil),        // 28: viam.component.camera.v1.DistortionParameters
+	nil,                                 // 29: viam.component.camera.v1.GetImagesRequest.FormatsEntry
+	(*structpb.Struct)(nil),             // 30: google.protobuf.Struct
+	(*v1.ResponseMetadata)(nil),         // 31: viam.common.v1.ResponseMetadata
+	(*timestamppb.Timestamp)(nil),       // 32: google.protobuf.Timestamp
+	(*v1.PoseInFrame)(nil),              // 33: viam.common.v1.PoseInFrame
+	(*v1.DoCommandRequest)(nil),         // 34: viam.common.v1.DoCommandRequest
+	(*v1.GetGeometriesRequest)(nil),     // 35: viam.common.v1.GetGeometriesRequest
+	(*httpbody.HttpBody)(nil),           // 36: google.api.HttpBody
+	(*v1.DoCommandResponse)(nil),        // 37: viam.common.v1.DoCommandResponse
+	(*v1.GetGeometriesResponse)(nil),    // 38: viam.common.v1.GetGeometriesResponse
 }
 var file_component_camera_v1_camera_proto_depIdxs = []int32{
-	16, // 0: viam.component.camera.v1.GetImageRequest.extra:type_name -> google.protobuf.Struct
-	5,  // 1: viam.component.camera.v1.GetImagesResponse.images:type_name -> viam.component.camera.v1.Image
-	17, // 2: viam.component.camera.v1.GetImagesResponse.response_metadata:type_name -> viam.common.v1.ResponseMetadata
-	0,  // 3: viam.component.camera.v1.Image.format:type_name -> viam.component.camera.v1.Format
-	16, // 4: viam.component.camera.v1.RenderFrameRequest.extra:type_name -> google.protobuf.Struct
-	16, // 5: viam.component.camera.v1.GetPointCloudRequest.extra:type_name -> google.protobuf.Struct
-	14, // 6: viam.component.camera.v1.GetPropertiesResponse.intrinsic_parameters:type_name -> viam.component.camera.v1.IntrinsicParameters
-	15, // 7: viam.component.camera.v1.GetPropertiesResponse.distortion_parameters:type_name -> viam.component.camera.v1.DistortionParameters
-	12, // 8: viam.component.camera.v1.Webcams.webcams:type_name -> viam.component.camera.v1.Webcam
-	13, // 9: viam.component.camera.v1.Webcam.properties:type_name -> viam.component.camera.v1.Property
-	1,  // 10: viam.component.camera.v1.CameraService.GetImage:input_type -> viam.component.camera.v1.GetImageRequest
-	3,  // 11: viam.component.camera.v1.CameraService.GetImages:input_type -> viam.component.camera.v1.GetImagesRequest
-	6,  // 12: viam.component.camera.v1.CameraService.RenderFrame:input_type -> viam.component.camera.v1.RenderFrameRequest
-	7,  // 13: viam.component.camera.v1.CameraService.GetPointCloud:input_type -> viam.component.camera.v1.GetPointCloudRequest
-	9,  // 14: viam.component.camera.v1.CameraService.GetProperties:input_type -> viam.component.camera.v1.GetPropertiesRequest
-	18, // 15: viam.component.camera.v1.CameraService.DoCommand:input_type -> viam.common.v1.DoCommandRequest
-	19, // 16: viam.component.camera.v1.CameraService.GetGeometries:input_type -> viam.common.v1.GetGeometriesRequest
-	2,  // 17: viam.component.camera.v1.CameraService.GetImage:output_type -> viam.component.camera.v1.GetImageResponse
-	4,  // 18: viam.component.camera.v1.CameraService.GetImages:output_type -> viam.component.camera.v1.GetImagesResponse
-	20, // 19: viam.component.camera.v1.CameraService.RenderFrame:output_type -> google.api.HttpBody
-	8,  // 20: viam.component.camera.v1.CameraService.GetPointCloud:output_type -> viam.component.camera.v1.GetPointCloudResponse
-	10, // 21: viam.component.camera.v1.CameraService.GetProperties:output_type -> viam.component.camera.v1.GetPropertiesResponse
-	21, // 22: viam.component.camera.v1.CameraService.DoCommand:output_type -> viam.common.v1.DoCommandResponse
-	22, // 23: viam.component.camera.v1.CameraService.GetGeometries:output_type -> viam.common.v1.GetGeometriesResponse
-	17, // [17:24] is the sub-list for method output_type
-	10, // [10:17] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	30, // 0: viam.component.camera.v1.GetImageRequest.extra:type_name -> google.protobuf.Struct
+	29, // 1: viam.component.camera.v1.GetImagesRequest.formats:type_name -> viam.component.camera.v1.GetImagesRequest.FormatsEntry
+	30, // 2: viam.component.camera.v1.GetImagesRequest.extra:type_name -> google.protobuf.Struct
+	7,  // 3: viam.component.camera.v1.GetImagesResponse.images:type_name -> viam.component.camera.v1.Image
+	31, // 4: viam.component.camera.v1.GetImagesResponse.response_metadata:type_name -> viam.common.v1.ResponseMetadata
+	0,  // 5: viam.component.camera.v1.Image.format:type_name -> viam.component.camera.v1.Format
+	32, // 6: viam.component.camera.v1.Image.captured_at:type_name -> google.protobuf.Timestamp
+	1,  // 7: viam.component.camera.v1.StreamImagesRequest.codecs:type_name -> viam.component.camera.v1.VideoCodec
+	30, // 8: viam.component.camera.v1.StreamImagesRequest.extra:type_name -> google.protobuf.Struct
+	1,  // 9: viam.component.camera.v1.StreamImagesResponse.codec:type_name -> viam.component.camera.v1.VideoCodec
+	31, // 10: viam.component.camera.v1.StreamImagesResponse.response_metadata:type_name -> viam.common.v1.ResponseMetadata
+	30, // 11: viam.component.camera.v1.RenderFrameRequest.extra:type_name -> google.protobuf.Struct
+	30, // 12: viam.component.camera.v1.GetPointCloudRequest.extra:type_name -> google.protobuf.Struct
+	30, // 13: viam.component.camera.v1.GetPointCloudStreamRequest.extra:type_name -> google.protobuf.Struct
+	33, // 14: viam.component.camera.v1.GetPointCloudStreamResponse.sensor_pose:type_name -> viam.common.v1.PoseInFrame
+	31, // 15: viam.component.camera.v1.GetPointCloudStreamResponse.response_metadata:type_name -> viam.common.v1.ResponseMetadata
+	27, // 16: viam.component.camera.v1.GetPropertiesResponse.intrinsic_parameters:type_name -> viam.component.camera.v1.IntrinsicParameters
+	28, // 17: viam.component.camera.v1.GetPropertiesResponse.distortion_parameters:type_name -> viam.component.camera.v1.DistortionParameters
+	30, // 18: viam.component.camera.v1.GetControlsRequest.extra:type_name -> google.protobuf.Struct
+	23, // 19: viam.component.camera.v1.GetControlsResponse.controls:type_name -> viam.component.camera.v1.ControlInfo
+	21, // 20: viam.component.camera.v1.SetControlsRequest.controls:type_name -> viam.component.camera.v1.Control
+	30, // 21: viam.component.camera.v1.SetControlsRequest.extra:type_name -> google.protobuf.Struct
+	21, // 22: viam.component.camera.v1.SetControlsResponse.controls:type_name -> viam.component.camera.v1.Control
+	2,  // 23: viam.component.camera.v1.Control.type:type_name -> viam.component.camera.v1.ControlType
+	22, // 24: viam.component.camera.v1.Control.resolution:type_name -> viam.component.camera.v1.Resolution
+	21, // 25: viam.component.camera.v1.ControlInfo.control:type_name -> viam.component.camera.v1.Control
+	22, // 26: viam.component.camera.v1.ControlInfo.resolutions:type_name -> viam.component.camera.v1.Resolution
+	25, // 27: viam.component.camera.v1.Webcams.webcams:type_name -> viam.component.camera.v1.Webcam
+	26, // 28: viam.component.camera.v1.Webcam.properties:type_name -> viam.component.camera.v1.Property
+	0,  // 29: viam.component.camera.v1.GetImagesRequest.FormatsEntry.value:type_name -> viam.component.camera.v1.Format
+	3,  // 30: viam.component.camera.v1.CameraService.GetImage:input_type -> viam.component.camera.v1.GetImageRequest
+	5,  // 31: viam.component.camera.v1.CameraService.GetImages:input_type -> viam.component.camera.v1.GetImagesRequest
+	8,  // 32: viam.component.camera.v1.CameraService.StreamImages:input_type -> viam.component.camera.v1.StreamImagesRequest
+	10, // 33: viam.component.camera.v1.CameraService.RenderFrame:input_type -> viam.component.camera.v1.RenderFrameRequest
+	11, // 34: viam.component.camera.v1.CameraService.GetPointCloud:input_type -> viam.component.camera.v1.GetPointCloudRequest
+	13, // 35: viam.component.camera.v1.CameraService.GetPointCloudStream:input_type -> viam.component.camera.v1.GetPointCloudStreamRequest
+	15, // 36: viam.component.camera.v1.CameraService.GetProperties:input_type -> viam.component.camera.v1.GetPropertiesRequest
+	17, // 37: viam.component.camera.v1.CameraService.GetControls:input_type -> viam.component.camera.v1.GetControlsRequest
+	19, // 38: viam.component.camera.v1.CameraService.SetControls:input_type -> viam.component.camera.v1.SetControlsRequest
+	34, // 39: viam.component.camera.v1.CameraService.DoCommand:input_type -> viam.common.v1.DoCommandRequest
+	35, // 40: viam.component.camera.v1.CameraService.GetGeometries:input_type -> viam.common.v1.GetGeometriesRequest
+	4,  // 41: viam.component.camera.v1.CameraService.GetImage:output_type -> viam.component.camera.v1.GetImageResponse
+	6,  // 42: viam.component.camera.v1.CameraService.GetImages:output_type -> viam.component.camera.v1.GetImagesResponse
+	9,  // 43: viam.component.camera.v1.CameraService.StreamImages:output_type -> viam.component.camera.v1.StreamImagesResponse
+	36, // 44: viam.component.camera.v1.CameraService.RenderFrame:output_type -> google.api.HttpBody
+	12, // 45: viam.component.camera.v1.CameraService.GetPointCloud:output_type -> viam.component.camera.v1.GetPointCloudResponse
+	14, // 46: viam.component.camera.v1.CameraService.GetPointCloudStream:output_type -> viam.component.camera.v1.GetPointCloudStreamResponse
+	16, // 47: viam.component.camera.v1.CameraService.GetProperties:output_type -> viam.component.camera.v1.GetPropertiesResponse
+	18, // 48: viam.component.camera.v1.CameraService.GetControls:output_type -> viam.component.camera.v1.GetControlsResponse
+	20, // 49: viam.component.camera.v1.CameraService.SetControls:output_type -> viam.component.camera.v1.SetControlsResponse
+	37, // 50: viam.component.camera.v1.CameraService.DoCommand:output_type -> viam.common.v1.DoCommandResponse
+	38, // 51: viam.component.camera.v1.CameraService.GetGeometries:output_type -> viam.common.v1.GetGeometriesResponse
+	41, // [41:52] is the sub-list for method output_type
+	30, // [30:41] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
 }
 
 func init() { file_component_camera_v1_camera_proto_init() }
@@ -1375,7 +2670,7 @@ func file_component_camera_v1_camera_proto_init() {
 			}
 		}
 		file_component_camera_v1_camera_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RenderFrameRequest); i {
+			switch v := v.(*StreamImagesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1387,7 +2682,7 @@ func file_component_camera_v1_camera_proto_init() {
 			}
 		}
 		file_component_camera_v1_camera_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPointCloudRequest); i {
+			switch v := v.(*StreamImagesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1399,7 +2694,7 @@ func file_component_camera_v1_camera_proto_init() {
 			}
 		}
 		file_component_camera_v1_camera_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPointCloudResponse); i {
+			switch v := v.(*RenderFrameRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1411,7 +2706,7 @@ func file_component_camera_v1_camera_proto_init() {
 			}
 		}
 		file_component_camera_v1_camera_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPropertiesRequest); i {
+			switch v := v.(*GetPointCloudRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1423,7 +2718,7 @@ func file_component_camera_v1_camera_proto_init() {
 			}
 		}
 		file_component_camera_v1_camera_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPropertiesResponse); i {
+			switch v := v.(*GetPointCloudResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1435,7 +2730,7 @@ func file_component_camera_v1_camera_proto_init() {
 			}
 		}
 		file_component_camera_v1_camera_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Webcams); i {
+			switch v := v.(*GetPointCloudStreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1447,7 +2742,7 @@ func file_component_camera_v1_camera_proto_init() {
 			}
 		}
 		file_component_camera_v1_camera_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Webcam); i {
+			switch v := v.(*GetPointCloudStreamResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1459,7 +2754,7 @@ func file_component_camera_v1_camera_proto_init() {
 			}
 		}
 		file_component_camera_v1_camera_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Property); i {
+			switch v := v.(*GetPropertiesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1471,7 +2766,7 @@ func file_component_camera_v1_camera_proto_init() {
 			}
 		}
 		file_component_camera_v1_camera_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IntrinsicParameters); i {
+			switch v := v.(*GetPropertiesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1483,6 +2778,138 @@ func file_component_camera_v1_camera_proto_init() {
 			}
 		}
 		file_component_camera_v1_camera_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetControlsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_camera_v1_camera_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetControlsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_camera_v1_camera_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetControlsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_camera_v1_camera_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetControlsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_camera_v1_camera_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Control); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_camera_v1_camera_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Resolution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_camera_v1_camera_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ControlInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_camera_v1_camera_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Webcams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_camera_v1_camera_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Webcam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_camera_v1_camera_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Property); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_camera_v1_camera_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IntrinsicParameters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_camera_v1_camera_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DistortionParameters); i {
 			case 0:
 				return &v.state
@@ -1495,13 +2922,18 @@ func file_component_camera_v1_camera_proto_init() {
 			}
 		}
 	}
+	file_component_camera_v1_camera_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_component_camera_v1_camera_proto_msgTypes[18].OneofWrappers = []interface{}{
+		(*Control_Number)(nil),
+		(*Control_Resolution)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_component_camera_v1_camera_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   15,
+			NumEnums:      3,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -28,14 +28,27 @@ type CameraServiceClient interface {
 	// can be requested but may not necessarily be the same one returned.
 	GetImage(ctx context.Context, in *GetImageRequest, opts ...grpc.CallOption) (*GetImageResponse, error)
 	GetImages(ctx context.Context, in *GetImagesRequest, opts ...grpc.CallOption) (*GetImagesResponse, error)
+	// StreamImages returns a stream of frames from a camera of the underlying robot, encoded with the first
+	// of the requested codecs that the camera supports.
+	StreamImages(ctx context.Context, in *StreamImagesRequest, opts ...grpc.CallOption) (CameraService_StreamImagesClient, error)
 	// RenderFrame renders a frame from a camera of the underlying robot to an HTTP response. A specific MIME type
 	// can be requested but may not necessarily be the same one returned.
 	RenderFrame(ctx context.Context, in *RenderFrameRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
 	// GetPointCloud returns a point cloud from a camera of the underlying robot. A specific MIME type
 	// can be requested but may not necessarily be the same one returned.
 	GetPointCloud(ctx context.Context, in *GetPointCloudRequest, opts ...grpc.CallOption) (*GetPointCloudResponse, error)
+	// GetPointCloudStream returns a point cloud from a camera of the underlying robot split into chunks, so that
+	// clouds larger than the maximum gRPC message size can be transferred. If continuous is requested, successive
+	// scans are streamed until the stream is closed. A specific MIME type can be requested but may not necessarily
+	// be the same one returned.
+	GetPointCloudStream(ctx context.Context, in *GetPointCloudStreamRequest, opts ...grpc.CallOption) (CameraService_GetPointCloudStreamClient, error)
 	// GetProperties returns the camera intrinsic parameters and camera distortion parameters from a camera of the underlying robot, if available.
 	GetProperties(ctx context.Context, in *GetPropertiesRequest, opts ...grpc.CallOption) (*GetPropertiesResponse, error)
+	// GetControls returns the current values and supported ranges of the controls of a camera of the underlying robot.
+	GetControls(ctx context.Context, in *GetControlsRequest, opts ...grpc.CallOption) (*GetControlsResponse, error)
+	// SetControls sets the values of the given controls of a camera of the underlying robot. Controls which are
+	// not given are left unchanged.
+	SetControls(ctx context.Context, in *SetControlsRequest, opts ...grpc.CallOption) (*SetControlsResponse, error)
 	// DoCommand sends/receives arbitrary commands
 	DoCommand(ctx context.Context, in *v1.DoCommandRequest, opts ...grpc.CallOption) (*v1.DoCommandResponse, error)
 	// GetGeometries returns the geometries of the component in their current configuration
@@ -68,6 +81,38 @@ func (c *cameraServiceClient) GetImages(ctx context.Context, in *GetImagesReques
 	return out, nil
 }
 
+func (c *cameraServiceClient) StreamImages(ctx context.Context, in *StreamImagesRequest, opts ...grpc.CallOption) (CameraService_StreamImagesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CameraService_ServiceDesc.Streams[0], "/viam.component.camera.v1.CameraService/StreamImages", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cameraServiceStreamImagesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CameraService_StreamImagesClient interface {
+	Recv() (*StreamImagesResponse, error)
+	grpc.ClientStream
+}
+
+type cameraServiceStreamImagesClient struct {
+	grpc.ClientStream
+}
+
+func (x *cameraServiceStreamImagesClient) Recv() (*StreamImagesResponse, error) {
+	m := new(StreamImagesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *cameraServiceClient) RenderFrame(ctx context.Context, in *RenderFrameRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
 	out := new(httpbody.HttpBody)
 	err := c.cc.Invoke(ctx, "/viam.component.camera.v1.CameraService/RenderFrame", in, out, opts...)
@@ -86,6 +131,38 @@ func (c *cameraServiceClient) GetPointCloud(ctx context.Context, in *GetPointClo
 	return out, nil
 }
 
+func (c *cameraServiceClient) GetPointCloudStream(ctx context.Context, in *GetPointCloudStreamRequest, opts ...grpc.CallOption) (CameraService_GetPointCloudStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CameraService_ServiceDesc.Streams[1], "/viam.component.camera.v1.CameraService/GetPointCloudStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cameraServiceGetPointCloudStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CameraService_GetPointCloudStreamClient interface {
+	Recv() (*GetPointCloudStreamResponse, error)
+	grpc.ClientStream
+}
+
+type cameraServiceGetPointCloudStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *cameraServiceGetPointCloudStreamClient) Recv() (*GetPointCloudStreamResponse, error) {
+	m := new(GetPointCloudStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *cameraServiceClient) GetProperties(ctx context.Context, in *GetPropertiesRequest, opts ...grpc.CallOption) (*GetPropertiesResponse, error) {
 	out := new(GetPropertiesResponse)
 	err := c.cc.Invoke(ctx, "/viam.component.camera.v1.CameraService/GetProperties", in, out, opts...)
@@ -95,6 +172,24 @@ func (c *cameraServiceClient) GetProperties(ctx context.Context, in *GetProperti
 	return out, nil
 }
 
+func (c *cameraServiceClient) GetControls(ctx context.Context, in *GetControlsRequest, opts ...grpc.CallOption) (*GetControlsResponse, error) {
+	out := new(GetControlsResponse)
+	err := c.cc.Invoke(ctx, "/viam.component.camera.v1.CameraService/GetControls", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cameraServiceClient) SetControls(ctx context.Context, in *SetControlsRequest, opts ...grpc.CallOption) (*SetControlsResponse, error) {
+	out := new(SetControlsResponse)
+	err := c.cc.Invoke(ctx, "/viam.component.camera.v1.CameraService/SetControls", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cameraServiceClient) DoCommand(ctx context.Context, in *v1.DoCommandRequest, opts ...grpc.CallOption) (*v1.DoCommandResponse, error) {
 	out := new(v1.DoCommandResponse)
 	err := c.cc.Invoke(ctx, "/viam.component.camera.v1.CameraService/DoCommand", in, out, opts...)
@@ -121,14 +216,27 @@ type CameraServiceServer interface {
 	// can be requested but may not necessarily be the same one returned.
 	GetImage(context.Context, *GetImageRequest) (*GetImageResponse, error)
 	GetImages(context.Context, *GetImagesRequest) (*GetImagesResponse, error)
+	// StreamImages returns a stream of frames from a camera of the underlying robot, encoded with the first
+	// of the requested codecs that the camera supports.
+	StreamImages(*StreamImagesRequest, CameraService_StreamImagesServer) error
 	// RenderFrame renders a frame from a camera of the underlying robot to an HTTP response. A specific MIME type
 	// can be requested but may not necessarily be the same one returned.
 	RenderFrame(context.Context, *RenderFrameRequest) (*httpbody.HttpBody, error)
 	// GetPointCloud returns a point cloud from a camera of the underlying robot. A specific MIME type
 	// can be requested but may not necessarily be the same one returned.
 	GetPointCloud(context.Context, *GetPointCloudRequest) (*GetPointCloudResponse, error)
+	// GetPointCloudStream returns a point cloud from a camera of the underlying robot split into chunks, so that
+	// clouds larger than the maximum gRPC message size can be transferred. If continuous is requested, successive
+	// scans are streamed until the stream is closed. A specific MIME type can be requested but may not necessarily
+	// be the same one returned.
+	GetPointCloudStream(*GetPointCloudStreamRequest, CameraService_GetPointCloudStreamServer) error
 	// GetProperties returns the camera intrinsic parameters and camera distortion parameters from a camera of the underlying robot, if available.
 	GetProperties(context.Context, *GetPropertiesRequest) (*GetPropertiesResponse, error)
+	// GetControls returns the current values and supported ranges of the controls of a camera of the underlying robot.
+	GetControls(context.Context, *GetControlsRequest) (*GetControlsResponse, error)
+	// SetControls sets the values of the given controls of a camera of the underlying robot. Controls which are
+	// not given are left unchanged.
+	SetControls(context.Context, *SetControlsRequest) (*SetControlsResponse, error)
 	// DoCommand sends/receives arbitrary commands
 	DoCommand(context.Context, *v1.DoCommandRequest) (*v1.DoCommandResponse, error)
 	// GetGeometries returns the geometries of the component in their current configuration
@@ -146,15 +254,27 @@ func (UnimplementedCameraServiceServer) GetImage(context.Context, *GetImageReque
 func (UnimplementedCameraServiceServer) GetImages(context.Context, *GetImagesRequest) (*GetImagesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetImages not implemented")
 }
+func (UnimplementedCameraServiceServer) StreamImages(*StreamImagesRequest, CameraService_StreamImagesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamImages not implemented")
+}
 func (UnimplementedCameraServiceServer) RenderFrame(context.Context, *RenderFrameRequest) (*httpbody.HttpBody, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RenderFrame not implemented")
 }
 func (UnimplementedCameraServiceServer) GetPointCloud(context.Context, *GetPointCloudRequest) (*GetPointCloudResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPointCloud not implemented")
 }
+func (UnimplementedCameraServiceServer) GetPointCloudStream(*GetPointCloudStreamRequest, CameraService_GetPointCloudStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetPointCloudStream not implemented")
+}
 func (UnimplementedCameraServiceServer) GetProperties(context.Context, *GetPropertiesRequest) (*GetPropertiesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProperties not implemented")
 }
+func (UnimplementedCameraServiceServer) GetControls(context.Context, *GetControlsRequest) (*GetControlsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetControls not implemented")
+}
+func (UnimplementedCameraServiceServer) SetControls(context.Context, *SetControlsRequest) (*SetControlsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetControls not implemented")
+}
 func (UnimplementedCameraServiceServer) DoCommand(context.Context, *v1.DoCommandRequest) (*v1.DoCommandResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DoCommand not implemented")
 }
@@ -210,6 +330,27 @@ func _CameraService_GetImages_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CameraService_StreamImages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamImagesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CameraServiceServer).StreamImages(m, &cameraServiceStreamImagesServer{stream})
+}
+
+type CameraService_StreamImagesServer interface {
+	Send(*StreamImagesResponse) error
+	grpc.ServerStream
+}
+
+type cameraServiceStreamImagesServer struct {
+	grpc.ServerStream
+}
+
+func (x *cameraServiceStreamImagesServer) Send(m *StreamImagesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _CameraService_RenderFrame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RenderFrameRequest)
 	if err := dec(in); err != nil {
@@ -246,6 +387,27 @@ func _CameraService_GetPointCloud_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CameraService_GetPointCloudStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetPointCloudStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CameraServiceServer).GetPointCloudStream(m, &cameraServiceGetPointCloudStreamServer{stream})
+}
+
+type CameraService_GetPointCloudStreamServer interface {
+	Send(*GetPointCloudStreamResponse) error
+	grpc.ServerStream
+}
+
+type cameraServiceGetPointCloudStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *cameraServiceGetPointCloudStreamServer) Send(m *GetPointCloudStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _CameraService_GetProperties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPropertiesRequest)
 	if err := dec(in); err != nil {
@@ -264,6 +426,42 @@ func _CameraService_GetProperties_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CameraService_GetControls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetControlsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CameraServiceServer).GetControls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/viam.component.camera.v1.CameraService/GetControls",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CameraServiceServer).GetControls(ctx, req.(*GetControlsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CameraService_SetControls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetControlsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CameraServiceServer).SetControls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/viam.component.camera.v1.CameraService/SetControls",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CameraServiceServer).SetControls(ctx, req.(*SetControlsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CameraService_DoCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v1.DoCommandRequest)
 	if err := dec(in); err != nil {
@@ -327,6 +525,14 @@ var CameraService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProperties",
 			Handler:    _CameraService_GetProperties_Handler,
 		},
+		{
+			MethodName: "GetControls",
+			Handler:    _CameraService_GetControls_Handler,
+		},
+		{
+			MethodName: "SetControls",
+			Handler:    _CameraService_SetControls_Handler,
+		},
 		{
 			MethodName: "DoCommand",
 			Handler:    _CameraService_DoCommand_Handler,
@@ -336,6 +542,17 @@ var CameraService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _CameraService_GetGeometries_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamImages",
+			Handler:       _CameraService_StreamImages_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetPointCloudStream",
+			Handler:       _CameraService_GetPointCloudStream_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "component/camera/v1/camera.proto",
 }
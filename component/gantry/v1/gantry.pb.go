@@ -23,6 +23,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type InterpolationMode int32
+
+const (
+	InterpolationMode_INTERPOLATION_MODE_UNSPECIFIED InterpolationMode = 0
+	// Each axis moves to its position at its own speed, so axes may arrive at different times
+	InterpolationMode_INTERPOLATION_MODE_INDEPENDENT InterpolationMode = 1
+	// Axes are scaled so that all arrive together and the gantry moves in a straight line.
+	// The given speeds and accelerations are treated as per-axis limits
+	InterpolationMode_INTERPOLATION_MODE_LINEAR InterpolationMode = 2
+)
+
+// Enum value maps for InterpolationMode.
+var (
+	InterpolationMode_name = map[int32]string{
+		0: "INTERPOLATION_MODE_UNSPECIFIED",
+		1: "INTERPOLATION_MODE_INDEPENDENT",
+		2: "INTERPOLATION_MODE_LINEAR",
+	}
+	InterpolationMode_value = map[string]int32{
+		"INTERPOLATION_MODE_UNSPECIFIED": 0,
+		"INTERPOLATION_MODE_INDEPENDENT": 1,
+		"INTERPOLATION_MODE_LINEAR":      2,
+	}
+)
+
+func (x InterpolationMode) Enum() *InterpolationMode {
+	p := new(InterpolationMode)
+	*p = x
+	return p
+}
+
+func (x InterpolationMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InterpolationMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_component_gantry_v1_gantry_proto_enumTypes[0].Descriptor()
+}
+
+func (InterpolationMode) Type() protoreflect.EnumType {
+	return &file_component_gantry_v1_gantry_proto_enumTypes[0]
+}
+
+func (x InterpolationMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InterpolationMode.Descriptor instead.
+func (InterpolationMode) EnumDescriptor() ([]byte, []int) {
+	return file_component_gantry_v1_gantry_proto_rawDescGZIP(), []int{0}
+}
+
 type GetPositionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -136,6 +188,12 @@ type MoveToPositionRequest struct {
 	PositionsMm []float64 `protobuf:"fixed64,2,rep,packed,name=positions_mm,json=positionsMm,proto3" json:"positions_mm,omitempty"`
 	// Speeds to move each gantry axis must match length and order of positions_mm.
 	SpeedsMmPerSec []float64 `protobuf:"fixed64,3,rep,packed,name=speeds_mm_per_sec,json=speedsMmPerSec,proto3" json:"speeds_mm_per_sec,omitempty"`
+	// Accelerations to move each gantry axis with, must match length and order of positions_mm.
+	AccelerationsMmPerSec2 []float64 `protobuf:"fixed64,4,rep,packed,name=accelerations_mm_per_sec2,json=accelerationsMmPerSec2,proto3" json:"accelerations_mm_per_sec2,omitempty"`
+	// Jerk limits for each gantry axis, must match length and order of positions_mm.
+	JerksMmPerSec3 []float64 `protobuf:"fixed64,5,rep,packed,name=jerks_mm_per_sec3,json=jerksMmPerSec3,proto3" json:"jerks_mm_per_sec3,omitempty"`
+	// How the motion of the axes is coordinated. Defaults to INTERPOLATION_MODE_INDEPENDENT
+	InterpolationMode InterpolationMode `protobuf:"varint,6,opt,name=interpolation_mode,json=interpolationMode,proto3,enum=viam.component.gantry.v1.InterpolationMode" json:"interpolation_mode,omitempty"`
 	// Additional arguments to the method
 	Extra *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
 }
@@ -193,6 +251,27 @@ func (x *MoveToPositionRequest) GetSpeedsMmPerSec() []float64 {
 	return nil
 }
 
+func (x *MoveToPositionRequest) GetAccelerationsMmPerSec2() []float64 {
+	if x != nil {
+		return x.AccelerationsMmPerSec2
+	}
+	return nil
+}
+
+func (x *MoveToPositionRequest) GetJerksMmPerSec3() []float64 {
+	if x != nil {
+		return x.JerksMmPerSec3
+	}
+	return nil
+}
+
+func (x *MoveToPositionRequest) GetInterpolationMode() InterpolationMode {
+	if x != nil {
+		return x.InterpolationMode
+	}
+	return InterpolationMode_INTERPOLATION_MODE_UNSPECIFIED
+}
+
 func (x *MoveToPositionRequest) GetExtra() *structpb.Struct {
 	if x != nil {
 		return x.Extra
@@ -238,6 +317,119 @@ func (*MoveToPositionResponse) Descriptor() ([]byte, []int) {
 	return file_component_gantry_v1_gantry_proto_rawDescGZIP(), []int{3}
 }
 
+type SetVelocityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Velocity to move each gantry axis at, must match length and order of the positions returned by GetPosition.
+	// Negative values move towards the axis origin
+	VelocitiesMmPerSec []float64 `protobuf:"fixed64,2,rep,packed,name=velocities_mm_per_sec,json=velocitiesMmPerSec,proto3" json:"velocities_mm_per_sec,omitempty"`
+	// Accelerations to reach the velocities with, must match length and order of velocities_mm_per_sec.
+	AccelerationsMmPerSec2 []float64 `protobuf:"fixed64,3,rep,packed,name=accelerations_mm_per_sec2,json=accelerationsMmPerSec2,proto3" json:"accelerations_mm_per_sec2,omitempty"`
+	// Additional arguments to the method
+	Extra *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
+}
+
+func (x *SetVelocityRequest) Reset() {
+	*x = SetVelocityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_gantry_v1_gantry_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetVelocityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVelocityRequest) ProtoMessage() {}
+
+func (x *SetVelocityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_gantry_v1_gantry_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVelocityRequest.ProtoReflect.Descriptor instead.
+func (*SetVelocityRequest) Descriptor() ([]byte, []int) {
+	return file_component_gantry_v1_gantry_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetVelocityRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetVelocityRequest) GetVelocitiesMmPerSec() []float64 {
+	if x != nil {
+		return x.VelocitiesMmPerSec
+	}
+	return nil
+}
+
+func (x *SetVelocityRequest) GetAccelerationsMmPerSec2() []float64 {
+	if x != nil {
+		return x.AccelerationsMmPerSec2
+	}
+	return nil
+}
+
+func (x *SetVelocityRequest) GetExtra() *structpb.Struct {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+type SetVelocityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetVelocityResponse) Reset() {
+	*x = SetVelocityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_gantry_v1_gantry_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetVelocityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVelocityResponse) ProtoMessage() {}
+
+func (x *SetVelocityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_gantry_v1_gantry_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVelocityResponse.ProtoReflect.Descriptor instead.
+func (*SetVelocityResponse) Descriptor() ([]byte, []int) {
+	return file_component_gantry_v1_gantry_proto_rawDescGZIP(), []int{5}
+}
+
 type HomeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -251,7 +443,7 @@ type HomeRequest struct {
 func (x *HomeRequest) Reset() {
 	*x = HomeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_gantry_v1_gantry_proto_msgTypes[4]
+		mi := &file_component_gantry_v1_gantry_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -264,7 +456,7 @@ func (x *HomeRequest) String() string {
 func (*HomeRequest) ProtoMessage() {}
 
 func (x *HomeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_component_gantry_v1_gantry_proto_msgTypes[4]
+	mi := &file_component_gantry_v1_gantry_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -277,7 +469,7 @@ func (x *HomeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HomeRequest.ProtoReflect.Descriptor instead.
 func (*HomeRequest) Descriptor() ([]byte, []int) {
-	return file_component_gantry_v1_gantry_proto_rawDescGZIP(), []int{4}
+	return file_component_gantry_v1_gantry_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *HomeRequest) GetName() string {
@@ -306,7 +498,7 @@ type HomeResponse struct {
 func (x *HomeResponse) Reset() {
 	*x = HomeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_gantry_v1_gantry_proto_msgTypes[5]
+		mi := &file_component_gantry_v1_gantry_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -319,7 +511,7 @@ func (x *HomeResponse) String() string {
 func (*HomeResponse) ProtoMessage() {}
 
 func (x *HomeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_component_gantry_v1_gantry_proto_msgTypes[5]
+	mi := &file_component_gantry_v1_gantry_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -332,7 +524,7 @@ func (x *HomeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HomeResponse.ProtoReflect.Descriptor instead.
 func (*HomeResponse) Descriptor() ([]byte, []int) {
-	return file_component_gantry_v1_gantry_proto_rawDescGZIP(), []int{5}
+	return file_component_gantry_v1_gantry_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *HomeResponse) GetHomed() bool {
@@ -355,7 +547,7 @@ type GetLengthsRequest struct {
 func (x *GetLengthsRequest) Reset() {
 	*x = GetLengthsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_gantry_v1_gantry_proto_msgTypes[6]
+		mi := &file_component_gantry_v1_gantry_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -368,7 +560,7 @@ func (x *GetLengthsRequest) String() string {
 func (*GetLengthsRequest) ProtoMessage() {}
 
 func (x *GetLengthsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_component_gantry_v1_gantry_proto_msgTypes[6]
+	mi := &file_component_gantry_v1_gantry_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -381,7 +573,7 @@ func (x *GetLengthsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLengthsRequest.ProtoReflect.Descriptor instead.
 func (*GetLengthsRequest) Descriptor() ([]byte, []int) {
-	return file_component_gantry_v1_gantry_proto_rawDescGZIP(), []int{6}
+	return file_component_gantry_v1_gantry_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetLengthsRequest) GetName() string {
@@ -409,7 +601,7 @@ type GetLengthsResponse struct {
 func (x *GetLengthsResponse) Reset() {
 	*x = GetLengthsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_gantry_v1_gantry_proto_msgTypes[7]
+		mi := &file_component_gantry_v1_gantry_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -422,7 +614,7 @@ func (x *GetLengthsResponse) String() string {
 func (*GetLengthsResponse) ProtoMessage() {}
 
 func (x *GetLengthsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_component_gantry_v1_gantry_proto_msgTypes[7]
+	mi := &file_component_gantry_v1_gantry_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -435,7 +627,7 @@ func (x *GetLengthsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLengthsResponse.ProtoReflect.Descriptor instead.
 func (*GetLengthsResponse) Descriptor() ([]byte, []int) {
-	return file_component_gantry_v1_gantry_proto_rawDescGZIP(), []int{7}
+	return file_component_gantry_v1_gantry_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetLengthsResponse) GetLengthsMm() []float64 {
@@ -459,7 +651,7 @@ type StopRequest struct {
 func (x *StopRequest) Reset() {
 	*x = StopRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_gantry_v1_gantry_proto_msgTypes[8]
+		mi := &file_component_gantry_v1_gantry_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -472,7 +664,7 @@ func (x *StopRequest) String() string {
 func (*StopRequest) ProtoMessage() {}
 
 func (x *StopRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_component_gantry_v1_gantry_proto_msgTypes[8]
+	mi := &file_component_gantry_v1_gantry_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -485,7 +677,7 @@ func (x *StopRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopRequest.ProtoReflect.Descriptor instead.
 func (*StopRequest) Descriptor() ([]byte, []int) {
-	return file_component_gantry_v1_gantry_proto_rawDescGZIP(), []int{8}
+	return file_component_gantry_v1_gantry_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *StopRequest) GetName() string {
@@ -511,7 +703,7 @@ type StopResponse struct {
 func (x *StopResponse) Reset() {
 	*x = StopResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_gantry_v1_gantry_proto_msgTypes[9]
+		mi := &file_component_gantry_v1_gantry_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -524,7 +716,7 @@ func (x *StopResponse) String() string {
 func (*StopResponse) ProtoMessage() {}
 
 func (x *StopResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_component_gantry_v1_gantry_proto_msgTypes[9]
+	mi := &file_component_gantry_v1_gantry_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -537,7 +729,7 @@ func (x *StopResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopResponse.ProtoReflect.Descriptor instead.
 func (*StopResponse) Descriptor() ([]byte, []int) {
-	return file_component_gantry_v1_gantry_proto_rawDescGZIP(), []int{9}
+	return file_component_gantry_v1_gantry_proto_rawDescGZIP(), []int{11}
 }
 
 type Status struct {
@@ -553,7 +745,7 @@ type Status struct {
 func (x *Status) Reset() {
 	*x = Status{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_gantry_v1_gantry_proto_msgTypes[10]
+		mi := &file_component_gantry_v1_gantry_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -566,7 +758,7 @@ func (x *Status) String() string {
 func (*Status) ProtoMessage() {}
 
 func (x *Status) ProtoReflect() protoreflect.Message {
-	mi := &file_component_gantry_v1_gantry_proto_msgTypes[10]
+	mi := &file_component_gantry_v1_gantry_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -579,7 +771,7 @@ func (x *Status) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Status.ProtoReflect.Descriptor instead.
 func (*Status) Descriptor() ([]byte, []int) {
-	return file_component_gantry_v1_gantry_proto_rawDescGZIP(), []int{10}
+	return file_component_gantry_v1_gantry_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Status) GetPositionsMm() []float64 {
@@ -614,7 +806,7 @@ type IsMovingRequest struct {
 func (x *IsMovingRequest) Reset() {
 	*x = IsMovingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_gantry_v1_gantry_proto_msgTypes[11]
+		mi := &file_component_gantry_v1_gantry_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -627,7 +819,7 @@ func (x *IsMovingRequest) String() string {
 func (*IsMovingRequest) ProtoMessage() {}
 
 func (x *IsMovingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_component_gantry_v1_gantry_proto_msgTypes[11]
+	mi := &file_component_gantry_v1_gantry_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -640,7 +832,7 @@ func (x *IsMovingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsMovingRequest.ProtoReflect.Descriptor instead.
 func (*IsMovingRequest) Descriptor() ([]byte, []int) {
-	return file_component_gantry_v1_gantry_proto_rawDescGZIP(), []int{11}
+	return file_component_gantry_v1_gantry_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *IsMovingRequest) GetName() string {
@@ -661,7 +853,7 @@ type IsMovingResponse struct {
 func (x *IsMovingResponse) Reset() {
 	*x = IsMovingResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_gantry_v1_gantry_proto_msgTypes[12]
+		mi := &file_component_gantry_v1_gantry_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -674,7 +866,7 @@ func (x *IsMovingResponse) String() string {
 func (*IsMovingResponse) ProtoMessage() {}
 
 func (x *IsMovingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_component_gantry_v1_gantry_proto_msgTypes[12]
+	mi := &file_component_gantry_v1_gantry_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -687,7 +879,7 @@ func (x *IsMovingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsMovingResponse.ProtoReflect.Descriptor instead.
 func (*IsMovingResponse) Descriptor() ([]byte, []int) {
-	return file_component_gantry_v1_gantry_proto_rawDescGZIP(), []int{12}
+	return file_component_gantry_v1_gantry_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *IsMovingResponse) GetIsMoving() bool {
@@ -718,7 +910,7 @@ var file_component_gantry_v1_gantry_proto_rawDesc = []byte{
 	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x6d, 0x6d,
 	0x18, 0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x4d, 0x6d, 0x22, 0xa8, 0x01, 0x0a, 0x15, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x50, 0x6f,
+	0x73, 0x4d, 0x6d, 0x22, 0xea, 0x02, 0x0a, 0x15, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x50, 0x6f,
 	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
 	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
 	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x6d,
@@ -726,128 +918,173 @@ var file_component_gantry_v1_gantry_proto_rawDesc = []byte{
 	0x6e, 0x73, 0x4d, 0x6d, 0x12, 0x29, 0x0a, 0x11, 0x73, 0x70, 0x65, 0x65, 0x64, 0x73, 0x5f, 0x6d,
 	0x6d, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x03, 0x20, 0x03, 0x28, 0x01, 0x52,
 	0x0e, 0x73, 0x70, 0x65, 0x65, 0x64, 0x73, 0x4d, 0x6d, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12,
-	0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x18,
-	0x0a, 0x16, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x50, 0x0a, 0x0b, 0x48, 0x6f, 0x6d, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x65,
-	0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x24, 0x0a, 0x0c, 0x48, 0x6f,
-	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f,
-	0x6d, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x68, 0x6f, 0x6d, 0x65, 0x64,
-	0x22, 0x56, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74,
-	0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
-	0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x33, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c,
-	0x65, 0x6e, 0x67, 0x74, 0x68, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d,
-	0x0a, 0x0a, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x73, 0x5f, 0x6d, 0x6d, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x01, 0x52, 0x09, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x73, 0x4d, 0x6d, 0x22, 0x50, 0x0a,
-	0x0b, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x39, 0x0a, 0x19, 0x61, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x5f, 0x6d, 0x6d, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x32, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x01, 0x52, 0x16, 0x61, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x4d, 0x6d, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x32, 0x12, 0x29, 0x0a, 0x11, 0x6a, 0x65,
+	0x72, 0x6b, 0x73, 0x5f, 0x6d, 0x6d, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x33, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x01, 0x52, 0x0e, 0x6a, 0x65, 0x72, 0x6b, 0x73, 0x4d, 0x6d, 0x50, 0x65,
+	0x72, 0x53, 0x65, 0x63, 0x33, 0x12, 0x5a, 0x0a, 0x12, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x6f,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x2b, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x2e, 0x67, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x70, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x11,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61,
+	0x22, 0x18, 0x0a, 0x16, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc5, 0x01, 0x0a, 0x12, 0x53,
+	0x65, 0x74, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x15, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x5f, 0x6d, 0x6d, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x01, 0x52, 0x12, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x4d, 0x6d, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x39, 0x0a, 0x19, 0x61, 0x63, 0x63, 0x65,
+	0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x6d, 0x6d, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x73, 0x65, 0x63, 0x32, 0x18, 0x03, 0x20, 0x03, 0x28, 0x01, 0x52, 0x16, 0x61, 0x63, 0x63,
+	0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4d, 0x6d, 0x50, 0x65, 0x72, 0x53,
+	0x65, 0x63, 0x32, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74,
+	0x72, 0x61, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x50, 0x0a, 0x0b, 0x48, 0x6f, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x05,
+	0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x24, 0x0a, 0x0c, 0x48,
+	0x6f, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x68,
+	0x6f, 0x6d, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x68, 0x6f, 0x6d, 0x65,
+	0x64, 0x22, 0x56, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x33, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x73, 0x5f, 0x6d, 0x6d, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x73, 0x4d, 0x6d, 0x22, 0x50,
+	0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61,
+	0x22, 0x0e, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x67, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x6d, 0x6d, 0x18, 0x01, 0x20, 0x03, 0x28, 0x01,
+	0x52, 0x0b, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4d, 0x6d, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x73, 0x5f, 0x6d, 0x6d, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x01, 0x52, 0x09, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x73, 0x4d, 0x6d, 0x12, 0x1b, 0x0a, 0x09,
+	0x69, 0x73, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x69, 0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x22, 0x25, 0x0a, 0x0f, 0x49, 0x73, 0x4d,
+	0x6f, 0x76, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
 	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22,
-	0x0e, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x67, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x6d, 0x6d, 0x18, 0x01, 0x20, 0x03, 0x28, 0x01, 0x52,
-	0x0b, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4d, 0x6d, 0x12, 0x1d, 0x0a, 0x0a,
-	0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x73, 0x5f, 0x6d, 0x6d, 0x18, 0x02, 0x20, 0x03, 0x28, 0x01,
-	0x52, 0x09, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x73, 0x4d, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x69,
-	0x73, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
-	0x69, 0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x22, 0x25, 0x0a, 0x0f, 0x49, 0x73, 0x4d, 0x6f,
-	0x76, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
-	0x2f, 0x0a, 0x10, 0x49, 0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67,
-	0x32, 0xda, 0x09, 0x0a, 0x0d, 0x47, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0xa1, 0x01, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x2c, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
-	0x65, 0x6e, 0x74, 0x2e, 0x67, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2d, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
-	0x74, 0x2e, 0x67, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50,
-	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f,
-	0x67, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0xae, 0x01, 0x0a, 0x0e, 0x4d, 0x6f, 0x76, 0x65, 0x54,
-	0x6f, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x76, 0x69, 0x61, 0x6d,
+	0x22, 0x2f, 0x0a, 0x10, 0x49, 0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x6e,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e,
+	0x67, 0x2a, 0x7a, 0x0a, 0x11, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x6f, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x1e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x50,
+	0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x49, 0x4e,
+	0x54, 0x45, 0x52, 0x50, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
+	0x5f, 0x49, 0x4e, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x1d,
+	0x0a, 0x19, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x50, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x45, 0x41, 0x52, 0x10, 0x02, 0x32, 0x86, 0x0b,
+	0x0a, 0x0d, 0x47, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0xa1, 0x01, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x2c, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x2e, 0x67, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e,
+	0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x67,
+	0x61, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x67, 0x61, 0x6e,
+	0x74, 0x72, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0xae, 0x01, 0x0a, 0x0e, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x67, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x67, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0xa0, 0x92, 0x29, 0x01, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x1a, 0x2d, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x67, 0x61,
+	0x6e, 0x74, 0x72, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0xa9, 0x01, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x56, 0x65, 0x6c, 0x6f,
+	0x63, 0x69, 0x74, 0x79, 0x12, 0x2c, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x67, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x74, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x2e, 0x67, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x74, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x3d, 0xa0, 0x92, 0x29, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x22, 0x31, 0x2f,
+	0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x67, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2f, 0x7b, 0x6e, 0x61,
+	0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x65, 0x74, 0x5f, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79,
+	0x12, 0x88, 0x01, 0x0a, 0x04, 0x48, 0x6f, 0x6d, 0x65, 0x12, 0x25, 0x2e, 0x76, 0x69, 0x61, 0x6d,
 	0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x67, 0x61, 0x6e, 0x74, 0x72,
-	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x50, 0x6f, 0x73, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x76, 0x69, 0x61,
-	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x67, 0x61, 0x6e, 0x74,
-	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x50, 0x6f, 0x73, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0xa0, 0x92,
-	0x29, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x1a, 0x2d, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
-	0x2f, 0x67, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x88, 0x01, 0x0a, 0x04, 0x48, 0x6f, 0x6d, 0x65,
-	0x12, 0x25, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x26, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
 	0x74, 0x2e, 0x67, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6d, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b,
+	0x1a, 0x29, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x67, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2f,
+	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x68, 0x6f, 0x6d, 0x65, 0x12, 0x9d, 0x01, 0x0a, 0x0a,
+	0x47, 0x65, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x73, 0x12, 0x2b, 0x2e, 0x76, 0x69, 0x61,
+	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x67, 0x61, 0x6e, 0x74,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63,
 	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x67, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2e,
-	0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x1a, 0x29, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f,
-	0x67, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x68, 0x6f,
-	0x6d, 0x65, 0x12, 0x9d, 0x01, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68,
-	0x73, 0x12, 0x2b, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
-	0x6e, 0x74, 0x2e, 0x67, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
-	0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e,
-	0x67, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x6e,
-	0x67, 0x74, 0x68, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x67, 0x61, 0x6e,
-	0x74, 0x72, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6c, 0x65, 0x6e, 0x67, 0x74,
-	0x68, 0x73, 0x12, 0x88, 0x01, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x25, 0x2e, 0x76, 0x69,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f,
+	0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x67, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2f, 0x7b, 0x6e, 0x61,
+	0x6d, 0x65, 0x7d, 0x2f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x73, 0x12, 0x88, 0x01, 0x0a, 0x04,
+	0x53, 0x74, 0x6f, 0x70, 0x12, 0x25, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x67, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x76, 0x69,
 	0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x67, 0x61, 0x6e,
-	0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
-	0x65, 0x6e, 0x74, 0x2e, 0x67, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
-	0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x2b, 0x22, 0x29, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x22, 0x29, 0x2f, 0x76, 0x69,
+	0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x2f, 0x67, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65,
+	0x7d, 0x2f, 0x73, 0x74, 0x6f, 0x70, 0x12, 0x99, 0x01, 0x0a, 0x08, 0x49, 0x73, 0x4d, 0x6f, 0x76,
+	0x69, 0x6e, 0x67, 0x12, 0x29, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x67, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
+	0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e,
+	0x67, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x4d, 0x6f, 0x76, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x30, 0x12, 0x2e, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
 	0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x67, 0x61, 0x6e, 0x74, 0x72,
-	0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x74, 0x6f, 0x70, 0x12, 0x99, 0x01,
-	0x0a, 0x08, 0x49, 0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x12, 0x29, 0x2e, 0x76, 0x69, 0x61,
-	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x67, 0x61, 0x6e, 0x74,
-	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d,
-	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x67, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31,
-	0x2e, 0x49, 0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x12, 0x2e, 0x2f, 0x76, 0x69, 0x61, 0x6d,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
-	0x74, 0x2f, 0x67, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f,
-	0x69, 0x73, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x12, 0x89, 0x01, 0x0a, 0x09, 0x44, 0x6f,
-	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x20, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
-	0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76, 0x69, 0x61, 0x6d,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x43, 0x6f, 0x6d,
-	0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x31, 0x22, 0x2f, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x67, 0x61, 0x6e,
-	0x74, 0x72, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x6f, 0x5f, 0x63, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x95, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6f,
-	0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6f, 0x6d,
-	0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
-	0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f, 0x76,
-	0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
-	0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x67, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d,
-	0x65, 0x7d, 0x2f, 0x67, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x42, 0x43, 0x0a,
-	0x1c, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
-	0x65, 0x6e, 0x74, 0x2e, 0x67, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x5a, 0x23, 0x67,
-	0x6f, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63,
-	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x67, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2f,
-	0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x69, 0x73, 0x5f, 0x6d, 0x6f, 0x76, 0x69,
+	0x6e, 0x67, 0x12, 0x89, 0x01, 0x0a, 0x09, 0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x12, 0x20, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x22, 0x2f, 0x2f,
+	0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x67, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2f, 0x7b, 0x6e, 0x61,
+	0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x6f, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x95,
+	0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x24, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6f, 0x6d, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x67, 0x61,
+	0x6e, 0x74, 0x72, 0x79, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x67, 0x65, 0x6f, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x42, 0x43, 0x0a, 0x1c, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x69,
+	0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x67, 0x61, 0x6e,
+	0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x5a, 0x23, 0x67, 0x6f, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x2f, 0x67, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -862,54 +1099,62 @@ func file_component_gantry_v1_gantry_proto_rawDescGZIP() []byte {
 	return file_component_gantry_v1_gantry_proto_rawDescData
 }
 
-var file_component_gantry_v1_gantry_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_component_gantry_v1_gantry_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_component_gantry_v1_gantry_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_component_gantry_v1_gantry_proto_goTypes = []interface{}{
-	(*GetPositionRequest)(nil),       // 0: viam.component.gantry.v1.GetPositionRequest
-	(*GetPositionResponse)(nil),      // 1: viam.component.gantry.v1.GetPositionResponse
-	(*MoveToPositionRequest)(nil),    // 2: viam.component.gantry.v1.MoveToPositionRequest
-	(*MoveToPositionResponse)(nil),   // 3: viam.component.gantry.v1.MoveToPositionResponse
-	(*HomeRequest)(nil),              // 4: viam.component.gantry.v1.HomeRequest
-	(*HomeResponse)(nil),             // 5: viam.component.gantry.v1.HomeResponse
-	(*GetLengthsRequest)(nil),        // 6: viam.component.gantry.v1.GetLengthsRequest
-	(*GetLengthsResponse)(nil),       // 7: viam.component.gantry.v1.GetLengthsResponse
-	(*StopRequest)(nil),              // 8: viam.component.gantry.v1.StopRequest
-	(*StopResponse)(nil),             // 9: viam.component.gantry.v1.StopResponse
-	(*Status)(nil),                   // 10: viam.component.gantry.v1.Status
-	(*IsMovingRequest)(nil),          // 11: viam.component.gantry.v1.IsMovingRequest
-	(*IsMovingResponse)(nil),         // 12: viam.component.gantry.v1.IsMovingResponse
-	(*structpb.Struct)(nil),          // 13: google.protobuf.Struct
-	(*v1.DoCommandRequest)(nil),      // 14: viam.common.v1.DoCommandRequest
-	(*v1.GetGeometriesRequest)(nil),  // 15: viam.common.v1.GetGeometriesRequest
-	(*v1.DoCommandResponse)(nil),     // 16: viam.common.v1.DoCommandResponse
-	(*v1.GetGeometriesResponse)(nil), // 17: viam.common.v1.GetGeometriesResponse
+	(InterpolationMode)(0),           // 0: viam.component.gantry.v1.InterpolationMode
+	(*GetPositionRequest)(nil),       // 1: viam.component.gantry.v1.GetPositionRequest
+	(*GetPositionResponse)(nil),      // 2: viam.component.gantry.v1.GetPositionResponse
+	(*MoveToPositionRequest)(nil),    // 3: viam.component.gantry.v1.MoveToPositionRequest
+	(*MoveToPositionResponse)(nil),   // 4: viam.component.gantry.v1.MoveToPositionResponse
+	(*SetVelocityRequest)(nil),       // 5: viam.component.gantry.v1.SetVelocityRequest
+	(*SetVelocityResponse)(nil),      // 6: viam.component.gantry.v1.SetVelocityResponse
+	(*HomeRequest)(nil),              // 7: viam.component.gantry.v1.HomeRequest
+	(*HomeResponse)(nil),             // 8: viam.component.gantry.v1.HomeResponse
+	(*GetLengthsRequest)(nil),        // 9: viam.component.gantry.v1.GetLengthsRequest
+	(*GetLengthsResponse)(nil),       // 10: viam.component.gantry.v1.GetLengthsResponse
+	(*StopRequest)(nil),              // 11: viam.component.gantry.v1.StopRequest
+	(*StopResponse)(nil),             // 12: viam.component.gantry.v1.StopResponse
+	(*Status)(nil),                   // 13: viam.component.gantry.v1.Status
+	(*IsMovingRequest)(nil),          // 14: viam.component.gantry.v1.IsMovingRequest
+	(*IsMovingResponse)(nil),         // 15: viam.component.gantry.v1.IsMovingResponse
+	(*structpb.Struct)(nil),          // 16: google.protobuf.Struct
+	(*v1.DoCommandRequest)(nil),      // 17: viam.common.v1.DoCommandRequest
+	(*v1.GetGeometriesRequest)(nil),  // 18: viam.common.v1.GetGeometriesRequest
+	(*v1.DoCommandResponse)(nil),     // 19: viam.common.v1.DoCommandResponse
+	(*v1.GetGeometriesResponse)(nil), // 20: viam.common.v1.GetGeometriesResponse
 }
 var file_component_gantry_v1_gantry_proto_depIdxs = []int32{
-	13, // 0: viam.component.gantry.v1.GetPositionRequest.extra:type_name -> google.protobuf.Struct
-	13, // 1: viam.component.gantry.v1.MoveToPositionRequest.extra:type_name -> google.protobuf.Struct
-	13, // 2: viam.component.gantry.v1.HomeRequest.extra:type_name -> google.protobuf.Struct
-	13, // 3: viam.component.gantry.v1.GetLengthsRequest.extra:type_name -> google.protobuf.Struct
-	13, // 4: viam.component.gantry.v1.StopRequest.extra:type_name -> google.protobuf.Struct
-	0,  // 5: viam.component.gantry.v1.GantryService.GetPosition:input_type -> viam.component.gantry.v1.GetPositionRequest
-	2,  // 6: viam.component.gantry.v1.GantryService.MoveToPosition:input_type -> viam.component.gantry.v1.MoveToPositionRequest
-	4,  // 7: viam.component.gantry.v1.GantryService.Home:input_type -> viam.component.gantry.v1.HomeRequest
-	6,  // 8: viam.component.gantry.v1.GantryService.GetLengths:input_type -> viam.component.gantry.v1.GetLengthsRequest
-	8,  // 9: viam.component.gantry.v1.GantryService.Stop:input_type -> viam.component.gantry.v1.StopRequest
-	11, // 10: viam.component.gantry.v1.GantryService.IsMoving:input_type -> viam.component.gantry.v1.IsMovingRequest
-	14, // 11: viam.component.gantry.v1.GantryService.DoCommand:input_type -> viam.common.v1.DoCommandRequest
-	15, // 12: viam.component.gantry.v1.GantryService.GetGeometries:input_type -> viam.common.v1.GetGeometriesRequest
-	1,  // 13: viam.component.gantry.v1.GantryService.GetPosition:output_type -> viam.component.gantry.v1.GetPositionResponse
-	3,  // 14: viam.component.gantry.v1.GantryService.MoveToPosition:output_type -> viam.component.gantry.v1.MoveToPositionResponse
-	5,  // 15: viam.component.gantry.v1.GantryService.Home:output_type -> viam.component.gantry.v1.HomeResponse
-	7,  // 16: viam.component.gantry.v1.GantryService.GetLengths:output_type -> viam.component.gantry.v1.GetLengthsResponse
-	9,  // 17: viam.component.gantry.v1.GantryService.Stop:output_type -> viam.component.gantry.v1.StopResponse
-	12, // 18: viam.component.gantry.v1.GantryService.IsMoving:output_type -> viam.component.gantry.v1.IsMovingResponse
-	16, // 19: viam.component.gantry.v1.GantryService.DoCommand:output_type -> viam.common.v1.DoCommandResponse
-	17, // 20: viam.component.gantry.v1.GantryService.GetGeometries:output_type -> viam.common.v1.GetGeometriesResponse
-	13, // [13:21] is the sub-list for method output_type
-	5,  // [5:13] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	16, // 0: viam.component.gantry.v1.GetPositionRequest.extra:type_name -> google.protobuf.Struct
+	0,  // 1: viam.component.gantry.v1.MoveToPositionRequest.interpolation_mode:type_name -> viam.component.gantry.v1.InterpolationMode
+	16, // 2: viam.component.gantry.v1.MoveToPositionRequest.extra:type_name -> google.protobuf.Struct
+	16, // 3: viam.component.gantry.v1.SetVelocityRequest.extra:type_name -> google.protobuf.Struct
+	16, // 4: viam.component.gantry.v1.HomeRequest.extra:type_name -> google.protobuf.Struct
+	16, // 5: viam.component.gantry.v1.GetLengthsRequest.extra:type_name -> google.protobuf.Struct
+	16, // 6: viam.component.gantry.v1.StopRequest.extra:type_name -> google.protobuf.Struct
+	1,  // 7: viam.component.gantry.v1.GantryService.GetPosition:input_type -> viam.component.gantry.v1.GetPositionRequest
+	3,  // 8: viam.component.gantry.v1.GantryService.MoveToPosition:input_type -> viam.component.gantry.v1.MoveToPositionRequest
+	5,  // 9: viam.component.gantry.v1.GantryService.SetVelocity:input_type -> viam.component.gantry.v1.SetVelocityRequest
+	7,  // 10: viam.component.gantry.v1.GantryService.Home:input_type -> viam.component.gantry.v1.HomeRequest
+	9,  // 11: viam.component.gantry.v1.GantryService.GetLengths:input_type -> viam.component.gantry.v1.GetLengthsRequest
+	11, // 12: viam.component.gantry.v1.GantryService.Stop:input_type -> viam.component.gantry.v1.StopRequest
+	14, // 13: viam.component.gantry.v1.GantryService.IsMoving:input_type -> viam.component.gantry.v1.IsMovingRequest
+	17, // 14: viam.component.gantry.v1.GantryService.DoCommand:input_type -> viam.common.v1.DoCommandRequest
+	18, // 15: viam.component.gantry.v1.GantryService.GetGeometries:input_type -> viam.common.v1.GetGeometriesRequest
+	2,  // 16: viam.component.gantry.v1.GantryService.GetPosition:output_type -> viam.component.gantry.v1.GetPositionResponse
+	4,  // 17: viam.component.gantry.v1.GantryService.MoveToPosition:output_type -> viam.component.gantry.v1.MoveToPositionResponse
+	6,  // 18: viam.component.gantry.v1.GantryService.SetVelocity:output_type -> viam.component.gantry.v1.SetVelocityResponse
+	8,  // 19: viam.component.gantry.v1.GantryService.Home:output_type -> viam.component.gantry.v1.HomeResponse
+	10, // 20: viam.component.gantry.v1.GantryService.GetLengths:output_type -> viam.component.gantry.v1.GetLengthsResponse
+	12, // 21: viam.component.gantry.v1.GantryService.Stop:output_type -> viam.component.gantry.v1.StopResponse
+	15, // 22: viam.component.gantry.v1.GantryService.IsMoving:output_type -> viam.component.gantry.v1.IsMovingResponse
+	19, // 23: viam.component.gantry.v1.GantryService.DoCommand:output_type -> viam.common.v1.DoCommandResponse
+	20, // 24: viam.component.gantry.v1.GantryService.GetGeometries:output_type -> viam.common.v1.GetGeometriesResponse
+	16, // [16:25] is the sub-list for method output_type
+	7,  // [7:16] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_component_gantry_v1_gantry_proto_init() }
@@ -967,7 +1212,7 @@ func file_component_gantry_v1_gantry_proto_init() {
 			}
 		}
 		file_component_gantry_v1_gantry_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HomeRequest); i {
+			switch v := v.(*SetVelocityRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -979,7 +1224,7 @@ func file_component_gantry_v1_gantry_proto_init() {
 			}
 		}
 		file_component_gantry_v1_gantry_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HomeResponse); i {
+			switch v := v.(*SetVelocityResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -991,7 +1236,7 @@ func file_component_gantry_v1_gantry_proto_init() {
 			}
 		}
 		file_component_gantry_v1_gantry_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLengthsRequest); i {
+			switch v := v.(*HomeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1003,7 +1248,7 @@ func file_component_gantry_v1_gantry_proto_init() {
 			}
 		}
 		file_component_gantry_v1_gantry_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLengthsResponse); i {
+			switch v := v.(*HomeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1015,7 +1260,7 @@ func file_component_gantry_v1_gantry_proto_init() {
 			}
 		}
 		file_component_gantry_v1_gantry_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopRequest); i {
+			switch v := v.(*GetLengthsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1027,7 +1272,7 @@ func file_component_gantry_v1_gantry_proto_init() {
 			}
 		}
 		file_component_gantry_v1_gantry_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopResponse); i {
+			switch v := v.(*GetLengthsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1039,7 +1284,7 @@ func file_component_gantry_v1_gantry_proto_init() {
 			}
 		}
 		file_component_gantry_v1_gantry_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Status); i {
+			switch v := v.(*StopRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1051,7 +1296,7 @@ func file_component_gantry_v1_gantry_proto_init() {
 			}
 		}
 		file_component_gantry_v1_gantry_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsMovingRequest); i {
+			switch v := v.(*StopResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1063,6 +1308,30 @@ func file_component_gantry_v1_gantry_proto_init() {
 			}
 		}
 		file_component_gantry_v1_gantry_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Status); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_gantry_v1_gantry_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsMovingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_gantry_v1_gantry_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IsMovingResponse); i {
 			case 0:
 				return &v.state
@@ -1080,13 +1349,14 @@ func file_component_gantry_v1_gantry_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_component_gantry_v1_gantry_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   13,
+			NumEnums:      1,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_component_gantry_v1_gantry_proto_goTypes,
 		DependencyIndexes: file_component_gantry_v1_gantry_proto_depIdxs,
+		EnumInfos:         file_component_gantry_v1_gantry_proto_enumTypes,
 		MessageInfos:      file_component_gantry_v1_gantry_proto_msgTypes,
 	}.Build()
 	File_component_gantry_v1_gantry_proto = out.File
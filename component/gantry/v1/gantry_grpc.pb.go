@@ -27,6 +27,8 @@ type GantryServiceClient interface {
 	GetPosition(ctx context.Context, in *GetPositionRequest, opts ...grpc.CallOption) (*GetPositionResponse, error)
 	// MoveToPosition moves a gantry of the underlying robot to the requested position.
 	MoveToPosition(ctx context.Context, in *MoveToPositionRequest, opts ...grpc.CallOption) (*MoveToPositionResponse, error)
+	// SetVelocity jogs each axis of a gantry at the requested velocity until stopped or a new operation cancels this one
+	SetVelocity(ctx context.Context, in *SetVelocityRequest, opts ...grpc.CallOption) (*SetVelocityResponse, error)
 	// Home runs the homing sequence of a gantry and returns true once it's completed.
 	Home(ctx context.Context, in *HomeRequest, opts ...grpc.CallOption) (*HomeResponse, error)
 	// GetLengths gets the lengths of a gantry of the underlying robot.
@@ -67,6 +69,15 @@ func (c *gantryServiceClient) MoveToPosition(ctx context.Context, in *MoveToPosi
 	return out, nil
 }
 
+func (c *gantryServiceClient) SetVelocity(ctx context.Context, in *SetVelocityRequest, opts ...grpc.CallOption) (*SetVelocityResponse, error) {
+	out := new(SetVelocityResponse)
+	err := c.cc.Invoke(ctx, "/viam.component.gantry.v1.GantryService/SetVelocity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gantryServiceClient) Home(ctx context.Context, in *HomeRequest, opts ...grpc.CallOption) (*HomeResponse, error) {
 	out := new(HomeResponse)
 	err := c.cc.Invoke(ctx, "/viam.component.gantry.v1.GantryService/Home", in, out, opts...)
@@ -129,6 +140,8 @@ type GantryServiceServer interface {
 	GetPosition(context.Context, *GetPositionRequest) (*GetPositionResponse, error)
 	// MoveToPosition moves a gantry of the underlying robot to the requested position.
 	MoveToPosition(context.Context, *MoveToPositionRequest) (*MoveToPositionResponse, error)
+	// SetVelocity jogs each axis of a gantry at the requested velocity until stopped or a new operation cancels this one
+	SetVelocity(context.Context, *SetVelocityRequest) (*SetVelocityResponse, error)
 	// Home runs the homing sequence of a gantry and returns true once it's completed.
 	Home(context.Context, *HomeRequest) (*HomeResponse, error)
 	// GetLengths gets the lengths of a gantry of the underlying robot.
@@ -154,6 +167,9 @@ func (UnimplementedGantryServiceServer) GetPosition(context.Context, *GetPositio
 func (UnimplementedGantryServiceServer) MoveToPosition(context.Context, *MoveToPositionRequest) (*MoveToPositionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MoveToPosition not implemented")
 }
+func (UnimplementedGantryServiceServer) SetVelocity(context.Context, *SetVelocityRequest) (*SetVelocityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVelocity not implemented")
+}
 func (UnimplementedGantryServiceServer) Home(context.Context, *HomeRequest) (*HomeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Home not implemented")
 }
@@ -221,6 +237,24 @@ func _GantryService_MoveToPosition_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GantryService_SetVelocity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetVelocityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GantryServiceServer).SetVelocity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/viam.component.gantry.v1.GantryService/SetVelocity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GantryServiceServer).SetVelocity(ctx, req.(*SetVelocityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GantryService_Home_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(HomeRequest)
 	if err := dec(in); err != nil {
@@ -344,6 +378,10 @@ var GantryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MoveToPosition",
 			Handler:    _GantryService_MoveToPosition_Handler,
 		},
+		{
+			MethodName: "SetVelocity",
+			Handler:    _GantryService_SetVelocity_Handler,
+		},
 		{
 			MethodName: "Home",
 			Handler:    _GantryService_Home_Handler,
@@ -27,6 +27,13 @@ type GripperServiceClient interface {
 	Open(ctx context.Context, in *OpenRequest, opts ...grpc.CallOption) (*OpenResponse, error)
 	// Grab requests a gripper of the underlying robot to grab.
 	Grab(ctx context.Context, in *GrabRequest, opts ...grpc.CallOption) (*GrabResponse, error)
+	// MoveToWidth moves the jaws of a gripper of the underlying robot to the requested width,
+	// stopping early if the requested force is reached.
+	MoveToWidth(ctx context.Context, in *MoveToWidthRequest, opts ...grpc.CallOption) (*MoveToWidthResponse, error)
+	// GetState returns the current width, force and object detection state of a gripper
+	GetState(ctx context.Context, in *GetStateRequest, opts ...grpc.CallOption) (*GetStateResponse, error)
+	// GetProperties returns the width and force ranges supported by a gripper
+	GetProperties(ctx context.Context, in *GetPropertiesRequest, opts ...grpc.CallOption) (*GetPropertiesResponse, error)
 	// Stop stops a robot's gripper
 	Stop(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error)
 	// IsMoving reports if a component is in motion
@@ -63,6 +70,33 @@ func (c *gripperServiceClient) Grab(ctx context.Context, in *GrabRequest, opts .
 	return out, nil
 }
 
+func (c *gripperServiceClient) MoveToWidth(ctx context.Context, in *MoveToWidthRequest, opts ...grpc.CallOption) (*MoveToWidthResponse, error) {
+	out := new(MoveToWidthResponse)
+	err := c.cc.Invoke(ctx, "/viam.component.gripper.v1.GripperService/MoveToWidth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gripperServiceClient) GetState(ctx context.Context, in *GetStateRequest, opts ...grpc.CallOption) (*GetStateResponse, error) {
+	out := new(GetStateResponse)
+	err := c.cc.Invoke(ctx, "/viam.component.gripper.v1.GripperService/GetState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gripperServiceClient) GetProperties(ctx context.Context, in *GetPropertiesRequest, opts ...grpc.CallOption) (*GetPropertiesResponse, error) {
+	out := new(GetPropertiesResponse)
+	err := c.cc.Invoke(ctx, "/viam.component.gripper.v1.GripperService/GetProperties", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gripperServiceClient) Stop(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error) {
 	out := new(StopResponse)
 	err := c.cc.Invoke(ctx, "/viam.component.gripper.v1.GripperService/Stop", in, out, opts...)
@@ -107,6 +141,13 @@ type GripperServiceServer interface {
 	Open(context.Context, *OpenRequest) (*OpenResponse, error)
 	// Grab requests a gripper of the underlying robot to grab.
 	Grab(context.Context, *GrabRequest) (*GrabResponse, error)
+	// MoveToWidth moves the jaws of a gripper of the underlying robot to the requested width,
+	// stopping early if the requested force is reached.
+	MoveToWidth(context.Context, *MoveToWidthRequest) (*MoveToWidthResponse, error)
+	// GetState returns the current width, force and object detection state of a gripper
+	GetState(context.Context, *GetStateRequest) (*GetStateResponse, error)
+	// GetProperties returns the width and force ranges supported by a gripper
+	GetProperties(context.Context, *GetPropertiesRequest) (*GetPropertiesResponse, error)
 	// Stop stops a robot's gripper
 	Stop(context.Context, *StopRequest) (*StopResponse, error)
 	// IsMoving reports if a component is in motion
@@ -128,6 +169,15 @@ func (UnimplementedGripperServiceServer) Open(context.Context, *OpenRequest) (*O
 func (UnimplementedGripperServiceServer) Grab(context.Context, *GrabRequest) (*GrabResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Grab not implemented")
 }
+func (UnimplementedGripperServiceServer) MoveToWidth(context.Context, *MoveToWidthRequest) (*MoveToWidthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveToWidth not implemented")
+}
+func (UnimplementedGripperServiceServer) GetState(context.Context, *GetStateRequest) (*GetStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetState not implemented")
+}
+func (UnimplementedGripperServiceServer) GetProperties(context.Context, *GetPropertiesRequest) (*GetPropertiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProperties not implemented")
+}
 func (UnimplementedGripperServiceServer) Stop(context.Context, *StopRequest) (*StopResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Stop not implemented")
 }
@@ -189,6 +239,60 @@ func _GripperService_Grab_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GripperService_MoveToWidth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveToWidthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GripperServiceServer).MoveToWidth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/viam.component.gripper.v1.GripperService/MoveToWidth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GripperServiceServer).MoveToWidth(ctx, req.(*MoveToWidthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GripperService_GetState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GripperServiceServer).GetState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/viam.component.gripper.v1.GripperService/GetState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GripperServiceServer).GetState(ctx, req.(*GetStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GripperService_GetProperties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPropertiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GripperServiceServer).GetProperties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/viam.component.gripper.v1.GripperService/GetProperties",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GripperServiceServer).GetProperties(ctx, req.(*GetPropertiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GripperService_Stop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StopRequest)
 	if err := dec(in); err != nil {
@@ -276,6 +380,18 @@ var GripperService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Grab",
 			Handler:    _GripperService_Grab_Handler,
 		},
+		{
+			MethodName: "MoveToWidth",
+			Handler:    _GripperService_MoveToWidth_Handler,
+		},
+		{
+			MethodName: "GetState",
+			Handler:    _GripperService_GetState_Handler,
+		},
+		{
+			MethodName: "GetProperties",
+			Handler:    _GripperService_GetProperties_Handler,
+		},
 		{
 			MethodName: "Stop",
 			Handler:    _GripperService_Stop_Handler,
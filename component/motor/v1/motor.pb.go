@@ -12,6 +12,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -23,6 +24,72 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type MotorFaultCode int32
+
+const (
+	MotorFaultCode_MOTOR_FAULT_CODE_UNSPECIFIED      MotorFaultCode = 0
+	MotorFaultCode_MOTOR_FAULT_CODE_OVER_CURRENT     MotorFaultCode = 1
+	MotorFaultCode_MOTOR_FAULT_CODE_OVER_TEMPERATURE MotorFaultCode = 2
+	MotorFaultCode_MOTOR_FAULT_CODE_UNDER_VOLTAGE    MotorFaultCode = 3
+	MotorFaultCode_MOTOR_FAULT_CODE_OVER_VOLTAGE     MotorFaultCode = 4
+	// The motor is powered but not turning
+	MotorFaultCode_MOTOR_FAULT_CODE_STALL   MotorFaultCode = 5
+	MotorFaultCode_MOTOR_FAULT_CODE_ENCODER MotorFaultCode = 6
+	// Any other fault reported by the motor driver; see the description for details
+	MotorFaultCode_MOTOR_FAULT_CODE_DRIVER MotorFaultCode = 7
+)
+
+// Enum value maps for MotorFaultCode.
+var (
+	MotorFaultCode_name = map[int32]string{
+		0: "MOTOR_FAULT_CODE_UNSPECIFIED",
+		1: "MOTOR_FAULT_CODE_OVER_CURRENT",
+		2: "MOTOR_FAULT_CODE_OVER_TEMPERATURE",
+		3: "MOTOR_FAULT_CODE_UNDER_VOLTAGE",
+		4: "MOTOR_FAULT_CODE_OVER_VOLTAGE",
+		5: "MOTOR_FAULT_CODE_STALL",
+		6: "MOTOR_FAULT_CODE_ENCODER",
+		7: "MOTOR_FAULT_CODE_DRIVER",
+	}
+	MotorFaultCode_value = map[string]int32{
+		"MOTOR_FAULT_CODE_UNSPECIFIED":      0,
+		"MOTOR_FAULT_CODE_OVER_CURRENT":     1,
+		"MOTOR_FAULT_CODE_OVER_TEMPERATURE": 2,
+		"MOTOR_FAULT_CODE_UNDER_VOLTAGE":    3,
+		"MOTOR_FAULT_CODE_OVER_VOLTAGE":     4,
+		"MOTOR_FAULT_CODE_STALL":            5,
+		"MOTOR_FAULT_CODE_ENCODER":          6,
+		"MOTOR_FAULT_CODE_DRIVER":           7,
+	}
+)
+
+func (x MotorFaultCode) Enum() *MotorFaultCode {
+	p := new(MotorFaultCode)
+	*p = x
+	return p
+}
+
+func (x MotorFaultCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MotorFaultCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_component_motor_v1_motor_proto_enumTypes[0].Descriptor()
+}
+
+func (MotorFaultCode) Type() protoreflect.EnumType {
+	return &file_component_motor_v1_motor_proto_enumTypes[0]
+}
+
+func (x MotorFaultCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MotorFaultCode.Descriptor instead.
+func (MotorFaultCode) EnumDescriptor() ([]byte, []int) {
+	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{0}
+}
+
 type SetPowerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -353,6 +420,223 @@ func (*GoToResponse) Descriptor() ([]byte, []int) {
 	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{5}
 }
 
+type SetRPMRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of a motor
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Speed of motor travel in rotations per minute, where negative values indicate a backwards direction
+	Rpm float64 `protobuf:"fixed64,2,opt,name=rpm,proto3" json:"rpm,omitempty"`
+	// Additional arguments to the method
+	Extra *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
+}
+
+func (x *SetRPMRequest) Reset() {
+	*x = SetRPMRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_motor_v1_motor_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetRPMRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRPMRequest) ProtoMessage() {}
+
+func (x *SetRPMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_motor_v1_motor_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRPMRequest.ProtoReflect.Descriptor instead.
+func (*SetRPMRequest) Descriptor() ([]byte, []int) {
+	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SetRPMRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetRPMRequest) GetRpm() float64 {
+	if x != nil {
+		return x.Rpm
+	}
+	return 0
+}
+
+func (x *SetRPMRequest) GetExtra() *structpb.Struct {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+type SetRPMResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetRPMResponse) Reset() {
+	*x = SetRPMResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_motor_v1_motor_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetRPMResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRPMResponse) ProtoMessage() {}
+
+func (x *SetRPMResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_motor_v1_motor_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRPMResponse.ProtoReflect.Descriptor instead.
+func (*SetRPMResponse) Descriptor() ([]byte, []int) {
+	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{7}
+}
+
+type SetTorqueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of a motor
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Torque to apply in newton meters, where negative values indicate a backwards direction
+	TorqueNm float64 `protobuf:"fixed64,2,opt,name=torque_nm,json=torqueNm,proto3" json:"torque_nm,omitempty"`
+	// Speed limit in rotations per minute while applying torque. If unset, the motor's max rpm is used
+	MaxRpm *float64 `protobuf:"fixed64,3,opt,name=max_rpm,json=maxRpm,proto3,oneof" json:"max_rpm,omitempty"`
+	// Additional arguments to the method
+	Extra *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
+}
+
+func (x *SetTorqueRequest) Reset() {
+	*x = SetTorqueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_motor_v1_motor_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTorqueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTorqueRequest) ProtoMessage() {}
+
+func (x *SetTorqueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_motor_v1_motor_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTorqueRequest.ProtoReflect.Descriptor instead.
+func (*SetTorqueRequest) Descriptor() ([]byte, []int) {
+	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SetTorqueRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetTorqueRequest) GetTorqueNm() float64 {
+	if x != nil {
+		return x.TorqueNm
+	}
+	return 0
+}
+
+func (x *SetTorqueRequest) GetMaxRpm() float64 {
+	if x != nil && x.MaxRpm != nil {
+		return *x.MaxRpm
+	}
+	return 0
+}
+
+func (x *SetTorqueRequest) GetExtra() *structpb.Struct {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+type SetTorqueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetTorqueResponse) Reset() {
+	*x = SetTorqueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_motor_v1_motor_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTorqueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTorqueResponse) ProtoMessage() {}
+
+func (x *SetTorqueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_motor_v1_motor_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTorqueResponse.ProtoReflect.Descriptor instead.
+func (*SetTorqueResponse) Descriptor() ([]byte, []int) {
+	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{9}
+}
+
 type ResetZeroPositionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -369,7 +653,7 @@ type ResetZeroPositionRequest struct {
 func (x *ResetZeroPositionRequest) Reset() {
 	*x = ResetZeroPositionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_motor_v1_motor_proto_msgTypes[6]
+		mi := &file_component_motor_v1_motor_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -382,7 +666,7 @@ func (x *ResetZeroPositionRequest) String() string {
 func (*ResetZeroPositionRequest) ProtoMessage() {}
 
 func (x *ResetZeroPositionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_component_motor_v1_motor_proto_msgTypes[6]
+	mi := &file_component_motor_v1_motor_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -395,7 +679,7 @@ func (x *ResetZeroPositionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetZeroPositionRequest.ProtoReflect.Descriptor instead.
 func (*ResetZeroPositionRequest) Descriptor() ([]byte, []int) {
-	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{6}
+	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ResetZeroPositionRequest) GetName() string {
@@ -428,7 +712,7 @@ type ResetZeroPositionResponse struct {
 func (x *ResetZeroPositionResponse) Reset() {
 	*x = ResetZeroPositionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_motor_v1_motor_proto_msgTypes[7]
+		mi := &file_component_motor_v1_motor_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -441,7 +725,7 @@ func (x *ResetZeroPositionResponse) String() string {
 func (*ResetZeroPositionResponse) ProtoMessage() {}
 
 func (x *ResetZeroPositionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_component_motor_v1_motor_proto_msgTypes[7]
+	mi := &file_component_motor_v1_motor_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -454,7 +738,7 @@ func (x *ResetZeroPositionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetZeroPositionResponse.ProtoReflect.Descriptor instead.
 func (*ResetZeroPositionResponse) Descriptor() ([]byte, []int) {
-	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{7}
+	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{11}
 }
 
 type GetPositionRequest struct {
@@ -471,7 +755,7 @@ type GetPositionRequest struct {
 func (x *GetPositionRequest) Reset() {
 	*x = GetPositionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_motor_v1_motor_proto_msgTypes[8]
+		mi := &file_component_motor_v1_motor_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -484,7 +768,7 @@ func (x *GetPositionRequest) String() string {
 func (*GetPositionRequest) ProtoMessage() {}
 
 func (x *GetPositionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_component_motor_v1_motor_proto_msgTypes[8]
+	mi := &file_component_motor_v1_motor_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -497,7 +781,7 @@ func (x *GetPositionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPositionRequest.ProtoReflect.Descriptor instead.
 func (*GetPositionRequest) Descriptor() ([]byte, []int) {
-	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{8}
+	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetPositionRequest) GetName() string {
@@ -526,7 +810,7 @@ type GetPositionResponse struct {
 func (x *GetPositionResponse) Reset() {
 	*x = GetPositionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_motor_v1_motor_proto_msgTypes[9]
+		mi := &file_component_motor_v1_motor_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -539,7 +823,7 @@ func (x *GetPositionResponse) String() string {
 func (*GetPositionResponse) ProtoMessage() {}
 
 func (x *GetPositionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_component_motor_v1_motor_proto_msgTypes[9]
+	mi := &file_component_motor_v1_motor_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -552,7 +836,7 @@ func (x *GetPositionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPositionResponse.ProtoReflect.Descriptor instead.
 func (*GetPositionResponse) Descriptor() ([]byte, []int) {
-	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{9}
+	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetPositionResponse) GetPosition() float64 {
@@ -576,7 +860,7 @@ type StopRequest struct {
 func (x *StopRequest) Reset() {
 	*x = StopRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_motor_v1_motor_proto_msgTypes[10]
+		mi := &file_component_motor_v1_motor_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -589,7 +873,7 @@ func (x *StopRequest) String() string {
 func (*StopRequest) ProtoMessage() {}
 
 func (x *StopRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_component_motor_v1_motor_proto_msgTypes[10]
+	mi := &file_component_motor_v1_motor_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -602,7 +886,7 @@ func (x *StopRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopRequest.ProtoReflect.Descriptor instead.
 func (*StopRequest) Descriptor() ([]byte, []int) {
-	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{10}
+	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *StopRequest) GetName() string {
@@ -628,7 +912,7 @@ type StopResponse struct {
 func (x *StopResponse) Reset() {
 	*x = StopResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_motor_v1_motor_proto_msgTypes[11]
+		mi := &file_component_motor_v1_motor_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -641,7 +925,7 @@ func (x *StopResponse) String() string {
 func (*StopResponse) ProtoMessage() {}
 
 func (x *StopResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_component_motor_v1_motor_proto_msgTypes[11]
+	mi := &file_component_motor_v1_motor_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -654,7 +938,7 @@ func (x *StopResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopResponse.ProtoReflect.Descriptor instead.
 func (*StopResponse) Descriptor() ([]byte, []int) {
-	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{11}
+	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{15}
 }
 
 type IsPoweredRequest struct {
@@ -671,7 +955,7 @@ type IsPoweredRequest struct {
 func (x *IsPoweredRequest) Reset() {
 	*x = IsPoweredRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_motor_v1_motor_proto_msgTypes[12]
+		mi := &file_component_motor_v1_motor_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -684,7 +968,7 @@ func (x *IsPoweredRequest) String() string {
 func (*IsPoweredRequest) ProtoMessage() {}
 
 func (x *IsPoweredRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_component_motor_v1_motor_proto_msgTypes[12]
+	mi := &file_component_motor_v1_motor_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -697,7 +981,7 @@ func (x *IsPoweredRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsPoweredRequest.ProtoReflect.Descriptor instead.
 func (*IsPoweredRequest) Descriptor() ([]byte, []int) {
-	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{12}
+	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *IsPoweredRequest) GetName() string {
@@ -730,7 +1014,7 @@ type IsPoweredResponse struct {
 func (x *IsPoweredResponse) Reset() {
 	*x = IsPoweredResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_motor_v1_motor_proto_msgTypes[13]
+		mi := &file_component_motor_v1_motor_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -743,7 +1027,7 @@ func (x *IsPoweredResponse) String() string {
 func (*IsPoweredResponse) ProtoMessage() {}
 
 func (x *IsPoweredResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_component_motor_v1_motor_proto_msgTypes[13]
+	mi := &file_component_motor_v1_motor_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -756,7 +1040,7 @@ func (x *IsPoweredResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsPoweredResponse.ProtoReflect.Descriptor instead.
 func (*IsPoweredResponse) Descriptor() ([]byte, []int) {
-	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{13}
+	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *IsPoweredResponse) GetIsOn() bool {
@@ -773,7 +1057,7 @@ func (x *IsPoweredResponse) GetPowerPct() float64 {
 	return 0
 }
 
-type GetPropertiesRequest struct {
+type GetTelemetryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -784,23 +1068,23 @@ type GetPropertiesRequest struct {
 	Extra *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
 }
 
-func (x *GetPropertiesRequest) Reset() {
-	*x = GetPropertiesRequest{}
+func (x *GetTelemetryRequest) Reset() {
+	*x = GetTelemetryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_motor_v1_motor_proto_msgTypes[14]
+		mi := &file_component_motor_v1_motor_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPropertiesRequest) String() string {
+func (x *GetTelemetryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPropertiesRequest) ProtoMessage() {}
+func (*GetTelemetryRequest) ProtoMessage() {}
 
-func (x *GetPropertiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_component_motor_v1_motor_proto_msgTypes[14]
+func (x *GetTelemetryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_motor_v1_motor_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -811,38 +1095,365 @@ func (x *GetPropertiesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPropertiesRequest.ProtoReflect.Descriptor instead.
-func (*GetPropertiesRequest) Descriptor() ([]byte, []int) {
-	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GetTelemetryRequest.ProtoReflect.Descriptor instead.
+func (*GetTelemetryRequest) Descriptor() ([]byte, []int) {
+	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GetPropertiesRequest) GetName() string {
+func (x *GetTelemetryRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *GetPropertiesRequest) GetExtra() *structpb.Struct {
+func (x *GetTelemetryRequest) GetExtra() *structpb.Struct {
 	if x != nil {
 		return x.Extra
 	}
 	return nil
 }
 
-type GetPropertiesResponse struct {
+type GetTelemetryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Measured motor current in amps. Unset if the motor does not measure current
+	CurrentA *float64 `protobuf:"fixed64,1,opt,name=current_a,json=currentA,proto3,oneof" json:"current_a,omitempty"`
+	// Measured supply voltage of the motor driver in volts. Unset if the motor does not measure voltage
+	BusVoltageV *float64 `protobuf:"fixed64,2,opt,name=bus_voltage_v,json=busVoltageV,proto3,oneof" json:"bus_voltage_v,omitempty"`
+	// Measured motor winding temperature in degrees celsius. Unset if the motor does not measure it
+	WindingTemperatureCelsius *float64 `protobuf:"fixed64,3,opt,name=winding_temperature_celsius,json=windingTemperatureCelsius,proto3,oneof" json:"winding_temperature_celsius,omitempty"`
+	// Measured motor driver temperature in degrees celsius. Unset if the motor does not measure it
+	DriverTemperatureCelsius *float64 `protobuf:"fixed64,4,opt,name=driver_temperature_celsius,json=driverTemperatureCelsius,proto3,oneof" json:"driver_temperature_celsius,omitempty"`
+	// Measured speed of the motor in rotations per minute. Unset if the motor does not measure speed
+	Rpm *float64 `protobuf:"fixed64,5,opt,name=rpm,proto3,oneof" json:"rpm,omitempty"`
+	// Faults currently active on the motor
+	Faults []*MotorFault `protobuf:"bytes,6,rep,name=faults,proto3" json:"faults,omitempty"`
+	// Time at which the telemetry was measured
+	MeasuredAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=measured_at,json=measuredAt,proto3" json:"measured_at,omitempty"`
+}
+
+func (x *GetTelemetryResponse) Reset() {
+	*x = GetTelemetryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_motor_v1_motor_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTelemetryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTelemetryResponse) ProtoMessage() {}
+
+func (x *GetTelemetryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_motor_v1_motor_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTelemetryResponse.ProtoReflect.Descriptor instead.
+func (*GetTelemetryResponse) Descriptor() ([]byte, []int) {
+	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetTelemetryResponse) GetCurrentA() float64 {
+	if x != nil && x.CurrentA != nil {
+		return *x.CurrentA
+	}
+	return 0
+}
+
+func (x *GetTelemetryResponse) GetBusVoltageV() float64 {
+	if x != nil && x.BusVoltageV != nil {
+		return *x.BusVoltageV
+	}
+	return 0
+}
+
+func (x *GetTelemetryResponse) GetWindingTemperatureCelsius() float64 {
+	if x != nil && x.WindingTemperatureCelsius != nil {
+		return *x.WindingTemperatureCelsius
+	}
+	return 0
+}
+
+func (x *GetTelemetryResponse) GetDriverTemperatureCelsius() float64 {
+	if x != nil && x.DriverTemperatureCelsius != nil {
+		return *x.DriverTemperatureCelsius
+	}
+	return 0
+}
+
+func (x *GetTelemetryResponse) GetRpm() float64 {
+	if x != nil && x.Rpm != nil {
+		return *x.Rpm
+	}
+	return 0
+}
+
+func (x *GetTelemetryResponse) GetFaults() []*MotorFault {
+	if x != nil {
+		return x.Faults
+	}
+	return nil
+}
+
+func (x *GetTelemetryResponse) GetMeasuredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MeasuredAt
+	}
+	return nil
+}
+
+type MotorFault struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code MotorFaultCode `protobuf:"varint,1,opt,name=code,proto3,enum=viam.component.motor.v1.MotorFaultCode" json:"code,omitempty"`
+	// Human readable description of the fault, including any driver specific code
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Time at which the fault was first detected
+	DetectedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
+}
+
+func (x *MotorFault) Reset() {
+	*x = MotorFault{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_motor_v1_motor_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MotorFault) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MotorFault) ProtoMessage() {}
+
+func (x *MotorFault) ProtoReflect() protoreflect.Message {
+	mi := &file_component_motor_v1_motor_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MotorFault.ProtoReflect.Descriptor instead.
+func (*MotorFault) Descriptor() ([]byte, []int) {
+	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MotorFault) GetCode() MotorFaultCode {
+	if x != nil {
+		return x.Code
+	}
+	return MotorFaultCode_MOTOR_FAULT_CODE_UNSPECIFIED
+}
+
+func (x *MotorFault) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MotorFault) GetDetectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return nil
+}
+
+type ClearFaultsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of a motor
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Additional arguments to the method
+	Extra *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
+}
+
+func (x *ClearFaultsRequest) Reset() {
+	*x = ClearFaultsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_motor_v1_motor_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearFaultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearFaultsRequest) ProtoMessage() {}
+
+func (x *ClearFaultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_motor_v1_motor_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearFaultsRequest.ProtoReflect.Descriptor instead.
+func (*ClearFaultsRequest) Descriptor() ([]byte, []int) {
+	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ClearFaultsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ClearFaultsRequest) GetExtra() *structpb.Struct {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+type ClearFaultsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ClearFaultsResponse) Reset() {
+	*x = ClearFaultsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_motor_v1_motor_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearFaultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearFaultsResponse) ProtoMessage() {}
+
+func (x *ClearFaultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_component_motor_v1_motor_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearFaultsResponse.ProtoReflect.Descriptor instead.
+func (*ClearFaultsResponse) Descriptor() ([]byte, []int) {
+	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{22}
+}
+
+type GetPropertiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of a motor
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Additional arguments to the method
+	Extra *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
+}
+
+func (x *GetPropertiesRequest) Reset() {
+	*x = GetPropertiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_component_motor_v1_motor_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPropertiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPropertiesRequest) ProtoMessage() {}
+
+func (x *GetPropertiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_component_motor_v1_motor_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPropertiesRequest.ProtoReflect.Descriptor instead.
+func (*GetPropertiesRequest) Descriptor() ([]byte, []int) {
+	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetPropertiesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetPropertiesRequest) GetExtra() *structpb.Struct {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+type GetPropertiesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Returns true if the motor supports reporting its position
 	PositionReporting bool `protobuf:"varint,1,opt,name=position_reporting,json=positionReporting,proto3" json:"position_reporting,omitempty"`
+	// Returns true if the motor supports closed loop velocity control with SetRPM
+	VelocityControl bool `protobuf:"varint,2,opt,name=velocity_control,json=velocityControl,proto3" json:"velocity_control,omitempty"`
+	// Returns true if the motor supports torque control with SetTorque
+	TorqueControl bool `protobuf:"varint,3,opt,name=torque_control,json=torqueControl,proto3" json:"torque_control,omitempty"`
+	// Returns the maximum speed of the motor in rotations per minute, if known
+	MaxRpm *float64 `protobuf:"fixed64,4,opt,name=max_rpm,json=maxRpm,proto3,oneof" json:"max_rpm,omitempty"`
+	// Returns the number of encoder ticks per rotation of the motor, if it has an encoder
+	TicksPerRotation *int64 `protobuf:"varint,5,opt,name=ticks_per_rotation,json=ticksPerRotation,proto3,oneof" json:"ticks_per_rotation,omitempty"`
 }
 
 func (x *GetPropertiesResponse) Reset() {
 	*x = GetPropertiesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_motor_v1_motor_proto_msgTypes[15]
+		mi := &file_component_motor_v1_motor_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -855,7 +1466,7 @@ func (x *GetPropertiesResponse) String() string {
 func (*GetPropertiesResponse) ProtoMessage() {}
 
 func (x *GetPropertiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_component_motor_v1_motor_proto_msgTypes[15]
+	mi := &file_component_motor_v1_motor_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -868,7 +1479,7 @@ func (x *GetPropertiesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPropertiesResponse.ProtoReflect.Descriptor instead.
 func (*GetPropertiesResponse) Descriptor() ([]byte, []int) {
-	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{15}
+	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetPropertiesResponse) GetPositionReporting() bool {
@@ -878,6 +1489,34 @@ func (x *GetPropertiesResponse) GetPositionReporting() bool {
 	return false
 }
 
+func (x *GetPropertiesResponse) GetVelocityControl() bool {
+	if x != nil {
+		return x.VelocityControl
+	}
+	return false
+}
+
+func (x *GetPropertiesResponse) GetTorqueControl() bool {
+	if x != nil {
+		return x.TorqueControl
+	}
+	return false
+}
+
+func (x *GetPropertiesResponse) GetMaxRpm() float64 {
+	if x != nil && x.MaxRpm != nil {
+		return *x.MaxRpm
+	}
+	return 0
+}
+
+func (x *GetPropertiesResponse) GetTicksPerRotation() int64 {
+	if x != nil && x.TicksPerRotation != nil {
+		return *x.TicksPerRotation
+	}
+	return 0
+}
+
 type Status struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -894,7 +1533,7 @@ type Status struct {
 func (x *Status) Reset() {
 	*x = Status{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_motor_v1_motor_proto_msgTypes[16]
+		mi := &file_component_motor_v1_motor_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -907,7 +1546,7 @@ func (x *Status) String() string {
 func (*Status) ProtoMessage() {}
 
 func (x *Status) ProtoReflect() protoreflect.Message {
-	mi := &file_component_motor_v1_motor_proto_msgTypes[16]
+	mi := &file_component_motor_v1_motor_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -920,7 +1559,7 @@ func (x *Status) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Status.ProtoReflect.Descriptor instead.
 func (*Status) Descriptor() ([]byte, []int) {
-	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{16}
+	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *Status) GetIsPowered() bool {
@@ -955,7 +1594,7 @@ type IsMovingRequest struct {
 func (x *IsMovingRequest) Reset() {
 	*x = IsMovingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_motor_v1_motor_proto_msgTypes[17]
+		mi := &file_component_motor_v1_motor_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -968,7 +1607,7 @@ func (x *IsMovingRequest) String() string {
 func (*IsMovingRequest) ProtoMessage() {}
 
 func (x *IsMovingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_component_motor_v1_motor_proto_msgTypes[17]
+	mi := &file_component_motor_v1_motor_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -981,7 +1620,7 @@ func (x *IsMovingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsMovingRequest.ProtoReflect.Descriptor instead.
 func (*IsMovingRequest) Descriptor() ([]byte, []int) {
-	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{17}
+	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *IsMovingRequest) GetName() string {
@@ -1002,7 +1641,7 @@ type IsMovingResponse struct {
 func (x *IsMovingResponse) Reset() {
 	*x = IsMovingResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_component_motor_v1_motor_proto_msgTypes[18]
+		mi := &file_component_motor_v1_motor_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1015,7 +1654,7 @@ func (x *IsMovingResponse) String() string {
 func (*IsMovingResponse) ProtoMessage() {}
 
 func (x *IsMovingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_component_motor_v1_motor_proto_msgTypes[18]
+	mi := &file_component_motor_v1_motor_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1028,7 +1667,7 @@ func (x *IsMovingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsMovingResponse.ProtoReflect.Descriptor instead.
 func (*IsMovingResponse) Descriptor() ([]byte, []int) {
-	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{18}
+	return file_component_motor_v1_motor_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *IsMovingResponse) GetIsMoving() bool {
@@ -1049,202 +1688,346 @@ var file_component_motor_v1_motor_proto_rawDesc = []byte{
 	0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e,
 	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x71, 0x0a,
-	0x0f, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x70, 0x63,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x50, 0x63,
-	0x74, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61,
-	0x22, 0x12, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x0c, 0x47, 0x6f, 0x46, 0x6f, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x70, 0x6d,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x72, 0x70, 0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x72,
-	0x65, 0x76, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x0b, 0x72, 0x65, 0x76, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a,
+	0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x71,
+	0x0a, 0x0f, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x70,
+	0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x50,
+	0x63, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72,
+	0x61, 0x22, 0x12, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x0c, 0x47, 0x6f, 0x46, 0x6f, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x70,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x72, 0x70, 0x6d, 0x12, 0x20, 0x0a, 0x0b,
+	0x72, 0x65, 0x76, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0b, 0x72, 0x65, 0x76, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d,
+	0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x0f, 0x0a,
+	0x0d, 0x47, 0x6f, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x95,
+	0x01, 0x0a, 0x0b, 0x47, 0x6f, 0x54, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x70, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x03, 0x72, 0x70, 0x6d, 0x12, 0x31, 0x0a, 0x14, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x72, 0x65, 0x76, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x13, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x76, 0x6f,
+	0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61,
+	0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52,
+	0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x0e, 0x0a, 0x0c, 0x47, 0x6f, 0x54, 0x6f, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x64, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x52, 0x50, 0x4d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x72,
+	0x70, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x72, 0x70, 0x6d, 0x12, 0x2d, 0x0a,
 	0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x0f, 0x0a, 0x0d,
-	0x47, 0x6f, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x95, 0x01,
-	0x0a, 0x0b, 0x47, 0x6f, 0x54, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x70, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03,
-	0x72, 0x70, 0x6d, 0x12, 0x31, 0x0a, 0x14, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x72, 0x65, 0x76, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x13, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6c,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18,
-	0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05,
-	0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x0e, 0x0a, 0x0c, 0x47, 0x6f, 0x54, 0x6f, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x75, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x65, 0x74, 0x5a, 0x65,
-	0x72, 0x6f, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x10, 0x0a, 0x0e,
+	0x53, 0x65, 0x74, 0x52, 0x50, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9c,
+	0x01, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x54, 0x6f, 0x72, 0x71, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x72, 0x71, 0x75,
+	0x65, 0x5f, 0x6e, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x74, 0x6f, 0x72, 0x71,
+	0x75, 0x65, 0x4e, 0x6d, 0x12, 0x1c, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x70, 0x6d, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x52, 0x70, 0x6d, 0x88,
+	0x01, 0x01, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72,
+	0x61, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x70, 0x6d, 0x22, 0x13, 0x0a,
+	0x11, 0x53, 0x65, 0x74, 0x54, 0x6f, 0x72, 0x71, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x75, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x65, 0x74, 0x5a, 0x65, 0x72, 0x6f, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x5a, 0x65, 0x72, 0x6f, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22,
+	0x31, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x50, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x2d, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65,
+	0x78, 0x74, 0x72, 0x61, 0x22, 0x0e, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x55, 0x0a, 0x10, 0x49, 0x73, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x65,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x05,
+	0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x45, 0x0a, 0x11, 0x49,
+	0x73, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x13, 0x0a, 0x05, 0x69, 0x73, 0x5f, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x69, 0x73, 0x4f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x70,
+	0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x50,
+	0x63, 0x74, 0x22, 0x58, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a,
 	0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x1b, 0x0a, 0x19,
-	0x52, 0x65, 0x73, 0x65, 0x74, 0x5a, 0x65, 0x72, 0x6f, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57, 0x0a, 0x12, 0x47, 0x65, 0x74,
-	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74,
-	0x72, 0x61, 0x22, 0x31, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x50, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72,
-	0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
-	0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x0e, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x70, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x55, 0x0a, 0x10, 0x49, 0x73, 0x50, 0x6f, 0x77,
-	0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x45,
-	0x0a, 0x11, 0x49, 0x73, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x69, 0x73, 0x5f, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x04, 0x69, 0x73, 0x4f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x77, 0x65,
-	0x72, 0x5f, 0x70, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x6f, 0x77,
-	0x65, 0x72, 0x50, 0x63, 0x74, 0x22, 0x59, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70,
-	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61,
-	0x22, 0x46, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x60, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x65, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x65,
-	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a,
-	0x09, 0x69, 0x73, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x08, 0x69, 0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x22, 0x25, 0x0a, 0x0f, 0x49, 0x73,
-	0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x22, 0x2f, 0x0a, 0x10, 0x49, 0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6d, 0x6f, 0x76, 0x69,
-	0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4d, 0x6f, 0x76, 0x69,
-	0x6e, 0x67, 0x32, 0x99, 0x0d, 0x0a, 0x0c, 0x4d, 0x6f, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x96, 0x01, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72,
-	0x12, 0x28, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
-	0x74, 0x2e, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x6f,
-	0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x76, 0x69, 0x61,
-	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x6d, 0x6f, 0x74, 0x6f,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0xa0, 0x92, 0x29, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x2b, 0x1a, 0x29, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
-	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2f,
-	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x8e, 0x01, 0x0a,
-	0x05, 0x47, 0x6f, 0x46, 0x6f, 0x72, 0x12, 0x25, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f,
-	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x6f, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0xe1, 0x03, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x09, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x41, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0d, 0x62, 0x75, 0x73, 0x5f, 0x76,
+	0x6f, 0x6c, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x76, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01,
+	0x52, 0x0b, 0x62, 0x75, 0x73, 0x56, 0x6f, 0x6c, 0x74, 0x61, 0x67, 0x65, 0x56, 0x88, 0x01, 0x01,
+	0x12, 0x43, 0x0a, 0x1b, 0x77, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x6d, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x02, 0x52, 0x19, 0x77, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x43, 0x65, 0x6c, 0x73, 0x69,
+	0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x41, 0x0a, 0x1a, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x5f,
+	0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x65, 0x6c, 0x73,
+	0x69, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x18, 0x64, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x43, 0x65,
+	0x6c, 0x73, 0x69, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x72, 0x70, 0x6d, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x04, 0x52, 0x03, 0x72, 0x70, 0x6d, 0x88, 0x01, 0x01, 0x12,
+	0x3b, 0x0a, 0x06, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x2e, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x74, 0x6f, 0x72, 0x46,
+	0x61, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x0b,
+	0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6d,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x64, 0x41, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x62, 0x75, 0x73, 0x5f,
+	0x76, 0x6f, 0x6c, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x76, 0x42, 0x1e, 0x0a, 0x1c, 0x5f, 0x77, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x64, 0x72,
+	0x69, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x72, 0x70, 0x6d,
+	0x22, 0xa8, 0x01, 0x0a, 0x0a, 0x4d, 0x6f, 0x74, 0x6f, 0x72, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x12,
+	0x3b, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e,
 	0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x6d,
-	0x6f, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6f, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0xa0, 0x92, 0x29, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x2c, 0x1a, 0x2a, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
-	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2f,
-	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x67, 0x6f, 0x5f, 0x66, 0x6f, 0x72, 0x12, 0x8a, 0x01,
-	0x0a, 0x04, 0x47, 0x6f, 0x54, 0x6f, 0x12, 0x24, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f,
-	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x6f, 0x54, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x76,
-	0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x6d, 0x6f,
-	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6f, 0x54, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x35, 0xa0, 0x92, 0x29, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x1a,
-	0x29, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f,
-	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2f, 0x7b, 0x6e,
-	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x67, 0x6f, 0x5f, 0x74, 0x6f, 0x12, 0xac, 0x01, 0x0a, 0x11, 0x52,
-	0x65, 0x73, 0x65, 0x74, 0x5a, 0x65, 0x72, 0x6f, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x31, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
-	0x74, 0x2e, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74,
-	0x5a, 0x65, 0x72, 0x6f, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
-	0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x73, 0x65, 0x74, 0x5a, 0x65, 0x72, 0x6f, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x1a,
-	0x28, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f,
-	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2f, 0x7b, 0x6e,
-	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x7a, 0x65, 0x72, 0x6f, 0x12, 0x9e, 0x01, 0x0a, 0x0b, 0x47, 0x65,
-	0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x76, 0x69, 0x61, 0x6d,
-	0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x6d, 0x6f, 0x74, 0x6f, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f,
+	0x6f, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x74, 0x6f, 0x72, 0x46, 0x61, 0x75,
+	0x6c, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b,
+	0x0a, 0x0b, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0a, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x57, 0x0a, 0x12, 0x43,
+	0x6c, 0x65, 0x61, 0x72, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65,
+	0x78, 0x74, 0x72, 0x61, 0x22, 0x15, 0x0a, 0x13, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x46, 0x61, 0x75,
+	0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x59, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61,
+	0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52,
+	0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x8c, 0x02, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2d, 0x0a, 0x12, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x12,
+	0x29, 0x0a, 0x10, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x76, 0x65, 0x6c, 0x6f, 0x63,
+	0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f,
+	0x72, 0x71, 0x75, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x74, 0x6f, 0x72, 0x71, 0x75, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x12, 0x1c, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x70, 0x6d, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x00, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x52, 0x70, 0x6d, 0x88, 0x01, 0x01, 0x12,
+	0x31, 0x0a, 0x12, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x72, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x10, 0x74,
+	0x69, 0x63, 0x6b, 0x73, 0x50, 0x65, 0x72, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88,
+	0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x70, 0x6d, 0x42, 0x15,
+	0x0a, 0x13, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x72, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x60, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x65, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73,
+	0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69,
+	0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x22, 0x25, 0x0a, 0x0f, 0x49, 0x73, 0x4d, 0x6f, 0x76,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2f,
+	0x0a, 0x10, 0x49, 0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x2a,
+	0x9a, 0x02, 0x0a, 0x0e, 0x4d, 0x6f, 0x74, 0x6f, 0x72, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x4d, 0x4f, 0x54, 0x4f, 0x52, 0x5f, 0x46, 0x41, 0x55, 0x4c,
+	0x54, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x4d, 0x4f, 0x54, 0x4f, 0x52, 0x5f, 0x46, 0x41,
+	0x55, 0x4c, 0x54, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x43, 0x55,
+	0x52, 0x52, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x25, 0x0a, 0x21, 0x4d, 0x4f, 0x54, 0x4f, 0x52,
+	0x5f, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4f, 0x56, 0x45, 0x52,
+	0x5f, 0x54, 0x45, 0x4d, 0x50, 0x45, 0x52, 0x41, 0x54, 0x55, 0x52, 0x45, 0x10, 0x02, 0x12, 0x22,
+	0x0a, 0x1e, 0x4d, 0x4f, 0x54, 0x4f, 0x52, 0x5f, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x43, 0x4f,
+	0x44, 0x45, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x56, 0x4f, 0x4c, 0x54, 0x41, 0x47, 0x45,
+	0x10, 0x03, 0x12, 0x21, 0x0a, 0x1d, 0x4d, 0x4f, 0x54, 0x4f, 0x52, 0x5f, 0x46, 0x41, 0x55, 0x4c,
+	0x54, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x56, 0x4f, 0x4c, 0x54,
+	0x41, 0x47, 0x45, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x4f, 0x54, 0x4f, 0x52, 0x5f, 0x46,
+	0x41, 0x55, 0x4c, 0x54, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x10,
+	0x05, 0x12, 0x1c, 0x0a, 0x18, 0x4d, 0x4f, 0x54, 0x4f, 0x52, 0x5f, 0x46, 0x41, 0x55, 0x4c, 0x54,
+	0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x45, 0x52, 0x10, 0x06, 0x12,
+	0x1b, 0x0a, 0x17, 0x4d, 0x4f, 0x54, 0x4f, 0x52, 0x5f, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x43,
+	0x4f, 0x44, 0x45, 0x5f, 0x44, 0x52, 0x49, 0x56, 0x45, 0x52, 0x10, 0x07, 0x32, 0x91, 0x12, 0x0a,
+	0x0c, 0x4d, 0x6f, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x96, 0x01,
+	0x0a, 0x08, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x28, 0x2e, 0x76, 0x69, 0x61,
+	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x6d, 0x6f, 0x74, 0x6f,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x35, 0xa0, 0x92, 0x29, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x1a, 0x29, 0x2f, 0x76, 0x69,
+	0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x2f, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
+	0x2f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x8e, 0x01, 0x0a, 0x05, 0x47, 0x6f, 0x46, 0x6f, 0x72,
+	0x12, 0x25, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x2e, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6f, 0x46, 0x6f, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x6f, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x36, 0xa0, 0x92, 0x29, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x1a, 0x2a, 0x2f, 0x76, 0x69,
+	0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x2f, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
+	0x2f, 0x67, 0x6f, 0x5f, 0x66, 0x6f, 0x72, 0x12, 0x8a, 0x01, 0x0a, 0x04, 0x47, 0x6f, 0x54, 0x6f,
+	0x12, 0x24, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x2e, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6f, 0x54, 0x6f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f,
 	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x76,
+	0x2e, 0x47, 0x6f, 0x54, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0xa0,
+	0x92, 0x29, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x1a, 0x29, 0x2f, 0x76, 0x69, 0x61, 0x6d,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x2f, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x67,
+	0x6f, 0x5f, 0x74, 0x6f, 0x12, 0x8e, 0x01, 0x0a, 0x06, 0x53, 0x65, 0x74, 0x52, 0x50, 0x4d, 0x12,
+	0x26, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x2e, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x50, 0x4d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x50, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x33, 0xa0, 0x92, 0x29, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x1a, 0x27, 0x2f, 0x76,
 	0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
 	0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65,
-	0x7d, 0x2f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0xa4, 0x01, 0x0a, 0x0d, 0x47,
-	0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x2d, 0x2e, 0x76,
-	0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x6d, 0x6f,
-	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72,
-	0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x76, 0x69,
-	0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x6d, 0x6f, 0x74,
-	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
-	0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
-	0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x6d, 0x6f, 0x74, 0x6f,
-	0x72, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x73, 0x12, 0x85, 0x01, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x24, 0x2e, 0x76, 0x69, 0x61,
-	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x6d, 0x6f, 0x74, 0x6f,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x25, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
-	0x74, 0x2e, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x1a,
-	0x28, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f,
-	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2f, 0x7b, 0x6e,
-	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x74, 0x6f, 0x70, 0x12, 0x97, 0x01, 0x0a, 0x09, 0x49, 0x73,
-	0x50, 0x6f, 0x77, 0x65, 0x72, 0x65, 0x64, 0x12, 0x29, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63,
-	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x49, 0x73, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
-	0x65, 0x6e, 0x74, 0x2e, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x50,
-	0x6f, 0x77, 0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70,
+	0x7d, 0x2f, 0x72, 0x70, 0x6d, 0x12, 0x9a, 0x01, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x54, 0x6f, 0x72,
+	0x71, 0x75, 0x65, 0x12, 0x29, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x74, 0x54, 0x6f, 0x72, 0x71, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
+	0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e,
+	0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x6f, 0x72, 0x71,
+	0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0xa0, 0x92, 0x29, 0x01,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x1a, 0x2a, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70,
 	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x6d,
-	0x6f, 0x74, 0x6f, 0x72, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x70, 0x6f, 0x77, 0x65,
-	0x72, 0x65, 0x64, 0x12, 0x96, 0x01, 0x0a, 0x08, 0x49, 0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67,
-	0x12, 0x28, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
-	0x74, 0x2e, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x4d, 0x6f, 0x76,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x76, 0x69, 0x61,
-	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x6d, 0x6f, 0x74, 0x6f,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f,
-	0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2f, 0x7b, 0x6e, 0x61, 0x6d,
-	0x65, 0x7d, 0x2f, 0x69, 0x73, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x12, 0x88, 0x01, 0x0a,
-	0x09, 0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x20, 0x2e, 0x76, 0x69, 0x61,
-	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x43, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76,
-	0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f,
-	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x22, 0x2e, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61,
+	0x6f, 0x74, 0x6f, 0x72, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x74, 0x6f, 0x72, 0x71,
+	0x75, 0x65, 0x12, 0xac, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x65, 0x74, 0x5a, 0x65, 0x72, 0x6f,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x5a, 0x65, 0x72, 0x6f, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x76, 0x69,
+	0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x6d, 0x6f, 0x74,
+	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x5a, 0x65, 0x72, 0x6f, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x1a, 0x28, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f,
+	0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x7a, 0x65, 0x72,
+	0x6f, 0x12, 0x9e, 0x01, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2b, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x2e, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
+	0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e,
+	0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x6d, 0x6f, 0x74,
+	0x6f, 0x72, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0xa4, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x2d, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x76, 0x69,
+	0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x2f, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
+	0x2f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x85, 0x01, 0x0a, 0x04, 0x53, 0x74,
+	0x6f, 0x70, 0x12, 0x24, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x2e, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x1a, 0x28, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61,
 	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f,
-	0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x6f, 0x5f,
-	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x94, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x47,
-	0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x76, 0x69, 0x61, 0x6d,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x65,
-	0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x25, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x12, 0x2e,
+	0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x74, 0x6f,
+	0x70, 0x12, 0x97, 0x01, 0x0a, 0x09, 0x49, 0x73, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x65, 0x64, 0x12,
+	0x29, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x2e, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x50, 0x6f, 0x77, 0x65,
+	0x72, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x76, 0x69, 0x61,
+	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x6d, 0x6f, 0x74, 0x6f,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b,
 	0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d,
 	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2f, 0x7b, 0x6e, 0x61,
-	0x6d, 0x65, 0x7d, 0x2f, 0x67, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x42, 0x41,
-	0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
-	0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x5a, 0x22, 0x67,
-	0x6f, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63,
-	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2f, 0x76,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6d, 0x65, 0x7d, 0x2f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x65, 0x64, 0x12, 0xa2, 0x01, 0x0a, 0x0c,
+	0x47, 0x65, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x2c, 0x2e, 0x76,
+	0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x6d, 0x6f,
+	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65,
+	0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x76, 0x69, 0x61,
+	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x6d, 0x6f, 0x74, 0x6f,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x2f, 0x12, 0x2d, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2f,
+	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79,
+	0x12, 0xa2, 0x01, 0x0a, 0x0b, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x73,
+	0x12, 0x2b, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x2e, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72,
+	0x46, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
+	0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x6d,
+	0x6f, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x46, 0x61, 0x75,
+	0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x32, 0x1a, 0x30, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x6d, 0x6f, 0x74, 0x6f,
+	0x72, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x96, 0x01, 0x0a, 0x08, 0x49, 0x73, 0x4d, 0x6f, 0x76, 0x69,
+	0x6e, 0x67, 0x12, 0x28, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x2e, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x4d,
+	0x6f, 0x76, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x76,
+	0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x6d, 0x6f,
+	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x4d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12,
+	0x2d, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2f, 0x7b, 0x6e,
+	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x69, 0x73, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x12, 0x88,
+	0x01, 0x0a, 0x09, 0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x20, 0x2e, 0x76,
+	0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x22, 0x2e, 0x2f, 0x76, 0x69, 0x61, 0x6d,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x2f, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64,
+	0x6f, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x94, 0x01, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x76, 0x69,
+	0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30,
+	0x12, 0x2e, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2f, 0x7b,
+	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x67, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x42, 0x41, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x6d, 0x6f, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x5a,
+	0x22, 0x67, 0x6f, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x6d, 0x6f, 0x74, 0x6f, 0x72,
+	0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1259,69 +2042,97 @@ func file_component_motor_v1_motor_proto_rawDescGZIP() []byte {
 	return file_component_motor_v1_motor_proto_rawDescData
 }
 
-var file_component_motor_v1_motor_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_component_motor_v1_motor_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_component_motor_v1_motor_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_component_motor_v1_motor_proto_goTypes = []interface{}{
-	(*SetPowerRequest)(nil),           // 0: viam.component.motor.v1.SetPowerRequest
-	(*SetPowerResponse)(nil),          // 1: viam.component.motor.v1.SetPowerResponse
-	(*GoForRequest)(nil),              // 2: viam.component.motor.v1.GoForRequest
-	(*GoForResponse)(nil),             // 3: viam.component.motor.v1.GoForResponse
-	(*GoToRequest)(nil),               // 4: viam.component.motor.v1.GoToRequest
-	(*GoToResponse)(nil),              // 5: viam.component.motor.v1.GoToResponse
-	(*ResetZeroPositionRequest)(nil),  // 6: viam.component.motor.v1.ResetZeroPositionRequest
-	(*ResetZeroPositionResponse)(nil), // 7: viam.component.motor.v1.ResetZeroPositionResponse
-	(*GetPositionRequest)(nil),        // 8: viam.component.motor.v1.GetPositionRequest
-	(*GetPositionResponse)(nil),       // 9: viam.component.motor.v1.GetPositionResponse
-	(*StopRequest)(nil),               // 10: viam.component.motor.v1.StopRequest
-	(*StopResponse)(nil),              // 11: viam.component.motor.v1.StopResponse
-	(*IsPoweredRequest)(nil),          // 12: viam.component.motor.v1.IsPoweredRequest
-	(*IsPoweredResponse)(nil),         // 13: viam.component.motor.v1.IsPoweredResponse
-	(*GetPropertiesRequest)(nil),      // 14: viam.component.motor.v1.GetPropertiesRequest
-	(*GetPropertiesResponse)(nil),     // 15: viam.component.motor.v1.GetPropertiesResponse
-	(*Status)(nil),                    // 16: viam.component.motor.v1.Status
-	(*IsMovingRequest)(nil),           // 17: viam.component.motor.v1.IsMovingRequest
-	(*IsMovingResponse)(nil),          // 18: viam.component.motor.v1.IsMovingResponse
-	(*structpb.Struct)(nil),           // 19: google.protobuf.Struct
-	(*v1.DoCommandRequest)(nil),       // 20: viam.common.v1.DoCommandRequest
-	(*v1.GetGeometriesRequest)(nil),   // 21: viam.common.v1.GetGeometriesRequest
-	(*v1.DoCommandResponse)(nil),      // 22: viam.common.v1.DoCommandResponse
-	(*v1.GetGeometriesResponse)(nil),  // 23: viam.common.v1.GetGeometriesResponse
+	(MotorFaultCode)(0),               // 0: viam.component.motor.v1.MotorFaultCode
+	(*SetPowerRequest)(nil),           // 1: viam.component.motor.v1.SetPowerRequest
+	(*SetPowerResponse)(nil),          // 2: viam.component.motor.v1.SetPowerResponse
+	(*GoForRequest)(nil),              // 3: viam.component.motor.v1.GoForRequest
+	(*GoForResponse)(nil),             // 4: viam.component.motor.v1.GoForResponse
+	(*GoToRequest)(nil),               // 5: viam.component.motor.v1.GoToRequest
+	(*GoToResponse)(nil),              // 6: viam.component.motor.v1.GoToResponse
+	(*SetRPMRequest)(nil),             // 7: viam.component.motor.v1.SetRPMRequest
+	(*SetRPMResponse)(nil),            // 8: viam.component.motor.v1.SetRPMResponse
+	(*SetTorqueRequest)(nil),          // 9: viam.component.motor.v1.SetTorqueRequest
+	(*SetTorqueResponse)(nil),         // 10: viam.component.motor.v1.SetTorqueResponse
+	(*ResetZeroPositionRequest)(nil),  // 11: viam.component.motor.v1.ResetZeroPositionRequest
+	(*ResetZeroPositionResponse)(nil), // 12: viam.component.motor.v1.ResetZeroPositionResponse
+	(*GetPositionRequest)(nil),        // 13: viam.component.motor.v1.GetPositionRequest
+	(*GetPositionResponse)(nil),       // 14: viam.component.motor.v1.GetPositionResponse
+	(*StopRequest)(nil),               // 15: viam.component.motor.v1.StopRequest
+	(*StopResponse)(nil),              // 16: viam.component.motor.v1.StopResponse
+	(*IsPoweredRequest)(nil),          // 17: viam.component.motor.v1.IsPoweredRequest
+	(*IsPoweredResponse)(nil),         // 18: viam.component.motor.v1.IsPoweredResponse
+	(*GetTelemetryRequest)(nil),       // 19: viam.component.motor.v1.GetTelemetryRequest
+	(*GetTelemetryResponse)(nil),      // 20: viam.component.motor.v1.GetTelemetryResponse
+	(*MotorFault)(nil),                // 21: viam.component.motor.v1.MotorFault
+	(*ClearFaultsRequest)(nil),        // 22: viam.component.motor.v1.ClearFaultsRequest
+	(*ClearFaultsResponse)(nil),       // 23: viam.component.motor.v1.ClearFaultsResponse
+	(*GetPropertiesRequest)(nil),      // 24: viam.component.motor.v1.GetPropertiesRequest
+	(*GetPropertiesResponse)(nil),     // 25: viam.component.motor.v1.GetPropertiesResponse
+	(*Status)(nil),                    // 26: viam.component.motor.v1.Status
+	(*IsMovingRequest)(nil),           // 27: viam.component.motor.v1.IsMovingRequest
+	(*IsMovingResponse)(nil),          // 28: viam.component.motor.v1.IsMovingResponse
+	(*structpb.Struct)(nil),           // 29: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),     // 30: google.protobuf.Timestamp
+	(*v1.DoCommandRequest)(nil),       // 31: viam.common.v1.DoCommandRequest
+	(*v1.GetGeometriesRequest)(nil),   // 32: viam.common.v1.GetGeometriesRequest
+	(*v1.DoCommandResponse)(nil),      // 33: viam.common.v1.DoCommandResponse
+	(*v1.GetGeometriesResponse)(nil),  // 34: viam.common.v1.GetGeometriesResponse
 }
 var file_component_motor_v1_motor_proto_depIdxs = []int32{
-	19, // 0: viam.component.motor.v1.SetPowerRequest.extra:type_name -> google.protobuf.Struct
-	19, // 1: viam.component.motor.v1.GoForRequest.extra:type_name -> google.protobuf.Struct
-	19, // 2: viam.component.motor.v1.GoToRequest.extra:type_name -> google.protobuf.Struct
-	19, // 3: viam.component.motor.v1.ResetZeroPositionRequest.extra:type_name -> google.protobuf.Struct
-	19, // 4: viam.component.motor.v1.GetPositionRequest.extra:type_name -> google.protobuf.Struct
-	19, // 5: viam.component.motor.v1.StopRequest.extra:type_name -> google.protobuf.Struct
-	19, // 6: viam.component.motor.v1.IsPoweredRequest.extra:type_name -> google.protobuf.Struct
-	19, // 7: viam.component.motor.v1.GetPropertiesRequest.extra:type_name -> google.protobuf.Struct
-	0,  // 8: viam.component.motor.v1.MotorService.SetPower:input_type -> viam.component.motor.v1.SetPowerRequest
-	2,  // 9: viam.component.motor.v1.MotorService.GoFor:input_type -> viam.component.motor.v1.GoForRequest
-	4,  // 10: viam.component.motor.v1.MotorService.GoTo:input_type -> viam.component.motor.v1.GoToRequest
-	6,  // 11: viam.component.motor.v1.MotorService.ResetZeroPosition:input_type -> viam.component.motor.v1.ResetZeroPositionRequest
-	8,  // 12: viam.component.motor.v1.MotorService.GetPosition:input_type -> viam.component.motor.v1.GetPositionRequest
-	14, // 13: viam.component.motor.v1.MotorService.GetProperties:input_type -> viam.component.motor.v1.GetPropertiesRequest
-	10, // 14: viam.component.motor.v1.MotorService.Stop:input_type -> viam.component.motor.v1.StopRequest
-	12, // 15: viam.component.motor.v1.MotorService.IsPowered:input_type -> viam.component.motor.v1.IsPoweredRequest
-	17, // 16: viam.component.motor.v1.MotorService.IsMoving:input_type -> viam.component.motor.v1.IsMovingRequest
-	20, // 17: viam.component.motor.v1.MotorService.DoCommand:input_type -> viam.common.v1.DoCommandRequest
-	21, // 18: viam.component.motor.v1.MotorService.GetGeometries:input_type -> viam.common.v1.GetGeometriesRequest
-	1,  // 19: viam.component.motor.v1.MotorService.SetPower:output_type -> viam.component.motor.v1.SetPowerResponse
-	3,  // 20: viam.component.motor.v1.MotorService.GoFor:output_type -> viam.component.motor.v1.GoForResponse
-	5,  // 21: viam.component.motor.v1.MotorService.GoTo:output_type -> viam.component.motor.v1.GoToResponse
-	7,  // 22: viam.component.motor.v1.MotorService.ResetZeroPosition:output_type -> viam.component.motor.v1.ResetZeroPositionResponse
-	9,  // 23: viam.component.motor.v1.MotorService.GetPosition:output_type -> viam.component.motor.v1.GetPositionResponse
-	15, // 24: viam.component.motor.v1.MotorService.GetProperties:output_type -> viam.component.motor.v1.GetPropertiesResponse
-	11, // 25: viam.component.motor.v1.MotorService.Stop:output_type -> viam.component.motor.v1.StopResponse
-	13, // 26: viam.component.motor.v1.MotorService.IsPowered:output_type -> viam.component.motor.v1.IsPoweredResponse
-	18, // 27: viam.component.motor.v1.MotorService.IsMoving:output_type -> viam.component.motor.v1.IsMovingResponse
-	22, // 28: viam.component.motor.v1.MotorService.DoCommand:output_type -> viam.common.v1.DoCommandResponse
-	23, // 29: viam.component.motor.v1.MotorService.GetGeometries:output_type -> viam.common.v1.GetGeometriesResponse
-	19, // [19:30] is the sub-list for method output_type
-	8,  // [8:19] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	29, // 0: viam.component.motor.v1.SetPowerRequest.extra:type_name -> google.protobuf.Struct
+	29, // 1: viam.component.motor.v1.GoForRequest.extra:type_name -> google.protobuf.Struct
+	29, // 2: viam.component.motor.v1.GoToRequest.extra:type_name -> google.protobuf.Struct
+	29, // 3: viam.component.motor.v1.SetRPMRequest.extra:type_name -> google.protobuf.Struct
+	29, // 4: viam.component.motor.v1.SetTorqueRequest.extra:type_name -> google.protobuf.Struct
+	29, // 5: viam.component.motor.v1.ResetZeroPositionRequest.extra:type_name -> google.protobuf.Struct
+	29, // 6: viam.component.motor.v1.GetPositionRequest.extra:type_name -> google.protobuf.Struct
+	29, // 7: viam.component.motor.v1.StopRequest.extra:type_name -> google.protobuf.Struct
+	29, // 8: viam.component.motor.v1.IsPoweredRequest.extra:type_name -> google.protobuf.Struct
+	29, // 9: viam.component.motor.v1.GetTelemetryRequest.extra:type_name -> google.protobuf.Struct
+	21, // 10: viam.component.motor.v1.GetTelemetryResponse.faults:type_name -> viam.component.motor.v1.MotorFault
+	30, // 11: viam.component.motor.v1.GetTelemetryResponse.measured_at:type_name -> google.protobuf.Timestamp
+	0,  // 12: viam.component.motor.v1.MotorFault.code:type_name -> viam.component.motor.v1.MotorFaultCode
+	30, // 13: viam.component.motor.v1.MotorFault.detected_at:type_name -> google.protobuf.Timestamp
+	29, // 14: viam.component.motor.v1.ClearFaultsRequest.extra:type_name -> google.protobuf.Struct
+	29, // 15: viam.component.motor.v1.GetPropertiesRequest.extra:type_name -> google.protobuf.Struct
+	1,  // 16: viam.component.motor.v1.MotorService.SetPower:input_type -> viam.component.motor.v1.SetPowerRequest
+	3,  // 17: viam.component.motor.v1.MotorService.GoFor:input_type -> viam.component.motor.v1.GoForRequest
+	5,  // 18: viam.component.motor.v1.MotorService.GoTo:input_type -> viam.component.motor.v1.GoToRequest
+	7,  // 19: viam.component.motor.v1.MotorService.SetRPM:input_type -> viam.component.motor.v1.SetRPMRequest
+	9,  // 20: viam.component.motor.v1.MotorService.SetTorque:input_type -> viam.component.motor.v1.SetTorqueRequest
+	11, // 21: viam.component.motor.v1.MotorService.ResetZeroPosition:input_type -> viam.component.motor.v1.ResetZeroPositionRequest
+	13, // 22: viam.component.motor.v1.MotorService.GetPosition:input_type -> viam.component.motor.v1.GetPositionRequest
+	24, // 23: viam.component.motor.v1.MotorService.GetProperties:input_type -> viam.component.motor.v1.GetPropertiesRequest
+	15, // 24: viam.component.motor.v1.MotorService.Stop:input_type -> viam.component.motor.v1.StopRequest
+	17, // 25: viam.component.motor.v1.MotorService.IsPowered:input_type -> viam.component.motor.v1.IsPoweredRequest
+	19, // 26: viam.component.motor.v1.MotorService.GetTelemetry:input_type -> viam.component.motor.v1.GetTelemetryRequest
+	22, // 27: viam.component.motor.v1.MotorService.ClearFaults:input_type -> viam.component.motor.v1.ClearFaultsRequest
+	27, // 28: viam.component.motor.v1.MotorService.IsMoving:input_type -> viam.component.motor.v1.IsMovingRequest
+	31, // 29: viam.component.motor.v1.MotorService.DoCommand:input_type -> viam.common.v1.DoCommandRequest
+	32, // 30: viam.component.motor.v1.MotorService.GetGeometries:input_type -> viam.common.v1.GetGeometriesRequest
+	2,  // 31: viam.component.motor.v1.MotorService.SetPower:output_type -> viam.component.motor.v1.SetPowerResponse
+	4,  // 32: viam.component.motor.v1.MotorService.GoFor:output_type -> viam.component.motor.v1.GoForResponse
+	6,  // 33: viam.component.motor.v1.MotorService.GoTo:output_type -> viam.component.motor.v1.GoToResponse
+	8,  // 34: viam.component.motor.v1.MotorService.SetRPM:output_type -> viam.component.motor.v1.SetRPMResponse
+	10, // 35: viam.component.motor.v1.MotorService.SetTorque:output_type -> viam.component.motor.v1.SetTorqueResponse
+	12, // 36: viam.component.motor.v1.MotorService.ResetZeroPosition:output_type -> viam.component.motor.v1.ResetZeroPositionResponse
+	14, // 37: viam.component.motor.v1.MotorService.GetPosition:output_type -> viam.component.motor.v1.GetPositionResponse
+	25, // 38: viam.component.motor.v1.MotorService.GetProperties:output_type -> viam.component.motor.v1.GetPropertiesResponse
+	16, // 39: viam.component.motor.v1.MotorService.Stop:output_type -> viam.component.motor.v1.StopResponse
+	18, // 40: viam.component.motor.v1.MotorService.IsPowered:output_type -> viam.component.motor.v1.IsPoweredResponse
+	20, // 41: viam.component.motor.v1.MotorService.GetTelemetry:output_type -> viam.component.motor.v1.GetTelemetryResponse
+	23, // 42: viam.component.motor.v1.MotorService.ClearFaults:output_type -> viam.component.motor.v1.ClearFaultsResponse
+	28, // 43: viam.component.motor.v1.MotorService.IsMoving:output_type -> viam.component.motor.v1.IsMovingResponse
+	33, // 44: viam.component.motor.v1.MotorService.DoCommand:output_type -> viam.common.v1.DoCommandResponse
+	34, // 45: viam.component.motor.v1.MotorService.GetGeometries:output_type -> viam.common.v1.GetGeometriesResponse
+	31, // [31:46] is the sub-list for method output_type
+	16, // [16:31] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_component_motor_v1_motor_proto_init() }
@@ -1403,7 +2214,7 @@ func file_component_motor_v1_motor_proto_init() {
 			}
 		}
 		file_component_motor_v1_motor_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResetZeroPositionRequest); i {
+			switch v := v.(*SetRPMRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1415,7 +2226,7 @@ func file_component_motor_v1_motor_proto_init() {
 			}
 		}
 		file_component_motor_v1_motor_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResetZeroPositionResponse); i {
+			switch v := v.(*SetRPMResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1427,7 +2238,7 @@ func file_component_motor_v1_motor_proto_init() {
 			}
 		}
 		file_component_motor_v1_motor_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPositionRequest); i {
+			switch v := v.(*SetTorqueRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1439,7 +2250,7 @@ func file_component_motor_v1_motor_proto_init() {
 			}
 		}
 		file_component_motor_v1_motor_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPositionResponse); i {
+			switch v := v.(*SetTorqueResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1451,7 +2262,7 @@ func file_component_motor_v1_motor_proto_init() {
 			}
 		}
 		file_component_motor_v1_motor_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopRequest); i {
+			switch v := v.(*ResetZeroPositionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1463,7 +2274,7 @@ func file_component_motor_v1_motor_proto_init() {
 			}
 		}
 		file_component_motor_v1_motor_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopResponse); i {
+			switch v := v.(*ResetZeroPositionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1475,7 +2286,7 @@ func file_component_motor_v1_motor_proto_init() {
 			}
 		}
 		file_component_motor_v1_motor_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsPoweredRequest); i {
+			switch v := v.(*GetPositionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1487,7 +2298,7 @@ func file_component_motor_v1_motor_proto_init() {
 			}
 		}
 		file_component_motor_v1_motor_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsPoweredResponse); i {
+			switch v := v.(*GetPositionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1499,7 +2310,7 @@ func file_component_motor_v1_motor_proto_init() {
 			}
 		}
 		file_component_motor_v1_motor_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPropertiesRequest); i {
+			switch v := v.(*StopRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1511,7 +2322,7 @@ func file_component_motor_v1_motor_proto_init() {
 			}
 		}
 		file_component_motor_v1_motor_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPropertiesResponse); i {
+			switch v := v.(*StopResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1523,7 +2334,7 @@ func file_component_motor_v1_motor_proto_init() {
 			}
 		}
 		file_component_motor_v1_motor_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Status); i {
+			switch v := v.(*IsPoweredRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1535,7 +2346,7 @@ func file_component_motor_v1_motor_proto_init() {
 			}
 		}
 		file_component_motor_v1_motor_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsMovingRequest); i {
+			switch v := v.(*IsPoweredResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1547,6 +2358,114 @@ func file_component_motor_v1_motor_proto_init() {
 			}
 		}
 		file_component_motor_v1_motor_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTelemetryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_motor_v1_motor_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTelemetryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_motor_v1_motor_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MotorFault); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_motor_v1_motor_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearFaultsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_motor_v1_motor_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearFaultsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_motor_v1_motor_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPropertiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_motor_v1_motor_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPropertiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_motor_v1_motor_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Status); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_motor_v1_motor_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsMovingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_component_motor_v1_motor_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IsMovingResponse); i {
 			case 0:
 				return &v.state
@@ -1559,18 +2478,22 @@ func file_component_motor_v1_motor_proto_init() {
 			}
 		}
 	}
+	file_component_motor_v1_motor_proto_msgTypes[8].OneofWrappers = []interface{}{}
+	file_component_motor_v1_motor_proto_msgTypes[19].OneofWrappers = []interface{}{}
+	file_component_motor_v1_motor_proto_msgTypes[24].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_component_motor_v1_motor_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   19,
+			NumEnums:      1,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_component_motor_v1_motor_proto_goTypes,
 		DependencyIndexes: file_component_motor_v1_motor_proto_depIdxs,
+		EnumInfos:         file_component_motor_v1_motor_proto_enumTypes,
 		MessageInfos:      file_component_motor_v1_motor_proto_msgTypes,
 	}.Build()
 	File_component_motor_v1_motor_proto = out.File
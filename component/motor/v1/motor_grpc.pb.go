@@ -37,6 +37,14 @@ type MotorServiceClient interface {
 	// is relative to its home position at a specified speed which is expressed in RPM
 	// This method will return an error if position reporting is not supported
 	GoTo(ctx context.Context, in *GoToRequest, opts ...grpc.CallOption) (*GoToResponse, error)
+	// SetRPM instructs the motor to turn at a specified speed, which is expressed in RPM,
+	// indefinitely until stopped or another operation comes in
+	// This method will return an error if velocity control is not supported
+	SetRPM(ctx context.Context, in *SetRPMRequest, opts ...grpc.CallOption) (*SetRPMResponse, error)
+	// SetTorque instructs the motor to apply a specified torque, which is expressed in newton meters,
+	// indefinitely until stopped or another operation comes in
+	// This method will return an error if torque control is not supported
+	SetTorque(ctx context.Context, in *SetTorqueRequest, opts ...grpc.CallOption) (*SetTorqueResponse, error)
 	// ResetZeroPosition sets the current position of the motor as the new zero position
 	// This method will return an error if position reporting is not supported
 	ResetZeroPosition(ctx context.Context, in *ResetZeroPositionRequest, opts ...grpc.CallOption) (*ResetZeroPositionResponse, error)
@@ -49,6 +57,11 @@ type MotorServiceClient interface {
 	Stop(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error)
 	// IsPowered returns true if the robot's motor is on
 	IsPowered(ctx context.Context, in *IsPoweredRequest, opts ...grpc.CallOption) (*IsPoweredResponse, error)
+	// GetTelemetry reports the measured electrical, thermal and speed state of the robot's motor and any active faults
+	GetTelemetry(ctx context.Context, in *GetTelemetryRequest, opts ...grpc.CallOption) (*GetTelemetryResponse, error)
+	// ClearFaults clears the latched faults of the robot's motor
+	// This method will return an error if a fault is still present
+	ClearFaults(ctx context.Context, in *ClearFaultsRequest, opts ...grpc.CallOption) (*ClearFaultsResponse, error)
 	// IsMoving reports if a component is in motion
 	IsMoving(ctx context.Context, in *IsMovingRequest, opts ...grpc.CallOption) (*IsMovingResponse, error)
 	// DoCommand sends/receives arbitrary commands
@@ -92,6 +105,24 @@ func (c *motorServiceClient) GoTo(ctx context.Context, in *GoToRequest, opts ...
 	return out, nil
 }
 
+func (c *motorServiceClient) SetRPM(ctx context.Context, in *SetRPMRequest, opts ...grpc.CallOption) (*SetRPMResponse, error) {
+	out := new(SetRPMResponse)
+	err := c.cc.Invoke(ctx, "/viam.component.motor.v1.MotorService/SetRPM", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *motorServiceClient) SetTorque(ctx context.Context, in *SetTorqueRequest, opts ...grpc.CallOption) (*SetTorqueResponse, error) {
+	out := new(SetTorqueResponse)
+	err := c.cc.Invoke(ctx, "/viam.component.motor.v1.MotorService/SetTorque", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *motorServiceClient) ResetZeroPosition(ctx context.Context, in *ResetZeroPositionRequest, opts ...grpc.CallOption) (*ResetZeroPositionResponse, error) {
 	out := new(ResetZeroPositionResponse)
 	err := c.cc.Invoke(ctx, "/viam.component.motor.v1.MotorService/ResetZeroPosition", in, out, opts...)
@@ -137,6 +168,24 @@ func (c *motorServiceClient) IsPowered(ctx context.Context, in *IsPoweredRequest
 	return out, nil
 }
 
+func (c *motorServiceClient) GetTelemetry(ctx context.Context, in *GetTelemetryRequest, opts ...grpc.CallOption) (*GetTelemetryResponse, error) {
+	out := new(GetTelemetryResponse)
+	err := c.cc.Invoke(ctx, "/viam.component.motor.v1.MotorService/GetTelemetry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *motorServiceClient) ClearFaults(ctx context.Context, in *ClearFaultsRequest, opts ...grpc.CallOption) (*ClearFaultsResponse, error) {
+	out := new(ClearFaultsResponse)
+	err := c.cc.Invoke(ctx, "/viam.component.motor.v1.MotorService/ClearFaults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *motorServiceClient) IsMoving(ctx context.Context, in *IsMovingRequest, opts ...grpc.CallOption) (*IsMovingResponse, error) {
 	out := new(IsMovingResponse)
 	err := c.cc.Invoke(ctx, "/viam.component.motor.v1.MotorService/IsMoving", in, out, opts...)
@@ -182,6 +231,14 @@ type MotorServiceServer interface {
 	// is relative to its home position at a specified speed which is expressed in RPM
 	// This method will return an error if position reporting is not supported
 	GoTo(context.Context, *GoToRequest) (*GoToResponse, error)
+	// SetRPM instructs the motor to turn at a specified speed, which is expressed in RPM,
+	// indefinitely until stopped or another operation comes in
+	// This method will return an error if velocity control is not supported
+	SetRPM(context.Context, *SetRPMRequest) (*SetRPMResponse, error)
+	// SetTorque instructs the motor to apply a specified torque, which is expressed in newton meters,
+	// indefinitely until stopped or another operation comes in
+	// This method will return an error if torque control is not supported
+	SetTorque(context.Context, *SetTorqueRequest) (*SetTorqueResponse, error)
 	// ResetZeroPosition sets the current position of the motor as the new zero position
 	// This method will return an error if position reporting is not supported
 	ResetZeroPosition(context.Context, *ResetZeroPositionRequest) (*ResetZeroPositionResponse, error)
@@ -194,6 +251,11 @@ type MotorServiceServer interface {
 	Stop(context.Context, *StopRequest) (*StopResponse, error)
 	// IsPowered returns true if the robot's motor is on
 	IsPowered(context.Context, *IsPoweredRequest) (*IsPoweredResponse, error)
+	// GetTelemetry reports the measured electrical, thermal and speed state of the robot's motor and any active faults
+	GetTelemetry(context.Context, *GetTelemetryRequest) (*GetTelemetryResponse, error)
+	// ClearFaults clears the latched faults of the robot's motor
+	// This method will return an error if a fault is still present
+	ClearFaults(context.Context, *ClearFaultsRequest) (*ClearFaultsResponse, error)
 	// IsMoving reports if a component is in motion
 	IsMoving(context.Context, *IsMovingRequest) (*IsMovingResponse, error)
 	// DoCommand sends/receives arbitrary commands
@@ -216,6 +278,12 @@ func (UnimplementedMotorServiceServer) GoFor(context.Context, *GoForRequest) (*G
 func (UnimplementedMotorServiceServer) GoTo(context.Context, *GoToRequest) (*GoToResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GoTo not implemented")
 }
+func (UnimplementedMotorServiceServer) SetRPM(context.Context, *SetRPMRequest) (*SetRPMResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRPM not implemented")
+}
+func (UnimplementedMotorServiceServer) SetTorque(context.Context, *SetTorqueRequest) (*SetTorqueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTorque not implemented")
+}
 func (UnimplementedMotorServiceServer) ResetZeroPosition(context.Context, *ResetZeroPositionRequest) (*ResetZeroPositionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetZeroPosition not implemented")
 }
@@ -231,6 +299,12 @@ func (UnimplementedMotorServiceServer) Stop(context.Context, *StopRequest) (*Sto
 func (UnimplementedMotorServiceServer) IsPowered(context.Context, *IsPoweredRequest) (*IsPoweredResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsPowered not implemented")
 }
+func (UnimplementedMotorServiceServer) GetTelemetry(context.Context, *GetTelemetryRequest) (*GetTelemetryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTelemetry not implemented")
+}
+func (UnimplementedMotorServiceServer) ClearFaults(context.Context, *ClearFaultsRequest) (*ClearFaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearFaults not implemented")
+}
 func (UnimplementedMotorServiceServer) IsMoving(context.Context, *IsMovingRequest) (*IsMovingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsMoving not implemented")
 }
@@ -307,6 +381,42 @@ func _MotorService_GoTo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MotorService_SetRPM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRPMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MotorServiceServer).SetRPM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/viam.component.motor.v1.MotorService/SetRPM",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MotorServiceServer).SetRPM(ctx, req.(*SetRPMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MotorService_SetTorque_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTorqueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MotorServiceServer).SetTorque(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/viam.component.motor.v1.MotorService/SetTorque",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MotorServiceServer).SetTorque(ctx, req.(*SetTorqueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MotorService_ResetZeroPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResetZeroPositionRequest)
 	if err := dec(in); err != nil {
@@ -397,6 +507,42 @@ func _MotorService_IsPowered_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MotorService_GetTelemetry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTelemetryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MotorServiceServer).GetTelemetry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/viam.component.motor.v1.MotorService/GetTelemetry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MotorServiceServer).GetTelemetry(ctx, req.(*GetTelemetryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MotorService_ClearFaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearFaultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MotorServiceServer).ClearFaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/viam.component.motor.v1.MotorService/ClearFaults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MotorServiceServer).ClearFaults(ctx, req.(*ClearFaultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MotorService_IsMoving_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(IsMovingRequest)
 	if err := dec(in); err != nil {
@@ -470,6 +616,14 @@ var MotorService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GoTo",
 			Handler:    _MotorService_GoTo_Handler,
 		},
+		{
+			MethodName: "SetRPM",
+			Handler:    _MotorService_SetRPM_Handler,
+		},
+		{
+			MethodName: "SetTorque",
+			Handler:    _MotorService_SetTorque_Handler,
+		},
 		{
 			MethodName: "ResetZeroPosition",
 			Handler:    _MotorService_ResetZeroPosition_Handler,
@@ -490,6 +644,14 @@ var MotorService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IsPowered",
 			Handler:    _MotorService_IsPowered_Handler,
 		},
+		{
+			MethodName: "GetTelemetry",
+			Handler:    _MotorService_GetTelemetry_Handler,
+		},
+		{
+			MethodName: "ClearFaults",
+			Handler:    _MotorService_ClearFaults_Handler,
+		},
 		{
 			MethodName: "IsMoving",
 			Handler:    _MotorService_IsMoving_Handler,
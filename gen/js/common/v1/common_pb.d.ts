@@ -64,6 +64,18 @@ export class AnalogStatus extends jspb.Message {
   getValue(): number;
   setValue(value: number): void;
 
+  getVoltage(): number;
+  setVoltage(value: number): void;
+
+  getMinRange(): number;
+  setMinRange(value: number): void;
+
+  getMaxRange(): number;
+  setMaxRange(value: number): void;
+
+  getStepSize(): number;
+  setStepSize(value: number): void;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): AnalogStatus.AsObject;
   static toObject(includeInstance: boolean, msg: AnalogStatus): AnalogStatus.AsObject;
@@ -77,6 +89,10 @@ export class AnalogStatus extends jspb.Message {
 export namespace AnalogStatus {
   export type AsObject = {
     value: number,
+    voltage: number,
+    minRange: number,
+    maxRange: number,
+    stepSize: number,
   }
 }
 
@@ -402,6 +418,60 @@ export namespace PointCloudObject {
   }
 }
 
+export class PointCloud extends jspb.Message {
+  getNumPoints(): number;
+  setNumPoints(value: number): void;
+
+  clearPositionsMmList(): void;
+  getPositionsMmList(): Array<number>;
+  setPositionsMmList(value: Array<number>): void;
+  addPositionsMm(value: number, index?: number): number;
+
+  clearColorsList(): void;
+  getColorsList(): Array<number>;
+  setColorsList(value: Array<number>): void;
+  addColors(value: number, index?: number): number;
+
+  clearIntensitiesList(): void;
+  getIntensitiesList(): Array<number>;
+  setIntensitiesList(value: Array<number>): void;
+  addIntensities(value: number, index?: number): number;
+
+  clearNormalsList(): void;
+  getNormalsList(): Array<number>;
+  setNormalsList(value: Array<number>): void;
+  addNormals(value: number, index?: number): number;
+
+  getCompression(): PointCloudCompressionMap[keyof PointCloudCompressionMap];
+  setCompression(value: PointCloudCompressionMap[keyof PointCloudCompressionMap]): void;
+
+  getCompressedData(): Uint8Array | string;
+  getCompressedData_asU8(): Uint8Array;
+  getCompressedData_asB64(): string;
+  setCompressedData(value: Uint8Array | string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PointCloud.AsObject;
+  static toObject(includeInstance: boolean, msg: PointCloud): PointCloud.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: PointCloud, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PointCloud;
+  static deserializeBinaryFromReader(message: PointCloud, reader: jspb.BinaryReader): PointCloud;
+}
+
+export namespace PointCloud {
+  export type AsObject = {
+    numPoints: number,
+    positionsMmList: Array<number>,
+    colorsList: Array<number>,
+    intensitiesList: Array<number>,
+    normalsList: Array<number>,
+    compression: PointCloudCompressionMap[keyof PointCloudCompressionMap],
+    compressedData: Uint8Array | string,
+  }
+}
+
 export class GeoPoint extends jspb.Message {
   getLatitude(): number;
   setLatitude(value: number): void;
@@ -706,6 +776,15 @@ export namespace GetGeometriesResponse {
 
   export const safetyHeartbeatMonitored: jspb.ExtensionFieldInfo<boolean>;
 
+export interface PointCloudCompressionMap {
+  POINT_CLOUD_COMPRESSION_UNSPECIFIED: 0;
+  POINT_CLOUD_COMPRESSION_NONE: 1;
+  POINT_CLOUD_COMPRESSION_ZSTD: 2;
+  POINT_CLOUD_COMPRESSION_GZIP: 3;
+}
+
+export const PointCloudCompression: PointCloudCompressionMap;
+
 export interface KinematicsFileFormatMap {
   KINEMATICS_FILE_FORMAT_UNSPECIFIED: 0;
   KINEMATICS_FILE_FORMAT_SVA: 1;
@@ -39,6 +39,8 @@ goog.exportSymbol('proto.viam.common.v1.GetKinematicsRequest', null, global);
 goog.exportSymbol('proto.viam.common.v1.GetKinematicsResponse', null, global);
 goog.exportSymbol('proto.viam.common.v1.KinematicsFileFormat', null, global);
 goog.exportSymbol('proto.viam.common.v1.Orientation', null, global);
+goog.exportSymbol('proto.viam.common.v1.PointCloud', null, global);
+goog.exportSymbol('proto.viam.common.v1.PointCloudCompression', null, global);
 goog.exportSymbol('proto.viam.common.v1.PointCloudObject', null, global);
 goog.exportSymbol('proto.viam.common.v1.Pose', null, global);
 goog.exportSymbol('proto.viam.common.v1.PoseInFrame', null, global);
@@ -344,6 +346,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.viam.common.v1.PointCloudObject.displayName = 'proto.viam.common.v1.PointCloudObject';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.common.v1.PointCloud = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.viam.common.v1.PointCloud.repeatedFields_, null);
+};
+goog.inherits(proto.viam.common.v1.PointCloud, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.common.v1.PointCloud.displayName = 'proto.viam.common.v1.PointCloud';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -1014,7 +1037,11 @@ proto.viam.common.v1.AnalogStatus.prototype.toObject = function(opt_includeInsta
  */
 proto.viam.common.v1.AnalogStatus.toObject = function(includeInstance, msg) {
   var f, obj = {
-    value: jspb.Message.getFieldWithDefault(msg, 1, 0)
+    value: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    voltage: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0),
+    minRange: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0),
+    maxRange: jspb.Message.getFloatingPointFieldWithDefault(msg, 4, 0.0),
+    stepSize: jspb.Message.getFloatingPointFieldWithDefault(msg, 5, 0.0)
   };
 
   if (includeInstance) {
@@ -1055,6 +1082,22 @@ proto.viam.common.v1.AnalogStatus.deserializeBinaryFromReader = function(msg, re
       var value = /** @type {number} */ (reader.readInt32());
       msg.setValue(value);
       break;
+    case 2:
+      var value = /** @type {number} */ (reader.readFloat());
+      msg.setVoltage(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readFloat());
+      msg.setMinRange(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readFloat());
+      msg.setMaxRange(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readFloat());
+      msg.setStepSize(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1091,6 +1134,34 @@ proto.viam.common.v1.AnalogStatus.serializeBinaryToWriter = function(message, wr
       f
     );
   }
+  f = message.getVoltage();
+  if (f !== 0.0) {
+    writer.writeFloat(
+      2,
+      f
+    );
+  }
+  f = message.getMinRange();
+  if (f !== 0.0) {
+    writer.writeFloat(
+      3,
+      f
+    );
+  }
+  f = message.getMaxRange();
+  if (f !== 0.0) {
+    writer.writeFloat(
+      4,
+      f
+    );
+  }
+  f = message.getStepSize();
+  if (f !== 0.0) {
+    writer.writeFloat(
+      5,
+      f
+    );
+  }
 };
 
 
@@ -1112,6 +1183,78 @@ proto.viam.common.v1.AnalogStatus.prototype.setValue = function(value) {
 };
 
 
+/**
+ * optional float voltage = 2;
+ * @return {number}
+ */
+proto.viam.common.v1.AnalogStatus.prototype.getVoltage = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.common.v1.AnalogStatus} returns this
+ */
+proto.viam.common.v1.AnalogStatus.prototype.setVoltage = function(value) {
+  return jspb.Message.setProto3FloatField(this, 2, value);
+};
+
+
+/**
+ * optional float min_range = 3;
+ * @return {number}
+ */
+proto.viam.common.v1.AnalogStatus.prototype.getMinRange = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 3, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.common.v1.AnalogStatus} returns this
+ */
+proto.viam.common.v1.AnalogStatus.prototype.setMinRange = function(value) {
+  return jspb.Message.setProto3FloatField(this, 3, value);
+};
+
+
+/**
+ * optional float max_range = 4;
+ * @return {number}
+ */
+proto.viam.common.v1.AnalogStatus.prototype.getMaxRange = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 4, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.common.v1.AnalogStatus} returns this
+ */
+proto.viam.common.v1.AnalogStatus.prototype.setMaxRange = function(value) {
+  return jspb.Message.setProto3FloatField(this, 4, value);
+};
+
+
+/**
+ * optional float step_size = 5;
+ * @return {number}
+ */
+proto.viam.common.v1.AnalogStatus.prototype.getStepSize = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 5, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.common.v1.AnalogStatus} returns this
+ */
+proto.viam.common.v1.AnalogStatus.prototype.setStepSize = function(value) {
+  return jspb.Message.setProto3FloatField(this, 5, value);
+};
+
+
 
 
 
@@ -3341,6 +3484,431 @@ proto.viam.common.v1.PointCloudObject.prototype.hasGeometries = function() {
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.common.v1.PointCloud.repeatedFields_ = [2,3,4,5];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.common.v1.PointCloud.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.common.v1.PointCloud.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.common.v1.PointCloud} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.common.v1.PointCloud.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    numPoints: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    positionsMmList: (f = jspb.Message.getRepeatedFloatingPointField(msg, 2)) == null ? undefined : f,
+    colorsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+    intensitiesList: (f = jspb.Message.getRepeatedFloatingPointField(msg, 4)) == null ? undefined : f,
+    normalsList: (f = jspb.Message.getRepeatedFloatingPointField(msg, 5)) == null ? undefined : f,
+    compression: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    compressedData: msg.getCompressedData_asB64()
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.common.v1.PointCloud}
+ */
+proto.viam.common.v1.PointCloud.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.common.v1.PointCloud;
+  return proto.viam.common.v1.PointCloud.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.common.v1.PointCloud} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.common.v1.PointCloud}
+ */
+proto.viam.common.v1.PointCloud.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setNumPoints(value);
+      break;
+    case 2:
+      var values = /** @type {!Array<number>} */ (reader.isDelimited() ? reader.readPackedFloat() : [reader.readFloat()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addPositionsMm(values[i]);
+      }
+      break;
+    case 3:
+      var values = /** @type {!Array<number>} */ (reader.isDelimited() ? reader.readPackedUint32() : [reader.readUint32()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addColors(values[i]);
+      }
+      break;
+    case 4:
+      var values = /** @type {!Array<number>} */ (reader.isDelimited() ? reader.readPackedFloat() : [reader.readFloat()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addIntensities(values[i]);
+      }
+      break;
+    case 5:
+      var values = /** @type {!Array<number>} */ (reader.isDelimited() ? reader.readPackedFloat() : [reader.readFloat()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addNormals(values[i]);
+      }
+      break;
+    case 6:
+      var value = /** @type {!proto.viam.common.v1.PointCloudCompression} */ (reader.readEnum());
+      msg.setCompression(value);
+      break;
+    case 7:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setCompressedData(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.common.v1.PointCloud.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.common.v1.PointCloud.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.common.v1.PointCloud} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.common.v1.PointCloud.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getNumPoints();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getPositionsMmList();
+  if (f.length > 0) {
+    writer.writePackedFloat(
+      2,
+      f
+    );
+  }
+  f = message.getColorsList();
+  if (f.length > 0) {
+    writer.writePackedUint32(
+      3,
+      f
+    );
+  }
+  f = message.getIntensitiesList();
+  if (f.length > 0) {
+    writer.writePackedFloat(
+      4,
+      f
+    );
+  }
+  f = message.getNormalsList();
+  if (f.length > 0) {
+    writer.writePackedFloat(
+      5,
+      f
+    );
+  }
+  f = message.getCompression();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      6,
+      f
+    );
+  }
+  f = message.getCompressedData_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      7,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 num_points = 1;
+ * @return {number}
+ */
+proto.viam.common.v1.PointCloud.prototype.getNumPoints = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.common.v1.PointCloud} returns this
+ */
+proto.viam.common.v1.PointCloud.prototype.setNumPoints = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * repeated float positions_mm = 2;
+ * @return {!Array<number>}
+ */
+proto.viam.common.v1.PointCloud.prototype.getPositionsMmList = function() {
+  return /** @type {!Array<number>} */ (jspb.Message.getRepeatedFloatingPointField(this, 2));
+};
+
+
+/**
+ * @param {!Array<number>} value
+ * @return {!proto.viam.common.v1.PointCloud} returns this
+ */
+proto.viam.common.v1.PointCloud.prototype.setPositionsMmList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {number} value
+ * @param {number=} opt_index
+ * @return {!proto.viam.common.v1.PointCloud} returns this
+ */
+proto.viam.common.v1.PointCloud.prototype.addPositionsMm = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.common.v1.PointCloud} returns this
+ */
+proto.viam.common.v1.PointCloud.prototype.clearPositionsMmList = function() {
+  return this.setPositionsMmList([]);
+};
+
+
+/**
+ * repeated uint32 colors = 3;
+ * @return {!Array<number>}
+ */
+proto.viam.common.v1.PointCloud.prototype.getColorsList = function() {
+  return /** @type {!Array<number>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/**
+ * @param {!Array<number>} value
+ * @return {!proto.viam.common.v1.PointCloud} returns this
+ */
+proto.viam.common.v1.PointCloud.prototype.setColorsList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {number} value
+ * @param {number=} opt_index
+ * @return {!proto.viam.common.v1.PointCloud} returns this
+ */
+proto.viam.common.v1.PointCloud.prototype.addColors = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.common.v1.PointCloud} returns this
+ */
+proto.viam.common.v1.PointCloud.prototype.clearColorsList = function() {
+  return this.setColorsList([]);
+};
+
+
+/**
+ * repeated float intensities = 4;
+ * @return {!Array<number>}
+ */
+proto.viam.common.v1.PointCloud.prototype.getIntensitiesList = function() {
+  return /** @type {!Array<number>} */ (jspb.Message.getRepeatedFloatingPointField(this, 4));
+};
+
+
+/**
+ * @param {!Array<number>} value
+ * @return {!proto.viam.common.v1.PointCloud} returns this
+ */
+proto.viam.common.v1.PointCloud.prototype.setIntensitiesList = function(value) {
+  return jspb.Message.setField(this, 4, value || []);
+};
+
+
+/**
+ * @param {number} value
+ * @param {number=} opt_index
+ * @return {!proto.viam.common.v1.PointCloud} returns this
+ */
+proto.viam.common.v1.PointCloud.prototype.addIntensities = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.common.v1.PointCloud} returns this
+ */
+proto.viam.common.v1.PointCloud.prototype.clearIntensitiesList = function() {
+  return this.setIntensitiesList([]);
+};
+
+
+/**
+ * repeated float normals = 5;
+ * @return {!Array<number>}
+ */
+proto.viam.common.v1.PointCloud.prototype.getNormalsList = function() {
+  return /** @type {!Array<number>} */ (jspb.Message.getRepeatedFloatingPointField(this, 5));
+};
+
+
+/**
+ * @param {!Array<number>} value
+ * @return {!proto.viam.common.v1.PointCloud} returns this
+ */
+proto.viam.common.v1.PointCloud.prototype.setNormalsList = function(value) {
+  return jspb.Message.setField(this, 5, value || []);
+};
+
+
+/**
+ * @param {number} value
+ * @param {number=} opt_index
+ * @return {!proto.viam.common.v1.PointCloud} returns this
+ */
+proto.viam.common.v1.PointCloud.prototype.addNormals = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.common.v1.PointCloud} returns this
+ */
+proto.viam.common.v1.PointCloud.prototype.clearNormalsList = function() {
+  return this.setNormalsList([]);
+};
+
+
+/**
+ * optional PointCloudCompression compression = 6;
+ * @return {!proto.viam.common.v1.PointCloudCompression}
+ */
+proto.viam.common.v1.PointCloud.prototype.getCompression = function() {
+  return /** @type {!proto.viam.common.v1.PointCloudCompression} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {!proto.viam.common.v1.PointCloudCompression} value
+ * @return {!proto.viam.common.v1.PointCloud} returns this
+ */
+proto.viam.common.v1.PointCloud.prototype.setCompression = function(value) {
+  return jspb.Message.setProto3EnumField(this, 6, value);
+};
+
+
+/**
+ * optional bytes compressed_data = 7;
+ * @return {string}
+ */
+proto.viam.common.v1.PointCloud.prototype.getCompressedData = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * optional bytes compressed_data = 7;
+ * This is a type-conversion wrapper around `getCompressedData()`
+ * @return {string}
+ */
+proto.viam.common.v1.PointCloud.prototype.getCompressedData_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getCompressedData()));
+};
+
+
+/**
+ * optional bytes compressed_data = 7;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getCompressedData()`
+ * @return {!Uint8Array}
+ */
+proto.viam.common.v1.PointCloud.prototype.getCompressedData_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getCompressedData()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.viam.common.v1.PointCloud} returns this
+ */
+proto.viam.common.v1.PointCloud.prototype.setCompressedData = function(value) {
+  return jspb.Message.setProto3BytesField(this, 7, value);
+};
+
+
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -5475,6 +6043,16 @@ proto.viam.common.v1.GetGeometriesResponse.prototype.clearGeometriesList = funct
 };
 
 
+/**
+ * @enum {number}
+ */
+proto.viam.common.v1.PointCloudCompression = {
+  POINT_CLOUD_COMPRESSION_UNSPECIFIED: 0,
+  POINT_CLOUD_COMPRESSION_NONE: 1,
+  POINT_CLOUD_COMPRESSION_ZSTD: 2,
+  POINT_CLOUD_COMPRESSION_GZIP: 3
+};
+
 /**
  * @enum {number}
  */
@@ -124,6 +124,102 @@ export namespace GetJointPositionsResponse {
   }
 }
 
+export class GetJointPropertiesRequest extends jspb.Message {
+  getName(): string;
+  setName(value: string): void;
+
+  hasExtra(): boolean;
+  clearExtra(): void;
+  getExtra(): google_protobuf_struct_pb.Struct | undefined;
+  setExtra(value?: google_protobuf_struct_pb.Struct): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetJointPropertiesRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: GetJointPropertiesRequest): GetJointPropertiesRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetJointPropertiesRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetJointPropertiesRequest;
+  static deserializeBinaryFromReader(message: GetJointPropertiesRequest, reader: jspb.BinaryReader): GetJointPropertiesRequest;
+}
+
+export namespace GetJointPropertiesRequest {
+  export type AsObject = {
+    name: string,
+    extra?: google_protobuf_struct_pb.Struct.AsObject,
+  }
+}
+
+export class GetJointPropertiesResponse extends jspb.Message {
+  clearJointsList(): void;
+  getJointsList(): Array<JointProperties>;
+  setJointsList(value: Array<JointProperties>): void;
+  addJoints(value?: JointProperties, index?: number): JointProperties;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetJointPropertiesResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetJointPropertiesResponse): GetJointPropertiesResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetJointPropertiesResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetJointPropertiesResponse;
+  static deserializeBinaryFromReader(message: GetJointPropertiesResponse, reader: jspb.BinaryReader): GetJointPropertiesResponse;
+}
+
+export namespace GetJointPropertiesResponse {
+  export type AsObject = {
+    jointsList: Array<JointProperties.AsObject>,
+  }
+}
+
+export class JointProperties extends jspb.Message {
+  getName(): string;
+  setName(value: string): void;
+
+  getType(): JointTypeMap[keyof JointTypeMap];
+  setType(value: JointTypeMap[keyof JointTypeMap]): void;
+
+  hasMinPosition(): boolean;
+  clearMinPosition(): void;
+  getMinPosition(): number;
+  setMinPosition(value: number): void;
+
+  hasMaxPosition(): boolean;
+  clearMaxPosition(): void;
+  getMaxPosition(): number;
+  setMaxPosition(value: number): void;
+
+  hasMaxVelocity(): boolean;
+  clearMaxVelocity(): void;
+  getMaxVelocity(): number;
+  setMaxVelocity(value: number): void;
+
+  hasMaxAcceleration(): boolean;
+  clearMaxAcceleration(): void;
+  getMaxAcceleration(): number;
+  setMaxAcceleration(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): JointProperties.AsObject;
+  static toObject(includeInstance: boolean, msg: JointProperties): JointProperties.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: JointProperties, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): JointProperties;
+  static deserializeBinaryFromReader(message: JointProperties, reader: jspb.BinaryReader): JointProperties;
+}
+
+export namespace JointProperties {
+  export type AsObject = {
+    name: string,
+    type: JointTypeMap[keyof JointTypeMap],
+    minPosition: number,
+    maxPosition: number,
+    maxVelocity: number,
+    maxAcceleration: number,
+  }
+}
+
 export class MoveToPositionRequest extends jspb.Message {
   getName(): string;
   setName(value: string): void;
@@ -220,6 +316,94 @@ export namespace MoveToJointPositionsResponse {
   }
 }
 
+export class MoveThroughJointPositionsRequest extends jspb.Message {
+  getName(): string;
+  setName(value: string): void;
+
+  clearPositionsList(): void;
+  getPositionsList(): Array<JointPositions>;
+  setPositionsList(value: Array<JointPositions>): void;
+  addPositions(value?: JointPositions, index?: number): JointPositions;
+
+  hasOptions(): boolean;
+  clearOptions(): void;
+  getOptions(): MoveOptions | undefined;
+  setOptions(value?: MoveOptions): void;
+
+  clearWaypointOptionsList(): void;
+  getWaypointOptionsList(): Array<MoveOptions>;
+  setWaypointOptionsList(value: Array<MoveOptions>): void;
+  addWaypointOptions(value?: MoveOptions, index?: number): MoveOptions;
+
+  hasExtra(): boolean;
+  clearExtra(): void;
+  getExtra(): google_protobuf_struct_pb.Struct | undefined;
+  setExtra(value?: google_protobuf_struct_pb.Struct): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): MoveThroughJointPositionsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: MoveThroughJointPositionsRequest): MoveThroughJointPositionsRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: MoveThroughJointPositionsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): MoveThroughJointPositionsRequest;
+  static deserializeBinaryFromReader(message: MoveThroughJointPositionsRequest, reader: jspb.BinaryReader): MoveThroughJointPositionsRequest;
+}
+
+export namespace MoveThroughJointPositionsRequest {
+  export type AsObject = {
+    name: string,
+    positionsList: Array<JointPositions.AsObject>,
+    options?: MoveOptions.AsObject,
+    waypointOptionsList: Array<MoveOptions.AsObject>,
+    extra?: google_protobuf_struct_pb.Struct.AsObject,
+  }
+}
+
+export class MoveThroughJointPositionsResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): MoveThroughJointPositionsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: MoveThroughJointPositionsResponse): MoveThroughJointPositionsResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: MoveThroughJointPositionsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): MoveThroughJointPositionsResponse;
+  static deserializeBinaryFromReader(message: MoveThroughJointPositionsResponse, reader: jspb.BinaryReader): MoveThroughJointPositionsResponse;
+}
+
+export namespace MoveThroughJointPositionsResponse {
+  export type AsObject = {
+  }
+}
+
+export class MoveOptions extends jspb.Message {
+  hasMaxVelDegsPerSec(): boolean;
+  clearMaxVelDegsPerSec(): void;
+  getMaxVelDegsPerSec(): number;
+  setMaxVelDegsPerSec(value: number): void;
+
+  hasMaxAccDegsPerSec2(): boolean;
+  clearMaxAccDegsPerSec2(): void;
+  getMaxAccDegsPerSec2(): number;
+  setMaxAccDegsPerSec2(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): MoveOptions.AsObject;
+  static toObject(includeInstance: boolean, msg: MoveOptions): MoveOptions.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: MoveOptions, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): MoveOptions;
+  static deserializeBinaryFromReader(message: MoveOptions, reader: jspb.BinaryReader): MoveOptions;
+}
+
+export namespace MoveOptions {
+  export type AsObject = {
+    maxVelDegsPerSec: number,
+    maxAccDegsPerSec2: number,
+  }
+}
+
 export class StopRequest extends jspb.Message {
   getName(): string;
   setName(value: string): void;
@@ -334,3 +518,12 @@ export namespace IsMovingResponse {
   }
 }
 
+export interface JointTypeMap {
+  JOINT_TYPE_UNSPECIFIED: 0;
+  JOINT_TYPE_REVOLUTE: 1;
+  JOINT_TYPE_PRISMATIC: 2;
+  JOINT_TYPE_CONTINUOUS: 3;
+}
+
+export const JointType: JointTypeMap;
+
@@ -25,9 +25,16 @@ goog.exportSymbol('proto.viam.component.arm.v1.GetEndPositionRequest', null, glo
 goog.exportSymbol('proto.viam.component.arm.v1.GetEndPositionResponse', null, global);
 goog.exportSymbol('proto.viam.component.arm.v1.GetJointPositionsRequest', null, global);
 goog.exportSymbol('proto.viam.component.arm.v1.GetJointPositionsResponse', null, global);
+goog.exportSymbol('proto.viam.component.arm.v1.GetJointPropertiesRequest', null, global);
+goog.exportSymbol('proto.viam.component.arm.v1.GetJointPropertiesResponse', null, global);
 goog.exportSymbol('proto.viam.component.arm.v1.IsMovingRequest', null, global);
 goog.exportSymbol('proto.viam.component.arm.v1.IsMovingResponse', null, global);
 goog.exportSymbol('proto.viam.component.arm.v1.JointPositions', null, global);
+goog.exportSymbol('proto.viam.component.arm.v1.JointProperties', null, global);
+goog.exportSymbol('proto.viam.component.arm.v1.JointType', null, global);
+goog.exportSymbol('proto.viam.component.arm.v1.MoveOptions', null, global);
+goog.exportSymbol('proto.viam.component.arm.v1.MoveThroughJointPositionsRequest', null, global);
+goog.exportSymbol('proto.viam.component.arm.v1.MoveThroughJointPositionsResponse', null, global);
 goog.exportSymbol('proto.viam.component.arm.v1.MoveToJointPositionsRequest', null, global);
 goog.exportSymbol('proto.viam.component.arm.v1.MoveToJointPositionsResponse', null, global);
 goog.exportSymbol('proto.viam.component.arm.v1.MoveToPositionRequest', null, global);
@@ -140,6 +147,69 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.viam.component.arm.v1.GetJointPositionsResponse.displayName = 'proto.viam.component.arm.v1.GetJointPositionsResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.arm.v1.GetJointPropertiesRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.component.arm.v1.GetJointPropertiesRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.arm.v1.GetJointPropertiesRequest.displayName = 'proto.viam.component.arm.v1.GetJointPropertiesRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.arm.v1.GetJointPropertiesResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.viam.component.arm.v1.GetJointPropertiesResponse.repeatedFields_, null);
+};
+goog.inherits(proto.viam.component.arm.v1.GetJointPropertiesResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.arm.v1.GetJointPropertiesResponse.displayName = 'proto.viam.component.arm.v1.GetJointPropertiesResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.arm.v1.JointProperties = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.component.arm.v1.JointProperties, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.arm.v1.JointProperties.displayName = 'proto.viam.component.arm.v1.JointProperties';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -224,6 +294,69 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.viam.component.arm.v1.MoveToJointPositionsResponse.displayName = 'proto.viam.component.arm.v1.MoveToJointPositionsResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.arm.v1.MoveThroughJointPositionsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.viam.component.arm.v1.MoveThroughJointPositionsRequest.repeatedFields_, null);
+};
+goog.inherits(proto.viam.component.arm.v1.MoveThroughJointPositionsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.arm.v1.MoveThroughJointPositionsRequest.displayName = 'proto.viam.component.arm.v1.MoveThroughJointPositionsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.arm.v1.MoveThroughJointPositionsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.component.arm.v1.MoveThroughJointPositionsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.arm.v1.MoveThroughJointPositionsResponse.displayName = 'proto.viam.component.arm.v1.MoveThroughJointPositionsResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.arm.v1.MoveOptions = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.component.arm.v1.MoveOptions, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.arm.v1.MoveOptions.displayName = 'proto.viam.component.arm.v1.MoveOptions';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -1167,8 +1300,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.arm.v1.MoveToPositionRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.arm.v1.MoveToPositionRequest.toObject(opt_includeInstance, this);
+proto.viam.component.arm.v1.GetJointPropertiesRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.arm.v1.GetJointPropertiesRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -1177,14 +1310,13 @@ proto.viam.component.arm.v1.MoveToPositionRequest.prototype.toObject = function(
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.arm.v1.MoveToPositionRequest} msg The msg instance to transform.
+ * @param {!proto.viam.component.arm.v1.GetJointPropertiesRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.arm.v1.MoveToPositionRequest.toObject = function(includeInstance, msg) {
+proto.viam.component.arm.v1.GetJointPropertiesRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    to: (f = msg.getTo()) && common_v1_common_pb.Pose.toObject(includeInstance, f),
     extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
   };
 
@@ -1199,23 +1331,23 @@ proto.viam.component.arm.v1.MoveToPositionRequest.toObject = function(includeIns
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.arm.v1.MoveToPositionRequest}
+ * @return {!proto.viam.component.arm.v1.GetJointPropertiesRequest}
  */
-proto.viam.component.arm.v1.MoveToPositionRequest.deserializeBinary = function(bytes) {
+proto.viam.component.arm.v1.GetJointPropertiesRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.arm.v1.MoveToPositionRequest;
-  return proto.viam.component.arm.v1.MoveToPositionRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.arm.v1.GetJointPropertiesRequest;
+  return proto.viam.component.arm.v1.GetJointPropertiesRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.arm.v1.MoveToPositionRequest} msg The message object to deserialize into.
+ * @param {!proto.viam.component.arm.v1.GetJointPropertiesRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.arm.v1.MoveToPositionRequest}
+ * @return {!proto.viam.component.arm.v1.GetJointPropertiesRequest}
  */
-proto.viam.component.arm.v1.MoveToPositionRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.arm.v1.GetJointPropertiesRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1226,11 +1358,6 @@ proto.viam.component.arm.v1.MoveToPositionRequest.deserializeBinaryFromReader =
       var value = /** @type {string} */ (reader.readString());
       msg.setName(value);
       break;
-    case 2:
-      var value = new common_v1_common_pb.Pose;
-      reader.readMessage(value,common_v1_common_pb.Pose.deserializeBinaryFromReader);
-      msg.setTo(value);
-      break;
     case 99:
       var value = new google_protobuf_struct_pb.Struct;
       reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
@@ -1249,9 +1376,9 @@ proto.viam.component.arm.v1.MoveToPositionRequest.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.arm.v1.MoveToPositionRequest.prototype.serializeBinary = function() {
+proto.viam.component.arm.v1.GetJointPropertiesRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.arm.v1.MoveToPositionRequest.serializeBinaryToWriter(this, writer);
+  proto.viam.component.arm.v1.GetJointPropertiesRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1259,11 +1386,11 @@ proto.viam.component.arm.v1.MoveToPositionRequest.prototype.serializeBinary = fu
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.arm.v1.MoveToPositionRequest} message
+ * @param {!proto.viam.component.arm.v1.GetJointPropertiesRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.arm.v1.MoveToPositionRequest.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.arm.v1.GetJointPropertiesRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getName();
   if (f.length > 0) {
@@ -1272,14 +1399,6 @@ proto.viam.component.arm.v1.MoveToPositionRequest.serializeBinaryToWriter = func
       f
     );
   }
-  f = message.getTo();
-  if (f != null) {
-    writer.writeMessage(
-      2,
-      f,
-      common_v1_common_pb.Pose.serializeBinaryToWriter
-    );
-  }
   f = message.getExtra();
   if (f != null) {
     writer.writeMessage(
@@ -1295,62 +1414,25 @@ proto.viam.component.arm.v1.MoveToPositionRequest.serializeBinaryToWriter = func
  * optional string name = 1;
  * @return {string}
  */
-proto.viam.component.arm.v1.MoveToPositionRequest.prototype.getName = function() {
+proto.viam.component.arm.v1.GetJointPropertiesRequest.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.viam.component.arm.v1.MoveToPositionRequest} returns this
+ * @return {!proto.viam.component.arm.v1.GetJointPropertiesRequest} returns this
  */
-proto.viam.component.arm.v1.MoveToPositionRequest.prototype.setName = function(value) {
+proto.viam.component.arm.v1.GetJointPropertiesRequest.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
-/**
- * optional viam.common.v1.Pose to = 2;
- * @return {?proto.viam.common.v1.Pose}
- */
-proto.viam.component.arm.v1.MoveToPositionRequest.prototype.getTo = function() {
-  return /** @type{?proto.viam.common.v1.Pose} */ (
-    jspb.Message.getWrapperField(this, common_v1_common_pb.Pose, 2));
-};
-
-
-/**
- * @param {?proto.viam.common.v1.Pose|undefined} value
- * @return {!proto.viam.component.arm.v1.MoveToPositionRequest} returns this
-*/
-proto.viam.component.arm.v1.MoveToPositionRequest.prototype.setTo = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.viam.component.arm.v1.MoveToPositionRequest} returns this
- */
-proto.viam.component.arm.v1.MoveToPositionRequest.prototype.clearTo = function() {
-  return this.setTo(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.viam.component.arm.v1.MoveToPositionRequest.prototype.hasTo = function() {
-  return jspb.Message.getField(this, 2) != null;
-};
-
-
 /**
  * optional google.protobuf.Struct extra = 99;
  * @return {?proto.google.protobuf.Struct}
  */
-proto.viam.component.arm.v1.MoveToPositionRequest.prototype.getExtra = function() {
+proto.viam.component.arm.v1.GetJointPropertiesRequest.prototype.getExtra = function() {
   return /** @type{?proto.google.protobuf.Struct} */ (
     jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
 };
@@ -1358,18 +1440,18 @@ proto.viam.component.arm.v1.MoveToPositionRequest.prototype.getExtra = function(
 
 /**
  * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.viam.component.arm.v1.MoveToPositionRequest} returns this
+ * @return {!proto.viam.component.arm.v1.GetJointPropertiesRequest} returns this
 */
-proto.viam.component.arm.v1.MoveToPositionRequest.prototype.setExtra = function(value) {
+proto.viam.component.arm.v1.GetJointPropertiesRequest.prototype.setExtra = function(value) {
   return jspb.Message.setWrapperField(this, 99, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.viam.component.arm.v1.MoveToPositionRequest} returns this
+ * @return {!proto.viam.component.arm.v1.GetJointPropertiesRequest} returns this
  */
-proto.viam.component.arm.v1.MoveToPositionRequest.prototype.clearExtra = function() {
+proto.viam.component.arm.v1.GetJointPropertiesRequest.prototype.clearExtra = function() {
   return this.setExtra(undefined);
 };
 
@@ -1378,12 +1460,19 @@ proto.viam.component.arm.v1.MoveToPositionRequest.prototype.clearExtra = functio
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.component.arm.v1.MoveToPositionRequest.prototype.hasExtra = function() {
+proto.viam.component.arm.v1.GetJointPropertiesRequest.prototype.hasExtra = function() {
   return jspb.Message.getField(this, 99) != null;
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.component.arm.v1.GetJointPropertiesResponse.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -1399,8 +1488,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.arm.v1.MoveToPositionResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.arm.v1.MoveToPositionResponse.toObject(opt_includeInstance, this);
+proto.viam.component.arm.v1.GetJointPropertiesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.arm.v1.GetJointPropertiesResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -1409,13 +1498,14 @@ proto.viam.component.arm.v1.MoveToPositionResponse.prototype.toObject = function
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.arm.v1.MoveToPositionResponse} msg The msg instance to transform.
+ * @param {!proto.viam.component.arm.v1.GetJointPropertiesResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.arm.v1.MoveToPositionResponse.toObject = function(includeInstance, msg) {
+proto.viam.component.arm.v1.GetJointPropertiesResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    jointsList: jspb.Message.toObjectList(msg.getJointsList(),
+    proto.viam.component.arm.v1.JointProperties.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -1429,29 +1519,34 @@ proto.viam.component.arm.v1.MoveToPositionResponse.toObject = function(includeIn
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.arm.v1.MoveToPositionResponse}
+ * @return {!proto.viam.component.arm.v1.GetJointPropertiesResponse}
  */
-proto.viam.component.arm.v1.MoveToPositionResponse.deserializeBinary = function(bytes) {
+proto.viam.component.arm.v1.GetJointPropertiesResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.arm.v1.MoveToPositionResponse;
-  return proto.viam.component.arm.v1.MoveToPositionResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.arm.v1.GetJointPropertiesResponse;
+  return proto.viam.component.arm.v1.GetJointPropertiesResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.arm.v1.MoveToPositionResponse} msg The message object to deserialize into.
+ * @param {!proto.viam.component.arm.v1.GetJointPropertiesResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.arm.v1.MoveToPositionResponse}
+ * @return {!proto.viam.component.arm.v1.GetJointPropertiesResponse}
  */
-proto.viam.component.arm.v1.MoveToPositionResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.arm.v1.GetJointPropertiesResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = new proto.viam.component.arm.v1.JointProperties;
+      reader.readMessage(value,proto.viam.component.arm.v1.JointProperties.deserializeBinaryFromReader);
+      msg.addJoints(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1465,9 +1560,9 @@ proto.viam.component.arm.v1.MoveToPositionResponse.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.arm.v1.MoveToPositionResponse.prototype.serializeBinary = function() {
+proto.viam.component.arm.v1.GetJointPropertiesResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.arm.v1.MoveToPositionResponse.serializeBinaryToWriter(this, writer);
+  proto.viam.component.arm.v1.GetJointPropertiesResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1475,12 +1570,58 @@ proto.viam.component.arm.v1.MoveToPositionResponse.prototype.serializeBinary = f
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.arm.v1.MoveToPositionResponse} message
+ * @param {!proto.viam.component.arm.v1.GetJointPropertiesResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.arm.v1.MoveToPositionResponse.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.arm.v1.GetJointPropertiesResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getJointsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.viam.component.arm.v1.JointProperties.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated JointProperties joints = 1;
+ * @return {!Array<!proto.viam.component.arm.v1.JointProperties>}
+ */
+proto.viam.component.arm.v1.GetJointPropertiesResponse.prototype.getJointsList = function() {
+  return /** @type{!Array<!proto.viam.component.arm.v1.JointProperties>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.viam.component.arm.v1.JointProperties, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.viam.component.arm.v1.JointProperties>} value
+ * @return {!proto.viam.component.arm.v1.GetJointPropertiesResponse} returns this
+*/
+proto.viam.component.arm.v1.GetJointPropertiesResponse.prototype.setJointsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.viam.component.arm.v1.JointProperties=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.component.arm.v1.JointProperties}
+ */
+proto.viam.component.arm.v1.GetJointPropertiesResponse.prototype.addJoints = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.viam.component.arm.v1.JointProperties, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.component.arm.v1.GetJointPropertiesResponse} returns this
+ */
+proto.viam.component.arm.v1.GetJointPropertiesResponse.prototype.clearJointsList = function() {
+  return this.setJointsList([]);
 };
 
 
@@ -1500,8 +1641,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.arm.v1.MoveToJointPositionsRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.arm.v1.MoveToJointPositionsRequest.toObject(opt_includeInstance, this);
+proto.viam.component.arm.v1.JointProperties.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.arm.v1.JointProperties.toObject(opt_includeInstance, this);
 };
 
 
@@ -1510,15 +1651,18 @@ proto.viam.component.arm.v1.MoveToJointPositionsRequest.prototype.toObject = fun
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.arm.v1.MoveToJointPositionsRequest} msg The msg instance to transform.
+ * @param {!proto.viam.component.arm.v1.JointProperties} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.arm.v1.MoveToJointPositionsRequest.toObject = function(includeInstance, msg) {
+proto.viam.component.arm.v1.JointProperties.toObject = function(includeInstance, msg) {
   var f, obj = {
     name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    positions: (f = msg.getPositions()) && proto.viam.component.arm.v1.JointPositions.toObject(includeInstance, f),
-    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+    type: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    minPosition: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0),
+    maxPosition: jspb.Message.getFloatingPointFieldWithDefault(msg, 4, 0.0),
+    maxVelocity: jspb.Message.getFloatingPointFieldWithDefault(msg, 5, 0.0),
+    maxAcceleration: jspb.Message.getFloatingPointFieldWithDefault(msg, 6, 0.0)
   };
 
   if (includeInstance) {
@@ -1532,23 +1676,23 @@ proto.viam.component.arm.v1.MoveToJointPositionsRequest.toObject = function(incl
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.arm.v1.MoveToJointPositionsRequest}
+ * @return {!proto.viam.component.arm.v1.JointProperties}
  */
-proto.viam.component.arm.v1.MoveToJointPositionsRequest.deserializeBinary = function(bytes) {
+proto.viam.component.arm.v1.JointProperties.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.arm.v1.MoveToJointPositionsRequest;
-  return proto.viam.component.arm.v1.MoveToJointPositionsRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.arm.v1.JointProperties;
+  return proto.viam.component.arm.v1.JointProperties.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.arm.v1.MoveToJointPositionsRequest} msg The message object to deserialize into.
+ * @param {!proto.viam.component.arm.v1.JointProperties} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.arm.v1.MoveToJointPositionsRequest}
+ * @return {!proto.viam.component.arm.v1.JointProperties}
  */
-proto.viam.component.arm.v1.MoveToJointPositionsRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.arm.v1.JointProperties.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1560,14 +1704,696 @@ proto.viam.component.arm.v1.MoveToJointPositionsRequest.deserializeBinaryFromRea
       msg.setName(value);
       break;
     case 2:
-      var value = new proto.viam.component.arm.v1.JointPositions;
-      reader.readMessage(value,proto.viam.component.arm.v1.JointPositions.deserializeBinaryFromReader);
-      msg.setPositions(value);
+      var value = /** @type {!proto.viam.component.arm.v1.JointType} */ (reader.readEnum());
+      msg.setType(value);
       break;
-    case 99:
-      var value = new google_protobuf_struct_pb.Struct;
-      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setExtra(value);
+    case 3:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setMinPosition(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setMaxPosition(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setMaxVelocity(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setMaxAcceleration(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.arm.v1.JointProperties.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.arm.v1.JointProperties.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.arm.v1.JointProperties} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.arm.v1.JointProperties.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getType();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeDouble(
+      3,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 4));
+  if (f != null) {
+    writer.writeDouble(
+      4,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 5));
+  if (f != null) {
+    writer.writeDouble(
+      5,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 6));
+  if (f != null) {
+    writer.writeDouble(
+      6,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.viam.component.arm.v1.JointProperties.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.arm.v1.JointProperties} returns this
+ */
+proto.viam.component.arm.v1.JointProperties.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional JointType type = 2;
+ * @return {!proto.viam.component.arm.v1.JointType}
+ */
+proto.viam.component.arm.v1.JointProperties.prototype.getType = function() {
+  return /** @type {!proto.viam.component.arm.v1.JointType} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {!proto.viam.component.arm.v1.JointType} value
+ * @return {!proto.viam.component.arm.v1.JointProperties} returns this
+ */
+proto.viam.component.arm.v1.JointProperties.prototype.setType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
+};
+
+
+/**
+ * optional double min_position = 3;
+ * @return {number}
+ */
+proto.viam.component.arm.v1.JointProperties.prototype.getMinPosition = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 3, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.arm.v1.JointProperties} returns this
+ */
+proto.viam.component.arm.v1.JointProperties.prototype.setMinPosition = function(value) {
+  return jspb.Message.setField(this, 3, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.viam.component.arm.v1.JointProperties} returns this
+ */
+proto.viam.component.arm.v1.JointProperties.prototype.clearMinPosition = function() {
+  return jspb.Message.setField(this, 3, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.arm.v1.JointProperties.prototype.hasMinPosition = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional double max_position = 4;
+ * @return {number}
+ */
+proto.viam.component.arm.v1.JointProperties.prototype.getMaxPosition = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 4, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.arm.v1.JointProperties} returns this
+ */
+proto.viam.component.arm.v1.JointProperties.prototype.setMaxPosition = function(value) {
+  return jspb.Message.setField(this, 4, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.viam.component.arm.v1.JointProperties} returns this
+ */
+proto.viam.component.arm.v1.JointProperties.prototype.clearMaxPosition = function() {
+  return jspb.Message.setField(this, 4, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.arm.v1.JointProperties.prototype.hasMaxPosition = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional double max_velocity = 5;
+ * @return {number}
+ */
+proto.viam.component.arm.v1.JointProperties.prototype.getMaxVelocity = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 5, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.arm.v1.JointProperties} returns this
+ */
+proto.viam.component.arm.v1.JointProperties.prototype.setMaxVelocity = function(value) {
+  return jspb.Message.setField(this, 5, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.viam.component.arm.v1.JointProperties} returns this
+ */
+proto.viam.component.arm.v1.JointProperties.prototype.clearMaxVelocity = function() {
+  return jspb.Message.setField(this, 5, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.arm.v1.JointProperties.prototype.hasMaxVelocity = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional double max_acceleration = 6;
+ * @return {number}
+ */
+proto.viam.component.arm.v1.JointProperties.prototype.getMaxAcceleration = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 6, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.arm.v1.JointProperties} returns this
+ */
+proto.viam.component.arm.v1.JointProperties.prototype.setMaxAcceleration = function(value) {
+  return jspb.Message.setField(this, 6, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.viam.component.arm.v1.JointProperties} returns this
+ */
+proto.viam.component.arm.v1.JointProperties.prototype.clearMaxAcceleration = function() {
+  return jspb.Message.setField(this, 6, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.arm.v1.JointProperties.prototype.hasMaxAcceleration = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.arm.v1.MoveToPositionRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.arm.v1.MoveToPositionRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.arm.v1.MoveToPositionRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.arm.v1.MoveToPositionRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    to: (f = msg.getTo()) && common_v1_common_pb.Pose.toObject(includeInstance, f),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.arm.v1.MoveToPositionRequest}
+ */
+proto.viam.component.arm.v1.MoveToPositionRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.arm.v1.MoveToPositionRequest;
+  return proto.viam.component.arm.v1.MoveToPositionRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.arm.v1.MoveToPositionRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.arm.v1.MoveToPositionRequest}
+ */
+proto.viam.component.arm.v1.MoveToPositionRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = new common_v1_common_pb.Pose;
+      reader.readMessage(value,common_v1_common_pb.Pose.deserializeBinaryFromReader);
+      msg.setTo(value);
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.arm.v1.MoveToPositionRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.arm.v1.MoveToPositionRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.arm.v1.MoveToPositionRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.arm.v1.MoveToPositionRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getTo();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      common_v1_common_pb.Pose.serializeBinaryToWriter
+    );
+  }
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.viam.component.arm.v1.MoveToPositionRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.arm.v1.MoveToPositionRequest} returns this
+ */
+proto.viam.component.arm.v1.MoveToPositionRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional viam.common.v1.Pose to = 2;
+ * @return {?proto.viam.common.v1.Pose}
+ */
+proto.viam.component.arm.v1.MoveToPositionRequest.prototype.getTo = function() {
+  return /** @type{?proto.viam.common.v1.Pose} */ (
+    jspb.Message.getWrapperField(this, common_v1_common_pb.Pose, 2));
+};
+
+
+/**
+ * @param {?proto.viam.common.v1.Pose|undefined} value
+ * @return {!proto.viam.component.arm.v1.MoveToPositionRequest} returns this
+*/
+proto.viam.component.arm.v1.MoveToPositionRequest.prototype.setTo = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.arm.v1.MoveToPositionRequest} returns this
+ */
+proto.viam.component.arm.v1.MoveToPositionRequest.prototype.clearTo = function() {
+  return this.setTo(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.arm.v1.MoveToPositionRequest.prototype.hasTo = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.component.arm.v1.MoveToPositionRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.component.arm.v1.MoveToPositionRequest} returns this
+*/
+proto.viam.component.arm.v1.MoveToPositionRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.arm.v1.MoveToPositionRequest} returns this
+ */
+proto.viam.component.arm.v1.MoveToPositionRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.arm.v1.MoveToPositionRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.arm.v1.MoveToPositionResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.arm.v1.MoveToPositionResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.arm.v1.MoveToPositionResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.arm.v1.MoveToPositionResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.arm.v1.MoveToPositionResponse}
+ */
+proto.viam.component.arm.v1.MoveToPositionResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.arm.v1.MoveToPositionResponse;
+  return proto.viam.component.arm.v1.MoveToPositionResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.arm.v1.MoveToPositionResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.arm.v1.MoveToPositionResponse}
+ */
+proto.viam.component.arm.v1.MoveToPositionResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.arm.v1.MoveToPositionResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.arm.v1.MoveToPositionResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.arm.v1.MoveToPositionResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.arm.v1.MoveToPositionResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.arm.v1.MoveToJointPositionsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.arm.v1.MoveToJointPositionsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.arm.v1.MoveToJointPositionsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.arm.v1.MoveToJointPositionsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    positions: (f = msg.getPositions()) && proto.viam.component.arm.v1.JointPositions.toObject(includeInstance, f),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.arm.v1.MoveToJointPositionsRequest}
+ */
+proto.viam.component.arm.v1.MoveToJointPositionsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.arm.v1.MoveToJointPositionsRequest;
+  return proto.viam.component.arm.v1.MoveToJointPositionsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.arm.v1.MoveToJointPositionsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.arm.v1.MoveToJointPositionsRequest}
+ */
+proto.viam.component.arm.v1.MoveToJointPositionsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = new proto.viam.component.arm.v1.JointPositions;
+      reader.readMessage(value,proto.viam.component.arm.v1.JointPositions.deserializeBinaryFromReader);
+      msg.setPositions(value);
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
       break;
     default:
       reader.skipField();
@@ -1584,7 +2410,361 @@ proto.viam.component.arm.v1.MoveToJointPositionsRequest.deserializeBinaryFromRea
  */
 proto.viam.component.arm.v1.MoveToJointPositionsRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.arm.v1.MoveToJointPositionsRequest.serializeBinaryToWriter(this, writer);
+  proto.viam.component.arm.v1.MoveToJointPositionsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.arm.v1.MoveToJointPositionsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.arm.v1.MoveToJointPositionsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPositions();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.viam.component.arm.v1.JointPositions.serializeBinaryToWriter
+    );
+  }
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.viam.component.arm.v1.MoveToJointPositionsRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.arm.v1.MoveToJointPositionsRequest} returns this
+ */
+proto.viam.component.arm.v1.MoveToJointPositionsRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional JointPositions positions = 2;
+ * @return {?proto.viam.component.arm.v1.JointPositions}
+ */
+proto.viam.component.arm.v1.MoveToJointPositionsRequest.prototype.getPositions = function() {
+  return /** @type{?proto.viam.component.arm.v1.JointPositions} */ (
+    jspb.Message.getWrapperField(this, proto.viam.component.arm.v1.JointPositions, 2));
+};
+
+
+/**
+ * @param {?proto.viam.component.arm.v1.JointPositions|undefined} value
+ * @return {!proto.viam.component.arm.v1.MoveToJointPositionsRequest} returns this
+*/
+proto.viam.component.arm.v1.MoveToJointPositionsRequest.prototype.setPositions = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.arm.v1.MoveToJointPositionsRequest} returns this
+ */
+proto.viam.component.arm.v1.MoveToJointPositionsRequest.prototype.clearPositions = function() {
+  return this.setPositions(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.arm.v1.MoveToJointPositionsRequest.prototype.hasPositions = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.component.arm.v1.MoveToJointPositionsRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.component.arm.v1.MoveToJointPositionsRequest} returns this
+*/
+proto.viam.component.arm.v1.MoveToJointPositionsRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.arm.v1.MoveToJointPositionsRequest} returns this
+ */
+proto.viam.component.arm.v1.MoveToJointPositionsRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.arm.v1.MoveToJointPositionsRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.arm.v1.MoveToJointPositionsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.arm.v1.MoveToJointPositionsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.arm.v1.MoveToJointPositionsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.arm.v1.MoveToJointPositionsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.arm.v1.MoveToJointPositionsResponse}
+ */
+proto.viam.component.arm.v1.MoveToJointPositionsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.arm.v1.MoveToJointPositionsResponse;
+  return proto.viam.component.arm.v1.MoveToJointPositionsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.arm.v1.MoveToJointPositionsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.arm.v1.MoveToJointPositionsResponse}
+ */
+proto.viam.component.arm.v1.MoveToJointPositionsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.arm.v1.MoveToJointPositionsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.arm.v1.MoveToJointPositionsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.arm.v1.MoveToJointPositionsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.arm.v1.MoveToJointPositionsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.component.arm.v1.MoveThroughJointPositionsRequest.repeatedFields_ = [2,4];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.arm.v1.MoveThroughJointPositionsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.arm.v1.MoveThroughJointPositionsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.arm.v1.MoveThroughJointPositionsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.arm.v1.MoveThroughJointPositionsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    positionsList: jspb.Message.toObjectList(msg.getPositionsList(),
+    proto.viam.component.arm.v1.JointPositions.toObject, includeInstance),
+    options: (f = msg.getOptions()) && proto.viam.component.arm.v1.MoveOptions.toObject(includeInstance, f),
+    waypointOptionsList: jspb.Message.toObjectList(msg.getWaypointOptionsList(),
+    proto.viam.component.arm.v1.MoveOptions.toObject, includeInstance),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.arm.v1.MoveThroughJointPositionsRequest}
+ */
+proto.viam.component.arm.v1.MoveThroughJointPositionsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.arm.v1.MoveThroughJointPositionsRequest;
+  return proto.viam.component.arm.v1.MoveThroughJointPositionsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.arm.v1.MoveThroughJointPositionsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.arm.v1.MoveThroughJointPositionsRequest}
+ */
+proto.viam.component.arm.v1.MoveThroughJointPositionsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = new proto.viam.component.arm.v1.JointPositions;
+      reader.readMessage(value,proto.viam.component.arm.v1.JointPositions.deserializeBinaryFromReader);
+      msg.addPositions(value);
+      break;
+    case 3:
+      var value = new proto.viam.component.arm.v1.MoveOptions;
+      reader.readMessage(value,proto.viam.component.arm.v1.MoveOptions.deserializeBinaryFromReader);
+      msg.setOptions(value);
+      break;
+    case 4:
+      var value = new proto.viam.component.arm.v1.MoveOptions;
+      reader.readMessage(value,proto.viam.component.arm.v1.MoveOptions.deserializeBinaryFromReader);
+      msg.addWaypointOptions(value);
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.arm.v1.MoveThroughJointPositionsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.arm.v1.MoveThroughJointPositionsRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1592,11 +2772,11 @@ proto.viam.component.arm.v1.MoveToJointPositionsRequest.prototype.serializeBinar
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.arm.v1.MoveToJointPositionsRequest} message
+ * @param {!proto.viam.component.arm.v1.MoveThroughJointPositionsRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.arm.v1.MoveToJointPositionsRequest.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.arm.v1.MoveThroughJointPositionsRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getName();
   if (f.length > 0) {
@@ -1605,14 +2785,30 @@ proto.viam.component.arm.v1.MoveToJointPositionsRequest.serializeBinaryToWriter
       f
     );
   }
-  f = message.getPositions();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getPositionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       2,
       f,
       proto.viam.component.arm.v1.JointPositions.serializeBinaryToWriter
     );
   }
+  f = message.getOptions();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.viam.component.arm.v1.MoveOptions.serializeBinaryToWriter
+    );
+  }
+  f = message.getWaypointOptionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.viam.component.arm.v1.MoveOptions.serializeBinaryToWriter
+    );
+  }
   f = message.getExtra();
   if (f != null) {
     writer.writeMessage(
@@ -1628,45 +2824,83 @@ proto.viam.component.arm.v1.MoveToJointPositionsRequest.serializeBinaryToWriter
  * optional string name = 1;
  * @return {string}
  */
-proto.viam.component.arm.v1.MoveToJointPositionsRequest.prototype.getName = function() {
+proto.viam.component.arm.v1.MoveThroughJointPositionsRequest.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.viam.component.arm.v1.MoveToJointPositionsRequest} returns this
+ * @return {!proto.viam.component.arm.v1.MoveThroughJointPositionsRequest} returns this
  */
-proto.viam.component.arm.v1.MoveToJointPositionsRequest.prototype.setName = function(value) {
+proto.viam.component.arm.v1.MoveThroughJointPositionsRequest.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional JointPositions positions = 2;
- * @return {?proto.viam.component.arm.v1.JointPositions}
+ * repeated JointPositions positions = 2;
+ * @return {!Array<!proto.viam.component.arm.v1.JointPositions>}
  */
-proto.viam.component.arm.v1.MoveToJointPositionsRequest.prototype.getPositions = function() {
-  return /** @type{?proto.viam.component.arm.v1.JointPositions} */ (
-    jspb.Message.getWrapperField(this, proto.viam.component.arm.v1.JointPositions, 2));
+proto.viam.component.arm.v1.MoveThroughJointPositionsRequest.prototype.getPositionsList = function() {
+  return /** @type{!Array<!proto.viam.component.arm.v1.JointPositions>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.viam.component.arm.v1.JointPositions, 2));
 };
 
 
 /**
- * @param {?proto.viam.component.arm.v1.JointPositions|undefined} value
- * @return {!proto.viam.component.arm.v1.MoveToJointPositionsRequest} returns this
+ * @param {!Array<!proto.viam.component.arm.v1.JointPositions>} value
+ * @return {!proto.viam.component.arm.v1.MoveThroughJointPositionsRequest} returns this
 */
-proto.viam.component.arm.v1.MoveToJointPositionsRequest.prototype.setPositions = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+proto.viam.component.arm.v1.MoveThroughJointPositionsRequest.prototype.setPositionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.viam.component.arm.v1.JointPositions=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.component.arm.v1.JointPositions}
+ */
+proto.viam.component.arm.v1.MoveThroughJointPositionsRequest.prototype.addPositions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.viam.component.arm.v1.JointPositions, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.component.arm.v1.MoveThroughJointPositionsRequest} returns this
+ */
+proto.viam.component.arm.v1.MoveThroughJointPositionsRequest.prototype.clearPositionsList = function() {
+  return this.setPositionsList([]);
+};
+
+
+/**
+ * optional MoveOptions options = 3;
+ * @return {?proto.viam.component.arm.v1.MoveOptions}
+ */
+proto.viam.component.arm.v1.MoveThroughJointPositionsRequest.prototype.getOptions = function() {
+  return /** @type{?proto.viam.component.arm.v1.MoveOptions} */ (
+    jspb.Message.getWrapperField(this, proto.viam.component.arm.v1.MoveOptions, 3));
+};
+
+
+/**
+ * @param {?proto.viam.component.arm.v1.MoveOptions|undefined} value
+ * @return {!proto.viam.component.arm.v1.MoveThroughJointPositionsRequest} returns this
+*/
+proto.viam.component.arm.v1.MoveThroughJointPositionsRequest.prototype.setOptions = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.viam.component.arm.v1.MoveToJointPositionsRequest} returns this
+ * @return {!proto.viam.component.arm.v1.MoveThroughJointPositionsRequest} returns this
  */
-proto.viam.component.arm.v1.MoveToJointPositionsRequest.prototype.clearPositions = function() {
-  return this.setPositions(undefined);
+proto.viam.component.arm.v1.MoveThroughJointPositionsRequest.prototype.clearOptions = function() {
+  return this.setOptions(undefined);
 };
 
 
@@ -1674,8 +2908,46 @@ proto.viam.component.arm.v1.MoveToJointPositionsRequest.prototype.clearPositions
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.component.arm.v1.MoveToJointPositionsRequest.prototype.hasPositions = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.viam.component.arm.v1.MoveThroughJointPositionsRequest.prototype.hasOptions = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * repeated MoveOptions waypoint_options = 4;
+ * @return {!Array<!proto.viam.component.arm.v1.MoveOptions>}
+ */
+proto.viam.component.arm.v1.MoveThroughJointPositionsRequest.prototype.getWaypointOptionsList = function() {
+  return /** @type{!Array<!proto.viam.component.arm.v1.MoveOptions>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.viam.component.arm.v1.MoveOptions, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.viam.component.arm.v1.MoveOptions>} value
+ * @return {!proto.viam.component.arm.v1.MoveThroughJointPositionsRequest} returns this
+*/
+proto.viam.component.arm.v1.MoveThroughJointPositionsRequest.prototype.setWaypointOptionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.viam.component.arm.v1.MoveOptions=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.component.arm.v1.MoveOptions}
+ */
+proto.viam.component.arm.v1.MoveThroughJointPositionsRequest.prototype.addWaypointOptions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.viam.component.arm.v1.MoveOptions, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.component.arm.v1.MoveThroughJointPositionsRequest} returns this
+ */
+proto.viam.component.arm.v1.MoveThroughJointPositionsRequest.prototype.clearWaypointOptionsList = function() {
+  return this.setWaypointOptionsList([]);
 };
 
 
@@ -1683,7 +2955,7 @@ proto.viam.component.arm.v1.MoveToJointPositionsRequest.prototype.hasPositions =
  * optional google.protobuf.Struct extra = 99;
  * @return {?proto.google.protobuf.Struct}
  */
-proto.viam.component.arm.v1.MoveToJointPositionsRequest.prototype.getExtra = function() {
+proto.viam.component.arm.v1.MoveThroughJointPositionsRequest.prototype.getExtra = function() {
   return /** @type{?proto.google.protobuf.Struct} */ (
     jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
 };
@@ -1691,18 +2963,18 @@ proto.viam.component.arm.v1.MoveToJointPositionsRequest.prototype.getExtra = fun
 
 /**
  * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.viam.component.arm.v1.MoveToJointPositionsRequest} returns this
+ * @return {!proto.viam.component.arm.v1.MoveThroughJointPositionsRequest} returns this
 */
-proto.viam.component.arm.v1.MoveToJointPositionsRequest.prototype.setExtra = function(value) {
+proto.viam.component.arm.v1.MoveThroughJointPositionsRequest.prototype.setExtra = function(value) {
   return jspb.Message.setWrapperField(this, 99, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.viam.component.arm.v1.MoveToJointPositionsRequest} returns this
+ * @return {!proto.viam.component.arm.v1.MoveThroughJointPositionsRequest} returns this
  */
-proto.viam.component.arm.v1.MoveToJointPositionsRequest.prototype.clearExtra = function() {
+proto.viam.component.arm.v1.MoveThroughJointPositionsRequest.prototype.clearExtra = function() {
   return this.setExtra(undefined);
 };
 
@@ -1711,7 +2983,7 @@ proto.viam.component.arm.v1.MoveToJointPositionsRequest.prototype.clearExtra = f
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.component.arm.v1.MoveToJointPositionsRequest.prototype.hasExtra = function() {
+proto.viam.component.arm.v1.MoveThroughJointPositionsRequest.prototype.hasExtra = function() {
   return jspb.Message.getField(this, 99) != null;
 };
 
@@ -1732,8 +3004,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.arm.v1.MoveToJointPositionsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.arm.v1.MoveToJointPositionsResponse.toObject(opt_includeInstance, this);
+proto.viam.component.arm.v1.MoveThroughJointPositionsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.arm.v1.MoveThroughJointPositionsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -1742,11 +3014,11 @@ proto.viam.component.arm.v1.MoveToJointPositionsResponse.prototype.toObject = fu
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.arm.v1.MoveToJointPositionsResponse} msg The msg instance to transform.
+ * @param {!proto.viam.component.arm.v1.MoveThroughJointPositionsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.arm.v1.MoveToJointPositionsResponse.toObject = function(includeInstance, msg) {
+proto.viam.component.arm.v1.MoveThroughJointPositionsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
 
   };
@@ -1762,23 +3034,23 @@ proto.viam.component.arm.v1.MoveToJointPositionsResponse.toObject = function(inc
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.arm.v1.MoveToJointPositionsResponse}
+ * @return {!proto.viam.component.arm.v1.MoveThroughJointPositionsResponse}
  */
-proto.viam.component.arm.v1.MoveToJointPositionsResponse.deserializeBinary = function(bytes) {
+proto.viam.component.arm.v1.MoveThroughJointPositionsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.arm.v1.MoveToJointPositionsResponse;
-  return proto.viam.component.arm.v1.MoveToJointPositionsResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.arm.v1.MoveThroughJointPositionsResponse;
+  return proto.viam.component.arm.v1.MoveThroughJointPositionsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.arm.v1.MoveToJointPositionsResponse} msg The message object to deserialize into.
+ * @param {!proto.viam.component.arm.v1.MoveThroughJointPositionsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.arm.v1.MoveToJointPositionsResponse}
+ * @return {!proto.viam.component.arm.v1.MoveThroughJointPositionsResponse}
  */
-proto.viam.component.arm.v1.MoveToJointPositionsResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.arm.v1.MoveThroughJointPositionsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1798,9 +3070,9 @@ proto.viam.component.arm.v1.MoveToJointPositionsResponse.deserializeBinaryFromRe
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.arm.v1.MoveToJointPositionsResponse.prototype.serializeBinary = function() {
+proto.viam.component.arm.v1.MoveThroughJointPositionsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.arm.v1.MoveToJointPositionsResponse.serializeBinaryToWriter(this, writer);
+  proto.viam.component.arm.v1.MoveThroughJointPositionsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1808,12 +3080,208 @@ proto.viam.component.arm.v1.MoveToJointPositionsResponse.prototype.serializeBina
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.arm.v1.MoveToJointPositionsResponse} message
+ * @param {!proto.viam.component.arm.v1.MoveThroughJointPositionsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.arm.v1.MoveToJointPositionsResponse.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.arm.v1.MoveThroughJointPositionsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.arm.v1.MoveOptions.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.arm.v1.MoveOptions.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.arm.v1.MoveOptions} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.arm.v1.MoveOptions.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    maxVelDegsPerSec: jspb.Message.getFloatingPointFieldWithDefault(msg, 1, 0.0),
+    maxAccDegsPerSec2: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.arm.v1.MoveOptions}
+ */
+proto.viam.component.arm.v1.MoveOptions.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.arm.v1.MoveOptions;
+  return proto.viam.component.arm.v1.MoveOptions.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.arm.v1.MoveOptions} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.arm.v1.MoveOptions}
+ */
+proto.viam.component.arm.v1.MoveOptions.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setMaxVelDegsPerSec(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setMaxAccDegsPerSec2(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.arm.v1.MoveOptions.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.arm.v1.MoveOptions.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.arm.v1.MoveOptions} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.arm.v1.MoveOptions.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = /** @type {number} */ (jspb.Message.getField(message, 1));
+  if (f != null) {
+    writer.writeDouble(
+      1,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeDouble(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional double max_vel_degs_per_sec = 1;
+ * @return {number}
+ */
+proto.viam.component.arm.v1.MoveOptions.prototype.getMaxVelDegsPerSec = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 1, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.arm.v1.MoveOptions} returns this
+ */
+proto.viam.component.arm.v1.MoveOptions.prototype.setMaxVelDegsPerSec = function(value) {
+  return jspb.Message.setField(this, 1, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.viam.component.arm.v1.MoveOptions} returns this
+ */
+proto.viam.component.arm.v1.MoveOptions.prototype.clearMaxVelDegsPerSec = function() {
+  return jspb.Message.setField(this, 1, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.arm.v1.MoveOptions.prototype.hasMaxVelDegsPerSec = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional double max_acc_degs_per_sec2 = 2;
+ * @return {number}
+ */
+proto.viam.component.arm.v1.MoveOptions.prototype.getMaxAccDegsPerSec2 = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.arm.v1.MoveOptions} returns this
+ */
+proto.viam.component.arm.v1.MoveOptions.prototype.setMaxAccDegsPerSec2 = function(value) {
+  return jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.viam.component.arm.v1.MoveOptions} returns this
+ */
+proto.viam.component.arm.v1.MoveOptions.prototype.clearMaxAccDegsPerSec2 = function() {
+  return jspb.Message.setField(this, 2, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.arm.v1.MoveOptions.prototype.hasMaxAccDegsPerSec2 = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
@@ -2591,4 +4059,14 @@ proto.viam.component.arm.v1.IsMovingResponse.prototype.setIsMoving = function(va
 };
 
 
+/**
+ * @enum {number}
+ */
+proto.viam.component.arm.v1.JointType = {
+  JOINT_TYPE_UNSPECIFIED: 0,
+  JOINT_TYPE_REVOLUTE: 1,
+  JOINT_TYPE_PRISMATIC: 2,
+  JOINT_TYPE_CONTINUOUS: 3
+};
+
 goog.object.extend(exports, proto.viam.component.arm.v1);
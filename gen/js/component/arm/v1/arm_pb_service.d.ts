@@ -32,6 +32,15 @@ type ArmServiceGetJointPositions = {
   readonly responseType: typeof component_arm_v1_arm_pb.GetJointPositionsResponse;
 };
 
+type ArmServiceGetJointProperties = {
+  readonly methodName: string;
+  readonly service: typeof ArmService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof component_arm_v1_arm_pb.GetJointPropertiesRequest;
+  readonly responseType: typeof component_arm_v1_arm_pb.GetJointPropertiesResponse;
+};
+
 type ArmServiceMoveToJointPositions = {
   readonly methodName: string;
   readonly service: typeof ArmService;
@@ -41,6 +50,15 @@ type ArmServiceMoveToJointPositions = {
   readonly responseType: typeof component_arm_v1_arm_pb.MoveToJointPositionsResponse;
 };
 
+type ArmServiceMoveThroughJointPositions = {
+  readonly methodName: string;
+  readonly service: typeof ArmService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof component_arm_v1_arm_pb.MoveThroughJointPositionsRequest;
+  readonly responseType: typeof component_arm_v1_arm_pb.MoveThroughJointPositionsResponse;
+};
+
 type ArmServiceStop = {
   readonly methodName: string;
   readonly service: typeof ArmService;
@@ -91,7 +109,9 @@ export class ArmService {
   static readonly GetEndPosition: ArmServiceGetEndPosition;
   static readonly MoveToPosition: ArmServiceMoveToPosition;
   static readonly GetJointPositions: ArmServiceGetJointPositions;
+  static readonly GetJointProperties: ArmServiceGetJointProperties;
   static readonly MoveToJointPositions: ArmServiceMoveToJointPositions;
+  static readonly MoveThroughJointPositions: ArmServiceMoveThroughJointPositions;
   static readonly Stop: ArmServiceStop;
   static readonly IsMoving: ArmServiceIsMoving;
   static readonly DoCommand: ArmServiceDoCommand;
@@ -158,6 +178,15 @@ export class ArmServiceClient {
     requestMessage: component_arm_v1_arm_pb.GetJointPositionsRequest,
     callback: (error: ServiceError|null, responseMessage: component_arm_v1_arm_pb.GetJointPositionsResponse|null) => void
   ): UnaryResponse;
+  getJointProperties(
+    requestMessage: component_arm_v1_arm_pb.GetJointPropertiesRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: component_arm_v1_arm_pb.GetJointPropertiesResponse|null) => void
+  ): UnaryResponse;
+  getJointProperties(
+    requestMessage: component_arm_v1_arm_pb.GetJointPropertiesRequest,
+    callback: (error: ServiceError|null, responseMessage: component_arm_v1_arm_pb.GetJointPropertiesResponse|null) => void
+  ): UnaryResponse;
   moveToJointPositions(
     requestMessage: component_arm_v1_arm_pb.MoveToJointPositionsRequest,
     metadata: grpc.Metadata,
@@ -167,6 +196,15 @@ export class ArmServiceClient {
     requestMessage: component_arm_v1_arm_pb.MoveToJointPositionsRequest,
     callback: (error: ServiceError|null, responseMessage: component_arm_v1_arm_pb.MoveToJointPositionsResponse|null) => void
   ): UnaryResponse;
+  moveThroughJointPositions(
+    requestMessage: component_arm_v1_arm_pb.MoveThroughJointPositionsRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: component_arm_v1_arm_pb.MoveThroughJointPositionsResponse|null) => void
+  ): UnaryResponse;
+  moveThroughJointPositions(
+    requestMessage: component_arm_v1_arm_pb.MoveThroughJointPositionsRequest,
+    callback: (error: ServiceError|null, responseMessage: component_arm_v1_arm_pb.MoveThroughJointPositionsResponse|null) => void
+  ): UnaryResponse;
   stop(
     requestMessage: component_arm_v1_arm_pb.StopRequest,
     metadata: grpc.Metadata,
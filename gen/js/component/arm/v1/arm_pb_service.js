@@ -38,6 +38,15 @@ ArmService.GetJointPositions = {
   responseType: component_arm_v1_arm_pb.GetJointPositionsResponse
 };
 
+ArmService.GetJointProperties = {
+  methodName: "GetJointProperties",
+  service: ArmService,
+  requestStream: false,
+  responseStream: false,
+  requestType: component_arm_v1_arm_pb.GetJointPropertiesRequest,
+  responseType: component_arm_v1_arm_pb.GetJointPropertiesResponse
+};
+
 ArmService.MoveToJointPositions = {
   methodName: "MoveToJointPositions",
   service: ArmService,
@@ -47,6 +56,15 @@ ArmService.MoveToJointPositions = {
   responseType: component_arm_v1_arm_pb.MoveToJointPositionsResponse
 };
 
+ArmService.MoveThroughJointPositions = {
+  methodName: "MoveThroughJointPositions",
+  service: ArmService,
+  requestStream: false,
+  responseStream: false,
+  requestType: component_arm_v1_arm_pb.MoveThroughJointPositionsRequest,
+  responseType: component_arm_v1_arm_pb.MoveThroughJointPositionsResponse
+};
+
 ArmService.Stop = {
   methodName: "Stop",
   service: ArmService,
@@ -192,6 +210,37 @@ ArmServiceClient.prototype.getJointPositions = function getJointPositions(reques
   };
 };
 
+ArmServiceClient.prototype.getJointProperties = function getJointProperties(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(ArmService.GetJointProperties, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
 ArmServiceClient.prototype.moveToJointPositions = function moveToJointPositions(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
@@ -223,6 +272,37 @@ ArmServiceClient.prototype.moveToJointPositions = function moveToJointPositions(
   };
 };
 
+ArmServiceClient.prototype.moveThroughJointPositions = function moveThroughJointPositions(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(ArmService.MoveThroughJointPositions, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
 ArmServiceClient.prototype.stop = function stop(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
@@ -25,6 +25,8 @@ var common_v1_common_pb = require('../../../common/v1/common_pb.js')
 var google_api_annotations_pb = require('../../../google/api/annotations_pb.js')
 
 var google_protobuf_struct_pb = require('google-protobuf/google/protobuf/struct_pb.js')
+
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js')
 const proto = {};
 proto.viam = {};
 proto.viam.component = {};
@@ -205,6 +207,128 @@ proto.viam.component.base.v1.BaseServicePromiseClient.prototype.spin =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.viam.component.base.v1.MoveArcRequest,
+ *   !proto.viam.component.base.v1.MoveArcResponse>}
+ */
+const methodDescriptor_BaseService_MoveArc = new grpc.web.MethodDescriptor(
+  '/viam.component.base.v1.BaseService/MoveArc',
+  grpc.web.MethodType.UNARY,
+  proto.viam.component.base.v1.MoveArcRequest,
+  proto.viam.component.base.v1.MoveArcResponse,
+  /**
+   * @param {!proto.viam.component.base.v1.MoveArcRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.viam.component.base.v1.MoveArcResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.viam.component.base.v1.MoveArcRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.viam.component.base.v1.MoveArcResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.viam.component.base.v1.MoveArcResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.viam.component.base.v1.BaseServiceClient.prototype.moveArc =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/viam.component.base.v1.BaseService/MoveArc',
+      request,
+      metadata || {},
+      methodDescriptor_BaseService_MoveArc,
+      callback);
+};
+
+
+/**
+ * @param {!proto.viam.component.base.v1.MoveArcRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.viam.component.base.v1.MoveArcResponse>}
+ *     Promise that resolves to the response
+ */
+proto.viam.component.base.v1.BaseServicePromiseClient.prototype.moveArc =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/viam.component.base.v1.BaseService/MoveArc',
+      request,
+      metadata || {},
+      methodDescriptor_BaseService_MoveArc);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.viam.component.base.v1.FollowPathRequest,
+ *   !proto.viam.component.base.v1.FollowPathResponse>}
+ */
+const methodDescriptor_BaseService_FollowPath = new grpc.web.MethodDescriptor(
+  '/viam.component.base.v1.BaseService/FollowPath',
+  grpc.web.MethodType.UNARY,
+  proto.viam.component.base.v1.FollowPathRequest,
+  proto.viam.component.base.v1.FollowPathResponse,
+  /**
+   * @param {!proto.viam.component.base.v1.FollowPathRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.viam.component.base.v1.FollowPathResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.viam.component.base.v1.FollowPathRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.viam.component.base.v1.FollowPathResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.viam.component.base.v1.FollowPathResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.viam.component.base.v1.BaseServiceClient.prototype.followPath =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/viam.component.base.v1.BaseService/FollowPath',
+      request,
+      metadata || {},
+      methodDescriptor_BaseService_FollowPath,
+      callback);
+};
+
+
+/**
+ * @param {!proto.viam.component.base.v1.FollowPathRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.viam.component.base.v1.FollowPathResponse>}
+ *     Promise that resolves to the response
+ */
+proto.viam.component.base.v1.BaseServicePromiseClient.prototype.followPath =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/viam.component.base.v1.BaseService/FollowPath',
+      request,
+      metadata || {},
+      methodDescriptor_BaseService_FollowPath);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -327,6 +451,128 @@ proto.viam.component.base.v1.BaseServicePromiseClient.prototype.setVelocity =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.viam.component.base.v1.GetOdometryRequest,
+ *   !proto.viam.component.base.v1.GetOdometryResponse>}
+ */
+const methodDescriptor_BaseService_GetOdometry = new grpc.web.MethodDescriptor(
+  '/viam.component.base.v1.BaseService/GetOdometry',
+  grpc.web.MethodType.UNARY,
+  proto.viam.component.base.v1.GetOdometryRequest,
+  proto.viam.component.base.v1.GetOdometryResponse,
+  /**
+   * @param {!proto.viam.component.base.v1.GetOdometryRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.viam.component.base.v1.GetOdometryResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.viam.component.base.v1.GetOdometryRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.viam.component.base.v1.GetOdometryResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.viam.component.base.v1.GetOdometryResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.viam.component.base.v1.BaseServiceClient.prototype.getOdometry =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/viam.component.base.v1.BaseService/GetOdometry',
+      request,
+      metadata || {},
+      methodDescriptor_BaseService_GetOdometry,
+      callback);
+};
+
+
+/**
+ * @param {!proto.viam.component.base.v1.GetOdometryRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.viam.component.base.v1.GetOdometryResponse>}
+ *     Promise that resolves to the response
+ */
+proto.viam.component.base.v1.BaseServicePromiseClient.prototype.getOdometry =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/viam.component.base.v1.BaseService/GetOdometry',
+      request,
+      metadata || {},
+      methodDescriptor_BaseService_GetOdometry);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.viam.component.base.v1.ResetOdometryRequest,
+ *   !proto.viam.component.base.v1.ResetOdometryResponse>}
+ */
+const methodDescriptor_BaseService_ResetOdometry = new grpc.web.MethodDescriptor(
+  '/viam.component.base.v1.BaseService/ResetOdometry',
+  grpc.web.MethodType.UNARY,
+  proto.viam.component.base.v1.ResetOdometryRequest,
+  proto.viam.component.base.v1.ResetOdometryResponse,
+  /**
+   * @param {!proto.viam.component.base.v1.ResetOdometryRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.viam.component.base.v1.ResetOdometryResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.viam.component.base.v1.ResetOdometryRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.viam.component.base.v1.ResetOdometryResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.viam.component.base.v1.ResetOdometryResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.viam.component.base.v1.BaseServiceClient.prototype.resetOdometry =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/viam.component.base.v1.BaseService/ResetOdometry',
+      request,
+      metadata || {},
+      methodDescriptor_BaseService_ResetOdometry,
+      callback);
+};
+
+
+/**
+ * @param {!proto.viam.component.base.v1.ResetOdometryRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.viam.component.base.v1.ResetOdometryResponse>}
+ *     Promise that resolves to the response
+ */
+proto.viam.component.base.v1.BaseServicePromiseClient.prototype.resetOdometry =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/viam.component.base.v1.BaseService/ResetOdometry',
+      request,
+      metadata || {},
+      methodDescriptor_BaseService_ResetOdometry);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
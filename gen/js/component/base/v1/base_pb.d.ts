@@ -5,6 +5,7 @@ import * as jspb from "google-protobuf";
 import * as common_v1_common_pb from "../../../common/v1/common_pb";
 import * as google_api_annotations_pb from "../../../google/api/annotations_pb";
 import * as google_protobuf_struct_pb from "google-protobuf/google/protobuf/struct_pb";
+import * as google_protobuf_timestamp_pb from "google-protobuf/google/protobuf/timestamp_pb";
 
 export class MoveStraightRequest extends jspb.Message {
   getName(): string;
@@ -106,6 +107,169 @@ export namespace SpinResponse {
   }
 }
 
+export class MoveArcRequest extends jspb.Message {
+  getName(): string;
+  setName(value: string): void;
+
+  getRadiusMm(): number;
+  setRadiusMm(value: number): void;
+
+  hasArcLengthMm(): boolean;
+  clearArcLengthMm(): void;
+  getArcLengthMm(): number;
+  setArcLengthMm(value: number): void;
+
+  hasAngleDeg(): boolean;
+  clearAngleDeg(): void;
+  getAngleDeg(): number;
+  setAngleDeg(value: number): void;
+
+  getMmPerSec(): number;
+  setMmPerSec(value: number): void;
+
+  hasExtra(): boolean;
+  clearExtra(): void;
+  getExtra(): google_protobuf_struct_pb.Struct | undefined;
+  setExtra(value?: google_protobuf_struct_pb.Struct): void;
+
+  getExtentCase(): MoveArcRequest.ExtentCase;
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): MoveArcRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: MoveArcRequest): MoveArcRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: MoveArcRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): MoveArcRequest;
+  static deserializeBinaryFromReader(message: MoveArcRequest, reader: jspb.BinaryReader): MoveArcRequest;
+}
+
+export namespace MoveArcRequest {
+  export type AsObject = {
+    name: string,
+    radiusMm: number,
+    arcLengthMm: number,
+    angleDeg: number,
+    mmPerSec: number,
+    extra?: google_protobuf_struct_pb.Struct.AsObject,
+  }
+
+  export enum ExtentCase {
+    EXTENT_NOT_SET = 0,
+    ARC_LENGTH_MM = 3,
+    ANGLE_DEG = 4,
+  }
+}
+
+export class MoveArcResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): MoveArcResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: MoveArcResponse): MoveArcResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: MoveArcResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): MoveArcResponse;
+  static deserializeBinaryFromReader(message: MoveArcResponse, reader: jspb.BinaryReader): MoveArcResponse;
+}
+
+export namespace MoveArcResponse {
+  export type AsObject = {
+  }
+}
+
+export class PathPoint extends jspb.Message {
+  getXMm(): number;
+  setXMm(value: number): void;
+
+  getYMm(): number;
+  setYMm(value: number): void;
+
+  hasThetaDeg(): boolean;
+  clearThetaDeg(): void;
+  getThetaDeg(): number;
+  setThetaDeg(value: number): void;
+
+  hasMmPerSec(): boolean;
+  clearMmPerSec(): void;
+  getMmPerSec(): number;
+  setMmPerSec(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PathPoint.AsObject;
+  static toObject(includeInstance: boolean, msg: PathPoint): PathPoint.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: PathPoint, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PathPoint;
+  static deserializeBinaryFromReader(message: PathPoint, reader: jspb.BinaryReader): PathPoint;
+}
+
+export namespace PathPoint {
+  export type AsObject = {
+    xMm: number,
+    yMm: number,
+    thetaDeg: number,
+    mmPerSec: number,
+  }
+}
+
+export class FollowPathRequest extends jspb.Message {
+  getName(): string;
+  setName(value: string): void;
+
+  clearPathList(): void;
+  getPathList(): Array<PathPoint>;
+  setPathList(value: Array<PathPoint>): void;
+  addPath(value?: PathPoint, index?: number): PathPoint;
+
+  getMmPerSec(): number;
+  setMmPerSec(value: number): void;
+
+  hasMaxAccMmPerSec2(): boolean;
+  clearMaxAccMmPerSec2(): void;
+  getMaxAccMmPerSec2(): number;
+  setMaxAccMmPerSec2(value: number): void;
+
+  hasExtra(): boolean;
+  clearExtra(): void;
+  getExtra(): google_protobuf_struct_pb.Struct | undefined;
+  setExtra(value?: google_protobuf_struct_pb.Struct): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): FollowPathRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: FollowPathRequest): FollowPathRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: FollowPathRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): FollowPathRequest;
+  static deserializeBinaryFromReader(message: FollowPathRequest, reader: jspb.BinaryReader): FollowPathRequest;
+}
+
+export namespace FollowPathRequest {
+  export type AsObject = {
+    name: string,
+    pathList: Array<PathPoint.AsObject>,
+    mmPerSec: number,
+    maxAccMmPerSec2: number,
+    extra?: google_protobuf_struct_pb.Struct.AsObject,
+  }
+}
+
+export class FollowPathResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): FollowPathResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: FollowPathResponse): FollowPathResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: FollowPathResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): FollowPathResponse;
+  static deserializeBinaryFromReader(message: FollowPathResponse, reader: jspb.BinaryReader): FollowPathResponse;
+}
+
+export namespace FollowPathResponse {
+  export type AsObject = {
+  }
+}
+
 export class StopRequest extends jspb.Message {
   getName(): string;
   setName(value: string): void;
@@ -256,6 +420,120 @@ export namespace SetVelocityResponse {
   }
 }
 
+export class GetOdometryRequest extends jspb.Message {
+  getName(): string;
+  setName(value: string): void;
+
+  hasExtra(): boolean;
+  clearExtra(): void;
+  getExtra(): google_protobuf_struct_pb.Struct | undefined;
+  setExtra(value?: google_protobuf_struct_pb.Struct): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetOdometryRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: GetOdometryRequest): GetOdometryRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetOdometryRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetOdometryRequest;
+  static deserializeBinaryFromReader(message: GetOdometryRequest, reader: jspb.BinaryReader): GetOdometryRequest;
+}
+
+export namespace GetOdometryRequest {
+  export type AsObject = {
+    name: string,
+    extra?: google_protobuf_struct_pb.Struct.AsObject,
+  }
+}
+
+export class GetOdometryResponse extends jspb.Message {
+  hasPose(): boolean;
+  clearPose(): void;
+  getPose(): common_v1_common_pb.Pose | undefined;
+  setPose(value?: common_v1_common_pb.Pose): void;
+
+  hasLinearVelocity(): boolean;
+  clearLinearVelocity(): void;
+  getLinearVelocity(): common_v1_common_pb.Vector3 | undefined;
+  setLinearVelocity(value?: common_v1_common_pb.Vector3): void;
+
+  hasAngularVelocity(): boolean;
+  clearAngularVelocity(): void;
+  getAngularVelocity(): common_v1_common_pb.Vector3 | undefined;
+  setAngularVelocity(value?: common_v1_common_pb.Vector3): void;
+
+  hasMeasuredAt(): boolean;
+  clearMeasuredAt(): void;
+  getMeasuredAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setMeasuredAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  hasResetAt(): boolean;
+  clearResetAt(): void;
+  getResetAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setResetAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetOdometryResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetOdometryResponse): GetOdometryResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetOdometryResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetOdometryResponse;
+  static deserializeBinaryFromReader(message: GetOdometryResponse, reader: jspb.BinaryReader): GetOdometryResponse;
+}
+
+export namespace GetOdometryResponse {
+  export type AsObject = {
+    pose?: common_v1_common_pb.Pose.AsObject,
+    linearVelocity?: common_v1_common_pb.Vector3.AsObject,
+    angularVelocity?: common_v1_common_pb.Vector3.AsObject,
+    measuredAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    resetAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+  }
+}
+
+export class ResetOdometryRequest extends jspb.Message {
+  getName(): string;
+  setName(value: string): void;
+
+  hasExtra(): boolean;
+  clearExtra(): void;
+  getExtra(): google_protobuf_struct_pb.Struct | undefined;
+  setExtra(value?: google_protobuf_struct_pb.Struct): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ResetOdometryRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ResetOdometryRequest): ResetOdometryRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ResetOdometryRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ResetOdometryRequest;
+  static deserializeBinaryFromReader(message: ResetOdometryRequest, reader: jspb.BinaryReader): ResetOdometryRequest;
+}
+
+export namespace ResetOdometryRequest {
+  export type AsObject = {
+    name: string,
+    extra?: google_protobuf_struct_pb.Struct.AsObject,
+  }
+}
+
+export class ResetOdometryResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ResetOdometryResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ResetOdometryResponse): ResetOdometryResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ResetOdometryResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ResetOdometryResponse;
+  static deserializeBinaryFromReader(message: ResetOdometryResponse, reader: jspb.BinaryReader): ResetOdometryResponse;
+}
+
+export namespace ResetOdometryResponse {
+  export type AsObject = {
+  }
+}
+
 export class IsMovingRequest extends jspb.Message {
   getName(): string;
   setName(value: string): void;
@@ -21,12 +21,24 @@ var google_api_annotations_pb = require('../../../google/api/annotations_pb.js')
 goog.object.extend(proto, google_api_annotations_pb);
 var google_protobuf_struct_pb = require('google-protobuf/google/protobuf/struct_pb.js');
 goog.object.extend(proto, google_protobuf_struct_pb);
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
+goog.object.extend(proto, google_protobuf_timestamp_pb);
+goog.exportSymbol('proto.viam.component.base.v1.FollowPathRequest', null, global);
+goog.exportSymbol('proto.viam.component.base.v1.FollowPathResponse', null, global);
+goog.exportSymbol('proto.viam.component.base.v1.GetOdometryRequest', null, global);
+goog.exportSymbol('proto.viam.component.base.v1.GetOdometryResponse', null, global);
 goog.exportSymbol('proto.viam.component.base.v1.GetPropertiesRequest', null, global);
 goog.exportSymbol('proto.viam.component.base.v1.GetPropertiesResponse', null, global);
 goog.exportSymbol('proto.viam.component.base.v1.IsMovingRequest', null, global);
 goog.exportSymbol('proto.viam.component.base.v1.IsMovingResponse', null, global);
+goog.exportSymbol('proto.viam.component.base.v1.MoveArcRequest', null, global);
+goog.exportSymbol('proto.viam.component.base.v1.MoveArcRequest.ExtentCase', null, global);
+goog.exportSymbol('proto.viam.component.base.v1.MoveArcResponse', null, global);
 goog.exportSymbol('proto.viam.component.base.v1.MoveStraightRequest', null, global);
 goog.exportSymbol('proto.viam.component.base.v1.MoveStraightResponse', null, global);
+goog.exportSymbol('proto.viam.component.base.v1.PathPoint', null, global);
+goog.exportSymbol('proto.viam.component.base.v1.ResetOdometryRequest', null, global);
+goog.exportSymbol('proto.viam.component.base.v1.ResetOdometryResponse', null, global);
 goog.exportSymbol('proto.viam.component.base.v1.SetPowerRequest', null, global);
 goog.exportSymbol('proto.viam.component.base.v1.SetPowerResponse', null, global);
 goog.exportSymbol('proto.viam.component.base.v1.SetVelocityRequest', null, global);
@@ -119,6 +131,111 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.viam.component.base.v1.SpinResponse.displayName = 'proto.viam.component.base.v1.SpinResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.base.v1.MoveArcRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, proto.viam.component.base.v1.MoveArcRequest.oneofGroups_);
+};
+goog.inherits(proto.viam.component.base.v1.MoveArcRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.base.v1.MoveArcRequest.displayName = 'proto.viam.component.base.v1.MoveArcRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.base.v1.MoveArcResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.component.base.v1.MoveArcResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.base.v1.MoveArcResponse.displayName = 'proto.viam.component.base.v1.MoveArcResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.base.v1.PathPoint = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.component.base.v1.PathPoint, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.base.v1.PathPoint.displayName = 'proto.viam.component.base.v1.PathPoint';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.base.v1.FollowPathRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.viam.component.base.v1.FollowPathRequest.repeatedFields_, null);
+};
+goog.inherits(proto.viam.component.base.v1.FollowPathRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.base.v1.FollowPathRequest.displayName = 'proto.viam.component.base.v1.FollowPathRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.base.v1.FollowPathResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.component.base.v1.FollowPathResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.base.v1.FollowPathResponse.displayName = 'proto.viam.component.base.v1.FollowPathResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -245,6 +362,90 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.viam.component.base.v1.SetVelocityResponse.displayName = 'proto.viam.component.base.v1.SetVelocityResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.base.v1.GetOdometryRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.component.base.v1.GetOdometryRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.base.v1.GetOdometryRequest.displayName = 'proto.viam.component.base.v1.GetOdometryRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.base.v1.GetOdometryResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.component.base.v1.GetOdometryResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.base.v1.GetOdometryResponse.displayName = 'proto.viam.component.base.v1.GetOdometryResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.base.v1.ResetOdometryRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.component.base.v1.ResetOdometryRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.base.v1.ResetOdometryRequest.displayName = 'proto.viam.component.base.v1.ResetOdometryRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.base.v1.ResetOdometryResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.component.base.v1.ResetOdometryResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.base.v1.ResetOdometryResponse.displayName = 'proto.viam.component.base.v1.ResetOdometryResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -1014,6 +1215,32 @@ proto.viam.component.base.v1.SpinResponse.serializeBinaryToWriter = function(mes
 
 
 
+/**
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
+ * @const
+ */
+proto.viam.component.base.v1.MoveArcRequest.oneofGroups_ = [[3,4]];
+
+/**
+ * @enum {number}
+ */
+proto.viam.component.base.v1.MoveArcRequest.ExtentCase = {
+  EXTENT_NOT_SET: 0,
+  ARC_LENGTH_MM: 3,
+  ANGLE_DEG: 4
+};
+
+/**
+ * @return {proto.viam.component.base.v1.MoveArcRequest.ExtentCase}
+ */
+proto.viam.component.base.v1.MoveArcRequest.prototype.getExtentCase = function() {
+  return /** @type {proto.viam.component.base.v1.MoveArcRequest.ExtentCase} */(jspb.Message.computeOneofCase(this, proto.viam.component.base.v1.MoveArcRequest.oneofGroups_[0]));
+};
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -1029,8 +1256,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.base.v1.StopRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.base.v1.StopRequest.toObject(opt_includeInstance, this);
+proto.viam.component.base.v1.MoveArcRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.base.v1.MoveArcRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -1039,13 +1266,17 @@ proto.viam.component.base.v1.StopRequest.prototype.toObject = function(opt_inclu
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.base.v1.StopRequest} msg The msg instance to transform.
+ * @param {!proto.viam.component.base.v1.MoveArcRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.base.v1.StopRequest.toObject = function(includeInstance, msg) {
+proto.viam.component.base.v1.MoveArcRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    radiusMm: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0),
+    arcLengthMm: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0),
+    angleDeg: jspb.Message.getFloatingPointFieldWithDefault(msg, 4, 0.0),
+    mmPerSec: jspb.Message.getFloatingPointFieldWithDefault(msg, 5, 0.0),
     extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
   };
 
@@ -1060,23 +1291,23 @@ proto.viam.component.base.v1.StopRequest.toObject = function(includeInstance, ms
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.base.v1.StopRequest}
+ * @return {!proto.viam.component.base.v1.MoveArcRequest}
  */
-proto.viam.component.base.v1.StopRequest.deserializeBinary = function(bytes) {
+proto.viam.component.base.v1.MoveArcRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.base.v1.StopRequest;
-  return proto.viam.component.base.v1.StopRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.base.v1.MoveArcRequest;
+  return proto.viam.component.base.v1.MoveArcRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.base.v1.StopRequest} msg The message object to deserialize into.
+ * @param {!proto.viam.component.base.v1.MoveArcRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.base.v1.StopRequest}
+ * @return {!proto.viam.component.base.v1.MoveArcRequest}
  */
-proto.viam.component.base.v1.StopRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.base.v1.MoveArcRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1087,6 +1318,22 @@ proto.viam.component.base.v1.StopRequest.deserializeBinaryFromReader = function(
       var value = /** @type {string} */ (reader.readString());
       msg.setName(value);
       break;
+    case 2:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setRadiusMm(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setArcLengthMm(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setAngleDeg(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setMmPerSec(value);
+      break;
     case 99:
       var value = new google_protobuf_struct_pb.Struct;
       reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
@@ -1105,9 +1352,9 @@ proto.viam.component.base.v1.StopRequest.deserializeBinaryFromReader = function(
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.base.v1.StopRequest.prototype.serializeBinary = function() {
+proto.viam.component.base.v1.MoveArcRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.base.v1.StopRequest.serializeBinaryToWriter(this, writer);
+  proto.viam.component.base.v1.MoveArcRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1115,11 +1362,11 @@ proto.viam.component.base.v1.StopRequest.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.base.v1.StopRequest} message
+ * @param {!proto.viam.component.base.v1.MoveArcRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.base.v1.StopRequest.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.base.v1.MoveArcRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getName();
   if (f.length > 0) {
@@ -1128,6 +1375,34 @@ proto.viam.component.base.v1.StopRequest.serializeBinaryToWriter = function(mess
       f
     );
   }
+  f = message.getRadiusMm();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      2,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeDouble(
+      3,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 4));
+  if (f != null) {
+    writer.writeDouble(
+      4,
+      f
+    );
+  }
+  f = message.getMmPerSec();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      5,
+      f
+    );
+  }
   f = message.getExtra();
   if (f != null) {
     writer.writeMessage(
@@ -1143,63 +1418,1897 @@ proto.viam.component.base.v1.StopRequest.serializeBinaryToWriter = function(mess
  * optional string name = 1;
  * @return {string}
  */
-proto.viam.component.base.v1.StopRequest.prototype.getName = function() {
+proto.viam.component.base.v1.MoveArcRequest.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.viam.component.base.v1.StopRequest} returns this
+ * @return {!proto.viam.component.base.v1.MoveArcRequest} returns this
  */
-proto.viam.component.base.v1.StopRequest.prototype.setName = function(value) {
+proto.viam.component.base.v1.MoveArcRequest.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional google.protobuf.Struct extra = 99;
- * @return {?proto.google.protobuf.Struct}
+ * optional double radius_mm = 2;
+ * @return {number}
  */
-proto.viam.component.base.v1.StopRequest.prototype.getExtra = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+proto.viam.component.base.v1.MoveArcRequest.prototype.getRadiusMm = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
 };
 
 
 /**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.viam.component.base.v1.StopRequest} returns this
-*/
-proto.viam.component.base.v1.StopRequest.prototype.setExtra = function(value) {
-  return jspb.Message.setWrapperField(this, 99, value);
+ * @param {number} value
+ * @return {!proto.viam.component.base.v1.MoveArcRequest} returns this
+ */
+proto.viam.component.base.v1.MoveArcRequest.prototype.setRadiusMm = function(value) {
+  return jspb.Message.setProto3FloatField(this, 2, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.viam.component.base.v1.StopRequest} returns this
+ * optional double arc_length_mm = 3;
+ * @return {number}
  */
-proto.viam.component.base.v1.StopRequest.prototype.clearExtra = function() {
-  return this.setExtra(undefined);
+proto.viam.component.base.v1.MoveArcRequest.prototype.getArcLengthMm = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 3, 0.0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.viam.component.base.v1.MoveArcRequest} returns this
  */
-proto.viam.component.base.v1.StopRequest.prototype.hasExtra = function() {
-  return jspb.Message.getField(this, 99) != null;
+proto.viam.component.base.v1.MoveArcRequest.prototype.setArcLengthMm = function(value) {
+  return jspb.Message.setOneofField(this, 3, proto.viam.component.base.v1.MoveArcRequest.oneofGroups_[0], value);
 };
 
 
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.viam.component.base.v1.MoveArcRequest} returns this
+ */
+proto.viam.component.base.v1.MoveArcRequest.prototype.clearArcLengthMm = function() {
+  return jspb.Message.setOneofField(this, 3, proto.viam.component.base.v1.MoveArcRequest.oneofGroups_[0], undefined);
+};
 
 
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.base.v1.MoveArcRequest.prototype.hasArcLengthMm = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional double angle_deg = 4;
+ * @return {number}
+ */
+proto.viam.component.base.v1.MoveArcRequest.prototype.getAngleDeg = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 4, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.base.v1.MoveArcRequest} returns this
+ */
+proto.viam.component.base.v1.MoveArcRequest.prototype.setAngleDeg = function(value) {
+  return jspb.Message.setOneofField(this, 4, proto.viam.component.base.v1.MoveArcRequest.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.viam.component.base.v1.MoveArcRequest} returns this
+ */
+proto.viam.component.base.v1.MoveArcRequest.prototype.clearAngleDeg = function() {
+  return jspb.Message.setOneofField(this, 4, proto.viam.component.base.v1.MoveArcRequest.oneofGroups_[0], undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.base.v1.MoveArcRequest.prototype.hasAngleDeg = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional double mm_per_sec = 5;
+ * @return {number}
+ */
+proto.viam.component.base.v1.MoveArcRequest.prototype.getMmPerSec = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 5, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.base.v1.MoveArcRequest} returns this
+ */
+proto.viam.component.base.v1.MoveArcRequest.prototype.setMmPerSec = function(value) {
+  return jspb.Message.setProto3FloatField(this, 5, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.component.base.v1.MoveArcRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.component.base.v1.MoveArcRequest} returns this
+*/
+proto.viam.component.base.v1.MoveArcRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.base.v1.MoveArcRequest} returns this
+ */
+proto.viam.component.base.v1.MoveArcRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.base.v1.MoveArcRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.base.v1.MoveArcResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.base.v1.MoveArcResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.base.v1.MoveArcResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.base.v1.MoveArcResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.base.v1.MoveArcResponse}
+ */
+proto.viam.component.base.v1.MoveArcResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.base.v1.MoveArcResponse;
+  return proto.viam.component.base.v1.MoveArcResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.base.v1.MoveArcResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.base.v1.MoveArcResponse}
+ */
+proto.viam.component.base.v1.MoveArcResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.base.v1.MoveArcResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.base.v1.MoveArcResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.base.v1.MoveArcResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.base.v1.MoveArcResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.base.v1.PathPoint.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.base.v1.PathPoint.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.base.v1.PathPoint} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.base.v1.PathPoint.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    xMm: jspb.Message.getFloatingPointFieldWithDefault(msg, 1, 0.0),
+    yMm: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0),
+    thetaDeg: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0),
+    mmPerSec: jspb.Message.getFloatingPointFieldWithDefault(msg, 4, 0.0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.base.v1.PathPoint}
+ */
+proto.viam.component.base.v1.PathPoint.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.base.v1.PathPoint;
+  return proto.viam.component.base.v1.PathPoint.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.base.v1.PathPoint} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.base.v1.PathPoint}
+ */
+proto.viam.component.base.v1.PathPoint.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setXMm(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setYMm(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setThetaDeg(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setMmPerSec(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.base.v1.PathPoint.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.base.v1.PathPoint.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.base.v1.PathPoint} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.base.v1.PathPoint.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getXMm();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      1,
+      f
+    );
+  }
+  f = message.getYMm();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      2,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeDouble(
+      3,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 4));
+  if (f != null) {
+    writer.writeDouble(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional double x_mm = 1;
+ * @return {number}
+ */
+proto.viam.component.base.v1.PathPoint.prototype.getXMm = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 1, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.base.v1.PathPoint} returns this
+ */
+proto.viam.component.base.v1.PathPoint.prototype.setXMm = function(value) {
+  return jspb.Message.setProto3FloatField(this, 1, value);
+};
+
+
+/**
+ * optional double y_mm = 2;
+ * @return {number}
+ */
+proto.viam.component.base.v1.PathPoint.prototype.getYMm = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.base.v1.PathPoint} returns this
+ */
+proto.viam.component.base.v1.PathPoint.prototype.setYMm = function(value) {
+  return jspb.Message.setProto3FloatField(this, 2, value);
+};
+
+
+/**
+ * optional double theta_deg = 3;
+ * @return {number}
+ */
+proto.viam.component.base.v1.PathPoint.prototype.getThetaDeg = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 3, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.base.v1.PathPoint} returns this
+ */
+proto.viam.component.base.v1.PathPoint.prototype.setThetaDeg = function(value) {
+  return jspb.Message.setField(this, 3, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.viam.component.base.v1.PathPoint} returns this
+ */
+proto.viam.component.base.v1.PathPoint.prototype.clearThetaDeg = function() {
+  return jspb.Message.setField(this, 3, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.base.v1.PathPoint.prototype.hasThetaDeg = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional double mm_per_sec = 4;
+ * @return {number}
+ */
+proto.viam.component.base.v1.PathPoint.prototype.getMmPerSec = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 4, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.base.v1.PathPoint} returns this
+ */
+proto.viam.component.base.v1.PathPoint.prototype.setMmPerSec = function(value) {
+  return jspb.Message.setField(this, 4, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.viam.component.base.v1.PathPoint} returns this
+ */
+proto.viam.component.base.v1.PathPoint.prototype.clearMmPerSec = function() {
+  return jspb.Message.setField(this, 4, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.base.v1.PathPoint.prototype.hasMmPerSec = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.component.base.v1.FollowPathRequest.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.base.v1.FollowPathRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.base.v1.FollowPathRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.base.v1.FollowPathRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.base.v1.FollowPathRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    pathList: jspb.Message.toObjectList(msg.getPathList(),
+    proto.viam.component.base.v1.PathPoint.toObject, includeInstance),
+    mmPerSec: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0),
+    maxAccMmPerSec2: jspb.Message.getFloatingPointFieldWithDefault(msg, 4, 0.0),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.base.v1.FollowPathRequest}
+ */
+proto.viam.component.base.v1.FollowPathRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.base.v1.FollowPathRequest;
+  return proto.viam.component.base.v1.FollowPathRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.base.v1.FollowPathRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.base.v1.FollowPathRequest}
+ */
+proto.viam.component.base.v1.FollowPathRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = new proto.viam.component.base.v1.PathPoint;
+      reader.readMessage(value,proto.viam.component.base.v1.PathPoint.deserializeBinaryFromReader);
+      msg.addPath(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setMmPerSec(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setMaxAccMmPerSec2(value);
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.base.v1.FollowPathRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.base.v1.FollowPathRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.base.v1.FollowPathRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.base.v1.FollowPathRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPathList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.viam.component.base.v1.PathPoint.serializeBinaryToWriter
+    );
+  }
+  f = message.getMmPerSec();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      3,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 4));
+  if (f != null) {
+    writer.writeDouble(
+      4,
+      f
+    );
+  }
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.viam.component.base.v1.FollowPathRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.base.v1.FollowPathRequest} returns this
+ */
+proto.viam.component.base.v1.FollowPathRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * repeated PathPoint path = 2;
+ * @return {!Array<!proto.viam.component.base.v1.PathPoint>}
+ */
+proto.viam.component.base.v1.FollowPathRequest.prototype.getPathList = function() {
+  return /** @type{!Array<!proto.viam.component.base.v1.PathPoint>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.viam.component.base.v1.PathPoint, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.viam.component.base.v1.PathPoint>} value
+ * @return {!proto.viam.component.base.v1.FollowPathRequest} returns this
+*/
+proto.viam.component.base.v1.FollowPathRequest.prototype.setPathList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.viam.component.base.v1.PathPoint=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.component.base.v1.PathPoint}
+ */
+proto.viam.component.base.v1.FollowPathRequest.prototype.addPath = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.viam.component.base.v1.PathPoint, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.component.base.v1.FollowPathRequest} returns this
+ */
+proto.viam.component.base.v1.FollowPathRequest.prototype.clearPathList = function() {
+  return this.setPathList([]);
+};
+
+
+/**
+ * optional double mm_per_sec = 3;
+ * @return {number}
+ */
+proto.viam.component.base.v1.FollowPathRequest.prototype.getMmPerSec = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 3, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.base.v1.FollowPathRequest} returns this
+ */
+proto.viam.component.base.v1.FollowPathRequest.prototype.setMmPerSec = function(value) {
+  return jspb.Message.setProto3FloatField(this, 3, value);
+};
+
+
+/**
+ * optional double max_acc_mm_per_sec2 = 4;
+ * @return {number}
+ */
+proto.viam.component.base.v1.FollowPathRequest.prototype.getMaxAccMmPerSec2 = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 4, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.base.v1.FollowPathRequest} returns this
+ */
+proto.viam.component.base.v1.FollowPathRequest.prototype.setMaxAccMmPerSec2 = function(value) {
+  return jspb.Message.setField(this, 4, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.viam.component.base.v1.FollowPathRequest} returns this
+ */
+proto.viam.component.base.v1.FollowPathRequest.prototype.clearMaxAccMmPerSec2 = function() {
+  return jspb.Message.setField(this, 4, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.base.v1.FollowPathRequest.prototype.hasMaxAccMmPerSec2 = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.component.base.v1.FollowPathRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.component.base.v1.FollowPathRequest} returns this
+*/
+proto.viam.component.base.v1.FollowPathRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.base.v1.FollowPathRequest} returns this
+ */
+proto.viam.component.base.v1.FollowPathRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.base.v1.FollowPathRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.base.v1.FollowPathResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.base.v1.FollowPathResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.base.v1.FollowPathResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.base.v1.FollowPathResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.base.v1.FollowPathResponse}
+ */
+proto.viam.component.base.v1.FollowPathResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.base.v1.FollowPathResponse;
+  return proto.viam.component.base.v1.FollowPathResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.base.v1.FollowPathResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.base.v1.FollowPathResponse}
+ */
+proto.viam.component.base.v1.FollowPathResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.base.v1.FollowPathResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.base.v1.FollowPathResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.base.v1.FollowPathResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.base.v1.FollowPathResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.base.v1.StopRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.base.v1.StopRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.base.v1.StopRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.base.v1.StopRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.base.v1.StopRequest}
+ */
+proto.viam.component.base.v1.StopRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.base.v1.StopRequest;
+  return proto.viam.component.base.v1.StopRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.base.v1.StopRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.base.v1.StopRequest}
+ */
+proto.viam.component.base.v1.StopRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.base.v1.StopRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.base.v1.StopRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.base.v1.StopRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.base.v1.StopRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.viam.component.base.v1.StopRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.base.v1.StopRequest} returns this
+ */
+proto.viam.component.base.v1.StopRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.component.base.v1.StopRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.component.base.v1.StopRequest} returns this
+*/
+proto.viam.component.base.v1.StopRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.base.v1.StopRequest} returns this
+ */
+proto.viam.component.base.v1.StopRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.base.v1.StopRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.base.v1.StopResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.base.v1.StopResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.base.v1.StopResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.base.v1.StopResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.base.v1.StopResponse}
+ */
+proto.viam.component.base.v1.StopResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.base.v1.StopResponse;
+  return proto.viam.component.base.v1.StopResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.base.v1.StopResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.base.v1.StopResponse}
+ */
+proto.viam.component.base.v1.StopResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.base.v1.StopResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.base.v1.StopResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.base.v1.StopResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.base.v1.StopResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.base.v1.SetPowerRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.base.v1.SetPowerRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.base.v1.SetPowerRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.base.v1.SetPowerRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    linear: (f = msg.getLinear()) && common_v1_common_pb.Vector3.toObject(includeInstance, f),
+    angular: (f = msg.getAngular()) && common_v1_common_pb.Vector3.toObject(includeInstance, f),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.base.v1.SetPowerRequest}
+ */
+proto.viam.component.base.v1.SetPowerRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.base.v1.SetPowerRequest;
+  return proto.viam.component.base.v1.SetPowerRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.base.v1.SetPowerRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.base.v1.SetPowerRequest}
+ */
+proto.viam.component.base.v1.SetPowerRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = new common_v1_common_pb.Vector3;
+      reader.readMessage(value,common_v1_common_pb.Vector3.deserializeBinaryFromReader);
+      msg.setLinear(value);
+      break;
+    case 3:
+      var value = new common_v1_common_pb.Vector3;
+      reader.readMessage(value,common_v1_common_pb.Vector3.deserializeBinaryFromReader);
+      msg.setAngular(value);
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.base.v1.SetPowerRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.base.v1.SetPowerRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.base.v1.SetPowerRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.base.v1.SetPowerRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getLinear();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      common_v1_common_pb.Vector3.serializeBinaryToWriter
+    );
+  }
+  f = message.getAngular();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      common_v1_common_pb.Vector3.serializeBinaryToWriter
+    );
+  }
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.viam.component.base.v1.SetPowerRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.base.v1.SetPowerRequest} returns this
+ */
+proto.viam.component.base.v1.SetPowerRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional viam.common.v1.Vector3 linear = 2;
+ * @return {?proto.viam.common.v1.Vector3}
+ */
+proto.viam.component.base.v1.SetPowerRequest.prototype.getLinear = function() {
+  return /** @type{?proto.viam.common.v1.Vector3} */ (
+    jspb.Message.getWrapperField(this, common_v1_common_pb.Vector3, 2));
+};
+
+
+/**
+ * @param {?proto.viam.common.v1.Vector3|undefined} value
+ * @return {!proto.viam.component.base.v1.SetPowerRequest} returns this
+*/
+proto.viam.component.base.v1.SetPowerRequest.prototype.setLinear = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.base.v1.SetPowerRequest} returns this
+ */
+proto.viam.component.base.v1.SetPowerRequest.prototype.clearLinear = function() {
+  return this.setLinear(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.base.v1.SetPowerRequest.prototype.hasLinear = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional viam.common.v1.Vector3 angular = 3;
+ * @return {?proto.viam.common.v1.Vector3}
+ */
+proto.viam.component.base.v1.SetPowerRequest.prototype.getAngular = function() {
+  return /** @type{?proto.viam.common.v1.Vector3} */ (
+    jspb.Message.getWrapperField(this, common_v1_common_pb.Vector3, 3));
+};
+
+
+/**
+ * @param {?proto.viam.common.v1.Vector3|undefined} value
+ * @return {!proto.viam.component.base.v1.SetPowerRequest} returns this
+*/
+proto.viam.component.base.v1.SetPowerRequest.prototype.setAngular = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.base.v1.SetPowerRequest} returns this
+ */
+proto.viam.component.base.v1.SetPowerRequest.prototype.clearAngular = function() {
+  return this.setAngular(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.base.v1.SetPowerRequest.prototype.hasAngular = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.component.base.v1.SetPowerRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.component.base.v1.SetPowerRequest} returns this
+*/
+proto.viam.component.base.v1.SetPowerRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.base.v1.SetPowerRequest} returns this
+ */
+proto.viam.component.base.v1.SetPowerRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.base.v1.SetPowerRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.base.v1.SetPowerResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.base.v1.SetPowerResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.base.v1.SetPowerResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.base.v1.SetPowerResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.base.v1.SetPowerResponse}
+ */
+proto.viam.component.base.v1.SetPowerResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.base.v1.SetPowerResponse;
+  return proto.viam.component.base.v1.SetPowerResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.base.v1.SetPowerResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.base.v1.SetPowerResponse}
+ */
+proto.viam.component.base.v1.SetPowerResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.base.v1.SetPowerResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.base.v1.SetPowerResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.base.v1.SetPowerResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.base.v1.SetPowerResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.base.v1.SetVelocityRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.base.v1.SetVelocityRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.base.v1.SetVelocityRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.base.v1.SetVelocityRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    linear: (f = msg.getLinear()) && common_v1_common_pb.Vector3.toObject(includeInstance, f),
+    angular: (f = msg.getAngular()) && common_v1_common_pb.Vector3.toObject(includeInstance, f),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.base.v1.SetVelocityRequest}
+ */
+proto.viam.component.base.v1.SetVelocityRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.base.v1.SetVelocityRequest;
+  return proto.viam.component.base.v1.SetVelocityRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.base.v1.SetVelocityRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.base.v1.SetVelocityRequest}
+ */
+proto.viam.component.base.v1.SetVelocityRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = new common_v1_common_pb.Vector3;
+      reader.readMessage(value,common_v1_common_pb.Vector3.deserializeBinaryFromReader);
+      msg.setLinear(value);
+      break;
+    case 3:
+      var value = new common_v1_common_pb.Vector3;
+      reader.readMessage(value,common_v1_common_pb.Vector3.deserializeBinaryFromReader);
+      msg.setAngular(value);
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.base.v1.SetVelocityRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.base.v1.SetVelocityRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.base.v1.SetVelocityRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.base.v1.SetVelocityRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getLinear();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      common_v1_common_pb.Vector3.serializeBinaryToWriter
+    );
+  }
+  f = message.getAngular();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      common_v1_common_pb.Vector3.serializeBinaryToWriter
+    );
+  }
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.viam.component.base.v1.SetVelocityRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.base.v1.SetVelocityRequest} returns this
+ */
+proto.viam.component.base.v1.SetVelocityRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional viam.common.v1.Vector3 linear = 2;
+ * @return {?proto.viam.common.v1.Vector3}
+ */
+proto.viam.component.base.v1.SetVelocityRequest.prototype.getLinear = function() {
+  return /** @type{?proto.viam.common.v1.Vector3} */ (
+    jspb.Message.getWrapperField(this, common_v1_common_pb.Vector3, 2));
+};
+
+
+/**
+ * @param {?proto.viam.common.v1.Vector3|undefined} value
+ * @return {!proto.viam.component.base.v1.SetVelocityRequest} returns this
+*/
+proto.viam.component.base.v1.SetVelocityRequest.prototype.setLinear = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.base.v1.SetVelocityRequest} returns this
+ */
+proto.viam.component.base.v1.SetVelocityRequest.prototype.clearLinear = function() {
+  return this.setLinear(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.base.v1.SetVelocityRequest.prototype.hasLinear = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional viam.common.v1.Vector3 angular = 3;
+ * @return {?proto.viam.common.v1.Vector3}
+ */
+proto.viam.component.base.v1.SetVelocityRequest.prototype.getAngular = function() {
+  return /** @type{?proto.viam.common.v1.Vector3} */ (
+    jspb.Message.getWrapperField(this, common_v1_common_pb.Vector3, 3));
+};
+
+
+/**
+ * @param {?proto.viam.common.v1.Vector3|undefined} value
+ * @return {!proto.viam.component.base.v1.SetVelocityRequest} returns this
+*/
+proto.viam.component.base.v1.SetVelocityRequest.prototype.setAngular = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.base.v1.SetVelocityRequest} returns this
+ */
+proto.viam.component.base.v1.SetVelocityRequest.prototype.clearAngular = function() {
+  return this.setAngular(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.base.v1.SetVelocityRequest.prototype.hasAngular = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.component.base.v1.SetVelocityRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.component.base.v1.SetVelocityRequest} returns this
+*/
+proto.viam.component.base.v1.SetVelocityRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.base.v1.SetVelocityRequest} returns this
+ */
+proto.viam.component.base.v1.SetVelocityRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.base.v1.SetVelocityRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
  * Field names that are reserved in JavaScript and will be renamed to pb_name.
  * Optional fields that are not set will be set to undefined.
  * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
@@ -1210,8 +3319,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.base.v1.StopResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.base.v1.StopResponse.toObject(opt_includeInstance, this);
+proto.viam.component.base.v1.SetVelocityResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.base.v1.SetVelocityResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -1220,11 +3329,11 @@ proto.viam.component.base.v1.StopResponse.prototype.toObject = function(opt_incl
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.base.v1.StopResponse} msg The msg instance to transform.
+ * @param {!proto.viam.component.base.v1.SetVelocityResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.base.v1.StopResponse.toObject = function(includeInstance, msg) {
+proto.viam.component.base.v1.SetVelocityResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
 
   };
@@ -1240,23 +3349,23 @@ proto.viam.component.base.v1.StopResponse.toObject = function(includeInstance, m
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.base.v1.StopResponse}
+ * @return {!proto.viam.component.base.v1.SetVelocityResponse}
  */
-proto.viam.component.base.v1.StopResponse.deserializeBinary = function(bytes) {
+proto.viam.component.base.v1.SetVelocityResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.base.v1.StopResponse;
-  return proto.viam.component.base.v1.StopResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.base.v1.SetVelocityResponse;
+  return proto.viam.component.base.v1.SetVelocityResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.base.v1.StopResponse} msg The message object to deserialize into.
+ * @param {!proto.viam.component.base.v1.SetVelocityResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.base.v1.StopResponse}
+ * @return {!proto.viam.component.base.v1.SetVelocityResponse}
  */
-proto.viam.component.base.v1.StopResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.base.v1.SetVelocityResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1276,9 +3385,9 @@ proto.viam.component.base.v1.StopResponse.deserializeBinaryFromReader = function
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.base.v1.StopResponse.prototype.serializeBinary = function() {
+proto.viam.component.base.v1.SetVelocityResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.base.v1.StopResponse.serializeBinaryToWriter(this, writer);
+  proto.viam.component.base.v1.SetVelocityResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1286,11 +3395,11 @@ proto.viam.component.base.v1.StopResponse.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.base.v1.StopResponse} message
+ * @param {!proto.viam.component.base.v1.SetVelocityResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.base.v1.StopResponse.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.base.v1.SetVelocityResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
 };
 
@@ -1311,8 +3420,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.base.v1.SetPowerRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.base.v1.SetPowerRequest.toObject(opt_includeInstance, this);
+proto.viam.component.base.v1.GetOdometryRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.base.v1.GetOdometryRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -1321,15 +3430,13 @@ proto.viam.component.base.v1.SetPowerRequest.prototype.toObject = function(opt_i
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.base.v1.SetPowerRequest} msg The msg instance to transform.
+ * @param {!proto.viam.component.base.v1.GetOdometryRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.base.v1.SetPowerRequest.toObject = function(includeInstance, msg) {
+proto.viam.component.base.v1.GetOdometryRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    linear: (f = msg.getLinear()) && common_v1_common_pb.Vector3.toObject(includeInstance, f),
-    angular: (f = msg.getAngular()) && common_v1_common_pb.Vector3.toObject(includeInstance, f),
     extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
   };
 
@@ -1344,23 +3451,23 @@ proto.viam.component.base.v1.SetPowerRequest.toObject = function(includeInstance
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.base.v1.SetPowerRequest}
+ * @return {!proto.viam.component.base.v1.GetOdometryRequest}
  */
-proto.viam.component.base.v1.SetPowerRequest.deserializeBinary = function(bytes) {
+proto.viam.component.base.v1.GetOdometryRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.base.v1.SetPowerRequest;
-  return proto.viam.component.base.v1.SetPowerRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.base.v1.GetOdometryRequest;
+  return proto.viam.component.base.v1.GetOdometryRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.base.v1.SetPowerRequest} msg The message object to deserialize into.
+ * @param {!proto.viam.component.base.v1.GetOdometryRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.base.v1.SetPowerRequest}
+ * @return {!proto.viam.component.base.v1.GetOdometryRequest}
  */
-proto.viam.component.base.v1.SetPowerRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.base.v1.GetOdometryRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1371,16 +3478,6 @@ proto.viam.component.base.v1.SetPowerRequest.deserializeBinaryFromReader = funct
       var value = /** @type {string} */ (reader.readString());
       msg.setName(value);
       break;
-    case 2:
-      var value = new common_v1_common_pb.Vector3;
-      reader.readMessage(value,common_v1_common_pb.Vector3.deserializeBinaryFromReader);
-      msg.setLinear(value);
-      break;
-    case 3:
-      var value = new common_v1_common_pb.Vector3;
-      reader.readMessage(value,common_v1_common_pb.Vector3.deserializeBinaryFromReader);
-      msg.setAngular(value);
-      break;
     case 99:
       var value = new google_protobuf_struct_pb.Struct;
       reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
@@ -1399,9 +3496,9 @@ proto.viam.component.base.v1.SetPowerRequest.deserializeBinaryFromReader = funct
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.base.v1.SetPowerRequest.prototype.serializeBinary = function() {
+proto.viam.component.base.v1.GetOdometryRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.base.v1.SetPowerRequest.serializeBinaryToWriter(this, writer);
+  proto.viam.component.base.v1.GetOdometryRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1409,11 +3506,11 @@ proto.viam.component.base.v1.SetPowerRequest.prototype.serializeBinary = functio
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.base.v1.SetPowerRequest} message
+ * @param {!proto.viam.component.base.v1.GetOdometryRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.base.v1.SetPowerRequest.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.base.v1.GetOdometryRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getName();
   if (f.length > 0) {
@@ -1422,7 +3519,208 @@ proto.viam.component.base.v1.SetPowerRequest.serializeBinaryToWriter = function(
       f
     );
   }
-  f = message.getLinear();
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.viam.component.base.v1.GetOdometryRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.base.v1.GetOdometryRequest} returns this
+ */
+proto.viam.component.base.v1.GetOdometryRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.component.base.v1.GetOdometryRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.component.base.v1.GetOdometryRequest} returns this
+*/
+proto.viam.component.base.v1.GetOdometryRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.base.v1.GetOdometryRequest} returns this
+ */
+proto.viam.component.base.v1.GetOdometryRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.base.v1.GetOdometryRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.base.v1.GetOdometryResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.base.v1.GetOdometryResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.base.v1.GetOdometryResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.base.v1.GetOdometryResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    pose: (f = msg.getPose()) && common_v1_common_pb.Pose.toObject(includeInstance, f),
+    linearVelocity: (f = msg.getLinearVelocity()) && common_v1_common_pb.Vector3.toObject(includeInstance, f),
+    angularVelocity: (f = msg.getAngularVelocity()) && common_v1_common_pb.Vector3.toObject(includeInstance, f),
+    measuredAt: (f = msg.getMeasuredAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    resetAt: (f = msg.getResetAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.base.v1.GetOdometryResponse}
+ */
+proto.viam.component.base.v1.GetOdometryResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.base.v1.GetOdometryResponse;
+  return proto.viam.component.base.v1.GetOdometryResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.base.v1.GetOdometryResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.base.v1.GetOdometryResponse}
+ */
+proto.viam.component.base.v1.GetOdometryResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new common_v1_common_pb.Pose;
+      reader.readMessage(value,common_v1_common_pb.Pose.deserializeBinaryFromReader);
+      msg.setPose(value);
+      break;
+    case 2:
+      var value = new common_v1_common_pb.Vector3;
+      reader.readMessage(value,common_v1_common_pb.Vector3.deserializeBinaryFromReader);
+      msg.setLinearVelocity(value);
+      break;
+    case 3:
+      var value = new common_v1_common_pb.Vector3;
+      reader.readMessage(value,common_v1_common_pb.Vector3.deserializeBinaryFromReader);
+      msg.setAngularVelocity(value);
+      break;
+    case 4:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setMeasuredAt(value);
+      break;
+    case 5:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setResetAt(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.base.v1.GetOdometryResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.base.v1.GetOdometryResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.base.v1.GetOdometryResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.base.v1.GetOdometryResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPose();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      common_v1_common_pb.Pose.serializeBinaryToWriter
+    );
+  }
+  f = message.getLinearVelocity();
   if (f != null) {
     writer.writeMessage(
       2,
@@ -1430,7 +3728,7 @@ proto.viam.component.base.v1.SetPowerRequest.serializeBinaryToWriter = function(
       common_v1_common_pb.Vector3.serializeBinaryToWriter
     );
   }
-  f = message.getAngular();
+  f = message.getAngularVelocity();
   if (f != null) {
     writer.writeMessage(
       3,
@@ -1438,40 +3736,67 @@ proto.viam.component.base.v1.SetPowerRequest.serializeBinaryToWriter = function(
       common_v1_common_pb.Vector3.serializeBinaryToWriter
     );
   }
-  f = message.getExtra();
+  f = message.getMeasuredAt();
   if (f != null) {
     writer.writeMessage(
-      99,
+      4,
       f,
-      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getResetAt();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string name = 1;
- * @return {string}
+ * optional viam.common.v1.Pose pose = 1;
+ * @return {?proto.viam.common.v1.Pose}
  */
-proto.viam.component.base.v1.SetPowerRequest.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.viam.component.base.v1.GetOdometryResponse.prototype.getPose = function() {
+  return /** @type{?proto.viam.common.v1.Pose} */ (
+    jspb.Message.getWrapperField(this, common_v1_common_pb.Pose, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.viam.component.base.v1.SetPowerRequest} returns this
+ * @param {?proto.viam.common.v1.Pose|undefined} value
+ * @return {!proto.viam.component.base.v1.GetOdometryResponse} returns this
+*/
+proto.viam.component.base.v1.GetOdometryResponse.prototype.setPose = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.base.v1.GetOdometryResponse} returns this
  */
-proto.viam.component.base.v1.SetPowerRequest.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.viam.component.base.v1.GetOdometryResponse.prototype.clearPose = function() {
+  return this.setPose(undefined);
 };
 
 
 /**
- * optional viam.common.v1.Vector3 linear = 2;
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.base.v1.GetOdometryResponse.prototype.hasPose = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional viam.common.v1.Vector3 linear_velocity = 2;
  * @return {?proto.viam.common.v1.Vector3}
  */
-proto.viam.component.base.v1.SetPowerRequest.prototype.getLinear = function() {
+proto.viam.component.base.v1.GetOdometryResponse.prototype.getLinearVelocity = function() {
   return /** @type{?proto.viam.common.v1.Vector3} */ (
     jspb.Message.getWrapperField(this, common_v1_common_pb.Vector3, 2));
 };
@@ -1479,19 +3804,19 @@ proto.viam.component.base.v1.SetPowerRequest.prototype.getLinear = function() {
 
 /**
  * @param {?proto.viam.common.v1.Vector3|undefined} value
- * @return {!proto.viam.component.base.v1.SetPowerRequest} returns this
+ * @return {!proto.viam.component.base.v1.GetOdometryResponse} returns this
 */
-proto.viam.component.base.v1.SetPowerRequest.prototype.setLinear = function(value) {
+proto.viam.component.base.v1.GetOdometryResponse.prototype.setLinearVelocity = function(value) {
   return jspb.Message.setWrapperField(this, 2, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.viam.component.base.v1.SetPowerRequest} returns this
+ * @return {!proto.viam.component.base.v1.GetOdometryResponse} returns this
  */
-proto.viam.component.base.v1.SetPowerRequest.prototype.clearLinear = function() {
-  return this.setLinear(undefined);
+proto.viam.component.base.v1.GetOdometryResponse.prototype.clearLinearVelocity = function() {
+  return this.setLinearVelocity(undefined);
 };
 
 
@@ -1499,16 +3824,16 @@ proto.viam.component.base.v1.SetPowerRequest.prototype.clearLinear = function()
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.component.base.v1.SetPowerRequest.prototype.hasLinear = function() {
+proto.viam.component.base.v1.GetOdometryResponse.prototype.hasLinearVelocity = function() {
   return jspb.Message.getField(this, 2) != null;
 };
 
 
 /**
- * optional viam.common.v1.Vector3 angular = 3;
+ * optional viam.common.v1.Vector3 angular_velocity = 3;
  * @return {?proto.viam.common.v1.Vector3}
  */
-proto.viam.component.base.v1.SetPowerRequest.prototype.getAngular = function() {
+proto.viam.component.base.v1.GetOdometryResponse.prototype.getAngularVelocity = function() {
   return /** @type{?proto.viam.common.v1.Vector3} */ (
     jspb.Message.getWrapperField(this, common_v1_common_pb.Vector3, 3));
 };
@@ -1516,19 +3841,19 @@ proto.viam.component.base.v1.SetPowerRequest.prototype.getAngular = function() {
 
 /**
  * @param {?proto.viam.common.v1.Vector3|undefined} value
- * @return {!proto.viam.component.base.v1.SetPowerRequest} returns this
+ * @return {!proto.viam.component.base.v1.GetOdometryResponse} returns this
 */
-proto.viam.component.base.v1.SetPowerRequest.prototype.setAngular = function(value) {
+proto.viam.component.base.v1.GetOdometryResponse.prototype.setAngularVelocity = function(value) {
   return jspb.Message.setWrapperField(this, 3, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.viam.component.base.v1.SetPowerRequest} returns this
+ * @return {!proto.viam.component.base.v1.GetOdometryResponse} returns this
  */
-proto.viam.component.base.v1.SetPowerRequest.prototype.clearAngular = function() {
-  return this.setAngular(undefined);
+proto.viam.component.base.v1.GetOdometryResponse.prototype.clearAngularVelocity = function() {
+  return this.setAngularVelocity(undefined);
 };
 
 
@@ -1536,36 +3861,36 @@ proto.viam.component.base.v1.SetPowerRequest.prototype.clearAngular = function()
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.component.base.v1.SetPowerRequest.prototype.hasAngular = function() {
+proto.viam.component.base.v1.GetOdometryResponse.prototype.hasAngularVelocity = function() {
   return jspb.Message.getField(this, 3) != null;
 };
 
 
 /**
- * optional google.protobuf.Struct extra = 99;
- * @return {?proto.google.protobuf.Struct}
+ * optional google.protobuf.Timestamp measured_at = 4;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.viam.component.base.v1.SetPowerRequest.prototype.getExtra = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+proto.viam.component.base.v1.GetOdometryResponse.prototype.getMeasuredAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 4));
 };
 
 
 /**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.viam.component.base.v1.SetPowerRequest} returns this
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.viam.component.base.v1.GetOdometryResponse} returns this
 */
-proto.viam.component.base.v1.SetPowerRequest.prototype.setExtra = function(value) {
-  return jspb.Message.setWrapperField(this, 99, value);
+proto.viam.component.base.v1.GetOdometryResponse.prototype.setMeasuredAt = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.viam.component.base.v1.SetPowerRequest} returns this
+ * @return {!proto.viam.component.base.v1.GetOdometryResponse} returns this
  */
-proto.viam.component.base.v1.SetPowerRequest.prototype.clearExtra = function() {
-  return this.setExtra(undefined);
+proto.viam.component.base.v1.GetOdometryResponse.prototype.clearMeasuredAt = function() {
+  return this.setMeasuredAt(undefined);
 };
 
 
@@ -1573,109 +3898,45 @@ proto.viam.component.base.v1.SetPowerRequest.prototype.clearExtra = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.component.base.v1.SetPowerRequest.prototype.hasExtra = function() {
-  return jspb.Message.getField(this, 99) != null;
-};
-
-
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
-/**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
- */
-proto.viam.component.base.v1.SetPowerResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.base.v1.SetPowerResponse.toObject(opt_includeInstance, this);
-};
-
-
-/**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.viam.component.base.v1.SetPowerResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.viam.component.base.v1.SetPowerResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.viam.component.base.v1.GetOdometryResponse.prototype.hasMeasuredAt = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.base.v1.SetPowerResponse}
+ * optional google.protobuf.Timestamp reset_at = 5;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.viam.component.base.v1.SetPowerResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.base.v1.SetPowerResponse;
-  return proto.viam.component.base.v1.SetPowerResponse.deserializeBinaryFromReader(msg, reader);
+proto.viam.component.base.v1.GetOdometryResponse.prototype.getResetAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 5));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.viam.component.base.v1.SetPowerResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.base.v1.SetPowerResponse}
- */
-proto.viam.component.base.v1.SetPowerResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.viam.component.base.v1.GetOdometryResponse} returns this
+*/
+proto.viam.component.base.v1.GetOdometryResponse.prototype.setResetAt = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.base.v1.GetOdometryResponse} returns this
  */
-proto.viam.component.base.v1.SetPowerResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.viam.component.base.v1.SetPowerResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.viam.component.base.v1.GetOdometryResponse.prototype.clearResetAt = function() {
+  return this.setResetAt(undefined);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.base.v1.SetPowerResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.viam.component.base.v1.SetPowerResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
+proto.viam.component.base.v1.GetOdometryResponse.prototype.hasResetAt = function() {
+  return jspb.Message.getField(this, 5) != null;
 };
 
 
@@ -1695,8 +3956,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.base.v1.SetVelocityRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.base.v1.SetVelocityRequest.toObject(opt_includeInstance, this);
+proto.viam.component.base.v1.ResetOdometryRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.base.v1.ResetOdometryRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -1705,15 +3966,13 @@ proto.viam.component.base.v1.SetVelocityRequest.prototype.toObject = function(op
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.base.v1.SetVelocityRequest} msg The msg instance to transform.
+ * @param {!proto.viam.component.base.v1.ResetOdometryRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.base.v1.SetVelocityRequest.toObject = function(includeInstance, msg) {
+proto.viam.component.base.v1.ResetOdometryRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    linear: (f = msg.getLinear()) && common_v1_common_pb.Vector3.toObject(includeInstance, f),
-    angular: (f = msg.getAngular()) && common_v1_common_pb.Vector3.toObject(includeInstance, f),
     extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
   };
 
@@ -1728,23 +3987,23 @@ proto.viam.component.base.v1.SetVelocityRequest.toObject = function(includeInsta
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.base.v1.SetVelocityRequest}
+ * @return {!proto.viam.component.base.v1.ResetOdometryRequest}
  */
-proto.viam.component.base.v1.SetVelocityRequest.deserializeBinary = function(bytes) {
+proto.viam.component.base.v1.ResetOdometryRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.base.v1.SetVelocityRequest;
-  return proto.viam.component.base.v1.SetVelocityRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.base.v1.ResetOdometryRequest;
+  return proto.viam.component.base.v1.ResetOdometryRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.base.v1.SetVelocityRequest} msg The message object to deserialize into.
+ * @param {!proto.viam.component.base.v1.ResetOdometryRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.base.v1.SetVelocityRequest}
+ * @return {!proto.viam.component.base.v1.ResetOdometryRequest}
  */
-proto.viam.component.base.v1.SetVelocityRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.base.v1.ResetOdometryRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1755,16 +4014,6 @@ proto.viam.component.base.v1.SetVelocityRequest.deserializeBinaryFromReader = fu
       var value = /** @type {string} */ (reader.readString());
       msg.setName(value);
       break;
-    case 2:
-      var value = new common_v1_common_pb.Vector3;
-      reader.readMessage(value,common_v1_common_pb.Vector3.deserializeBinaryFromReader);
-      msg.setLinear(value);
-      break;
-    case 3:
-      var value = new common_v1_common_pb.Vector3;
-      reader.readMessage(value,common_v1_common_pb.Vector3.deserializeBinaryFromReader);
-      msg.setAngular(value);
-      break;
     case 99:
       var value = new google_protobuf_struct_pb.Struct;
       reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
@@ -1783,9 +4032,9 @@ proto.viam.component.base.v1.SetVelocityRequest.deserializeBinaryFromReader = fu
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.base.v1.SetVelocityRequest.prototype.serializeBinary = function() {
+proto.viam.component.base.v1.ResetOdometryRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.base.v1.SetVelocityRequest.serializeBinaryToWriter(this, writer);
+  proto.viam.component.base.v1.ResetOdometryRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1793,11 +4042,11 @@ proto.viam.component.base.v1.SetVelocityRequest.prototype.serializeBinary = func
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.base.v1.SetVelocityRequest} message
+ * @param {!proto.viam.component.base.v1.ResetOdometryRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.base.v1.SetVelocityRequest.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.base.v1.ResetOdometryRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getName();
   if (f.length > 0) {
@@ -1806,22 +4055,6 @@ proto.viam.component.base.v1.SetVelocityRequest.serializeBinaryToWriter = functi
       f
     );
   }
-  f = message.getLinear();
-  if (f != null) {
-    writer.writeMessage(
-      2,
-      f,
-      common_v1_common_pb.Vector3.serializeBinaryToWriter
-    );
-  }
-  f = message.getAngular();
-  if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      common_v1_common_pb.Vector3.serializeBinaryToWriter
-    );
-  }
   f = message.getExtra();
   if (f != null) {
     writer.writeMessage(
@@ -1837,99 +4070,25 @@ proto.viam.component.base.v1.SetVelocityRequest.serializeBinaryToWriter = functi
  * optional string name = 1;
  * @return {string}
  */
-proto.viam.component.base.v1.SetVelocityRequest.prototype.getName = function() {
+proto.viam.component.base.v1.ResetOdometryRequest.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.viam.component.base.v1.SetVelocityRequest} returns this
+ * @return {!proto.viam.component.base.v1.ResetOdometryRequest} returns this
  */
-proto.viam.component.base.v1.SetVelocityRequest.prototype.setName = function(value) {
+proto.viam.component.base.v1.ResetOdometryRequest.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
-/**
- * optional viam.common.v1.Vector3 linear = 2;
- * @return {?proto.viam.common.v1.Vector3}
- */
-proto.viam.component.base.v1.SetVelocityRequest.prototype.getLinear = function() {
-  return /** @type{?proto.viam.common.v1.Vector3} */ (
-    jspb.Message.getWrapperField(this, common_v1_common_pb.Vector3, 2));
-};
-
-
-/**
- * @param {?proto.viam.common.v1.Vector3|undefined} value
- * @return {!proto.viam.component.base.v1.SetVelocityRequest} returns this
-*/
-proto.viam.component.base.v1.SetVelocityRequest.prototype.setLinear = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.viam.component.base.v1.SetVelocityRequest} returns this
- */
-proto.viam.component.base.v1.SetVelocityRequest.prototype.clearLinear = function() {
-  return this.setLinear(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.viam.component.base.v1.SetVelocityRequest.prototype.hasLinear = function() {
-  return jspb.Message.getField(this, 2) != null;
-};
-
-
-/**
- * optional viam.common.v1.Vector3 angular = 3;
- * @return {?proto.viam.common.v1.Vector3}
- */
-proto.viam.component.base.v1.SetVelocityRequest.prototype.getAngular = function() {
-  return /** @type{?proto.viam.common.v1.Vector3} */ (
-    jspb.Message.getWrapperField(this, common_v1_common_pb.Vector3, 3));
-};
-
-
-/**
- * @param {?proto.viam.common.v1.Vector3|undefined} value
- * @return {!proto.viam.component.base.v1.SetVelocityRequest} returns this
-*/
-proto.viam.component.base.v1.SetVelocityRequest.prototype.setAngular = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.viam.component.base.v1.SetVelocityRequest} returns this
- */
-proto.viam.component.base.v1.SetVelocityRequest.prototype.clearAngular = function() {
-  return this.setAngular(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.viam.component.base.v1.SetVelocityRequest.prototype.hasAngular = function() {
-  return jspb.Message.getField(this, 3) != null;
-};
-
-
 /**
  * optional google.protobuf.Struct extra = 99;
  * @return {?proto.google.protobuf.Struct}
  */
-proto.viam.component.base.v1.SetVelocityRequest.prototype.getExtra = function() {
+proto.viam.component.base.v1.ResetOdometryRequest.prototype.getExtra = function() {
   return /** @type{?proto.google.protobuf.Struct} */ (
     jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
 };
@@ -1937,18 +4096,18 @@ proto.viam.component.base.v1.SetVelocityRequest.prototype.getExtra = function()
 
 /**
  * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.viam.component.base.v1.SetVelocityRequest} returns this
+ * @return {!proto.viam.component.base.v1.ResetOdometryRequest} returns this
 */
-proto.viam.component.base.v1.SetVelocityRequest.prototype.setExtra = function(value) {
+proto.viam.component.base.v1.ResetOdometryRequest.prototype.setExtra = function(value) {
   return jspb.Message.setWrapperField(this, 99, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.viam.component.base.v1.SetVelocityRequest} returns this
+ * @return {!proto.viam.component.base.v1.ResetOdometryRequest} returns this
  */
-proto.viam.component.base.v1.SetVelocityRequest.prototype.clearExtra = function() {
+proto.viam.component.base.v1.ResetOdometryRequest.prototype.clearExtra = function() {
   return this.setExtra(undefined);
 };
 
@@ -1957,7 +4116,7 @@ proto.viam.component.base.v1.SetVelocityRequest.prototype.clearExtra = function(
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.component.base.v1.SetVelocityRequest.prototype.hasExtra = function() {
+proto.viam.component.base.v1.ResetOdometryRequest.prototype.hasExtra = function() {
   return jspb.Message.getField(this, 99) != null;
 };
 
@@ -1978,8 +4137,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.base.v1.SetVelocityResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.base.v1.SetVelocityResponse.toObject(opt_includeInstance, this);
+proto.viam.component.base.v1.ResetOdometryResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.base.v1.ResetOdometryResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -1988,11 +4147,11 @@ proto.viam.component.base.v1.SetVelocityResponse.prototype.toObject = function(o
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.base.v1.SetVelocityResponse} msg The msg instance to transform.
+ * @param {!proto.viam.component.base.v1.ResetOdometryResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.base.v1.SetVelocityResponse.toObject = function(includeInstance, msg) {
+proto.viam.component.base.v1.ResetOdometryResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
 
   };
@@ -2008,23 +4167,23 @@ proto.viam.component.base.v1.SetVelocityResponse.toObject = function(includeInst
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.base.v1.SetVelocityResponse}
+ * @return {!proto.viam.component.base.v1.ResetOdometryResponse}
  */
-proto.viam.component.base.v1.SetVelocityResponse.deserializeBinary = function(bytes) {
+proto.viam.component.base.v1.ResetOdometryResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.base.v1.SetVelocityResponse;
-  return proto.viam.component.base.v1.SetVelocityResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.base.v1.ResetOdometryResponse;
+  return proto.viam.component.base.v1.ResetOdometryResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.base.v1.SetVelocityResponse} msg The message object to deserialize into.
+ * @param {!proto.viam.component.base.v1.ResetOdometryResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.base.v1.SetVelocityResponse}
+ * @return {!proto.viam.component.base.v1.ResetOdometryResponse}
  */
-proto.viam.component.base.v1.SetVelocityResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.base.v1.ResetOdometryResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2044,9 +4203,9 @@ proto.viam.component.base.v1.SetVelocityResponse.deserializeBinaryFromReader = f
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.base.v1.SetVelocityResponse.prototype.serializeBinary = function() {
+proto.viam.component.base.v1.ResetOdometryResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.base.v1.SetVelocityResponse.serializeBinaryToWriter(this, writer);
+  proto.viam.component.base.v1.ResetOdometryResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2054,11 +4213,11 @@ proto.viam.component.base.v1.SetVelocityResponse.prototype.serializeBinary = fun
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.base.v1.SetVelocityResponse} message
+ * @param {!proto.viam.component.base.v1.ResetOdometryResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.base.v1.SetVelocityResponse.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.base.v1.ResetOdometryResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
 };
 
@@ -23,6 +23,24 @@ type BaseServiceSpin = {
   readonly responseType: typeof component_base_v1_base_pb.SpinResponse;
 };
 
+type BaseServiceMoveArc = {
+  readonly methodName: string;
+  readonly service: typeof BaseService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof component_base_v1_base_pb.MoveArcRequest;
+  readonly responseType: typeof component_base_v1_base_pb.MoveArcResponse;
+};
+
+type BaseServiceFollowPath = {
+  readonly methodName: string;
+  readonly service: typeof BaseService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof component_base_v1_base_pb.FollowPathRequest;
+  readonly responseType: typeof component_base_v1_base_pb.FollowPathResponse;
+};
+
 type BaseServiceSetPower = {
   readonly methodName: string;
   readonly service: typeof BaseService;
@@ -41,6 +59,24 @@ type BaseServiceSetVelocity = {
   readonly responseType: typeof component_base_v1_base_pb.SetVelocityResponse;
 };
 
+type BaseServiceGetOdometry = {
+  readonly methodName: string;
+  readonly service: typeof BaseService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof component_base_v1_base_pb.GetOdometryRequest;
+  readonly responseType: typeof component_base_v1_base_pb.GetOdometryResponse;
+};
+
+type BaseServiceResetOdometry = {
+  readonly methodName: string;
+  readonly service: typeof BaseService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof component_base_v1_base_pb.ResetOdometryRequest;
+  readonly responseType: typeof component_base_v1_base_pb.ResetOdometryResponse;
+};
+
 type BaseServiceStop = {
   readonly methodName: string;
   readonly service: typeof BaseService;
@@ -90,8 +126,12 @@ export class BaseService {
   static readonly serviceName: string;
   static readonly MoveStraight: BaseServiceMoveStraight;
   static readonly Spin: BaseServiceSpin;
+  static readonly MoveArc: BaseServiceMoveArc;
+  static readonly FollowPath: BaseServiceFollowPath;
   static readonly SetPower: BaseServiceSetPower;
   static readonly SetVelocity: BaseServiceSetVelocity;
+  static readonly GetOdometry: BaseServiceGetOdometry;
+  static readonly ResetOdometry: BaseServiceResetOdometry;
   static readonly Stop: BaseServiceStop;
   static readonly IsMoving: BaseServiceIsMoving;
   static readonly DoCommand: BaseServiceDoCommand;
@@ -149,6 +189,24 @@ export class BaseServiceClient {
     requestMessage: component_base_v1_base_pb.SpinRequest,
     callback: (error: ServiceError|null, responseMessage: component_base_v1_base_pb.SpinResponse|null) => void
   ): UnaryResponse;
+  moveArc(
+    requestMessage: component_base_v1_base_pb.MoveArcRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: component_base_v1_base_pb.MoveArcResponse|null) => void
+  ): UnaryResponse;
+  moveArc(
+    requestMessage: component_base_v1_base_pb.MoveArcRequest,
+    callback: (error: ServiceError|null, responseMessage: component_base_v1_base_pb.MoveArcResponse|null) => void
+  ): UnaryResponse;
+  followPath(
+    requestMessage: component_base_v1_base_pb.FollowPathRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: component_base_v1_base_pb.FollowPathResponse|null) => void
+  ): UnaryResponse;
+  followPath(
+    requestMessage: component_base_v1_base_pb.FollowPathRequest,
+    callback: (error: ServiceError|null, responseMessage: component_base_v1_base_pb.FollowPathResponse|null) => void
+  ): UnaryResponse;
   setPower(
     requestMessage: component_base_v1_base_pb.SetPowerRequest,
     metadata: grpc.Metadata,
@@ -167,6 +225,24 @@ export class BaseServiceClient {
     requestMessage: component_base_v1_base_pb.SetVelocityRequest,
     callback: (error: ServiceError|null, responseMessage: component_base_v1_base_pb.SetVelocityResponse|null) => void
   ): UnaryResponse;
+  getOdometry(
+    requestMessage: component_base_v1_base_pb.GetOdometryRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: component_base_v1_base_pb.GetOdometryResponse|null) => void
+  ): UnaryResponse;
+  getOdometry(
+    requestMessage: component_base_v1_base_pb.GetOdometryRequest,
+    callback: (error: ServiceError|null, responseMessage: component_base_v1_base_pb.GetOdometryResponse|null) => void
+  ): UnaryResponse;
+  resetOdometry(
+    requestMessage: component_base_v1_base_pb.ResetOdometryRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: component_base_v1_base_pb.ResetOdometryResponse|null) => void
+  ): UnaryResponse;
+  resetOdometry(
+    requestMessage: component_base_v1_base_pb.ResetOdometryRequest,
+    callback: (error: ServiceError|null, responseMessage: component_base_v1_base_pb.ResetOdometryResponse|null) => void
+  ): UnaryResponse;
   stop(
     requestMessage: component_base_v1_base_pb.StopRequest,
     metadata: grpc.Metadata,
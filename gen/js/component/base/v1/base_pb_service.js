@@ -29,6 +29,24 @@ BaseService.Spin = {
   responseType: component_base_v1_base_pb.SpinResponse
 };
 
+BaseService.MoveArc = {
+  methodName: "MoveArc",
+  service: BaseService,
+  requestStream: false,
+  responseStream: false,
+  requestType: component_base_v1_base_pb.MoveArcRequest,
+  responseType: component_base_v1_base_pb.MoveArcResponse
+};
+
+BaseService.FollowPath = {
+  methodName: "FollowPath",
+  service: BaseService,
+  requestStream: false,
+  responseStream: false,
+  requestType: component_base_v1_base_pb.FollowPathRequest,
+  responseType: component_base_v1_base_pb.FollowPathResponse
+};
+
 BaseService.SetPower = {
   methodName: "SetPower",
   service: BaseService,
@@ -47,6 +65,24 @@ BaseService.SetVelocity = {
   responseType: component_base_v1_base_pb.SetVelocityResponse
 };
 
+BaseService.GetOdometry = {
+  methodName: "GetOdometry",
+  service: BaseService,
+  requestStream: false,
+  responseStream: false,
+  requestType: component_base_v1_base_pb.GetOdometryRequest,
+  responseType: component_base_v1_base_pb.GetOdometryResponse
+};
+
+BaseService.ResetOdometry = {
+  methodName: "ResetOdometry",
+  service: BaseService,
+  requestStream: false,
+  responseStream: false,
+  requestType: component_base_v1_base_pb.ResetOdometryRequest,
+  responseType: component_base_v1_base_pb.ResetOdometryResponse
+};
+
 BaseService.Stop = {
   methodName: "Stop",
   service: BaseService,
@@ -161,6 +197,68 @@ BaseServiceClient.prototype.spin = function spin(requestMessage, metadata, callb
   };
 };
 
+BaseServiceClient.prototype.moveArc = function moveArc(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(BaseService.MoveArc, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+BaseServiceClient.prototype.followPath = function followPath(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(BaseService.FollowPath, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
 BaseServiceClient.prototype.setPower = function setPower(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
@@ -223,6 +321,68 @@ BaseServiceClient.prototype.setVelocity = function setVelocity(requestMessage, m
   };
 };
 
+BaseServiceClient.prototype.getOdometry = function getOdometry(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(BaseService.GetOdometry, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+BaseServiceClient.prototype.resetOdometry = function resetOdometry(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(BaseService.ResetOdometry, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
 BaseServiceClient.prototype.stop = function stop(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
@@ -27,6 +27,8 @@ var google_api_annotations_pb = require('../../../google/api/annotations_pb.js')
 var google_protobuf_duration_pb = require('google-protobuf/google/protobuf/duration_pb.js')
 
 var google_protobuf_struct_pb = require('google-protobuf/google/protobuf/struct_pb.js')
+
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js')
 const proto = {};
 proto.viam = {};
 proto.viam.component = {};
@@ -268,6 +270,62 @@ proto.viam.component.board.v1.BoardServicePromiseClient.prototype.getGPIO =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.viam.component.board.v1.StreamGPIOEdgesRequest,
+ *   !proto.viam.component.board.v1.StreamGPIOEdgesResponse>}
+ */
+const methodDescriptor_BoardService_StreamGPIOEdges = new grpc.web.MethodDescriptor(
+  '/viam.component.board.v1.BoardService/StreamGPIOEdges',
+  grpc.web.MethodType.SERVER_STREAMING,
+  proto.viam.component.board.v1.StreamGPIOEdgesRequest,
+  proto.viam.component.board.v1.StreamGPIOEdgesResponse,
+  /**
+   * @param {!proto.viam.component.board.v1.StreamGPIOEdgesRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.viam.component.board.v1.StreamGPIOEdgesResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.viam.component.board.v1.StreamGPIOEdgesRequest} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.viam.component.board.v1.StreamGPIOEdgesResponse>}
+ *     The XHR Node Readable Stream
+ */
+proto.viam.component.board.v1.BoardServiceClient.prototype.streamGPIOEdges =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/viam.component.board.v1.BoardService/StreamGPIOEdges',
+      request,
+      metadata || {},
+      methodDescriptor_BoardService_StreamGPIOEdges);
+};
+
+
+/**
+ * @param {!proto.viam.component.board.v1.StreamGPIOEdgesRequest} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.viam.component.board.v1.StreamGPIOEdgesResponse>}
+ *     The XHR Node Readable Stream
+ */
+proto.viam.component.board.v1.BoardServicePromiseClient.prototype.streamGPIOEdges =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/viam.component.board.v1.BoardService/StreamGPIOEdges',
+      request,
+      metadata || {},
+      methodDescriptor_BoardService_StreamGPIOEdges);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -634,6 +692,62 @@ proto.viam.component.board.v1.BoardServicePromiseClient.prototype.readAnalogRead
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.viam.component.board.v1.StreamAnalogReadersRequest,
+ *   !proto.viam.component.board.v1.StreamAnalogReadersResponse>}
+ */
+const methodDescriptor_BoardService_StreamAnalogReaders = new grpc.web.MethodDescriptor(
+  '/viam.component.board.v1.BoardService/StreamAnalogReaders',
+  grpc.web.MethodType.SERVER_STREAMING,
+  proto.viam.component.board.v1.StreamAnalogReadersRequest,
+  proto.viam.component.board.v1.StreamAnalogReadersResponse,
+  /**
+   * @param {!proto.viam.component.board.v1.StreamAnalogReadersRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.viam.component.board.v1.StreamAnalogReadersResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.viam.component.board.v1.StreamAnalogReadersRequest} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.viam.component.board.v1.StreamAnalogReadersResponse>}
+ *     The XHR Node Readable Stream
+ */
+proto.viam.component.board.v1.BoardServiceClient.prototype.streamAnalogReaders =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/viam.component.board.v1.BoardService/StreamAnalogReaders',
+      request,
+      metadata || {},
+      methodDescriptor_BoardService_StreamAnalogReaders);
+};
+
+
+/**
+ * @param {!proto.viam.component.board.v1.StreamAnalogReadersRequest} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.viam.component.board.v1.StreamAnalogReadersResponse>}
+ *     The XHR Node Readable Stream
+ */
+proto.viam.component.board.v1.BoardServicePromiseClient.prototype.streamAnalogReaders =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/viam.component.board.v1.BoardService/StreamAnalogReaders',
+      request,
+      metadata || {},
+      methodDescriptor_BoardService_StreamAnalogReaders);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -695,6 +809,184 @@ proto.viam.component.board.v1.BoardServicePromiseClient.prototype.getDigitalInte
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.viam.component.board.v1.StreamTicksRequest,
+ *   !proto.viam.component.board.v1.StreamTicksResponse>}
+ */
+const methodDescriptor_BoardService_StreamTicks = new grpc.web.MethodDescriptor(
+  '/viam.component.board.v1.BoardService/StreamTicks',
+  grpc.web.MethodType.SERVER_STREAMING,
+  proto.viam.component.board.v1.StreamTicksRequest,
+  proto.viam.component.board.v1.StreamTicksResponse,
+  /**
+   * @param {!proto.viam.component.board.v1.StreamTicksRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.viam.component.board.v1.StreamTicksResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.viam.component.board.v1.StreamTicksRequest} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.viam.component.board.v1.StreamTicksResponse>}
+ *     The XHR Node Readable Stream
+ */
+proto.viam.component.board.v1.BoardServiceClient.prototype.streamTicks =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/viam.component.board.v1.BoardService/StreamTicks',
+      request,
+      metadata || {},
+      methodDescriptor_BoardService_StreamTicks);
+};
+
+
+/**
+ * @param {!proto.viam.component.board.v1.StreamTicksRequest} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.viam.component.board.v1.StreamTicksResponse>}
+ *     The XHR Node Readable Stream
+ */
+proto.viam.component.board.v1.BoardServicePromiseClient.prototype.streamTicks =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/viam.component.board.v1.BoardService/StreamTicks',
+      request,
+      metadata || {},
+      methodDescriptor_BoardService_StreamTicks);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.viam.component.board.v1.I2CReadWriteRequest,
+ *   !proto.viam.component.board.v1.I2CReadWriteResponse>}
+ */
+const methodDescriptor_BoardService_I2CReadWrite = new grpc.web.MethodDescriptor(
+  '/viam.component.board.v1.BoardService/I2CReadWrite',
+  grpc.web.MethodType.UNARY,
+  proto.viam.component.board.v1.I2CReadWriteRequest,
+  proto.viam.component.board.v1.I2CReadWriteResponse,
+  /**
+   * @param {!proto.viam.component.board.v1.I2CReadWriteRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.viam.component.board.v1.I2CReadWriteResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.viam.component.board.v1.I2CReadWriteRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.viam.component.board.v1.I2CReadWriteResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.viam.component.board.v1.I2CReadWriteResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.viam.component.board.v1.BoardServiceClient.prototype.i2CReadWrite =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/viam.component.board.v1.BoardService/I2CReadWrite',
+      request,
+      metadata || {},
+      methodDescriptor_BoardService_I2CReadWrite,
+      callback);
+};
+
+
+/**
+ * @param {!proto.viam.component.board.v1.I2CReadWriteRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.viam.component.board.v1.I2CReadWriteResponse>}
+ *     Promise that resolves to the response
+ */
+proto.viam.component.board.v1.BoardServicePromiseClient.prototype.i2CReadWrite =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/viam.component.board.v1.BoardService/I2CReadWrite',
+      request,
+      metadata || {},
+      methodDescriptor_BoardService_I2CReadWrite);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.viam.component.board.v1.SPITransferRequest,
+ *   !proto.viam.component.board.v1.SPITransferResponse>}
+ */
+const methodDescriptor_BoardService_SPITransfer = new grpc.web.MethodDescriptor(
+  '/viam.component.board.v1.BoardService/SPITransfer',
+  grpc.web.MethodType.UNARY,
+  proto.viam.component.board.v1.SPITransferRequest,
+  proto.viam.component.board.v1.SPITransferResponse,
+  /**
+   * @param {!proto.viam.component.board.v1.SPITransferRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.viam.component.board.v1.SPITransferResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.viam.component.board.v1.SPITransferRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.viam.component.board.v1.SPITransferResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.viam.component.board.v1.SPITransferResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.viam.component.board.v1.BoardServiceClient.prototype.sPITransfer =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/viam.component.board.v1.BoardService/SPITransfer',
+      request,
+      metadata || {},
+      methodDescriptor_BoardService_SPITransfer,
+      callback);
+};
+
+
+/**
+ * @param {!proto.viam.component.board.v1.SPITransferRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.viam.component.board.v1.SPITransferResponse>}
+ *     Promise that resolves to the response
+ */
+proto.viam.component.board.v1.BoardServicePromiseClient.prototype.sPITransfer =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/viam.component.board.v1.BoardService/SPITransfer',
+      request,
+      metadata || {},
+      methodDescriptor_BoardService_SPITransfer);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -756,6 +1048,67 @@ proto.viam.component.board.v1.BoardServicePromiseClient.prototype.setPowerMode =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.viam.component.board.v1.GetPowerModeRequest,
+ *   !proto.viam.component.board.v1.GetPowerModeResponse>}
+ */
+const methodDescriptor_BoardService_GetPowerMode = new grpc.web.MethodDescriptor(
+  '/viam.component.board.v1.BoardService/GetPowerMode',
+  grpc.web.MethodType.UNARY,
+  proto.viam.component.board.v1.GetPowerModeRequest,
+  proto.viam.component.board.v1.GetPowerModeResponse,
+  /**
+   * @param {!proto.viam.component.board.v1.GetPowerModeRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.viam.component.board.v1.GetPowerModeResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.viam.component.board.v1.GetPowerModeRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.viam.component.board.v1.GetPowerModeResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.viam.component.board.v1.GetPowerModeResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.viam.component.board.v1.BoardServiceClient.prototype.getPowerMode =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/viam.component.board.v1.BoardService/GetPowerMode',
+      request,
+      metadata || {},
+      methodDescriptor_BoardService_GetPowerMode,
+      callback);
+};
+
+
+/**
+ * @param {!proto.viam.component.board.v1.GetPowerModeRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.viam.component.board.v1.GetPowerModeResponse>}
+ *     Promise that resolves to the response
+ */
+proto.viam.component.board.v1.BoardServicePromiseClient.prototype.getPowerMode =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/viam.component.board.v1.BoardService/GetPowerMode',
+      request,
+      metadata || {},
+      methodDescriptor_BoardService_GetPowerMode);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
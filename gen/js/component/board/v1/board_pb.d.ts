@@ -6,6 +6,7 @@ import * as common_v1_common_pb from "../../../common/v1/common_pb";
 import * as google_api_annotations_pb from "../../../google/api/annotations_pb";
 import * as google_protobuf_duration_pb from "google-protobuf/google/protobuf/duration_pb";
 import * as google_protobuf_struct_pb from "google-protobuf/google/protobuf/struct_pb";
+import * as google_protobuf_timestamp_pb from "google-protobuf/google/protobuf/timestamp_pb";
 
 export class StatusRequest extends jspb.Message {
   getName(): string;
@@ -155,6 +156,72 @@ export namespace GetGPIOResponse {
   }
 }
 
+export class StreamGPIOEdgesRequest extends jspb.Message {
+  getName(): string;
+  setName(value: string): void;
+
+  clearPinsList(): void;
+  getPinsList(): Array<string>;
+  setPinsList(value: Array<string>): void;
+  addPins(value: string, index?: number): string;
+
+  getEdge(): EdgeMap[keyof EdgeMap];
+  setEdge(value: EdgeMap[keyof EdgeMap]): void;
+
+  hasExtra(): boolean;
+  clearExtra(): void;
+  getExtra(): google_protobuf_struct_pb.Struct | undefined;
+  setExtra(value?: google_protobuf_struct_pb.Struct): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StreamGPIOEdgesRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: StreamGPIOEdgesRequest): StreamGPIOEdgesRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: StreamGPIOEdgesRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StreamGPIOEdgesRequest;
+  static deserializeBinaryFromReader(message: StreamGPIOEdgesRequest, reader: jspb.BinaryReader): StreamGPIOEdgesRequest;
+}
+
+export namespace StreamGPIOEdgesRequest {
+  export type AsObject = {
+    name: string,
+    pinsList: Array<string>,
+    edge: EdgeMap[keyof EdgeMap],
+    extra?: google_protobuf_struct_pb.Struct.AsObject,
+  }
+}
+
+export class StreamGPIOEdgesResponse extends jspb.Message {
+  getPin(): string;
+  setPin(value: string): void;
+
+  getHigh(): boolean;
+  setHigh(value: boolean): void;
+
+  hasTime(): boolean;
+  clearTime(): void;
+  getTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setTime(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StreamGPIOEdgesResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: StreamGPIOEdgesResponse): StreamGPIOEdgesResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: StreamGPIOEdgesResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StreamGPIOEdgesResponse;
+  static deserializeBinaryFromReader(message: StreamGPIOEdgesResponse, reader: jspb.BinaryReader): StreamGPIOEdgesResponse;
+}
+
+export namespace StreamGPIOEdgesResponse {
+  export type AsObject = {
+    pin: string,
+    high: boolean,
+    time?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+  }
+}
+
 export class PWMRequest extends jspb.Message {
   getName(): string;
   setName(value: string): void;
@@ -389,6 +456,23 @@ export class ReadAnalogReaderResponse extends jspb.Message {
   getValue(): number;
   setValue(value: number): void;
 
+  getVoltage(): number;
+  setVoltage(value: number): void;
+
+  getMinRange(): number;
+  setMinRange(value: number): void;
+
+  getMaxRange(): number;
+  setMaxRange(value: number): void;
+
+  getStepSize(): number;
+  setStepSize(value: number): void;
+
+  hasTime(): boolean;
+  clearTime(): void;
+  getTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setTime(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): ReadAnalogReaderResponse.AsObject;
   static toObject(includeInstance: boolean, msg: ReadAnalogReaderResponse): ReadAnalogReaderResponse.AsObject;
@@ -402,6 +486,107 @@ export class ReadAnalogReaderResponse extends jspb.Message {
 export namespace ReadAnalogReaderResponse {
   export type AsObject = {
     value: number,
+    voltage: number,
+    minRange: number,
+    maxRange: number,
+    stepSize: number,
+    time?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+  }
+}
+
+export class StreamAnalogReadersRequest extends jspb.Message {
+  getBoardName(): string;
+  setBoardName(value: string): void;
+
+  clearAnalogReaderNamesList(): void;
+  getAnalogReaderNamesList(): Array<string>;
+  setAnalogReaderNamesList(value: Array<string>): void;
+  addAnalogReaderNames(value: string, index?: number): string;
+
+  getSampleRateHz(): number;
+  setSampleRateHz(value: number): void;
+
+  getSamplesPerBatch(): number;
+  setSamplesPerBatch(value: number): void;
+
+  hasExtra(): boolean;
+  clearExtra(): void;
+  getExtra(): google_protobuf_struct_pb.Struct | undefined;
+  setExtra(value?: google_protobuf_struct_pb.Struct): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StreamAnalogReadersRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: StreamAnalogReadersRequest): StreamAnalogReadersRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: StreamAnalogReadersRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StreamAnalogReadersRequest;
+  static deserializeBinaryFromReader(message: StreamAnalogReadersRequest, reader: jspb.BinaryReader): StreamAnalogReadersRequest;
+}
+
+export namespace StreamAnalogReadersRequest {
+  export type AsObject = {
+    boardName: string,
+    analogReaderNamesList: Array<string>,
+    sampleRateHz: number,
+    samplesPerBatch: number,
+    extra?: google_protobuf_struct_pb.Struct.AsObject,
+  }
+}
+
+export class StreamAnalogReadersResponse extends jspb.Message {
+  clearSamplesList(): void;
+  getSamplesList(): Array<AnalogSample>;
+  setSamplesList(value: Array<AnalogSample>): void;
+  addSamples(value?: AnalogSample, index?: number): AnalogSample;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StreamAnalogReadersResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: StreamAnalogReadersResponse): StreamAnalogReadersResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: StreamAnalogReadersResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StreamAnalogReadersResponse;
+  static deserializeBinaryFromReader(message: StreamAnalogReadersResponse, reader: jspb.BinaryReader): StreamAnalogReadersResponse;
+}
+
+export namespace StreamAnalogReadersResponse {
+  export type AsObject = {
+    samplesList: Array<AnalogSample.AsObject>,
+  }
+}
+
+export class AnalogSample extends jspb.Message {
+  getAnalogReaderName(): string;
+  setAnalogReaderName(value: string): void;
+
+  getValue(): number;
+  setValue(value: number): void;
+
+  getVoltage(): number;
+  setVoltage(value: number): void;
+
+  hasTime(): boolean;
+  clearTime(): void;
+  getTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setTime(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AnalogSample.AsObject;
+  static toObject(includeInstance: boolean, msg: AnalogSample): AnalogSample.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: AnalogSample, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AnalogSample;
+  static deserializeBinaryFromReader(message: AnalogSample, reader: jspb.BinaryReader): AnalogSample;
+}
+
+export namespace AnalogSample {
+  export type AsObject = {
+    analogReaderName: string,
+    value: number,
+    voltage: number,
+    time?: google_protobuf_timestamp_pb.Timestamp.AsObject,
   }
 }
 
@@ -455,6 +640,305 @@ export namespace GetDigitalInterruptValueResponse {
   }
 }
 
+export class StreamTicksRequest extends jspb.Message {
+  getBoardName(): string;
+  setBoardName(value: string): void;
+
+  clearDigitalInterruptNamesList(): void;
+  getDigitalInterruptNamesList(): Array<string>;
+  setDigitalInterruptNamesList(value: Array<string>): void;
+  addDigitalInterruptNames(value: string, index?: number): string;
+
+  hasExtra(): boolean;
+  clearExtra(): void;
+  getExtra(): google_protobuf_struct_pb.Struct | undefined;
+  setExtra(value?: google_protobuf_struct_pb.Struct): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StreamTicksRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: StreamTicksRequest): StreamTicksRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: StreamTicksRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StreamTicksRequest;
+  static deserializeBinaryFromReader(message: StreamTicksRequest, reader: jspb.BinaryReader): StreamTicksRequest;
+}
+
+export namespace StreamTicksRequest {
+  export type AsObject = {
+    boardName: string,
+    digitalInterruptNamesList: Array<string>,
+    extra?: google_protobuf_struct_pb.Struct.AsObject,
+  }
+}
+
+export class StreamTicksResponse extends jspb.Message {
+  getDigitalInterruptName(): string;
+  setDigitalInterruptName(value: string): void;
+
+  getHigh(): boolean;
+  setHigh(value: boolean): void;
+
+  hasTime(): boolean;
+  clearTime(): void;
+  getTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setTime(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  getValue(): number;
+  setValue(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StreamTicksResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: StreamTicksResponse): StreamTicksResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: StreamTicksResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StreamTicksResponse;
+  static deserializeBinaryFromReader(message: StreamTicksResponse, reader: jspb.BinaryReader): StreamTicksResponse;
+}
+
+export namespace StreamTicksResponse {
+  export type AsObject = {
+    digitalInterruptName: string,
+    high: boolean,
+    time?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    value: number,
+  }
+}
+
+export class I2CReadWriteRequest extends jspb.Message {
+  getBoardName(): string;
+  setBoardName(value: string): void;
+
+  getI2cName(): string;
+  setI2cName(value: string): void;
+
+  getAddress(): number;
+  setAddress(value: number): void;
+
+  clearOperationsList(): void;
+  getOperationsList(): Array<I2COperation>;
+  setOperationsList(value: Array<I2COperation>): void;
+  addOperations(value?: I2COperation, index?: number): I2COperation;
+
+  hasExtra(): boolean;
+  clearExtra(): void;
+  getExtra(): google_protobuf_struct_pb.Struct | undefined;
+  setExtra(value?: google_protobuf_struct_pb.Struct): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): I2CReadWriteRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: I2CReadWriteRequest): I2CReadWriteRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: I2CReadWriteRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): I2CReadWriteRequest;
+  static deserializeBinaryFromReader(message: I2CReadWriteRequest, reader: jspb.BinaryReader): I2CReadWriteRequest;
+}
+
+export namespace I2CReadWriteRequest {
+  export type AsObject = {
+    boardName: string,
+    i2cName: string,
+    address: number,
+    operationsList: Array<I2COperation.AsObject>,
+    extra?: google_protobuf_struct_pb.Struct.AsObject,
+  }
+}
+
+export class I2COperation extends jspb.Message {
+  hasWrite(): boolean;
+  clearWrite(): void;
+  getWrite(): Uint8Array | string;
+  getWrite_asU8(): Uint8Array;
+  getWrite_asB64(): string;
+  setWrite(value: Uint8Array | string): void;
+
+  hasReadLength(): boolean;
+  clearReadLength(): void;
+  getReadLength(): number;
+  setReadLength(value: number): void;
+
+  getOperationCase(): I2COperation.OperationCase;
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): I2COperation.AsObject;
+  static toObject(includeInstance: boolean, msg: I2COperation): I2COperation.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: I2COperation, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): I2COperation;
+  static deserializeBinaryFromReader(message: I2COperation, reader: jspb.BinaryReader): I2COperation;
+}
+
+export namespace I2COperation {
+  export type AsObject = {
+    write: Uint8Array | string,
+    readLength: number,
+  }
+
+  export enum OperationCase {
+    OPERATION_NOT_SET = 0,
+    WRITE = 1,
+    READ_LENGTH = 2,
+  }
+}
+
+export class I2CReadWriteResponse extends jspb.Message {
+  clearReadsList(): void;
+  getReadsList(): Array<Uint8Array | string>;
+  getReadsList_asU8(): Array<Uint8Array>;
+  getReadsList_asB64(): Array<string>;
+  setReadsList(value: Array<Uint8Array | string>): void;
+  addReads(value: Uint8Array | string, index?: number): Uint8Array | string;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): I2CReadWriteResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: I2CReadWriteResponse): I2CReadWriteResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: I2CReadWriteResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): I2CReadWriteResponse;
+  static deserializeBinaryFromReader(message: I2CReadWriteResponse, reader: jspb.BinaryReader): I2CReadWriteResponse;
+}
+
+export namespace I2CReadWriteResponse {
+  export type AsObject = {
+    readsList: Array<Uint8Array | string>,
+  }
+}
+
+export class SPITransferRequest extends jspb.Message {
+  getBoardName(): string;
+  setBoardName(value: string): void;
+
+  getSpiName(): string;
+  setSpiName(value: string): void;
+
+  getChipSelect(): string;
+  setChipSelect(value: string): void;
+
+  getMode(): number;
+  setMode(value: number): void;
+
+  getBaudHz(): number;
+  setBaudHz(value: number): void;
+
+  getData(): Uint8Array | string;
+  getData_asU8(): Uint8Array;
+  getData_asB64(): string;
+  setData(value: Uint8Array | string): void;
+
+  hasExtra(): boolean;
+  clearExtra(): void;
+  getExtra(): google_protobuf_struct_pb.Struct | undefined;
+  setExtra(value?: google_protobuf_struct_pb.Struct): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SPITransferRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: SPITransferRequest): SPITransferRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SPITransferRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SPITransferRequest;
+  static deserializeBinaryFromReader(message: SPITransferRequest, reader: jspb.BinaryReader): SPITransferRequest;
+}
+
+export namespace SPITransferRequest {
+  export type AsObject = {
+    boardName: string,
+    spiName: string,
+    chipSelect: string,
+    mode: number,
+    baudHz: number,
+    data: Uint8Array | string,
+    extra?: google_protobuf_struct_pb.Struct.AsObject,
+  }
+}
+
+export class SPITransferResponse extends jspb.Message {
+  getData(): Uint8Array | string;
+  getData_asU8(): Uint8Array;
+  getData_asB64(): string;
+  setData(value: Uint8Array | string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SPITransferResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: SPITransferResponse): SPITransferResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SPITransferResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SPITransferResponse;
+  static deserializeBinaryFromReader(message: SPITransferResponse, reader: jspb.BinaryReader): SPITransferResponse;
+}
+
+export namespace SPITransferResponse {
+  export type AsObject = {
+    data: Uint8Array | string,
+  }
+}
+
+export class SerialStreamRequest extends jspb.Message {
+  getBoardName(): string;
+  setBoardName(value: string): void;
+
+  getSerialName(): string;
+  setSerialName(value: string): void;
+
+  getBaudRate(): number;
+  setBaudRate(value: number): void;
+
+  getData(): Uint8Array | string;
+  getData_asU8(): Uint8Array;
+  getData_asB64(): string;
+  setData(value: Uint8Array | string): void;
+
+  hasExtra(): boolean;
+  clearExtra(): void;
+  getExtra(): google_protobuf_struct_pb.Struct | undefined;
+  setExtra(value?: google_protobuf_struct_pb.Struct): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SerialStreamRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: SerialStreamRequest): SerialStreamRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SerialStreamRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SerialStreamRequest;
+  static deserializeBinaryFromReader(message: SerialStreamRequest, reader: jspb.BinaryReader): SerialStreamRequest;
+}
+
+export namespace SerialStreamRequest {
+  export type AsObject = {
+    boardName: string,
+    serialName: string,
+    baudRate: number,
+    data: Uint8Array | string,
+    extra?: google_protobuf_struct_pb.Struct.AsObject,
+  }
+}
+
+export class SerialStreamResponse extends jspb.Message {
+  getData(): Uint8Array | string;
+  getData_asU8(): Uint8Array;
+  getData_asB64(): string;
+  setData(value: Uint8Array | string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SerialStreamResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: SerialStreamResponse): SerialStreamResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SerialStreamResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SerialStreamResponse;
+  static deserializeBinaryFromReader(message: SerialStreamResponse, reader: jspb.BinaryReader): SerialStreamResponse;
+}
+
+export namespace SerialStreamResponse {
+  export type AsObject = {
+    data: Uint8Array | string,
+  }
+}
+
 export class SetPowerModeRequest extends jspb.Message {
   getName(): string;
   setName(value: string): void;
@@ -467,6 +951,11 @@ export class SetPowerModeRequest extends jspb.Message {
   getDuration(): google_protobuf_duration_pb.Duration | undefined;
   setDuration(value?: google_protobuf_duration_pb.Duration): void;
 
+  clearWakeSourcesList(): void;
+  getWakeSourcesList(): Array<WakeSource>;
+  setWakeSourcesList(value: Array<WakeSource>): void;
+  addWakeSources(value?: WakeSource, index?: number): WakeSource;
+
   hasExtra(): boolean;
   clearExtra(): void;
   getExtra(): google_protobuf_struct_pb.Struct | undefined;
@@ -487,6 +976,7 @@ export namespace SetPowerModeRequest {
     name: string,
     powerMode: PowerModeMap[keyof PowerModeMap],
     duration?: google_protobuf_duration_pb.Duration.AsObject,
+    wakeSourcesList: Array<WakeSource.AsObject>,
     extra?: google_protobuf_struct_pb.Struct.AsObject,
   }
 }
@@ -507,11 +997,211 @@ export namespace SetPowerModeResponse {
   }
 }
 
+export class WakeSource extends jspb.Message {
+  hasGpio(): boolean;
+  clearGpio(): void;
+  getGpio(): GPIOWakeSource | undefined;
+  setGpio(value?: GPIOWakeSource): void;
+
+  hasRtc(): boolean;
+  clearRtc(): void;
+  getRtc(): RTCWakeSource | undefined;
+  setRtc(value?: RTCWakeSource): void;
+
+  hasAnalog(): boolean;
+  clearAnalog(): void;
+  getAnalog(): AnalogWakeSource | undefined;
+  setAnalog(value?: AnalogWakeSource): void;
+
+  getSourceCase(): WakeSource.SourceCase;
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): WakeSource.AsObject;
+  static toObject(includeInstance: boolean, msg: WakeSource): WakeSource.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: WakeSource, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): WakeSource;
+  static deserializeBinaryFromReader(message: WakeSource, reader: jspb.BinaryReader): WakeSource;
+}
+
+export namespace WakeSource {
+  export type AsObject = {
+    gpio?: GPIOWakeSource.AsObject,
+    rtc?: RTCWakeSource.AsObject,
+    analog?: AnalogWakeSource.AsObject,
+  }
+
+  export enum SourceCase {
+    SOURCE_NOT_SET = 0,
+    GPIO = 1,
+    RTC = 2,
+    ANALOG = 3,
+  }
+}
+
+export class GPIOWakeSource extends jspb.Message {
+  getPin(): string;
+  setPin(value: string): void;
+
+  getEdge(): EdgeMap[keyof EdgeMap];
+  setEdge(value: EdgeMap[keyof EdgeMap]): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GPIOWakeSource.AsObject;
+  static toObject(includeInstance: boolean, msg: GPIOWakeSource): GPIOWakeSource.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GPIOWakeSource, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GPIOWakeSource;
+  static deserializeBinaryFromReader(message: GPIOWakeSource, reader: jspb.BinaryReader): GPIOWakeSource;
+}
+
+export namespace GPIOWakeSource {
+  export type AsObject = {
+    pin: string,
+    edge: EdgeMap[keyof EdgeMap],
+  }
+}
+
+export class RTCWakeSource extends jspb.Message {
+  hasTime(): boolean;
+  clearTime(): void;
+  getTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setTime(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RTCWakeSource.AsObject;
+  static toObject(includeInstance: boolean, msg: RTCWakeSource): RTCWakeSource.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: RTCWakeSource, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RTCWakeSource;
+  static deserializeBinaryFromReader(message: RTCWakeSource, reader: jspb.BinaryReader): RTCWakeSource;
+}
+
+export namespace RTCWakeSource {
+  export type AsObject = {
+    time?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+  }
+}
+
+export class AnalogWakeSource extends jspb.Message {
+  getAnalogReaderName(): string;
+  setAnalogReaderName(value: string): void;
+
+  getThresholdVoltage(): number;
+  setThresholdVoltage(value: number): void;
+
+  getRising(): boolean;
+  setRising(value: boolean): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AnalogWakeSource.AsObject;
+  static toObject(includeInstance: boolean, msg: AnalogWakeSource): AnalogWakeSource.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: AnalogWakeSource, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AnalogWakeSource;
+  static deserializeBinaryFromReader(message: AnalogWakeSource, reader: jspb.BinaryReader): AnalogWakeSource;
+}
+
+export namespace AnalogWakeSource {
+  export type AsObject = {
+    analogReaderName: string,
+    thresholdVoltage: number,
+    rising: boolean,
+  }
+}
+
+export class GetPowerModeRequest extends jspb.Message {
+  getName(): string;
+  setName(value: string): void;
+
+  hasExtra(): boolean;
+  clearExtra(): void;
+  getExtra(): google_protobuf_struct_pb.Struct | undefined;
+  setExtra(value?: google_protobuf_struct_pb.Struct): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetPowerModeRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: GetPowerModeRequest): GetPowerModeRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetPowerModeRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetPowerModeRequest;
+  static deserializeBinaryFromReader(message: GetPowerModeRequest, reader: jspb.BinaryReader): GetPowerModeRequest;
+}
+
+export namespace GetPowerModeRequest {
+  export type AsObject = {
+    name: string,
+    extra?: google_protobuf_struct_pb.Struct.AsObject,
+  }
+}
+
+export class GetPowerModeResponse extends jspb.Message {
+  getPowerMode(): PowerModeMap[keyof PowerModeMap];
+  setPowerMode(value: PowerModeMap[keyof PowerModeMap]): void;
+
+  getLastWakeReason(): WakeReasonMap[keyof WakeReasonMap];
+  setLastWakeReason(value: WakeReasonMap[keyof WakeReasonMap]): void;
+
+  hasLastWakeSource(): boolean;
+  clearLastWakeSource(): void;
+  getLastWakeSource(): WakeSource | undefined;
+  setLastWakeSource(value?: WakeSource): void;
+
+  hasLastWakeTime(): boolean;
+  clearLastWakeTime(): void;
+  getLastWakeTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setLastWakeTime(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetPowerModeResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetPowerModeResponse): GetPowerModeResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetPowerModeResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetPowerModeResponse;
+  static deserializeBinaryFromReader(message: GetPowerModeResponse, reader: jspb.BinaryReader): GetPowerModeResponse;
+}
+
+export namespace GetPowerModeResponse {
+  export type AsObject = {
+    powerMode: PowerModeMap[keyof PowerModeMap],
+    lastWakeReason: WakeReasonMap[keyof WakeReasonMap],
+    lastWakeSource?: WakeSource.AsObject,
+    lastWakeTime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+  }
+}
+
+export interface EdgeMap {
+  EDGE_UNSPECIFIED: 0;
+  EDGE_RISING: 1;
+  EDGE_FALLING: 2;
+  EDGE_BOTH: 3;
+}
+
+export const Edge: EdgeMap;
+
 export interface PowerModeMap {
   POWER_MODE_UNSPECIFIED: 0;
   POWER_MODE_NORMAL: 1;
   POWER_MODE_OFFLINE_DEEP: 2;
+  POWER_MODE_OFFLINE_LIGHT: 3;
+  POWER_MODE_THROTTLED: 4;
 }
 
 export const PowerMode: PowerModeMap;
 
+export interface WakeReasonMap {
+  WAKE_REASON_UNSPECIFIED: 0;
+  WAKE_REASON_POWER_ON: 1;
+  WAKE_REASON_DURATION: 2;
+  WAKE_REASON_GPIO: 3;
+  WAKE_REASON_RTC: 4;
+  WAKE_REASON_ANALOG: 5;
+}
+
+export const WakeReason: WakeReasonMap;
+
@@ -23,17 +23,34 @@ var google_protobuf_duration_pb = require('google-protobuf/google/protobuf/durat
 goog.object.extend(proto, google_protobuf_duration_pb);
 var google_protobuf_struct_pb = require('google-protobuf/google/protobuf/struct_pb.js');
 goog.object.extend(proto, google_protobuf_struct_pb);
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
+goog.object.extend(proto, google_protobuf_timestamp_pb);
+goog.exportSymbol('proto.viam.component.board.v1.AnalogSample', null, global);
+goog.exportSymbol('proto.viam.component.board.v1.AnalogWakeSource', null, global);
+goog.exportSymbol('proto.viam.component.board.v1.Edge', null, global);
+goog.exportSymbol('proto.viam.component.board.v1.GPIOWakeSource', null, global);
 goog.exportSymbol('proto.viam.component.board.v1.GetDigitalInterruptValueRequest', null, global);
 goog.exportSymbol('proto.viam.component.board.v1.GetDigitalInterruptValueResponse', null, global);
 goog.exportSymbol('proto.viam.component.board.v1.GetGPIORequest', null, global);
 goog.exportSymbol('proto.viam.component.board.v1.GetGPIOResponse', null, global);
+goog.exportSymbol('proto.viam.component.board.v1.GetPowerModeRequest', null, global);
+goog.exportSymbol('proto.viam.component.board.v1.GetPowerModeResponse', null, global);
+goog.exportSymbol('proto.viam.component.board.v1.I2COperation', null, global);
+goog.exportSymbol('proto.viam.component.board.v1.I2COperation.OperationCase', null, global);
+goog.exportSymbol('proto.viam.component.board.v1.I2CReadWriteRequest', null, global);
+goog.exportSymbol('proto.viam.component.board.v1.I2CReadWriteResponse', null, global);
 goog.exportSymbol('proto.viam.component.board.v1.PWMFrequencyRequest', null, global);
 goog.exportSymbol('proto.viam.component.board.v1.PWMFrequencyResponse', null, global);
 goog.exportSymbol('proto.viam.component.board.v1.PWMRequest', null, global);
 goog.exportSymbol('proto.viam.component.board.v1.PWMResponse', null, global);
 goog.exportSymbol('proto.viam.component.board.v1.PowerMode', null, global);
+goog.exportSymbol('proto.viam.component.board.v1.RTCWakeSource', null, global);
 goog.exportSymbol('proto.viam.component.board.v1.ReadAnalogReaderRequest', null, global);
 goog.exportSymbol('proto.viam.component.board.v1.ReadAnalogReaderResponse', null, global);
+goog.exportSymbol('proto.viam.component.board.v1.SPITransferRequest', null, global);
+goog.exportSymbol('proto.viam.component.board.v1.SPITransferResponse', null, global);
+goog.exportSymbol('proto.viam.component.board.v1.SerialStreamRequest', null, global);
+goog.exportSymbol('proto.viam.component.board.v1.SerialStreamResponse', null, global);
 goog.exportSymbol('proto.viam.component.board.v1.SetGPIORequest', null, global);
 goog.exportSymbol('proto.viam.component.board.v1.SetGPIOResponse', null, global);
 goog.exportSymbol('proto.viam.component.board.v1.SetPWMFrequencyRequest', null, global);
@@ -44,6 +61,15 @@ goog.exportSymbol('proto.viam.component.board.v1.SetPowerModeRequest', null, glo
 goog.exportSymbol('proto.viam.component.board.v1.SetPowerModeResponse', null, global);
 goog.exportSymbol('proto.viam.component.board.v1.StatusRequest', null, global);
 goog.exportSymbol('proto.viam.component.board.v1.StatusResponse', null, global);
+goog.exportSymbol('proto.viam.component.board.v1.StreamAnalogReadersRequest', null, global);
+goog.exportSymbol('proto.viam.component.board.v1.StreamAnalogReadersResponse', null, global);
+goog.exportSymbol('proto.viam.component.board.v1.StreamGPIOEdgesRequest', null, global);
+goog.exportSymbol('proto.viam.component.board.v1.StreamGPIOEdgesResponse', null, global);
+goog.exportSymbol('proto.viam.component.board.v1.StreamTicksRequest', null, global);
+goog.exportSymbol('proto.viam.component.board.v1.StreamTicksResponse', null, global);
+goog.exportSymbol('proto.viam.component.board.v1.WakeReason', null, global);
+goog.exportSymbol('proto.viam.component.board.v1.WakeSource', null, global);
+goog.exportSymbol('proto.viam.component.board.v1.WakeSource.SourceCase', null, global);
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -170,6 +196,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.viam.component.board.v1.GetGPIOResponse.displayName = 'proto.viam.component.board.v1.GetGPIOResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.board.v1.StreamGPIOEdgesRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.viam.component.board.v1.StreamGPIOEdgesRequest.repeatedFields_, null);
+};
+goog.inherits(proto.viam.component.board.v1.StreamGPIOEdgesRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.board.v1.StreamGPIOEdgesRequest.displayName = 'proto.viam.component.board.v1.StreamGPIOEdgesRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.board.v1.StreamGPIOEdgesResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.component.board.v1.StreamGPIOEdgesResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.board.v1.StreamGPIOEdgesResponse.displayName = 'proto.viam.component.board.v1.StreamGPIOEdgesResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -380,6 +448,69 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.viam.component.board.v1.ReadAnalogReaderResponse.displayName = 'proto.viam.component.board.v1.ReadAnalogReaderResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.board.v1.StreamAnalogReadersRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.viam.component.board.v1.StreamAnalogReadersRequest.repeatedFields_, null);
+};
+goog.inherits(proto.viam.component.board.v1.StreamAnalogReadersRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.board.v1.StreamAnalogReadersRequest.displayName = 'proto.viam.component.board.v1.StreamAnalogReadersRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.board.v1.StreamAnalogReadersResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.viam.component.board.v1.StreamAnalogReadersResponse.repeatedFields_, null);
+};
+goog.inherits(proto.viam.component.board.v1.StreamAnalogReadersResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.board.v1.StreamAnalogReadersResponse.displayName = 'proto.viam.component.board.v1.StreamAnalogReadersResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.board.v1.AnalogSample = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.component.board.v1.AnalogSample, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.board.v1.AnalogSample.displayName = 'proto.viam.component.board.v1.AnalogSample';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -432,16 +563,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.viam.component.board.v1.SetPowerModeRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.viam.component.board.v1.StreamTicksRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.viam.component.board.v1.StreamTicksRequest.repeatedFields_, null);
 };
-goog.inherits(proto.viam.component.board.v1.SetPowerModeRequest, jspb.Message);
+goog.inherits(proto.viam.component.board.v1.StreamTicksRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.viam.component.board.v1.SetPowerModeRequest.displayName = 'proto.viam.component.board.v1.SetPowerModeRequest';
+  proto.viam.component.board.v1.StreamTicksRequest.displayName = 'proto.viam.component.board.v1.StreamTicksRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -453,125 +584,2967 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.viam.component.board.v1.SetPowerModeResponse = function(opt_data) {
+proto.viam.component.board.v1.StreamTicksResponse = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.viam.component.board.v1.SetPowerModeResponse, jspb.Message);
+goog.inherits(proto.viam.component.board.v1.StreamTicksResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.viam.component.board.v1.SetPowerModeResponse.displayName = 'proto.viam.component.board.v1.SetPowerModeResponse';
+  proto.viam.component.board.v1.StreamTicksResponse.displayName = 'proto.viam.component.board.v1.StreamTicksResponse';
 }
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.viam.component.board.v1.StatusRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.board.v1.StatusRequest.toObject(opt_includeInstance, this);
+proto.viam.component.board.v1.I2CReadWriteRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.viam.component.board.v1.I2CReadWriteRequest.repeatedFields_, null);
 };
-
-
+goog.inherits(proto.viam.component.board.v1.I2CReadWriteRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.board.v1.I2CReadWriteRequest.displayName = 'proto.viam.component.board.v1.I2CReadWriteRequest';
+}
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.viam.component.board.v1.StatusRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.viam.component.board.v1.StatusRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.viam.component.board.v1.I2COperation = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, proto.viam.component.board.v1.I2COperation.oneofGroups_);
 };
+goog.inherits(proto.viam.component.board.v1.I2COperation, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.board.v1.I2COperation.displayName = 'proto.viam.component.board.v1.I2COperation';
 }
-
-
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.board.v1.StatusRequest}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.viam.component.board.v1.StatusRequest.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.board.v1.StatusRequest;
-  return proto.viam.component.board.v1.StatusRequest.deserializeBinaryFromReader(msg, reader);
+proto.viam.component.board.v1.I2CReadWriteResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.viam.component.board.v1.I2CReadWriteResponse.repeatedFields_, null);
 };
-
-
+goog.inherits(proto.viam.component.board.v1.I2CReadWriteResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.board.v1.I2CReadWriteResponse.displayName = 'proto.viam.component.board.v1.I2CReadWriteResponse';
+}
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.viam.component.board.v1.StatusRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.board.v1.StatusRequest}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.viam.component.board.v1.StatusRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    case 99:
-      var value = new google_protobuf_struct_pb.Struct;
-      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setExtra(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.viam.component.board.v1.SPITransferRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.viam.component.board.v1.SPITransferRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.board.v1.SPITransferRequest.displayName = 'proto.viam.component.board.v1.SPITransferRequest';
+}
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.viam.component.board.v1.StatusRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.viam.component.board.v1.StatusRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.viam.component.board.v1.SPITransferResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.viam.component.board.v1.SPITransferResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.board.v1.SPITransferResponse.displayName = 'proto.viam.component.board.v1.SPITransferResponse';
+}
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.board.v1.StatusRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.viam.component.board.v1.StatusRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.board.v1.SerialStreamRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.component.board.v1.SerialStreamRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.board.v1.SerialStreamRequest.displayName = 'proto.viam.component.board.v1.SerialStreamRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.board.v1.SerialStreamResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.component.board.v1.SerialStreamResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.board.v1.SerialStreamResponse.displayName = 'proto.viam.component.board.v1.SerialStreamResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.board.v1.SetPowerModeRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.viam.component.board.v1.SetPowerModeRequest.repeatedFields_, null);
+};
+goog.inherits(proto.viam.component.board.v1.SetPowerModeRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.board.v1.SetPowerModeRequest.displayName = 'proto.viam.component.board.v1.SetPowerModeRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.board.v1.SetPowerModeResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.component.board.v1.SetPowerModeResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.board.v1.SetPowerModeResponse.displayName = 'proto.viam.component.board.v1.SetPowerModeResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.board.v1.WakeSource = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, proto.viam.component.board.v1.WakeSource.oneofGroups_);
+};
+goog.inherits(proto.viam.component.board.v1.WakeSource, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.board.v1.WakeSource.displayName = 'proto.viam.component.board.v1.WakeSource';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.board.v1.GPIOWakeSource = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.component.board.v1.GPIOWakeSource, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.board.v1.GPIOWakeSource.displayName = 'proto.viam.component.board.v1.GPIOWakeSource';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.board.v1.RTCWakeSource = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.component.board.v1.RTCWakeSource, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.board.v1.RTCWakeSource.displayName = 'proto.viam.component.board.v1.RTCWakeSource';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.board.v1.AnalogWakeSource = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.component.board.v1.AnalogWakeSource, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.board.v1.AnalogWakeSource.displayName = 'proto.viam.component.board.v1.AnalogWakeSource';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.board.v1.GetPowerModeRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.component.board.v1.GetPowerModeRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.board.v1.GetPowerModeRequest.displayName = 'proto.viam.component.board.v1.GetPowerModeRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.board.v1.GetPowerModeResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.component.board.v1.GetPowerModeResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.board.v1.GetPowerModeResponse.displayName = 'proto.viam.component.board.v1.GetPowerModeResponse';
+}
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.board.v1.StatusRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.StatusRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.board.v1.StatusRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.StatusRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.board.v1.StatusRequest}
+ */
+proto.viam.component.board.v1.StatusRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.board.v1.StatusRequest;
+  return proto.viam.component.board.v1.StatusRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.board.v1.StatusRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.board.v1.StatusRequest}
+ */
+proto.viam.component.board.v1.StatusRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.board.v1.StatusRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.board.v1.StatusRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.board.v1.StatusRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.StatusRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.viam.component.board.v1.StatusRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.board.v1.StatusRequest} returns this
+ */
+proto.viam.component.board.v1.StatusRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.component.board.v1.StatusRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.component.board.v1.StatusRequest} returns this
+*/
+proto.viam.component.board.v1.StatusRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.board.v1.StatusRequest} returns this
+ */
+proto.viam.component.board.v1.StatusRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.board.v1.StatusRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.board.v1.StatusResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.StatusResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.board.v1.StatusResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.StatusResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    status: (f = msg.getStatus()) && common_v1_common_pb.BoardStatus.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.board.v1.StatusResponse}
+ */
+proto.viam.component.board.v1.StatusResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.board.v1.StatusResponse;
+  return proto.viam.component.board.v1.StatusResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.board.v1.StatusResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.board.v1.StatusResponse}
+ */
+proto.viam.component.board.v1.StatusResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new common_v1_common_pb.BoardStatus;
+      reader.readMessage(value,common_v1_common_pb.BoardStatus.deserializeBinaryFromReader);
+      msg.setStatus(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.board.v1.StatusResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.board.v1.StatusResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.board.v1.StatusResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.StatusResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getStatus();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      common_v1_common_pb.BoardStatus.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional viam.common.v1.BoardStatus status = 1;
+ * @return {?proto.viam.common.v1.BoardStatus}
+ */
+proto.viam.component.board.v1.StatusResponse.prototype.getStatus = function() {
+  return /** @type{?proto.viam.common.v1.BoardStatus} */ (
+    jspb.Message.getWrapperField(this, common_v1_common_pb.BoardStatus, 1));
+};
+
+
+/**
+ * @param {?proto.viam.common.v1.BoardStatus|undefined} value
+ * @return {!proto.viam.component.board.v1.StatusResponse} returns this
+*/
+proto.viam.component.board.v1.StatusResponse.prototype.setStatus = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.board.v1.StatusResponse} returns this
+ */
+proto.viam.component.board.v1.StatusResponse.prototype.clearStatus = function() {
+  return this.setStatus(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.board.v1.StatusResponse.prototype.hasStatus = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.board.v1.SetGPIORequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.SetGPIORequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.board.v1.SetGPIORequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.SetGPIORequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    pin: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    high: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.board.v1.SetGPIORequest}
+ */
+proto.viam.component.board.v1.SetGPIORequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.board.v1.SetGPIORequest;
+  return proto.viam.component.board.v1.SetGPIORequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.board.v1.SetGPIORequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.board.v1.SetGPIORequest}
+ */
+proto.viam.component.board.v1.SetGPIORequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPin(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setHigh(value);
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.board.v1.SetGPIORequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.board.v1.SetGPIORequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.board.v1.SetGPIORequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.SetGPIORequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPin();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getHigh();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.viam.component.board.v1.SetGPIORequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.board.v1.SetGPIORequest} returns this
+ */
+proto.viam.component.board.v1.SetGPIORequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string pin = 2;
+ * @return {string}
+ */
+proto.viam.component.board.v1.SetGPIORequest.prototype.getPin = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.board.v1.SetGPIORequest} returns this
+ */
+proto.viam.component.board.v1.SetGPIORequest.prototype.setPin = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional bool high = 3;
+ * @return {boolean}
+ */
+proto.viam.component.board.v1.SetGPIORequest.prototype.getHigh = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.viam.component.board.v1.SetGPIORequest} returns this
+ */
+proto.viam.component.board.v1.SetGPIORequest.prototype.setHigh = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.component.board.v1.SetGPIORequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.component.board.v1.SetGPIORequest} returns this
+*/
+proto.viam.component.board.v1.SetGPIORequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.board.v1.SetGPIORequest} returns this
+ */
+proto.viam.component.board.v1.SetGPIORequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.board.v1.SetGPIORequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.board.v1.SetGPIOResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.SetGPIOResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.board.v1.SetGPIOResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.SetGPIOResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.board.v1.SetGPIOResponse}
+ */
+proto.viam.component.board.v1.SetGPIOResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.board.v1.SetGPIOResponse;
+  return proto.viam.component.board.v1.SetGPIOResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.board.v1.SetGPIOResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.board.v1.SetGPIOResponse}
+ */
+proto.viam.component.board.v1.SetGPIOResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.board.v1.SetGPIOResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.board.v1.SetGPIOResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.board.v1.SetGPIOResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.SetGPIOResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.board.v1.GetGPIORequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.GetGPIORequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.board.v1.GetGPIORequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.GetGPIORequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    pin: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.board.v1.GetGPIORequest}
+ */
+proto.viam.component.board.v1.GetGPIORequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.board.v1.GetGPIORequest;
+  return proto.viam.component.board.v1.GetGPIORequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.board.v1.GetGPIORequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.board.v1.GetGPIORequest}
+ */
+proto.viam.component.board.v1.GetGPIORequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPin(value);
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.board.v1.GetGPIORequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.board.v1.GetGPIORequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.board.v1.GetGPIORequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.GetGPIORequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPin();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.viam.component.board.v1.GetGPIORequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.board.v1.GetGPIORequest} returns this
+ */
+proto.viam.component.board.v1.GetGPIORequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string pin = 2;
+ * @return {string}
+ */
+proto.viam.component.board.v1.GetGPIORequest.prototype.getPin = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.board.v1.GetGPIORequest} returns this
+ */
+proto.viam.component.board.v1.GetGPIORequest.prototype.setPin = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.component.board.v1.GetGPIORequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.component.board.v1.GetGPIORequest} returns this
+*/
+proto.viam.component.board.v1.GetGPIORequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.board.v1.GetGPIORequest} returns this
+ */
+proto.viam.component.board.v1.GetGPIORequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.board.v1.GetGPIORequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.board.v1.GetGPIOResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.GetGPIOResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.board.v1.GetGPIOResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.GetGPIOResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    high: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.board.v1.GetGPIOResponse}
+ */
+proto.viam.component.board.v1.GetGPIOResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.board.v1.GetGPIOResponse;
+  return proto.viam.component.board.v1.GetGPIOResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.board.v1.GetGPIOResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.board.v1.GetGPIOResponse}
+ */
+proto.viam.component.board.v1.GetGPIOResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setHigh(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.board.v1.GetGPIOResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.board.v1.GetGPIOResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.board.v1.GetGPIOResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.GetGPIOResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getHigh();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool high = 1;
+ * @return {boolean}
+ */
+proto.viam.component.board.v1.GetGPIOResponse.prototype.getHigh = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.viam.component.board.v1.GetGPIOResponse} returns this
+ */
+proto.viam.component.board.v1.GetGPIOResponse.prototype.setHigh = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.component.board.v1.StreamGPIOEdgesRequest.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.board.v1.StreamGPIOEdgesRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.StreamGPIOEdgesRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.board.v1.StreamGPIOEdgesRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.StreamGPIOEdgesRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    pinsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+    edge: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.board.v1.StreamGPIOEdgesRequest}
+ */
+proto.viam.component.board.v1.StreamGPIOEdgesRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.board.v1.StreamGPIOEdgesRequest;
+  return proto.viam.component.board.v1.StreamGPIOEdgesRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.board.v1.StreamGPIOEdgesRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.board.v1.StreamGPIOEdgesRequest}
+ */
+proto.viam.component.board.v1.StreamGPIOEdgesRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addPins(value);
+      break;
+    case 3:
+      var value = /** @type {!proto.viam.component.board.v1.Edge} */ (reader.readEnum());
+      msg.setEdge(value);
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.board.v1.StreamGPIOEdgesRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.board.v1.StreamGPIOEdgesRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.board.v1.StreamGPIOEdgesRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.StreamGPIOEdgesRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPinsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
+  f = message.getEdge();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      3,
+      f
+    );
+  }
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.viam.component.board.v1.StreamGPIOEdgesRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.board.v1.StreamGPIOEdgesRequest} returns this
+ */
+proto.viam.component.board.v1.StreamGPIOEdgesRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * repeated string pins = 2;
+ * @return {!Array<string>}
+ */
+proto.viam.component.board.v1.StreamGPIOEdgesRequest.prototype.getPinsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.viam.component.board.v1.StreamGPIOEdgesRequest} returns this
+ */
+proto.viam.component.board.v1.StreamGPIOEdgesRequest.prototype.setPinsList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.viam.component.board.v1.StreamGPIOEdgesRequest} returns this
+ */
+proto.viam.component.board.v1.StreamGPIOEdgesRequest.prototype.addPins = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.component.board.v1.StreamGPIOEdgesRequest} returns this
+ */
+proto.viam.component.board.v1.StreamGPIOEdgesRequest.prototype.clearPinsList = function() {
+  return this.setPinsList([]);
+};
+
+
+/**
+ * optional Edge edge = 3;
+ * @return {!proto.viam.component.board.v1.Edge}
+ */
+proto.viam.component.board.v1.StreamGPIOEdgesRequest.prototype.getEdge = function() {
+  return /** @type {!proto.viam.component.board.v1.Edge} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {!proto.viam.component.board.v1.Edge} value
+ * @return {!proto.viam.component.board.v1.StreamGPIOEdgesRequest} returns this
+ */
+proto.viam.component.board.v1.StreamGPIOEdgesRequest.prototype.setEdge = function(value) {
+  return jspb.Message.setProto3EnumField(this, 3, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.component.board.v1.StreamGPIOEdgesRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.component.board.v1.StreamGPIOEdgesRequest} returns this
+*/
+proto.viam.component.board.v1.StreamGPIOEdgesRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.board.v1.StreamGPIOEdgesRequest} returns this
+ */
+proto.viam.component.board.v1.StreamGPIOEdgesRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.board.v1.StreamGPIOEdgesRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.board.v1.StreamGPIOEdgesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.StreamGPIOEdgesResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.board.v1.StreamGPIOEdgesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.StreamGPIOEdgesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    pin: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    high: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    time: (f = msg.getTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.board.v1.StreamGPIOEdgesResponse}
+ */
+proto.viam.component.board.v1.StreamGPIOEdgesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.board.v1.StreamGPIOEdgesResponse;
+  return proto.viam.component.board.v1.StreamGPIOEdgesResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.board.v1.StreamGPIOEdgesResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.board.v1.StreamGPIOEdgesResponse}
+ */
+proto.viam.component.board.v1.StreamGPIOEdgesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPin(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setHigh(value);
+      break;
+    case 3:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setTime(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.board.v1.StreamGPIOEdgesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.board.v1.StreamGPIOEdgesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.board.v1.StreamGPIOEdgesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.StreamGPIOEdgesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPin();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getHigh();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = message.getTime();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string pin = 1;
+ * @return {string}
+ */
+proto.viam.component.board.v1.StreamGPIOEdgesResponse.prototype.getPin = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.board.v1.StreamGPIOEdgesResponse} returns this
+ */
+proto.viam.component.board.v1.StreamGPIOEdgesResponse.prototype.setPin = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional bool high = 2;
+ * @return {boolean}
+ */
+proto.viam.component.board.v1.StreamGPIOEdgesResponse.prototype.getHigh = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.viam.component.board.v1.StreamGPIOEdgesResponse} returns this
+ */
+proto.viam.component.board.v1.StreamGPIOEdgesResponse.prototype.setHigh = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp time = 3;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.viam.component.board.v1.StreamGPIOEdgesResponse.prototype.getTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.viam.component.board.v1.StreamGPIOEdgesResponse} returns this
+*/
+proto.viam.component.board.v1.StreamGPIOEdgesResponse.prototype.setTime = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.board.v1.StreamGPIOEdgesResponse} returns this
+ */
+proto.viam.component.board.v1.StreamGPIOEdgesResponse.prototype.clearTime = function() {
+  return this.setTime(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.board.v1.StreamGPIOEdgesResponse.prototype.hasTime = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.board.v1.PWMRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.PWMRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.board.v1.PWMRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.PWMRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    pin: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.board.v1.PWMRequest}
+ */
+proto.viam.component.board.v1.PWMRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.board.v1.PWMRequest;
+  return proto.viam.component.board.v1.PWMRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.board.v1.PWMRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.board.v1.PWMRequest}
+ */
+proto.viam.component.board.v1.PWMRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPin(value);
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.board.v1.PWMRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.board.v1.PWMRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.board.v1.PWMRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.PWMRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPin();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.viam.component.board.v1.PWMRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.board.v1.PWMRequest} returns this
+ */
+proto.viam.component.board.v1.PWMRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string pin = 2;
+ * @return {string}
+ */
+proto.viam.component.board.v1.PWMRequest.prototype.getPin = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.board.v1.PWMRequest} returns this
+ */
+proto.viam.component.board.v1.PWMRequest.prototype.setPin = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.component.board.v1.PWMRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.component.board.v1.PWMRequest} returns this
+*/
+proto.viam.component.board.v1.PWMRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.board.v1.PWMRequest} returns this
+ */
+proto.viam.component.board.v1.PWMRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.board.v1.PWMRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.board.v1.PWMResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.PWMResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.board.v1.PWMResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.PWMResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    dutyCyclePct: jspb.Message.getFloatingPointFieldWithDefault(msg, 1, 0.0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.board.v1.PWMResponse}
+ */
+proto.viam.component.board.v1.PWMResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.board.v1.PWMResponse;
+  return proto.viam.component.board.v1.PWMResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.board.v1.PWMResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.board.v1.PWMResponse}
+ */
+proto.viam.component.board.v1.PWMResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setDutyCyclePct(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.board.v1.PWMResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.board.v1.PWMResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.board.v1.PWMResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.PWMResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDutyCyclePct();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional double duty_cycle_pct = 1;
+ * @return {number}
+ */
+proto.viam.component.board.v1.PWMResponse.prototype.getDutyCyclePct = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 1, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.board.v1.PWMResponse} returns this
+ */
+proto.viam.component.board.v1.PWMResponse.prototype.setDutyCyclePct = function(value) {
+  return jspb.Message.setProto3FloatField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.board.v1.SetPWMRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.SetPWMRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.board.v1.SetPWMRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.SetPWMRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    pin: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    dutyCyclePct: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.board.v1.SetPWMRequest}
+ */
+proto.viam.component.board.v1.SetPWMRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.board.v1.SetPWMRequest;
+  return proto.viam.component.board.v1.SetPWMRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.board.v1.SetPWMRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.board.v1.SetPWMRequest}
+ */
+proto.viam.component.board.v1.SetPWMRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPin(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setDutyCyclePct(value);
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.board.v1.SetPWMRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.board.v1.SetPWMRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.board.v1.SetPWMRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.SetPWMRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPin();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getDutyCyclePct();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      3,
+      f
+    );
+  }
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.viam.component.board.v1.SetPWMRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.board.v1.SetPWMRequest} returns this
+ */
+proto.viam.component.board.v1.SetPWMRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string pin = 2;
+ * @return {string}
+ */
+proto.viam.component.board.v1.SetPWMRequest.prototype.getPin = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.board.v1.SetPWMRequest} returns this
+ */
+proto.viam.component.board.v1.SetPWMRequest.prototype.setPin = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional double duty_cycle_pct = 3;
+ * @return {number}
+ */
+proto.viam.component.board.v1.SetPWMRequest.prototype.getDutyCyclePct = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 3, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.board.v1.SetPWMRequest} returns this
+ */
+proto.viam.component.board.v1.SetPWMRequest.prototype.setDutyCyclePct = function(value) {
+  return jspb.Message.setProto3FloatField(this, 3, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.component.board.v1.SetPWMRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.component.board.v1.SetPWMRequest} returns this
+*/
+proto.viam.component.board.v1.SetPWMRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.board.v1.SetPWMRequest} returns this
+ */
+proto.viam.component.board.v1.SetPWMRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.board.v1.SetPWMRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.board.v1.SetPWMResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.SetPWMResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.board.v1.SetPWMResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.SetPWMResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.board.v1.SetPWMResponse}
+ */
+proto.viam.component.board.v1.SetPWMResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.board.v1.SetPWMResponse;
+  return proto.viam.component.board.v1.SetPWMResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.board.v1.SetPWMResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.board.v1.SetPWMResponse}
+ */
+proto.viam.component.board.v1.SetPWMResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.board.v1.SetPWMResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.board.v1.SetPWMResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.board.v1.SetPWMResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.SetPWMResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.board.v1.PWMFrequencyRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.PWMFrequencyRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.board.v1.PWMFrequencyRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.PWMFrequencyRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    pin: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.board.v1.PWMFrequencyRequest}
+ */
+proto.viam.component.board.v1.PWMFrequencyRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.board.v1.PWMFrequencyRequest;
+  return proto.viam.component.board.v1.PWMFrequencyRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.board.v1.PWMFrequencyRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.board.v1.PWMFrequencyRequest}
+ */
+proto.viam.component.board.v1.PWMFrequencyRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPin(value);
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.board.v1.PWMFrequencyRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.board.v1.PWMFrequencyRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.board.v1.PWMFrequencyRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.PWMFrequencyRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPin();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.viam.component.board.v1.PWMFrequencyRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.board.v1.PWMFrequencyRequest} returns this
+ */
+proto.viam.component.board.v1.PWMFrequencyRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string pin = 2;
+ * @return {string}
+ */
+proto.viam.component.board.v1.PWMFrequencyRequest.prototype.getPin = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.board.v1.PWMFrequencyRequest} returns this
+ */
+proto.viam.component.board.v1.PWMFrequencyRequest.prototype.setPin = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.component.board.v1.PWMFrequencyRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.component.board.v1.PWMFrequencyRequest} returns this
+*/
+proto.viam.component.board.v1.PWMFrequencyRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.board.v1.PWMFrequencyRequest} returns this
+ */
+proto.viam.component.board.v1.PWMFrequencyRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.board.v1.PWMFrequencyRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.board.v1.PWMFrequencyResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.PWMFrequencyResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.board.v1.PWMFrequencyResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.PWMFrequencyResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    frequencyHz: jspb.Message.getFieldWithDefault(msg, 1, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.board.v1.PWMFrequencyResponse}
+ */
+proto.viam.component.board.v1.PWMFrequencyResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.board.v1.PWMFrequencyResponse;
+  return proto.viam.component.board.v1.PWMFrequencyResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.board.v1.PWMFrequencyResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.board.v1.PWMFrequencyResponse}
+ */
+proto.viam.component.board.v1.PWMFrequencyResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setFrequencyHz(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.board.v1.PWMFrequencyResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.board.v1.PWMFrequencyResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.board.v1.PWMFrequencyResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.PWMFrequencyResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getFrequencyHz();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 frequency_hz = 1;
+ * @return {number}
+ */
+proto.viam.component.board.v1.PWMFrequencyResponse.prototype.getFrequencyHz = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.board.v1.PWMFrequencyResponse} returns this
+ */
+proto.viam.component.board.v1.PWMFrequencyResponse.prototype.setFrequencyHz = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.board.v1.SetPWMFrequencyRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.SetPWMFrequencyRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.board.v1.SetPWMFrequencyRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.SetPWMFrequencyRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    pin: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    frequencyHz: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.board.v1.SetPWMFrequencyRequest}
+ */
+proto.viam.component.board.v1.SetPWMFrequencyRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.board.v1.SetPWMFrequencyRequest;
+  return proto.viam.component.board.v1.SetPWMFrequencyRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.board.v1.SetPWMFrequencyRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.board.v1.SetPWMFrequencyRequest}
+ */
+proto.viam.component.board.v1.SetPWMFrequencyRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPin(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setFrequencyHz(value);
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.board.v1.SetPWMFrequencyRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.board.v1.SetPWMFrequencyRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.board.v1.SetPWMFrequencyRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.SetPWMFrequencyRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
   f = message.getName();
   if (f.length > 0) {
     writer.writeString(
@@ -579,6 +3552,1560 @@ proto.viam.component.board.v1.StatusRequest.serializeBinaryToWriter = function(m
       f
     );
   }
+  f = message.getPin();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getFrequencyHz();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.viam.component.board.v1.SetPWMFrequencyRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.board.v1.SetPWMFrequencyRequest} returns this
+ */
+proto.viam.component.board.v1.SetPWMFrequencyRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string pin = 2;
+ * @return {string}
+ */
+proto.viam.component.board.v1.SetPWMFrequencyRequest.prototype.getPin = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.board.v1.SetPWMFrequencyRequest} returns this
+ */
+proto.viam.component.board.v1.SetPWMFrequencyRequest.prototype.setPin = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 frequency_hz = 3;
+ * @return {number}
+ */
+proto.viam.component.board.v1.SetPWMFrequencyRequest.prototype.getFrequencyHz = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.board.v1.SetPWMFrequencyRequest} returns this
+ */
+proto.viam.component.board.v1.SetPWMFrequencyRequest.prototype.setFrequencyHz = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.component.board.v1.SetPWMFrequencyRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.component.board.v1.SetPWMFrequencyRequest} returns this
+*/
+proto.viam.component.board.v1.SetPWMFrequencyRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.board.v1.SetPWMFrequencyRequest} returns this
+ */
+proto.viam.component.board.v1.SetPWMFrequencyRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.board.v1.SetPWMFrequencyRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.board.v1.SetPWMFrequencyResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.SetPWMFrequencyResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.board.v1.SetPWMFrequencyResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.SetPWMFrequencyResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.board.v1.SetPWMFrequencyResponse}
+ */
+proto.viam.component.board.v1.SetPWMFrequencyResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.board.v1.SetPWMFrequencyResponse;
+  return proto.viam.component.board.v1.SetPWMFrequencyResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.board.v1.SetPWMFrequencyResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.board.v1.SetPWMFrequencyResponse}
+ */
+proto.viam.component.board.v1.SetPWMFrequencyResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.board.v1.SetPWMFrequencyResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.board.v1.SetPWMFrequencyResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.board.v1.SetPWMFrequencyResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.SetPWMFrequencyResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.board.v1.ReadAnalogReaderRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.ReadAnalogReaderRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.board.v1.ReadAnalogReaderRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.ReadAnalogReaderRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    boardName: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    analogReaderName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.board.v1.ReadAnalogReaderRequest}
+ */
+proto.viam.component.board.v1.ReadAnalogReaderRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.board.v1.ReadAnalogReaderRequest;
+  return proto.viam.component.board.v1.ReadAnalogReaderRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.board.v1.ReadAnalogReaderRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.board.v1.ReadAnalogReaderRequest}
+ */
+proto.viam.component.board.v1.ReadAnalogReaderRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBoardName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAnalogReaderName(value);
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.board.v1.ReadAnalogReaderRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.board.v1.ReadAnalogReaderRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.board.v1.ReadAnalogReaderRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.ReadAnalogReaderRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getBoardName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getAnalogReaderName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string board_name = 1;
+ * @return {string}
+ */
+proto.viam.component.board.v1.ReadAnalogReaderRequest.prototype.getBoardName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.board.v1.ReadAnalogReaderRequest} returns this
+ */
+proto.viam.component.board.v1.ReadAnalogReaderRequest.prototype.setBoardName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string analog_reader_name = 2;
+ * @return {string}
+ */
+proto.viam.component.board.v1.ReadAnalogReaderRequest.prototype.getAnalogReaderName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.board.v1.ReadAnalogReaderRequest} returns this
+ */
+proto.viam.component.board.v1.ReadAnalogReaderRequest.prototype.setAnalogReaderName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.component.board.v1.ReadAnalogReaderRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.component.board.v1.ReadAnalogReaderRequest} returns this
+*/
+proto.viam.component.board.v1.ReadAnalogReaderRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.board.v1.ReadAnalogReaderRequest} returns this
+ */
+proto.viam.component.board.v1.ReadAnalogReaderRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.board.v1.ReadAnalogReaderRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.board.v1.ReadAnalogReaderResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.ReadAnalogReaderResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.board.v1.ReadAnalogReaderResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.ReadAnalogReaderResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    value: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    voltage: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0),
+    minRange: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0),
+    maxRange: jspb.Message.getFloatingPointFieldWithDefault(msg, 4, 0.0),
+    stepSize: jspb.Message.getFloatingPointFieldWithDefault(msg, 5, 0.0),
+    time: (f = msg.getTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.board.v1.ReadAnalogReaderResponse}
+ */
+proto.viam.component.board.v1.ReadAnalogReaderResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.board.v1.ReadAnalogReaderResponse;
+  return proto.viam.component.board.v1.ReadAnalogReaderResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.board.v1.ReadAnalogReaderResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.board.v1.ReadAnalogReaderResponse}
+ */
+proto.viam.component.board.v1.ReadAnalogReaderResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setValue(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readFloat());
+      msg.setVoltage(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readFloat());
+      msg.setMinRange(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readFloat());
+      msg.setMaxRange(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readFloat());
+      msg.setStepSize(value);
+      break;
+    case 6:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setTime(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.board.v1.ReadAnalogReaderResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.board.v1.ReadAnalogReaderResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.board.v1.ReadAnalogReaderResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.ReadAnalogReaderResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getValue();
+  if (f !== 0) {
+    writer.writeInt32(
+      1,
+      f
+    );
+  }
+  f = message.getVoltage();
+  if (f !== 0.0) {
+    writer.writeFloat(
+      2,
+      f
+    );
+  }
+  f = message.getMinRange();
+  if (f !== 0.0) {
+    writer.writeFloat(
+      3,
+      f
+    );
+  }
+  f = message.getMaxRange();
+  if (f !== 0.0) {
+    writer.writeFloat(
+      4,
+      f
+    );
+  }
+  f = message.getStepSize();
+  if (f !== 0.0) {
+    writer.writeFloat(
+      5,
+      f
+    );
+  }
+  f = message.getTime();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional int32 value = 1;
+ * @return {number}
+ */
+proto.viam.component.board.v1.ReadAnalogReaderResponse.prototype.getValue = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.board.v1.ReadAnalogReaderResponse} returns this
+ */
+proto.viam.component.board.v1.ReadAnalogReaderResponse.prototype.setValue = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional float voltage = 2;
+ * @return {number}
+ */
+proto.viam.component.board.v1.ReadAnalogReaderResponse.prototype.getVoltage = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.board.v1.ReadAnalogReaderResponse} returns this
+ */
+proto.viam.component.board.v1.ReadAnalogReaderResponse.prototype.setVoltage = function(value) {
+  return jspb.Message.setProto3FloatField(this, 2, value);
+};
+
+
+/**
+ * optional float min_range = 3;
+ * @return {number}
+ */
+proto.viam.component.board.v1.ReadAnalogReaderResponse.prototype.getMinRange = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 3, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.board.v1.ReadAnalogReaderResponse} returns this
+ */
+proto.viam.component.board.v1.ReadAnalogReaderResponse.prototype.setMinRange = function(value) {
+  return jspb.Message.setProto3FloatField(this, 3, value);
+};
+
+
+/**
+ * optional float max_range = 4;
+ * @return {number}
+ */
+proto.viam.component.board.v1.ReadAnalogReaderResponse.prototype.getMaxRange = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 4, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.board.v1.ReadAnalogReaderResponse} returns this
+ */
+proto.viam.component.board.v1.ReadAnalogReaderResponse.prototype.setMaxRange = function(value) {
+  return jspb.Message.setProto3FloatField(this, 4, value);
+};
+
+
+/**
+ * optional float step_size = 5;
+ * @return {number}
+ */
+proto.viam.component.board.v1.ReadAnalogReaderResponse.prototype.getStepSize = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 5, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.board.v1.ReadAnalogReaderResponse} returns this
+ */
+proto.viam.component.board.v1.ReadAnalogReaderResponse.prototype.setStepSize = function(value) {
+  return jspb.Message.setProto3FloatField(this, 5, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp time = 6;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.viam.component.board.v1.ReadAnalogReaderResponse.prototype.getTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 6));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.viam.component.board.v1.ReadAnalogReaderResponse} returns this
+*/
+proto.viam.component.board.v1.ReadAnalogReaderResponse.prototype.setTime = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.board.v1.ReadAnalogReaderResponse} returns this
+ */
+proto.viam.component.board.v1.ReadAnalogReaderResponse.prototype.clearTime = function() {
+  return this.setTime(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.board.v1.ReadAnalogReaderResponse.prototype.hasTime = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.component.board.v1.StreamAnalogReadersRequest.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.board.v1.StreamAnalogReadersRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.StreamAnalogReadersRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.board.v1.StreamAnalogReadersRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.StreamAnalogReadersRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    boardName: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    analogReaderNamesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+    sampleRateHz: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0),
+    samplesPerBatch: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.board.v1.StreamAnalogReadersRequest}
+ */
+proto.viam.component.board.v1.StreamAnalogReadersRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.board.v1.StreamAnalogReadersRequest;
+  return proto.viam.component.board.v1.StreamAnalogReadersRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.board.v1.StreamAnalogReadersRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.board.v1.StreamAnalogReadersRequest}
+ */
+proto.viam.component.board.v1.StreamAnalogReadersRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBoardName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addAnalogReaderNames(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setSampleRateHz(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setSamplesPerBatch(value);
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.board.v1.StreamAnalogReadersRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.board.v1.StreamAnalogReadersRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.board.v1.StreamAnalogReadersRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.StreamAnalogReadersRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getBoardName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getAnalogReaderNamesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
+  f = message.getSampleRateHz();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      3,
+      f
+    );
+  }
+  f = message.getSamplesPerBatch();
+  if (f !== 0) {
+    writer.writeUint32(
+      4,
+      f
+    );
+  }
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string board_name = 1;
+ * @return {string}
+ */
+proto.viam.component.board.v1.StreamAnalogReadersRequest.prototype.getBoardName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.board.v1.StreamAnalogReadersRequest} returns this
+ */
+proto.viam.component.board.v1.StreamAnalogReadersRequest.prototype.setBoardName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * repeated string analog_reader_names = 2;
+ * @return {!Array<string>}
+ */
+proto.viam.component.board.v1.StreamAnalogReadersRequest.prototype.getAnalogReaderNamesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.viam.component.board.v1.StreamAnalogReadersRequest} returns this
+ */
+proto.viam.component.board.v1.StreamAnalogReadersRequest.prototype.setAnalogReaderNamesList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.viam.component.board.v1.StreamAnalogReadersRequest} returns this
+ */
+proto.viam.component.board.v1.StreamAnalogReadersRequest.prototype.addAnalogReaderNames = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.component.board.v1.StreamAnalogReadersRequest} returns this
+ */
+proto.viam.component.board.v1.StreamAnalogReadersRequest.prototype.clearAnalogReaderNamesList = function() {
+  return this.setAnalogReaderNamesList([]);
+};
+
+
+/**
+ * optional double sample_rate_hz = 3;
+ * @return {number}
+ */
+proto.viam.component.board.v1.StreamAnalogReadersRequest.prototype.getSampleRateHz = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 3, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.board.v1.StreamAnalogReadersRequest} returns this
+ */
+proto.viam.component.board.v1.StreamAnalogReadersRequest.prototype.setSampleRateHz = function(value) {
+  return jspb.Message.setProto3FloatField(this, 3, value);
+};
+
+
+/**
+ * optional uint32 samples_per_batch = 4;
+ * @return {number}
+ */
+proto.viam.component.board.v1.StreamAnalogReadersRequest.prototype.getSamplesPerBatch = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.board.v1.StreamAnalogReadersRequest} returns this
+ */
+proto.viam.component.board.v1.StreamAnalogReadersRequest.prototype.setSamplesPerBatch = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.component.board.v1.StreamAnalogReadersRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.component.board.v1.StreamAnalogReadersRequest} returns this
+*/
+proto.viam.component.board.v1.StreamAnalogReadersRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.board.v1.StreamAnalogReadersRequest} returns this
+ */
+proto.viam.component.board.v1.StreamAnalogReadersRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.board.v1.StreamAnalogReadersRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.component.board.v1.StreamAnalogReadersResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.board.v1.StreamAnalogReadersResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.StreamAnalogReadersResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.board.v1.StreamAnalogReadersResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.StreamAnalogReadersResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    samplesList: jspb.Message.toObjectList(msg.getSamplesList(),
+    proto.viam.component.board.v1.AnalogSample.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.board.v1.StreamAnalogReadersResponse}
+ */
+proto.viam.component.board.v1.StreamAnalogReadersResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.board.v1.StreamAnalogReadersResponse;
+  return proto.viam.component.board.v1.StreamAnalogReadersResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.board.v1.StreamAnalogReadersResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.board.v1.StreamAnalogReadersResponse}
+ */
+proto.viam.component.board.v1.StreamAnalogReadersResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.viam.component.board.v1.AnalogSample;
+      reader.readMessage(value,proto.viam.component.board.v1.AnalogSample.deserializeBinaryFromReader);
+      msg.addSamples(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.board.v1.StreamAnalogReadersResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.board.v1.StreamAnalogReadersResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.board.v1.StreamAnalogReadersResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.StreamAnalogReadersResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSamplesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.viam.component.board.v1.AnalogSample.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated AnalogSample samples = 1;
+ * @return {!Array<!proto.viam.component.board.v1.AnalogSample>}
+ */
+proto.viam.component.board.v1.StreamAnalogReadersResponse.prototype.getSamplesList = function() {
+  return /** @type{!Array<!proto.viam.component.board.v1.AnalogSample>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.viam.component.board.v1.AnalogSample, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.viam.component.board.v1.AnalogSample>} value
+ * @return {!proto.viam.component.board.v1.StreamAnalogReadersResponse} returns this
+*/
+proto.viam.component.board.v1.StreamAnalogReadersResponse.prototype.setSamplesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.viam.component.board.v1.AnalogSample=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.component.board.v1.AnalogSample}
+ */
+proto.viam.component.board.v1.StreamAnalogReadersResponse.prototype.addSamples = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.viam.component.board.v1.AnalogSample, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.component.board.v1.StreamAnalogReadersResponse} returns this
+ */
+proto.viam.component.board.v1.StreamAnalogReadersResponse.prototype.clearSamplesList = function() {
+  return this.setSamplesList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.board.v1.AnalogSample.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.AnalogSample.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.board.v1.AnalogSample} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.AnalogSample.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    analogReaderName: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    value: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    voltage: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0),
+    time: (f = msg.getTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.board.v1.AnalogSample}
+ */
+proto.viam.component.board.v1.AnalogSample.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.board.v1.AnalogSample;
+  return proto.viam.component.board.v1.AnalogSample.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.board.v1.AnalogSample} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.board.v1.AnalogSample}
+ */
+proto.viam.component.board.v1.AnalogSample.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAnalogReaderName(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setValue(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readFloat());
+      msg.setVoltage(value);
+      break;
+    case 4:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setTime(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.board.v1.AnalogSample.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.board.v1.AnalogSample.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.board.v1.AnalogSample} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.AnalogSample.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAnalogReaderName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getValue();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getVoltage();
+  if (f !== 0.0) {
+    writer.writeFloat(
+      3,
+      f
+    );
+  }
+  f = message.getTime();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string analog_reader_name = 1;
+ * @return {string}
+ */
+proto.viam.component.board.v1.AnalogSample.prototype.getAnalogReaderName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.board.v1.AnalogSample} returns this
+ */
+proto.viam.component.board.v1.AnalogSample.prototype.setAnalogReaderName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int32 value = 2;
+ * @return {number}
+ */
+proto.viam.component.board.v1.AnalogSample.prototype.getValue = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.board.v1.AnalogSample} returns this
+ */
+proto.viam.component.board.v1.AnalogSample.prototype.setValue = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional float voltage = 3;
+ * @return {number}
+ */
+proto.viam.component.board.v1.AnalogSample.prototype.getVoltage = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 3, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.board.v1.AnalogSample} returns this
+ */
+proto.viam.component.board.v1.AnalogSample.prototype.setVoltage = function(value) {
+  return jspb.Message.setProto3FloatField(this, 3, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp time = 4;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.viam.component.board.v1.AnalogSample.prototype.getTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 4));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.viam.component.board.v1.AnalogSample} returns this
+*/
+proto.viam.component.board.v1.AnalogSample.prototype.setTime = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.board.v1.AnalogSample} returns this
+ */
+proto.viam.component.board.v1.AnalogSample.prototype.clearTime = function() {
+  return this.setTime(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.board.v1.AnalogSample.prototype.hasTime = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.board.v1.GetDigitalInterruptValueRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.GetDigitalInterruptValueRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.board.v1.GetDigitalInterruptValueRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.GetDigitalInterruptValueRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    boardName: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    digitalInterruptName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.board.v1.GetDigitalInterruptValueRequest}
+ */
+proto.viam.component.board.v1.GetDigitalInterruptValueRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.board.v1.GetDigitalInterruptValueRequest;
+  return proto.viam.component.board.v1.GetDigitalInterruptValueRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.board.v1.GetDigitalInterruptValueRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.board.v1.GetDigitalInterruptValueRequest}
+ */
+proto.viam.component.board.v1.GetDigitalInterruptValueRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBoardName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDigitalInterruptName(value);
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.board.v1.GetDigitalInterruptValueRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.board.v1.GetDigitalInterruptValueRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.board.v1.GetDigitalInterruptValueRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.board.v1.GetDigitalInterruptValueRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getBoardName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDigitalInterruptName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
   f = message.getExtra();
   if (f != null) {
     writer.writeMessage(
@@ -591,28 +5118,46 @@ proto.viam.component.board.v1.StatusRequest.serializeBinaryToWriter = function(m
 
 
 /**
- * optional string name = 1;
+ * optional string board_name = 1;
  * @return {string}
  */
-proto.viam.component.board.v1.StatusRequest.prototype.getName = function() {
+proto.viam.component.board.v1.GetDigitalInterruptValueRequest.prototype.getBoardName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.viam.component.board.v1.StatusRequest} returns this
+ * @return {!proto.viam.component.board.v1.GetDigitalInterruptValueRequest} returns this
  */
-proto.viam.component.board.v1.StatusRequest.prototype.setName = function(value) {
+proto.viam.component.board.v1.GetDigitalInterruptValueRequest.prototype.setBoardName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional string digital_interrupt_name = 2;
+ * @return {string}
+ */
+proto.viam.component.board.v1.GetDigitalInterruptValueRequest.prototype.getDigitalInterruptName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.board.v1.GetDigitalInterruptValueRequest} returns this
+ */
+proto.viam.component.board.v1.GetDigitalInterruptValueRequest.prototype.setDigitalInterruptName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
 /**
  * optional google.protobuf.Struct extra = 99;
  * @return {?proto.google.protobuf.Struct}
  */
-proto.viam.component.board.v1.StatusRequest.prototype.getExtra = function() {
+proto.viam.component.board.v1.GetDigitalInterruptValueRequest.prototype.getExtra = function() {
   return /** @type{?proto.google.protobuf.Struct} */ (
     jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
 };
@@ -620,18 +5165,18 @@ proto.viam.component.board.v1.StatusRequest.prototype.getExtra = function() {
 
 /**
  * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.viam.component.board.v1.StatusRequest} returns this
+ * @return {!proto.viam.component.board.v1.GetDigitalInterruptValueRequest} returns this
 */
-proto.viam.component.board.v1.StatusRequest.prototype.setExtra = function(value) {
+proto.viam.component.board.v1.GetDigitalInterruptValueRequest.prototype.setExtra = function(value) {
   return jspb.Message.setWrapperField(this, 99, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.viam.component.board.v1.StatusRequest} returns this
+ * @return {!proto.viam.component.board.v1.GetDigitalInterruptValueRequest} returns this
  */
-proto.viam.component.board.v1.StatusRequest.prototype.clearExtra = function() {
+proto.viam.component.board.v1.GetDigitalInterruptValueRequest.prototype.clearExtra = function() {
   return this.setExtra(undefined);
 };
 
@@ -640,7 +5185,7 @@ proto.viam.component.board.v1.StatusRequest.prototype.clearExtra = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.component.board.v1.StatusRequest.prototype.hasExtra = function() {
+proto.viam.component.board.v1.GetDigitalInterruptValueRequest.prototype.hasExtra = function() {
   return jspb.Message.getField(this, 99) != null;
 };
 
@@ -661,8 +5206,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.board.v1.StatusResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.board.v1.StatusResponse.toObject(opt_includeInstance, this);
+proto.viam.component.board.v1.GetDigitalInterruptValueResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.GetDigitalInterruptValueResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -671,13 +5216,13 @@ proto.viam.component.board.v1.StatusResponse.prototype.toObject = function(opt_i
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.board.v1.StatusResponse} msg The msg instance to transform.
+ * @param {!proto.viam.component.board.v1.GetDigitalInterruptValueResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.board.v1.StatusResponse.toObject = function(includeInstance, msg) {
+proto.viam.component.board.v1.GetDigitalInterruptValueResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    status: (f = msg.getStatus()) && common_v1_common_pb.BoardStatus.toObject(includeInstance, f)
+    value: jspb.Message.getFieldWithDefault(msg, 1, 0)
   };
 
   if (includeInstance) {
@@ -691,23 +5236,23 @@ proto.viam.component.board.v1.StatusResponse.toObject = function(includeInstance
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.board.v1.StatusResponse}
+ * @return {!proto.viam.component.board.v1.GetDigitalInterruptValueResponse}
  */
-proto.viam.component.board.v1.StatusResponse.deserializeBinary = function(bytes) {
+proto.viam.component.board.v1.GetDigitalInterruptValueResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.board.v1.StatusResponse;
-  return proto.viam.component.board.v1.StatusResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.board.v1.GetDigitalInterruptValueResponse;
+  return proto.viam.component.board.v1.GetDigitalInterruptValueResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.board.v1.StatusResponse} msg The message object to deserialize into.
+ * @param {!proto.viam.component.board.v1.GetDigitalInterruptValueResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.board.v1.StatusResponse}
+ * @return {!proto.viam.component.board.v1.GetDigitalInterruptValueResponse}
  */
-proto.viam.component.board.v1.StatusResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.board.v1.GetDigitalInterruptValueResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -715,9 +5260,8 @@ proto.viam.component.board.v1.StatusResponse.deserializeBinaryFromReader = funct
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new common_v1_common_pb.BoardStatus;
-      reader.readMessage(value,common_v1_common_pb.BoardStatus.deserializeBinaryFromReader);
-      msg.setStatus(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setValue(value);
       break;
     default:
       reader.skipField();
@@ -732,9 +5276,9 @@ proto.viam.component.board.v1.StatusResponse.deserializeBinaryFromReader = funct
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.board.v1.StatusResponse.prototype.serializeBinary = function() {
+proto.viam.component.board.v1.GetDigitalInterruptValueResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.board.v1.StatusResponse.serializeBinaryToWriter(this, writer);
+  proto.viam.component.board.v1.GetDigitalInterruptValueResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -742,60 +5286,47 @@ proto.viam.component.board.v1.StatusResponse.prototype.serializeBinary = functio
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.board.v1.StatusResponse} message
+ * @param {!proto.viam.component.board.v1.GetDigitalInterruptValueResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.board.v1.StatusResponse.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.board.v1.GetDigitalInterruptValueResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getStatus();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getValue();
+  if (f !== 0) {
+    writer.writeInt64(
       1,
-      f,
-      common_v1_common_pb.BoardStatus.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * optional viam.common.v1.BoardStatus status = 1;
- * @return {?proto.viam.common.v1.BoardStatus}
+ * optional int64 value = 1;
+ * @return {number}
  */
-proto.viam.component.board.v1.StatusResponse.prototype.getStatus = function() {
-  return /** @type{?proto.viam.common.v1.BoardStatus} */ (
-    jspb.Message.getWrapperField(this, common_v1_common_pb.BoardStatus, 1));
-};
-
-
-/**
- * @param {?proto.viam.common.v1.BoardStatus|undefined} value
- * @return {!proto.viam.component.board.v1.StatusResponse} returns this
-*/
-proto.viam.component.board.v1.StatusResponse.prototype.setStatus = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+proto.viam.component.board.v1.GetDigitalInterruptValueResponse.prototype.getValue = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.viam.component.board.v1.StatusResponse} returns this
+ * @param {number} value
+ * @return {!proto.viam.component.board.v1.GetDigitalInterruptValueResponse} returns this
  */
-proto.viam.component.board.v1.StatusResponse.prototype.clearStatus = function() {
-  return this.setStatus(undefined);
+proto.viam.component.board.v1.GetDigitalInterruptValueResponse.prototype.setValue = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
+
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.viam.component.board.v1.StatusResponse.prototype.hasStatus = function() {
-  return jspb.Message.getField(this, 1) != null;
-};
-
-
+proto.viam.component.board.v1.StreamTicksRequest.repeatedFields_ = [2];
 
 
 
@@ -812,8 +5343,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.board.v1.SetGPIORequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.board.v1.SetGPIORequest.toObject(opt_includeInstance, this);
+proto.viam.component.board.v1.StreamTicksRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.StreamTicksRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -822,15 +5353,14 @@ proto.viam.component.board.v1.SetGPIORequest.prototype.toObject = function(opt_i
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.board.v1.SetGPIORequest} msg The msg instance to transform.
+ * @param {!proto.viam.component.board.v1.StreamTicksRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.board.v1.SetGPIORequest.toObject = function(includeInstance, msg) {
+proto.viam.component.board.v1.StreamTicksRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    pin: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    high: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    boardName: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    digitalInterruptNamesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
     extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
   };
 
@@ -845,23 +5375,23 @@ proto.viam.component.board.v1.SetGPIORequest.toObject = function(includeInstance
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.board.v1.SetGPIORequest}
+ * @return {!proto.viam.component.board.v1.StreamTicksRequest}
  */
-proto.viam.component.board.v1.SetGPIORequest.deserializeBinary = function(bytes) {
+proto.viam.component.board.v1.StreamTicksRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.board.v1.SetGPIORequest;
-  return proto.viam.component.board.v1.SetGPIORequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.board.v1.StreamTicksRequest;
+  return proto.viam.component.board.v1.StreamTicksRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.board.v1.SetGPIORequest} msg The message object to deserialize into.
+ * @param {!proto.viam.component.board.v1.StreamTicksRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.board.v1.SetGPIORequest}
+ * @return {!proto.viam.component.board.v1.StreamTicksRequest}
  */
-proto.viam.component.board.v1.SetGPIORequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.board.v1.StreamTicksRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -870,15 +5400,11 @@ proto.viam.component.board.v1.SetGPIORequest.deserializeBinaryFromReader = funct
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setBoardName(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setPin(value);
-      break;
-    case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setHigh(value);
+      msg.addDigitalInterruptNames(value);
       break;
     case 99:
       var value = new google_protobuf_struct_pb.Struct;
@@ -898,9 +5424,9 @@ proto.viam.component.board.v1.SetGPIORequest.deserializeBinaryFromReader = funct
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.board.v1.SetGPIORequest.prototype.serializeBinary = function() {
+proto.viam.component.board.v1.StreamTicksRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.board.v1.SetGPIORequest.serializeBinaryToWriter(this, writer);
+  proto.viam.component.board.v1.StreamTicksRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -908,33 +5434,26 @@ proto.viam.component.board.v1.SetGPIORequest.prototype.serializeBinary = functio
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.board.v1.SetGPIORequest} message
+ * @param {!proto.viam.component.board.v1.StreamTicksRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.board.v1.SetGPIORequest.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.board.v1.StreamTicksRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
+  f = message.getBoardName();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getPin();
+  f = message.getDigitalInterruptNamesList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedString(
       2,
       f
     );
   }
-  f = message.getHigh();
-  if (f) {
-    writer.writeBool(
-      3,
-      f
-    );
-  }
   f = message.getExtra();
   if (f != null) {
     writer.writeMessage(
@@ -947,56 +5466,57 @@ proto.viam.component.board.v1.SetGPIORequest.serializeBinaryToWriter = function(
 
 
 /**
- * optional string name = 1;
+ * optional string board_name = 1;
  * @return {string}
  */
-proto.viam.component.board.v1.SetGPIORequest.prototype.getName = function() {
+proto.viam.component.board.v1.StreamTicksRequest.prototype.getBoardName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.viam.component.board.v1.SetGPIORequest} returns this
+ * @return {!proto.viam.component.board.v1.StreamTicksRequest} returns this
  */
-proto.viam.component.board.v1.SetGPIORequest.prototype.setName = function(value) {
+proto.viam.component.board.v1.StreamTicksRequest.prototype.setBoardName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string pin = 2;
- * @return {string}
+ * repeated string digital_interrupt_names = 2;
+ * @return {!Array<string>}
  */
-proto.viam.component.board.v1.SetGPIORequest.prototype.getPin = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.viam.component.board.v1.StreamTicksRequest.prototype.getDigitalInterruptNamesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.viam.component.board.v1.SetGPIORequest} returns this
+ * @param {!Array<string>} value
+ * @return {!proto.viam.component.board.v1.StreamTicksRequest} returns this
  */
-proto.viam.component.board.v1.SetGPIORequest.prototype.setPin = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.viam.component.board.v1.StreamTicksRequest.prototype.setDigitalInterruptNamesList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
 };
 
 
 /**
- * optional bool high = 3;
- * @return {boolean}
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.viam.component.board.v1.StreamTicksRequest} returns this
  */
-proto.viam.component.board.v1.SetGPIORequest.prototype.getHigh = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+proto.viam.component.board.v1.StreamTicksRequest.prototype.addDigitalInterruptNames = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.viam.component.board.v1.SetGPIORequest} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.component.board.v1.StreamTicksRequest} returns this
  */
-proto.viam.component.board.v1.SetGPIORequest.prototype.setHigh = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 3, value);
+proto.viam.component.board.v1.StreamTicksRequest.prototype.clearDigitalInterruptNamesList = function() {
+  return this.setDigitalInterruptNamesList([]);
 };
 
 
@@ -1004,7 +5524,7 @@ proto.viam.component.board.v1.SetGPIORequest.prototype.setHigh = function(value)
  * optional google.protobuf.Struct extra = 99;
  * @return {?proto.google.protobuf.Struct}
  */
-proto.viam.component.board.v1.SetGPIORequest.prototype.getExtra = function() {
+proto.viam.component.board.v1.StreamTicksRequest.prototype.getExtra = function() {
   return /** @type{?proto.google.protobuf.Struct} */ (
     jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
 };
@@ -1012,18 +5532,18 @@ proto.viam.component.board.v1.SetGPIORequest.prototype.getExtra = function() {
 
 /**
  * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.viam.component.board.v1.SetGPIORequest} returns this
+ * @return {!proto.viam.component.board.v1.StreamTicksRequest} returns this
 */
-proto.viam.component.board.v1.SetGPIORequest.prototype.setExtra = function(value) {
+proto.viam.component.board.v1.StreamTicksRequest.prototype.setExtra = function(value) {
   return jspb.Message.setWrapperField(this, 99, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.viam.component.board.v1.SetGPIORequest} returns this
+ * @return {!proto.viam.component.board.v1.StreamTicksRequest} returns this
  */
-proto.viam.component.board.v1.SetGPIORequest.prototype.clearExtra = function() {
+proto.viam.component.board.v1.StreamTicksRequest.prototype.clearExtra = function() {
   return this.setExtra(undefined);
 };
 
@@ -1032,7 +5552,7 @@ proto.viam.component.board.v1.SetGPIORequest.prototype.clearExtra = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.component.board.v1.SetGPIORequest.prototype.hasExtra = function() {
+proto.viam.component.board.v1.StreamTicksRequest.prototype.hasExtra = function() {
   return jspb.Message.getField(this, 99) != null;
 };
 
@@ -1053,8 +5573,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.board.v1.SetGPIOResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.board.v1.SetGPIOResponse.toObject(opt_includeInstance, this);
+proto.viam.component.board.v1.StreamTicksResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.StreamTicksResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -1063,13 +5583,16 @@ proto.viam.component.board.v1.SetGPIOResponse.prototype.toObject = function(opt_
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.board.v1.SetGPIOResponse} msg The msg instance to transform.
+ * @param {!proto.viam.component.board.v1.StreamTicksResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.board.v1.SetGPIOResponse.toObject = function(includeInstance, msg) {
+proto.viam.component.board.v1.StreamTicksResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    digitalInterruptName: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    high: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    time: (f = msg.getTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    value: jspb.Message.getFieldWithDefault(msg, 4, 0)
   };
 
   if (includeInstance) {
@@ -1083,29 +5606,46 @@ proto.viam.component.board.v1.SetGPIOResponse.toObject = function(includeInstanc
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.board.v1.SetGPIOResponse}
+ * @return {!proto.viam.component.board.v1.StreamTicksResponse}
  */
-proto.viam.component.board.v1.SetGPIOResponse.deserializeBinary = function(bytes) {
+proto.viam.component.board.v1.StreamTicksResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.board.v1.SetGPIOResponse;
-  return proto.viam.component.board.v1.SetGPIOResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.board.v1.StreamTicksResponse;
+  return proto.viam.component.board.v1.StreamTicksResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.board.v1.SetGPIOResponse} msg The message object to deserialize into.
+ * @param {!proto.viam.component.board.v1.StreamTicksResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.board.v1.SetGPIOResponse}
+ * @return {!proto.viam.component.board.v1.StreamTicksResponse}
  */
-proto.viam.component.board.v1.SetGPIOResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.board.v1.StreamTicksResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDigitalInterruptName(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setHigh(value);
+      break;
+    case 3:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setTime(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setValue(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1119,9 +5659,9 @@ proto.viam.component.board.v1.SetGPIOResponse.deserializeBinaryFromReader = func
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.board.v1.SetGPIOResponse.prototype.serializeBinary = function() {
+proto.viam.component.board.v1.StreamTicksResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.board.v1.SetGPIOResponse.serializeBinaryToWriter(this, writer);
+  proto.viam.component.board.v1.StreamTicksResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1129,16 +5669,143 @@ proto.viam.component.board.v1.SetGPIOResponse.prototype.serializeBinary = functi
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.board.v1.SetGPIOResponse} message
+ * @param {!proto.viam.component.board.v1.StreamTicksResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.board.v1.SetGPIOResponse.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.board.v1.StreamTicksResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getDigitalInterruptName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getHigh();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = message.getTime();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getValue();
+  if (f !== 0) {
+    writer.writeInt64(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string digital_interrupt_name = 1;
+ * @return {string}
+ */
+proto.viam.component.board.v1.StreamTicksResponse.prototype.getDigitalInterruptName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.board.v1.StreamTicksResponse} returns this
+ */
+proto.viam.component.board.v1.StreamTicksResponse.prototype.setDigitalInterruptName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional bool high = 2;
+ * @return {boolean}
+ */
+proto.viam.component.board.v1.StreamTicksResponse.prototype.getHigh = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.viam.component.board.v1.StreamTicksResponse} returns this
+ */
+proto.viam.component.board.v1.StreamTicksResponse.prototype.setHigh = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp time = 3;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.viam.component.board.v1.StreamTicksResponse.prototype.getTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.viam.component.board.v1.StreamTicksResponse} returns this
+*/
+proto.viam.component.board.v1.StreamTicksResponse.prototype.setTime = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.board.v1.StreamTicksResponse} returns this
+ */
+proto.viam.component.board.v1.StreamTicksResponse.prototype.clearTime = function() {
+  return this.setTime(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.board.v1.StreamTicksResponse.prototype.hasTime = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional int64 value = 4;
+ * @return {number}
+ */
+proto.viam.component.board.v1.StreamTicksResponse.prototype.getValue = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.board.v1.StreamTicksResponse} returns this
+ */
+proto.viam.component.board.v1.StreamTicksResponse.prototype.setValue = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.component.board.v1.I2CReadWriteRequest.repeatedFields_ = [4];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -1154,8 +5821,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.board.v1.GetGPIORequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.board.v1.GetGPIORequest.toObject(opt_includeInstance, this);
+proto.viam.component.board.v1.I2CReadWriteRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.I2CReadWriteRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -1164,14 +5831,17 @@ proto.viam.component.board.v1.GetGPIORequest.prototype.toObject = function(opt_i
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.board.v1.GetGPIORequest} msg The msg instance to transform.
+ * @param {!proto.viam.component.board.v1.I2CReadWriteRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.board.v1.GetGPIORequest.toObject = function(includeInstance, msg) {
+proto.viam.component.board.v1.I2CReadWriteRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    pin: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    boardName: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    i2cName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    address: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    operationsList: jspb.Message.toObjectList(msg.getOperationsList(),
+    proto.viam.component.board.v1.I2COperation.toObject, includeInstance),
     extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
   };
 
@@ -1186,23 +5856,23 @@ proto.viam.component.board.v1.GetGPIORequest.toObject = function(includeInstance
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.board.v1.GetGPIORequest}
+ * @return {!proto.viam.component.board.v1.I2CReadWriteRequest}
  */
-proto.viam.component.board.v1.GetGPIORequest.deserializeBinary = function(bytes) {
+proto.viam.component.board.v1.I2CReadWriteRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.board.v1.GetGPIORequest;
-  return proto.viam.component.board.v1.GetGPIORequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.board.v1.I2CReadWriteRequest;
+  return proto.viam.component.board.v1.I2CReadWriteRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.board.v1.GetGPIORequest} msg The message object to deserialize into.
+ * @param {!proto.viam.component.board.v1.I2CReadWriteRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.board.v1.GetGPIORequest}
+ * @return {!proto.viam.component.board.v1.I2CReadWriteRequest}
  */
-proto.viam.component.board.v1.GetGPIORequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.board.v1.I2CReadWriteRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1211,11 +5881,20 @@ proto.viam.component.board.v1.GetGPIORequest.deserializeBinaryFromReader = funct
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setBoardName(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setPin(value);
+      msg.setI2cName(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setAddress(value);
+      break;
+    case 4:
+      var value = new proto.viam.component.board.v1.I2COperation;
+      reader.readMessage(value,proto.viam.component.board.v1.I2COperation.deserializeBinaryFromReader);
+      msg.addOperations(value);
       break;
     case 99:
       var value = new google_protobuf_struct_pb.Struct;
@@ -1235,9 +5914,9 @@ proto.viam.component.board.v1.GetGPIORequest.deserializeBinaryFromReader = funct
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.board.v1.GetGPIORequest.prototype.serializeBinary = function() {
+proto.viam.component.board.v1.I2CReadWriteRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.board.v1.GetGPIORequest.serializeBinaryToWriter(this, writer);
+  proto.viam.component.board.v1.I2CReadWriteRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1245,26 +5924,41 @@ proto.viam.component.board.v1.GetGPIORequest.prototype.serializeBinary = functio
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.board.v1.GetGPIORequest} message
+ * @param {!proto.viam.component.board.v1.I2CReadWriteRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.board.v1.GetGPIORequest.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.board.v1.I2CReadWriteRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
+  f = message.getBoardName();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getPin();
+  f = message.getI2cName();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
+  f = message.getAddress();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
+    );
+  }
+  f = message.getOperationsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.viam.component.board.v1.I2COperation.serializeBinaryToWriter
+    );
+  }
   f = message.getExtra();
   if (f != null) {
     writer.writeMessage(
@@ -1277,208 +5971,160 @@ proto.viam.component.board.v1.GetGPIORequest.serializeBinaryToWriter = function(
 
 
 /**
- * optional string name = 1;
+ * optional string board_name = 1;
  * @return {string}
  */
-proto.viam.component.board.v1.GetGPIORequest.prototype.getName = function() {
+proto.viam.component.board.v1.I2CReadWriteRequest.prototype.getBoardName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.viam.component.board.v1.GetGPIORequest} returns this
+ * @return {!proto.viam.component.board.v1.I2CReadWriteRequest} returns this
  */
-proto.viam.component.board.v1.GetGPIORequest.prototype.setName = function(value) {
+proto.viam.component.board.v1.I2CReadWriteRequest.prototype.setBoardName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string pin = 2;
+ * optional string i2c_name = 2;
  * @return {string}
  */
-proto.viam.component.board.v1.GetGPIORequest.prototype.getPin = function() {
+proto.viam.component.board.v1.I2CReadWriteRequest.prototype.getI2cName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.viam.component.board.v1.GetGPIORequest} returns this
+ * @return {!proto.viam.component.board.v1.I2CReadWriteRequest} returns this
  */
-proto.viam.component.board.v1.GetGPIORequest.prototype.setPin = function(value) {
+proto.viam.component.board.v1.I2CReadWriteRequest.prototype.setI2cName = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional google.protobuf.Struct extra = 99;
- * @return {?proto.google.protobuf.Struct}
- */
-proto.viam.component.board.v1.GetGPIORequest.prototype.getExtra = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
-};
-
-
-/**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.viam.component.board.v1.GetGPIORequest} returns this
-*/
-proto.viam.component.board.v1.GetGPIORequest.prototype.setExtra = function(value) {
-  return jspb.Message.setWrapperField(this, 99, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.viam.component.board.v1.GetGPIORequest} returns this
+ * optional uint32 address = 3;
+ * @return {number}
  */
-proto.viam.component.board.v1.GetGPIORequest.prototype.clearExtra = function() {
-  return this.setExtra(undefined);
+proto.viam.component.board.v1.I2CReadWriteRequest.prototype.getAddress = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.viam.component.board.v1.I2CReadWriteRequest} returns this
  */
-proto.viam.component.board.v1.GetGPIORequest.prototype.hasExtra = function() {
-  return jspb.Message.getField(this, 99) != null;
+proto.viam.component.board.v1.I2CReadWriteRequest.prototype.setAddress = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * repeated I2COperation operations = 4;
+ * @return {!Array<!proto.viam.component.board.v1.I2COperation>}
  */
-proto.viam.component.board.v1.GetGPIOResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.board.v1.GetGPIOResponse.toObject(opt_includeInstance, this);
+proto.viam.component.board.v1.I2CReadWriteRequest.prototype.getOperationsList = function() {
+  return /** @type{!Array<!proto.viam.component.board.v1.I2COperation>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.viam.component.board.v1.I2COperation, 4));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.viam.component.board.v1.GetGPIOResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.viam.component.board.v1.GetGPIOResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    high: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+ * @param {!Array<!proto.viam.component.board.v1.I2COperation>} value
+ * @return {!proto.viam.component.board.v1.I2CReadWriteRequest} returns this
+*/
+proto.viam.component.board.v1.I2CReadWriteRequest.prototype.setOperationsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.board.v1.GetGPIOResponse}
+ * @param {!proto.viam.component.board.v1.I2COperation=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.component.board.v1.I2COperation}
  */
-proto.viam.component.board.v1.GetGPIOResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.board.v1.GetGPIOResponse;
-  return proto.viam.component.board.v1.GetGPIOResponse.deserializeBinaryFromReader(msg, reader);
+proto.viam.component.board.v1.I2CReadWriteRequest.prototype.addOperations = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.viam.component.board.v1.I2COperation, opt_index);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.viam.component.board.v1.GetGPIOResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.board.v1.GetGPIOResponse}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.component.board.v1.I2CReadWriteRequest} returns this
  */
-proto.viam.component.board.v1.GetGPIOResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setHigh(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.viam.component.board.v1.I2CReadWriteRequest.prototype.clearOperationsList = function() {
+  return this.setOperationsList([]);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
  */
-proto.viam.component.board.v1.GetGPIOResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.viam.component.board.v1.GetGPIOResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.viam.component.board.v1.I2CReadWriteRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.board.v1.GetGPIOResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.component.board.v1.I2CReadWriteRequest} returns this
+*/
+proto.viam.component.board.v1.I2CReadWriteRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.board.v1.I2CReadWriteRequest} returns this
  */
-proto.viam.component.board.v1.GetGPIOResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getHigh();
-  if (f) {
-    writer.writeBool(
-      1,
-      f
-    );
-  }
+proto.viam.component.board.v1.I2CReadWriteRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
 };
 
 
 /**
- * optional bool high = 1;
+ * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.component.board.v1.GetGPIOResponse.prototype.getHigh = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+proto.viam.component.board.v1.I2CReadWriteRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
 };
 
 
+
 /**
- * @param {boolean} value
- * @return {!proto.viam.component.board.v1.GetGPIOResponse} returns this
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
+ * @const
  */
-proto.viam.component.board.v1.GetGPIOResponse.prototype.setHigh = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
-};
+proto.viam.component.board.v1.I2COperation.oneofGroups_ = [[1,2]];
 
+/**
+ * @enum {number}
+ */
+proto.viam.component.board.v1.I2COperation.OperationCase = {
+  OPERATION_NOT_SET: 0,
+  WRITE: 1,
+  READ_LENGTH: 2
+};
 
+/**
+ * @return {proto.viam.component.board.v1.I2COperation.OperationCase}
+ */
+proto.viam.component.board.v1.I2COperation.prototype.getOperationCase = function() {
+  return /** @type {proto.viam.component.board.v1.I2COperation.OperationCase} */(jspb.Message.computeOneofCase(this, proto.viam.component.board.v1.I2COperation.oneofGroups_[0]));
+};
 
 
 
@@ -1495,8 +6141,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.board.v1.PWMRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.board.v1.PWMRequest.toObject(opt_includeInstance, this);
+proto.viam.component.board.v1.I2COperation.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.I2COperation.toObject(opt_includeInstance, this);
 };
 
 
@@ -1505,15 +6151,14 @@ proto.viam.component.board.v1.PWMRequest.prototype.toObject = function(opt_inclu
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.board.v1.PWMRequest} msg The msg instance to transform.
+ * @param {!proto.viam.component.board.v1.I2COperation} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.board.v1.PWMRequest.toObject = function(includeInstance, msg) {
+proto.viam.component.board.v1.I2COperation.toObject = function(includeInstance, msg) {
   var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    pin: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+    write: msg.getWrite_asB64(),
+    readLength: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -1527,23 +6172,23 @@ proto.viam.component.board.v1.PWMRequest.toObject = function(includeInstance, ms
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.board.v1.PWMRequest}
+ * @return {!proto.viam.component.board.v1.I2COperation}
  */
-proto.viam.component.board.v1.PWMRequest.deserializeBinary = function(bytes) {
+proto.viam.component.board.v1.I2COperation.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.board.v1.PWMRequest;
-  return proto.viam.component.board.v1.PWMRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.board.v1.I2COperation;
+  return proto.viam.component.board.v1.I2COperation.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.board.v1.PWMRequest} msg The message object to deserialize into.
+ * @param {!proto.viam.component.board.v1.I2COperation} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.board.v1.PWMRequest}
+ * @return {!proto.viam.component.board.v1.I2COperation}
  */
-proto.viam.component.board.v1.PWMRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.board.v1.I2COperation.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1551,17 +6196,12 @@ proto.viam.component.board.v1.PWMRequest.deserializeBinaryFromReader = function(
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setWrite(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPin(value);
-      break;
-    case 99:
-      var value = new google_protobuf_struct_pb.Struct;
-      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setExtra(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setReadLength(value);
       break;
     default:
       reader.skipField();
@@ -1576,9 +6216,9 @@ proto.viam.component.board.v1.PWMRequest.deserializeBinaryFromReader = function(
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.board.v1.PWMRequest.prototype.serializeBinary = function() {
+proto.viam.component.board.v1.I2COperation.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.board.v1.PWMRequest.serializeBinaryToWriter(this, writer);
+  proto.viam.component.board.v1.I2COperation.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1586,98 +6226,113 @@ proto.viam.component.board.v1.PWMRequest.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.board.v1.PWMRequest} message
+ * @param {!proto.viam.component.board.v1.I2COperation} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.board.v1.PWMRequest.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.board.v1.I2COperation.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
+  f = /** @type {!(string|Uint8Array)} */ (jspb.Message.getField(message, 1));
+  if (f != null) {
+    writer.writeBytes(
       1,
       f
     );
   }
-  f = message.getPin();
-  if (f.length > 0) {
-    writer.writeString(
+  f = /** @type {number} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeUint32(
       2,
       f
     );
   }
-  f = message.getExtra();
-  if (f != null) {
-    writer.writeMessage(
-      99,
-      f,
-      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
-    );
-  }
 };
 
 
 /**
- * optional string name = 1;
+ * optional bytes write = 1;
  * @return {string}
  */
-proto.viam.component.board.v1.PWMRequest.prototype.getName = function() {
+proto.viam.component.board.v1.I2COperation.prototype.getWrite = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.viam.component.board.v1.PWMRequest} returns this
+ * optional bytes write = 1;
+ * This is a type-conversion wrapper around `getWrite()`
+ * @return {string}
  */
-proto.viam.component.board.v1.PWMRequest.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.viam.component.board.v1.I2COperation.prototype.getWrite_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getWrite()));
 };
 
 
 /**
- * optional string pin = 2;
- * @return {string}
+ * optional bytes write = 1;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getWrite()`
+ * @return {!Uint8Array}
  */
-proto.viam.component.board.v1.PWMRequest.prototype.getPin = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.viam.component.board.v1.I2COperation.prototype.getWrite_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getWrite()));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.viam.component.board.v1.PWMRequest} returns this
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.viam.component.board.v1.I2COperation} returns this
  */
-proto.viam.component.board.v1.PWMRequest.prototype.setPin = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.viam.component.board.v1.I2COperation.prototype.setWrite = function(value) {
+  return jspb.Message.setOneofField(this, 1, proto.viam.component.board.v1.I2COperation.oneofGroups_[0], value);
 };
 
 
 /**
- * optional google.protobuf.Struct extra = 99;
- * @return {?proto.google.protobuf.Struct}
+ * Clears the field making it undefined.
+ * @return {!proto.viam.component.board.v1.I2COperation} returns this
  */
-proto.viam.component.board.v1.PWMRequest.prototype.getExtra = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+proto.viam.component.board.v1.I2COperation.prototype.clearWrite = function() {
+  return jspb.Message.setOneofField(this, 1, proto.viam.component.board.v1.I2COperation.oneofGroups_[0], undefined);
 };
 
 
 /**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.viam.component.board.v1.PWMRequest} returns this
-*/
-proto.viam.component.board.v1.PWMRequest.prototype.setExtra = function(value) {
-  return jspb.Message.setWrapperField(this, 99, value);
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.board.v1.I2COperation.prototype.hasWrite = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.viam.component.board.v1.PWMRequest} returns this
+ * optional uint32 read_length = 2;
+ * @return {number}
  */
-proto.viam.component.board.v1.PWMRequest.prototype.clearExtra = function() {
-  return this.setExtra(undefined);
+proto.viam.component.board.v1.I2COperation.prototype.getReadLength = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.board.v1.I2COperation} returns this
+ */
+proto.viam.component.board.v1.I2COperation.prototype.setReadLength = function(value) {
+  return jspb.Message.setOneofField(this, 2, proto.viam.component.board.v1.I2COperation.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.viam.component.board.v1.I2COperation} returns this
+ */
+proto.viam.component.board.v1.I2COperation.prototype.clearReadLength = function() {
+  return jspb.Message.setOneofField(this, 2, proto.viam.component.board.v1.I2COperation.oneofGroups_[0], undefined);
 };
 
 
@@ -1685,12 +6340,19 @@ proto.viam.component.board.v1.PWMRequest.prototype.clearExtra = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.component.board.v1.PWMRequest.prototype.hasExtra = function() {
-  return jspb.Message.getField(this, 99) != null;
+proto.viam.component.board.v1.I2COperation.prototype.hasReadLength = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.component.board.v1.I2CReadWriteResponse.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -1706,8 +6368,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.board.v1.PWMResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.board.v1.PWMResponse.toObject(opt_includeInstance, this);
+proto.viam.component.board.v1.I2CReadWriteResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.I2CReadWriteResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -1716,13 +6378,13 @@ proto.viam.component.board.v1.PWMResponse.prototype.toObject = function(opt_incl
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.board.v1.PWMResponse} msg The msg instance to transform.
+ * @param {!proto.viam.component.board.v1.I2CReadWriteResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.board.v1.PWMResponse.toObject = function(includeInstance, msg) {
+proto.viam.component.board.v1.I2CReadWriteResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    dutyCyclePct: jspb.Message.getFloatingPointFieldWithDefault(msg, 1, 0.0)
+    readsList: msg.getReadsList_asB64()
   };
 
   if (includeInstance) {
@@ -1736,23 +6398,23 @@ proto.viam.component.board.v1.PWMResponse.toObject = function(includeInstance, m
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.board.v1.PWMResponse}
+ * @return {!proto.viam.component.board.v1.I2CReadWriteResponse}
  */
-proto.viam.component.board.v1.PWMResponse.deserializeBinary = function(bytes) {
+proto.viam.component.board.v1.I2CReadWriteResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.board.v1.PWMResponse;
-  return proto.viam.component.board.v1.PWMResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.board.v1.I2CReadWriteResponse;
+  return proto.viam.component.board.v1.I2CReadWriteResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.board.v1.PWMResponse} msg The message object to deserialize into.
+ * @param {!proto.viam.component.board.v1.I2CReadWriteResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.board.v1.PWMResponse}
+ * @return {!proto.viam.component.board.v1.I2CReadWriteResponse}
  */
-proto.viam.component.board.v1.PWMResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.board.v1.I2CReadWriteResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1760,8 +6422,8 @@ proto.viam.component.board.v1.PWMResponse.deserializeBinaryFromReader = function
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readDouble());
-      msg.setDutyCyclePct(value);
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.addReads(value);
       break;
     default:
       reader.skipField();
@@ -1776,9 +6438,9 @@ proto.viam.component.board.v1.PWMResponse.deserializeBinaryFromReader = function
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.board.v1.PWMResponse.prototype.serializeBinary = function() {
+proto.viam.component.board.v1.I2CReadWriteResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.board.v1.PWMResponse.serializeBinaryToWriter(this, writer);
+  proto.viam.component.board.v1.I2CReadWriteResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1786,15 +6448,15 @@ proto.viam.component.board.v1.PWMResponse.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.board.v1.PWMResponse} message
+ * @param {!proto.viam.component.board.v1.I2CReadWriteResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.board.v1.PWMResponse.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.board.v1.I2CReadWriteResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getDutyCyclePct();
-  if (f !== 0.0) {
-    writer.writeDouble(
+  f = message.getReadsList_asU8();
+  if (f.length > 0) {
+    writer.writeRepeatedBytes(
       1,
       f
     );
@@ -1803,20 +6465,63 @@ proto.viam.component.board.v1.PWMResponse.serializeBinaryToWriter = function(mes
 
 
 /**
- * optional double duty_cycle_pct = 1;
- * @return {number}
+ * repeated bytes reads = 1;
+ * @return {!Array<string>}
  */
-proto.viam.component.board.v1.PWMResponse.prototype.getDutyCyclePct = function() {
-  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 1, 0.0));
+proto.viam.component.board.v1.I2CReadWriteResponse.prototype.getReadsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.viam.component.board.v1.PWMResponse} returns this
+ * repeated bytes reads = 1;
+ * This is a type-conversion wrapper around `getReadsList()`
+ * @return {!Array<string>}
  */
-proto.viam.component.board.v1.PWMResponse.prototype.setDutyCyclePct = function(value) {
-  return jspb.Message.setProto3FloatField(this, 1, value);
+proto.viam.component.board.v1.I2CReadWriteResponse.prototype.getReadsList_asB64 = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.bytesListAsB64(
+      this.getReadsList()));
+};
+
+
+/**
+ * repeated bytes reads = 1;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getReadsList()`
+ * @return {!Array<!Uint8Array>}
+ */
+proto.viam.component.board.v1.I2CReadWriteResponse.prototype.getReadsList_asU8 = function() {
+  return /** @type {!Array<!Uint8Array>} */ (jspb.Message.bytesListAsU8(
+      this.getReadsList()));
+};
+
+
+/**
+ * @param {!(Array<!Uint8Array>|Array<string>)} value
+ * @return {!proto.viam.component.board.v1.I2CReadWriteResponse} returns this
+ */
+proto.viam.component.board.v1.I2CReadWriteResponse.prototype.setReadsList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @param {number=} opt_index
+ * @return {!proto.viam.component.board.v1.I2CReadWriteResponse} returns this
+ */
+proto.viam.component.board.v1.I2CReadWriteResponse.prototype.addReads = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.component.board.v1.I2CReadWriteResponse} returns this
+ */
+proto.viam.component.board.v1.I2CReadWriteResponse.prototype.clearReadsList = function() {
+  return this.setReadsList([]);
 };
 
 
@@ -1836,8 +6541,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.board.v1.SetPWMRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.board.v1.SetPWMRequest.toObject(opt_includeInstance, this);
+proto.viam.component.board.v1.SPITransferRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.SPITransferRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -1846,15 +6551,18 @@ proto.viam.component.board.v1.SetPWMRequest.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.board.v1.SetPWMRequest} msg The msg instance to transform.
+ * @param {!proto.viam.component.board.v1.SPITransferRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.board.v1.SetPWMRequest.toObject = function(includeInstance, msg) {
+proto.viam.component.board.v1.SPITransferRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    pin: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    dutyCyclePct: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0),
+    boardName: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    spiName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    chipSelect: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    mode: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    baudHz: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    data: msg.getData_asB64(),
     extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
   };
 
@@ -1869,23 +6577,23 @@ proto.viam.component.board.v1.SetPWMRequest.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.board.v1.SetPWMRequest}
+ * @return {!proto.viam.component.board.v1.SPITransferRequest}
  */
-proto.viam.component.board.v1.SetPWMRequest.deserializeBinary = function(bytes) {
+proto.viam.component.board.v1.SPITransferRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.board.v1.SetPWMRequest;
-  return proto.viam.component.board.v1.SetPWMRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.board.v1.SPITransferRequest;
+  return proto.viam.component.board.v1.SPITransferRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.board.v1.SetPWMRequest} msg The message object to deserialize into.
+ * @param {!proto.viam.component.board.v1.SPITransferRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.board.v1.SetPWMRequest}
+ * @return {!proto.viam.component.board.v1.SPITransferRequest}
  */
-proto.viam.component.board.v1.SetPWMRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.board.v1.SPITransferRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1894,15 +6602,27 @@ proto.viam.component.board.v1.SetPWMRequest.deserializeBinaryFromReader = functi
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setBoardName(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setPin(value);
+      msg.setSpiName(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readDouble());
-      msg.setDutyCyclePct(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setChipSelect(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setMode(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setBaudHz(value);
+      break;
+    case 6:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setData(value);
       break;
     case 99:
       var value = new google_protobuf_struct_pb.Struct;
@@ -1922,9 +6642,9 @@ proto.viam.component.board.v1.SetPWMRequest.deserializeBinaryFromReader = functi
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.board.v1.SetPWMRequest.prototype.serializeBinary = function() {
+proto.viam.component.board.v1.SPITransferRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.board.v1.SetPWMRequest.serializeBinaryToWriter(this, writer);
+  proto.viam.component.board.v1.SPITransferRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1932,30 +6652,51 @@ proto.viam.component.board.v1.SetPWMRequest.prototype.serializeBinary = function
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.board.v1.SetPWMRequest} message
+ * @param {!proto.viam.component.board.v1.SPITransferRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.board.v1.SetPWMRequest.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.board.v1.SPITransferRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
+  f = message.getBoardName();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getPin();
+  f = message.getSpiName();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getDutyCyclePct();
-  if (f !== 0.0) {
-    writer.writeDouble(
-      3,
+  f = message.getChipSelect();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getMode();
+  if (f !== 0) {
+    writer.writeUint32(
+      4,
+      f
+    );
+  }
+  f = message.getBaudHz();
+  if (f !== 0) {
+    writer.writeUint64(
+      5,
+      f
+    );
+  }
+  f = message.getData_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      6,
       f
     );
   }
@@ -1971,56 +6712,134 @@ proto.viam.component.board.v1.SetPWMRequest.serializeBinaryToWriter = function(m
 
 
 /**
- * optional string name = 1;
+ * optional string board_name = 1;
  * @return {string}
  */
-proto.viam.component.board.v1.SetPWMRequest.prototype.getName = function() {
+proto.viam.component.board.v1.SPITransferRequest.prototype.getBoardName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.viam.component.board.v1.SetPWMRequest} returns this
+ * @return {!proto.viam.component.board.v1.SPITransferRequest} returns this
  */
-proto.viam.component.board.v1.SetPWMRequest.prototype.setName = function(value) {
+proto.viam.component.board.v1.SPITransferRequest.prototype.setBoardName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string pin = 2;
+ * optional string spi_name = 2;
  * @return {string}
  */
-proto.viam.component.board.v1.SetPWMRequest.prototype.getPin = function() {
+proto.viam.component.board.v1.SPITransferRequest.prototype.getSpiName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.viam.component.board.v1.SetPWMRequest} returns this
+ * @return {!proto.viam.component.board.v1.SPITransferRequest} returns this
  */
-proto.viam.component.board.v1.SetPWMRequest.prototype.setPin = function(value) {
+proto.viam.component.board.v1.SPITransferRequest.prototype.setSpiName = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional double duty_cycle_pct = 3;
+ * optional string chip_select = 3;
+ * @return {string}
+ */
+proto.viam.component.board.v1.SPITransferRequest.prototype.getChipSelect = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.board.v1.SPITransferRequest} returns this
+ */
+proto.viam.component.board.v1.SPITransferRequest.prototype.setChipSelect = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional uint32 mode = 4;
  * @return {number}
  */
-proto.viam.component.board.v1.SetPWMRequest.prototype.getDutyCyclePct = function() {
-  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 3, 0.0));
+proto.viam.component.board.v1.SPITransferRequest.prototype.getMode = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.viam.component.board.v1.SetPWMRequest} returns this
+ * @return {!proto.viam.component.board.v1.SPITransferRequest} returns this
  */
-proto.viam.component.board.v1.SetPWMRequest.prototype.setDutyCyclePct = function(value) {
-  return jspb.Message.setProto3FloatField(this, 3, value);
+proto.viam.component.board.v1.SPITransferRequest.prototype.setMode = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional uint64 baud_hz = 5;
+ * @return {number}
+ */
+proto.viam.component.board.v1.SPITransferRequest.prototype.getBaudHz = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.board.v1.SPITransferRequest} returns this
+ */
+proto.viam.component.board.v1.SPITransferRequest.prototype.setBaudHz = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional bytes data = 6;
+ * @return {string}
+ */
+proto.viam.component.board.v1.SPITransferRequest.prototype.getData = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * optional bytes data = 6;
+ * This is a type-conversion wrapper around `getData()`
+ * @return {string}
+ */
+proto.viam.component.board.v1.SPITransferRequest.prototype.getData_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getData()));
+};
+
+
+/**
+ * optional bytes data = 6;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getData()`
+ * @return {!Uint8Array}
+ */
+proto.viam.component.board.v1.SPITransferRequest.prototype.getData_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getData()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.viam.component.board.v1.SPITransferRequest} returns this
+ */
+proto.viam.component.board.v1.SPITransferRequest.prototype.setData = function(value) {
+  return jspb.Message.setProto3BytesField(this, 6, value);
 };
 
 
@@ -2028,7 +6847,7 @@ proto.viam.component.board.v1.SetPWMRequest.prototype.setDutyCyclePct = function
  * optional google.protobuf.Struct extra = 99;
  * @return {?proto.google.protobuf.Struct}
  */
-proto.viam.component.board.v1.SetPWMRequest.prototype.getExtra = function() {
+proto.viam.component.board.v1.SPITransferRequest.prototype.getExtra = function() {
   return /** @type{?proto.google.protobuf.Struct} */ (
     jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
 };
@@ -2036,18 +6855,18 @@ proto.viam.component.board.v1.SetPWMRequest.prototype.getExtra = function() {
 
 /**
  * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.viam.component.board.v1.SetPWMRequest} returns this
+ * @return {!proto.viam.component.board.v1.SPITransferRequest} returns this
 */
-proto.viam.component.board.v1.SetPWMRequest.prototype.setExtra = function(value) {
+proto.viam.component.board.v1.SPITransferRequest.prototype.setExtra = function(value) {
   return jspb.Message.setWrapperField(this, 99, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.viam.component.board.v1.SetPWMRequest} returns this
+ * @return {!proto.viam.component.board.v1.SPITransferRequest} returns this
  */
-proto.viam.component.board.v1.SetPWMRequest.prototype.clearExtra = function() {
+proto.viam.component.board.v1.SPITransferRequest.prototype.clearExtra = function() {
   return this.setExtra(undefined);
 };
 
@@ -2056,7 +6875,7 @@ proto.viam.component.board.v1.SetPWMRequest.prototype.clearExtra = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.component.board.v1.SetPWMRequest.prototype.hasExtra = function() {
+proto.viam.component.board.v1.SPITransferRequest.prototype.hasExtra = function() {
   return jspb.Message.getField(this, 99) != null;
 };
 
@@ -2077,8 +6896,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.board.v1.SetPWMResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.board.v1.SetPWMResponse.toObject(opt_includeInstance, this);
+proto.viam.component.board.v1.SPITransferResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.SPITransferResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2087,13 +6906,13 @@ proto.viam.component.board.v1.SetPWMResponse.prototype.toObject = function(opt_i
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.board.v1.SetPWMResponse} msg The msg instance to transform.
+ * @param {!proto.viam.component.board.v1.SPITransferResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.board.v1.SetPWMResponse.toObject = function(includeInstance, msg) {
+proto.viam.component.board.v1.SPITransferResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    data: msg.getData_asB64()
   };
 
   if (includeInstance) {
@@ -2107,29 +6926,33 @@ proto.viam.component.board.v1.SetPWMResponse.toObject = function(includeInstance
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.board.v1.SetPWMResponse}
+ * @return {!proto.viam.component.board.v1.SPITransferResponse}
  */
-proto.viam.component.board.v1.SetPWMResponse.deserializeBinary = function(bytes) {
+proto.viam.component.board.v1.SPITransferResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.board.v1.SetPWMResponse;
-  return proto.viam.component.board.v1.SetPWMResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.board.v1.SPITransferResponse;
+  return proto.viam.component.board.v1.SPITransferResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.board.v1.SetPWMResponse} msg The message object to deserialize into.
+ * @param {!proto.viam.component.board.v1.SPITransferResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.board.v1.SetPWMResponse}
+ * @return {!proto.viam.component.board.v1.SPITransferResponse}
  */
-proto.viam.component.board.v1.SetPWMResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.board.v1.SPITransferResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setData(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -2143,9 +6966,9 @@ proto.viam.component.board.v1.SetPWMResponse.deserializeBinaryFromReader = funct
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.board.v1.SetPWMResponse.prototype.serializeBinary = function() {
+proto.viam.component.board.v1.SPITransferResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.board.v1.SetPWMResponse.serializeBinaryToWriter(this, writer);
+  proto.viam.component.board.v1.SPITransferResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2153,12 +6976,61 @@ proto.viam.component.board.v1.SetPWMResponse.prototype.serializeBinary = functio
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.board.v1.SetPWMResponse} message
+ * @param {!proto.viam.component.board.v1.SPITransferResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.board.v1.SetPWMResponse.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.board.v1.SPITransferResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getData_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bytes data = 1;
+ * @return {string}
+ */
+proto.viam.component.board.v1.SPITransferResponse.prototype.getData = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * optional bytes data = 1;
+ * This is a type-conversion wrapper around `getData()`
+ * @return {string}
+ */
+proto.viam.component.board.v1.SPITransferResponse.prototype.getData_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getData()));
+};
+
+
+/**
+ * optional bytes data = 1;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getData()`
+ * @return {!Uint8Array}
+ */
+proto.viam.component.board.v1.SPITransferResponse.prototype.getData_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getData()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.viam.component.board.v1.SPITransferResponse} returns this
+ */
+proto.viam.component.board.v1.SPITransferResponse.prototype.setData = function(value) {
+  return jspb.Message.setProto3BytesField(this, 1, value);
 };
 
 
@@ -2178,8 +7050,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.board.v1.PWMFrequencyRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.board.v1.PWMFrequencyRequest.toObject(opt_includeInstance, this);
+proto.viam.component.board.v1.SerialStreamRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.SerialStreamRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -2188,14 +7060,16 @@ proto.viam.component.board.v1.PWMFrequencyRequest.prototype.toObject = function(
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.board.v1.PWMFrequencyRequest} msg The msg instance to transform.
+ * @param {!proto.viam.component.board.v1.SerialStreamRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.board.v1.PWMFrequencyRequest.toObject = function(includeInstance, msg) {
+proto.viam.component.board.v1.SerialStreamRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    pin: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    boardName: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    serialName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    baudRate: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    data: msg.getData_asB64(),
     extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
   };
 
@@ -2210,23 +7084,23 @@ proto.viam.component.board.v1.PWMFrequencyRequest.toObject = function(includeIns
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.board.v1.PWMFrequencyRequest}
+ * @return {!proto.viam.component.board.v1.SerialStreamRequest}
  */
-proto.viam.component.board.v1.PWMFrequencyRequest.deserializeBinary = function(bytes) {
+proto.viam.component.board.v1.SerialStreamRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.board.v1.PWMFrequencyRequest;
-  return proto.viam.component.board.v1.PWMFrequencyRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.board.v1.SerialStreamRequest;
+  return proto.viam.component.board.v1.SerialStreamRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.board.v1.PWMFrequencyRequest} msg The message object to deserialize into.
+ * @param {!proto.viam.component.board.v1.SerialStreamRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.board.v1.PWMFrequencyRequest}
+ * @return {!proto.viam.component.board.v1.SerialStreamRequest}
  */
-proto.viam.component.board.v1.PWMFrequencyRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.board.v1.SerialStreamRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2235,11 +7109,19 @@ proto.viam.component.board.v1.PWMFrequencyRequest.deserializeBinaryFromReader =
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setBoardName(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setPin(value);
+      msg.setSerialName(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setBaudRate(value);
+      break;
+    case 4:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setData(value);
       break;
     case 99:
       var value = new google_protobuf_struct_pb.Struct;
@@ -2259,9 +7141,9 @@ proto.viam.component.board.v1.PWMFrequencyRequest.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.board.v1.PWMFrequencyRequest.prototype.serializeBinary = function() {
+proto.viam.component.board.v1.SerialStreamRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.board.v1.PWMFrequencyRequest.serializeBinaryToWriter(this, writer);
+  proto.viam.component.board.v1.SerialStreamRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2269,26 +7151,40 @@ proto.viam.component.board.v1.PWMFrequencyRequest.prototype.serializeBinary = fu
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.board.v1.PWMFrequencyRequest} message
+ * @param {!proto.viam.component.board.v1.SerialStreamRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.board.v1.PWMFrequencyRequest.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.board.v1.SerialStreamRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
+  f = message.getBoardName();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getPin();
+  f = message.getSerialName();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
+  f = message.getBaudRate();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
+    );
+  }
+  f = message.getData_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      4,
+      f
+    );
+  }
   f = message.getExtra();
   if (f != null) {
     writer.writeMessage(
@@ -2301,46 +7197,106 @@ proto.viam.component.board.v1.PWMFrequencyRequest.serializeBinaryToWriter = func
 
 
 /**
- * optional string name = 1;
+ * optional string board_name = 1;
  * @return {string}
  */
-proto.viam.component.board.v1.PWMFrequencyRequest.prototype.getName = function() {
+proto.viam.component.board.v1.SerialStreamRequest.prototype.getBoardName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.viam.component.board.v1.PWMFrequencyRequest} returns this
+ * @return {!proto.viam.component.board.v1.SerialStreamRequest} returns this
  */
-proto.viam.component.board.v1.PWMFrequencyRequest.prototype.setName = function(value) {
+proto.viam.component.board.v1.SerialStreamRequest.prototype.setBoardName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string pin = 2;
+ * optional string serial_name = 2;
  * @return {string}
  */
-proto.viam.component.board.v1.PWMFrequencyRequest.prototype.getPin = function() {
+proto.viam.component.board.v1.SerialStreamRequest.prototype.getSerialName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.viam.component.board.v1.PWMFrequencyRequest} returns this
+ * @return {!proto.viam.component.board.v1.SerialStreamRequest} returns this
  */
-proto.viam.component.board.v1.PWMFrequencyRequest.prototype.setPin = function(value) {
+proto.viam.component.board.v1.SerialStreamRequest.prototype.setSerialName = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
+/**
+ * optional uint32 baud_rate = 3;
+ * @return {number}
+ */
+proto.viam.component.board.v1.SerialStreamRequest.prototype.getBaudRate = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.board.v1.SerialStreamRequest} returns this
+ */
+proto.viam.component.board.v1.SerialStreamRequest.prototype.setBaudRate = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional bytes data = 4;
+ * @return {string}
+ */
+proto.viam.component.board.v1.SerialStreamRequest.prototype.getData = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * optional bytes data = 4;
+ * This is a type-conversion wrapper around `getData()`
+ * @return {string}
+ */
+proto.viam.component.board.v1.SerialStreamRequest.prototype.getData_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getData()));
+};
+
+
+/**
+ * optional bytes data = 4;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getData()`
+ * @return {!Uint8Array}
+ */
+proto.viam.component.board.v1.SerialStreamRequest.prototype.getData_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getData()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.viam.component.board.v1.SerialStreamRequest} returns this
+ */
+proto.viam.component.board.v1.SerialStreamRequest.prototype.setData = function(value) {
+  return jspb.Message.setProto3BytesField(this, 4, value);
+};
+
+
 /**
  * optional google.protobuf.Struct extra = 99;
  * @return {?proto.google.protobuf.Struct}
  */
-proto.viam.component.board.v1.PWMFrequencyRequest.prototype.getExtra = function() {
+proto.viam.component.board.v1.SerialStreamRequest.prototype.getExtra = function() {
   return /** @type{?proto.google.protobuf.Struct} */ (
     jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
 };
@@ -2348,18 +7304,18 @@ proto.viam.component.board.v1.PWMFrequencyRequest.prototype.getExtra = function(
 
 /**
  * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.viam.component.board.v1.PWMFrequencyRequest} returns this
+ * @return {!proto.viam.component.board.v1.SerialStreamRequest} returns this
 */
-proto.viam.component.board.v1.PWMFrequencyRequest.prototype.setExtra = function(value) {
+proto.viam.component.board.v1.SerialStreamRequest.prototype.setExtra = function(value) {
   return jspb.Message.setWrapperField(this, 99, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.viam.component.board.v1.PWMFrequencyRequest} returns this
+ * @return {!proto.viam.component.board.v1.SerialStreamRequest} returns this
  */
-proto.viam.component.board.v1.PWMFrequencyRequest.prototype.clearExtra = function() {
+proto.viam.component.board.v1.SerialStreamRequest.prototype.clearExtra = function() {
   return this.setExtra(undefined);
 };
 
@@ -2368,7 +7324,7 @@ proto.viam.component.board.v1.PWMFrequencyRequest.prototype.clearExtra = functio
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.component.board.v1.PWMFrequencyRequest.prototype.hasExtra = function() {
+proto.viam.component.board.v1.SerialStreamRequest.prototype.hasExtra = function() {
   return jspb.Message.getField(this, 99) != null;
 };
 
@@ -2389,8 +7345,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.board.v1.PWMFrequencyResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.board.v1.PWMFrequencyResponse.toObject(opt_includeInstance, this);
+proto.viam.component.board.v1.SerialStreamResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.SerialStreamResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2399,13 +7355,13 @@ proto.viam.component.board.v1.PWMFrequencyResponse.prototype.toObject = function
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.board.v1.PWMFrequencyResponse} msg The msg instance to transform.
+ * @param {!proto.viam.component.board.v1.SerialStreamResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.board.v1.PWMFrequencyResponse.toObject = function(includeInstance, msg) {
+proto.viam.component.board.v1.SerialStreamResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    frequencyHz: jspb.Message.getFieldWithDefault(msg, 1, 0)
+    data: msg.getData_asB64()
   };
 
   if (includeInstance) {
@@ -2419,23 +7375,23 @@ proto.viam.component.board.v1.PWMFrequencyResponse.toObject = function(includeIn
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.board.v1.PWMFrequencyResponse}
+ * @return {!proto.viam.component.board.v1.SerialStreamResponse}
  */
-proto.viam.component.board.v1.PWMFrequencyResponse.deserializeBinary = function(bytes) {
+proto.viam.component.board.v1.SerialStreamResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.board.v1.PWMFrequencyResponse;
-  return proto.viam.component.board.v1.PWMFrequencyResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.board.v1.SerialStreamResponse;
+  return proto.viam.component.board.v1.SerialStreamResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.board.v1.PWMFrequencyResponse} msg The message object to deserialize into.
+ * @param {!proto.viam.component.board.v1.SerialStreamResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.board.v1.PWMFrequencyResponse}
+ * @return {!proto.viam.component.board.v1.SerialStreamResponse}
  */
-proto.viam.component.board.v1.PWMFrequencyResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.board.v1.SerialStreamResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2443,8 +7399,8 @@ proto.viam.component.board.v1.PWMFrequencyResponse.deserializeBinaryFromReader =
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setFrequencyHz(value);
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setData(value);
       break;
     default:
       reader.skipField();
@@ -2459,9 +7415,9 @@ proto.viam.component.board.v1.PWMFrequencyResponse.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.board.v1.PWMFrequencyResponse.prototype.serializeBinary = function() {
+proto.viam.component.board.v1.SerialStreamResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.board.v1.PWMFrequencyResponse.serializeBinaryToWriter(this, writer);
+  proto.viam.component.board.v1.SerialStreamResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2469,15 +7425,15 @@ proto.viam.component.board.v1.PWMFrequencyResponse.prototype.serializeBinary = f
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.board.v1.PWMFrequencyResponse} message
+ * @param {!proto.viam.component.board.v1.SerialStreamResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.board.v1.PWMFrequencyResponse.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.board.v1.SerialStreamResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getFrequencyHz();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getData_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
       1,
       f
     );
@@ -2486,24 +7442,55 @@ proto.viam.component.board.v1.PWMFrequencyResponse.serializeBinaryToWriter = fun
 
 
 /**
- * optional uint64 frequency_hz = 1;
- * @return {number}
+ * optional bytes data = 1;
+ * @return {string}
  */
-proto.viam.component.board.v1.PWMFrequencyResponse.prototype.getFrequencyHz = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.viam.component.board.v1.SerialStreamResponse.prototype.getData = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.viam.component.board.v1.PWMFrequencyResponse} returns this
+ * optional bytes data = 1;
+ * This is a type-conversion wrapper around `getData()`
+ * @return {string}
  */
-proto.viam.component.board.v1.PWMFrequencyResponse.prototype.setFrequencyHz = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.viam.component.board.v1.SerialStreamResponse.prototype.getData_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getData()));
+};
+
+
+/**
+ * optional bytes data = 1;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getData()`
+ * @return {!Uint8Array}
+ */
+proto.viam.component.board.v1.SerialStreamResponse.prototype.getData_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getData()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.viam.component.board.v1.SerialStreamResponse} returns this
+ */
+proto.viam.component.board.v1.SerialStreamResponse.prototype.setData = function(value) {
+  return jspb.Message.setProto3BytesField(this, 1, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.component.board.v1.SetPowerModeRequest.repeatedFields_ = [4];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -2519,8 +7506,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.board.v1.SetPWMFrequencyRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.board.v1.SetPWMFrequencyRequest.toObject(opt_includeInstance, this);
+proto.viam.component.board.v1.SetPowerModeRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.SetPowerModeRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -2529,15 +7516,17 @@ proto.viam.component.board.v1.SetPWMFrequencyRequest.prototype.toObject = functi
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.board.v1.SetPWMFrequencyRequest} msg The msg instance to transform.
+ * @param {!proto.viam.component.board.v1.SetPowerModeRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.board.v1.SetPWMFrequencyRequest.toObject = function(includeInstance, msg) {
+proto.viam.component.board.v1.SetPowerModeRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    pin: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    frequencyHz: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    powerMode: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    duration: (f = msg.getDuration()) && google_protobuf_duration_pb.Duration.toObject(includeInstance, f),
+    wakeSourcesList: jspb.Message.toObjectList(msg.getWakeSourcesList(),
+    proto.viam.component.board.v1.WakeSource.toObject, includeInstance),
     extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
   };
 
@@ -2552,23 +7541,23 @@ proto.viam.component.board.v1.SetPWMFrequencyRequest.toObject = function(include
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.board.v1.SetPWMFrequencyRequest}
+ * @return {!proto.viam.component.board.v1.SetPowerModeRequest}
  */
-proto.viam.component.board.v1.SetPWMFrequencyRequest.deserializeBinary = function(bytes) {
+proto.viam.component.board.v1.SetPowerModeRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.board.v1.SetPWMFrequencyRequest;
-  return proto.viam.component.board.v1.SetPWMFrequencyRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.board.v1.SetPowerModeRequest;
+  return proto.viam.component.board.v1.SetPowerModeRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.board.v1.SetPWMFrequencyRequest} msg The message object to deserialize into.
+ * @param {!proto.viam.component.board.v1.SetPowerModeRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.board.v1.SetPWMFrequencyRequest}
+ * @return {!proto.viam.component.board.v1.SetPowerModeRequest}
  */
-proto.viam.component.board.v1.SetPWMFrequencyRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.board.v1.SetPowerModeRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2580,12 +7569,18 @@ proto.viam.component.board.v1.SetPWMFrequencyRequest.deserializeBinaryFromReader
       msg.setName(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPin(value);
+      var value = /** @type {!proto.viam.component.board.v1.PowerMode} */ (reader.readEnum());
+      msg.setPowerMode(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setFrequencyHz(value);
+      var value = new google_protobuf_duration_pb.Duration;
+      reader.readMessage(value,google_protobuf_duration_pb.Duration.deserializeBinaryFromReader);
+      msg.setDuration(value);
+      break;
+    case 4:
+      var value = new proto.viam.component.board.v1.WakeSource;
+      reader.readMessage(value,proto.viam.component.board.v1.WakeSource.deserializeBinaryFromReader);
+      msg.addWakeSources(value);
       break;
     case 99:
       var value = new google_protobuf_struct_pb.Struct;
@@ -2605,9 +7600,9 @@ proto.viam.component.board.v1.SetPWMFrequencyRequest.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.board.v1.SetPWMFrequencyRequest.prototype.serializeBinary = function() {
+proto.viam.component.board.v1.SetPowerModeRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.board.v1.SetPWMFrequencyRequest.serializeBinaryToWriter(this, writer);
+  proto.viam.component.board.v1.SetPowerModeRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2615,11 +7610,11 @@ proto.viam.component.board.v1.SetPWMFrequencyRequest.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.board.v1.SetPWMFrequencyRequest} message
+ * @param {!proto.viam.component.board.v1.SetPowerModeRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.board.v1.SetPWMFrequencyRequest.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.board.v1.SetPowerModeRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getName();
   if (f.length > 0) {
@@ -2628,18 +7623,27 @@ proto.viam.component.board.v1.SetPWMFrequencyRequest.serializeBinaryToWriter = f
       f
     );
   }
-  f = message.getPin();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getPowerMode();
+  if (f !== 0.0) {
+    writer.writeEnum(
       2,
       f
     );
   }
-  f = message.getFrequencyHz();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getDuration();
+  if (f != null) {
+    writer.writeMessage(
       3,
-      f
+      f,
+      google_protobuf_duration_pb.Duration.serializeBinaryToWriter
+    );
+  }
+  f = message.getWakeSourcesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.viam.component.board.v1.WakeSource.serializeBinaryToWriter
     );
   }
   f = message.getExtra();
@@ -2657,53 +7661,110 @@ proto.viam.component.board.v1.SetPWMFrequencyRequest.serializeBinaryToWriter = f
  * optional string name = 1;
  * @return {string}
  */
-proto.viam.component.board.v1.SetPWMFrequencyRequest.prototype.getName = function() {
+proto.viam.component.board.v1.SetPowerModeRequest.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.viam.component.board.v1.SetPWMFrequencyRequest} returns this
+ * @return {!proto.viam.component.board.v1.SetPowerModeRequest} returns this
  */
-proto.viam.component.board.v1.SetPWMFrequencyRequest.prototype.setName = function(value) {
+proto.viam.component.board.v1.SetPowerModeRequest.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string pin = 2;
- * @return {string}
+ * optional PowerMode power_mode = 2;
+ * @return {!proto.viam.component.board.v1.PowerMode}
  */
-proto.viam.component.board.v1.SetPWMFrequencyRequest.prototype.getPin = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.viam.component.board.v1.SetPowerModeRequest.prototype.getPowerMode = function() {
+  return /** @type {!proto.viam.component.board.v1.PowerMode} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.viam.component.board.v1.SetPWMFrequencyRequest} returns this
+ * @param {!proto.viam.component.board.v1.PowerMode} value
+ * @return {!proto.viam.component.board.v1.SetPowerModeRequest} returns this
  */
-proto.viam.component.board.v1.SetPWMFrequencyRequest.prototype.setPin = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.viam.component.board.v1.SetPowerModeRequest.prototype.setPowerMode = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
 };
 
 
 /**
- * optional uint64 frequency_hz = 3;
- * @return {number}
+ * optional google.protobuf.Duration duration = 3;
+ * @return {?proto.google.protobuf.Duration}
  */
-proto.viam.component.board.v1.SetPWMFrequencyRequest.prototype.getFrequencyHz = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.viam.component.board.v1.SetPowerModeRequest.prototype.getDuration = function() {
+  return /** @type{?proto.google.protobuf.Duration} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_duration_pb.Duration, 3));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.viam.component.board.v1.SetPWMFrequencyRequest} returns this
+ * @param {?proto.google.protobuf.Duration|undefined} value
+ * @return {!proto.viam.component.board.v1.SetPowerModeRequest} returns this
+*/
+proto.viam.component.board.v1.SetPowerModeRequest.prototype.setDuration = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.board.v1.SetPowerModeRequest} returns this
  */
-proto.viam.component.board.v1.SetPWMFrequencyRequest.prototype.setFrequencyHz = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.viam.component.board.v1.SetPowerModeRequest.prototype.clearDuration = function() {
+  return this.setDuration(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.board.v1.SetPowerModeRequest.prototype.hasDuration = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * repeated WakeSource wake_sources = 4;
+ * @return {!Array<!proto.viam.component.board.v1.WakeSource>}
+ */
+proto.viam.component.board.v1.SetPowerModeRequest.prototype.getWakeSourcesList = function() {
+  return /** @type{!Array<!proto.viam.component.board.v1.WakeSource>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.viam.component.board.v1.WakeSource, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.viam.component.board.v1.WakeSource>} value
+ * @return {!proto.viam.component.board.v1.SetPowerModeRequest} returns this
+*/
+proto.viam.component.board.v1.SetPowerModeRequest.prototype.setWakeSourcesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.viam.component.board.v1.WakeSource=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.component.board.v1.WakeSource}
+ */
+proto.viam.component.board.v1.SetPowerModeRequest.prototype.addWakeSources = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.viam.component.board.v1.WakeSource, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.component.board.v1.SetPowerModeRequest} returns this
+ */
+proto.viam.component.board.v1.SetPowerModeRequest.prototype.clearWakeSourcesList = function() {
+  return this.setWakeSourcesList([]);
 };
 
 
@@ -2711,7 +7772,7 @@ proto.viam.component.board.v1.SetPWMFrequencyRequest.prototype.setFrequencyHz =
  * optional google.protobuf.Struct extra = 99;
  * @return {?proto.google.protobuf.Struct}
  */
-proto.viam.component.board.v1.SetPWMFrequencyRequest.prototype.getExtra = function() {
+proto.viam.component.board.v1.SetPowerModeRequest.prototype.getExtra = function() {
   return /** @type{?proto.google.protobuf.Struct} */ (
     jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
 };
@@ -2719,18 +7780,18 @@ proto.viam.component.board.v1.SetPWMFrequencyRequest.prototype.getExtra = functi
 
 /**
  * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.viam.component.board.v1.SetPWMFrequencyRequest} returns this
+ * @return {!proto.viam.component.board.v1.SetPowerModeRequest} returns this
 */
-proto.viam.component.board.v1.SetPWMFrequencyRequest.prototype.setExtra = function(value) {
+proto.viam.component.board.v1.SetPowerModeRequest.prototype.setExtra = function(value) {
   return jspb.Message.setWrapperField(this, 99, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.viam.component.board.v1.SetPWMFrequencyRequest} returns this
+ * @return {!proto.viam.component.board.v1.SetPowerModeRequest} returns this
  */
-proto.viam.component.board.v1.SetPWMFrequencyRequest.prototype.clearExtra = function() {
+proto.viam.component.board.v1.SetPowerModeRequest.prototype.clearExtra = function() {
   return this.setExtra(undefined);
 };
 
@@ -2739,7 +7800,7 @@ proto.viam.component.board.v1.SetPWMFrequencyRequest.prototype.clearExtra = func
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.component.board.v1.SetPWMFrequencyRequest.prototype.hasExtra = function() {
+proto.viam.component.board.v1.SetPowerModeRequest.prototype.hasExtra = function() {
   return jspb.Message.getField(this, 99) != null;
 };
 
@@ -2760,8 +7821,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.board.v1.SetPWMFrequencyResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.board.v1.SetPWMFrequencyResponse.toObject(opt_includeInstance, this);
+proto.viam.component.board.v1.SetPowerModeResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.SetPowerModeResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2770,11 +7831,11 @@ proto.viam.component.board.v1.SetPWMFrequencyResponse.prototype.toObject = funct
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.board.v1.SetPWMFrequencyResponse} msg The msg instance to transform.
+ * @param {!proto.viam.component.board.v1.SetPowerModeResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.board.v1.SetPWMFrequencyResponse.toObject = function(includeInstance, msg) {
+proto.viam.component.board.v1.SetPowerModeResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
 
   };
@@ -2790,23 +7851,23 @@ proto.viam.component.board.v1.SetPWMFrequencyResponse.toObject = function(includ
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.board.v1.SetPWMFrequencyResponse}
+ * @return {!proto.viam.component.board.v1.SetPowerModeResponse}
  */
-proto.viam.component.board.v1.SetPWMFrequencyResponse.deserializeBinary = function(bytes) {
+proto.viam.component.board.v1.SetPowerModeResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.board.v1.SetPWMFrequencyResponse;
-  return proto.viam.component.board.v1.SetPWMFrequencyResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.board.v1.SetPowerModeResponse;
+  return proto.viam.component.board.v1.SetPowerModeResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.board.v1.SetPWMFrequencyResponse} msg The message object to deserialize into.
+ * @param {!proto.viam.component.board.v1.SetPowerModeResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.board.v1.SetPWMFrequencyResponse}
+ * @return {!proto.viam.component.board.v1.SetPowerModeResponse}
  */
-proto.viam.component.board.v1.SetPWMFrequencyResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.board.v1.SetPowerModeResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2826,9 +7887,9 @@ proto.viam.component.board.v1.SetPWMFrequencyResponse.deserializeBinaryFromReade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.board.v1.SetPWMFrequencyResponse.prototype.serializeBinary = function() {
+proto.viam.component.board.v1.SetPowerModeResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.board.v1.SetPWMFrequencyResponse.serializeBinaryToWriter(this, writer);
+  proto.viam.component.board.v1.SetPowerModeResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2836,16 +7897,43 @@ proto.viam.component.board.v1.SetPWMFrequencyResponse.prototype.serializeBinary
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.board.v1.SetPWMFrequencyResponse} message
+ * @param {!proto.viam.component.board.v1.SetPowerModeResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.board.v1.SetPWMFrequencyResponse.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.board.v1.SetPowerModeResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
 };
 
 
 
+/**
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
+ * @const
+ */
+proto.viam.component.board.v1.WakeSource.oneofGroups_ = [[1,2,3]];
+
+/**
+ * @enum {number}
+ */
+proto.viam.component.board.v1.WakeSource.SourceCase = {
+  SOURCE_NOT_SET: 0,
+  GPIO: 1,
+  RTC: 2,
+  ANALOG: 3
+};
+
+/**
+ * @return {proto.viam.component.board.v1.WakeSource.SourceCase}
+ */
+proto.viam.component.board.v1.WakeSource.prototype.getSourceCase = function() {
+  return /** @type {proto.viam.component.board.v1.WakeSource.SourceCase} */(jspb.Message.computeOneofCase(this, proto.viam.component.board.v1.WakeSource.oneofGroups_[0]));
+};
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -2861,8 +7949,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.board.v1.ReadAnalogReaderRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.board.v1.ReadAnalogReaderRequest.toObject(opt_includeInstance, this);
+proto.viam.component.board.v1.WakeSource.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.WakeSource.toObject(opt_includeInstance, this);
 };
 
 
@@ -2871,15 +7959,15 @@ proto.viam.component.board.v1.ReadAnalogReaderRequest.prototype.toObject = funct
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.board.v1.ReadAnalogReaderRequest} msg The msg instance to transform.
+ * @param {!proto.viam.component.board.v1.WakeSource} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.board.v1.ReadAnalogReaderRequest.toObject = function(includeInstance, msg) {
+proto.viam.component.board.v1.WakeSource.toObject = function(includeInstance, msg) {
   var f, obj = {
-    boardName: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    analogReaderName: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+    gpio: (f = msg.getGpio()) && proto.viam.component.board.v1.GPIOWakeSource.toObject(includeInstance, f),
+    rtc: (f = msg.getRtc()) && proto.viam.component.board.v1.RTCWakeSource.toObject(includeInstance, f),
+    analog: (f = msg.getAnalog()) && proto.viam.component.board.v1.AnalogWakeSource.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -2893,23 +7981,23 @@ proto.viam.component.board.v1.ReadAnalogReaderRequest.toObject = function(includ
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.board.v1.ReadAnalogReaderRequest}
+ * @return {!proto.viam.component.board.v1.WakeSource}
  */
-proto.viam.component.board.v1.ReadAnalogReaderRequest.deserializeBinary = function(bytes) {
+proto.viam.component.board.v1.WakeSource.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.board.v1.ReadAnalogReaderRequest;
-  return proto.viam.component.board.v1.ReadAnalogReaderRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.board.v1.WakeSource;
+  return proto.viam.component.board.v1.WakeSource.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.board.v1.ReadAnalogReaderRequest} msg The message object to deserialize into.
+ * @param {!proto.viam.component.board.v1.WakeSource} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.board.v1.ReadAnalogReaderRequest}
+ * @return {!proto.viam.component.board.v1.WakeSource}
  */
-proto.viam.component.board.v1.ReadAnalogReaderRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.board.v1.WakeSource.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2917,17 +8005,19 @@ proto.viam.component.board.v1.ReadAnalogReaderRequest.deserializeBinaryFromReade
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setBoardName(value);
+      var value = new proto.viam.component.board.v1.GPIOWakeSource;
+      reader.readMessage(value,proto.viam.component.board.v1.GPIOWakeSource.deserializeBinaryFromReader);
+      msg.setGpio(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setAnalogReaderName(value);
+      var value = new proto.viam.component.board.v1.RTCWakeSource;
+      reader.readMessage(value,proto.viam.component.board.v1.RTCWakeSource.deserializeBinaryFromReader);
+      msg.setRtc(value);
       break;
-    case 99:
-      var value = new google_protobuf_struct_pb.Struct;
-      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setExtra(value);
+    case 3:
+      var value = new proto.viam.component.board.v1.AnalogWakeSource;
+      reader.readMessage(value,proto.viam.component.board.v1.AnalogWakeSource.deserializeBinaryFromReader);
+      msg.setAnalog(value);
       break;
     default:
       reader.skipField();
@@ -2942,9 +8032,9 @@ proto.viam.component.board.v1.ReadAnalogReaderRequest.deserializeBinaryFromReade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.board.v1.ReadAnalogReaderRequest.prototype.serializeBinary = function() {
+proto.viam.component.board.v1.WakeSource.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.board.v1.ReadAnalogReaderRequest.serializeBinaryToWriter(this, writer);
+  proto.viam.component.board.v1.WakeSource.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2952,98 +8042,138 @@ proto.viam.component.board.v1.ReadAnalogReaderRequest.prototype.serializeBinary
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.board.v1.ReadAnalogReaderRequest} message
+ * @param {!proto.viam.component.board.v1.WakeSource} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.board.v1.ReadAnalogReaderRequest.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.board.v1.WakeSource.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getBoardName();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getGpio();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      proto.viam.component.board.v1.GPIOWakeSource.serializeBinaryToWriter
     );
   }
-  f = message.getAnalogReaderName();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getRtc();
+  if (f != null) {
+    writer.writeMessage(
       2,
-      f
+      f,
+      proto.viam.component.board.v1.RTCWakeSource.serializeBinaryToWriter
     );
   }
-  f = message.getExtra();
+  f = message.getAnalog();
   if (f != null) {
     writer.writeMessage(
-      99,
+      3,
       f,
-      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+      proto.viam.component.board.v1.AnalogWakeSource.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string board_name = 1;
- * @return {string}
+ * optional GPIOWakeSource gpio = 1;
+ * @return {?proto.viam.component.board.v1.GPIOWakeSource}
+ */
+proto.viam.component.board.v1.WakeSource.prototype.getGpio = function() {
+  return /** @type{?proto.viam.component.board.v1.GPIOWakeSource} */ (
+    jspb.Message.getWrapperField(this, proto.viam.component.board.v1.GPIOWakeSource, 1));
+};
+
+
+/**
+ * @param {?proto.viam.component.board.v1.GPIOWakeSource|undefined} value
+ * @return {!proto.viam.component.board.v1.WakeSource} returns this
+*/
+proto.viam.component.board.v1.WakeSource.prototype.setGpio = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 1, proto.viam.component.board.v1.WakeSource.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.board.v1.WakeSource} returns this
+ */
+proto.viam.component.board.v1.WakeSource.prototype.clearGpio = function() {
+  return this.setGpio(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.viam.component.board.v1.ReadAnalogReaderRequest.prototype.getBoardName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.viam.component.board.v1.WakeSource.prototype.hasGpio = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.viam.component.board.v1.ReadAnalogReaderRequest} returns this
+ * optional RTCWakeSource rtc = 2;
+ * @return {?proto.viam.component.board.v1.RTCWakeSource}
  */
-proto.viam.component.board.v1.ReadAnalogReaderRequest.prototype.setBoardName = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.viam.component.board.v1.WakeSource.prototype.getRtc = function() {
+  return /** @type{?proto.viam.component.board.v1.RTCWakeSource} */ (
+    jspb.Message.getWrapperField(this, proto.viam.component.board.v1.RTCWakeSource, 2));
 };
 
 
 /**
- * optional string analog_reader_name = 2;
- * @return {string}
+ * @param {?proto.viam.component.board.v1.RTCWakeSource|undefined} value
+ * @return {!proto.viam.component.board.v1.WakeSource} returns this
+*/
+proto.viam.component.board.v1.WakeSource.prototype.setRtc = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 2, proto.viam.component.board.v1.WakeSource.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.board.v1.WakeSource} returns this
  */
-proto.viam.component.board.v1.ReadAnalogReaderRequest.prototype.getAnalogReaderName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.viam.component.board.v1.WakeSource.prototype.clearRtc = function() {
+  return this.setRtc(undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.viam.component.board.v1.ReadAnalogReaderRequest} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.viam.component.board.v1.ReadAnalogReaderRequest.prototype.setAnalogReaderName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.viam.component.board.v1.WakeSource.prototype.hasRtc = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
 /**
- * optional google.protobuf.Struct extra = 99;
- * @return {?proto.google.protobuf.Struct}
+ * optional AnalogWakeSource analog = 3;
+ * @return {?proto.viam.component.board.v1.AnalogWakeSource}
  */
-proto.viam.component.board.v1.ReadAnalogReaderRequest.prototype.getExtra = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+proto.viam.component.board.v1.WakeSource.prototype.getAnalog = function() {
+  return /** @type{?proto.viam.component.board.v1.AnalogWakeSource} */ (
+    jspb.Message.getWrapperField(this, proto.viam.component.board.v1.AnalogWakeSource, 3));
 };
 
 
 /**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.viam.component.board.v1.ReadAnalogReaderRequest} returns this
+ * @param {?proto.viam.component.board.v1.AnalogWakeSource|undefined} value
+ * @return {!proto.viam.component.board.v1.WakeSource} returns this
 */
-proto.viam.component.board.v1.ReadAnalogReaderRequest.prototype.setExtra = function(value) {
-  return jspb.Message.setWrapperField(this, 99, value);
+proto.viam.component.board.v1.WakeSource.prototype.setAnalog = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 3, proto.viam.component.board.v1.WakeSource.oneofGroups_[0], value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.viam.component.board.v1.ReadAnalogReaderRequest} returns this
+ * @return {!proto.viam.component.board.v1.WakeSource} returns this
  */
-proto.viam.component.board.v1.ReadAnalogReaderRequest.prototype.clearExtra = function() {
-  return this.setExtra(undefined);
+proto.viam.component.board.v1.WakeSource.prototype.clearAnalog = function() {
+  return this.setAnalog(undefined);
 };
 
 
@@ -3051,8 +8181,8 @@ proto.viam.component.board.v1.ReadAnalogReaderRequest.prototype.clearExtra = fun
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.component.board.v1.ReadAnalogReaderRequest.prototype.hasExtra = function() {
-  return jspb.Message.getField(this, 99) != null;
+proto.viam.component.board.v1.WakeSource.prototype.hasAnalog = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
@@ -3072,8 +8202,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.board.v1.ReadAnalogReaderResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.board.v1.ReadAnalogReaderResponse.toObject(opt_includeInstance, this);
+proto.viam.component.board.v1.GPIOWakeSource.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.GPIOWakeSource.toObject(opt_includeInstance, this);
 };
 
 
@@ -3082,13 +8212,14 @@ proto.viam.component.board.v1.ReadAnalogReaderResponse.prototype.toObject = func
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.board.v1.ReadAnalogReaderResponse} msg The msg instance to transform.
+ * @param {!proto.viam.component.board.v1.GPIOWakeSource} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.board.v1.ReadAnalogReaderResponse.toObject = function(includeInstance, msg) {
+proto.viam.component.board.v1.GPIOWakeSource.toObject = function(includeInstance, msg) {
   var f, obj = {
-    value: jspb.Message.getFieldWithDefault(msg, 1, 0)
+    pin: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    edge: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -3102,23 +8233,23 @@ proto.viam.component.board.v1.ReadAnalogReaderResponse.toObject = function(inclu
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.board.v1.ReadAnalogReaderResponse}
+ * @return {!proto.viam.component.board.v1.GPIOWakeSource}
  */
-proto.viam.component.board.v1.ReadAnalogReaderResponse.deserializeBinary = function(bytes) {
+proto.viam.component.board.v1.GPIOWakeSource.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.board.v1.ReadAnalogReaderResponse;
-  return proto.viam.component.board.v1.ReadAnalogReaderResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.board.v1.GPIOWakeSource;
+  return proto.viam.component.board.v1.GPIOWakeSource.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.board.v1.ReadAnalogReaderResponse} msg The message object to deserialize into.
+ * @param {!proto.viam.component.board.v1.GPIOWakeSource} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.board.v1.ReadAnalogReaderResponse}
+ * @return {!proto.viam.component.board.v1.GPIOWakeSource}
  */
-proto.viam.component.board.v1.ReadAnalogReaderResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.board.v1.GPIOWakeSource.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3126,8 +8257,12 @@ proto.viam.component.board.v1.ReadAnalogReaderResponse.deserializeBinaryFromRead
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setValue(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPin(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.viam.component.board.v1.Edge} */ (reader.readEnum());
+      msg.setEdge(value);
       break;
     default:
       reader.skipField();
@@ -3142,9 +8277,9 @@ proto.viam.component.board.v1.ReadAnalogReaderResponse.deserializeBinaryFromRead
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.board.v1.ReadAnalogReaderResponse.prototype.serializeBinary = function() {
+proto.viam.component.board.v1.GPIOWakeSource.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.board.v1.ReadAnalogReaderResponse.serializeBinaryToWriter(this, writer);
+  proto.viam.component.board.v1.GPIOWakeSource.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3152,37 +8287,62 @@ proto.viam.component.board.v1.ReadAnalogReaderResponse.prototype.serializeBinary
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.board.v1.ReadAnalogReaderResponse} message
+ * @param {!proto.viam.component.board.v1.GPIOWakeSource} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.board.v1.ReadAnalogReaderResponse.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.board.v1.GPIOWakeSource.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getValue();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = message.getPin();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
+  f = message.getEdge();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional int32 value = 1;
- * @return {number}
+ * optional string pin = 1;
+ * @return {string}
  */
-proto.viam.component.board.v1.ReadAnalogReaderResponse.prototype.getValue = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.viam.component.board.v1.GPIOWakeSource.prototype.getPin = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.viam.component.board.v1.ReadAnalogReaderResponse} returns this
+ * @param {string} value
+ * @return {!proto.viam.component.board.v1.GPIOWakeSource} returns this
  */
-proto.viam.component.board.v1.ReadAnalogReaderResponse.prototype.setValue = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.viam.component.board.v1.GPIOWakeSource.prototype.setPin = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional Edge edge = 2;
+ * @return {!proto.viam.component.board.v1.Edge}
+ */
+proto.viam.component.board.v1.GPIOWakeSource.prototype.getEdge = function() {
+  return /** @type {!proto.viam.component.board.v1.Edge} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {!proto.viam.component.board.v1.Edge} value
+ * @return {!proto.viam.component.board.v1.GPIOWakeSource} returns this
+ */
+proto.viam.component.board.v1.GPIOWakeSource.prototype.setEdge = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
 };
 
 
@@ -3202,8 +8362,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.board.v1.GetDigitalInterruptValueRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.board.v1.GetDigitalInterruptValueRequest.toObject(opt_includeInstance, this);
+proto.viam.component.board.v1.RTCWakeSource.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.RTCWakeSource.toObject(opt_includeInstance, this);
 };
 
 
@@ -3212,15 +8372,13 @@ proto.viam.component.board.v1.GetDigitalInterruptValueRequest.prototype.toObject
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.board.v1.GetDigitalInterruptValueRequest} msg The msg instance to transform.
+ * @param {!proto.viam.component.board.v1.RTCWakeSource} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.board.v1.GetDigitalInterruptValueRequest.toObject = function(includeInstance, msg) {
+proto.viam.component.board.v1.RTCWakeSource.toObject = function(includeInstance, msg) {
   var f, obj = {
-    boardName: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    digitalInterruptName: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+    time: (f = msg.getTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -3234,23 +8392,23 @@ proto.viam.component.board.v1.GetDigitalInterruptValueRequest.toObject = functio
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.board.v1.GetDigitalInterruptValueRequest}
+ * @return {!proto.viam.component.board.v1.RTCWakeSource}
  */
-proto.viam.component.board.v1.GetDigitalInterruptValueRequest.deserializeBinary = function(bytes) {
+proto.viam.component.board.v1.RTCWakeSource.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.board.v1.GetDigitalInterruptValueRequest;
-  return proto.viam.component.board.v1.GetDigitalInterruptValueRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.board.v1.RTCWakeSource;
+  return proto.viam.component.board.v1.RTCWakeSource.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.board.v1.GetDigitalInterruptValueRequest} msg The message object to deserialize into.
+ * @param {!proto.viam.component.board.v1.RTCWakeSource} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.board.v1.GetDigitalInterruptValueRequest}
+ * @return {!proto.viam.component.board.v1.RTCWakeSource}
  */
-proto.viam.component.board.v1.GetDigitalInterruptValueRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.board.v1.RTCWakeSource.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3258,17 +8416,9 @@ proto.viam.component.board.v1.GetDigitalInterruptValueRequest.deserializeBinaryF
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setBoardName(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setDigitalInterruptName(value);
-      break;
-    case 99:
-      var value = new google_protobuf_struct_pb.Struct;
-      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setExtra(value);
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setTime(value);
       break;
     default:
       reader.skipField();
@@ -3283,9 +8433,9 @@ proto.viam.component.board.v1.GetDigitalInterruptValueRequest.deserializeBinaryF
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.board.v1.GetDigitalInterruptValueRequest.prototype.serializeBinary = function() {
+proto.viam.component.board.v1.RTCWakeSource.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.board.v1.GetDigitalInterruptValueRequest.serializeBinaryToWriter(this, writer);
+  proto.viam.component.board.v1.RTCWakeSource.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3293,98 +8443,48 @@ proto.viam.component.board.v1.GetDigitalInterruptValueRequest.prototype.serializ
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.board.v1.GetDigitalInterruptValueRequest} message
+ * @param {!proto.viam.component.board.v1.RTCWakeSource} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.board.v1.GetDigitalInterruptValueRequest.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.board.v1.RTCWakeSource.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getBoardName();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getDigitalInterruptName();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getExtra();
+  f = message.getTime();
   if (f != null) {
     writer.writeMessage(
-      99,
+      1,
       f,
-      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string board_name = 1;
- * @return {string}
- */
-proto.viam.component.board.v1.GetDigitalInterruptValueRequest.prototype.getBoardName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.viam.component.board.v1.GetDigitalInterruptValueRequest} returns this
- */
-proto.viam.component.board.v1.GetDigitalInterruptValueRequest.prototype.setBoardName = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
-/**
- * optional string digital_interrupt_name = 2;
- * @return {string}
- */
-proto.viam.component.board.v1.GetDigitalInterruptValueRequest.prototype.getDigitalInterruptName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.viam.component.board.v1.GetDigitalInterruptValueRequest} returns this
- */
-proto.viam.component.board.v1.GetDigitalInterruptValueRequest.prototype.setDigitalInterruptName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
-
-
-/**
- * optional google.protobuf.Struct extra = 99;
- * @return {?proto.google.protobuf.Struct}
+ * optional google.protobuf.Timestamp time = 1;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.viam.component.board.v1.GetDigitalInterruptValueRequest.prototype.getExtra = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+proto.viam.component.board.v1.RTCWakeSource.prototype.getTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 1));
 };
 
 
 /**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.viam.component.board.v1.GetDigitalInterruptValueRequest} returns this
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.viam.component.board.v1.RTCWakeSource} returns this
 */
-proto.viam.component.board.v1.GetDigitalInterruptValueRequest.prototype.setExtra = function(value) {
-  return jspb.Message.setWrapperField(this, 99, value);
+proto.viam.component.board.v1.RTCWakeSource.prototype.setTime = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.viam.component.board.v1.GetDigitalInterruptValueRequest} returns this
+ * @return {!proto.viam.component.board.v1.RTCWakeSource} returns this
  */
-proto.viam.component.board.v1.GetDigitalInterruptValueRequest.prototype.clearExtra = function() {
-  return this.setExtra(undefined);
+proto.viam.component.board.v1.RTCWakeSource.prototype.clearTime = function() {
+  return this.setTime(undefined);
 };
 
 
@@ -3392,8 +8492,8 @@ proto.viam.component.board.v1.GetDigitalInterruptValueRequest.prototype.clearExt
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.component.board.v1.GetDigitalInterruptValueRequest.prototype.hasExtra = function() {
-  return jspb.Message.getField(this, 99) != null;
+proto.viam.component.board.v1.RTCWakeSource.prototype.hasTime = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
@@ -3413,8 +8513,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.board.v1.GetDigitalInterruptValueResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.board.v1.GetDigitalInterruptValueResponse.toObject(opt_includeInstance, this);
+proto.viam.component.board.v1.AnalogWakeSource.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.AnalogWakeSource.toObject(opt_includeInstance, this);
 };
 
 
@@ -3423,13 +8523,15 @@ proto.viam.component.board.v1.GetDigitalInterruptValueResponse.prototype.toObjec
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.board.v1.GetDigitalInterruptValueResponse} msg The msg instance to transform.
+ * @param {!proto.viam.component.board.v1.AnalogWakeSource} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.board.v1.GetDigitalInterruptValueResponse.toObject = function(includeInstance, msg) {
+proto.viam.component.board.v1.AnalogWakeSource.toObject = function(includeInstance, msg) {
   var f, obj = {
-    value: jspb.Message.getFieldWithDefault(msg, 1, 0)
+    analogReaderName: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    thresholdVoltage: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0),
+    rising: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
   };
 
   if (includeInstance) {
@@ -3443,23 +8545,23 @@ proto.viam.component.board.v1.GetDigitalInterruptValueResponse.toObject = functi
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.board.v1.GetDigitalInterruptValueResponse}
+ * @return {!proto.viam.component.board.v1.AnalogWakeSource}
  */
-proto.viam.component.board.v1.GetDigitalInterruptValueResponse.deserializeBinary = function(bytes) {
+proto.viam.component.board.v1.AnalogWakeSource.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.board.v1.GetDigitalInterruptValueResponse;
-  return proto.viam.component.board.v1.GetDigitalInterruptValueResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.board.v1.AnalogWakeSource;
+  return proto.viam.component.board.v1.AnalogWakeSource.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.board.v1.GetDigitalInterruptValueResponse} msg The message object to deserialize into.
+ * @param {!proto.viam.component.board.v1.AnalogWakeSource} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.board.v1.GetDigitalInterruptValueResponse}
+ * @return {!proto.viam.component.board.v1.AnalogWakeSource}
  */
-proto.viam.component.board.v1.GetDigitalInterruptValueResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.board.v1.AnalogWakeSource.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3467,8 +8569,16 @@ proto.viam.component.board.v1.GetDigitalInterruptValueResponse.deserializeBinary
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setValue(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAnalogReaderName(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readFloat());
+      msg.setThresholdVoltage(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setRising(value);
       break;
     default:
       reader.skipField();
@@ -3483,9 +8593,9 @@ proto.viam.component.board.v1.GetDigitalInterruptValueResponse.deserializeBinary
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.board.v1.GetDigitalInterruptValueResponse.prototype.serializeBinary = function() {
+proto.viam.component.board.v1.AnalogWakeSource.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.board.v1.GetDigitalInterruptValueResponse.serializeBinaryToWriter(this, writer);
+  proto.viam.component.board.v1.AnalogWakeSource.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3493,37 +8603,87 @@ proto.viam.component.board.v1.GetDigitalInterruptValueResponse.prototype.seriali
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.board.v1.GetDigitalInterruptValueResponse} message
+ * @param {!proto.viam.component.board.v1.AnalogWakeSource} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.board.v1.GetDigitalInterruptValueResponse.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.board.v1.AnalogWakeSource.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getValue();
-  if (f !== 0) {
-    writer.writeInt64(
+  f = message.getAnalogReaderName();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
+  f = message.getThresholdVoltage();
+  if (f !== 0.0) {
+    writer.writeFloat(
+      2,
+      f
+    );
+  }
+  f = message.getRising();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string analog_reader_name = 1;
+ * @return {string}
+ */
+proto.viam.component.board.v1.AnalogWakeSource.prototype.getAnalogReaderName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.board.v1.AnalogWakeSource} returns this
+ */
+proto.viam.component.board.v1.AnalogWakeSource.prototype.setAnalogReaderName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional int64 value = 1;
+ * optional float threshold_voltage = 2;
  * @return {number}
  */
-proto.viam.component.board.v1.GetDigitalInterruptValueResponse.prototype.getValue = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.viam.component.board.v1.AnalogWakeSource.prototype.getThresholdVoltage = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.viam.component.board.v1.GetDigitalInterruptValueResponse} returns this
+ * @return {!proto.viam.component.board.v1.AnalogWakeSource} returns this
  */
-proto.viam.component.board.v1.GetDigitalInterruptValueResponse.prototype.setValue = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.viam.component.board.v1.AnalogWakeSource.prototype.setThresholdVoltage = function(value) {
+  return jspb.Message.setProto3FloatField(this, 2, value);
+};
+
+
+/**
+ * optional bool rising = 3;
+ * @return {boolean}
+ */
+proto.viam.component.board.v1.AnalogWakeSource.prototype.getRising = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.viam.component.board.v1.AnalogWakeSource} returns this
+ */
+proto.viam.component.board.v1.AnalogWakeSource.prototype.setRising = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
 };
 
 
@@ -3543,8 +8703,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.board.v1.SetPowerModeRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.board.v1.SetPowerModeRequest.toObject(opt_includeInstance, this);
+proto.viam.component.board.v1.GetPowerModeRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.GetPowerModeRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -3553,15 +8713,13 @@ proto.viam.component.board.v1.SetPowerModeRequest.prototype.toObject = function(
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.board.v1.SetPowerModeRequest} msg The msg instance to transform.
+ * @param {!proto.viam.component.board.v1.GetPowerModeRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.board.v1.SetPowerModeRequest.toObject = function(includeInstance, msg) {
+proto.viam.component.board.v1.GetPowerModeRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    powerMode: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    duration: (f = msg.getDuration()) && google_protobuf_duration_pb.Duration.toObject(includeInstance, f),
     extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
   };
 
@@ -3576,23 +8734,23 @@ proto.viam.component.board.v1.SetPowerModeRequest.toObject = function(includeIns
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.board.v1.SetPowerModeRequest}
+ * @return {!proto.viam.component.board.v1.GetPowerModeRequest}
  */
-proto.viam.component.board.v1.SetPowerModeRequest.deserializeBinary = function(bytes) {
+proto.viam.component.board.v1.GetPowerModeRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.board.v1.SetPowerModeRequest;
-  return proto.viam.component.board.v1.SetPowerModeRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.board.v1.GetPowerModeRequest;
+  return proto.viam.component.board.v1.GetPowerModeRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.board.v1.SetPowerModeRequest} msg The message object to deserialize into.
+ * @param {!proto.viam.component.board.v1.GetPowerModeRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.board.v1.SetPowerModeRequest}
+ * @return {!proto.viam.component.board.v1.GetPowerModeRequest}
  */
-proto.viam.component.board.v1.SetPowerModeRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.board.v1.GetPowerModeRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3603,15 +8761,6 @@ proto.viam.component.board.v1.SetPowerModeRequest.deserializeBinaryFromReader =
       var value = /** @type {string} */ (reader.readString());
       msg.setName(value);
       break;
-    case 2:
-      var value = /** @type {!proto.viam.component.board.v1.PowerMode} */ (reader.readEnum());
-      msg.setPowerMode(value);
-      break;
-    case 3:
-      var value = new google_protobuf_duration_pb.Duration;
-      reader.readMessage(value,google_protobuf_duration_pb.Duration.deserializeBinaryFromReader);
-      msg.setDuration(value);
-      break;
     case 99:
       var value = new google_protobuf_struct_pb.Struct;
       reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
@@ -3630,9 +8779,9 @@ proto.viam.component.board.v1.SetPowerModeRequest.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.board.v1.SetPowerModeRequest.prototype.serializeBinary = function() {
+proto.viam.component.board.v1.GetPowerModeRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.board.v1.SetPowerModeRequest.serializeBinaryToWriter(this, writer);
+  proto.viam.component.board.v1.GetPowerModeRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3640,11 +8789,11 @@ proto.viam.component.board.v1.SetPowerModeRequest.prototype.serializeBinary = fu
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.board.v1.SetPowerModeRequest} message
+ * @param {!proto.viam.component.board.v1.GetPowerModeRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.board.v1.SetPowerModeRequest.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.board.v1.GetPowerModeRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getName();
   if (f.length > 0) {
@@ -3653,21 +8802,6 @@ proto.viam.component.board.v1.SetPowerModeRequest.serializeBinaryToWriter = func
       f
     );
   }
-  f = message.getPowerMode();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      2,
-      f
-    );
-  }
-  f = message.getDuration();
-  if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      google_protobuf_duration_pb.Duration.serializeBinaryToWriter
-    );
-  }
   f = message.getExtra();
   if (f != null) {
     writer.writeMessage(
@@ -3683,80 +8817,25 @@ proto.viam.component.board.v1.SetPowerModeRequest.serializeBinaryToWriter = func
  * optional string name = 1;
  * @return {string}
  */
-proto.viam.component.board.v1.SetPowerModeRequest.prototype.getName = function() {
+proto.viam.component.board.v1.GetPowerModeRequest.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.viam.component.board.v1.SetPowerModeRequest} returns this
+ * @return {!proto.viam.component.board.v1.GetPowerModeRequest} returns this
  */
-proto.viam.component.board.v1.SetPowerModeRequest.prototype.setName = function(value) {
+proto.viam.component.board.v1.GetPowerModeRequest.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
-/**
- * optional PowerMode power_mode = 2;
- * @return {!proto.viam.component.board.v1.PowerMode}
- */
-proto.viam.component.board.v1.SetPowerModeRequest.prototype.getPowerMode = function() {
-  return /** @type {!proto.viam.component.board.v1.PowerMode} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/**
- * @param {!proto.viam.component.board.v1.PowerMode} value
- * @return {!proto.viam.component.board.v1.SetPowerModeRequest} returns this
- */
-proto.viam.component.board.v1.SetPowerModeRequest.prototype.setPowerMode = function(value) {
-  return jspb.Message.setProto3EnumField(this, 2, value);
-};
-
-
-/**
- * optional google.protobuf.Duration duration = 3;
- * @return {?proto.google.protobuf.Duration}
- */
-proto.viam.component.board.v1.SetPowerModeRequest.prototype.getDuration = function() {
-  return /** @type{?proto.google.protobuf.Duration} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_duration_pb.Duration, 3));
-};
-
-
-/**
- * @param {?proto.google.protobuf.Duration|undefined} value
- * @return {!proto.viam.component.board.v1.SetPowerModeRequest} returns this
-*/
-proto.viam.component.board.v1.SetPowerModeRequest.prototype.setDuration = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.viam.component.board.v1.SetPowerModeRequest} returns this
- */
-proto.viam.component.board.v1.SetPowerModeRequest.prototype.clearDuration = function() {
-  return this.setDuration(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.viam.component.board.v1.SetPowerModeRequest.prototype.hasDuration = function() {
-  return jspb.Message.getField(this, 3) != null;
-};
-
-
 /**
  * optional google.protobuf.Struct extra = 99;
  * @return {?proto.google.protobuf.Struct}
  */
-proto.viam.component.board.v1.SetPowerModeRequest.prototype.getExtra = function() {
+proto.viam.component.board.v1.GetPowerModeRequest.prototype.getExtra = function() {
   return /** @type{?proto.google.protobuf.Struct} */ (
     jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
 };
@@ -3764,18 +8843,18 @@ proto.viam.component.board.v1.SetPowerModeRequest.prototype.getExtra = function(
 
 /**
  * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.viam.component.board.v1.SetPowerModeRequest} returns this
+ * @return {!proto.viam.component.board.v1.GetPowerModeRequest} returns this
 */
-proto.viam.component.board.v1.SetPowerModeRequest.prototype.setExtra = function(value) {
+proto.viam.component.board.v1.GetPowerModeRequest.prototype.setExtra = function(value) {
   return jspb.Message.setWrapperField(this, 99, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.viam.component.board.v1.SetPowerModeRequest} returns this
+ * @return {!proto.viam.component.board.v1.GetPowerModeRequest} returns this
  */
-proto.viam.component.board.v1.SetPowerModeRequest.prototype.clearExtra = function() {
+proto.viam.component.board.v1.GetPowerModeRequest.prototype.clearExtra = function() {
   return this.setExtra(undefined);
 };
 
@@ -3784,7 +8863,7 @@ proto.viam.component.board.v1.SetPowerModeRequest.prototype.clearExtra = functio
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.component.board.v1.SetPowerModeRequest.prototype.hasExtra = function() {
+proto.viam.component.board.v1.GetPowerModeRequest.prototype.hasExtra = function() {
   return jspb.Message.getField(this, 99) != null;
 };
 
@@ -3805,8 +8884,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.board.v1.SetPowerModeResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.board.v1.SetPowerModeResponse.toObject(opt_includeInstance, this);
+proto.viam.component.board.v1.GetPowerModeResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.board.v1.GetPowerModeResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -3815,13 +8894,16 @@ proto.viam.component.board.v1.SetPowerModeResponse.prototype.toObject = function
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.board.v1.SetPowerModeResponse} msg The msg instance to transform.
+ * @param {!proto.viam.component.board.v1.GetPowerModeResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.board.v1.SetPowerModeResponse.toObject = function(includeInstance, msg) {
+proto.viam.component.board.v1.GetPowerModeResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    powerMode: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    lastWakeReason: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    lastWakeSource: (f = msg.getLastWakeSource()) && proto.viam.component.board.v1.WakeSource.toObject(includeInstance, f),
+    lastWakeTime: (f = msg.getLastWakeTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -3835,29 +8917,47 @@ proto.viam.component.board.v1.SetPowerModeResponse.toObject = function(includeIn
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.board.v1.SetPowerModeResponse}
+ * @return {!proto.viam.component.board.v1.GetPowerModeResponse}
  */
-proto.viam.component.board.v1.SetPowerModeResponse.deserializeBinary = function(bytes) {
+proto.viam.component.board.v1.GetPowerModeResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.board.v1.SetPowerModeResponse;
-  return proto.viam.component.board.v1.SetPowerModeResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.board.v1.GetPowerModeResponse;
+  return proto.viam.component.board.v1.GetPowerModeResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.board.v1.SetPowerModeResponse} msg The message object to deserialize into.
+ * @param {!proto.viam.component.board.v1.GetPowerModeResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.board.v1.SetPowerModeResponse}
+ * @return {!proto.viam.component.board.v1.GetPowerModeResponse}
  */
-proto.viam.component.board.v1.SetPowerModeResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.board.v1.GetPowerModeResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = /** @type {!proto.viam.component.board.v1.PowerMode} */ (reader.readEnum());
+      msg.setPowerMode(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.viam.component.board.v1.WakeReason} */ (reader.readEnum());
+      msg.setLastWakeReason(value);
+      break;
+    case 3:
+      var value = new proto.viam.component.board.v1.WakeSource;
+      reader.readMessage(value,proto.viam.component.board.v1.WakeSource.deserializeBinaryFromReader);
+      msg.setLastWakeSource(value);
+      break;
+    case 4:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setLastWakeTime(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -3871,9 +8971,9 @@ proto.viam.component.board.v1.SetPowerModeResponse.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.board.v1.SetPowerModeResponse.prototype.serializeBinary = function() {
+proto.viam.component.board.v1.GetPowerModeResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.board.v1.SetPowerModeResponse.serializeBinaryToWriter(this, writer);
+  proto.viam.component.board.v1.GetPowerModeResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3881,22 +8981,186 @@ proto.viam.component.board.v1.SetPowerModeResponse.prototype.serializeBinary = f
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.board.v1.SetPowerModeResponse} message
+ * @param {!proto.viam.component.board.v1.GetPowerModeResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.board.v1.SetPowerModeResponse.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.board.v1.GetPowerModeResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getPowerMode();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getLastWakeReason();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+  f = message.getLastWakeSource();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.viam.component.board.v1.WakeSource.serializeBinaryToWriter
+    );
+  }
+  f = message.getLastWakeTime();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional PowerMode power_mode = 1;
+ * @return {!proto.viam.component.board.v1.PowerMode}
+ */
+proto.viam.component.board.v1.GetPowerModeResponse.prototype.getPowerMode = function() {
+  return /** @type {!proto.viam.component.board.v1.PowerMode} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.viam.component.board.v1.PowerMode} value
+ * @return {!proto.viam.component.board.v1.GetPowerModeResponse} returns this
+ */
+proto.viam.component.board.v1.GetPowerModeResponse.prototype.setPowerMode = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional WakeReason last_wake_reason = 2;
+ * @return {!proto.viam.component.board.v1.WakeReason}
+ */
+proto.viam.component.board.v1.GetPowerModeResponse.prototype.getLastWakeReason = function() {
+  return /** @type {!proto.viam.component.board.v1.WakeReason} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
+/**
+ * @param {!proto.viam.component.board.v1.WakeReason} value
+ * @return {!proto.viam.component.board.v1.GetPowerModeResponse} returns this
+ */
+proto.viam.component.board.v1.GetPowerModeResponse.prototype.setLastWakeReason = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
+};
+
+
+/**
+ * optional WakeSource last_wake_source = 3;
+ * @return {?proto.viam.component.board.v1.WakeSource}
+ */
+proto.viam.component.board.v1.GetPowerModeResponse.prototype.getLastWakeSource = function() {
+  return /** @type{?proto.viam.component.board.v1.WakeSource} */ (
+    jspb.Message.getWrapperField(this, proto.viam.component.board.v1.WakeSource, 3));
+};
+
+
+/**
+ * @param {?proto.viam.component.board.v1.WakeSource|undefined} value
+ * @return {!proto.viam.component.board.v1.GetPowerModeResponse} returns this
+*/
+proto.viam.component.board.v1.GetPowerModeResponse.prototype.setLastWakeSource = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.board.v1.GetPowerModeResponse} returns this
+ */
+proto.viam.component.board.v1.GetPowerModeResponse.prototype.clearLastWakeSource = function() {
+  return this.setLastWakeSource(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.board.v1.GetPowerModeResponse.prototype.hasLastWakeSource = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional google.protobuf.Timestamp last_wake_time = 4;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.viam.component.board.v1.GetPowerModeResponse.prototype.getLastWakeTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 4));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.viam.component.board.v1.GetPowerModeResponse} returns this
+*/
+proto.viam.component.board.v1.GetPowerModeResponse.prototype.setLastWakeTime = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.board.v1.GetPowerModeResponse} returns this
+ */
+proto.viam.component.board.v1.GetPowerModeResponse.prototype.clearLastWakeTime = function() {
+  return this.setLastWakeTime(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.board.v1.GetPowerModeResponse.prototype.hasLastWakeTime = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.viam.component.board.v1.Edge = {
+  EDGE_UNSPECIFIED: 0,
+  EDGE_RISING: 1,
+  EDGE_FALLING: 2,
+  EDGE_BOTH: 3
+};
+
 /**
  * @enum {number}
  */
 proto.viam.component.board.v1.PowerMode = {
   POWER_MODE_UNSPECIFIED: 0,
   POWER_MODE_NORMAL: 1,
-  POWER_MODE_OFFLINE_DEEP: 2
+  POWER_MODE_OFFLINE_DEEP: 2,
+  POWER_MODE_OFFLINE_LIGHT: 3,
+  POWER_MODE_THROTTLED: 4
+};
+
+/**
+ * @enum {number}
+ */
+proto.viam.component.board.v1.WakeReason = {
+  WAKE_REASON_UNSPECIFIED: 0,
+  WAKE_REASON_POWER_ON: 1,
+  WAKE_REASON_DURATION: 2,
+  WAKE_REASON_GPIO: 3,
+  WAKE_REASON_RTC: 4,
+  WAKE_REASON_ANALOG: 5
 };
 
 goog.object.extend(exports, proto.viam.component.board.v1);
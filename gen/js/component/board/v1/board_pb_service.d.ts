@@ -32,6 +32,15 @@ type BoardServiceGetGPIO = {
   readonly responseType: typeof component_board_v1_board_pb.GetGPIOResponse;
 };
 
+type BoardServiceStreamGPIOEdges = {
+  readonly methodName: string;
+  readonly service: typeof BoardService;
+  readonly requestStream: false;
+  readonly responseStream: true;
+  readonly requestType: typeof component_board_v1_board_pb.StreamGPIOEdgesRequest;
+  readonly responseType: typeof component_board_v1_board_pb.StreamGPIOEdgesResponse;
+};
+
 type BoardServicePWM = {
   readonly methodName: string;
   readonly service: typeof BoardService;
@@ -86,6 +95,15 @@ type BoardServiceReadAnalogReader = {
   readonly responseType: typeof component_board_v1_board_pb.ReadAnalogReaderResponse;
 };
 
+type BoardServiceStreamAnalogReaders = {
+  readonly methodName: string;
+  readonly service: typeof BoardService;
+  readonly requestStream: false;
+  readonly responseStream: true;
+  readonly requestType: typeof component_board_v1_board_pb.StreamAnalogReadersRequest;
+  readonly responseType: typeof component_board_v1_board_pb.StreamAnalogReadersResponse;
+};
+
 type BoardServiceGetDigitalInterruptValue = {
   readonly methodName: string;
   readonly service: typeof BoardService;
@@ -95,6 +113,42 @@ type BoardServiceGetDigitalInterruptValue = {
   readonly responseType: typeof component_board_v1_board_pb.GetDigitalInterruptValueResponse;
 };
 
+type BoardServiceStreamTicks = {
+  readonly methodName: string;
+  readonly service: typeof BoardService;
+  readonly requestStream: false;
+  readonly responseStream: true;
+  readonly requestType: typeof component_board_v1_board_pb.StreamTicksRequest;
+  readonly responseType: typeof component_board_v1_board_pb.StreamTicksResponse;
+};
+
+type BoardServiceI2CReadWrite = {
+  readonly methodName: string;
+  readonly service: typeof BoardService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof component_board_v1_board_pb.I2CReadWriteRequest;
+  readonly responseType: typeof component_board_v1_board_pb.I2CReadWriteResponse;
+};
+
+type BoardServiceSPITransfer = {
+  readonly methodName: string;
+  readonly service: typeof BoardService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof component_board_v1_board_pb.SPITransferRequest;
+  readonly responseType: typeof component_board_v1_board_pb.SPITransferResponse;
+};
+
+type BoardServiceSerialStream = {
+  readonly methodName: string;
+  readonly service: typeof BoardService;
+  readonly requestStream: true;
+  readonly responseStream: true;
+  readonly requestType: typeof component_board_v1_board_pb.SerialStreamRequest;
+  readonly responseType: typeof component_board_v1_board_pb.SerialStreamResponse;
+};
+
 type BoardServiceSetPowerMode = {
   readonly methodName: string;
   readonly service: typeof BoardService;
@@ -104,6 +158,15 @@ type BoardServiceSetPowerMode = {
   readonly responseType: typeof component_board_v1_board_pb.SetPowerModeResponse;
 };
 
+type BoardServiceGetPowerMode = {
+  readonly methodName: string;
+  readonly service: typeof BoardService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof component_board_v1_board_pb.GetPowerModeRequest;
+  readonly responseType: typeof component_board_v1_board_pb.GetPowerModeResponse;
+};
+
 type BoardServiceGetGeometries = {
   readonly methodName: string;
   readonly service: typeof BoardService;
@@ -118,14 +181,21 @@ export class BoardService {
   static readonly Status: BoardServiceStatus;
   static readonly SetGPIO: BoardServiceSetGPIO;
   static readonly GetGPIO: BoardServiceGetGPIO;
+  static readonly StreamGPIOEdges: BoardServiceStreamGPIOEdges;
   static readonly PWM: BoardServicePWM;
   static readonly SetPWM: BoardServiceSetPWM;
   static readonly PWMFrequency: BoardServicePWMFrequency;
   static readonly SetPWMFrequency: BoardServiceSetPWMFrequency;
   static readonly DoCommand: BoardServiceDoCommand;
   static readonly ReadAnalogReader: BoardServiceReadAnalogReader;
+  static readonly StreamAnalogReaders: BoardServiceStreamAnalogReaders;
   static readonly GetDigitalInterruptValue: BoardServiceGetDigitalInterruptValue;
+  static readonly StreamTicks: BoardServiceStreamTicks;
+  static readonly I2CReadWrite: BoardServiceI2CReadWrite;
+  static readonly SPITransfer: BoardServiceSPITransfer;
+  static readonly SerialStream: BoardServiceSerialStream;
   static readonly SetPowerMode: BoardServiceSetPowerMode;
+  static readonly GetPowerMode: BoardServiceGetPowerMode;
   static readonly GetGeometries: BoardServiceGetGeometries;
 }
 
@@ -188,6 +258,7 @@ export class BoardServiceClient {
     requestMessage: component_board_v1_board_pb.GetGPIORequest,
     callback: (error: ServiceError|null, responseMessage: component_board_v1_board_pb.GetGPIOResponse|null) => void
   ): UnaryResponse;
+  streamGPIOEdges(requestMessage: component_board_v1_board_pb.StreamGPIOEdgesRequest, metadata?: grpc.Metadata): ResponseStream<component_board_v1_board_pb.StreamGPIOEdgesResponse>;
   pWM(
     requestMessage: component_board_v1_board_pb.PWMRequest,
     metadata: grpc.Metadata,
@@ -242,6 +313,7 @@ export class BoardServiceClient {
     requestMessage: component_board_v1_board_pb.ReadAnalogReaderRequest,
     callback: (error: ServiceError|null, responseMessage: component_board_v1_board_pb.ReadAnalogReaderResponse|null) => void
   ): UnaryResponse;
+  streamAnalogReaders(requestMessage: component_board_v1_board_pb.StreamAnalogReadersRequest, metadata?: grpc.Metadata): ResponseStream<component_board_v1_board_pb.StreamAnalogReadersResponse>;
   getDigitalInterruptValue(
     requestMessage: component_board_v1_board_pb.GetDigitalInterruptValueRequest,
     metadata: grpc.Metadata,
@@ -251,6 +323,26 @@ export class BoardServiceClient {
     requestMessage: component_board_v1_board_pb.GetDigitalInterruptValueRequest,
     callback: (error: ServiceError|null, responseMessage: component_board_v1_board_pb.GetDigitalInterruptValueResponse|null) => void
   ): UnaryResponse;
+  streamTicks(requestMessage: component_board_v1_board_pb.StreamTicksRequest, metadata?: grpc.Metadata): ResponseStream<component_board_v1_board_pb.StreamTicksResponse>;
+  i2CReadWrite(
+    requestMessage: component_board_v1_board_pb.I2CReadWriteRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: component_board_v1_board_pb.I2CReadWriteResponse|null) => void
+  ): UnaryResponse;
+  i2CReadWrite(
+    requestMessage: component_board_v1_board_pb.I2CReadWriteRequest,
+    callback: (error: ServiceError|null, responseMessage: component_board_v1_board_pb.I2CReadWriteResponse|null) => void
+  ): UnaryResponse;
+  sPITransfer(
+    requestMessage: component_board_v1_board_pb.SPITransferRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: component_board_v1_board_pb.SPITransferResponse|null) => void
+  ): UnaryResponse;
+  sPITransfer(
+    requestMessage: component_board_v1_board_pb.SPITransferRequest,
+    callback: (error: ServiceError|null, responseMessage: component_board_v1_board_pb.SPITransferResponse|null) => void
+  ): UnaryResponse;
+  serialStream(metadata?: grpc.Metadata): BidirectionalStream<component_board_v1_board_pb.SerialStreamRequest, component_board_v1_board_pb.SerialStreamResponse>;
   setPowerMode(
     requestMessage: component_board_v1_board_pb.SetPowerModeRequest,
     metadata: grpc.Metadata,
@@ -260,6 +352,15 @@ export class BoardServiceClient {
     requestMessage: component_board_v1_board_pb.SetPowerModeRequest,
     callback: (error: ServiceError|null, responseMessage: component_board_v1_board_pb.SetPowerModeResponse|null) => void
   ): UnaryResponse;
+  getPowerMode(
+    requestMessage: component_board_v1_board_pb.GetPowerModeRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: component_board_v1_board_pb.GetPowerModeResponse|null) => void
+  ): UnaryResponse;
+  getPowerMode(
+    requestMessage: component_board_v1_board_pb.GetPowerModeRequest,
+    callback: (error: ServiceError|null, responseMessage: component_board_v1_board_pb.GetPowerModeResponse|null) => void
+  ): UnaryResponse;
   getGeometries(
     requestMessage: common_v1_common_pb.GetGeometriesRequest,
     metadata: grpc.Metadata,
@@ -38,6 +38,15 @@ BoardService.GetGPIO = {
   responseType: component_board_v1_board_pb.GetGPIOResponse
 };
 
+BoardService.StreamGPIOEdges = {
+  methodName: "StreamGPIOEdges",
+  service: BoardService,
+  requestStream: false,
+  responseStream: true,
+  requestType: component_board_v1_board_pb.StreamGPIOEdgesRequest,
+  responseType: component_board_v1_board_pb.StreamGPIOEdgesResponse
+};
+
 BoardService.PWM = {
   methodName: "PWM",
   service: BoardService,
@@ -92,6 +101,15 @@ BoardService.ReadAnalogReader = {
   responseType: component_board_v1_board_pb.ReadAnalogReaderResponse
 };
 
+BoardService.StreamAnalogReaders = {
+  methodName: "StreamAnalogReaders",
+  service: BoardService,
+  requestStream: false,
+  responseStream: true,
+  requestType: component_board_v1_board_pb.StreamAnalogReadersRequest,
+  responseType: component_board_v1_board_pb.StreamAnalogReadersResponse
+};
+
 BoardService.GetDigitalInterruptValue = {
   methodName: "GetDigitalInterruptValue",
   service: BoardService,
@@ -101,6 +119,42 @@ BoardService.GetDigitalInterruptValue = {
   responseType: component_board_v1_board_pb.GetDigitalInterruptValueResponse
 };
 
+BoardService.StreamTicks = {
+  methodName: "StreamTicks",
+  service: BoardService,
+  requestStream: false,
+  responseStream: true,
+  requestType: component_board_v1_board_pb.StreamTicksRequest,
+  responseType: component_board_v1_board_pb.StreamTicksResponse
+};
+
+BoardService.I2CReadWrite = {
+  methodName: "I2CReadWrite",
+  service: BoardService,
+  requestStream: false,
+  responseStream: false,
+  requestType: component_board_v1_board_pb.I2CReadWriteRequest,
+  responseType: component_board_v1_board_pb.I2CReadWriteResponse
+};
+
+BoardService.SPITransfer = {
+  methodName: "SPITransfer",
+  service: BoardService,
+  requestStream: false,
+  responseStream: false,
+  requestType: component_board_v1_board_pb.SPITransferRequest,
+  responseType: component_board_v1_board_pb.SPITransferResponse
+};
+
+BoardService.SerialStream = {
+  methodName: "SerialStream",
+  service: BoardService,
+  requestStream: true,
+  responseStream: true,
+  requestType: component_board_v1_board_pb.SerialStreamRequest,
+  responseType: component_board_v1_board_pb.SerialStreamResponse
+};
+
 BoardService.SetPowerMode = {
   methodName: "SetPowerMode",
   service: BoardService,
@@ -110,6 +164,15 @@ BoardService.SetPowerMode = {
   responseType: component_board_v1_board_pb.SetPowerModeResponse
 };
 
+BoardService.GetPowerMode = {
+  methodName: "GetPowerMode",
+  service: BoardService,
+  requestStream: false,
+  responseStream: false,
+  requestType: component_board_v1_board_pb.GetPowerModeRequest,
+  responseType: component_board_v1_board_pb.GetPowerModeResponse
+};
+
 BoardService.GetGeometries = {
   methodName: "GetGeometries",
   service: BoardService,
@@ -219,6 +282,45 @@ BoardServiceClient.prototype.getGPIO = function getGPIO(requestMessage, metadata
   };
 };
 
+BoardServiceClient.prototype.streamGPIOEdges = function streamGPIOEdges(requestMessage, metadata) {
+  var listeners = {
+    data: [],
+    end: [],
+    status: []
+  };
+  var client = grpc.invoke(BoardService.StreamGPIOEdges, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onMessage: function (responseMessage) {
+      listeners.data.forEach(function (handler) {
+        handler(responseMessage);
+      });
+    },
+    onEnd: function (status, statusMessage, trailers) {
+      listeners.status.forEach(function (handler) {
+        handler({ code: status, details: statusMessage, metadata: trailers });
+      });
+      listeners.end.forEach(function (handler) {
+        handler({ code: status, details: statusMessage, metadata: trailers });
+      });
+      listeners = null;
+    }
+  });
+  return {
+    on: function (type, handler) {
+      listeners[type].push(handler);
+      return this;
+    },
+    cancel: function () {
+      listeners = null;
+      client.close();
+    }
+  };
+};
+
 BoardServiceClient.prototype.pWM = function pWM(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
@@ -405,6 +507,45 @@ BoardServiceClient.prototype.readAnalogReader = function readAnalogReader(reques
   };
 };
 
+BoardServiceClient.prototype.streamAnalogReaders = function streamAnalogReaders(requestMessage, metadata) {
+  var listeners = {
+    data: [],
+    end: [],
+    status: []
+  };
+  var client = grpc.invoke(BoardService.StreamAnalogReaders, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onMessage: function (responseMessage) {
+      listeners.data.forEach(function (handler) {
+        handler(responseMessage);
+      });
+    },
+    onEnd: function (status, statusMessage, trailers) {
+      listeners.status.forEach(function (handler) {
+        handler({ code: status, details: statusMessage, metadata: trailers });
+      });
+      listeners.end.forEach(function (handler) {
+        handler({ code: status, details: statusMessage, metadata: trailers });
+      });
+      listeners = null;
+    }
+  });
+  return {
+    on: function (type, handler) {
+      listeners[type].push(handler);
+      return this;
+    },
+    cancel: function () {
+      listeners = null;
+      client.close();
+    }
+  };
+};
+
 BoardServiceClient.prototype.getDigitalInterruptValue = function getDigitalInterruptValue(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
@@ -436,6 +577,152 @@ BoardServiceClient.prototype.getDigitalInterruptValue = function getDigitalInter
   };
 };
 
+BoardServiceClient.prototype.streamTicks = function streamTicks(requestMessage, metadata) {
+  var listeners = {
+    data: [],
+    end: [],
+    status: []
+  };
+  var client = grpc.invoke(BoardService.StreamTicks, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onMessage: function (responseMessage) {
+      listeners.data.forEach(function (handler) {
+        handler(responseMessage);
+      });
+    },
+    onEnd: function (status, statusMessage, trailers) {
+      listeners.status.forEach(function (handler) {
+        handler({ code: status, details: statusMessage, metadata: trailers });
+      });
+      listeners.end.forEach(function (handler) {
+        handler({ code: status, details: statusMessage, metadata: trailers });
+      });
+      listeners = null;
+    }
+  });
+  return {
+    on: function (type, handler) {
+      listeners[type].push(handler);
+      return this;
+    },
+    cancel: function () {
+      listeners = null;
+      client.close();
+    }
+  };
+};
+
+BoardServiceClient.prototype.i2CReadWrite = function i2CReadWrite(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(BoardService.I2CReadWrite, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+BoardServiceClient.prototype.sPITransfer = function sPITransfer(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(BoardService.SPITransfer, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+BoardServiceClient.prototype.serialStream = function serialStream(metadata) {
+  var listeners = {
+    data: [],
+    end: [],
+    status: []
+  };
+  var client = grpc.client(BoardService.SerialStream, {
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport
+  });
+  client.onEnd(function (status, statusMessage, trailers) {
+    listeners.status.forEach(function (handler) {
+      handler({ code: status, details: statusMessage, metadata: trailers });
+    });
+    listeners.end.forEach(function (handler) {
+      handler({ code: status, details: statusMessage, metadata: trailers });
+    });
+    listeners = null;
+  });
+  client.onMessage(function (message) {
+    listeners.data.forEach(function (handler) {
+      handler(message);
+    })
+  });
+  client.start(metadata);
+  return {
+    on: function (type, handler) {
+      listeners[type].push(handler);
+      return this;
+    },
+    write: function (requestMessage) {
+      client.send(requestMessage);
+      return this;
+    },
+    end: function () {
+      client.finishSend();
+    },
+    cancel: function () {
+      listeners = null;
+      client.close();
+    }
+  };
+};
+
 BoardServiceClient.prototype.setPowerMode = function setPowerMode(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
@@ -467,6 +754,37 @@ BoardServiceClient.prototype.setPowerMode = function setPowerMode(requestMessage
   };
 };
 
+BoardServiceClient.prototype.getPowerMode = function getPowerMode(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(BoardService.GetPowerMode, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
 BoardServiceClient.prototype.getGeometries = function getGeometries(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
@@ -27,6 +27,8 @@ var google_api_annotations_pb = require('../../../google/api/annotations_pb.js')
 var google_api_httpbody_pb = require('../../../google/api/httpbody_pb.js')
 
 var google_protobuf_struct_pb = require('google-protobuf/google/protobuf/struct_pb.js')
+
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js')
 const proto = {};
 proto.viam = {};
 proto.viam.component = {};
@@ -207,6 +209,62 @@ proto.viam.component.camera.v1.CameraServicePromiseClient.prototype.getImages =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.viam.component.camera.v1.StreamImagesRequest,
+ *   !proto.viam.component.camera.v1.StreamImagesResponse>}
+ */
+const methodDescriptor_CameraService_StreamImages = new grpc.web.MethodDescriptor(
+  '/viam.component.camera.v1.CameraService/StreamImages',
+  grpc.web.MethodType.SERVER_STREAMING,
+  proto.viam.component.camera.v1.StreamImagesRequest,
+  proto.viam.component.camera.v1.StreamImagesResponse,
+  /**
+   * @param {!proto.viam.component.camera.v1.StreamImagesRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.viam.component.camera.v1.StreamImagesResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.viam.component.camera.v1.StreamImagesRequest} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.viam.component.camera.v1.StreamImagesResponse>}
+ *     The XHR Node Readable Stream
+ */
+proto.viam.component.camera.v1.CameraServiceClient.prototype.streamImages =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/viam.component.camera.v1.CameraService/StreamImages',
+      request,
+      metadata || {},
+      methodDescriptor_CameraService_StreamImages);
+};
+
+
+/**
+ * @param {!proto.viam.component.camera.v1.StreamImagesRequest} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.viam.component.camera.v1.StreamImagesResponse>}
+ *     The XHR Node Readable Stream
+ */
+proto.viam.component.camera.v1.CameraServicePromiseClient.prototype.streamImages =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/viam.component.camera.v1.CameraService/StreamImages',
+      request,
+      metadata || {},
+      methodDescriptor_CameraService_StreamImages);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -329,6 +387,62 @@ proto.viam.component.camera.v1.CameraServicePromiseClient.prototype.getPointClou
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.viam.component.camera.v1.GetPointCloudStreamRequest,
+ *   !proto.viam.component.camera.v1.GetPointCloudStreamResponse>}
+ */
+const methodDescriptor_CameraService_GetPointCloudStream = new grpc.web.MethodDescriptor(
+  '/viam.component.camera.v1.CameraService/GetPointCloudStream',
+  grpc.web.MethodType.SERVER_STREAMING,
+  proto.viam.component.camera.v1.GetPointCloudStreamRequest,
+  proto.viam.component.camera.v1.GetPointCloudStreamResponse,
+  /**
+   * @param {!proto.viam.component.camera.v1.GetPointCloudStreamRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.viam.component.camera.v1.GetPointCloudStreamResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.viam.component.camera.v1.GetPointCloudStreamRequest} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.viam.component.camera.v1.GetPointCloudStreamResponse>}
+ *     The XHR Node Readable Stream
+ */
+proto.viam.component.camera.v1.CameraServiceClient.prototype.getPointCloudStream =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/viam.component.camera.v1.CameraService/GetPointCloudStream',
+      request,
+      metadata || {},
+      methodDescriptor_CameraService_GetPointCloudStream);
+};
+
+
+/**
+ * @param {!proto.viam.component.camera.v1.GetPointCloudStreamRequest} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.viam.component.camera.v1.GetPointCloudStreamResponse>}
+ *     The XHR Node Readable Stream
+ */
+proto.viam.component.camera.v1.CameraServicePromiseClient.prototype.getPointCloudStream =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/viam.component.camera.v1.CameraService/GetPointCloudStream',
+      request,
+      metadata || {},
+      methodDescriptor_CameraService_GetPointCloudStream);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -390,6 +504,128 @@ proto.viam.component.camera.v1.CameraServicePromiseClient.prototype.getPropertie
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.viam.component.camera.v1.GetControlsRequest,
+ *   !proto.viam.component.camera.v1.GetControlsResponse>}
+ */
+const methodDescriptor_CameraService_GetControls = new grpc.web.MethodDescriptor(
+  '/viam.component.camera.v1.CameraService/GetControls',
+  grpc.web.MethodType.UNARY,
+  proto.viam.component.camera.v1.GetControlsRequest,
+  proto.viam.component.camera.v1.GetControlsResponse,
+  /**
+   * @param {!proto.viam.component.camera.v1.GetControlsRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.viam.component.camera.v1.GetControlsResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.viam.component.camera.v1.GetControlsRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.viam.component.camera.v1.GetControlsResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.viam.component.camera.v1.GetControlsResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.viam.component.camera.v1.CameraServiceClient.prototype.getControls =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/viam.component.camera.v1.CameraService/GetControls',
+      request,
+      metadata || {},
+      methodDescriptor_CameraService_GetControls,
+      callback);
+};
+
+
+/**
+ * @param {!proto.viam.component.camera.v1.GetControlsRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.viam.component.camera.v1.GetControlsResponse>}
+ *     Promise that resolves to the response
+ */
+proto.viam.component.camera.v1.CameraServicePromiseClient.prototype.getControls =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/viam.component.camera.v1.CameraService/GetControls',
+      request,
+      metadata || {},
+      methodDescriptor_CameraService_GetControls);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.viam.component.camera.v1.SetControlsRequest,
+ *   !proto.viam.component.camera.v1.SetControlsResponse>}
+ */
+const methodDescriptor_CameraService_SetControls = new grpc.web.MethodDescriptor(
+  '/viam.component.camera.v1.CameraService/SetControls',
+  grpc.web.MethodType.UNARY,
+  proto.viam.component.camera.v1.SetControlsRequest,
+  proto.viam.component.camera.v1.SetControlsResponse,
+  /**
+   * @param {!proto.viam.component.camera.v1.SetControlsRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.viam.component.camera.v1.SetControlsResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.viam.component.camera.v1.SetControlsRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.viam.component.camera.v1.SetControlsResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.viam.component.camera.v1.SetControlsResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.viam.component.camera.v1.CameraServiceClient.prototype.setControls =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/viam.component.camera.v1.CameraService/SetControls',
+      request,
+      metadata || {},
+      methodDescriptor_CameraService_SetControls,
+      callback);
+};
+
+
+/**
+ * @param {!proto.viam.component.camera.v1.SetControlsRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.viam.component.camera.v1.SetControlsResponse>}
+ *     Promise that resolves to the response
+ */
+proto.viam.component.camera.v1.CameraServicePromiseClient.prototype.setControls =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/viam.component.camera.v1.CameraService/SetControls',
+      request,
+      metadata || {},
+      methodDescriptor_CameraService_SetControls);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -6,6 +6,7 @@ import * as common_v1_common_pb from "../../../common/v1/common_pb";
 import * as google_api_annotations_pb from "../../../google/api/annotations_pb";
 import * as google_api_httpbody_pb from "../../../google/api/httpbody_pb";
 import * as google_protobuf_struct_pb from "google-protobuf/google/protobuf/struct_pb";
+import * as google_protobuf_timestamp_pb from "google-protobuf/google/protobuf/timestamp_pb";
 
 export class GetImageRequest extends jspb.Message {
   getName(): string;
@@ -67,6 +68,21 @@ export class GetImagesRequest extends jspb.Message {
   getName(): string;
   setName(value: string): void;
 
+  clearFilterSourceNamesList(): void;
+  getFilterSourceNamesList(): Array<string>;
+  setFilterSourceNamesList(value: Array<string>): void;
+  addFilterSourceNames(value: string, index?: number): string;
+
+  getFormatsMap(): jspb.Map<string, FormatMap[keyof FormatMap]>;
+  clearFormatsMap(): void;
+  getAlignDepthToColor(): boolean;
+  setAlignDepthToColor(value: boolean): void;
+
+  hasExtra(): boolean;
+  clearExtra(): void;
+  getExtra(): google_protobuf_struct_pb.Struct | undefined;
+  setExtra(value?: google_protobuf_struct_pb.Struct): void;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): GetImagesRequest.AsObject;
   static toObject(includeInstance: boolean, msg: GetImagesRequest): GetImagesRequest.AsObject;
@@ -80,6 +96,10 @@ export class GetImagesRequest extends jspb.Message {
 export namespace GetImagesRequest {
   export type AsObject = {
     name: string,
+    filterSourceNamesList: Array<string>,
+    formatsMap: Array<[string, FormatMap[keyof FormatMap]]>,
+    alignDepthToColor: boolean,
+    extra?: google_protobuf_struct_pb.Struct.AsObject,
   }
 }
 
@@ -123,6 +143,14 @@ export class Image extends jspb.Message {
   getImage_asB64(): string;
   setImage(value: Uint8Array | string): void;
 
+  hasCapturedAt(): boolean;
+  clearCapturedAt(): void;
+  getCapturedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setCapturedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  getAlignedToSourceName(): string;
+  setAlignedToSourceName(value: string): void;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): Image.AsObject;
   static toObject(includeInstance: boolean, msg: Image): Image.AsObject;
@@ -138,6 +166,104 @@ export namespace Image {
     sourceName: string,
     format: FormatMap[keyof FormatMap],
     image: Uint8Array | string,
+    capturedAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    alignedToSourceName: string,
+  }
+}
+
+export class StreamImagesRequest extends jspb.Message {
+  getName(): string;
+  setName(value: string): void;
+
+  clearCodecsList(): void;
+  getCodecsList(): Array<VideoCodecMap[keyof VideoCodecMap]>;
+  setCodecsList(value: Array<VideoCodecMap[keyof VideoCodecMap]>): void;
+  addCodecs(value: VideoCodecMap[keyof VideoCodecMap], index?: number): VideoCodecMap[keyof VideoCodecMap];
+
+  getTargetFps(): number;
+  setTargetFps(value: number): void;
+
+  getWidthPx(): number;
+  setWidthPx(value: number): void;
+
+  getHeightPx(): number;
+  setHeightPx(value: number): void;
+
+  getKeyframeInterval(): number;
+  setKeyframeInterval(value: number): void;
+
+  hasExtra(): boolean;
+  clearExtra(): void;
+  getExtra(): google_protobuf_struct_pb.Struct | undefined;
+  setExtra(value?: google_protobuf_struct_pb.Struct): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StreamImagesRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: StreamImagesRequest): StreamImagesRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: StreamImagesRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StreamImagesRequest;
+  static deserializeBinaryFromReader(message: StreamImagesRequest, reader: jspb.BinaryReader): StreamImagesRequest;
+}
+
+export namespace StreamImagesRequest {
+  export type AsObject = {
+    name: string,
+    codecsList: Array<VideoCodecMap[keyof VideoCodecMap]>,
+    targetFps: number,
+    widthPx: number,
+    heightPx: number,
+    keyframeInterval: number,
+    extra?: google_protobuf_struct_pb.Struct.AsObject,
+  }
+}
+
+export class StreamImagesResponse extends jspb.Message {
+  getCodec(): VideoCodecMap[keyof VideoCodecMap];
+  setCodec(value: VideoCodecMap[keyof VideoCodecMap]): void;
+
+  getData(): Uint8Array | string;
+  getData_asU8(): Uint8Array;
+  getData_asB64(): string;
+  setData(value: Uint8Array | string): void;
+
+  getWidthPx(): number;
+  setWidthPx(value: number): void;
+
+  getHeightPx(): number;
+  setHeightPx(value: number): void;
+
+  getKeyframe(): boolean;
+  setKeyframe(value: boolean): void;
+
+  getSequenceNumber(): number;
+  setSequenceNumber(value: number): void;
+
+  hasResponseMetadata(): boolean;
+  clearResponseMetadata(): void;
+  getResponseMetadata(): common_v1_common_pb.ResponseMetadata | undefined;
+  setResponseMetadata(value?: common_v1_common_pb.ResponseMetadata): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StreamImagesResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: StreamImagesResponse): StreamImagesResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: StreamImagesResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StreamImagesResponse;
+  static deserializeBinaryFromReader(message: StreamImagesResponse, reader: jspb.BinaryReader): StreamImagesResponse;
+}
+
+export namespace StreamImagesResponse {
+  export type AsObject = {
+    codec: VideoCodecMap[keyof VideoCodecMap],
+    data: Uint8Array | string,
+    widthPx: number,
+    heightPx: number,
+    keyframe: boolean,
+    sequenceNumber: number,
+    responseMetadata?: common_v1_common_pb.ResponseMetadata.AsObject,
   }
 }
 
@@ -227,6 +353,90 @@ export namespace GetPointCloudResponse {
   }
 }
 
+export class GetPointCloudStreamRequest extends jspb.Message {
+  getName(): string;
+  setName(value: string): void;
+
+  getMimeType(): string;
+  setMimeType(value: string): void;
+
+  getContinuous(): boolean;
+  setContinuous(value: boolean): void;
+
+  getMaxChunkSizeBytes(): number;
+  setMaxChunkSizeBytes(value: number): void;
+
+  hasExtra(): boolean;
+  clearExtra(): void;
+  getExtra(): google_protobuf_struct_pb.Struct | undefined;
+  setExtra(value?: google_protobuf_struct_pb.Struct): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetPointCloudStreamRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: GetPointCloudStreamRequest): GetPointCloudStreamRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetPointCloudStreamRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetPointCloudStreamRequest;
+  static deserializeBinaryFromReader(message: GetPointCloudStreamRequest, reader: jspb.BinaryReader): GetPointCloudStreamRequest;
+}
+
+export namespace GetPointCloudStreamRequest {
+  export type AsObject = {
+    name: string,
+    mimeType: string,
+    continuous: boolean,
+    maxChunkSizeBytes: number,
+    extra?: google_protobuf_struct_pb.Struct.AsObject,
+  }
+}
+
+export class GetPointCloudStreamResponse extends jspb.Message {
+  getMimeType(): string;
+  setMimeType(value: string): void;
+
+  getPointCloudChunk(): Uint8Array | string;
+  getPointCloudChunk_asU8(): Uint8Array;
+  getPointCloudChunk_asB64(): string;
+  setPointCloudChunk(value: Uint8Array | string): void;
+
+  getScanNumber(): number;
+  setScanNumber(value: number): void;
+
+  getLastChunk(): boolean;
+  setLastChunk(value: boolean): void;
+
+  hasSensorPose(): boolean;
+  clearSensorPose(): void;
+  getSensorPose(): common_v1_common_pb.PoseInFrame | undefined;
+  setSensorPose(value?: common_v1_common_pb.PoseInFrame): void;
+
+  hasResponseMetadata(): boolean;
+  clearResponseMetadata(): void;
+  getResponseMetadata(): common_v1_common_pb.ResponseMetadata | undefined;
+  setResponseMetadata(value?: common_v1_common_pb.ResponseMetadata): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetPointCloudStreamResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetPointCloudStreamResponse): GetPointCloudStreamResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetPointCloudStreamResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetPointCloudStreamResponse;
+  static deserializeBinaryFromReader(message: GetPointCloudStreamResponse, reader: jspb.BinaryReader): GetPointCloudStreamResponse;
+}
+
+export namespace GetPointCloudStreamResponse {
+  export type AsObject = {
+    mimeType: string,
+    pointCloudChunk: Uint8Array | string,
+    scanNumber: number,
+    lastChunk: boolean,
+    sensorPose?: common_v1_common_pb.PoseInFrame.AsObject,
+    responseMetadata?: common_v1_common_pb.ResponseMetadata.AsObject,
+  }
+}
+
 export class GetPropertiesRequest extends jspb.Message {
   getName(): string;
   setName(value: string): void;
@@ -279,6 +489,219 @@ export namespace GetPropertiesResponse {
   }
 }
 
+export class GetControlsRequest extends jspb.Message {
+  getName(): string;
+  setName(value: string): void;
+
+  hasExtra(): boolean;
+  clearExtra(): void;
+  getExtra(): google_protobuf_struct_pb.Struct | undefined;
+  setExtra(value?: google_protobuf_struct_pb.Struct): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetControlsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: GetControlsRequest): GetControlsRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetControlsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetControlsRequest;
+  static deserializeBinaryFromReader(message: GetControlsRequest, reader: jspb.BinaryReader): GetControlsRequest;
+}
+
+export namespace GetControlsRequest {
+  export type AsObject = {
+    name: string,
+    extra?: google_protobuf_struct_pb.Struct.AsObject,
+  }
+}
+
+export class GetControlsResponse extends jspb.Message {
+  clearControlsList(): void;
+  getControlsList(): Array<ControlInfo>;
+  setControlsList(value: Array<ControlInfo>): void;
+  addControls(value?: ControlInfo, index?: number): ControlInfo;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetControlsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetControlsResponse): GetControlsResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetControlsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetControlsResponse;
+  static deserializeBinaryFromReader(message: GetControlsResponse, reader: jspb.BinaryReader): GetControlsResponse;
+}
+
+export namespace GetControlsResponse {
+  export type AsObject = {
+    controlsList: Array<ControlInfo.AsObject>,
+  }
+}
+
+export class SetControlsRequest extends jspb.Message {
+  getName(): string;
+  setName(value: string): void;
+
+  clearControlsList(): void;
+  getControlsList(): Array<Control>;
+  setControlsList(value: Array<Control>): void;
+  addControls(value?: Control, index?: number): Control;
+
+  hasExtra(): boolean;
+  clearExtra(): void;
+  getExtra(): google_protobuf_struct_pb.Struct | undefined;
+  setExtra(value?: google_protobuf_struct_pb.Struct): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SetControlsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: SetControlsRequest): SetControlsRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SetControlsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetControlsRequest;
+  static deserializeBinaryFromReader(message: SetControlsRequest, reader: jspb.BinaryReader): SetControlsRequest;
+}
+
+export namespace SetControlsRequest {
+  export type AsObject = {
+    name: string,
+    controlsList: Array<Control.AsObject>,
+    extra?: google_protobuf_struct_pb.Struct.AsObject,
+  }
+}
+
+export class SetControlsResponse extends jspb.Message {
+  clearControlsList(): void;
+  getControlsList(): Array<Control>;
+  setControlsList(value: Array<Control>): void;
+  addControls(value?: Control, index?: number): Control;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SetControlsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: SetControlsResponse): SetControlsResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SetControlsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetControlsResponse;
+  static deserializeBinaryFromReader(message: SetControlsResponse, reader: jspb.BinaryReader): SetControlsResponse;
+}
+
+export namespace SetControlsResponse {
+  export type AsObject = {
+    controlsList: Array<Control.AsObject>,
+  }
+}
+
+export class Control extends jspb.Message {
+  getType(): ControlTypeMap[keyof ControlTypeMap];
+  setType(value: ControlTypeMap[keyof ControlTypeMap]): void;
+
+  hasNumber(): boolean;
+  clearNumber(): void;
+  getNumber(): number;
+  setNumber(value: number): void;
+
+  hasResolution(): boolean;
+  clearResolution(): void;
+  getResolution(): Resolution | undefined;
+  setResolution(value?: Resolution): void;
+
+  getAutoMode(): boolean;
+  setAutoMode(value: boolean): void;
+
+  getValueCase(): Control.ValueCase;
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Control.AsObject;
+  static toObject(includeInstance: boolean, msg: Control): Control.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: Control, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Control;
+  static deserializeBinaryFromReader(message: Control, reader: jspb.BinaryReader): Control;
+}
+
+export namespace Control {
+  export type AsObject = {
+    type: ControlTypeMap[keyof ControlTypeMap],
+    number: number,
+    resolution?: Resolution.AsObject,
+    autoMode: boolean,
+  }
+
+  export enum ValueCase {
+    VALUE_NOT_SET = 0,
+    NUMBER = 2,
+    RESOLUTION = 3,
+  }
+}
+
+export class Resolution extends jspb.Message {
+  getWidthPx(): number;
+  setWidthPx(value: number): void;
+
+  getHeightPx(): number;
+  setHeightPx(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Resolution.AsObject;
+  static toObject(includeInstance: boolean, msg: Resolution): Resolution.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: Resolution, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Resolution;
+  static deserializeBinaryFromReader(message: Resolution, reader: jspb.BinaryReader): Resolution;
+}
+
+export namespace Resolution {
+  export type AsObject = {
+    widthPx: number,
+    heightPx: number,
+  }
+}
+
+export class ControlInfo extends jspb.Message {
+  hasControl(): boolean;
+  clearControl(): void;
+  getControl(): Control | undefined;
+  setControl(value?: Control): void;
+
+  getMin(): number;
+  setMin(value: number): void;
+
+  getMax(): number;
+  setMax(value: number): void;
+
+  getStep(): number;
+  setStep(value: number): void;
+
+  clearResolutionsList(): void;
+  getResolutionsList(): Array<Resolution>;
+  setResolutionsList(value: Array<Resolution>): void;
+  addResolutions(value?: Resolution, index?: number): Resolution;
+
+  getSupportsAuto(): boolean;
+  setSupportsAuto(value: boolean): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ControlInfo.AsObject;
+  static toObject(includeInstance: boolean, msg: ControlInfo): ControlInfo.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ControlInfo, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ControlInfo;
+  static deserializeBinaryFromReader(message: ControlInfo, reader: jspb.BinaryReader): ControlInfo;
+}
+
+export namespace ControlInfo {
+  export type AsObject = {
+    control?: Control.AsObject,
+    min: number,
+    max: number,
+    step: number,
+    resolutionsList: Array<Resolution.AsObject>,
+    supportsAuto: boolean,
+  }
+}
+
 export class Webcams extends jspb.Message {
   clearWebcamsList(): void;
   getWebcamsList(): Array<Webcam>;
@@ -447,3 +870,25 @@ export interface FormatMap {
 
 export const Format: FormatMap;
 
+export interface VideoCodecMap {
+  VIDEO_CODEC_UNSPECIFIED: 0;
+  VIDEO_CODEC_MJPEG: 1;
+  VIDEO_CODEC_H264: 2;
+  VIDEO_CODEC_RAW_RGBA: 3;
+}
+
+export const VideoCodec: VideoCodecMap;
+
+export interface ControlTypeMap {
+  CONTROL_TYPE_UNSPECIFIED: 0;
+  CONTROL_TYPE_EXPOSURE: 1;
+  CONTROL_TYPE_GAIN: 2;
+  CONTROL_TYPE_WHITE_BALANCE: 3;
+  CONTROL_TYPE_FOCUS: 4;
+  CONTROL_TYPE_ZOOM: 5;
+  CONTROL_TYPE_FRAME_RATE: 6;
+  CONTROL_TYPE_RESOLUTION: 7;
+}
+
+export const ControlType: ControlTypeMap;
+
@@ -23,20 +23,36 @@ var google_api_httpbody_pb = require('../../../google/api/httpbody_pb.js');
 goog.object.extend(proto, google_api_httpbody_pb);
 var google_protobuf_struct_pb = require('google-protobuf/google/protobuf/struct_pb.js');
 goog.object.extend(proto, google_protobuf_struct_pb);
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
+goog.object.extend(proto, google_protobuf_timestamp_pb);
+goog.exportSymbol('proto.viam.component.camera.v1.Control', null, global);
+goog.exportSymbol('proto.viam.component.camera.v1.Control.ValueCase', null, global);
+goog.exportSymbol('proto.viam.component.camera.v1.ControlInfo', null, global);
+goog.exportSymbol('proto.viam.component.camera.v1.ControlType', null, global);
 goog.exportSymbol('proto.viam.component.camera.v1.DistortionParameters', null, global);
 goog.exportSymbol('proto.viam.component.camera.v1.Format', null, global);
+goog.exportSymbol('proto.viam.component.camera.v1.GetControlsRequest', null, global);
+goog.exportSymbol('proto.viam.component.camera.v1.GetControlsResponse', null, global);
 goog.exportSymbol('proto.viam.component.camera.v1.GetImageRequest', null, global);
 goog.exportSymbol('proto.viam.component.camera.v1.GetImageResponse', null, global);
 goog.exportSymbol('proto.viam.component.camera.v1.GetImagesRequest', null, global);
 goog.exportSymbol('proto.viam.component.camera.v1.GetImagesResponse', null, global);
 goog.exportSymbol('proto.viam.component.camera.v1.GetPointCloudRequest', null, global);
 goog.exportSymbol('proto.viam.component.camera.v1.GetPointCloudResponse', null, global);
+goog.exportSymbol('proto.viam.component.camera.v1.GetPointCloudStreamRequest', null, global);
+goog.exportSymbol('proto.viam.component.camera.v1.GetPointCloudStreamResponse', null, global);
 goog.exportSymbol('proto.viam.component.camera.v1.GetPropertiesRequest', null, global);
 goog.exportSymbol('proto.viam.component.camera.v1.GetPropertiesResponse', null, global);
 goog.exportSymbol('proto.viam.component.camera.v1.Image', null, global);
 goog.exportSymbol('proto.viam.component.camera.v1.IntrinsicParameters', null, global);
 goog.exportSymbol('proto.viam.component.camera.v1.Property', null, global);
 goog.exportSymbol('proto.viam.component.camera.v1.RenderFrameRequest', null, global);
+goog.exportSymbol('proto.viam.component.camera.v1.Resolution', null, global);
+goog.exportSymbol('proto.viam.component.camera.v1.SetControlsRequest', null, global);
+goog.exportSymbol('proto.viam.component.camera.v1.SetControlsResponse', null, global);
+goog.exportSymbol('proto.viam.component.camera.v1.StreamImagesRequest', null, global);
+goog.exportSymbol('proto.viam.component.camera.v1.StreamImagesResponse', null, global);
+goog.exportSymbol('proto.viam.component.camera.v1.VideoCodec', null, global);
 goog.exportSymbol('proto.viam.component.camera.v1.Webcam', null, global);
 goog.exportSymbol('proto.viam.component.camera.v1.Webcams', null, global);
 /**
@@ -92,7 +108,7 @@ if (goog.DEBUG && !COMPILED) {
  * @constructor
  */
 proto.viam.component.camera.v1.GetImagesRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.viam.component.camera.v1.GetImagesRequest.repeatedFields_, null);
 };
 goog.inherits(proto.viam.component.camera.v1.GetImagesRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
@@ -144,6 +160,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.viam.component.camera.v1.Image.displayName = 'proto.viam.component.camera.v1.Image';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.camera.v1.StreamImagesRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.viam.component.camera.v1.StreamImagesRequest.repeatedFields_, null);
+};
+goog.inherits(proto.viam.component.camera.v1.StreamImagesRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.camera.v1.StreamImagesRequest.displayName = 'proto.viam.component.camera.v1.StreamImagesRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.camera.v1.StreamImagesResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, 500, null, null);
+};
+goog.inherits(proto.viam.component.camera.v1.StreamImagesResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.camera.v1.StreamImagesResponse.displayName = 'proto.viam.component.camera.v1.StreamImagesResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -207,6 +265,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.viam.component.camera.v1.GetPointCloudResponse.displayName = 'proto.viam.component.camera.v1.GetPointCloudResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.component.camera.v1.GetPointCloudStreamRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.camera.v1.GetPointCloudStreamRequest.displayName = 'proto.viam.component.camera.v1.GetPointCloudStreamRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, 500, null, null);
+};
+goog.inherits(proto.viam.component.camera.v1.GetPointCloudStreamResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.camera.v1.GetPointCloudStreamResponse.displayName = 'proto.viam.component.camera.v1.GetPointCloudStreamResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -249,6 +349,153 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.viam.component.camera.v1.GetPropertiesResponse.displayName = 'proto.viam.component.camera.v1.GetPropertiesResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.camera.v1.GetControlsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.component.camera.v1.GetControlsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.camera.v1.GetControlsRequest.displayName = 'proto.viam.component.camera.v1.GetControlsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.camera.v1.GetControlsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.viam.component.camera.v1.GetControlsResponse.repeatedFields_, null);
+};
+goog.inherits(proto.viam.component.camera.v1.GetControlsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.camera.v1.GetControlsResponse.displayName = 'proto.viam.component.camera.v1.GetControlsResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.camera.v1.SetControlsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.viam.component.camera.v1.SetControlsRequest.repeatedFields_, null);
+};
+goog.inherits(proto.viam.component.camera.v1.SetControlsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.camera.v1.SetControlsRequest.displayName = 'proto.viam.component.camera.v1.SetControlsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.camera.v1.SetControlsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.viam.component.camera.v1.SetControlsResponse.repeatedFields_, null);
+};
+goog.inherits(proto.viam.component.camera.v1.SetControlsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.camera.v1.SetControlsResponse.displayName = 'proto.viam.component.camera.v1.SetControlsResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.camera.v1.Control = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, proto.viam.component.camera.v1.Control.oneofGroups_);
+};
+goog.inherits(proto.viam.component.camera.v1.Control, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.camera.v1.Control.displayName = 'proto.viam.component.camera.v1.Control';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.camera.v1.Resolution = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.component.camera.v1.Resolution, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.camera.v1.Resolution.displayName = 'proto.viam.component.camera.v1.Resolution';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.camera.v1.ControlInfo = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.viam.component.camera.v1.ControlInfo.repeatedFields_, null);
+};
+goog.inherits(proto.viam.component.camera.v1.ControlInfo, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.camera.v1.ControlInfo.displayName = 'proto.viam.component.camera.v1.ControlInfo';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -750,6 +997,13 @@ proto.viam.component.camera.v1.GetImageResponse.prototype.setImage = function(va
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.component.camera.v1.GetImagesRequest.repeatedFields_ = [2];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -781,7 +1035,11 @@ proto.viam.component.camera.v1.GetImagesRequest.prototype.toObject = function(op
  */
 proto.viam.component.camera.v1.GetImagesRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, "")
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    filterSourceNamesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+    formatsMap: (f = msg.getFormatsMap()) ? f.toObject(includeInstance, undefined) : [],
+    alignDepthToColor: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -822,6 +1080,25 @@ proto.viam.component.camera.v1.GetImagesRequest.deserializeBinaryFromReader = fu
       var value = /** @type {string} */ (reader.readString());
       msg.setName(value);
       break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addFilterSourceNames(value);
+      break;
+    case 3:
+      var value = msg.getFormatsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readEnum, null, "", 0);
+         });
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setAlignDepthToColor(value);
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -858,6 +1135,32 @@ proto.viam.component.camera.v1.GetImagesRequest.serializeBinaryToWriter = functi
       f
     );
   }
+  f = message.getFilterSourceNamesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
+  f = message.getFormatsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeEnum);
+  }
+  f = message.getAlignDepthToColor();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -879,36 +1182,150 @@ proto.viam.component.camera.v1.GetImagesRequest.prototype.setName = function(val
 };
 
 
-
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * repeated string filter_source_names = 2;
+ * @return {!Array<string>}
  */
-proto.viam.component.camera.v1.GetImagesResponse.repeatedFields_ = [1];
-
+proto.viam.component.camera.v1.GetImagesRequest.prototype.getFilterSourceNamesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * @param {!Array<string>} value
+ * @return {!proto.viam.component.camera.v1.GetImagesRequest} returns this
  */
-proto.viam.component.camera.v1.GetImagesResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.camera.v1.GetImagesResponse.toObject(opt_includeInstance, this);
+proto.viam.component.camera.v1.GetImagesRequest.prototype.setFilterSourceNamesList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.viam.component.camera.v1.GetImagesRequest} returns this
+ */
+proto.viam.component.camera.v1.GetImagesRequest.prototype.addFilterSourceNames = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.component.camera.v1.GetImagesRequest} returns this
+ */
+proto.viam.component.camera.v1.GetImagesRequest.prototype.clearFilterSourceNamesList = function() {
+  return this.setFilterSourceNamesList([]);
+};
+
+
+/**
+ * map<string, Format> formats = 3;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.viam.component.camera.v1.Format>}
+ */
+proto.viam.component.camera.v1.GetImagesRequest.prototype.getFormatsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.viam.component.camera.v1.Format>} */ (
+      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.viam.component.camera.v1.GetImagesRequest} returns this
+ */
+proto.viam.component.camera.v1.GetImagesRequest.prototype.clearFormatsMap = function() {
+  this.getFormatsMap().clear();
+  return this;};
+
+
+/**
+ * optional bool align_depth_to_color = 4;
+ * @return {boolean}
+ */
+proto.viam.component.camera.v1.GetImagesRequest.prototype.getAlignDepthToColor = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.viam.component.camera.v1.GetImagesRequest} returns this
+ */
+proto.viam.component.camera.v1.GetImagesRequest.prototype.setAlignDepthToColor = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.component.camera.v1.GetImagesRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.component.camera.v1.GetImagesRequest} returns this
+*/
+proto.viam.component.camera.v1.GetImagesRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.camera.v1.GetImagesRequest} returns this
+ */
+proto.viam.component.camera.v1.GetImagesRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.camera.v1.GetImagesRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.component.camera.v1.GetImagesResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.camera.v1.GetImagesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.camera.v1.GetImagesResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
@@ -1124,7 +1541,9 @@ proto.viam.component.camera.v1.Image.toObject = function(includeInstance, msg) {
   var f, obj = {
     sourceName: jspb.Message.getFieldWithDefault(msg, 1, ""),
     format: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    image: msg.getImage_asB64()
+    image: msg.getImage_asB64(),
+    capturedAt: (f = msg.getCapturedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    alignedToSourceName: jspb.Message.getFieldWithDefault(msg, 5, "")
   };
 
   if (includeInstance) {
@@ -1173,6 +1592,15 @@ proto.viam.component.camera.v1.Image.deserializeBinaryFromReader = function(msg,
       var value = /** @type {!Uint8Array} */ (reader.readBytes());
       msg.setImage(value);
       break;
+    case 4:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setCapturedAt(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAlignedToSourceName(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1223,6 +1651,21 @@ proto.viam.component.camera.v1.Image.serializeBinaryToWriter = function(message,
       f
     );
   }
+  f = message.getCapturedAt();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getAlignedToSourceName();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
 };
 
 
@@ -1304,6 +1747,68 @@ proto.viam.component.camera.v1.Image.prototype.setImage = function(value) {
 };
 
 
+/**
+ * optional google.protobuf.Timestamp captured_at = 4;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.viam.component.camera.v1.Image.prototype.getCapturedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 4));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.viam.component.camera.v1.Image} returns this
+*/
+proto.viam.component.camera.v1.Image.prototype.setCapturedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.camera.v1.Image} returns this
+ */
+proto.viam.component.camera.v1.Image.prototype.clearCapturedAt = function() {
+  return this.setCapturedAt(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.camera.v1.Image.prototype.hasCapturedAt = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional string aligned_to_source_name = 5;
+ * @return {string}
+ */
+proto.viam.component.camera.v1.Image.prototype.getAlignedToSourceName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.camera.v1.Image} returns this
+ */
+proto.viam.component.camera.v1.Image.prototype.setAlignedToSourceName = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.component.camera.v1.StreamImagesRequest.repeatedFields_ = [2];
 
 
 
@@ -1320,8 +1825,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.camera.v1.RenderFrameRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.camera.v1.RenderFrameRequest.toObject(opt_includeInstance, this);
+proto.viam.component.camera.v1.StreamImagesRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.camera.v1.StreamImagesRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -1330,14 +1835,18 @@ proto.viam.component.camera.v1.RenderFrameRequest.prototype.toObject = function(
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.camera.v1.RenderFrameRequest} msg The msg instance to transform.
+ * @param {!proto.viam.component.camera.v1.StreamImagesRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.camera.v1.RenderFrameRequest.toObject = function(includeInstance, msg) {
+proto.viam.component.camera.v1.StreamImagesRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    mimeType: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    codecsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+    targetFps: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0),
+    widthPx: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    heightPx: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    keyframeInterval: jspb.Message.getFieldWithDefault(msg, 6, 0),
     extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
   };
 
@@ -1352,23 +1861,23 @@ proto.viam.component.camera.v1.RenderFrameRequest.toObject = function(includeIns
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.camera.v1.RenderFrameRequest}
+ * @return {!proto.viam.component.camera.v1.StreamImagesRequest}
  */
-proto.viam.component.camera.v1.RenderFrameRequest.deserializeBinary = function(bytes) {
+proto.viam.component.camera.v1.StreamImagesRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.camera.v1.RenderFrameRequest;
-  return proto.viam.component.camera.v1.RenderFrameRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.camera.v1.StreamImagesRequest;
+  return proto.viam.component.camera.v1.StreamImagesRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.camera.v1.RenderFrameRequest} msg The message object to deserialize into.
+ * @param {!proto.viam.component.camera.v1.StreamImagesRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.camera.v1.RenderFrameRequest}
+ * @return {!proto.viam.component.camera.v1.StreamImagesRequest}
  */
-proto.viam.component.camera.v1.RenderFrameRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.camera.v1.StreamImagesRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1380,8 +1889,26 @@ proto.viam.component.camera.v1.RenderFrameRequest.deserializeBinaryFromReader =
       msg.setName(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setMimeType(value);
+      var values = /** @type {!Array<!proto.viam.component.camera.v1.VideoCodec>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addCodecs(values[i]);
+      }
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readFloat());
+      msg.setTargetFps(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setWidthPx(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setHeightPx(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setKeyframeInterval(value);
       break;
     case 99:
       var value = new google_protobuf_struct_pb.Struct;
@@ -1401,9 +1928,9 @@ proto.viam.component.camera.v1.RenderFrameRequest.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.camera.v1.RenderFrameRequest.prototype.serializeBinary = function() {
+proto.viam.component.camera.v1.StreamImagesRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.camera.v1.RenderFrameRequest.serializeBinaryToWriter(this, writer);
+  proto.viam.component.camera.v1.StreamImagesRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1411,11 +1938,11 @@ proto.viam.component.camera.v1.RenderFrameRequest.prototype.serializeBinary = fu
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.camera.v1.RenderFrameRequest} message
+ * @param {!proto.viam.component.camera.v1.StreamImagesRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.camera.v1.RenderFrameRequest.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.camera.v1.StreamImagesRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getName();
   if (f.length > 0) {
@@ -1424,13 +1951,41 @@ proto.viam.component.camera.v1.RenderFrameRequest.serializeBinaryToWriter = func
       f
     );
   }
-  f = message.getMimeType();
+  f = message.getCodecsList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writePackedEnum(
       2,
       f
     );
   }
+  f = message.getTargetFps();
+  if (f !== 0.0) {
+    writer.writeFloat(
+      3,
+      f
+    );
+  }
+  f = message.getWidthPx();
+  if (f !== 0) {
+    writer.writeUint32(
+      4,
+      f
+    );
+  }
+  f = message.getHeightPx();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
+  f = message.getKeyframeInterval();
+  if (f !== 0) {
+    writer.writeUint32(
+      6,
+      f
+    );
+  }
   f = message.getExtra();
   if (f != null) {
     writer.writeMessage(
@@ -1446,60 +2001,717 @@ proto.viam.component.camera.v1.RenderFrameRequest.serializeBinaryToWriter = func
  * optional string name = 1;
  * @return {string}
  */
-proto.viam.component.camera.v1.RenderFrameRequest.prototype.getName = function() {
+proto.viam.component.camera.v1.StreamImagesRequest.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.viam.component.camera.v1.RenderFrameRequest} returns this
+ * @return {!proto.viam.component.camera.v1.StreamImagesRequest} returns this
  */
-proto.viam.component.camera.v1.RenderFrameRequest.prototype.setName = function(value) {
+proto.viam.component.camera.v1.StreamImagesRequest.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string mime_type = 2;
- * @return {string}
+ * repeated VideoCodec codecs = 2;
+ * @return {!Array<!proto.viam.component.camera.v1.VideoCodec>}
  */
-proto.viam.component.camera.v1.RenderFrameRequest.prototype.getMimeType = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.viam.component.camera.v1.StreamImagesRequest.prototype.getCodecsList = function() {
+  return /** @type {!Array<!proto.viam.component.camera.v1.VideoCodec>} */ (jspb.Message.getRepeatedField(this, 2));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.viam.component.camera.v1.RenderFrameRequest} returns this
+ * @param {!Array<!proto.viam.component.camera.v1.VideoCodec>} value
+ * @return {!proto.viam.component.camera.v1.StreamImagesRequest} returns this
  */
-proto.viam.component.camera.v1.RenderFrameRequest.prototype.setMimeType = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.viam.component.camera.v1.StreamImagesRequest.prototype.setCodecsList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
 };
 
 
 /**
- * optional google.protobuf.Struct extra = 99;
- * @return {?proto.google.protobuf.Struct}
+ * @param {!proto.viam.component.camera.v1.VideoCodec} value
+ * @param {number=} opt_index
+ * @return {!proto.viam.component.camera.v1.StreamImagesRequest} returns this
  */
-proto.viam.component.camera.v1.RenderFrameRequest.prototype.getExtra = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+proto.viam.component.camera.v1.StreamImagesRequest.prototype.addCodecs = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
 };
 
 
 /**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.viam.component.camera.v1.RenderFrameRequest} returns this
-*/
-proto.viam.component.camera.v1.RenderFrameRequest.prototype.setExtra = function(value) {
-  return jspb.Message.setWrapperField(this, 99, value);
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.component.camera.v1.StreamImagesRequest} returns this
+ */
+proto.viam.component.camera.v1.StreamImagesRequest.prototype.clearCodecsList = function() {
+  return this.setCodecsList([]);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.viam.component.camera.v1.RenderFrameRequest} returns this
+ * optional float target_fps = 3;
+ * @return {number}
+ */
+proto.viam.component.camera.v1.StreamImagesRequest.prototype.getTargetFps = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 3, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.camera.v1.StreamImagesRequest} returns this
+ */
+proto.viam.component.camera.v1.StreamImagesRequest.prototype.setTargetFps = function(value) {
+  return jspb.Message.setProto3FloatField(this, 3, value);
+};
+
+
+/**
+ * optional uint32 width_px = 4;
+ * @return {number}
+ */
+proto.viam.component.camera.v1.StreamImagesRequest.prototype.getWidthPx = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.camera.v1.StreamImagesRequest} returns this
+ */
+proto.viam.component.camera.v1.StreamImagesRequest.prototype.setWidthPx = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional uint32 height_px = 5;
+ * @return {number}
+ */
+proto.viam.component.camera.v1.StreamImagesRequest.prototype.getHeightPx = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.camera.v1.StreamImagesRequest} returns this
+ */
+proto.viam.component.camera.v1.StreamImagesRequest.prototype.setHeightPx = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional uint32 keyframe_interval = 6;
+ * @return {number}
+ */
+proto.viam.component.camera.v1.StreamImagesRequest.prototype.getKeyframeInterval = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.camera.v1.StreamImagesRequest} returns this
+ */
+proto.viam.component.camera.v1.StreamImagesRequest.prototype.setKeyframeInterval = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.component.camera.v1.StreamImagesRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.component.camera.v1.StreamImagesRequest} returns this
+*/
+proto.viam.component.camera.v1.StreamImagesRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.camera.v1.StreamImagesRequest} returns this
+ */
+proto.viam.component.camera.v1.StreamImagesRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.camera.v1.StreamImagesRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.camera.v1.StreamImagesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.camera.v1.StreamImagesResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.camera.v1.StreamImagesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.camera.v1.StreamImagesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    codec: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    data: msg.getData_asB64(),
+    widthPx: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    heightPx: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    keyframe: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
+    sequenceNumber: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    responseMetadata: (f = msg.getResponseMetadata()) && common_v1_common_pb.ResponseMetadata.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.camera.v1.StreamImagesResponse}
+ */
+proto.viam.component.camera.v1.StreamImagesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.camera.v1.StreamImagesResponse;
+  return proto.viam.component.camera.v1.StreamImagesResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.camera.v1.StreamImagesResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.camera.v1.StreamImagesResponse}
+ */
+proto.viam.component.camera.v1.StreamImagesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.viam.component.camera.v1.VideoCodec} */ (reader.readEnum());
+      msg.setCodec(value);
+      break;
+    case 2:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setData(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setWidthPx(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setHeightPx(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setKeyframe(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setSequenceNumber(value);
+      break;
+    case 84260:
+      var value = new common_v1_common_pb.ResponseMetadata;
+      reader.readMessage(value,common_v1_common_pb.ResponseMetadata.deserializeBinaryFromReader);
+      msg.setResponseMetadata(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.camera.v1.StreamImagesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.camera.v1.StreamImagesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.camera.v1.StreamImagesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.camera.v1.StreamImagesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCodec();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getData_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      2,
+      f
+    );
+  }
+  f = message.getWidthPx();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
+    );
+  }
+  f = message.getHeightPx();
+  if (f !== 0) {
+    writer.writeUint32(
+      4,
+      f
+    );
+  }
+  f = message.getKeyframe();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+  f = message.getSequenceNumber();
+  if (f !== 0) {
+    writer.writeUint64(
+      6,
+      f
+    );
+  }
+  f = message.getResponseMetadata();
+  if (f != null) {
+    writer.writeMessage(
+      84260,
+      f,
+      common_v1_common_pb.ResponseMetadata.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional VideoCodec codec = 1;
+ * @return {!proto.viam.component.camera.v1.VideoCodec}
+ */
+proto.viam.component.camera.v1.StreamImagesResponse.prototype.getCodec = function() {
+  return /** @type {!proto.viam.component.camera.v1.VideoCodec} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.viam.component.camera.v1.VideoCodec} value
+ * @return {!proto.viam.component.camera.v1.StreamImagesResponse} returns this
+ */
+proto.viam.component.camera.v1.StreamImagesResponse.prototype.setCodec = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional bytes data = 2;
+ * @return {string}
+ */
+proto.viam.component.camera.v1.StreamImagesResponse.prototype.getData = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * optional bytes data = 2;
+ * This is a type-conversion wrapper around `getData()`
+ * @return {string}
+ */
+proto.viam.component.camera.v1.StreamImagesResponse.prototype.getData_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getData()));
+};
+
+
+/**
+ * optional bytes data = 2;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getData()`
+ * @return {!Uint8Array}
+ */
+proto.viam.component.camera.v1.StreamImagesResponse.prototype.getData_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getData()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.viam.component.camera.v1.StreamImagesResponse} returns this
+ */
+proto.viam.component.camera.v1.StreamImagesResponse.prototype.setData = function(value) {
+  return jspb.Message.setProto3BytesField(this, 2, value);
+};
+
+
+/**
+ * optional uint32 width_px = 3;
+ * @return {number}
+ */
+proto.viam.component.camera.v1.StreamImagesResponse.prototype.getWidthPx = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.camera.v1.StreamImagesResponse} returns this
+ */
+proto.viam.component.camera.v1.StreamImagesResponse.prototype.setWidthPx = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional uint32 height_px = 4;
+ * @return {number}
+ */
+proto.viam.component.camera.v1.StreamImagesResponse.prototype.getHeightPx = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.camera.v1.StreamImagesResponse} returns this
+ */
+proto.viam.component.camera.v1.StreamImagesResponse.prototype.setHeightPx = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional bool keyframe = 5;
+ * @return {boolean}
+ */
+proto.viam.component.camera.v1.StreamImagesResponse.prototype.getKeyframe = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.viam.component.camera.v1.StreamImagesResponse} returns this
+ */
+proto.viam.component.camera.v1.StreamImagesResponse.prototype.setKeyframe = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
+};
+
+
+/**
+ * optional uint64 sequence_number = 6;
+ * @return {number}
+ */
+proto.viam.component.camera.v1.StreamImagesResponse.prototype.getSequenceNumber = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.camera.v1.StreamImagesResponse} returns this
+ */
+proto.viam.component.camera.v1.StreamImagesResponse.prototype.setSequenceNumber = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * optional viam.common.v1.ResponseMetadata response_metadata = 84260;
+ * @return {?proto.viam.common.v1.ResponseMetadata}
+ */
+proto.viam.component.camera.v1.StreamImagesResponse.prototype.getResponseMetadata = function() {
+  return /** @type{?proto.viam.common.v1.ResponseMetadata} */ (
+    jspb.Message.getWrapperField(this, common_v1_common_pb.ResponseMetadata, 84260));
+};
+
+
+/**
+ * @param {?proto.viam.common.v1.ResponseMetadata|undefined} value
+ * @return {!proto.viam.component.camera.v1.StreamImagesResponse} returns this
+*/
+proto.viam.component.camera.v1.StreamImagesResponse.prototype.setResponseMetadata = function(value) {
+  return jspb.Message.setWrapperField(this, 84260, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.camera.v1.StreamImagesResponse} returns this
+ */
+proto.viam.component.camera.v1.StreamImagesResponse.prototype.clearResponseMetadata = function() {
+  return this.setResponseMetadata(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.camera.v1.StreamImagesResponse.prototype.hasResponseMetadata = function() {
+  return jspb.Message.getField(this, 84260) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.camera.v1.RenderFrameRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.camera.v1.RenderFrameRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.camera.v1.RenderFrameRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.camera.v1.RenderFrameRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    mimeType: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.camera.v1.RenderFrameRequest}
+ */
+proto.viam.component.camera.v1.RenderFrameRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.camera.v1.RenderFrameRequest;
+  return proto.viam.component.camera.v1.RenderFrameRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.camera.v1.RenderFrameRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.camera.v1.RenderFrameRequest}
+ */
+proto.viam.component.camera.v1.RenderFrameRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMimeType(value);
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.camera.v1.RenderFrameRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.camera.v1.RenderFrameRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.camera.v1.RenderFrameRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.camera.v1.RenderFrameRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getMimeType();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.viam.component.camera.v1.RenderFrameRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.camera.v1.RenderFrameRequest} returns this
+ */
+proto.viam.component.camera.v1.RenderFrameRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string mime_type = 2;
+ * @return {string}
+ */
+proto.viam.component.camera.v1.RenderFrameRequest.prototype.getMimeType = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.camera.v1.RenderFrameRequest} returns this
+ */
+proto.viam.component.camera.v1.RenderFrameRequest.prototype.setMimeType = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.component.camera.v1.RenderFrameRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.component.camera.v1.RenderFrameRequest} returns this
+*/
+proto.viam.component.camera.v1.RenderFrameRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.camera.v1.RenderFrameRequest} returns this
  */
 proto.viam.component.camera.v1.RenderFrameRequest.prototype.clearExtra = function() {
   return this.setExtra(undefined);
@@ -1510,12 +2722,1975 @@ proto.viam.component.camera.v1.RenderFrameRequest.prototype.clearExtra = functio
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.component.camera.v1.RenderFrameRequest.prototype.hasExtra = function() {
+proto.viam.component.camera.v1.RenderFrameRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.camera.v1.GetPointCloudRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.camera.v1.GetPointCloudRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.camera.v1.GetPointCloudRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.camera.v1.GetPointCloudRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    mimeType: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.camera.v1.GetPointCloudRequest}
+ */
+proto.viam.component.camera.v1.GetPointCloudRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.camera.v1.GetPointCloudRequest;
+  return proto.viam.component.camera.v1.GetPointCloudRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.camera.v1.GetPointCloudRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.camera.v1.GetPointCloudRequest}
+ */
+proto.viam.component.camera.v1.GetPointCloudRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMimeType(value);
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.camera.v1.GetPointCloudRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.camera.v1.GetPointCloudRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.camera.v1.GetPointCloudRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.camera.v1.GetPointCloudRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getMimeType();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.viam.component.camera.v1.GetPointCloudRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.camera.v1.GetPointCloudRequest} returns this
+ */
+proto.viam.component.camera.v1.GetPointCloudRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string mime_type = 2;
+ * @return {string}
+ */
+proto.viam.component.camera.v1.GetPointCloudRequest.prototype.getMimeType = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.camera.v1.GetPointCloudRequest} returns this
+ */
+proto.viam.component.camera.v1.GetPointCloudRequest.prototype.setMimeType = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.component.camera.v1.GetPointCloudRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.component.camera.v1.GetPointCloudRequest} returns this
+*/
+proto.viam.component.camera.v1.GetPointCloudRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.camera.v1.GetPointCloudRequest} returns this
+ */
+proto.viam.component.camera.v1.GetPointCloudRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.camera.v1.GetPointCloudRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.camera.v1.GetPointCloudResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.camera.v1.GetPointCloudResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.camera.v1.GetPointCloudResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.camera.v1.GetPointCloudResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    mimeType: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    pointCloud: msg.getPointCloud_asB64()
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.camera.v1.GetPointCloudResponse}
+ */
+proto.viam.component.camera.v1.GetPointCloudResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.camera.v1.GetPointCloudResponse;
+  return proto.viam.component.camera.v1.GetPointCloudResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.camera.v1.GetPointCloudResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.camera.v1.GetPointCloudResponse}
+ */
+proto.viam.component.camera.v1.GetPointCloudResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMimeType(value);
+      break;
+    case 2:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setPointCloud(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.camera.v1.GetPointCloudResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.camera.v1.GetPointCloudResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.camera.v1.GetPointCloudResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.camera.v1.GetPointCloudResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getMimeType();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPointCloud_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string mime_type = 1;
+ * @return {string}
+ */
+proto.viam.component.camera.v1.GetPointCloudResponse.prototype.getMimeType = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.camera.v1.GetPointCloudResponse} returns this
+ */
+proto.viam.component.camera.v1.GetPointCloudResponse.prototype.setMimeType = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional bytes point_cloud = 2;
+ * @return {string}
+ */
+proto.viam.component.camera.v1.GetPointCloudResponse.prototype.getPointCloud = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * optional bytes point_cloud = 2;
+ * This is a type-conversion wrapper around `getPointCloud()`
+ * @return {string}
+ */
+proto.viam.component.camera.v1.GetPointCloudResponse.prototype.getPointCloud_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getPointCloud()));
+};
+
+
+/**
+ * optional bytes point_cloud = 2;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getPointCloud()`
+ * @return {!Uint8Array}
+ */
+proto.viam.component.camera.v1.GetPointCloudResponse.prototype.getPointCloud_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getPointCloud()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.viam.component.camera.v1.GetPointCloudResponse} returns this
+ */
+proto.viam.component.camera.v1.GetPointCloudResponse.prototype.setPointCloud = function(value) {
+  return jspb.Message.setProto3BytesField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.camera.v1.GetPointCloudStreamRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.camera.v1.GetPointCloudStreamRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    mimeType: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    continuous: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    maxChunkSizeBytes: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.camera.v1.GetPointCloudStreamRequest}
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.camera.v1.GetPointCloudStreamRequest;
+  return proto.viam.component.camera.v1.GetPointCloudStreamRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.camera.v1.GetPointCloudStreamRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.camera.v1.GetPointCloudStreamRequest}
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMimeType(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setContinuous(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setMaxChunkSizeBytes(value);
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.camera.v1.GetPointCloudStreamRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.camera.v1.GetPointCloudStreamRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getMimeType();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getContinuous();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getMaxChunkSizeBytes();
+  if (f !== 0) {
+    writer.writeUint32(
+      4,
+      f
+    );
+  }
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.camera.v1.GetPointCloudStreamRequest} returns this
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string mime_type = 2;
+ * @return {string}
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamRequest.prototype.getMimeType = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.camera.v1.GetPointCloudStreamRequest} returns this
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamRequest.prototype.setMimeType = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional bool continuous = 3;
+ * @return {boolean}
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamRequest.prototype.getContinuous = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.viam.component.camera.v1.GetPointCloudStreamRequest} returns this
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamRequest.prototype.setContinuous = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+/**
+ * optional uint32 max_chunk_size_bytes = 4;
+ * @return {number}
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamRequest.prototype.getMaxChunkSizeBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.camera.v1.GetPointCloudStreamRequest} returns this
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamRequest.prototype.setMaxChunkSizeBytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.component.camera.v1.GetPointCloudStreamRequest} returns this
+*/
+proto.viam.component.camera.v1.GetPointCloudStreamRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.camera.v1.GetPointCloudStreamRequest} returns this
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.camera.v1.GetPointCloudStreamResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.camera.v1.GetPointCloudStreamResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    mimeType: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    pointCloudChunk: msg.getPointCloudChunk_asB64(),
+    scanNumber: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    lastChunk: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+    sensorPose: (f = msg.getSensorPose()) && common_v1_common_pb.PoseInFrame.toObject(includeInstance, f),
+    responseMetadata: (f = msg.getResponseMetadata()) && common_v1_common_pb.ResponseMetadata.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.camera.v1.GetPointCloudStreamResponse}
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.camera.v1.GetPointCloudStreamResponse;
+  return proto.viam.component.camera.v1.GetPointCloudStreamResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.camera.v1.GetPointCloudStreamResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.camera.v1.GetPointCloudStreamResponse}
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMimeType(value);
+      break;
+    case 2:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setPointCloudChunk(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setScanNumber(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setLastChunk(value);
+      break;
+    case 5:
+      var value = new common_v1_common_pb.PoseInFrame;
+      reader.readMessage(value,common_v1_common_pb.PoseInFrame.deserializeBinaryFromReader);
+      msg.setSensorPose(value);
+      break;
+    case 84260:
+      var value = new common_v1_common_pb.ResponseMetadata;
+      reader.readMessage(value,common_v1_common_pb.ResponseMetadata.deserializeBinaryFromReader);
+      msg.setResponseMetadata(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.camera.v1.GetPointCloudStreamResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.camera.v1.GetPointCloudStreamResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getMimeType();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPointCloudChunk_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      2,
+      f
+    );
+  }
+  f = message.getScanNumber();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getLastChunk();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+  f = message.getSensorPose();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      common_v1_common_pb.PoseInFrame.serializeBinaryToWriter
+    );
+  }
+  f = message.getResponseMetadata();
+  if (f != null) {
+    writer.writeMessage(
+      84260,
+      f,
+      common_v1_common_pb.ResponseMetadata.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string mime_type = 1;
+ * @return {string}
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamResponse.prototype.getMimeType = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.camera.v1.GetPointCloudStreamResponse} returns this
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamResponse.prototype.setMimeType = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional bytes point_cloud_chunk = 2;
+ * @return {string}
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamResponse.prototype.getPointCloudChunk = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * optional bytes point_cloud_chunk = 2;
+ * This is a type-conversion wrapper around `getPointCloudChunk()`
+ * @return {string}
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamResponse.prototype.getPointCloudChunk_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getPointCloudChunk()));
+};
+
+
+/**
+ * optional bytes point_cloud_chunk = 2;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getPointCloudChunk()`
+ * @return {!Uint8Array}
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamResponse.prototype.getPointCloudChunk_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getPointCloudChunk()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.viam.component.camera.v1.GetPointCloudStreamResponse} returns this
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamResponse.prototype.setPointCloudChunk = function(value) {
+  return jspb.Message.setProto3BytesField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 scan_number = 3;
+ * @return {number}
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamResponse.prototype.getScanNumber = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.camera.v1.GetPointCloudStreamResponse} returns this
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamResponse.prototype.setScanNumber = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional bool last_chunk = 4;
+ * @return {boolean}
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamResponse.prototype.getLastChunk = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.viam.component.camera.v1.GetPointCloudStreamResponse} returns this
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamResponse.prototype.setLastChunk = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
+};
+
+
+/**
+ * optional viam.common.v1.PoseInFrame sensor_pose = 5;
+ * @return {?proto.viam.common.v1.PoseInFrame}
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamResponse.prototype.getSensorPose = function() {
+  return /** @type{?proto.viam.common.v1.PoseInFrame} */ (
+    jspb.Message.getWrapperField(this, common_v1_common_pb.PoseInFrame, 5));
+};
+
+
+/**
+ * @param {?proto.viam.common.v1.PoseInFrame|undefined} value
+ * @return {!proto.viam.component.camera.v1.GetPointCloudStreamResponse} returns this
+*/
+proto.viam.component.camera.v1.GetPointCloudStreamResponse.prototype.setSensorPose = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.camera.v1.GetPointCloudStreamResponse} returns this
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamResponse.prototype.clearSensorPose = function() {
+  return this.setSensorPose(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamResponse.prototype.hasSensorPose = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional viam.common.v1.ResponseMetadata response_metadata = 84260;
+ * @return {?proto.viam.common.v1.ResponseMetadata}
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamResponse.prototype.getResponseMetadata = function() {
+  return /** @type{?proto.viam.common.v1.ResponseMetadata} */ (
+    jspb.Message.getWrapperField(this, common_v1_common_pb.ResponseMetadata, 84260));
+};
+
+
+/**
+ * @param {?proto.viam.common.v1.ResponseMetadata|undefined} value
+ * @return {!proto.viam.component.camera.v1.GetPointCloudStreamResponse} returns this
+*/
+proto.viam.component.camera.v1.GetPointCloudStreamResponse.prototype.setResponseMetadata = function(value) {
+  return jspb.Message.setWrapperField(this, 84260, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.camera.v1.GetPointCloudStreamResponse} returns this
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamResponse.prototype.clearResponseMetadata = function() {
+  return this.setResponseMetadata(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.camera.v1.GetPointCloudStreamResponse.prototype.hasResponseMetadata = function() {
+  return jspb.Message.getField(this, 84260) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.camera.v1.GetPropertiesRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.camera.v1.GetPropertiesRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.camera.v1.GetPropertiesRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.camera.v1.GetPropertiesRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.camera.v1.GetPropertiesRequest}
+ */
+proto.viam.component.camera.v1.GetPropertiesRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.camera.v1.GetPropertiesRequest;
+  return proto.viam.component.camera.v1.GetPropertiesRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.camera.v1.GetPropertiesRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.camera.v1.GetPropertiesRequest}
+ */
+proto.viam.component.camera.v1.GetPropertiesRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.camera.v1.GetPropertiesRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.camera.v1.GetPropertiesRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.camera.v1.GetPropertiesRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.camera.v1.GetPropertiesRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.viam.component.camera.v1.GetPropertiesRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.camera.v1.GetPropertiesRequest} returns this
+ */
+proto.viam.component.camera.v1.GetPropertiesRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.camera.v1.GetPropertiesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.camera.v1.GetPropertiesResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.camera.v1.GetPropertiesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.camera.v1.GetPropertiesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    supportsPcd: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+    intrinsicParameters: (f = msg.getIntrinsicParameters()) && proto.viam.component.camera.v1.IntrinsicParameters.toObject(includeInstance, f),
+    distortionParameters: (f = msg.getDistortionParameters()) && proto.viam.component.camera.v1.DistortionParameters.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.camera.v1.GetPropertiesResponse}
+ */
+proto.viam.component.camera.v1.GetPropertiesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.camera.v1.GetPropertiesResponse;
+  return proto.viam.component.camera.v1.GetPropertiesResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.camera.v1.GetPropertiesResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.camera.v1.GetPropertiesResponse}
+ */
+proto.viam.component.camera.v1.GetPropertiesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSupportsPcd(value);
+      break;
+    case 2:
+      var value = new proto.viam.component.camera.v1.IntrinsicParameters;
+      reader.readMessage(value,proto.viam.component.camera.v1.IntrinsicParameters.deserializeBinaryFromReader);
+      msg.setIntrinsicParameters(value);
+      break;
+    case 3:
+      var value = new proto.viam.component.camera.v1.DistortionParameters;
+      reader.readMessage(value,proto.viam.component.camera.v1.DistortionParameters.deserializeBinaryFromReader);
+      msg.setDistortionParameters(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.camera.v1.GetPropertiesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.camera.v1.GetPropertiesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.camera.v1.GetPropertiesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.camera.v1.GetPropertiesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSupportsPcd();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+  f = message.getIntrinsicParameters();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.viam.component.camera.v1.IntrinsicParameters.serializeBinaryToWriter
+    );
+  }
+  f = message.getDistortionParameters();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.viam.component.camera.v1.DistortionParameters.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional bool supports_pcd = 1;
+ * @return {boolean}
+ */
+proto.viam.component.camera.v1.GetPropertiesResponse.prototype.getSupportsPcd = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.viam.component.camera.v1.GetPropertiesResponse} returns this
+ */
+proto.viam.component.camera.v1.GetPropertiesResponse.prototype.setSupportsPcd = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+/**
+ * optional IntrinsicParameters intrinsic_parameters = 2;
+ * @return {?proto.viam.component.camera.v1.IntrinsicParameters}
+ */
+proto.viam.component.camera.v1.GetPropertiesResponse.prototype.getIntrinsicParameters = function() {
+  return /** @type{?proto.viam.component.camera.v1.IntrinsicParameters} */ (
+    jspb.Message.getWrapperField(this, proto.viam.component.camera.v1.IntrinsicParameters, 2));
+};
+
+
+/**
+ * @param {?proto.viam.component.camera.v1.IntrinsicParameters|undefined} value
+ * @return {!proto.viam.component.camera.v1.GetPropertiesResponse} returns this
+*/
+proto.viam.component.camera.v1.GetPropertiesResponse.prototype.setIntrinsicParameters = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.camera.v1.GetPropertiesResponse} returns this
+ */
+proto.viam.component.camera.v1.GetPropertiesResponse.prototype.clearIntrinsicParameters = function() {
+  return this.setIntrinsicParameters(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.camera.v1.GetPropertiesResponse.prototype.hasIntrinsicParameters = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional DistortionParameters distortion_parameters = 3;
+ * @return {?proto.viam.component.camera.v1.DistortionParameters}
+ */
+proto.viam.component.camera.v1.GetPropertiesResponse.prototype.getDistortionParameters = function() {
+  return /** @type{?proto.viam.component.camera.v1.DistortionParameters} */ (
+    jspb.Message.getWrapperField(this, proto.viam.component.camera.v1.DistortionParameters, 3));
+};
+
+
+/**
+ * @param {?proto.viam.component.camera.v1.DistortionParameters|undefined} value
+ * @return {!proto.viam.component.camera.v1.GetPropertiesResponse} returns this
+*/
+proto.viam.component.camera.v1.GetPropertiesResponse.prototype.setDistortionParameters = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.camera.v1.GetPropertiesResponse} returns this
+ */
+proto.viam.component.camera.v1.GetPropertiesResponse.prototype.clearDistortionParameters = function() {
+  return this.setDistortionParameters(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.camera.v1.GetPropertiesResponse.prototype.hasDistortionParameters = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.camera.v1.GetControlsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.camera.v1.GetControlsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.camera.v1.GetControlsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.camera.v1.GetControlsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.camera.v1.GetControlsRequest}
+ */
+proto.viam.component.camera.v1.GetControlsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.camera.v1.GetControlsRequest;
+  return proto.viam.component.camera.v1.GetControlsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.camera.v1.GetControlsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.camera.v1.GetControlsRequest}
+ */
+proto.viam.component.camera.v1.GetControlsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.camera.v1.GetControlsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.camera.v1.GetControlsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.camera.v1.GetControlsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.camera.v1.GetControlsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.viam.component.camera.v1.GetControlsRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.camera.v1.GetControlsRequest} returns this
+ */
+proto.viam.component.camera.v1.GetControlsRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.component.camera.v1.GetControlsRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.component.camera.v1.GetControlsRequest} returns this
+*/
+proto.viam.component.camera.v1.GetControlsRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.camera.v1.GetControlsRequest} returns this
+ */
+proto.viam.component.camera.v1.GetControlsRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.camera.v1.GetControlsRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.component.camera.v1.GetControlsResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.camera.v1.GetControlsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.camera.v1.GetControlsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.camera.v1.GetControlsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.camera.v1.GetControlsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    controlsList: jspb.Message.toObjectList(msg.getControlsList(),
+    proto.viam.component.camera.v1.ControlInfo.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.camera.v1.GetControlsResponse}
+ */
+proto.viam.component.camera.v1.GetControlsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.camera.v1.GetControlsResponse;
+  return proto.viam.component.camera.v1.GetControlsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.camera.v1.GetControlsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.camera.v1.GetControlsResponse}
+ */
+proto.viam.component.camera.v1.GetControlsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.viam.component.camera.v1.ControlInfo;
+      reader.readMessage(value,proto.viam.component.camera.v1.ControlInfo.deserializeBinaryFromReader);
+      msg.addControls(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.camera.v1.GetControlsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.camera.v1.GetControlsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.camera.v1.GetControlsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.camera.v1.GetControlsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getControlsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.viam.component.camera.v1.ControlInfo.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated ControlInfo controls = 1;
+ * @return {!Array<!proto.viam.component.camera.v1.ControlInfo>}
+ */
+proto.viam.component.camera.v1.GetControlsResponse.prototype.getControlsList = function() {
+  return /** @type{!Array<!proto.viam.component.camera.v1.ControlInfo>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.viam.component.camera.v1.ControlInfo, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.viam.component.camera.v1.ControlInfo>} value
+ * @return {!proto.viam.component.camera.v1.GetControlsResponse} returns this
+*/
+proto.viam.component.camera.v1.GetControlsResponse.prototype.setControlsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.viam.component.camera.v1.ControlInfo=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.component.camera.v1.ControlInfo}
+ */
+proto.viam.component.camera.v1.GetControlsResponse.prototype.addControls = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.viam.component.camera.v1.ControlInfo, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.component.camera.v1.GetControlsResponse} returns this
+ */
+proto.viam.component.camera.v1.GetControlsResponse.prototype.clearControlsList = function() {
+  return this.setControlsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.component.camera.v1.SetControlsRequest.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.camera.v1.SetControlsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.camera.v1.SetControlsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.camera.v1.SetControlsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.camera.v1.SetControlsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    controlsList: jspb.Message.toObjectList(msg.getControlsList(),
+    proto.viam.component.camera.v1.Control.toObject, includeInstance),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.camera.v1.SetControlsRequest}
+ */
+proto.viam.component.camera.v1.SetControlsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.camera.v1.SetControlsRequest;
+  return proto.viam.component.camera.v1.SetControlsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.camera.v1.SetControlsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.camera.v1.SetControlsRequest}
+ */
+proto.viam.component.camera.v1.SetControlsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = new proto.viam.component.camera.v1.Control;
+      reader.readMessage(value,proto.viam.component.camera.v1.Control.deserializeBinaryFromReader);
+      msg.addControls(value);
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.camera.v1.SetControlsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.camera.v1.SetControlsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.camera.v1.SetControlsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.camera.v1.SetControlsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getControlsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.viam.component.camera.v1.Control.serializeBinaryToWriter
+    );
+  }
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.viam.component.camera.v1.SetControlsRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.camera.v1.SetControlsRequest} returns this
+ */
+proto.viam.component.camera.v1.SetControlsRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * repeated Control controls = 2;
+ * @return {!Array<!proto.viam.component.camera.v1.Control>}
+ */
+proto.viam.component.camera.v1.SetControlsRequest.prototype.getControlsList = function() {
+  return /** @type{!Array<!proto.viam.component.camera.v1.Control>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.viam.component.camera.v1.Control, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.viam.component.camera.v1.Control>} value
+ * @return {!proto.viam.component.camera.v1.SetControlsRequest} returns this
+*/
+proto.viam.component.camera.v1.SetControlsRequest.prototype.setControlsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.viam.component.camera.v1.Control=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.component.camera.v1.Control}
+ */
+proto.viam.component.camera.v1.SetControlsRequest.prototype.addControls = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.viam.component.camera.v1.Control, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.component.camera.v1.SetControlsRequest} returns this
+ */
+proto.viam.component.camera.v1.SetControlsRequest.prototype.clearControlsList = function() {
+  return this.setControlsList([]);
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.component.camera.v1.SetControlsRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.component.camera.v1.SetControlsRequest} returns this
+*/
+proto.viam.component.camera.v1.SetControlsRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.camera.v1.SetControlsRequest} returns this
+ */
+proto.viam.component.camera.v1.SetControlsRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.camera.v1.SetControlsRequest.prototype.hasExtra = function() {
   return jspb.Message.getField(this, 99) != null;
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.component.camera.v1.SetControlsResponse.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -1531,8 +4706,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.camera.v1.GetPointCloudRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.camera.v1.GetPointCloudRequest.toObject(opt_includeInstance, this);
+proto.viam.component.camera.v1.SetControlsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.camera.v1.SetControlsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -1541,15 +4716,14 @@ proto.viam.component.camera.v1.GetPointCloudRequest.prototype.toObject = functio
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.camera.v1.GetPointCloudRequest} msg The msg instance to transform.
+ * @param {!proto.viam.component.camera.v1.SetControlsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.camera.v1.GetPointCloudRequest.toObject = function(includeInstance, msg) {
+proto.viam.component.camera.v1.SetControlsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    mimeType: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+    controlsList: jspb.Message.toObjectList(msg.getControlsList(),
+    proto.viam.component.camera.v1.Control.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -1563,23 +4737,23 @@ proto.viam.component.camera.v1.GetPointCloudRequest.toObject = function(includeI
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.camera.v1.GetPointCloudRequest}
+ * @return {!proto.viam.component.camera.v1.SetControlsResponse}
  */
-proto.viam.component.camera.v1.GetPointCloudRequest.deserializeBinary = function(bytes) {
+proto.viam.component.camera.v1.SetControlsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.camera.v1.GetPointCloudRequest;
-  return proto.viam.component.camera.v1.GetPointCloudRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.camera.v1.SetControlsResponse;
+  return proto.viam.component.camera.v1.SetControlsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.camera.v1.GetPointCloudRequest} msg The message object to deserialize into.
+ * @param {!proto.viam.component.camera.v1.SetControlsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.camera.v1.GetPointCloudRequest}
+ * @return {!proto.viam.component.camera.v1.SetControlsResponse}
  */
-proto.viam.component.camera.v1.GetPointCloudRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.camera.v1.SetControlsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1587,17 +4761,9 @@ proto.viam.component.camera.v1.GetPointCloudRequest.deserializeBinaryFromReader
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setMimeType(value);
-      break;
-    case 99:
-      var value = new google_protobuf_struct_pb.Struct;
-      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setExtra(value);
+      var value = new proto.viam.component.camera.v1.Control;
+      reader.readMessage(value,proto.viam.component.camera.v1.Control.deserializeBinaryFromReader);
+      msg.addControls(value);
       break;
     default:
       reader.skipField();
@@ -1612,9 +4778,9 @@ proto.viam.component.camera.v1.GetPointCloudRequest.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.camera.v1.GetPointCloudRequest.prototype.serializeBinary = function() {
+proto.viam.component.camera.v1.SetControlsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.camera.v1.GetPointCloudRequest.serializeBinaryToWriter(this, writer);
+  proto.viam.component.camera.v1.SetControlsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1622,113 +4788,90 @@ proto.viam.component.camera.v1.GetPointCloudRequest.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.camera.v1.GetPointCloudRequest} message
+ * @param {!proto.viam.component.camera.v1.SetControlsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.camera.v1.GetPointCloudRequest.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.camera.v1.SetControlsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
+  f = message.getControlsList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedMessage(
       1,
-      f
-    );
-  }
-  f = message.getMimeType();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getExtra();
-  if (f != null) {
-    writer.writeMessage(
-      99,
       f,
-      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+      proto.viam.component.camera.v1.Control.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string name = 1;
- * @return {string}
+ * repeated Control controls = 1;
+ * @return {!Array<!proto.viam.component.camera.v1.Control>}
  */
-proto.viam.component.camera.v1.GetPointCloudRequest.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.viam.component.camera.v1.SetControlsResponse.prototype.getControlsList = function() {
+  return /** @type{!Array<!proto.viam.component.camera.v1.Control>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.viam.component.camera.v1.Control, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.viam.component.camera.v1.GetPointCloudRequest} returns this
- */
-proto.viam.component.camera.v1.GetPointCloudRequest.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+ * @param {!Array<!proto.viam.component.camera.v1.Control>} value
+ * @return {!proto.viam.component.camera.v1.SetControlsResponse} returns this
+*/
+proto.viam.component.camera.v1.SetControlsResponse.prototype.setControlsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * optional string mime_type = 2;
- * @return {string}
+ * @param {!proto.viam.component.camera.v1.Control=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.component.camera.v1.Control}
  */
-proto.viam.component.camera.v1.GetPointCloudRequest.prototype.getMimeType = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.viam.component.camera.v1.SetControlsResponse.prototype.addControls = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.viam.component.camera.v1.Control, opt_index);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.viam.component.camera.v1.GetPointCloudRequest} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.component.camera.v1.SetControlsResponse} returns this
  */
-proto.viam.component.camera.v1.GetPointCloudRequest.prototype.setMimeType = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.viam.component.camera.v1.SetControlsResponse.prototype.clearControlsList = function() {
+  return this.setControlsList([]);
 };
 
 
-/**
- * optional google.protobuf.Struct extra = 99;
- * @return {?proto.google.protobuf.Struct}
- */
-proto.viam.component.camera.v1.GetPointCloudRequest.prototype.getExtra = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
-};
-
 
 /**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.viam.component.camera.v1.GetPointCloudRequest} returns this
-*/
-proto.viam.component.camera.v1.GetPointCloudRequest.prototype.setExtra = function(value) {
-  return jspb.Message.setWrapperField(this, 99, value);
-};
-
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
+ * @const
+ */
+proto.viam.component.camera.v1.Control.oneofGroups_ = [[2,3]];
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.viam.component.camera.v1.GetPointCloudRequest} returns this
+ * @enum {number}
  */
-proto.viam.component.camera.v1.GetPointCloudRequest.prototype.clearExtra = function() {
-  return this.setExtra(undefined);
+proto.viam.component.camera.v1.Control.ValueCase = {
+  VALUE_NOT_SET: 0,
+  NUMBER: 2,
+  RESOLUTION: 3
 };
 
-
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @return {proto.viam.component.camera.v1.Control.ValueCase}
  */
-proto.viam.component.camera.v1.GetPointCloudRequest.prototype.hasExtra = function() {
-  return jspb.Message.getField(this, 99) != null;
+proto.viam.component.camera.v1.Control.prototype.getValueCase = function() {
+  return /** @type {proto.viam.component.camera.v1.Control.ValueCase} */(jspb.Message.computeOneofCase(this, proto.viam.component.camera.v1.Control.oneofGroups_[0]));
 };
 
 
 
-
-
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -1742,8 +4885,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.camera.v1.GetPointCloudResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.camera.v1.GetPointCloudResponse.toObject(opt_includeInstance, this);
+proto.viam.component.camera.v1.Control.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.camera.v1.Control.toObject(opt_includeInstance, this);
 };
 
 
@@ -1752,14 +4895,16 @@ proto.viam.component.camera.v1.GetPointCloudResponse.prototype.toObject = functi
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.camera.v1.GetPointCloudResponse} msg The msg instance to transform.
+ * @param {!proto.viam.component.camera.v1.Control} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.camera.v1.GetPointCloudResponse.toObject = function(includeInstance, msg) {
+proto.viam.component.camera.v1.Control.toObject = function(includeInstance, msg) {
   var f, obj = {
-    mimeType: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    pointCloud: msg.getPointCloud_asB64()
+    type: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    number: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0),
+    resolution: (f = msg.getResolution()) && proto.viam.component.camera.v1.Resolution.toObject(includeInstance, f),
+    autoMode: jspb.Message.getBooleanFieldWithDefault(msg, 4, false)
   };
 
   if (includeInstance) {
@@ -1773,23 +4918,23 @@ proto.viam.component.camera.v1.GetPointCloudResponse.toObject = function(include
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.camera.v1.GetPointCloudResponse}
+ * @return {!proto.viam.component.camera.v1.Control}
  */
-proto.viam.component.camera.v1.GetPointCloudResponse.deserializeBinary = function(bytes) {
+proto.viam.component.camera.v1.Control.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.camera.v1.GetPointCloudResponse;
-  return proto.viam.component.camera.v1.GetPointCloudResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.camera.v1.Control;
+  return proto.viam.component.camera.v1.Control.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.camera.v1.GetPointCloudResponse} msg The message object to deserialize into.
+ * @param {!proto.viam.component.camera.v1.Control} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.camera.v1.GetPointCloudResponse}
+ * @return {!proto.viam.component.camera.v1.Control}
  */
-proto.viam.component.camera.v1.GetPointCloudResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.camera.v1.Control.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1797,12 +4942,21 @@ proto.viam.component.camera.v1.GetPointCloudResponse.deserializeBinaryFromReader
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setMimeType(value);
+      var value = /** @type {!proto.viam.component.camera.v1.ControlType} */ (reader.readEnum());
+      msg.setType(value);
       break;
     case 2:
-      var value = /** @type {!Uint8Array} */ (reader.readBytes());
-      msg.setPointCloud(value);
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setNumber(value);
+      break;
+    case 3:
+      var value = new proto.viam.component.camera.v1.Resolution;
+      reader.readMessage(value,proto.viam.component.camera.v1.Resolution.deserializeBinaryFromReader);
+      msg.setResolution(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setAutoMode(value);
       break;
     default:
       reader.skipField();
@@ -1817,9 +4971,9 @@ proto.viam.component.camera.v1.GetPointCloudResponse.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.camera.v1.GetPointCloudResponse.prototype.serializeBinary = function() {
+proto.viam.component.camera.v1.Control.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.camera.v1.GetPointCloudResponse.serializeBinaryToWriter(this, writer);
+  proto.viam.component.camera.v1.Control.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1827,86 +4981,150 @@ proto.viam.component.camera.v1.GetPointCloudResponse.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.camera.v1.GetPointCloudResponse} message
+ * @param {!proto.viam.component.camera.v1.Control} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.camera.v1.GetPointCloudResponse.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.camera.v1.Control.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getMimeType();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getType();
+  if (f !== 0.0) {
+    writer.writeEnum(
       1,
       f
     );
   }
-  f = message.getPointCloud_asU8();
-  if (f.length > 0) {
-    writer.writeBytes(
+  f = /** @type {number} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeDouble(
       2,
       f
     );
   }
+  f = message.getResolution();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.viam.component.camera.v1.Resolution.serializeBinaryToWriter
+    );
+  }
+  f = message.getAutoMode();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string mime_type = 1;
- * @return {string}
+ * optional ControlType type = 1;
+ * @return {!proto.viam.component.camera.v1.ControlType}
  */
-proto.viam.component.camera.v1.GetPointCloudResponse.prototype.getMimeType = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.viam.component.camera.v1.Control.prototype.getType = function() {
+  return /** @type {!proto.viam.component.camera.v1.ControlType} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.viam.component.camera.v1.GetPointCloudResponse} returns this
+ * @param {!proto.viam.component.camera.v1.ControlType} value
+ * @return {!proto.viam.component.camera.v1.Control} returns this
  */
-proto.viam.component.camera.v1.GetPointCloudResponse.prototype.setMimeType = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.viam.component.camera.v1.Control.prototype.setType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
 };
 
 
 /**
- * optional bytes point_cloud = 2;
- * @return {string}
+ * optional double number = 2;
+ * @return {number}
  */
-proto.viam.component.camera.v1.GetPointCloudResponse.prototype.getPointCloud = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.viam.component.camera.v1.Control.prototype.getNumber = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
 };
 
 
 /**
- * optional bytes point_cloud = 2;
- * This is a type-conversion wrapper around `getPointCloud()`
- * @return {string}
+ * @param {number} value
+ * @return {!proto.viam.component.camera.v1.Control} returns this
  */
-proto.viam.component.camera.v1.GetPointCloudResponse.prototype.getPointCloud_asB64 = function() {
-  return /** @type {string} */ (jspb.Message.bytesAsB64(
-      this.getPointCloud()));
+proto.viam.component.camera.v1.Control.prototype.setNumber = function(value) {
+  return jspb.Message.setOneofField(this, 2, proto.viam.component.camera.v1.Control.oneofGroups_[0], value);
 };
 
 
 /**
- * optional bytes point_cloud = 2;
- * Note that Uint8Array is not supported on all browsers.
- * @see http://caniuse.com/Uint8Array
- * This is a type-conversion wrapper around `getPointCloud()`
- * @return {!Uint8Array}
+ * Clears the field making it undefined.
+ * @return {!proto.viam.component.camera.v1.Control} returns this
+ */
+proto.viam.component.camera.v1.Control.prototype.clearNumber = function() {
+  return jspb.Message.setOneofField(this, 2, proto.viam.component.camera.v1.Control.oneofGroups_[0], undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.camera.v1.Control.prototype.hasNumber = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional Resolution resolution = 3;
+ * @return {?proto.viam.component.camera.v1.Resolution}
+ */
+proto.viam.component.camera.v1.Control.prototype.getResolution = function() {
+  return /** @type{?proto.viam.component.camera.v1.Resolution} */ (
+    jspb.Message.getWrapperField(this, proto.viam.component.camera.v1.Resolution, 3));
+};
+
+
+/**
+ * @param {?proto.viam.component.camera.v1.Resolution|undefined} value
+ * @return {!proto.viam.component.camera.v1.Control} returns this
+*/
+proto.viam.component.camera.v1.Control.prototype.setResolution = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 3, proto.viam.component.camera.v1.Control.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.camera.v1.Control} returns this
+ */
+proto.viam.component.camera.v1.Control.prototype.clearResolution = function() {
+  return this.setResolution(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.camera.v1.Control.prototype.hasResolution = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional bool auto_mode = 4;
+ * @return {boolean}
  */
-proto.viam.component.camera.v1.GetPointCloudResponse.prototype.getPointCloud_asU8 = function() {
-  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
-      this.getPointCloud()));
+proto.viam.component.camera.v1.Control.prototype.getAutoMode = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
 };
 
 
 /**
- * @param {!(string|Uint8Array)} value
- * @return {!proto.viam.component.camera.v1.GetPointCloudResponse} returns this
+ * @param {boolean} value
+ * @return {!proto.viam.component.camera.v1.Control} returns this
  */
-proto.viam.component.camera.v1.GetPointCloudResponse.prototype.setPointCloud = function(value) {
-  return jspb.Message.setProto3BytesField(this, 2, value);
+proto.viam.component.camera.v1.Control.prototype.setAutoMode = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
 };
 
 
@@ -1926,8 +5144,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.camera.v1.GetPropertiesRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.camera.v1.GetPropertiesRequest.toObject(opt_includeInstance, this);
+proto.viam.component.camera.v1.Resolution.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.camera.v1.Resolution.toObject(opt_includeInstance, this);
 };
 
 
@@ -1936,13 +5154,14 @@ proto.viam.component.camera.v1.GetPropertiesRequest.prototype.toObject = functio
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.camera.v1.GetPropertiesRequest} msg The msg instance to transform.
+ * @param {!proto.viam.component.camera.v1.Resolution} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.camera.v1.GetPropertiesRequest.toObject = function(includeInstance, msg) {
+proto.viam.component.camera.v1.Resolution.toObject = function(includeInstance, msg) {
   var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, "")
+    widthPx: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    heightPx: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -1956,23 +5175,23 @@ proto.viam.component.camera.v1.GetPropertiesRequest.toObject = function(includeI
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.camera.v1.GetPropertiesRequest}
+ * @return {!proto.viam.component.camera.v1.Resolution}
  */
-proto.viam.component.camera.v1.GetPropertiesRequest.deserializeBinary = function(bytes) {
+proto.viam.component.camera.v1.Resolution.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.camera.v1.GetPropertiesRequest;
-  return proto.viam.component.camera.v1.GetPropertiesRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.camera.v1.Resolution;
+  return proto.viam.component.camera.v1.Resolution.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.camera.v1.GetPropertiesRequest} msg The message object to deserialize into.
+ * @param {!proto.viam.component.camera.v1.Resolution} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.camera.v1.GetPropertiesRequest}
+ * @return {!proto.viam.component.camera.v1.Resolution}
  */
-proto.viam.component.camera.v1.GetPropertiesRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.camera.v1.Resolution.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1980,8 +5199,12 @@ proto.viam.component.camera.v1.GetPropertiesRequest.deserializeBinaryFromReader
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setWidthPx(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setHeightPx(value);
       break;
     default:
       reader.skipField();
@@ -1996,9 +5219,9 @@ proto.viam.component.camera.v1.GetPropertiesRequest.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.camera.v1.GetPropertiesRequest.prototype.serializeBinary = function() {
+proto.viam.component.camera.v1.Resolution.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.camera.v1.GetPropertiesRequest.serializeBinaryToWriter(this, writer);
+  proto.viam.component.camera.v1.Resolution.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2006,41 +5229,73 @@ proto.viam.component.camera.v1.GetPropertiesRequest.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.camera.v1.GetPropertiesRequest} message
+ * @param {!proto.viam.component.camera.v1.Resolution} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.camera.v1.GetPropertiesRequest.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.camera.v1.Resolution.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getWidthPx();
+  if (f !== 0) {
+    writer.writeUint32(
       1,
       f
     );
   }
+  f = message.getHeightPx();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string name = 1;
- * @return {string}
+ * optional uint32 width_px = 1;
+ * @return {number}
  */
-proto.viam.component.camera.v1.GetPropertiesRequest.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.viam.component.camera.v1.Resolution.prototype.getWidthPx = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.viam.component.camera.v1.GetPropertiesRequest} returns this
+ * @param {number} value
+ * @return {!proto.viam.component.camera.v1.Resolution} returns this
  */
-proto.viam.component.camera.v1.GetPropertiesRequest.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.viam.component.camera.v1.Resolution.prototype.setWidthPx = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint32 height_px = 2;
+ * @return {number}
+ */
+proto.viam.component.camera.v1.Resolution.prototype.getHeightPx = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.camera.v1.Resolution} returns this
+ */
+proto.viam.component.camera.v1.Resolution.prototype.setHeightPx = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.component.camera.v1.ControlInfo.repeatedFields_ = [5];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -2056,8 +5311,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.camera.v1.GetPropertiesResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.camera.v1.GetPropertiesResponse.toObject(opt_includeInstance, this);
+proto.viam.component.camera.v1.ControlInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.camera.v1.ControlInfo.toObject(opt_includeInstance, this);
 };
 
 
@@ -2066,15 +5321,19 @@ proto.viam.component.camera.v1.GetPropertiesResponse.prototype.toObject = functi
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.camera.v1.GetPropertiesResponse} msg The msg instance to transform.
+ * @param {!proto.viam.component.camera.v1.ControlInfo} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.camera.v1.GetPropertiesResponse.toObject = function(includeInstance, msg) {
+proto.viam.component.camera.v1.ControlInfo.toObject = function(includeInstance, msg) {
   var f, obj = {
-    supportsPcd: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
-    intrinsicParameters: (f = msg.getIntrinsicParameters()) && proto.viam.component.camera.v1.IntrinsicParameters.toObject(includeInstance, f),
-    distortionParameters: (f = msg.getDistortionParameters()) && proto.viam.component.camera.v1.DistortionParameters.toObject(includeInstance, f)
+    control: (f = msg.getControl()) && proto.viam.component.camera.v1.Control.toObject(includeInstance, f),
+    min: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0),
+    max: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0),
+    step: jspb.Message.getFloatingPointFieldWithDefault(msg, 4, 0.0),
+    resolutionsList: jspb.Message.toObjectList(msg.getResolutionsList(),
+    proto.viam.component.camera.v1.Resolution.toObject, includeInstance),
+    supportsAuto: jspb.Message.getBooleanFieldWithDefault(msg, 6, false)
   };
 
   if (includeInstance) {
@@ -2088,23 +5347,23 @@ proto.viam.component.camera.v1.GetPropertiesResponse.toObject = function(include
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.camera.v1.GetPropertiesResponse}
+ * @return {!proto.viam.component.camera.v1.ControlInfo}
  */
-proto.viam.component.camera.v1.GetPropertiesResponse.deserializeBinary = function(bytes) {
+proto.viam.component.camera.v1.ControlInfo.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.camera.v1.GetPropertiesResponse;
-  return proto.viam.component.camera.v1.GetPropertiesResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.camera.v1.ControlInfo;
+  return proto.viam.component.camera.v1.ControlInfo.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.camera.v1.GetPropertiesResponse} msg The message object to deserialize into.
+ * @param {!proto.viam.component.camera.v1.ControlInfo} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.camera.v1.GetPropertiesResponse}
+ * @return {!proto.viam.component.camera.v1.ControlInfo}
  */
-proto.viam.component.camera.v1.GetPropertiesResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.camera.v1.ControlInfo.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2112,18 +5371,30 @@ proto.viam.component.camera.v1.GetPropertiesResponse.deserializeBinaryFromReader
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setSupportsPcd(value);
+      var value = new proto.viam.component.camera.v1.Control;
+      reader.readMessage(value,proto.viam.component.camera.v1.Control.deserializeBinaryFromReader);
+      msg.setControl(value);
       break;
     case 2:
-      var value = new proto.viam.component.camera.v1.IntrinsicParameters;
-      reader.readMessage(value,proto.viam.component.camera.v1.IntrinsicParameters.deserializeBinaryFromReader);
-      msg.setIntrinsicParameters(value);
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setMin(value);
       break;
     case 3:
-      var value = new proto.viam.component.camera.v1.DistortionParameters;
-      reader.readMessage(value,proto.viam.component.camera.v1.DistortionParameters.deserializeBinaryFromReader);
-      msg.setDistortionParameters(value);
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setMax(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setStep(value);
+      break;
+    case 5:
+      var value = new proto.viam.component.camera.v1.Resolution;
+      reader.readMessage(value,proto.viam.component.camera.v1.Resolution.deserializeBinaryFromReader);
+      msg.addResolutions(value);
+      break;
+    case 6:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSupportsAuto(value);
       break;
     default:
       reader.skipField();
@@ -2138,9 +5409,9 @@ proto.viam.component.camera.v1.GetPropertiesResponse.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.camera.v1.GetPropertiesResponse.prototype.serializeBinary = function() {
+proto.viam.component.camera.v1.ControlInfo.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.camera.v1.GetPropertiesResponse.serializeBinaryToWriter(this, writer);
+  proto.viam.component.camera.v1.ControlInfo.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2148,127 +5419,203 @@ proto.viam.component.camera.v1.GetPropertiesResponse.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.camera.v1.GetPropertiesResponse} message
+ * @param {!proto.viam.component.camera.v1.ControlInfo} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.camera.v1.GetPropertiesResponse.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.camera.v1.ControlInfo.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSupportsPcd();
-  if (f) {
-    writer.writeBool(
+  f = message.getControl();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      proto.viam.component.camera.v1.Control.serializeBinaryToWriter
     );
   }
-  f = message.getIntrinsicParameters();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getMin();
+  if (f !== 0.0) {
+    writer.writeDouble(
       2,
-      f,
-      proto.viam.component.camera.v1.IntrinsicParameters.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getDistortionParameters();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getMax();
+  if (f !== 0.0) {
+    writer.writeDouble(
       3,
+      f
+    );
+  }
+  f = message.getStep();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      4,
+      f
+    );
+  }
+  f = message.getResolutionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      5,
       f,
-      proto.viam.component.camera.v1.DistortionParameters.serializeBinaryToWriter
+      proto.viam.component.camera.v1.Resolution.serializeBinaryToWriter
+    );
+  }
+  f = message.getSupportsAuto();
+  if (f) {
+    writer.writeBool(
+      6,
+      f
     );
   }
 };
 
 
 /**
- * optional bool supports_pcd = 1;
+ * optional Control control = 1;
+ * @return {?proto.viam.component.camera.v1.Control}
+ */
+proto.viam.component.camera.v1.ControlInfo.prototype.getControl = function() {
+  return /** @type{?proto.viam.component.camera.v1.Control} */ (
+    jspb.Message.getWrapperField(this, proto.viam.component.camera.v1.Control, 1));
+};
+
+
+/**
+ * @param {?proto.viam.component.camera.v1.Control|undefined} value
+ * @return {!proto.viam.component.camera.v1.ControlInfo} returns this
+*/
+proto.viam.component.camera.v1.ControlInfo.prototype.setControl = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.camera.v1.ControlInfo} returns this
+ */
+proto.viam.component.camera.v1.ControlInfo.prototype.clearControl = function() {
+  return this.setControl(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.component.camera.v1.GetPropertiesResponse.prototype.getSupportsPcd = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+proto.viam.component.camera.v1.ControlInfo.prototype.hasControl = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.viam.component.camera.v1.GetPropertiesResponse} returns this
+ * optional double min = 2;
+ * @return {number}
  */
-proto.viam.component.camera.v1.GetPropertiesResponse.prototype.setSupportsPcd = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
+proto.viam.component.camera.v1.ControlInfo.prototype.getMin = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
 };
 
 
 /**
- * optional IntrinsicParameters intrinsic_parameters = 2;
- * @return {?proto.viam.component.camera.v1.IntrinsicParameters}
+ * @param {number} value
+ * @return {!proto.viam.component.camera.v1.ControlInfo} returns this
  */
-proto.viam.component.camera.v1.GetPropertiesResponse.prototype.getIntrinsicParameters = function() {
-  return /** @type{?proto.viam.component.camera.v1.IntrinsicParameters} */ (
-    jspb.Message.getWrapperField(this, proto.viam.component.camera.v1.IntrinsicParameters, 2));
+proto.viam.component.camera.v1.ControlInfo.prototype.setMin = function(value) {
+  return jspb.Message.setProto3FloatField(this, 2, value);
 };
 
 
 /**
- * @param {?proto.viam.component.camera.v1.IntrinsicParameters|undefined} value
- * @return {!proto.viam.component.camera.v1.GetPropertiesResponse} returns this
-*/
-proto.viam.component.camera.v1.GetPropertiesResponse.prototype.setIntrinsicParameters = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+ * optional double max = 3;
+ * @return {number}
+ */
+proto.viam.component.camera.v1.ControlInfo.prototype.getMax = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 3, 0.0));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.viam.component.camera.v1.GetPropertiesResponse} returns this
+ * @param {number} value
+ * @return {!proto.viam.component.camera.v1.ControlInfo} returns this
  */
-proto.viam.component.camera.v1.GetPropertiesResponse.prototype.clearIntrinsicParameters = function() {
-  return this.setIntrinsicParameters(undefined);
+proto.viam.component.camera.v1.ControlInfo.prototype.setMax = function(value) {
+  return jspb.Message.setProto3FloatField(this, 3, value);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * optional double step = 4;
+ * @return {number}
  */
-proto.viam.component.camera.v1.GetPropertiesResponse.prototype.hasIntrinsicParameters = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.viam.component.camera.v1.ControlInfo.prototype.getStep = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 4, 0.0));
 };
 
 
 /**
- * optional DistortionParameters distortion_parameters = 3;
- * @return {?proto.viam.component.camera.v1.DistortionParameters}
+ * @param {number} value
+ * @return {!proto.viam.component.camera.v1.ControlInfo} returns this
  */
-proto.viam.component.camera.v1.GetPropertiesResponse.prototype.getDistortionParameters = function() {
-  return /** @type{?proto.viam.component.camera.v1.DistortionParameters} */ (
-    jspb.Message.getWrapperField(this, proto.viam.component.camera.v1.DistortionParameters, 3));
+proto.viam.component.camera.v1.ControlInfo.prototype.setStep = function(value) {
+  return jspb.Message.setProto3FloatField(this, 4, value);
 };
 
 
 /**
- * @param {?proto.viam.component.camera.v1.DistortionParameters|undefined} value
- * @return {!proto.viam.component.camera.v1.GetPropertiesResponse} returns this
+ * repeated Resolution resolutions = 5;
+ * @return {!Array<!proto.viam.component.camera.v1.Resolution>}
+ */
+proto.viam.component.camera.v1.ControlInfo.prototype.getResolutionsList = function() {
+  return /** @type{!Array<!proto.viam.component.camera.v1.Resolution>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.viam.component.camera.v1.Resolution, 5));
+};
+
+
+/**
+ * @param {!Array<!proto.viam.component.camera.v1.Resolution>} value
+ * @return {!proto.viam.component.camera.v1.ControlInfo} returns this
 */
-proto.viam.component.camera.v1.GetPropertiesResponse.prototype.setDistortionParameters = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+proto.viam.component.camera.v1.ControlInfo.prototype.setResolutionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 5, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.viam.component.camera.v1.GetPropertiesResponse} returns this
+ * @param {!proto.viam.component.camera.v1.Resolution=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.component.camera.v1.Resolution}
  */
-proto.viam.component.camera.v1.GetPropertiesResponse.prototype.clearDistortionParameters = function() {
-  return this.setDistortionParameters(undefined);
+proto.viam.component.camera.v1.ControlInfo.prototype.addResolutions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 5, opt_value, proto.viam.component.camera.v1.Resolution, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.component.camera.v1.ControlInfo} returns this
+ */
+proto.viam.component.camera.v1.ControlInfo.prototype.clearResolutionsList = function() {
+  return this.setResolutionsList([]);
+};
+
+
+/**
+ * optional bool supports_auto = 6;
  * @return {boolean}
  */
-proto.viam.component.camera.v1.GetPropertiesResponse.prototype.hasDistortionParameters = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.viam.component.camera.v1.ControlInfo.prototype.getSupportsAuto = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.viam.component.camera.v1.ControlInfo} returns this
+ */
+proto.viam.component.camera.v1.ControlInfo.prototype.setSupportsAuto = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 6, value);
 };
 
 
@@ -3411,4 +6758,28 @@ proto.viam.component.camera.v1.Format = {
   FORMAT_PNG: 4
 };
 
+/**
+ * @enum {number}
+ */
+proto.viam.component.camera.v1.VideoCodec = {
+  VIDEO_CODEC_UNSPECIFIED: 0,
+  VIDEO_CODEC_MJPEG: 1,
+  VIDEO_CODEC_H264: 2,
+  VIDEO_CODEC_RAW_RGBA: 3
+};
+
+/**
+ * @enum {number}
+ */
+proto.viam.component.camera.v1.ControlType = {
+  CONTROL_TYPE_UNSPECIFIED: 0,
+  CONTROL_TYPE_EXPOSURE: 1,
+  CONTROL_TYPE_GAIN: 2,
+  CONTROL_TYPE_WHITE_BALANCE: 3,
+  CONTROL_TYPE_FOCUS: 4,
+  CONTROL_TYPE_ZOOM: 5,
+  CONTROL_TYPE_FRAME_RATE: 6,
+  CONTROL_TYPE_RESOLUTION: 7
+};
+
 goog.object.extend(exports, proto.viam.component.camera.v1);
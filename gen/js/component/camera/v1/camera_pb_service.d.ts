@@ -24,6 +24,15 @@ type CameraServiceGetImages = {
   readonly responseType: typeof component_camera_v1_camera_pb.GetImagesResponse;
 };
 
+type CameraServiceStreamImages = {
+  readonly methodName: string;
+  readonly service: typeof CameraService;
+  readonly requestStream: false;
+  readonly responseStream: true;
+  readonly requestType: typeof component_camera_v1_camera_pb.StreamImagesRequest;
+  readonly responseType: typeof component_camera_v1_camera_pb.StreamImagesResponse;
+};
+
 type CameraServiceRenderFrame = {
   readonly methodName: string;
   readonly service: typeof CameraService;
@@ -42,6 +51,15 @@ type CameraServiceGetPointCloud = {
   readonly responseType: typeof component_camera_v1_camera_pb.GetPointCloudResponse;
 };
 
+type CameraServiceGetPointCloudStream = {
+  readonly methodName: string;
+  readonly service: typeof CameraService;
+  readonly requestStream: false;
+  readonly responseStream: true;
+  readonly requestType: typeof component_camera_v1_camera_pb.GetPointCloudStreamRequest;
+  readonly responseType: typeof component_camera_v1_camera_pb.GetPointCloudStreamResponse;
+};
+
 type CameraServiceGetProperties = {
   readonly methodName: string;
   readonly service: typeof CameraService;
@@ -51,6 +69,24 @@ type CameraServiceGetProperties = {
   readonly responseType: typeof component_camera_v1_camera_pb.GetPropertiesResponse;
 };
 
+type CameraServiceGetControls = {
+  readonly methodName: string;
+  readonly service: typeof CameraService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof component_camera_v1_camera_pb.GetControlsRequest;
+  readonly responseType: typeof component_camera_v1_camera_pb.GetControlsResponse;
+};
+
+type CameraServiceSetControls = {
+  readonly methodName: string;
+  readonly service: typeof CameraService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof component_camera_v1_camera_pb.SetControlsRequest;
+  readonly responseType: typeof component_camera_v1_camera_pb.SetControlsResponse;
+};
+
 type CameraServiceDoCommand = {
   readonly methodName: string;
   readonly service: typeof CameraService;
@@ -73,9 +109,13 @@ export class CameraService {
   static readonly serviceName: string;
   static readonly GetImage: CameraServiceGetImage;
   static readonly GetImages: CameraServiceGetImages;
+  static readonly StreamImages: CameraServiceStreamImages;
   static readonly RenderFrame: CameraServiceRenderFrame;
   static readonly GetPointCloud: CameraServiceGetPointCloud;
+  static readonly GetPointCloudStream: CameraServiceGetPointCloudStream;
   static readonly GetProperties: CameraServiceGetProperties;
+  static readonly GetControls: CameraServiceGetControls;
+  static readonly SetControls: CameraServiceSetControls;
   static readonly DoCommand: CameraServiceDoCommand;
   static readonly GetGeometries: CameraServiceGetGeometries;
 }
@@ -130,6 +170,7 @@ export class CameraServiceClient {
     requestMessage: component_camera_v1_camera_pb.GetImagesRequest,
     callback: (error: ServiceError|null, responseMessage: component_camera_v1_camera_pb.GetImagesResponse|null) => void
   ): UnaryResponse;
+  streamImages(requestMessage: component_camera_v1_camera_pb.StreamImagesRequest, metadata?: grpc.Metadata): ResponseStream<component_camera_v1_camera_pb.StreamImagesResponse>;
   renderFrame(
     requestMessage: component_camera_v1_camera_pb.RenderFrameRequest,
     metadata: grpc.Metadata,
@@ -148,6 +189,7 @@ export class CameraServiceClient {
     requestMessage: component_camera_v1_camera_pb.GetPointCloudRequest,
     callback: (error: ServiceError|null, responseMessage: component_camera_v1_camera_pb.GetPointCloudResponse|null) => void
   ): UnaryResponse;
+  getPointCloudStream(requestMessage: component_camera_v1_camera_pb.GetPointCloudStreamRequest, metadata?: grpc.Metadata): ResponseStream<component_camera_v1_camera_pb.GetPointCloudStreamResponse>;
   getProperties(
     requestMessage: component_camera_v1_camera_pb.GetPropertiesRequest,
     metadata: grpc.Metadata,
@@ -157,6 +199,24 @@ export class CameraServiceClient {
     requestMessage: component_camera_v1_camera_pb.GetPropertiesRequest,
     callback: (error: ServiceError|null, responseMessage: component_camera_v1_camera_pb.GetPropertiesResponse|null) => void
   ): UnaryResponse;
+  getControls(
+    requestMessage: component_camera_v1_camera_pb.GetControlsRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: component_camera_v1_camera_pb.GetControlsResponse|null) => void
+  ): UnaryResponse;
+  getControls(
+    requestMessage: component_camera_v1_camera_pb.GetControlsRequest,
+    callback: (error: ServiceError|null, responseMessage: component_camera_v1_camera_pb.GetControlsResponse|null) => void
+  ): UnaryResponse;
+  setControls(
+    requestMessage: component_camera_v1_camera_pb.SetControlsRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: component_camera_v1_camera_pb.SetControlsResponse|null) => void
+  ): UnaryResponse;
+  setControls(
+    requestMessage: component_camera_v1_camera_pb.SetControlsRequest,
+    callback: (error: ServiceError|null, responseMessage: component_camera_v1_camera_pb.SetControlsResponse|null) => void
+  ): UnaryResponse;
   doCommand(
     requestMessage: common_v1_common_pb.DoCommandRequest,
     metadata: grpc.Metadata,
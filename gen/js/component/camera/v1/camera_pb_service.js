@@ -30,6 +30,15 @@ CameraService.GetImages = {
   responseType: component_camera_v1_camera_pb.GetImagesResponse
 };
 
+CameraService.StreamImages = {
+  methodName: "StreamImages",
+  service: CameraService,
+  requestStream: false,
+  responseStream: true,
+  requestType: component_camera_v1_camera_pb.StreamImagesRequest,
+  responseType: component_camera_v1_camera_pb.StreamImagesResponse
+};
+
 CameraService.RenderFrame = {
   methodName: "RenderFrame",
   service: CameraService,
@@ -48,6 +57,15 @@ CameraService.GetPointCloud = {
   responseType: component_camera_v1_camera_pb.GetPointCloudResponse
 };
 
+CameraService.GetPointCloudStream = {
+  methodName: "GetPointCloudStream",
+  service: CameraService,
+  requestStream: false,
+  responseStream: true,
+  requestType: component_camera_v1_camera_pb.GetPointCloudStreamRequest,
+  responseType: component_camera_v1_camera_pb.GetPointCloudStreamResponse
+};
+
 CameraService.GetProperties = {
   methodName: "GetProperties",
   service: CameraService,
@@ -57,6 +75,24 @@ CameraService.GetProperties = {
   responseType: component_camera_v1_camera_pb.GetPropertiesResponse
 };
 
+CameraService.GetControls = {
+  methodName: "GetControls",
+  service: CameraService,
+  requestStream: false,
+  responseStream: false,
+  requestType: component_camera_v1_camera_pb.GetControlsRequest,
+  responseType: component_camera_v1_camera_pb.GetControlsResponse
+};
+
+CameraService.SetControls = {
+  methodName: "SetControls",
+  service: CameraService,
+  requestStream: false,
+  responseStream: false,
+  requestType: component_camera_v1_camera_pb.SetControlsRequest,
+  responseType: component_camera_v1_camera_pb.SetControlsResponse
+};
+
 CameraService.DoCommand = {
   methodName: "DoCommand",
   service: CameraService,
@@ -144,6 +180,45 @@ CameraServiceClient.prototype.getImages = function getImages(requestMessage, met
   };
 };
 
+CameraServiceClient.prototype.streamImages = function streamImages(requestMessage, metadata) {
+  var listeners = {
+    data: [],
+    end: [],
+    status: []
+  };
+  var client = grpc.invoke(CameraService.StreamImages, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onMessage: function (responseMessage) {
+      listeners.data.forEach(function (handler) {
+        handler(responseMessage);
+      });
+    },
+    onEnd: function (status, statusMessage, trailers) {
+      listeners.status.forEach(function (handler) {
+        handler({ code: status, details: statusMessage, metadata: trailers });
+      });
+      listeners.end.forEach(function (handler) {
+        handler({ code: status, details: statusMessage, metadata: trailers });
+      });
+      listeners = null;
+    }
+  });
+  return {
+    on: function (type, handler) {
+      listeners[type].push(handler);
+      return this;
+    },
+    cancel: function () {
+      listeners = null;
+      client.close();
+    }
+  };
+};
+
 CameraServiceClient.prototype.renderFrame = function renderFrame(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
@@ -206,6 +281,45 @@ CameraServiceClient.prototype.getPointCloud = function getPointCloud(requestMess
   };
 };
 
+CameraServiceClient.prototype.getPointCloudStream = function getPointCloudStream(requestMessage, metadata) {
+  var listeners = {
+    data: [],
+    end: [],
+    status: []
+  };
+  var client = grpc.invoke(CameraService.GetPointCloudStream, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onMessage: function (responseMessage) {
+      listeners.data.forEach(function (handler) {
+        handler(responseMessage);
+      });
+    },
+    onEnd: function (status, statusMessage, trailers) {
+      listeners.status.forEach(function (handler) {
+        handler({ code: status, details: statusMessage, metadata: trailers });
+      });
+      listeners.end.forEach(function (handler) {
+        handler({ code: status, details: statusMessage, metadata: trailers });
+      });
+      listeners = null;
+    }
+  });
+  return {
+    on: function (type, handler) {
+      listeners[type].push(handler);
+      return this;
+    },
+    cancel: function () {
+      listeners = null;
+      client.close();
+    }
+  };
+};
+
 CameraServiceClient.prototype.getProperties = function getProperties(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
@@ -237,6 +351,68 @@ CameraServiceClient.prototype.getProperties = function getProperties(requestMess
   };
 };
 
+CameraServiceClient.prototype.getControls = function getControls(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(CameraService.GetControls, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+CameraServiceClient.prototype.setControls = function setControls(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(CameraService.SetControls, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
 CameraServiceClient.prototype.doCommand = function doCommand(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
@@ -205,6 +205,67 @@ proto.viam.component.gantry.v1.GantryServicePromiseClient.prototype.moveToPositi
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.viam.component.gantry.v1.SetVelocityRequest,
+ *   !proto.viam.component.gantry.v1.SetVelocityResponse>}
+ */
+const methodDescriptor_GantryService_SetVelocity = new grpc.web.MethodDescriptor(
+  '/viam.component.gantry.v1.GantryService/SetVelocity',
+  grpc.web.MethodType.UNARY,
+  proto.viam.component.gantry.v1.SetVelocityRequest,
+  proto.viam.component.gantry.v1.SetVelocityResponse,
+  /**
+   * @param {!proto.viam.component.gantry.v1.SetVelocityRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.viam.component.gantry.v1.SetVelocityResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.viam.component.gantry.v1.SetVelocityRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.viam.component.gantry.v1.SetVelocityResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.viam.component.gantry.v1.SetVelocityResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.viam.component.gantry.v1.GantryServiceClient.prototype.setVelocity =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/viam.component.gantry.v1.GantryService/SetVelocity',
+      request,
+      metadata || {},
+      methodDescriptor_GantryService_SetVelocity,
+      callback);
+};
+
+
+/**
+ * @param {!proto.viam.component.gantry.v1.SetVelocityRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.viam.component.gantry.v1.SetVelocityResponse>}
+ *     Promise that resolves to the response
+ */
+proto.viam.component.gantry.v1.GantryServicePromiseClient.prototype.setVelocity =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/viam.component.gantry.v1.GantryService/SetVelocity',
+      request,
+      metadata || {},
+      methodDescriptor_GantryService_SetVelocity);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -68,6 +68,19 @@ export class MoveToPositionRequest extends jspb.Message {
   setSpeedsMmPerSecList(value: Array<number>): void;
   addSpeedsMmPerSec(value: number, index?: number): number;
 
+  clearAccelerationsMmPerSec2List(): void;
+  getAccelerationsMmPerSec2List(): Array<number>;
+  setAccelerationsMmPerSec2List(value: Array<number>): void;
+  addAccelerationsMmPerSec2(value: number, index?: number): number;
+
+  clearJerksMmPerSec3List(): void;
+  getJerksMmPerSec3List(): Array<number>;
+  setJerksMmPerSec3List(value: Array<number>): void;
+  addJerksMmPerSec3(value: number, index?: number): number;
+
+  getInterpolationMode(): InterpolationModeMap[keyof InterpolationModeMap];
+  setInterpolationMode(value: InterpolationModeMap[keyof InterpolationModeMap]): void;
+
   hasExtra(): boolean;
   clearExtra(): void;
   getExtra(): google_protobuf_struct_pb.Struct | undefined;
@@ -88,6 +101,9 @@ export namespace MoveToPositionRequest {
     name: string,
     positionsMmList: Array<number>,
     speedsMmPerSecList: Array<number>,
+    accelerationsMmPerSec2List: Array<number>,
+    jerksMmPerSec3List: Array<number>,
+    interpolationMode: InterpolationModeMap[keyof InterpolationModeMap],
     extra?: google_protobuf_struct_pb.Struct.AsObject,
   }
 }
@@ -108,6 +124,60 @@ export namespace MoveToPositionResponse {
   }
 }
 
+export class SetVelocityRequest extends jspb.Message {
+  getName(): string;
+  setName(value: string): void;
+
+  clearVelocitiesMmPerSecList(): void;
+  getVelocitiesMmPerSecList(): Array<number>;
+  setVelocitiesMmPerSecList(value: Array<number>): void;
+  addVelocitiesMmPerSec(value: number, index?: number): number;
+
+  clearAccelerationsMmPerSec2List(): void;
+  getAccelerationsMmPerSec2List(): Array<number>;
+  setAccelerationsMmPerSec2List(value: Array<number>): void;
+  addAccelerationsMmPerSec2(value: number, index?: number): number;
+
+  hasExtra(): boolean;
+  clearExtra(): void;
+  getExtra(): google_protobuf_struct_pb.Struct | undefined;
+  setExtra(value?: google_protobuf_struct_pb.Struct): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SetVelocityRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: SetVelocityRequest): SetVelocityRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SetVelocityRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetVelocityRequest;
+  static deserializeBinaryFromReader(message: SetVelocityRequest, reader: jspb.BinaryReader): SetVelocityRequest;
+}
+
+export namespace SetVelocityRequest {
+  export type AsObject = {
+    name: string,
+    velocitiesMmPerSecList: Array<number>,
+    accelerationsMmPerSec2List: Array<number>,
+    extra?: google_protobuf_struct_pb.Struct.AsObject,
+  }
+}
+
+export class SetVelocityResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SetVelocityResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: SetVelocityResponse): SetVelocityResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SetVelocityResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetVelocityResponse;
+  static deserializeBinaryFromReader(message: SetVelocityResponse, reader: jspb.BinaryReader): SetVelocityResponse;
+}
+
+export namespace SetVelocityResponse {
+  export type AsObject = {
+  }
+}
+
 export class HomeRequest extends jspb.Message {
   getName(): string;
   setName(value: string): void;
@@ -316,3 +386,11 @@ export namespace IsMovingResponse {
   }
 }
 
+export interface InterpolationModeMap {
+  INTERPOLATION_MODE_UNSPECIFIED: 0;
+  INTERPOLATION_MODE_INDEPENDENT: 1;
+  INTERPOLATION_MODE_LINEAR: 2;
+}
+
+export const InterpolationMode: InterpolationModeMap;
+
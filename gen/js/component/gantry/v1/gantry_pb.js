@@ -27,10 +27,13 @@ goog.exportSymbol('proto.viam.component.gantry.v1.GetPositionRequest', null, glo
 goog.exportSymbol('proto.viam.component.gantry.v1.GetPositionResponse', null, global);
 goog.exportSymbol('proto.viam.component.gantry.v1.HomeRequest', null, global);
 goog.exportSymbol('proto.viam.component.gantry.v1.HomeResponse', null, global);
+goog.exportSymbol('proto.viam.component.gantry.v1.InterpolationMode', null, global);
 goog.exportSymbol('proto.viam.component.gantry.v1.IsMovingRequest', null, global);
 goog.exportSymbol('proto.viam.component.gantry.v1.IsMovingResponse', null, global);
 goog.exportSymbol('proto.viam.component.gantry.v1.MoveToPositionRequest', null, global);
 goog.exportSymbol('proto.viam.component.gantry.v1.MoveToPositionResponse', null, global);
+goog.exportSymbol('proto.viam.component.gantry.v1.SetVelocityRequest', null, global);
+goog.exportSymbol('proto.viam.component.gantry.v1.SetVelocityResponse', null, global);
 goog.exportSymbol('proto.viam.component.gantry.v1.Status', null, global);
 goog.exportSymbol('proto.viam.component.gantry.v1.StopRequest', null, global);
 goog.exportSymbol('proto.viam.component.gantry.v1.StopResponse', null, global);
@@ -118,6 +121,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.viam.component.gantry.v1.MoveToPositionResponse.displayName = 'proto.viam.component.gantry.v1.MoveToPositionResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.gantry.v1.SetVelocityRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.viam.component.gantry.v1.SetVelocityRequest.repeatedFields_, null);
+};
+goog.inherits(proto.viam.component.gantry.v1.SetVelocityRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.gantry.v1.SetVelocityRequest.displayName = 'proto.viam.component.gantry.v1.SetVelocityRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.gantry.v1.SetVelocityResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.component.gantry.v1.SetVelocityResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.gantry.v1.SetVelocityResponse.displayName = 'proto.viam.component.gantry.v1.SetVelocityResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -652,7 +697,7 @@ proto.viam.component.gantry.v1.GetPositionResponse.prototype.clearPositionsMmLis
  * @private {!Array<number>}
  * @const
  */
-proto.viam.component.gantry.v1.MoveToPositionRequest.repeatedFields_ = [2,3];
+proto.viam.component.gantry.v1.MoveToPositionRequest.repeatedFields_ = [2,3,4,5];
 
 
 
@@ -688,6 +733,9 @@ proto.viam.component.gantry.v1.MoveToPositionRequest.toObject = function(include
     name: jspb.Message.getFieldWithDefault(msg, 1, ""),
     positionsMmList: (f = jspb.Message.getRepeatedFloatingPointField(msg, 2)) == null ? undefined : f,
     speedsMmPerSecList: (f = jspb.Message.getRepeatedFloatingPointField(msg, 3)) == null ? undefined : f,
+    accelerationsMmPerSec2List: (f = jspb.Message.getRepeatedFloatingPointField(msg, 4)) == null ? undefined : f,
+    jerksMmPerSec3List: (f = jspb.Message.getRepeatedFloatingPointField(msg, 5)) == null ? undefined : f,
+    interpolationMode: jspb.Message.getFieldWithDefault(msg, 6, 0),
     extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
   };
 
@@ -741,6 +789,22 @@ proto.viam.component.gantry.v1.MoveToPositionRequest.deserializeBinaryFromReader
         msg.addSpeedsMmPerSec(values[i]);
       }
       break;
+    case 4:
+      var values = /** @type {!Array<number>} */ (reader.isDelimited() ? reader.readPackedDouble() : [reader.readDouble()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addAccelerationsMmPerSec2(values[i]);
+      }
+      break;
+    case 5:
+      var values = /** @type {!Array<number>} */ (reader.isDelimited() ? reader.readPackedDouble() : [reader.readDouble()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addJerksMmPerSec3(values[i]);
+      }
+      break;
+    case 6:
+      var value = /** @type {!proto.viam.component.gantry.v1.InterpolationMode} */ (reader.readEnum());
+      msg.setInterpolationMode(value);
+      break;
     case 99:
       var value = new google_protobuf_struct_pb.Struct;
       reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
@@ -796,6 +860,27 @@ proto.viam.component.gantry.v1.MoveToPositionRequest.serializeBinaryToWriter = f
       f
     );
   }
+  f = message.getAccelerationsMmPerSec2List();
+  if (f.length > 0) {
+    writer.writePackedDouble(
+      4,
+      f
+    );
+  }
+  f = message.getJerksMmPerSec3List();
+  if (f.length > 0) {
+    writer.writePackedDouble(
+      5,
+      f
+    );
+  }
+  f = message.getInterpolationMode();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      6,
+      f
+    );
+  }
   f = message.getExtra();
   if (f != null) {
     writer.writeMessage(
@@ -899,6 +984,98 @@ proto.viam.component.gantry.v1.MoveToPositionRequest.prototype.clearSpeedsMmPerS
 };
 
 
+/**
+ * repeated double accelerations_mm_per_sec2 = 4;
+ * @return {!Array<number>}
+ */
+proto.viam.component.gantry.v1.MoveToPositionRequest.prototype.getAccelerationsMmPerSec2List = function() {
+  return /** @type {!Array<number>} */ (jspb.Message.getRepeatedFloatingPointField(this, 4));
+};
+
+
+/**
+ * @param {!Array<number>} value
+ * @return {!proto.viam.component.gantry.v1.MoveToPositionRequest} returns this
+ */
+proto.viam.component.gantry.v1.MoveToPositionRequest.prototype.setAccelerationsMmPerSec2List = function(value) {
+  return jspb.Message.setField(this, 4, value || []);
+};
+
+
+/**
+ * @param {number} value
+ * @param {number=} opt_index
+ * @return {!proto.viam.component.gantry.v1.MoveToPositionRequest} returns this
+ */
+proto.viam.component.gantry.v1.MoveToPositionRequest.prototype.addAccelerationsMmPerSec2 = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.component.gantry.v1.MoveToPositionRequest} returns this
+ */
+proto.viam.component.gantry.v1.MoveToPositionRequest.prototype.clearAccelerationsMmPerSec2List = function() {
+  return this.setAccelerationsMmPerSec2List([]);
+};
+
+
+/**
+ * repeated double jerks_mm_per_sec3 = 5;
+ * @return {!Array<number>}
+ */
+proto.viam.component.gantry.v1.MoveToPositionRequest.prototype.getJerksMmPerSec3List = function() {
+  return /** @type {!Array<number>} */ (jspb.Message.getRepeatedFloatingPointField(this, 5));
+};
+
+
+/**
+ * @param {!Array<number>} value
+ * @return {!proto.viam.component.gantry.v1.MoveToPositionRequest} returns this
+ */
+proto.viam.component.gantry.v1.MoveToPositionRequest.prototype.setJerksMmPerSec3List = function(value) {
+  return jspb.Message.setField(this, 5, value || []);
+};
+
+
+/**
+ * @param {number} value
+ * @param {number=} opt_index
+ * @return {!proto.viam.component.gantry.v1.MoveToPositionRequest} returns this
+ */
+proto.viam.component.gantry.v1.MoveToPositionRequest.prototype.addJerksMmPerSec3 = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.component.gantry.v1.MoveToPositionRequest} returns this
+ */
+proto.viam.component.gantry.v1.MoveToPositionRequest.prototype.clearJerksMmPerSec3List = function() {
+  return this.setJerksMmPerSec3List([]);
+};
+
+
+/**
+ * optional InterpolationMode interpolation_mode = 6;
+ * @return {!proto.viam.component.gantry.v1.InterpolationMode}
+ */
+proto.viam.component.gantry.v1.MoveToPositionRequest.prototype.getInterpolationMode = function() {
+  return /** @type {!proto.viam.component.gantry.v1.InterpolationMode} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {!proto.viam.component.gantry.v1.InterpolationMode} value
+ * @return {!proto.viam.component.gantry.v1.MoveToPositionRequest} returns this
+ */
+proto.viam.component.gantry.v1.MoveToPositionRequest.prototype.setInterpolationMode = function(value) {
+  return jspb.Message.setProto3EnumField(this, 6, value);
+};
+
+
 /**
  * optional google.protobuf.Struct extra = 99;
  * @return {?proto.google.protobuf.Struct}
@@ -1038,6 +1215,397 @@ proto.viam.component.gantry.v1.MoveToPositionResponse.serializeBinaryToWriter =
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.component.gantry.v1.SetVelocityRequest.repeatedFields_ = [2,3];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.gantry.v1.SetVelocityRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.gantry.v1.SetVelocityRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.gantry.v1.SetVelocityRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.gantry.v1.SetVelocityRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    velocitiesMmPerSecList: (f = jspb.Message.getRepeatedFloatingPointField(msg, 2)) == null ? undefined : f,
+    accelerationsMmPerSec2List: (f = jspb.Message.getRepeatedFloatingPointField(msg, 3)) == null ? undefined : f,
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.gantry.v1.SetVelocityRequest}
+ */
+proto.viam.component.gantry.v1.SetVelocityRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.gantry.v1.SetVelocityRequest;
+  return proto.viam.component.gantry.v1.SetVelocityRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.gantry.v1.SetVelocityRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.gantry.v1.SetVelocityRequest}
+ */
+proto.viam.component.gantry.v1.SetVelocityRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var values = /** @type {!Array<number>} */ (reader.isDelimited() ? reader.readPackedDouble() : [reader.readDouble()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addVelocitiesMmPerSec(values[i]);
+      }
+      break;
+    case 3:
+      var values = /** @type {!Array<number>} */ (reader.isDelimited() ? reader.readPackedDouble() : [reader.readDouble()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addAccelerationsMmPerSec2(values[i]);
+      }
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.gantry.v1.SetVelocityRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.gantry.v1.SetVelocityRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.gantry.v1.SetVelocityRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.gantry.v1.SetVelocityRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getVelocitiesMmPerSecList();
+  if (f.length > 0) {
+    writer.writePackedDouble(
+      2,
+      f
+    );
+  }
+  f = message.getAccelerationsMmPerSec2List();
+  if (f.length > 0) {
+    writer.writePackedDouble(
+      3,
+      f
+    );
+  }
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.viam.component.gantry.v1.SetVelocityRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.gantry.v1.SetVelocityRequest} returns this
+ */
+proto.viam.component.gantry.v1.SetVelocityRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * repeated double velocities_mm_per_sec = 2;
+ * @return {!Array<number>}
+ */
+proto.viam.component.gantry.v1.SetVelocityRequest.prototype.getVelocitiesMmPerSecList = function() {
+  return /** @type {!Array<number>} */ (jspb.Message.getRepeatedFloatingPointField(this, 2));
+};
+
+
+/**
+ * @param {!Array<number>} value
+ * @return {!proto.viam.component.gantry.v1.SetVelocityRequest} returns this
+ */
+proto.viam.component.gantry.v1.SetVelocityRequest.prototype.setVelocitiesMmPerSecList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {number} value
+ * @param {number=} opt_index
+ * @return {!proto.viam.component.gantry.v1.SetVelocityRequest} returns this
+ */
+proto.viam.component.gantry.v1.SetVelocityRequest.prototype.addVelocitiesMmPerSec = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.component.gantry.v1.SetVelocityRequest} returns this
+ */
+proto.viam.component.gantry.v1.SetVelocityRequest.prototype.clearVelocitiesMmPerSecList = function() {
+  return this.setVelocitiesMmPerSecList([]);
+};
+
+
+/**
+ * repeated double accelerations_mm_per_sec2 = 3;
+ * @return {!Array<number>}
+ */
+proto.viam.component.gantry.v1.SetVelocityRequest.prototype.getAccelerationsMmPerSec2List = function() {
+  return /** @type {!Array<number>} */ (jspb.Message.getRepeatedFloatingPointField(this, 3));
+};
+
+
+/**
+ * @param {!Array<number>} value
+ * @return {!proto.viam.component.gantry.v1.SetVelocityRequest} returns this
+ */
+proto.viam.component.gantry.v1.SetVelocityRequest.prototype.setAccelerationsMmPerSec2List = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {number} value
+ * @param {number=} opt_index
+ * @return {!proto.viam.component.gantry.v1.SetVelocityRequest} returns this
+ */
+proto.viam.component.gantry.v1.SetVelocityRequest.prototype.addAccelerationsMmPerSec2 = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.component.gantry.v1.SetVelocityRequest} returns this
+ */
+proto.viam.component.gantry.v1.SetVelocityRequest.prototype.clearAccelerationsMmPerSec2List = function() {
+  return this.setAccelerationsMmPerSec2List([]);
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.component.gantry.v1.SetVelocityRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.component.gantry.v1.SetVelocityRequest} returns this
+*/
+proto.viam.component.gantry.v1.SetVelocityRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.gantry.v1.SetVelocityRequest} returns this
+ */
+proto.viam.component.gantry.v1.SetVelocityRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.gantry.v1.SetVelocityRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.gantry.v1.SetVelocityResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.gantry.v1.SetVelocityResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.gantry.v1.SetVelocityResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.gantry.v1.SetVelocityResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.gantry.v1.SetVelocityResponse}
+ */
+proto.viam.component.gantry.v1.SetVelocityResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.gantry.v1.SetVelocityResponse;
+  return proto.viam.component.gantry.v1.SetVelocityResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.gantry.v1.SetVelocityResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.gantry.v1.SetVelocityResponse}
+ */
+proto.viam.component.gantry.v1.SetVelocityResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.gantry.v1.SetVelocityResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.gantry.v1.SetVelocityResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.gantry.v1.SetVelocityResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.gantry.v1.SetVelocityResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -2468,4 +3036,13 @@ proto.viam.component.gantry.v1.IsMovingResponse.prototype.setIsMoving = function
 };
 
 
+/**
+ * @enum {number}
+ */
+proto.viam.component.gantry.v1.InterpolationMode = {
+  INTERPOLATION_MODE_UNSPECIFIED: 0,
+  INTERPOLATION_MODE_INDEPENDENT: 1,
+  INTERPOLATION_MODE_LINEAR: 2
+};
+
 goog.object.extend(exports, proto.viam.component.gantry.v1);
@@ -23,6 +23,15 @@ type GantryServiceMoveToPosition = {
   readonly responseType: typeof component_gantry_v1_gantry_pb.MoveToPositionResponse;
 };
 
+type GantryServiceSetVelocity = {
+  readonly methodName: string;
+  readonly service: typeof GantryService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof component_gantry_v1_gantry_pb.SetVelocityRequest;
+  readonly responseType: typeof component_gantry_v1_gantry_pb.SetVelocityResponse;
+};
+
 type GantryServiceHome = {
   readonly methodName: string;
   readonly service: typeof GantryService;
@@ -81,6 +90,7 @@ export class GantryService {
   static readonly serviceName: string;
   static readonly GetPosition: GantryServiceGetPosition;
   static readonly MoveToPosition: GantryServiceMoveToPosition;
+  static readonly SetVelocity: GantryServiceSetVelocity;
   static readonly Home: GantryServiceHome;
   static readonly GetLengths: GantryServiceGetLengths;
   static readonly Stop: GantryServiceStop;
@@ -139,6 +149,15 @@ export class GantryServiceClient {
     requestMessage: component_gantry_v1_gantry_pb.MoveToPositionRequest,
     callback: (error: ServiceError|null, responseMessage: component_gantry_v1_gantry_pb.MoveToPositionResponse|null) => void
   ): UnaryResponse;
+  setVelocity(
+    requestMessage: component_gantry_v1_gantry_pb.SetVelocityRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: component_gantry_v1_gantry_pb.SetVelocityResponse|null) => void
+  ): UnaryResponse;
+  setVelocity(
+    requestMessage: component_gantry_v1_gantry_pb.SetVelocityRequest,
+    callback: (error: ServiceError|null, responseMessage: component_gantry_v1_gantry_pb.SetVelocityResponse|null) => void
+  ): UnaryResponse;
   home(
     requestMessage: component_gantry_v1_gantry_pb.HomeRequest,
     metadata: grpc.Metadata,
@@ -29,6 +29,15 @@ GantryService.MoveToPosition = {
   responseType: component_gantry_v1_gantry_pb.MoveToPositionResponse
 };
 
+GantryService.SetVelocity = {
+  methodName: "SetVelocity",
+  service: GantryService,
+  requestStream: false,
+  responseStream: false,
+  requestType: component_gantry_v1_gantry_pb.SetVelocityRequest,
+  responseType: component_gantry_v1_gantry_pb.SetVelocityResponse
+};
+
 GantryService.Home = {
   methodName: "Home",
   service: GantryService,
@@ -152,6 +161,37 @@ GantryServiceClient.prototype.moveToPosition = function moveToPosition(requestMe
   };
 };
 
+GantryServiceClient.prototype.setVelocity = function setVelocity(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(GantryService.SetVelocity, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
 GantryServiceClient.prototype.home = function home(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
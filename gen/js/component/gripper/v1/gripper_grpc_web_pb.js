@@ -205,6 +205,189 @@ proto.viam.component.gripper.v1.GripperServicePromiseClient.prototype.grab =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.viam.component.gripper.v1.MoveToWidthRequest,
+ *   !proto.viam.component.gripper.v1.MoveToWidthResponse>}
+ */
+const methodDescriptor_GripperService_MoveToWidth = new grpc.web.MethodDescriptor(
+  '/viam.component.gripper.v1.GripperService/MoveToWidth',
+  grpc.web.MethodType.UNARY,
+  proto.viam.component.gripper.v1.MoveToWidthRequest,
+  proto.viam.component.gripper.v1.MoveToWidthResponse,
+  /**
+   * @param {!proto.viam.component.gripper.v1.MoveToWidthRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.viam.component.gripper.v1.MoveToWidthResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.viam.component.gripper.v1.MoveToWidthRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.viam.component.gripper.v1.MoveToWidthResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.viam.component.gripper.v1.MoveToWidthResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.viam.component.gripper.v1.GripperServiceClient.prototype.moveToWidth =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/viam.component.gripper.v1.GripperService/MoveToWidth',
+      request,
+      metadata || {},
+      methodDescriptor_GripperService_MoveToWidth,
+      callback);
+};
+
+
+/**
+ * @param {!proto.viam.component.gripper.v1.MoveToWidthRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.viam.component.gripper.v1.MoveToWidthResponse>}
+ *     Promise that resolves to the response
+ */
+proto.viam.component.gripper.v1.GripperServicePromiseClient.prototype.moveToWidth =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/viam.component.gripper.v1.GripperService/MoveToWidth',
+      request,
+      metadata || {},
+      methodDescriptor_GripperService_MoveToWidth);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.viam.component.gripper.v1.GetStateRequest,
+ *   !proto.viam.component.gripper.v1.GetStateResponse>}
+ */
+const methodDescriptor_GripperService_GetState = new grpc.web.MethodDescriptor(
+  '/viam.component.gripper.v1.GripperService/GetState',
+  grpc.web.MethodType.UNARY,
+  proto.viam.component.gripper.v1.GetStateRequest,
+  proto.viam.component.gripper.v1.GetStateResponse,
+  /**
+   * @param {!proto.viam.component.gripper.v1.GetStateRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.viam.component.gripper.v1.GetStateResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.viam.component.gripper.v1.GetStateRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.viam.component.gripper.v1.GetStateResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.viam.component.gripper.v1.GetStateResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.viam.component.gripper.v1.GripperServiceClient.prototype.getState =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/viam.component.gripper.v1.GripperService/GetState',
+      request,
+      metadata || {},
+      methodDescriptor_GripperService_GetState,
+      callback);
+};
+
+
+/**
+ * @param {!proto.viam.component.gripper.v1.GetStateRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.viam.component.gripper.v1.GetStateResponse>}
+ *     Promise that resolves to the response
+ */
+proto.viam.component.gripper.v1.GripperServicePromiseClient.prototype.getState =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/viam.component.gripper.v1.GripperService/GetState',
+      request,
+      metadata || {},
+      methodDescriptor_GripperService_GetState);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.viam.component.gripper.v1.GetPropertiesRequest,
+ *   !proto.viam.component.gripper.v1.GetPropertiesResponse>}
+ */
+const methodDescriptor_GripperService_GetProperties = new grpc.web.MethodDescriptor(
+  '/viam.component.gripper.v1.GripperService/GetProperties',
+  grpc.web.MethodType.UNARY,
+  proto.viam.component.gripper.v1.GetPropertiesRequest,
+  proto.viam.component.gripper.v1.GetPropertiesResponse,
+  /**
+   * @param {!proto.viam.component.gripper.v1.GetPropertiesRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.viam.component.gripper.v1.GetPropertiesResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.viam.component.gripper.v1.GetPropertiesRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.viam.component.gripper.v1.GetPropertiesResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.viam.component.gripper.v1.GetPropertiesResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.viam.component.gripper.v1.GripperServiceClient.prototype.getProperties =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/viam.component.gripper.v1.GripperService/GetProperties',
+      request,
+      metadata || {},
+      methodDescriptor_GripperService_GetProperties,
+      callback);
+};
+
+
+/**
+ * @param {!proto.viam.component.gripper.v1.GetPropertiesRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.viam.component.gripper.v1.GetPropertiesResponse>}
+ *     Promise that resolves to the response
+ */
+proto.viam.component.gripper.v1.GripperServicePromiseClient.prototype.getProperties =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/viam.component.gripper.v1.GripperService/GetProperties',
+      request,
+      metadata || {},
+      methodDescriptor_GripperService_GetProperties);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
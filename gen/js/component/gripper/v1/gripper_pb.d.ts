@@ -100,6 +100,192 @@ export namespace GrabResponse {
   }
 }
 
+export class MoveToWidthRequest extends jspb.Message {
+  getName(): string;
+  setName(value: string): void;
+
+  getWidthMm(): number;
+  setWidthMm(value: number): void;
+
+  hasMaxForceN(): boolean;
+  clearMaxForceN(): void;
+  getMaxForceN(): number;
+  setMaxForceN(value: number): void;
+
+  hasSpeedMmPerSec(): boolean;
+  clearSpeedMmPerSec(): void;
+  getSpeedMmPerSec(): number;
+  setSpeedMmPerSec(value: number): void;
+
+  hasExtra(): boolean;
+  clearExtra(): void;
+  getExtra(): google_protobuf_struct_pb.Struct | undefined;
+  setExtra(value?: google_protobuf_struct_pb.Struct): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): MoveToWidthRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: MoveToWidthRequest): MoveToWidthRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: MoveToWidthRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): MoveToWidthRequest;
+  static deserializeBinaryFromReader(message: MoveToWidthRequest, reader: jspb.BinaryReader): MoveToWidthRequest;
+}
+
+export namespace MoveToWidthRequest {
+  export type AsObject = {
+    name: string,
+    widthMm: number,
+    maxForceN: number,
+    speedMmPerSec: number,
+    extra?: google_protobuf_struct_pb.Struct.AsObject,
+  }
+}
+
+export class MoveToWidthResponse extends jspb.Message {
+  getObjectDetected(): boolean;
+  setObjectDetected(value: boolean): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): MoveToWidthResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: MoveToWidthResponse): MoveToWidthResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: MoveToWidthResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): MoveToWidthResponse;
+  static deserializeBinaryFromReader(message: MoveToWidthResponse, reader: jspb.BinaryReader): MoveToWidthResponse;
+}
+
+export namespace MoveToWidthResponse {
+  export type AsObject = {
+    objectDetected: boolean,
+  }
+}
+
+export class GetStateRequest extends jspb.Message {
+  getName(): string;
+  setName(value: string): void;
+
+  hasExtra(): boolean;
+  clearExtra(): void;
+  getExtra(): google_protobuf_struct_pb.Struct | undefined;
+  setExtra(value?: google_protobuf_struct_pb.Struct): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetStateRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: GetStateRequest): GetStateRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetStateRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetStateRequest;
+  static deserializeBinaryFromReader(message: GetStateRequest, reader: jspb.BinaryReader): GetStateRequest;
+}
+
+export namespace GetStateRequest {
+  export type AsObject = {
+    name: string,
+    extra?: google_protobuf_struct_pb.Struct.AsObject,
+  }
+}
+
+export class GetStateResponse extends jspb.Message {
+  getWidthMm(): number;
+  setWidthMm(value: number): void;
+
+  getForceN(): number;
+  setForceN(value: number): void;
+
+  getObjectDetected(): boolean;
+  setObjectDetected(value: boolean): void;
+
+  getIsMoving(): boolean;
+  setIsMoving(value: boolean): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetStateResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetStateResponse): GetStateResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetStateResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetStateResponse;
+  static deserializeBinaryFromReader(message: GetStateResponse, reader: jspb.BinaryReader): GetStateResponse;
+}
+
+export namespace GetStateResponse {
+  export type AsObject = {
+    widthMm: number,
+    forceN: number,
+    objectDetected: boolean,
+    isMoving: boolean,
+  }
+}
+
+export class GetPropertiesRequest extends jspb.Message {
+  getName(): string;
+  setName(value: string): void;
+
+  hasExtra(): boolean;
+  clearExtra(): void;
+  getExtra(): google_protobuf_struct_pb.Struct | undefined;
+  setExtra(value?: google_protobuf_struct_pb.Struct): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetPropertiesRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: GetPropertiesRequest): GetPropertiesRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetPropertiesRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetPropertiesRequest;
+  static deserializeBinaryFromReader(message: GetPropertiesRequest, reader: jspb.BinaryReader): GetPropertiesRequest;
+}
+
+export namespace GetPropertiesRequest {
+  export type AsObject = {
+    name: string,
+    extra?: google_protobuf_struct_pb.Struct.AsObject,
+  }
+}
+
+export class GetPropertiesResponse extends jspb.Message {
+  getMinWidthMm(): number;
+  setMinWidthMm(value: number): void;
+
+  getMaxWidthMm(): number;
+  setMaxWidthMm(value: number): void;
+
+  getMinForceN(): number;
+  setMinForceN(value: number): void;
+
+  getMaxForceN(): number;
+  setMaxForceN(value: number): void;
+
+  getSupportsForceReporting(): boolean;
+  setSupportsForceReporting(value: boolean): void;
+
+  getSupportsObjectDetection(): boolean;
+  setSupportsObjectDetection(value: boolean): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetPropertiesResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetPropertiesResponse): GetPropertiesResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetPropertiesResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetPropertiesResponse;
+  static deserializeBinaryFromReader(message: GetPropertiesResponse, reader: jspb.BinaryReader): GetPropertiesResponse;
+}
+
+export namespace GetPropertiesResponse {
+  export type AsObject = {
+    minWidthMm: number,
+    maxWidthMm: number,
+    minForceN: number,
+    maxForceN: number,
+    supportsForceReporting: boolean,
+    supportsObjectDetection: boolean,
+  }
+}
+
 export class StopRequest extends jspb.Message {
   getName(): string;
   setName(value: string): void;
@@ -23,6 +23,33 @@ type GripperServiceGrab = {
   readonly responseType: typeof component_gripper_v1_gripper_pb.GrabResponse;
 };
 
+type GripperServiceMoveToWidth = {
+  readonly methodName: string;
+  readonly service: typeof GripperService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof component_gripper_v1_gripper_pb.MoveToWidthRequest;
+  readonly responseType: typeof component_gripper_v1_gripper_pb.MoveToWidthResponse;
+};
+
+type GripperServiceGetState = {
+  readonly methodName: string;
+  readonly service: typeof GripperService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof component_gripper_v1_gripper_pb.GetStateRequest;
+  readonly responseType: typeof component_gripper_v1_gripper_pb.GetStateResponse;
+};
+
+type GripperServiceGetProperties = {
+  readonly methodName: string;
+  readonly service: typeof GripperService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof component_gripper_v1_gripper_pb.GetPropertiesRequest;
+  readonly responseType: typeof component_gripper_v1_gripper_pb.GetPropertiesResponse;
+};
+
 type GripperServiceStop = {
   readonly methodName: string;
   readonly service: typeof GripperService;
@@ -63,6 +90,9 @@ export class GripperService {
   static readonly serviceName: string;
   static readonly Open: GripperServiceOpen;
   static readonly Grab: GripperServiceGrab;
+  static readonly MoveToWidth: GripperServiceMoveToWidth;
+  static readonly GetState: GripperServiceGetState;
+  static readonly GetProperties: GripperServiceGetProperties;
   static readonly Stop: GripperServiceStop;
   static readonly IsMoving: GripperServiceIsMoving;
   static readonly DoCommand: GripperServiceDoCommand;
@@ -119,6 +149,33 @@ export class GripperServiceClient {
     requestMessage: component_gripper_v1_gripper_pb.GrabRequest,
     callback: (error: ServiceError|null, responseMessage: component_gripper_v1_gripper_pb.GrabResponse|null) => void
   ): UnaryResponse;
+  moveToWidth(
+    requestMessage: component_gripper_v1_gripper_pb.MoveToWidthRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: component_gripper_v1_gripper_pb.MoveToWidthResponse|null) => void
+  ): UnaryResponse;
+  moveToWidth(
+    requestMessage: component_gripper_v1_gripper_pb.MoveToWidthRequest,
+    callback: (error: ServiceError|null, responseMessage: component_gripper_v1_gripper_pb.MoveToWidthResponse|null) => void
+  ): UnaryResponse;
+  getState(
+    requestMessage: component_gripper_v1_gripper_pb.GetStateRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: component_gripper_v1_gripper_pb.GetStateResponse|null) => void
+  ): UnaryResponse;
+  getState(
+    requestMessage: component_gripper_v1_gripper_pb.GetStateRequest,
+    callback: (error: ServiceError|null, responseMessage: component_gripper_v1_gripper_pb.GetStateResponse|null) => void
+  ): UnaryResponse;
+  getProperties(
+    requestMessage: component_gripper_v1_gripper_pb.GetPropertiesRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: component_gripper_v1_gripper_pb.GetPropertiesResponse|null) => void
+  ): UnaryResponse;
+  getProperties(
+    requestMessage: component_gripper_v1_gripper_pb.GetPropertiesRequest,
+    callback: (error: ServiceError|null, responseMessage: component_gripper_v1_gripper_pb.GetPropertiesResponse|null) => void
+  ): UnaryResponse;
   stop(
     requestMessage: component_gripper_v1_gripper_pb.StopRequest,
     metadata: grpc.Metadata,
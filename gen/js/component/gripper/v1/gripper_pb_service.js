@@ -29,6 +29,33 @@ GripperService.Grab = {
   responseType: component_gripper_v1_gripper_pb.GrabResponse
 };
 
+GripperService.MoveToWidth = {
+  methodName: "MoveToWidth",
+  service: GripperService,
+  requestStream: false,
+  responseStream: false,
+  requestType: component_gripper_v1_gripper_pb.MoveToWidthRequest,
+  responseType: component_gripper_v1_gripper_pb.MoveToWidthResponse
+};
+
+GripperService.GetState = {
+  methodName: "GetState",
+  service: GripperService,
+  requestStream: false,
+  responseStream: false,
+  requestType: component_gripper_v1_gripper_pb.GetStateRequest,
+  responseType: component_gripper_v1_gripper_pb.GetStateResponse
+};
+
+GripperService.GetProperties = {
+  methodName: "GetProperties",
+  service: GripperService,
+  requestStream: false,
+  responseStream: false,
+  requestType: component_gripper_v1_gripper_pb.GetPropertiesRequest,
+  responseType: component_gripper_v1_gripper_pb.GetPropertiesResponse
+};
+
 GripperService.Stop = {
   methodName: "Stop",
   service: GripperService,
@@ -134,6 +161,99 @@ GripperServiceClient.prototype.grab = function grab(requestMessage, metadata, ca
   };
 };
 
+GripperServiceClient.prototype.moveToWidth = function moveToWidth(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(GripperService.MoveToWidth, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+GripperServiceClient.prototype.getState = function getState(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(GripperService.GetState, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+GripperServiceClient.prototype.getProperties = function getProperties(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(GripperService.GetProperties, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
 GripperServiceClient.prototype.stop = function stop(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
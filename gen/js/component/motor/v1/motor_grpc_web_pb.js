@@ -25,6 +25,8 @@ var common_v1_common_pb = require('../../../common/v1/common_pb.js')
 var google_api_annotations_pb = require('../../../google/api/annotations_pb.js')
 
 var google_protobuf_struct_pb = require('google-protobuf/google/protobuf/struct_pb.js')
+
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js')
 const proto = {};
 proto.viam = {};
 proto.viam.component = {};
@@ -266,6 +268,128 @@ proto.viam.component.motor.v1.MotorServicePromiseClient.prototype.goTo =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.viam.component.motor.v1.SetRPMRequest,
+ *   !proto.viam.component.motor.v1.SetRPMResponse>}
+ */
+const methodDescriptor_MotorService_SetRPM = new grpc.web.MethodDescriptor(
+  '/viam.component.motor.v1.MotorService/SetRPM',
+  grpc.web.MethodType.UNARY,
+  proto.viam.component.motor.v1.SetRPMRequest,
+  proto.viam.component.motor.v1.SetRPMResponse,
+  /**
+   * @param {!proto.viam.component.motor.v1.SetRPMRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.viam.component.motor.v1.SetRPMResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.viam.component.motor.v1.SetRPMRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.viam.component.motor.v1.SetRPMResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.viam.component.motor.v1.SetRPMResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.viam.component.motor.v1.MotorServiceClient.prototype.setRPM =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/viam.component.motor.v1.MotorService/SetRPM',
+      request,
+      metadata || {},
+      methodDescriptor_MotorService_SetRPM,
+      callback);
+};
+
+
+/**
+ * @param {!proto.viam.component.motor.v1.SetRPMRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.viam.component.motor.v1.SetRPMResponse>}
+ *     Promise that resolves to the response
+ */
+proto.viam.component.motor.v1.MotorServicePromiseClient.prototype.setRPM =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/viam.component.motor.v1.MotorService/SetRPM',
+      request,
+      metadata || {},
+      methodDescriptor_MotorService_SetRPM);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.viam.component.motor.v1.SetTorqueRequest,
+ *   !proto.viam.component.motor.v1.SetTorqueResponse>}
+ */
+const methodDescriptor_MotorService_SetTorque = new grpc.web.MethodDescriptor(
+  '/viam.component.motor.v1.MotorService/SetTorque',
+  grpc.web.MethodType.UNARY,
+  proto.viam.component.motor.v1.SetTorqueRequest,
+  proto.viam.component.motor.v1.SetTorqueResponse,
+  /**
+   * @param {!proto.viam.component.motor.v1.SetTorqueRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.viam.component.motor.v1.SetTorqueResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.viam.component.motor.v1.SetTorqueRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.viam.component.motor.v1.SetTorqueResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.viam.component.motor.v1.SetTorqueResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.viam.component.motor.v1.MotorServiceClient.prototype.setTorque =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/viam.component.motor.v1.MotorService/SetTorque',
+      request,
+      metadata || {},
+      methodDescriptor_MotorService_SetTorque,
+      callback);
+};
+
+
+/**
+ * @param {!proto.viam.component.motor.v1.SetTorqueRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.viam.component.motor.v1.SetTorqueResponse>}
+ *     Promise that resolves to the response
+ */
+proto.viam.component.motor.v1.MotorServicePromiseClient.prototype.setTorque =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/viam.component.motor.v1.MotorService/SetTorque',
+      request,
+      metadata || {},
+      methodDescriptor_MotorService_SetTorque);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -571,6 +695,128 @@ proto.viam.component.motor.v1.MotorServicePromiseClient.prototype.isPowered =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.viam.component.motor.v1.GetTelemetryRequest,
+ *   !proto.viam.component.motor.v1.GetTelemetryResponse>}
+ */
+const methodDescriptor_MotorService_GetTelemetry = new grpc.web.MethodDescriptor(
+  '/viam.component.motor.v1.MotorService/GetTelemetry',
+  grpc.web.MethodType.UNARY,
+  proto.viam.component.motor.v1.GetTelemetryRequest,
+  proto.viam.component.motor.v1.GetTelemetryResponse,
+  /**
+   * @param {!proto.viam.component.motor.v1.GetTelemetryRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.viam.component.motor.v1.GetTelemetryResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.viam.component.motor.v1.GetTelemetryRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.viam.component.motor.v1.GetTelemetryResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.viam.component.motor.v1.GetTelemetryResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.viam.component.motor.v1.MotorServiceClient.prototype.getTelemetry =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/viam.component.motor.v1.MotorService/GetTelemetry',
+      request,
+      metadata || {},
+      methodDescriptor_MotorService_GetTelemetry,
+      callback);
+};
+
+
+/**
+ * @param {!proto.viam.component.motor.v1.GetTelemetryRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.viam.component.motor.v1.GetTelemetryResponse>}
+ *     Promise that resolves to the response
+ */
+proto.viam.component.motor.v1.MotorServicePromiseClient.prototype.getTelemetry =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/viam.component.motor.v1.MotorService/GetTelemetry',
+      request,
+      metadata || {},
+      methodDescriptor_MotorService_GetTelemetry);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.viam.component.motor.v1.ClearFaultsRequest,
+ *   !proto.viam.component.motor.v1.ClearFaultsResponse>}
+ */
+const methodDescriptor_MotorService_ClearFaults = new grpc.web.MethodDescriptor(
+  '/viam.component.motor.v1.MotorService/ClearFaults',
+  grpc.web.MethodType.UNARY,
+  proto.viam.component.motor.v1.ClearFaultsRequest,
+  proto.viam.component.motor.v1.ClearFaultsResponse,
+  /**
+   * @param {!proto.viam.component.motor.v1.ClearFaultsRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.viam.component.motor.v1.ClearFaultsResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.viam.component.motor.v1.ClearFaultsRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.viam.component.motor.v1.ClearFaultsResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.viam.component.motor.v1.ClearFaultsResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.viam.component.motor.v1.MotorServiceClient.prototype.clearFaults =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/viam.component.motor.v1.MotorService/ClearFaults',
+      request,
+      metadata || {},
+      methodDescriptor_MotorService_ClearFaults,
+      callback);
+};
+
+
+/**
+ * @param {!proto.viam.component.motor.v1.ClearFaultsRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.viam.component.motor.v1.ClearFaultsResponse>}
+ *     Promise that resolves to the response
+ */
+proto.viam.component.motor.v1.MotorServicePromiseClient.prototype.clearFaults =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/viam.component.motor.v1.MotorService/ClearFaults',
+      request,
+      metadata || {},
+      methodDescriptor_MotorService_ClearFaults);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -5,6 +5,7 @@ import * as jspb from "google-protobuf";
 import * as common_v1_common_pb from "../../../common/v1/common_pb";
 import * as google_api_annotations_pb from "../../../google/api/annotations_pb";
 import * as google_protobuf_struct_pb from "google-protobuf/google/protobuf/struct_pb";
+import * as google_protobuf_timestamp_pb from "google-protobuf/google/protobuf/timestamp_pb";
 
 export class SetPowerRequest extends jspb.Message {
   getName(): string;
@@ -152,6 +153,104 @@ export namespace GoToResponse {
   }
 }
 
+export class SetRPMRequest extends jspb.Message {
+  getName(): string;
+  setName(value: string): void;
+
+  getRpm(): number;
+  setRpm(value: number): void;
+
+  hasExtra(): boolean;
+  clearExtra(): void;
+  getExtra(): google_protobuf_struct_pb.Struct | undefined;
+  setExtra(value?: google_protobuf_struct_pb.Struct): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SetRPMRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: SetRPMRequest): SetRPMRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SetRPMRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetRPMRequest;
+  static deserializeBinaryFromReader(message: SetRPMRequest, reader: jspb.BinaryReader): SetRPMRequest;
+}
+
+export namespace SetRPMRequest {
+  export type AsObject = {
+    name: string,
+    rpm: number,
+    extra?: google_protobuf_struct_pb.Struct.AsObject,
+  }
+}
+
+export class SetRPMResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SetRPMResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: SetRPMResponse): SetRPMResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SetRPMResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetRPMResponse;
+  static deserializeBinaryFromReader(message: SetRPMResponse, reader: jspb.BinaryReader): SetRPMResponse;
+}
+
+export namespace SetRPMResponse {
+  export type AsObject = {
+  }
+}
+
+export class SetTorqueRequest extends jspb.Message {
+  getName(): string;
+  setName(value: string): void;
+
+  getTorqueNm(): number;
+  setTorqueNm(value: number): void;
+
+  hasMaxRpm(): boolean;
+  clearMaxRpm(): void;
+  getMaxRpm(): number;
+  setMaxRpm(value: number): void;
+
+  hasExtra(): boolean;
+  clearExtra(): void;
+  getExtra(): google_protobuf_struct_pb.Struct | undefined;
+  setExtra(value?: google_protobuf_struct_pb.Struct): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SetTorqueRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: SetTorqueRequest): SetTorqueRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SetTorqueRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetTorqueRequest;
+  static deserializeBinaryFromReader(message: SetTorqueRequest, reader: jspb.BinaryReader): SetTorqueRequest;
+}
+
+export namespace SetTorqueRequest {
+  export type AsObject = {
+    name: string,
+    torqueNm: number,
+    maxRpm: number,
+    extra?: google_protobuf_struct_pb.Struct.AsObject,
+  }
+}
+
+export class SetTorqueResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SetTorqueResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: SetTorqueResponse): SetTorqueResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SetTorqueResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetTorqueResponse;
+  static deserializeBinaryFromReader(message: SetTorqueResponse, reader: jspb.BinaryReader): SetTorqueResponse;
+}
+
+export namespace SetTorqueResponse {
+  export type AsObject = {
+  }
+}
+
 export class ResetZeroPositionRequest extends jspb.Message {
   getName(): string;
   setName(value: string): void;
@@ -336,6 +435,162 @@ export namespace IsPoweredResponse {
   }
 }
 
+export class GetTelemetryRequest extends jspb.Message {
+  getName(): string;
+  setName(value: string): void;
+
+  hasExtra(): boolean;
+  clearExtra(): void;
+  getExtra(): google_protobuf_struct_pb.Struct | undefined;
+  setExtra(value?: google_protobuf_struct_pb.Struct): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetTelemetryRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: GetTelemetryRequest): GetTelemetryRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetTelemetryRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetTelemetryRequest;
+  static deserializeBinaryFromReader(message: GetTelemetryRequest, reader: jspb.BinaryReader): GetTelemetryRequest;
+}
+
+export namespace GetTelemetryRequest {
+  export type AsObject = {
+    name: string,
+    extra?: google_protobuf_struct_pb.Struct.AsObject,
+  }
+}
+
+export class GetTelemetryResponse extends jspb.Message {
+  hasCurrentA(): boolean;
+  clearCurrentA(): void;
+  getCurrentA(): number;
+  setCurrentA(value: number): void;
+
+  hasBusVoltageV(): boolean;
+  clearBusVoltageV(): void;
+  getBusVoltageV(): number;
+  setBusVoltageV(value: number): void;
+
+  hasWindingTemperatureCelsius(): boolean;
+  clearWindingTemperatureCelsius(): void;
+  getWindingTemperatureCelsius(): number;
+  setWindingTemperatureCelsius(value: number): void;
+
+  hasDriverTemperatureCelsius(): boolean;
+  clearDriverTemperatureCelsius(): void;
+  getDriverTemperatureCelsius(): number;
+  setDriverTemperatureCelsius(value: number): void;
+
+  hasRpm(): boolean;
+  clearRpm(): void;
+  getRpm(): number;
+  setRpm(value: number): void;
+
+  clearFaultsList(): void;
+  getFaultsList(): Array<MotorFault>;
+  setFaultsList(value: Array<MotorFault>): void;
+  addFaults(value?: MotorFault, index?: number): MotorFault;
+
+  hasMeasuredAt(): boolean;
+  clearMeasuredAt(): void;
+  getMeasuredAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setMeasuredAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetTelemetryResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetTelemetryResponse): GetTelemetryResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetTelemetryResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetTelemetryResponse;
+  static deserializeBinaryFromReader(message: GetTelemetryResponse, reader: jspb.BinaryReader): GetTelemetryResponse;
+}
+
+export namespace GetTelemetryResponse {
+  export type AsObject = {
+    currentA: number,
+    busVoltageV: number,
+    windingTemperatureCelsius: number,
+    driverTemperatureCelsius: number,
+    rpm: number,
+    faultsList: Array<MotorFault.AsObject>,
+    measuredAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+  }
+}
+
+export class MotorFault extends jspb.Message {
+  getCode(): MotorFaultCodeMap[keyof MotorFaultCodeMap];
+  setCode(value: MotorFaultCodeMap[keyof MotorFaultCodeMap]): void;
+
+  getDescription(): string;
+  setDescription(value: string): void;
+
+  hasDetectedAt(): boolean;
+  clearDetectedAt(): void;
+  getDetectedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setDetectedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): MotorFault.AsObject;
+  static toObject(includeInstance: boolean, msg: MotorFault): MotorFault.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: MotorFault, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): MotorFault;
+  static deserializeBinaryFromReader(message: MotorFault, reader: jspb.BinaryReader): MotorFault;
+}
+
+export namespace MotorFault {
+  export type AsObject = {
+    code: MotorFaultCodeMap[keyof MotorFaultCodeMap],
+    description: string,
+    detectedAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+  }
+}
+
+export class ClearFaultsRequest extends jspb.Message {
+  getName(): string;
+  setName(value: string): void;
+
+  hasExtra(): boolean;
+  clearExtra(): void;
+  getExtra(): google_protobuf_struct_pb.Struct | undefined;
+  setExtra(value?: google_protobuf_struct_pb.Struct): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ClearFaultsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ClearFaultsRequest): ClearFaultsRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ClearFaultsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ClearFaultsRequest;
+  static deserializeBinaryFromReader(message: ClearFaultsRequest, reader: jspb.BinaryReader): ClearFaultsRequest;
+}
+
+export namespace ClearFaultsRequest {
+  export type AsObject = {
+    name: string,
+    extra?: google_protobuf_struct_pb.Struct.AsObject,
+  }
+}
+
+export class ClearFaultsResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ClearFaultsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ClearFaultsResponse): ClearFaultsResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ClearFaultsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ClearFaultsResponse;
+  static deserializeBinaryFromReader(message: ClearFaultsResponse, reader: jspb.BinaryReader): ClearFaultsResponse;
+}
+
+export namespace ClearFaultsResponse {
+  export type AsObject = {
+  }
+}
+
 export class GetPropertiesRequest extends jspb.Message {
   getName(): string;
   setName(value: string): void;
@@ -366,6 +621,22 @@ export class GetPropertiesResponse extends jspb.Message {
   getPositionReporting(): boolean;
   setPositionReporting(value: boolean): void;
 
+  getVelocityControl(): boolean;
+  setVelocityControl(value: boolean): void;
+
+  getTorqueControl(): boolean;
+  setTorqueControl(value: boolean): void;
+
+  hasMaxRpm(): boolean;
+  clearMaxRpm(): void;
+  getMaxRpm(): number;
+  setMaxRpm(value: number): void;
+
+  hasTicksPerRotation(): boolean;
+  clearTicksPerRotation(): void;
+  getTicksPerRotation(): number;
+  setTicksPerRotation(value: number): void;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): GetPropertiesResponse.AsObject;
   static toObject(includeInstance: boolean, msg: GetPropertiesResponse): GetPropertiesResponse.AsObject;
@@ -379,6 +650,10 @@ export class GetPropertiesResponse extends jspb.Message {
 export namespace GetPropertiesResponse {
   export type AsObject = {
     positionReporting: boolean,
+    velocityControl: boolean,
+    torqueControl: boolean,
+    maxRpm: number,
+    ticksPerRotation: number,
   }
 }
 
@@ -450,3 +725,16 @@ export namespace IsMovingResponse {
   }
 }
 
+export interface MotorFaultCodeMap {
+  MOTOR_FAULT_CODE_UNSPECIFIED: 0;
+  MOTOR_FAULT_CODE_OVER_CURRENT: 1;
+  MOTOR_FAULT_CODE_OVER_TEMPERATURE: 2;
+  MOTOR_FAULT_CODE_UNDER_VOLTAGE: 3;
+  MOTOR_FAULT_CODE_OVER_VOLTAGE: 4;
+  MOTOR_FAULT_CODE_STALL: 5;
+  MOTOR_FAULT_CODE_ENCODER: 6;
+  MOTOR_FAULT_CODE_DRIVER: 7;
+}
+
+export const MotorFaultCode: MotorFaultCodeMap;
+
@@ -21,10 +21,16 @@ var google_api_annotations_pb = require('../../../google/api/annotations_pb.js')
 goog.object.extend(proto, google_api_annotations_pb);
 var google_protobuf_struct_pb = require('google-protobuf/google/protobuf/struct_pb.js');
 goog.object.extend(proto, google_protobuf_struct_pb);
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
+goog.object.extend(proto, google_protobuf_timestamp_pb);
+goog.exportSymbol('proto.viam.component.motor.v1.ClearFaultsRequest', null, global);
+goog.exportSymbol('proto.viam.component.motor.v1.ClearFaultsResponse', null, global);
 goog.exportSymbol('proto.viam.component.motor.v1.GetPositionRequest', null, global);
 goog.exportSymbol('proto.viam.component.motor.v1.GetPositionResponse', null, global);
 goog.exportSymbol('proto.viam.component.motor.v1.GetPropertiesRequest', null, global);
 goog.exportSymbol('proto.viam.component.motor.v1.GetPropertiesResponse', null, global);
+goog.exportSymbol('proto.viam.component.motor.v1.GetTelemetryRequest', null, global);
+goog.exportSymbol('proto.viam.component.motor.v1.GetTelemetryResponse', null, global);
 goog.exportSymbol('proto.viam.component.motor.v1.GoForRequest', null, global);
 goog.exportSymbol('proto.viam.component.motor.v1.GoForResponse', null, global);
 goog.exportSymbol('proto.viam.component.motor.v1.GoToRequest', null, global);
@@ -33,10 +39,16 @@ goog.exportSymbol('proto.viam.component.motor.v1.IsMovingRequest', null, global)
 goog.exportSymbol('proto.viam.component.motor.v1.IsMovingResponse', null, global);
 goog.exportSymbol('proto.viam.component.motor.v1.IsPoweredRequest', null, global);
 goog.exportSymbol('proto.viam.component.motor.v1.IsPoweredResponse', null, global);
+goog.exportSymbol('proto.viam.component.motor.v1.MotorFault', null, global);
+goog.exportSymbol('proto.viam.component.motor.v1.MotorFaultCode', null, global);
 goog.exportSymbol('proto.viam.component.motor.v1.ResetZeroPositionRequest', null, global);
 goog.exportSymbol('proto.viam.component.motor.v1.ResetZeroPositionResponse', null, global);
 goog.exportSymbol('proto.viam.component.motor.v1.SetPowerRequest', null, global);
 goog.exportSymbol('proto.viam.component.motor.v1.SetPowerResponse', null, global);
+goog.exportSymbol('proto.viam.component.motor.v1.SetRPMRequest', null, global);
+goog.exportSymbol('proto.viam.component.motor.v1.SetRPMResponse', null, global);
+goog.exportSymbol('proto.viam.component.motor.v1.SetTorqueRequest', null, global);
+goog.exportSymbol('proto.viam.component.motor.v1.SetTorqueResponse', null, global);
 goog.exportSymbol('proto.viam.component.motor.v1.Status', null, global);
 goog.exportSymbol('proto.viam.component.motor.v1.StopRequest', null, global);
 goog.exportSymbol('proto.viam.component.motor.v1.StopResponse', null, global);
@@ -166,6 +178,90 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.viam.component.motor.v1.GoToResponse.displayName = 'proto.viam.component.motor.v1.GoToResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.motor.v1.SetRPMRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.component.motor.v1.SetRPMRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.motor.v1.SetRPMRequest.displayName = 'proto.viam.component.motor.v1.SetRPMRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.motor.v1.SetRPMResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.component.motor.v1.SetRPMResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.motor.v1.SetRPMResponse.displayName = 'proto.viam.component.motor.v1.SetRPMResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.motor.v1.SetTorqueRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.component.motor.v1.SetTorqueRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.motor.v1.SetTorqueRequest.displayName = 'proto.viam.component.motor.v1.SetTorqueRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.motor.v1.SetTorqueResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.component.motor.v1.SetTorqueResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.motor.v1.SetTorqueResponse.displayName = 'proto.viam.component.motor.v1.SetTorqueResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -334,6 +430,111 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.viam.component.motor.v1.IsPoweredResponse.displayName = 'proto.viam.component.motor.v1.IsPoweredResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.motor.v1.GetTelemetryRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.component.motor.v1.GetTelemetryRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.motor.v1.GetTelemetryRequest.displayName = 'proto.viam.component.motor.v1.GetTelemetryRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.motor.v1.GetTelemetryResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.viam.component.motor.v1.GetTelemetryResponse.repeatedFields_, null);
+};
+goog.inherits(proto.viam.component.motor.v1.GetTelemetryResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.motor.v1.GetTelemetryResponse.displayName = 'proto.viam.component.motor.v1.GetTelemetryResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.motor.v1.MotorFault = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.component.motor.v1.MotorFault, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.motor.v1.MotorFault.displayName = 'proto.viam.component.motor.v1.MotorFault';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.motor.v1.ClearFaultsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.component.motor.v1.ClearFaultsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.motor.v1.ClearFaultsRequest.displayName = 'proto.viam.component.motor.v1.ClearFaultsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.component.motor.v1.ClearFaultsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.component.motor.v1.ClearFaultsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.component.motor.v1.ClearFaultsResponse.displayName = 'proto.viam.component.motor.v1.ClearFaultsResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -1451,8 +1652,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.motor.v1.ResetZeroPositionRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.motor.v1.ResetZeroPositionRequest.toObject(opt_includeInstance, this);
+proto.viam.component.motor.v1.SetRPMRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.motor.v1.SetRPMRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -1461,14 +1662,14 @@ proto.viam.component.motor.v1.ResetZeroPositionRequest.prototype.toObject = func
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.motor.v1.ResetZeroPositionRequest} msg The msg instance to transform.
+ * @param {!proto.viam.component.motor.v1.SetRPMRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.motor.v1.ResetZeroPositionRequest.toObject = function(includeInstance, msg) {
+proto.viam.component.motor.v1.SetRPMRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    offset: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0),
+    rpm: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0),
     extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
   };
 
@@ -1483,23 +1684,23 @@ proto.viam.component.motor.v1.ResetZeroPositionRequest.toObject = function(inclu
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.motor.v1.ResetZeroPositionRequest}
+ * @return {!proto.viam.component.motor.v1.SetRPMRequest}
  */
-proto.viam.component.motor.v1.ResetZeroPositionRequest.deserializeBinary = function(bytes) {
+proto.viam.component.motor.v1.SetRPMRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.motor.v1.ResetZeroPositionRequest;
-  return proto.viam.component.motor.v1.ResetZeroPositionRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.motor.v1.SetRPMRequest;
+  return proto.viam.component.motor.v1.SetRPMRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.motor.v1.ResetZeroPositionRequest} msg The message object to deserialize into.
+ * @param {!proto.viam.component.motor.v1.SetRPMRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.motor.v1.ResetZeroPositionRequest}
+ * @return {!proto.viam.component.motor.v1.SetRPMRequest}
  */
-proto.viam.component.motor.v1.ResetZeroPositionRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.motor.v1.SetRPMRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1512,7 +1713,7 @@ proto.viam.component.motor.v1.ResetZeroPositionRequest.deserializeBinaryFromRead
       break;
     case 2:
       var value = /** @type {number} */ (reader.readDouble());
-      msg.setOffset(value);
+      msg.setRpm(value);
       break;
     case 99:
       var value = new google_protobuf_struct_pb.Struct;
@@ -1532,9 +1733,9 @@ proto.viam.component.motor.v1.ResetZeroPositionRequest.deserializeBinaryFromRead
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.motor.v1.ResetZeroPositionRequest.prototype.serializeBinary = function() {
+proto.viam.component.motor.v1.SetRPMRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.motor.v1.ResetZeroPositionRequest.serializeBinaryToWriter(this, writer);
+  proto.viam.component.motor.v1.SetRPMRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1542,11 +1743,11 @@ proto.viam.component.motor.v1.ResetZeroPositionRequest.prototype.serializeBinary
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.motor.v1.ResetZeroPositionRequest} message
+ * @param {!proto.viam.component.motor.v1.SetRPMRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.motor.v1.ResetZeroPositionRequest.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.motor.v1.SetRPMRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getName();
   if (f.length > 0) {
@@ -1555,7 +1756,7 @@ proto.viam.component.motor.v1.ResetZeroPositionRequest.serializeBinaryToWriter =
       f
     );
   }
-  f = message.getOffset();
+  f = message.getRpm();
   if (f !== 0.0) {
     writer.writeDouble(
       2,
@@ -1577,34 +1778,34 @@ proto.viam.component.motor.v1.ResetZeroPositionRequest.serializeBinaryToWriter =
  * optional string name = 1;
  * @return {string}
  */
-proto.viam.component.motor.v1.ResetZeroPositionRequest.prototype.getName = function() {
+proto.viam.component.motor.v1.SetRPMRequest.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.viam.component.motor.v1.ResetZeroPositionRequest} returns this
+ * @return {!proto.viam.component.motor.v1.SetRPMRequest} returns this
  */
-proto.viam.component.motor.v1.ResetZeroPositionRequest.prototype.setName = function(value) {
+proto.viam.component.motor.v1.SetRPMRequest.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional double offset = 2;
+ * optional double rpm = 2;
  * @return {number}
  */
-proto.viam.component.motor.v1.ResetZeroPositionRequest.prototype.getOffset = function() {
+proto.viam.component.motor.v1.SetRPMRequest.prototype.getRpm = function() {
   return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.viam.component.motor.v1.ResetZeroPositionRequest} returns this
+ * @return {!proto.viam.component.motor.v1.SetRPMRequest} returns this
  */
-proto.viam.component.motor.v1.ResetZeroPositionRequest.prototype.setOffset = function(value) {
+proto.viam.component.motor.v1.SetRPMRequest.prototype.setRpm = function(value) {
   return jspb.Message.setProto3FloatField(this, 2, value);
 };
 
@@ -1613,7 +1814,7 @@ proto.viam.component.motor.v1.ResetZeroPositionRequest.prototype.setOffset = fun
  * optional google.protobuf.Struct extra = 99;
  * @return {?proto.google.protobuf.Struct}
  */
-proto.viam.component.motor.v1.ResetZeroPositionRequest.prototype.getExtra = function() {
+proto.viam.component.motor.v1.SetRPMRequest.prototype.getExtra = function() {
   return /** @type{?proto.google.protobuf.Struct} */ (
     jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
 };
@@ -1621,18 +1822,18 @@ proto.viam.component.motor.v1.ResetZeroPositionRequest.prototype.getExtra = func
 
 /**
  * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.viam.component.motor.v1.ResetZeroPositionRequest} returns this
+ * @return {!proto.viam.component.motor.v1.SetRPMRequest} returns this
 */
-proto.viam.component.motor.v1.ResetZeroPositionRequest.prototype.setExtra = function(value) {
+proto.viam.component.motor.v1.SetRPMRequest.prototype.setExtra = function(value) {
   return jspb.Message.setWrapperField(this, 99, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.viam.component.motor.v1.ResetZeroPositionRequest} returns this
+ * @return {!proto.viam.component.motor.v1.SetRPMRequest} returns this
  */
-proto.viam.component.motor.v1.ResetZeroPositionRequest.prototype.clearExtra = function() {
+proto.viam.component.motor.v1.SetRPMRequest.prototype.clearExtra = function() {
   return this.setExtra(undefined);
 };
 
@@ -1641,7 +1842,7 @@ proto.viam.component.motor.v1.ResetZeroPositionRequest.prototype.clearExtra = fu
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.component.motor.v1.ResetZeroPositionRequest.prototype.hasExtra = function() {
+proto.viam.component.motor.v1.SetRPMRequest.prototype.hasExtra = function() {
   return jspb.Message.getField(this, 99) != null;
 };
 
@@ -1662,8 +1863,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.motor.v1.ResetZeroPositionResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.motor.v1.ResetZeroPositionResponse.toObject(opt_includeInstance, this);
+proto.viam.component.motor.v1.SetRPMResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.motor.v1.SetRPMResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -1672,11 +1873,11 @@ proto.viam.component.motor.v1.ResetZeroPositionResponse.prototype.toObject = fun
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.motor.v1.ResetZeroPositionResponse} msg The msg instance to transform.
+ * @param {!proto.viam.component.motor.v1.SetRPMResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.motor.v1.ResetZeroPositionResponse.toObject = function(includeInstance, msg) {
+proto.viam.component.motor.v1.SetRPMResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
 
   };
@@ -1692,23 +1893,23 @@ proto.viam.component.motor.v1.ResetZeroPositionResponse.toObject = function(incl
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.motor.v1.ResetZeroPositionResponse}
+ * @return {!proto.viam.component.motor.v1.SetRPMResponse}
  */
-proto.viam.component.motor.v1.ResetZeroPositionResponse.deserializeBinary = function(bytes) {
+proto.viam.component.motor.v1.SetRPMResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.motor.v1.ResetZeroPositionResponse;
-  return proto.viam.component.motor.v1.ResetZeroPositionResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.motor.v1.SetRPMResponse;
+  return proto.viam.component.motor.v1.SetRPMResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.motor.v1.ResetZeroPositionResponse} msg The message object to deserialize into.
+ * @param {!proto.viam.component.motor.v1.SetRPMResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.motor.v1.ResetZeroPositionResponse}
+ * @return {!proto.viam.component.motor.v1.SetRPMResponse}
  */
-proto.viam.component.motor.v1.ResetZeroPositionResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.motor.v1.SetRPMResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1728,9 +1929,9 @@ proto.viam.component.motor.v1.ResetZeroPositionResponse.deserializeBinaryFromRea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.motor.v1.ResetZeroPositionResponse.prototype.serializeBinary = function() {
+proto.viam.component.motor.v1.SetRPMResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.motor.v1.ResetZeroPositionResponse.serializeBinaryToWriter(this, writer);
+  proto.viam.component.motor.v1.SetRPMResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1738,11 +1939,11 @@ proto.viam.component.motor.v1.ResetZeroPositionResponse.prototype.serializeBinar
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.motor.v1.ResetZeroPositionResponse} message
+ * @param {!proto.viam.component.motor.v1.SetRPMResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.motor.v1.ResetZeroPositionResponse.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.motor.v1.SetRPMResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
 };
 
@@ -1763,8 +1964,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.motor.v1.GetPositionRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.motor.v1.GetPositionRequest.toObject(opt_includeInstance, this);
+proto.viam.component.motor.v1.SetTorqueRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.motor.v1.SetTorqueRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -1773,13 +1974,15 @@ proto.viam.component.motor.v1.GetPositionRequest.prototype.toObject = function(o
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.motor.v1.GetPositionRequest} msg The msg instance to transform.
+ * @param {!proto.viam.component.motor.v1.SetTorqueRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.motor.v1.GetPositionRequest.toObject = function(includeInstance, msg) {
+proto.viam.component.motor.v1.SetTorqueRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    torqueNm: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0),
+    maxRpm: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0),
     extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
   };
 
@@ -1794,23 +1997,23 @@ proto.viam.component.motor.v1.GetPositionRequest.toObject = function(includeInst
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.motor.v1.GetPositionRequest}
+ * @return {!proto.viam.component.motor.v1.SetTorqueRequest}
  */
-proto.viam.component.motor.v1.GetPositionRequest.deserializeBinary = function(bytes) {
+proto.viam.component.motor.v1.SetTorqueRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.motor.v1.GetPositionRequest;
-  return proto.viam.component.motor.v1.GetPositionRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.motor.v1.SetTorqueRequest;
+  return proto.viam.component.motor.v1.SetTorqueRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.motor.v1.GetPositionRequest} msg The message object to deserialize into.
+ * @param {!proto.viam.component.motor.v1.SetTorqueRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.motor.v1.GetPositionRequest}
+ * @return {!proto.viam.component.motor.v1.SetTorqueRequest}
  */
-proto.viam.component.motor.v1.GetPositionRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.motor.v1.SetTorqueRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1821,6 +2024,14 @@ proto.viam.component.motor.v1.GetPositionRequest.deserializeBinaryFromReader = f
       var value = /** @type {string} */ (reader.readString());
       msg.setName(value);
       break;
+    case 2:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setTorqueNm(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setMaxRpm(value);
+      break;
     case 99:
       var value = new google_protobuf_struct_pb.Struct;
       reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
@@ -1839,9 +2050,9 @@ proto.viam.component.motor.v1.GetPositionRequest.deserializeBinaryFromReader = f
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.motor.v1.GetPositionRequest.prototype.serializeBinary = function() {
+proto.viam.component.motor.v1.SetTorqueRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.motor.v1.GetPositionRequest.serializeBinaryToWriter(this, writer);
+  proto.viam.component.motor.v1.SetTorqueRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1849,11 +2060,11 @@ proto.viam.component.motor.v1.GetPositionRequest.prototype.serializeBinary = fun
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.motor.v1.GetPositionRequest} message
+ * @param {!proto.viam.component.motor.v1.SetTorqueRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.motor.v1.GetPositionRequest.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.motor.v1.SetTorqueRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getName();
   if (f.length > 0) {
@@ -1862,6 +2073,20 @@ proto.viam.component.motor.v1.GetPositionRequest.serializeBinaryToWriter = funct
       f
     );
   }
+  f = message.getTorqueNm();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      2,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeDouble(
+      3,
+      f
+    );
+  }
   f = message.getExtra();
   if (f != null) {
     writer.writeMessage(
@@ -1877,44 +2102,98 @@ proto.viam.component.motor.v1.GetPositionRequest.serializeBinaryToWriter = funct
  * optional string name = 1;
  * @return {string}
  */
-proto.viam.component.motor.v1.GetPositionRequest.prototype.getName = function() {
+proto.viam.component.motor.v1.SetTorqueRequest.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.viam.component.motor.v1.GetPositionRequest} returns this
+ * @return {!proto.viam.component.motor.v1.SetTorqueRequest} returns this
  */
-proto.viam.component.motor.v1.GetPositionRequest.prototype.setName = function(value) {
+proto.viam.component.motor.v1.SetTorqueRequest.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional google.protobuf.Struct extra = 99;
- * @return {?proto.google.protobuf.Struct}
+ * optional double torque_nm = 2;
+ * @return {number}
  */
-proto.viam.component.motor.v1.GetPositionRequest.prototype.getExtra = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+proto.viam.component.motor.v1.SetTorqueRequest.prototype.getTorqueNm = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
 };
 
 
 /**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.viam.component.motor.v1.GetPositionRequest} returns this
+ * @param {number} value
+ * @return {!proto.viam.component.motor.v1.SetTorqueRequest} returns this
+ */
+proto.viam.component.motor.v1.SetTorqueRequest.prototype.setTorqueNm = function(value) {
+  return jspb.Message.setProto3FloatField(this, 2, value);
+};
+
+
+/**
+ * optional double max_rpm = 3;
+ * @return {number}
+ */
+proto.viam.component.motor.v1.SetTorqueRequest.prototype.getMaxRpm = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 3, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.motor.v1.SetTorqueRequest} returns this
+ */
+proto.viam.component.motor.v1.SetTorqueRequest.prototype.setMaxRpm = function(value) {
+  return jspb.Message.setField(this, 3, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.viam.component.motor.v1.SetTorqueRequest} returns this
+ */
+proto.viam.component.motor.v1.SetTorqueRequest.prototype.clearMaxRpm = function() {
+  return jspb.Message.setField(this, 3, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.motor.v1.SetTorqueRequest.prototype.hasMaxRpm = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.component.motor.v1.SetTorqueRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.component.motor.v1.SetTorqueRequest} returns this
 */
-proto.viam.component.motor.v1.GetPositionRequest.prototype.setExtra = function(value) {
+proto.viam.component.motor.v1.SetTorqueRequest.prototype.setExtra = function(value) {
   return jspb.Message.setWrapperField(this, 99, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.viam.component.motor.v1.GetPositionRequest} returns this
+ * @return {!proto.viam.component.motor.v1.SetTorqueRequest} returns this
  */
-proto.viam.component.motor.v1.GetPositionRequest.prototype.clearExtra = function() {
+proto.viam.component.motor.v1.SetTorqueRequest.prototype.clearExtra = function() {
   return this.setExtra(undefined);
 };
 
@@ -1923,7 +2202,7 @@ proto.viam.component.motor.v1.GetPositionRequest.prototype.clearExtra = function
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.component.motor.v1.GetPositionRequest.prototype.hasExtra = function() {
+proto.viam.component.motor.v1.SetTorqueRequest.prototype.hasExtra = function() {
   return jspb.Message.getField(this, 99) != null;
 };
 
@@ -1944,8 +2223,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.motor.v1.GetPositionResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.motor.v1.GetPositionResponse.toObject(opt_includeInstance, this);
+proto.viam.component.motor.v1.SetTorqueResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.motor.v1.SetTorqueResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -1954,13 +2233,13 @@ proto.viam.component.motor.v1.GetPositionResponse.prototype.toObject = function(
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.motor.v1.GetPositionResponse} msg The msg instance to transform.
+ * @param {!proto.viam.component.motor.v1.SetTorqueResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.motor.v1.GetPositionResponse.toObject = function(includeInstance, msg) {
+proto.viam.component.motor.v1.SetTorqueResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    position: jspb.Message.getFloatingPointFieldWithDefault(msg, 1, 0.0)
+
   };
 
   if (includeInstance) {
@@ -1974,33 +2253,29 @@ proto.viam.component.motor.v1.GetPositionResponse.toObject = function(includeIns
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.motor.v1.GetPositionResponse}
+ * @return {!proto.viam.component.motor.v1.SetTorqueResponse}
  */
-proto.viam.component.motor.v1.GetPositionResponse.deserializeBinary = function(bytes) {
+proto.viam.component.motor.v1.SetTorqueResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.motor.v1.GetPositionResponse;
-  return proto.viam.component.motor.v1.GetPositionResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.motor.v1.SetTorqueResponse;
+  return proto.viam.component.motor.v1.SetTorqueResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.motor.v1.GetPositionResponse} msg The message object to deserialize into.
+ * @param {!proto.viam.component.motor.v1.SetTorqueResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.motor.v1.GetPositionResponse}
+ * @return {!proto.viam.component.motor.v1.SetTorqueResponse}
  */
-proto.viam.component.motor.v1.GetPositionResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.motor.v1.SetTorqueResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 1:
-      var value = /** @type {number} */ (reader.readDouble());
-      msg.setPosition(value);
-      break;
     default:
       reader.skipField();
       break;
@@ -2014,9 +2289,9 @@ proto.viam.component.motor.v1.GetPositionResponse.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.motor.v1.GetPositionResponse.prototype.serializeBinary = function() {
+proto.viam.component.motor.v1.SetTorqueResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.motor.v1.GetPositionResponse.serializeBinaryToWriter(this, writer);
+  proto.viam.component.motor.v1.SetTorqueResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2024,37 +2299,12 @@ proto.viam.component.motor.v1.GetPositionResponse.prototype.serializeBinary = fu
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.motor.v1.GetPositionResponse} message
+ * @param {!proto.viam.component.motor.v1.SetTorqueResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.motor.v1.GetPositionResponse.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.motor.v1.SetTorqueResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPosition();
-  if (f !== 0.0) {
-    writer.writeDouble(
-      1,
-      f
-    );
-  }
-};
-
-
-/**
- * optional double position = 1;
- * @return {number}
- */
-proto.viam.component.motor.v1.GetPositionResponse.prototype.getPosition = function() {
-  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 1, 0.0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.viam.component.motor.v1.GetPositionResponse} returns this
- */
-proto.viam.component.motor.v1.GetPositionResponse.prototype.setPosition = function(value) {
-  return jspb.Message.setProto3FloatField(this, 1, value);
 };
 
 
@@ -2074,8 +2324,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.motor.v1.StopRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.motor.v1.StopRequest.toObject(opt_includeInstance, this);
+proto.viam.component.motor.v1.ResetZeroPositionRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.motor.v1.ResetZeroPositionRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -2084,13 +2334,14 @@ proto.viam.component.motor.v1.StopRequest.prototype.toObject = function(opt_incl
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.motor.v1.StopRequest} msg The msg instance to transform.
+ * @param {!proto.viam.component.motor.v1.ResetZeroPositionRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.motor.v1.StopRequest.toObject = function(includeInstance, msg) {
+proto.viam.component.motor.v1.ResetZeroPositionRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    offset: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0),
     extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
   };
 
@@ -2105,23 +2356,23 @@ proto.viam.component.motor.v1.StopRequest.toObject = function(includeInstance, m
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.motor.v1.StopRequest}
+ * @return {!proto.viam.component.motor.v1.ResetZeroPositionRequest}
  */
-proto.viam.component.motor.v1.StopRequest.deserializeBinary = function(bytes) {
+proto.viam.component.motor.v1.ResetZeroPositionRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.motor.v1.StopRequest;
-  return proto.viam.component.motor.v1.StopRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.motor.v1.ResetZeroPositionRequest;
+  return proto.viam.component.motor.v1.ResetZeroPositionRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.motor.v1.StopRequest} msg The message object to deserialize into.
+ * @param {!proto.viam.component.motor.v1.ResetZeroPositionRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.motor.v1.StopRequest}
+ * @return {!proto.viam.component.motor.v1.ResetZeroPositionRequest}
  */
-proto.viam.component.motor.v1.StopRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.motor.v1.ResetZeroPositionRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2132,6 +2383,10 @@ proto.viam.component.motor.v1.StopRequest.deserializeBinaryFromReader = function
       var value = /** @type {string} */ (reader.readString());
       msg.setName(value);
       break;
+    case 2:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setOffset(value);
+      break;
     case 99:
       var value = new google_protobuf_struct_pb.Struct;
       reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
@@ -2150,9 +2405,9 @@ proto.viam.component.motor.v1.StopRequest.deserializeBinaryFromReader = function
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.motor.v1.StopRequest.prototype.serializeBinary = function() {
+proto.viam.component.motor.v1.ResetZeroPositionRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.motor.v1.StopRequest.serializeBinaryToWriter(this, writer);
+  proto.viam.component.motor.v1.ResetZeroPositionRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2160,11 +2415,11 @@ proto.viam.component.motor.v1.StopRequest.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.motor.v1.StopRequest} message
+ * @param {!proto.viam.component.motor.v1.ResetZeroPositionRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.motor.v1.StopRequest.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.motor.v1.ResetZeroPositionRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getName();
   if (f.length > 0) {
@@ -2173,6 +2428,13 @@ proto.viam.component.motor.v1.StopRequest.serializeBinaryToWriter = function(mes
       f
     );
   }
+  f = message.getOffset();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      2,
+      f
+    );
+  }
   f = message.getExtra();
   if (f != null) {
     writer.writeMessage(
@@ -2188,25 +2450,43 @@ proto.viam.component.motor.v1.StopRequest.serializeBinaryToWriter = function(mes
  * optional string name = 1;
  * @return {string}
  */
-proto.viam.component.motor.v1.StopRequest.prototype.getName = function() {
+proto.viam.component.motor.v1.ResetZeroPositionRequest.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.viam.component.motor.v1.StopRequest} returns this
+ * @return {!proto.viam.component.motor.v1.ResetZeroPositionRequest} returns this
  */
-proto.viam.component.motor.v1.StopRequest.prototype.setName = function(value) {
+proto.viam.component.motor.v1.ResetZeroPositionRequest.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional double offset = 2;
+ * @return {number}
+ */
+proto.viam.component.motor.v1.ResetZeroPositionRequest.prototype.getOffset = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.motor.v1.ResetZeroPositionRequest} returns this
+ */
+proto.viam.component.motor.v1.ResetZeroPositionRequest.prototype.setOffset = function(value) {
+  return jspb.Message.setProto3FloatField(this, 2, value);
+};
+
+
 /**
  * optional google.protobuf.Struct extra = 99;
  * @return {?proto.google.protobuf.Struct}
  */
-proto.viam.component.motor.v1.StopRequest.prototype.getExtra = function() {
+proto.viam.component.motor.v1.ResetZeroPositionRequest.prototype.getExtra = function() {
   return /** @type{?proto.google.protobuf.Struct} */ (
     jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
 };
@@ -2214,18 +2494,18 @@ proto.viam.component.motor.v1.StopRequest.prototype.getExtra = function() {
 
 /**
  * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.viam.component.motor.v1.StopRequest} returns this
+ * @return {!proto.viam.component.motor.v1.ResetZeroPositionRequest} returns this
 */
-proto.viam.component.motor.v1.StopRequest.prototype.setExtra = function(value) {
+proto.viam.component.motor.v1.ResetZeroPositionRequest.prototype.setExtra = function(value) {
   return jspb.Message.setWrapperField(this, 99, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.viam.component.motor.v1.StopRequest} returns this
+ * @return {!proto.viam.component.motor.v1.ResetZeroPositionRequest} returns this
  */
-proto.viam.component.motor.v1.StopRequest.prototype.clearExtra = function() {
+proto.viam.component.motor.v1.ResetZeroPositionRequest.prototype.clearExtra = function() {
   return this.setExtra(undefined);
 };
 
@@ -2234,7 +2514,7 @@ proto.viam.component.motor.v1.StopRequest.prototype.clearExtra = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.component.motor.v1.StopRequest.prototype.hasExtra = function() {
+proto.viam.component.motor.v1.ResetZeroPositionRequest.prototype.hasExtra = function() {
   return jspb.Message.getField(this, 99) != null;
 };
 
@@ -2255,8 +2535,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.motor.v1.StopResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.motor.v1.StopResponse.toObject(opt_includeInstance, this);
+proto.viam.component.motor.v1.ResetZeroPositionResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.motor.v1.ResetZeroPositionResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2265,11 +2545,11 @@ proto.viam.component.motor.v1.StopResponse.prototype.toObject = function(opt_inc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.motor.v1.StopResponse} msg The msg instance to transform.
+ * @param {!proto.viam.component.motor.v1.ResetZeroPositionResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.motor.v1.StopResponse.toObject = function(includeInstance, msg) {
+proto.viam.component.motor.v1.ResetZeroPositionResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
 
   };
@@ -2285,58 +2565,1835 @@ proto.viam.component.motor.v1.StopResponse.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.motor.v1.StopResponse}
+ * @return {!proto.viam.component.motor.v1.ResetZeroPositionResponse}
  */
-proto.viam.component.motor.v1.StopResponse.deserializeBinary = function(bytes) {
+proto.viam.component.motor.v1.ResetZeroPositionResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.motor.v1.StopResponse;
-  return proto.viam.component.motor.v1.StopResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.motor.v1.ResetZeroPositionResponse;
+  return proto.viam.component.motor.v1.ResetZeroPositionResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.motor.v1.ResetZeroPositionResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.motor.v1.ResetZeroPositionResponse}
+ */
+proto.viam.component.motor.v1.ResetZeroPositionResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.motor.v1.ResetZeroPositionResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.motor.v1.ResetZeroPositionResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.motor.v1.ResetZeroPositionResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.motor.v1.ResetZeroPositionResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.motor.v1.GetPositionRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.motor.v1.GetPositionRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.motor.v1.GetPositionRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.motor.v1.GetPositionRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.motor.v1.GetPositionRequest}
+ */
+proto.viam.component.motor.v1.GetPositionRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.motor.v1.GetPositionRequest;
+  return proto.viam.component.motor.v1.GetPositionRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.motor.v1.GetPositionRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.motor.v1.GetPositionRequest}
+ */
+proto.viam.component.motor.v1.GetPositionRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.motor.v1.GetPositionRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.motor.v1.GetPositionRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.motor.v1.GetPositionRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.motor.v1.GetPositionRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.viam.component.motor.v1.GetPositionRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.motor.v1.GetPositionRequest} returns this
+ */
+proto.viam.component.motor.v1.GetPositionRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.component.motor.v1.GetPositionRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.component.motor.v1.GetPositionRequest} returns this
+*/
+proto.viam.component.motor.v1.GetPositionRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.motor.v1.GetPositionRequest} returns this
+ */
+proto.viam.component.motor.v1.GetPositionRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.motor.v1.GetPositionRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.motor.v1.GetPositionResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.motor.v1.GetPositionResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.motor.v1.GetPositionResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.motor.v1.GetPositionResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    position: jspb.Message.getFloatingPointFieldWithDefault(msg, 1, 0.0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.motor.v1.GetPositionResponse}
+ */
+proto.viam.component.motor.v1.GetPositionResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.motor.v1.GetPositionResponse;
+  return proto.viam.component.motor.v1.GetPositionResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.motor.v1.GetPositionResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.motor.v1.GetPositionResponse}
+ */
+proto.viam.component.motor.v1.GetPositionResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setPosition(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.motor.v1.GetPositionResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.motor.v1.GetPositionResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.motor.v1.GetPositionResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.motor.v1.GetPositionResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPosition();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional double position = 1;
+ * @return {number}
+ */
+proto.viam.component.motor.v1.GetPositionResponse.prototype.getPosition = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 1, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.motor.v1.GetPositionResponse} returns this
+ */
+proto.viam.component.motor.v1.GetPositionResponse.prototype.setPosition = function(value) {
+  return jspb.Message.setProto3FloatField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.motor.v1.StopRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.motor.v1.StopRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.motor.v1.StopRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.motor.v1.StopRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.motor.v1.StopRequest}
+ */
+proto.viam.component.motor.v1.StopRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.motor.v1.StopRequest;
+  return proto.viam.component.motor.v1.StopRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.motor.v1.StopRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.motor.v1.StopRequest}
+ */
+proto.viam.component.motor.v1.StopRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.motor.v1.StopRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.motor.v1.StopRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.motor.v1.StopRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.motor.v1.StopRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.viam.component.motor.v1.StopRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.motor.v1.StopRequest} returns this
+ */
+proto.viam.component.motor.v1.StopRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.component.motor.v1.StopRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.component.motor.v1.StopRequest} returns this
+*/
+proto.viam.component.motor.v1.StopRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.motor.v1.StopRequest} returns this
+ */
+proto.viam.component.motor.v1.StopRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.motor.v1.StopRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.motor.v1.StopResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.motor.v1.StopResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.motor.v1.StopResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.motor.v1.StopResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.motor.v1.StopResponse}
+ */
+proto.viam.component.motor.v1.StopResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.motor.v1.StopResponse;
+  return proto.viam.component.motor.v1.StopResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.motor.v1.StopResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.motor.v1.StopResponse}
+ */
+proto.viam.component.motor.v1.StopResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.motor.v1.StopResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.motor.v1.StopResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.motor.v1.StopResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.motor.v1.StopResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.motor.v1.IsPoweredRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.motor.v1.IsPoweredRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.motor.v1.IsPoweredRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.motor.v1.IsPoweredRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.motor.v1.IsPoweredRequest}
+ */
+proto.viam.component.motor.v1.IsPoweredRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.motor.v1.IsPoweredRequest;
+  return proto.viam.component.motor.v1.IsPoweredRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.motor.v1.IsPoweredRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.motor.v1.IsPoweredRequest}
+ */
+proto.viam.component.motor.v1.IsPoweredRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.motor.v1.IsPoweredRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.motor.v1.IsPoweredRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.motor.v1.IsPoweredRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.motor.v1.IsPoweredRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.viam.component.motor.v1.IsPoweredRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.motor.v1.IsPoweredRequest} returns this
+ */
+proto.viam.component.motor.v1.IsPoweredRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.component.motor.v1.IsPoweredRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.component.motor.v1.IsPoweredRequest} returns this
+*/
+proto.viam.component.motor.v1.IsPoweredRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.motor.v1.IsPoweredRequest} returns this
+ */
+proto.viam.component.motor.v1.IsPoweredRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.motor.v1.IsPoweredRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.motor.v1.IsPoweredResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.motor.v1.IsPoweredResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.motor.v1.IsPoweredResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.motor.v1.IsPoweredResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    isOn: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+    powerPct: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.motor.v1.IsPoweredResponse}
+ */
+proto.viam.component.motor.v1.IsPoweredResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.motor.v1.IsPoweredResponse;
+  return proto.viam.component.motor.v1.IsPoweredResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.motor.v1.IsPoweredResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.motor.v1.IsPoweredResponse}
+ */
+proto.viam.component.motor.v1.IsPoweredResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsOn(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setPowerPct(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.motor.v1.IsPoweredResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.motor.v1.IsPoweredResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.motor.v1.IsPoweredResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.motor.v1.IsPoweredResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getIsOn();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+  f = message.getPowerPct();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool is_on = 1;
+ * @return {boolean}
+ */
+proto.viam.component.motor.v1.IsPoweredResponse.prototype.getIsOn = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.viam.component.motor.v1.IsPoweredResponse} returns this
+ */
+proto.viam.component.motor.v1.IsPoweredResponse.prototype.setIsOn = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+/**
+ * optional double power_pct = 2;
+ * @return {number}
+ */
+proto.viam.component.motor.v1.IsPoweredResponse.prototype.getPowerPct = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.motor.v1.IsPoweredResponse} returns this
+ */
+proto.viam.component.motor.v1.IsPoweredResponse.prototype.setPowerPct = function(value) {
+  return jspb.Message.setProto3FloatField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.motor.v1.GetTelemetryRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.motor.v1.GetTelemetryRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.motor.v1.GetTelemetryRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.motor.v1.GetTelemetryRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.motor.v1.GetTelemetryRequest}
+ */
+proto.viam.component.motor.v1.GetTelemetryRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.motor.v1.GetTelemetryRequest;
+  return proto.viam.component.motor.v1.GetTelemetryRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.motor.v1.GetTelemetryRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.motor.v1.GetTelemetryRequest}
+ */
+proto.viam.component.motor.v1.GetTelemetryRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.motor.v1.GetTelemetryRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.motor.v1.GetTelemetryRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.motor.v1.GetTelemetryRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.motor.v1.GetTelemetryRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.viam.component.motor.v1.GetTelemetryRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.motor.v1.GetTelemetryRequest} returns this
+ */
+proto.viam.component.motor.v1.GetTelemetryRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.component.motor.v1.GetTelemetryRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.component.motor.v1.GetTelemetryRequest} returns this
+*/
+proto.viam.component.motor.v1.GetTelemetryRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.motor.v1.GetTelemetryRequest} returns this
+ */
+proto.viam.component.motor.v1.GetTelemetryRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.motor.v1.GetTelemetryRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.component.motor.v1.GetTelemetryResponse.repeatedFields_ = [6];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.motor.v1.GetTelemetryResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.motor.v1.GetTelemetryResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.motor.v1.GetTelemetryResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.motor.v1.GetTelemetryResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    currentA: jspb.Message.getFloatingPointFieldWithDefault(msg, 1, 0.0),
+    busVoltageV: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0),
+    windingTemperatureCelsius: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0),
+    driverTemperatureCelsius: jspb.Message.getFloatingPointFieldWithDefault(msg, 4, 0.0),
+    rpm: jspb.Message.getFloatingPointFieldWithDefault(msg, 5, 0.0),
+    faultsList: jspb.Message.toObjectList(msg.getFaultsList(),
+    proto.viam.component.motor.v1.MotorFault.toObject, includeInstance),
+    measuredAt: (f = msg.getMeasuredAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.motor.v1.GetTelemetryResponse}
+ */
+proto.viam.component.motor.v1.GetTelemetryResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.motor.v1.GetTelemetryResponse;
+  return proto.viam.component.motor.v1.GetTelemetryResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.motor.v1.GetTelemetryResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.motor.v1.GetTelemetryResponse}
+ */
+proto.viam.component.motor.v1.GetTelemetryResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setCurrentA(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setBusVoltageV(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setWindingTemperatureCelsius(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setDriverTemperatureCelsius(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setRpm(value);
+      break;
+    case 6:
+      var value = new proto.viam.component.motor.v1.MotorFault;
+      reader.readMessage(value,proto.viam.component.motor.v1.MotorFault.deserializeBinaryFromReader);
+      msg.addFaults(value);
+      break;
+    case 7:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setMeasuredAt(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.motor.v1.GetTelemetryResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.motor.v1.GetTelemetryResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.motor.v1.GetTelemetryResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.motor.v1.GetTelemetryResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = /** @type {number} */ (jspb.Message.getField(message, 1));
+  if (f != null) {
+    writer.writeDouble(
+      1,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeDouble(
+      2,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeDouble(
+      3,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 4));
+  if (f != null) {
+    writer.writeDouble(
+      4,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 5));
+  if (f != null) {
+    writer.writeDouble(
+      5,
+      f
+    );
+  }
+  f = message.getFaultsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      6,
+      f,
+      proto.viam.component.motor.v1.MotorFault.serializeBinaryToWriter
+    );
+  }
+  f = message.getMeasuredAt();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional double current_a = 1;
+ * @return {number}
+ */
+proto.viam.component.motor.v1.GetTelemetryResponse.prototype.getCurrentA = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 1, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.motor.v1.GetTelemetryResponse} returns this
+ */
+proto.viam.component.motor.v1.GetTelemetryResponse.prototype.setCurrentA = function(value) {
+  return jspb.Message.setField(this, 1, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.viam.component.motor.v1.GetTelemetryResponse} returns this
+ */
+proto.viam.component.motor.v1.GetTelemetryResponse.prototype.clearCurrentA = function() {
+  return jspb.Message.setField(this, 1, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.motor.v1.GetTelemetryResponse.prototype.hasCurrentA = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional double bus_voltage_v = 2;
+ * @return {number}
+ */
+proto.viam.component.motor.v1.GetTelemetryResponse.prototype.getBusVoltageV = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.motor.v1.GetTelemetryResponse} returns this
+ */
+proto.viam.component.motor.v1.GetTelemetryResponse.prototype.setBusVoltageV = function(value) {
+  return jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.viam.component.motor.v1.GetTelemetryResponse} returns this
+ */
+proto.viam.component.motor.v1.GetTelemetryResponse.prototype.clearBusVoltageV = function() {
+  return jspb.Message.setField(this, 2, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.motor.v1.GetTelemetryResponse.prototype.hasBusVoltageV = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional double winding_temperature_celsius = 3;
+ * @return {number}
+ */
+proto.viam.component.motor.v1.GetTelemetryResponse.prototype.getWindingTemperatureCelsius = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 3, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.motor.v1.GetTelemetryResponse} returns this
+ */
+proto.viam.component.motor.v1.GetTelemetryResponse.prototype.setWindingTemperatureCelsius = function(value) {
+  return jspb.Message.setField(this, 3, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.viam.component.motor.v1.GetTelemetryResponse} returns this
+ */
+proto.viam.component.motor.v1.GetTelemetryResponse.prototype.clearWindingTemperatureCelsius = function() {
+  return jspb.Message.setField(this, 3, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.motor.v1.GetTelemetryResponse.prototype.hasWindingTemperatureCelsius = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional double driver_temperature_celsius = 4;
+ * @return {number}
+ */
+proto.viam.component.motor.v1.GetTelemetryResponse.prototype.getDriverTemperatureCelsius = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 4, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.motor.v1.GetTelemetryResponse} returns this
+ */
+proto.viam.component.motor.v1.GetTelemetryResponse.prototype.setDriverTemperatureCelsius = function(value) {
+  return jspb.Message.setField(this, 4, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.viam.component.motor.v1.GetTelemetryResponse} returns this
+ */
+proto.viam.component.motor.v1.GetTelemetryResponse.prototype.clearDriverTemperatureCelsius = function() {
+  return jspb.Message.setField(this, 4, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.motor.v1.GetTelemetryResponse.prototype.hasDriverTemperatureCelsius = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional double rpm = 5;
+ * @return {number}
+ */
+proto.viam.component.motor.v1.GetTelemetryResponse.prototype.getRpm = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 5, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.motor.v1.GetTelemetryResponse} returns this
+ */
+proto.viam.component.motor.v1.GetTelemetryResponse.prototype.setRpm = function(value) {
+  return jspb.Message.setField(this, 5, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.viam.component.motor.v1.GetTelemetryResponse} returns this
+ */
+proto.viam.component.motor.v1.GetTelemetryResponse.prototype.clearRpm = function() {
+  return jspb.Message.setField(this, 5, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.motor.v1.GetTelemetryResponse.prototype.hasRpm = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * repeated MotorFault faults = 6;
+ * @return {!Array<!proto.viam.component.motor.v1.MotorFault>}
+ */
+proto.viam.component.motor.v1.GetTelemetryResponse.prototype.getFaultsList = function() {
+  return /** @type{!Array<!proto.viam.component.motor.v1.MotorFault>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.viam.component.motor.v1.MotorFault, 6));
+};
+
+
+/**
+ * @param {!Array<!proto.viam.component.motor.v1.MotorFault>} value
+ * @return {!proto.viam.component.motor.v1.GetTelemetryResponse} returns this
+*/
+proto.viam.component.motor.v1.GetTelemetryResponse.prototype.setFaultsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 6, value);
+};
+
+
+/**
+ * @param {!proto.viam.component.motor.v1.MotorFault=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.component.motor.v1.MotorFault}
+ */
+proto.viam.component.motor.v1.GetTelemetryResponse.prototype.addFaults = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 6, opt_value, proto.viam.component.motor.v1.MotorFault, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.component.motor.v1.GetTelemetryResponse} returns this
+ */
+proto.viam.component.motor.v1.GetTelemetryResponse.prototype.clearFaultsList = function() {
+  return this.setFaultsList([]);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp measured_at = 7;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.viam.component.motor.v1.GetTelemetryResponse.prototype.getMeasuredAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 7));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.viam.component.motor.v1.GetTelemetryResponse} returns this
+*/
+proto.viam.component.motor.v1.GetTelemetryResponse.prototype.setMeasuredAt = function(value) {
+  return jspb.Message.setWrapperField(this, 7, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.motor.v1.GetTelemetryResponse} returns this
+ */
+proto.viam.component.motor.v1.GetTelemetryResponse.prototype.clearMeasuredAt = function() {
+  return this.setMeasuredAt(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.motor.v1.GetTelemetryResponse.prototype.hasMeasuredAt = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.component.motor.v1.MotorFault.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.motor.v1.MotorFault.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.component.motor.v1.MotorFault} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.motor.v1.MotorFault.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    code: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    description: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    detectedAt: (f = msg.getDetectedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.component.motor.v1.MotorFault}
+ */
+proto.viam.component.motor.v1.MotorFault.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.component.motor.v1.MotorFault;
+  return proto.viam.component.motor.v1.MotorFault.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.component.motor.v1.MotorFault} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.component.motor.v1.MotorFault}
+ */
+proto.viam.component.motor.v1.MotorFault.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.viam.component.motor.v1.MotorFaultCode} */ (reader.readEnum());
+      msg.setCode(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDescription(value);
+      break;
+    case 3:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setDetectedAt(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.component.motor.v1.MotorFault.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.component.motor.v1.MotorFault.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.component.motor.v1.MotorFault} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.component.motor.v1.MotorFault.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCode();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getDescription();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getDetectedAt();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional MotorFaultCode code = 1;
+ * @return {!proto.viam.component.motor.v1.MotorFaultCode}
+ */
+proto.viam.component.motor.v1.MotorFault.prototype.getCode = function() {
+  return /** @type {!proto.viam.component.motor.v1.MotorFaultCode} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.viam.component.motor.v1.MotorFaultCode} value
+ * @return {!proto.viam.component.motor.v1.MotorFault} returns this
+ */
+proto.viam.component.motor.v1.MotorFault.prototype.setCode = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional string description = 2;
+ * @return {string}
+ */
+proto.viam.component.motor.v1.MotorFault.prototype.getDescription = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.component.motor.v1.MotorFault} returns this
+ */
+proto.viam.component.motor.v1.MotorFault.prototype.setDescription = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.viam.component.motor.v1.StopResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.motor.v1.StopResponse}
+ * optional google.protobuf.Timestamp detected_at = 3;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.viam.component.motor.v1.StopResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.viam.component.motor.v1.MotorFault.prototype.getDetectedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.viam.component.motor.v1.MotorFault} returns this
+*/
+proto.viam.component.motor.v1.MotorFault.prototype.setDetectedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.component.motor.v1.MotorFault} returns this
  */
-proto.viam.component.motor.v1.StopResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.viam.component.motor.v1.StopResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.viam.component.motor.v1.MotorFault.prototype.clearDetectedAt = function() {
+  return this.setDetectedAt(undefined);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.motor.v1.StopResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.viam.component.motor.v1.StopResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
+proto.viam.component.motor.v1.MotorFault.prototype.hasDetectedAt = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
@@ -2356,8 +4413,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.motor.v1.IsPoweredRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.motor.v1.IsPoweredRequest.toObject(opt_includeInstance, this);
+proto.viam.component.motor.v1.ClearFaultsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.motor.v1.ClearFaultsRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -2366,11 +4423,11 @@ proto.viam.component.motor.v1.IsPoweredRequest.prototype.toObject = function(opt
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.motor.v1.IsPoweredRequest} msg The msg instance to transform.
+ * @param {!proto.viam.component.motor.v1.ClearFaultsRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.motor.v1.IsPoweredRequest.toObject = function(includeInstance, msg) {
+proto.viam.component.motor.v1.ClearFaultsRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     name: jspb.Message.getFieldWithDefault(msg, 1, ""),
     extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
@@ -2387,23 +4444,23 @@ proto.viam.component.motor.v1.IsPoweredRequest.toObject = function(includeInstan
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.motor.v1.IsPoweredRequest}
+ * @return {!proto.viam.component.motor.v1.ClearFaultsRequest}
  */
-proto.viam.component.motor.v1.IsPoweredRequest.deserializeBinary = function(bytes) {
+proto.viam.component.motor.v1.ClearFaultsRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.motor.v1.IsPoweredRequest;
-  return proto.viam.component.motor.v1.IsPoweredRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.motor.v1.ClearFaultsRequest;
+  return proto.viam.component.motor.v1.ClearFaultsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.motor.v1.IsPoweredRequest} msg The message object to deserialize into.
+ * @param {!proto.viam.component.motor.v1.ClearFaultsRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.motor.v1.IsPoweredRequest}
+ * @return {!proto.viam.component.motor.v1.ClearFaultsRequest}
  */
-proto.viam.component.motor.v1.IsPoweredRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.motor.v1.ClearFaultsRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2432,9 +4489,9 @@ proto.viam.component.motor.v1.IsPoweredRequest.deserializeBinaryFromReader = fun
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.motor.v1.IsPoweredRequest.prototype.serializeBinary = function() {
+proto.viam.component.motor.v1.ClearFaultsRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.motor.v1.IsPoweredRequest.serializeBinaryToWriter(this, writer);
+  proto.viam.component.motor.v1.ClearFaultsRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2442,11 +4499,11 @@ proto.viam.component.motor.v1.IsPoweredRequest.prototype.serializeBinary = funct
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.motor.v1.IsPoweredRequest} message
+ * @param {!proto.viam.component.motor.v1.ClearFaultsRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.motor.v1.IsPoweredRequest.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.motor.v1.ClearFaultsRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getName();
   if (f.length > 0) {
@@ -2470,16 +4527,16 @@ proto.viam.component.motor.v1.IsPoweredRequest.serializeBinaryToWriter = functio
  * optional string name = 1;
  * @return {string}
  */
-proto.viam.component.motor.v1.IsPoweredRequest.prototype.getName = function() {
+proto.viam.component.motor.v1.ClearFaultsRequest.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.viam.component.motor.v1.IsPoweredRequest} returns this
+ * @return {!proto.viam.component.motor.v1.ClearFaultsRequest} returns this
  */
-proto.viam.component.motor.v1.IsPoweredRequest.prototype.setName = function(value) {
+proto.viam.component.motor.v1.ClearFaultsRequest.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
@@ -2488,7 +4545,7 @@ proto.viam.component.motor.v1.IsPoweredRequest.prototype.setName = function(valu
  * optional google.protobuf.Struct extra = 99;
  * @return {?proto.google.protobuf.Struct}
  */
-proto.viam.component.motor.v1.IsPoweredRequest.prototype.getExtra = function() {
+proto.viam.component.motor.v1.ClearFaultsRequest.prototype.getExtra = function() {
   return /** @type{?proto.google.protobuf.Struct} */ (
     jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
 };
@@ -2496,18 +4553,18 @@ proto.viam.component.motor.v1.IsPoweredRequest.prototype.getExtra = function() {
 
 /**
  * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.viam.component.motor.v1.IsPoweredRequest} returns this
+ * @return {!proto.viam.component.motor.v1.ClearFaultsRequest} returns this
 */
-proto.viam.component.motor.v1.IsPoweredRequest.prototype.setExtra = function(value) {
+proto.viam.component.motor.v1.ClearFaultsRequest.prototype.setExtra = function(value) {
   return jspb.Message.setWrapperField(this, 99, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.viam.component.motor.v1.IsPoweredRequest} returns this
+ * @return {!proto.viam.component.motor.v1.ClearFaultsRequest} returns this
  */
-proto.viam.component.motor.v1.IsPoweredRequest.prototype.clearExtra = function() {
+proto.viam.component.motor.v1.ClearFaultsRequest.prototype.clearExtra = function() {
   return this.setExtra(undefined);
 };
 
@@ -2516,7 +4573,7 @@ proto.viam.component.motor.v1.IsPoweredRequest.prototype.clearExtra = function()
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.component.motor.v1.IsPoweredRequest.prototype.hasExtra = function() {
+proto.viam.component.motor.v1.ClearFaultsRequest.prototype.hasExtra = function() {
   return jspb.Message.getField(this, 99) != null;
 };
 
@@ -2537,8 +4594,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.component.motor.v1.IsPoweredResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.component.motor.v1.IsPoweredResponse.toObject(opt_includeInstance, this);
+proto.viam.component.motor.v1.ClearFaultsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.component.motor.v1.ClearFaultsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2547,14 +4604,13 @@ proto.viam.component.motor.v1.IsPoweredResponse.prototype.toObject = function(op
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.component.motor.v1.IsPoweredResponse} msg The msg instance to transform.
+ * @param {!proto.viam.component.motor.v1.ClearFaultsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.motor.v1.IsPoweredResponse.toObject = function(includeInstance, msg) {
+proto.viam.component.motor.v1.ClearFaultsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    isOn: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
-    powerPct: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0)
+
   };
 
   if (includeInstance) {
@@ -2568,37 +4624,29 @@ proto.viam.component.motor.v1.IsPoweredResponse.toObject = function(includeInsta
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.component.motor.v1.IsPoweredResponse}
+ * @return {!proto.viam.component.motor.v1.ClearFaultsResponse}
  */
-proto.viam.component.motor.v1.IsPoweredResponse.deserializeBinary = function(bytes) {
+proto.viam.component.motor.v1.ClearFaultsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.component.motor.v1.IsPoweredResponse;
-  return proto.viam.component.motor.v1.IsPoweredResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.component.motor.v1.ClearFaultsResponse;
+  return proto.viam.component.motor.v1.ClearFaultsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.component.motor.v1.IsPoweredResponse} msg The message object to deserialize into.
+ * @param {!proto.viam.component.motor.v1.ClearFaultsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.component.motor.v1.IsPoweredResponse}
+ * @return {!proto.viam.component.motor.v1.ClearFaultsResponse}
  */
-proto.viam.component.motor.v1.IsPoweredResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.component.motor.v1.ClearFaultsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIsOn(value);
-      break;
-    case 2:
-      var value = /** @type {number} */ (reader.readDouble());
-      msg.setPowerPct(value);
-      break;
     default:
       reader.skipField();
       break;
@@ -2612,9 +4660,9 @@ proto.viam.component.motor.v1.IsPoweredResponse.deserializeBinaryFromReader = fu
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.component.motor.v1.IsPoweredResponse.prototype.serializeBinary = function() {
+proto.viam.component.motor.v1.ClearFaultsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.component.motor.v1.IsPoweredResponse.serializeBinaryToWriter(this, writer);
+  proto.viam.component.motor.v1.ClearFaultsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2622,62 +4670,12 @@ proto.viam.component.motor.v1.IsPoweredResponse.prototype.serializeBinary = func
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.component.motor.v1.IsPoweredResponse} message
+ * @param {!proto.viam.component.motor.v1.ClearFaultsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.component.motor.v1.IsPoweredResponse.serializeBinaryToWriter = function(message, writer) {
+proto.viam.component.motor.v1.ClearFaultsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getIsOn();
-  if (f) {
-    writer.writeBool(
-      1,
-      f
-    );
-  }
-  f = message.getPowerPct();
-  if (f !== 0.0) {
-    writer.writeDouble(
-      2,
-      f
-    );
-  }
-};
-
-
-/**
- * optional bool is_on = 1;
- * @return {boolean}
- */
-proto.viam.component.motor.v1.IsPoweredResponse.prototype.getIsOn = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.viam.component.motor.v1.IsPoweredResponse} returns this
- */
-proto.viam.component.motor.v1.IsPoweredResponse.prototype.setIsOn = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
-};
-
-
-/**
- * optional double power_pct = 2;
- * @return {number}
- */
-proto.viam.component.motor.v1.IsPoweredResponse.prototype.getPowerPct = function() {
-  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.viam.component.motor.v1.IsPoweredResponse} returns this
- */
-proto.viam.component.motor.v1.IsPoweredResponse.prototype.setPowerPct = function(value) {
-  return jspb.Message.setProto3FloatField(this, 2, value);
 };
 
 
@@ -2894,7 +4892,11 @@ proto.viam.component.motor.v1.GetPropertiesResponse.prototype.toObject = functio
  */
 proto.viam.component.motor.v1.GetPropertiesResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    positionReporting: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+    positionReporting: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+    velocityControl: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    torqueControl: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    maxRpm: jspb.Message.getFloatingPointFieldWithDefault(msg, 4, 0.0),
+    ticksPerRotation: jspb.Message.getFieldWithDefault(msg, 5, 0)
   };
 
   if (includeInstance) {
@@ -2935,6 +4937,22 @@ proto.viam.component.motor.v1.GetPropertiesResponse.deserializeBinaryFromReader
       var value = /** @type {boolean} */ (reader.readBool());
       msg.setPositionReporting(value);
       break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setVelocityControl(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setTorqueControl(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setMaxRpm(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setTicksPerRotation(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -2971,6 +4989,34 @@ proto.viam.component.motor.v1.GetPropertiesResponse.serializeBinaryToWriter = fu
       f
     );
   }
+  f = message.getVelocityControl();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = message.getTorqueControl();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 4));
+  if (f != null) {
+    writer.writeDouble(
+      4,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 5));
+  if (f != null) {
+    writer.writeInt64(
+      5,
+      f
+    );
+  }
 };
 
 
@@ -2992,6 +5038,114 @@ proto.viam.component.motor.v1.GetPropertiesResponse.prototype.setPositionReporti
 };
 
 
+/**
+ * optional bool velocity_control = 2;
+ * @return {boolean}
+ */
+proto.viam.component.motor.v1.GetPropertiesResponse.prototype.getVelocityControl = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.viam.component.motor.v1.GetPropertiesResponse} returns this
+ */
+proto.viam.component.motor.v1.GetPropertiesResponse.prototype.setVelocityControl = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+/**
+ * optional bool torque_control = 3;
+ * @return {boolean}
+ */
+proto.viam.component.motor.v1.GetPropertiesResponse.prototype.getTorqueControl = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.viam.component.motor.v1.GetPropertiesResponse} returns this
+ */
+proto.viam.component.motor.v1.GetPropertiesResponse.prototype.setTorqueControl = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+/**
+ * optional double max_rpm = 4;
+ * @return {number}
+ */
+proto.viam.component.motor.v1.GetPropertiesResponse.prototype.getMaxRpm = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 4, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.motor.v1.GetPropertiesResponse} returns this
+ */
+proto.viam.component.motor.v1.GetPropertiesResponse.prototype.setMaxRpm = function(value) {
+  return jspb.Message.setField(this, 4, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.viam.component.motor.v1.GetPropertiesResponse} returns this
+ */
+proto.viam.component.motor.v1.GetPropertiesResponse.prototype.clearMaxRpm = function() {
+  return jspb.Message.setField(this, 4, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.motor.v1.GetPropertiesResponse.prototype.hasMaxRpm = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional int64 ticks_per_rotation = 5;
+ * @return {number}
+ */
+proto.viam.component.motor.v1.GetPropertiesResponse.prototype.getTicksPerRotation = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.component.motor.v1.GetPropertiesResponse} returns this
+ */
+proto.viam.component.motor.v1.GetPropertiesResponse.prototype.setTicksPerRotation = function(value) {
+  return jspb.Message.setField(this, 5, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.viam.component.motor.v1.GetPropertiesResponse} returns this
+ */
+proto.viam.component.motor.v1.GetPropertiesResponse.prototype.clearTicksPerRotation = function() {
+  return jspb.Message.setField(this, 5, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.component.motor.v1.GetPropertiesResponse.prototype.hasTicksPerRotation = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
 
 
 
@@ -3442,4 +5596,18 @@ proto.viam.component.motor.v1.IsMovingResponse.prototype.setIsMoving = function(
 };
 
 
+/**
+ * @enum {number}
+ */
+proto.viam.component.motor.v1.MotorFaultCode = {
+  MOTOR_FAULT_CODE_UNSPECIFIED: 0,
+  MOTOR_FAULT_CODE_OVER_CURRENT: 1,
+  MOTOR_FAULT_CODE_OVER_TEMPERATURE: 2,
+  MOTOR_FAULT_CODE_UNDER_VOLTAGE: 3,
+  MOTOR_FAULT_CODE_OVER_VOLTAGE: 4,
+  MOTOR_FAULT_CODE_STALL: 5,
+  MOTOR_FAULT_CODE_ENCODER: 6,
+  MOTOR_FAULT_CODE_DRIVER: 7
+};
+
 goog.object.extend(exports, proto.viam.component.motor.v1);
@@ -32,6 +32,24 @@ type MotorServiceGoTo = {
   readonly responseType: typeof component_motor_v1_motor_pb.GoToResponse;
 };
 
+type MotorServiceSetRPM = {
+  readonly methodName: string;
+  readonly service: typeof MotorService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof component_motor_v1_motor_pb.SetRPMRequest;
+  readonly responseType: typeof component_motor_v1_motor_pb.SetRPMResponse;
+};
+
+type MotorServiceSetTorque = {
+  readonly methodName: string;
+  readonly service: typeof MotorService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof component_motor_v1_motor_pb.SetTorqueRequest;
+  readonly responseType: typeof component_motor_v1_motor_pb.SetTorqueResponse;
+};
+
 type MotorServiceResetZeroPosition = {
   readonly methodName: string;
   readonly service: typeof MotorService;
@@ -77,6 +95,24 @@ type MotorServiceIsPowered = {
   readonly responseType: typeof component_motor_v1_motor_pb.IsPoweredResponse;
 };
 
+type MotorServiceGetTelemetry = {
+  readonly methodName: string;
+  readonly service: typeof MotorService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof component_motor_v1_motor_pb.GetTelemetryRequest;
+  readonly responseType: typeof component_motor_v1_motor_pb.GetTelemetryResponse;
+};
+
+type MotorServiceClearFaults = {
+  readonly methodName: string;
+  readonly service: typeof MotorService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof component_motor_v1_motor_pb.ClearFaultsRequest;
+  readonly responseType: typeof component_motor_v1_motor_pb.ClearFaultsResponse;
+};
+
 type MotorServiceIsMoving = {
   readonly methodName: string;
   readonly service: typeof MotorService;
@@ -109,11 +145,15 @@ export class MotorService {
   static readonly SetPower: MotorServiceSetPower;
   static readonly GoFor: MotorServiceGoFor;
   static readonly GoTo: MotorServiceGoTo;
+  static readonly SetRPM: MotorServiceSetRPM;
+  static readonly SetTorque: MotorServiceSetTorque;
   static readonly ResetZeroPosition: MotorServiceResetZeroPosition;
   static readonly GetPosition: MotorServiceGetPosition;
   static readonly GetProperties: MotorServiceGetProperties;
   static readonly Stop: MotorServiceStop;
   static readonly IsPowered: MotorServiceIsPowered;
+  static readonly GetTelemetry: MotorServiceGetTelemetry;
+  static readonly ClearFaults: MotorServiceClearFaults;
   static readonly IsMoving: MotorServiceIsMoving;
   static readonly DoCommand: MotorServiceDoCommand;
   static readonly GetGeometries: MotorServiceGetGeometries;
@@ -178,6 +218,24 @@ export class MotorServiceClient {
     requestMessage: component_motor_v1_motor_pb.GoToRequest,
     callback: (error: ServiceError|null, responseMessage: component_motor_v1_motor_pb.GoToResponse|null) => void
   ): UnaryResponse;
+  setRPM(
+    requestMessage: component_motor_v1_motor_pb.SetRPMRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: component_motor_v1_motor_pb.SetRPMResponse|null) => void
+  ): UnaryResponse;
+  setRPM(
+    requestMessage: component_motor_v1_motor_pb.SetRPMRequest,
+    callback: (error: ServiceError|null, responseMessage: component_motor_v1_motor_pb.SetRPMResponse|null) => void
+  ): UnaryResponse;
+  setTorque(
+    requestMessage: component_motor_v1_motor_pb.SetTorqueRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: component_motor_v1_motor_pb.SetTorqueResponse|null) => void
+  ): UnaryResponse;
+  setTorque(
+    requestMessage: component_motor_v1_motor_pb.SetTorqueRequest,
+    callback: (error: ServiceError|null, responseMessage: component_motor_v1_motor_pb.SetTorqueResponse|null) => void
+  ): UnaryResponse;
   resetZeroPosition(
     requestMessage: component_motor_v1_motor_pb.ResetZeroPositionRequest,
     metadata: grpc.Metadata,
@@ -223,6 +281,24 @@ export class MotorServiceClient {
     requestMessage: component_motor_v1_motor_pb.IsPoweredRequest,
     callback: (error: ServiceError|null, responseMessage: component_motor_v1_motor_pb.IsPoweredResponse|null) => void
   ): UnaryResponse;
+  getTelemetry(
+    requestMessage: component_motor_v1_motor_pb.GetTelemetryRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: component_motor_v1_motor_pb.GetTelemetryResponse|null) => void
+  ): UnaryResponse;
+  getTelemetry(
+    requestMessage: component_motor_v1_motor_pb.GetTelemetryRequest,
+    callback: (error: ServiceError|null, responseMessage: component_motor_v1_motor_pb.GetTelemetryResponse|null) => void
+  ): UnaryResponse;
+  clearFaults(
+    requestMessage: component_motor_v1_motor_pb.ClearFaultsRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: component_motor_v1_motor_pb.ClearFaultsResponse|null) => void
+  ): UnaryResponse;
+  clearFaults(
+    requestMessage: component_motor_v1_motor_pb.ClearFaultsRequest,
+    callback: (error: ServiceError|null, responseMessage: component_motor_v1_motor_pb.ClearFaultsResponse|null) => void
+  ): UnaryResponse;
   isMoving(
     requestMessage: component_motor_v1_motor_pb.IsMovingRequest,
     metadata: grpc.Metadata,
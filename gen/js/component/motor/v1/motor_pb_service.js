@@ -38,6 +38,24 @@ MotorService.GoTo = {
   responseType: component_motor_v1_motor_pb.GoToResponse
 };
 
+MotorService.SetRPM = {
+  methodName: "SetRPM",
+  service: MotorService,
+  requestStream: false,
+  responseStream: false,
+  requestType: component_motor_v1_motor_pb.SetRPMRequest,
+  responseType: component_motor_v1_motor_pb.SetRPMResponse
+};
+
+MotorService.SetTorque = {
+  methodName: "SetTorque",
+  service: MotorService,
+  requestStream: false,
+  responseStream: false,
+  requestType: component_motor_v1_motor_pb.SetTorqueRequest,
+  responseType: component_motor_v1_motor_pb.SetTorqueResponse
+};
+
 MotorService.ResetZeroPosition = {
   methodName: "ResetZeroPosition",
   service: MotorService,
@@ -83,6 +101,24 @@ MotorService.IsPowered = {
   responseType: component_motor_v1_motor_pb.IsPoweredResponse
 };
 
+MotorService.GetTelemetry = {
+  methodName: "GetTelemetry",
+  service: MotorService,
+  requestStream: false,
+  responseStream: false,
+  requestType: component_motor_v1_motor_pb.GetTelemetryRequest,
+  responseType: component_motor_v1_motor_pb.GetTelemetryResponse
+};
+
+MotorService.ClearFaults = {
+  methodName: "ClearFaults",
+  service: MotorService,
+  requestStream: false,
+  responseStream: false,
+  requestType: component_motor_v1_motor_pb.ClearFaultsRequest,
+  responseType: component_motor_v1_motor_pb.ClearFaultsResponse
+};
+
 MotorService.IsMoving = {
   methodName: "IsMoving",
   service: MotorService,
@@ -210,6 +246,68 @@ MotorServiceClient.prototype.goTo = function goTo(requestMessage, metadata, call
   };
 };
 
+MotorServiceClient.prototype.setRPM = function setRPM(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(MotorService.SetRPM, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+MotorServiceClient.prototype.setTorque = function setTorque(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(MotorService.SetTorque, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
 MotorServiceClient.prototype.resetZeroPosition = function resetZeroPosition(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
@@ -365,6 +463,68 @@ MotorServiceClient.prototype.isPowered = function isPowered(requestMessage, meta
   };
 };
 
+MotorServiceClient.prototype.getTelemetry = function getTelemetry(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(MotorService.GetTelemetry, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+MotorServiceClient.prototype.clearFaults = function clearFaults(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(MotorService.ClearFaults, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
 MotorServiceClient.prototype.isMoving = function isMoving(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
@@ -146,6 +146,9 @@ export class TransformPCDRequest extends jspb.Message {
   getDestination(): string;
   setDestination(value: string): void;
 
+  getMimeType(): string;
+  setMimeType(value: string): void;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): TransformPCDRequest.AsObject;
   static toObject(includeInstance: boolean, msg: TransformPCDRequest): TransformPCDRequest.AsObject;
@@ -161,6 +164,7 @@ export namespace TransformPCDRequest {
     pointCloudPcd: Uint8Array | string,
     source: string,
     destination: string,
+    mimeType: string,
   }
 }
 
@@ -170,6 +174,9 @@ export class TransformPCDResponse extends jspb.Message {
   getPointCloudPcd_asB64(): string;
   setPointCloudPcd(value: Uint8Array | string): void;
 
+  getMimeType(): string;
+  setMimeType(value: string): void;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): TransformPCDResponse.AsObject;
   static toObject(includeInstance: boolean, msg: TransformPCDResponse): TransformPCDResponse.AsObject;
@@ -183,6 +190,7 @@ export class TransformPCDResponse extends jspb.Message {
 export namespace TransformPCDResponse {
   export type AsObject = {
     pointCloudPcd: Uint8Array | string,
+    mimeType: string,
   }
 }
 
@@ -1832,7 +1832,8 @@ proto.viam.robot.v1.TransformPCDRequest.toObject = function(includeInstance, msg
   var f, obj = {
     pointCloudPcd: msg.getPointCloudPcd_asB64(),
     source: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    destination: jspb.Message.getFieldWithDefault(msg, 3, "")
+    destination: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    mimeType: jspb.Message.getFieldWithDefault(msg, 4, "")
   };
 
   if (includeInstance) {
@@ -1881,6 +1882,10 @@ proto.viam.robot.v1.TransformPCDRequest.deserializeBinaryFromReader = function(m
       var value = /** @type {string} */ (reader.readString());
       msg.setDestination(value);
       break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMimeType(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1931,6 +1936,13 @@ proto.viam.robot.v1.TransformPCDRequest.serializeBinaryToWriter = function(messa
       f
     );
   }
+  f = message.getMimeType();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
 };
 
 
@@ -2012,6 +2024,24 @@ proto.viam.robot.v1.TransformPCDRequest.prototype.setDestination = function(valu
 };
 
 
+/**
+ * optional string mime_type = 4;
+ * @return {string}
+ */
+proto.viam.robot.v1.TransformPCDRequest.prototype.getMimeType = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.robot.v1.TransformPCDRequest} returns this
+ */
+proto.viam.robot.v1.TransformPCDRequest.prototype.setMimeType = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
 
 
 
@@ -2044,7 +2074,8 @@ proto.viam.robot.v1.TransformPCDResponse.prototype.toObject = function(opt_inclu
  */
 proto.viam.robot.v1.TransformPCDResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    pointCloudPcd: msg.getPointCloudPcd_asB64()
+    pointCloudPcd: msg.getPointCloudPcd_asB64(),
+    mimeType: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -2085,6 +2116,10 @@ proto.viam.robot.v1.TransformPCDResponse.deserializeBinaryFromReader = function(
       var value = /** @type {!Uint8Array} */ (reader.readBytes());
       msg.setPointCloudPcd(value);
       break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMimeType(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -2121,6 +2156,13 @@ proto.viam.robot.v1.TransformPCDResponse.serializeBinaryToWriter = function(mess
       f
     );
   }
+  f = message.getMimeType();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
@@ -2166,6 +2208,24 @@ proto.viam.robot.v1.TransformPCDResponse.prototype.setPointCloudPcd = function(v
 };
 
 
+/**
+ * optional string mime_type = 2;
+ * @return {string}
+ */
+proto.viam.robot.v1.TransformPCDResponse.prototype.getMimeType = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.robot.v1.TransformPCDResponse} returns this
+ */
+proto.viam.robot.v1.TransformPCDResponse.prototype.setMimeType = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
 
 
 
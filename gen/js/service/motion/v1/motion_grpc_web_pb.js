@@ -25,6 +25,8 @@ var common_v1_common_pb = require('../../../common/v1/common_pb.js')
 var google_api_annotations_pb = require('../../../google/api/annotations_pb.js')
 
 var google_protobuf_struct_pb = require('google-protobuf/google/protobuf/struct_pb.js')
+
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js')
 const proto = {};
 proto.viam = {};
 proto.viam.service = {};
@@ -266,6 +268,250 @@ proto.viam.service.motion.v1.MotionServicePromiseClient.prototype.moveOnGlobe =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.viam.service.motion.v1.PlanMoveRequest,
+ *   !proto.viam.service.motion.v1.PlanMoveResponse>}
+ */
+const methodDescriptor_MotionService_PlanMove = new grpc.web.MethodDescriptor(
+  '/viam.service.motion.v1.MotionService/PlanMove',
+  grpc.web.MethodType.UNARY,
+  proto.viam.service.motion.v1.PlanMoveRequest,
+  proto.viam.service.motion.v1.PlanMoveResponse,
+  /**
+   * @param {!proto.viam.service.motion.v1.PlanMoveRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.viam.service.motion.v1.PlanMoveResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.viam.service.motion.v1.PlanMoveRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.viam.service.motion.v1.PlanMoveResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.viam.service.motion.v1.PlanMoveResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.viam.service.motion.v1.MotionServiceClient.prototype.planMove =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/viam.service.motion.v1.MotionService/PlanMove',
+      request,
+      metadata || {},
+      methodDescriptor_MotionService_PlanMove,
+      callback);
+};
+
+
+/**
+ * @param {!proto.viam.service.motion.v1.PlanMoveRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.viam.service.motion.v1.PlanMoveResponse>}
+ *     Promise that resolves to the response
+ */
+proto.viam.service.motion.v1.MotionServicePromiseClient.prototype.planMove =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/viam.service.motion.v1.MotionService/PlanMove',
+      request,
+      metadata || {},
+      methodDescriptor_MotionService_PlanMove);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.viam.service.motion.v1.GetPlanRequest,
+ *   !proto.viam.service.motion.v1.GetPlanResponse>}
+ */
+const methodDescriptor_MotionService_GetPlan = new grpc.web.MethodDescriptor(
+  '/viam.service.motion.v1.MotionService/GetPlan',
+  grpc.web.MethodType.UNARY,
+  proto.viam.service.motion.v1.GetPlanRequest,
+  proto.viam.service.motion.v1.GetPlanResponse,
+  /**
+   * @param {!proto.viam.service.motion.v1.GetPlanRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.viam.service.motion.v1.GetPlanResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.viam.service.motion.v1.GetPlanRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.viam.service.motion.v1.GetPlanResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.viam.service.motion.v1.GetPlanResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.viam.service.motion.v1.MotionServiceClient.prototype.getPlan =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/viam.service.motion.v1.MotionService/GetPlan',
+      request,
+      metadata || {},
+      methodDescriptor_MotionService_GetPlan,
+      callback);
+};
+
+
+/**
+ * @param {!proto.viam.service.motion.v1.GetPlanRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.viam.service.motion.v1.GetPlanResponse>}
+ *     Promise that resolves to the response
+ */
+proto.viam.service.motion.v1.MotionServicePromiseClient.prototype.getPlan =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/viam.service.motion.v1.MotionService/GetPlan',
+      request,
+      metadata || {},
+      methodDescriptor_MotionService_GetPlan);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.viam.service.motion.v1.GetExecutionStatusRequest,
+ *   !proto.viam.service.motion.v1.GetExecutionStatusResponse>}
+ */
+const methodDescriptor_MotionService_GetExecutionStatus = new grpc.web.MethodDescriptor(
+  '/viam.service.motion.v1.MotionService/GetExecutionStatus',
+  grpc.web.MethodType.UNARY,
+  proto.viam.service.motion.v1.GetExecutionStatusRequest,
+  proto.viam.service.motion.v1.GetExecutionStatusResponse,
+  /**
+   * @param {!proto.viam.service.motion.v1.GetExecutionStatusRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.viam.service.motion.v1.GetExecutionStatusResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.viam.service.motion.v1.GetExecutionStatusRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.viam.service.motion.v1.GetExecutionStatusResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.viam.service.motion.v1.GetExecutionStatusResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.viam.service.motion.v1.MotionServiceClient.prototype.getExecutionStatus =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/viam.service.motion.v1.MotionService/GetExecutionStatus',
+      request,
+      metadata || {},
+      methodDescriptor_MotionService_GetExecutionStatus,
+      callback);
+};
+
+
+/**
+ * @param {!proto.viam.service.motion.v1.GetExecutionStatusRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.viam.service.motion.v1.GetExecutionStatusResponse>}
+ *     Promise that resolves to the response
+ */
+proto.viam.service.motion.v1.MotionServicePromiseClient.prototype.getExecutionStatus =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/viam.service.motion.v1.MotionService/GetExecutionStatus',
+      request,
+      metadata || {},
+      methodDescriptor_MotionService_GetExecutionStatus);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.viam.service.motion.v1.StopExecutionRequest,
+ *   !proto.viam.service.motion.v1.StopExecutionResponse>}
+ */
+const methodDescriptor_MotionService_StopExecution = new grpc.web.MethodDescriptor(
+  '/viam.service.motion.v1.MotionService/StopExecution',
+  grpc.web.MethodType.UNARY,
+  proto.viam.service.motion.v1.StopExecutionRequest,
+  proto.viam.service.motion.v1.StopExecutionResponse,
+  /**
+   * @param {!proto.viam.service.motion.v1.StopExecutionRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.viam.service.motion.v1.StopExecutionResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.viam.service.motion.v1.StopExecutionRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.viam.service.motion.v1.StopExecutionResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.viam.service.motion.v1.StopExecutionResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.viam.service.motion.v1.MotionServiceClient.prototype.stopExecution =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/viam.service.motion.v1.MotionService/StopExecution',
+      request,
+      metadata || {},
+      methodDescriptor_MotionService_StopExecution,
+      callback);
+};
+
+
+/**
+ * @param {!proto.viam.service.motion.v1.StopExecutionRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.viam.service.motion.v1.StopExecutionResponse>}
+ *     Promise that resolves to the response
+ */
+proto.viam.service.motion.v1.MotionServicePromiseClient.prototype.stopExecution =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/viam.service.motion.v1.MotionService/StopExecution',
+      request,
+      metadata || {},
+      methodDescriptor_MotionService_StopExecution);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
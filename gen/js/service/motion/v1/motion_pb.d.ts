@@ -5,6 +5,7 @@ import * as jspb from "google-protobuf";
 import * as common_v1_common_pb from "../../../common/v1/common_pb";
 import * as google_api_annotations_pb from "../../../google/api/annotations_pb";
 import * as google_protobuf_struct_pb from "google-protobuf/google/protobuf/struct_pb";
+import * as google_protobuf_timestamp_pb from "google-protobuf/google/protobuf/timestamp_pb";
 
 export class MoveRequest extends jspb.Message {
   getName(): string;
@@ -30,6 +31,9 @@ export class MoveRequest extends jspb.Message {
   getConstraints(): Constraints | undefined;
   setConstraints(value?: Constraints): void;
 
+  getReturnExecutionId(): boolean;
+  setReturnExecutionId(value: boolean): void;
+
   hasExtra(): boolean;
   clearExtra(): void;
   getExtra(): google_protobuf_struct_pb.Struct | undefined;
@@ -52,6 +56,7 @@ export namespace MoveRequest {
     componentName?: common_v1_common_pb.ResourceName.AsObject,
     worldState?: common_v1_common_pb.WorldState.AsObject,
     constraints?: Constraints.AsObject,
+    returnExecutionId: boolean,
     extra?: google_protobuf_struct_pb.Struct.AsObject,
   }
 }
@@ -60,6 +65,14 @@ export class MoveResponse extends jspb.Message {
   getSuccess(): boolean;
   setSuccess(value: boolean): void;
 
+  getExecutionId(): string;
+  setExecutionId(value: string): void;
+
+  hasPlanningFailure(): boolean;
+  clearPlanningFailure(): void;
+  getPlanningFailure(): PlanningFailure | undefined;
+  setPlanningFailure(value?: PlanningFailure): void;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): MoveResponse.AsObject;
   static toObject(includeInstance: boolean, msg: MoveResponse): MoveResponse.AsObject;
@@ -73,6 +86,86 @@ export class MoveResponse extends jspb.Message {
 export namespace MoveResponse {
   export type AsObject = {
     success: boolean,
+    executionId: string,
+    planningFailure?: PlanningFailure.AsObject,
+  }
+}
+
+export class PlanMoveRequest extends jspb.Message {
+  getName(): string;
+  setName(value: string): void;
+
+  hasDestination(): boolean;
+  clearDestination(): void;
+  getDestination(): common_v1_common_pb.PoseInFrame | undefined;
+  setDestination(value?: common_v1_common_pb.PoseInFrame): void;
+
+  hasComponentName(): boolean;
+  clearComponentName(): void;
+  getComponentName(): common_v1_common_pb.ResourceName | undefined;
+  setComponentName(value?: common_v1_common_pb.ResourceName): void;
+
+  hasWorldState(): boolean;
+  clearWorldState(): void;
+  getWorldState(): common_v1_common_pb.WorldState | undefined;
+  setWorldState(value?: common_v1_common_pb.WorldState): void;
+
+  hasConstraints(): boolean;
+  clearConstraints(): void;
+  getConstraints(): Constraints | undefined;
+  setConstraints(value?: Constraints): void;
+
+  hasExtra(): boolean;
+  clearExtra(): void;
+  getExtra(): google_protobuf_struct_pb.Struct | undefined;
+  setExtra(value?: google_protobuf_struct_pb.Struct): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PlanMoveRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: PlanMoveRequest): PlanMoveRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: PlanMoveRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PlanMoveRequest;
+  static deserializeBinaryFromReader(message: PlanMoveRequest, reader: jspb.BinaryReader): PlanMoveRequest;
+}
+
+export namespace PlanMoveRequest {
+  export type AsObject = {
+    name: string,
+    destination?: common_v1_common_pb.PoseInFrame.AsObject,
+    componentName?: common_v1_common_pb.ResourceName.AsObject,
+    worldState?: common_v1_common_pb.WorldState.AsObject,
+    constraints?: Constraints.AsObject,
+    extra?: google_protobuf_struct_pb.Struct.AsObject,
+  }
+}
+
+export class PlanMoveResponse extends jspb.Message {
+  hasPlan(): boolean;
+  clearPlan(): void;
+  getPlan(): Plan | undefined;
+  setPlan(value?: Plan): void;
+
+  hasPlanningFailure(): boolean;
+  clearPlanningFailure(): void;
+  getPlanningFailure(): PlanningFailure | undefined;
+  setPlanningFailure(value?: PlanningFailure): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PlanMoveResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: PlanMoveResponse): PlanMoveResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: PlanMoveResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PlanMoveResponse;
+  static deserializeBinaryFromReader(message: PlanMoveResponse, reader: jspb.BinaryReader): PlanMoveResponse;
+}
+
+export namespace PlanMoveResponse {
+  export type AsObject = {
+    plan?: Plan.AsObject,
+    planningFailure?: PlanningFailure.AsObject,
   }
 }
 
@@ -95,6 +188,9 @@ export class MoveOnMapRequest extends jspb.Message {
   getSlamServiceName(): common_v1_common_pb.ResourceName | undefined;
   setSlamServiceName(value?: common_v1_common_pb.ResourceName): void;
 
+  getReturnExecutionId(): boolean;
+  setReturnExecutionId(value: boolean): void;
+
   hasExtra(): boolean;
   clearExtra(): void;
   getExtra(): google_protobuf_struct_pb.Struct | undefined;
@@ -116,6 +212,7 @@ export namespace MoveOnMapRequest {
     destination?: common_v1_common_pb.Pose.AsObject,
     componentName?: common_v1_common_pb.ResourceName.AsObject,
     slamServiceName?: common_v1_common_pb.ResourceName.AsObject,
+    returnExecutionId: boolean,
     extra?: google_protobuf_struct_pb.Struct.AsObject,
   }
 }
@@ -124,6 +221,14 @@ export class MoveOnMapResponse extends jspb.Message {
   getSuccess(): boolean;
   setSuccess(value: boolean): void;
 
+  getExecutionId(): string;
+  setExecutionId(value: string): void;
+
+  hasPlanningFailure(): boolean;
+  clearPlanningFailure(): void;
+  getPlanningFailure(): PlanningFailure | undefined;
+  setPlanningFailure(value?: PlanningFailure): void;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): MoveOnMapResponse.AsObject;
   static toObject(includeInstance: boolean, msg: MoveOnMapResponse): MoveOnMapResponse.AsObject;
@@ -137,6 +242,8 @@ export class MoveOnMapResponse extends jspb.Message {
 export namespace MoveOnMapResponse {
   export type AsObject = {
     success: boolean,
+    executionId: string,
+    planningFailure?: PlanningFailure.AsObject,
   }
 }
 
@@ -226,6 +333,9 @@ export class MoveOnGlobeRequest extends jspb.Message {
   getMotionConfiguration(): MotionConfiguration | undefined;
   setMotionConfiguration(value?: MotionConfiguration): void;
 
+  getReturnExecutionId(): boolean;
+  setReturnExecutionId(value: boolean): void;
+
   hasExtra(): boolean;
   clearExtra(): void;
   getExtra(): google_protobuf_struct_pb.Struct | undefined;
@@ -250,6 +360,7 @@ export namespace MoveOnGlobeRequest {
     movementSensorName?: common_v1_common_pb.ResourceName.AsObject,
     obstaclesList: Array<common_v1_common_pb.GeoObstacle.AsObject>,
     motionConfiguration?: MotionConfiguration.AsObject,
+    returnExecutionId: boolean,
     extra?: google_protobuf_struct_pb.Struct.AsObject,
   }
 }
@@ -258,6 +369,14 @@ export class MoveOnGlobeResponse extends jspb.Message {
   getSuccess(): boolean;
   setSuccess(value: boolean): void;
 
+  getExecutionId(): string;
+  setExecutionId(value: string): void;
+
+  hasPlanningFailure(): boolean;
+  clearPlanningFailure(): void;
+  getPlanningFailure(): PlanningFailure | undefined;
+  setPlanningFailure(value?: PlanningFailure): void;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): MoveOnGlobeResponse.AsObject;
   static toObject(includeInstance: boolean, msg: MoveOnGlobeResponse): MoveOnGlobeResponse.AsObject;
@@ -271,6 +390,8 @@ export class MoveOnGlobeResponse extends jspb.Message {
 export namespace MoveOnGlobeResponse {
   export type AsObject = {
     success: boolean,
+    executionId: string,
+    planningFailure?: PlanningFailure.AsObject,
   }
 }
 
@@ -338,6 +459,351 @@ export namespace GetPoseResponse {
   }
 }
 
+export class GetPlanRequest extends jspb.Message {
+  getName(): string;
+  setName(value: string): void;
+
+  getExecutionId(): string;
+  setExecutionId(value: string): void;
+
+  getIncludeReplans(): boolean;
+  setIncludeReplans(value: boolean): void;
+
+  hasExtra(): boolean;
+  clearExtra(): void;
+  getExtra(): google_protobuf_struct_pb.Struct | undefined;
+  setExtra(value?: google_protobuf_struct_pb.Struct): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetPlanRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: GetPlanRequest): GetPlanRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetPlanRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetPlanRequest;
+  static deserializeBinaryFromReader(message: GetPlanRequest, reader: jspb.BinaryReader): GetPlanRequest;
+}
+
+export namespace GetPlanRequest {
+  export type AsObject = {
+    name: string,
+    executionId: string,
+    includeReplans: boolean,
+    extra?: google_protobuf_struct_pb.Struct.AsObject,
+  }
+}
+
+export class GetPlanResponse extends jspb.Message {
+  hasCurrentPlan(): boolean;
+  clearCurrentPlan(): void;
+  getCurrentPlan(): Plan | undefined;
+  setCurrentPlan(value?: Plan): void;
+
+  hasStatus(): boolean;
+  clearStatus(): void;
+  getStatus(): ExecutionStatus | undefined;
+  setStatus(value?: ExecutionStatus): void;
+
+  clearReplansList(): void;
+  getReplansList(): Array<Plan>;
+  setReplansList(value: Array<Plan>): void;
+  addReplans(value?: Plan, index?: number): Plan;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetPlanResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetPlanResponse): GetPlanResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetPlanResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetPlanResponse;
+  static deserializeBinaryFromReader(message: GetPlanResponse, reader: jspb.BinaryReader): GetPlanResponse;
+}
+
+export namespace GetPlanResponse {
+  export type AsObject = {
+    currentPlan?: Plan.AsObject,
+    status?: ExecutionStatus.AsObject,
+    replansList: Array<Plan.AsObject>,
+  }
+}
+
+export class GetExecutionStatusRequest extends jspb.Message {
+  getName(): string;
+  setName(value: string): void;
+
+  getExecutionId(): string;
+  setExecutionId(value: string): void;
+
+  hasExtra(): boolean;
+  clearExtra(): void;
+  getExtra(): google_protobuf_struct_pb.Struct | undefined;
+  setExtra(value?: google_protobuf_struct_pb.Struct): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetExecutionStatusRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: GetExecutionStatusRequest): GetExecutionStatusRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetExecutionStatusRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetExecutionStatusRequest;
+  static deserializeBinaryFromReader(message: GetExecutionStatusRequest, reader: jspb.BinaryReader): GetExecutionStatusRequest;
+}
+
+export namespace GetExecutionStatusRequest {
+  export type AsObject = {
+    name: string,
+    executionId: string,
+    extra?: google_protobuf_struct_pb.Struct.AsObject,
+  }
+}
+
+export class GetExecutionStatusResponse extends jspb.Message {
+  hasStatus(): boolean;
+  clearStatus(): void;
+  getStatus(): ExecutionStatus | undefined;
+  setStatus(value?: ExecutionStatus): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetExecutionStatusResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetExecutionStatusResponse): GetExecutionStatusResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetExecutionStatusResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetExecutionStatusResponse;
+  static deserializeBinaryFromReader(message: GetExecutionStatusResponse, reader: jspb.BinaryReader): GetExecutionStatusResponse;
+}
+
+export namespace GetExecutionStatusResponse {
+  export type AsObject = {
+    status?: ExecutionStatus.AsObject,
+  }
+}
+
+export class StopExecutionRequest extends jspb.Message {
+  getName(): string;
+  setName(value: string): void;
+
+  getExecutionId(): string;
+  setExecutionId(value: string): void;
+
+  hasExtra(): boolean;
+  clearExtra(): void;
+  getExtra(): google_protobuf_struct_pb.Struct | undefined;
+  setExtra(value?: google_protobuf_struct_pb.Struct): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StopExecutionRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: StopExecutionRequest): StopExecutionRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: StopExecutionRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StopExecutionRequest;
+  static deserializeBinaryFromReader(message: StopExecutionRequest, reader: jspb.BinaryReader): StopExecutionRequest;
+}
+
+export namespace StopExecutionRequest {
+  export type AsObject = {
+    name: string,
+    executionId: string,
+    extra?: google_protobuf_struct_pb.Struct.AsObject,
+  }
+}
+
+export class StopExecutionResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StopExecutionResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: StopExecutionResponse): StopExecutionResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: StopExecutionResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StopExecutionResponse;
+  static deserializeBinaryFromReader(message: StopExecutionResponse, reader: jspb.BinaryReader): StopExecutionResponse;
+}
+
+export namespace StopExecutionResponse {
+  export type AsObject = {
+  }
+}
+
+export class Plan extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getExecutionId(): string;
+  setExecutionId(value: string): void;
+
+  hasComponentName(): boolean;
+  clearComponentName(): void;
+  getComponentName(): common_v1_common_pb.ResourceName | undefined;
+  setComponentName(value?: common_v1_common_pb.ResourceName): void;
+
+  clearStepsList(): void;
+  getStepsList(): Array<PlanStep>;
+  setStepsList(value: Array<PlanStep>): void;
+  addSteps(value?: PlanStep, index?: number): PlanStep;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Plan.AsObject;
+  static toObject(includeInstance: boolean, msg: Plan): Plan.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: Plan, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Plan;
+  static deserializeBinaryFromReader(message: Plan, reader: jspb.BinaryReader): Plan;
+}
+
+export namespace Plan {
+  export type AsObject = {
+    id: string,
+    executionId: string,
+    componentName?: common_v1_common_pb.ResourceName.AsObject,
+    stepsList: Array<PlanStep.AsObject>,
+  }
+}
+
+export class PlanStep extends jspb.Message {
+  getStepMap(): jspb.Map<string, ComponentState>;
+  clearStepMap(): void;
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PlanStep.AsObject;
+  static toObject(includeInstance: boolean, msg: PlanStep): PlanStep.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: PlanStep, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PlanStep;
+  static deserializeBinaryFromReader(message: PlanStep, reader: jspb.BinaryReader): PlanStep;
+}
+
+export namespace PlanStep {
+  export type AsObject = {
+    stepMap: Array<[string, ComponentState.AsObject]>,
+  }
+}
+
+export class ComponentState extends jspb.Message {
+  hasPose(): boolean;
+  clearPose(): void;
+  getPose(): common_v1_common_pb.Pose | undefined;
+  setPose(value?: common_v1_common_pb.Pose): void;
+
+  clearInputsList(): void;
+  getInputsList(): Array<number>;
+  setInputsList(value: Array<number>): void;
+  addInputs(value: number, index?: number): number;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ComponentState.AsObject;
+  static toObject(includeInstance: boolean, msg: ComponentState): ComponentState.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ComponentState, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ComponentState;
+  static deserializeBinaryFromReader(message: ComponentState, reader: jspb.BinaryReader): ComponentState;
+}
+
+export namespace ComponentState {
+  export type AsObject = {
+    pose?: common_v1_common_pb.Pose.AsObject,
+    inputsList: Array<number>,
+  }
+}
+
+export class ExecutionStatus extends jspb.Message {
+  getExecutionId(): string;
+  setExecutionId(value: string): void;
+
+  hasComponentName(): boolean;
+  clearComponentName(): void;
+  getComponentName(): common_v1_common_pb.ResourceName | undefined;
+  setComponentName(value?: common_v1_common_pb.ResourceName): void;
+
+  getState(): ExecutionStateMap[keyof ExecutionStateMap];
+  setState(value: ExecutionStateMap[keyof ExecutionStateMap]): void;
+
+  getPlanId(): string;
+  setPlanId(value: string): void;
+
+  getCurrentStepIndex(): number;
+  setCurrentStepIndex(value: number): void;
+
+  getReplanCount(): number;
+  setReplanCount(value: number): void;
+
+  hasReason(): boolean;
+  clearReason(): void;
+  getReason(): string;
+  setReason(value: string): void;
+
+  hasTimestamp(): boolean;
+  clearTimestamp(): void;
+  getTimestamp(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setTimestamp(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  hasPlanningFailure(): boolean;
+  clearPlanningFailure(): void;
+  getPlanningFailure(): PlanningFailure | undefined;
+  setPlanningFailure(value?: PlanningFailure): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ExecutionStatus.AsObject;
+  static toObject(includeInstance: boolean, msg: ExecutionStatus): ExecutionStatus.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ExecutionStatus, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ExecutionStatus;
+  static deserializeBinaryFromReader(message: ExecutionStatus, reader: jspb.BinaryReader): ExecutionStatus;
+}
+
+export namespace ExecutionStatus {
+  export type AsObject = {
+    executionId: string,
+    componentName?: common_v1_common_pb.ResourceName.AsObject,
+    state: ExecutionStateMap[keyof ExecutionStateMap],
+    planId: string,
+    currentStepIndex: number,
+    replanCount: number,
+    reason: string,
+    timestamp?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    planningFailure?: PlanningFailure.AsObject,
+  }
+}
+
+export class PlanningFailure extends jspb.Message {
+  getReason(): PlanningFailureReasonMap[keyof PlanningFailureReasonMap];
+  setReason(value: PlanningFailureReasonMap[keyof PlanningFailureReasonMap]): void;
+
+  getDescription(): string;
+  setDescription(value: string): void;
+
+  clearFramesList(): void;
+  getFramesList(): Array<string>;
+  setFramesList(value: Array<string>): void;
+  addFrames(value: string, index?: number): string;
+
+  clearGeometriesList(): void;
+  getGeometriesList(): Array<common_v1_common_pb.GeometriesInFrame>;
+  setGeometriesList(value: Array<common_v1_common_pb.GeometriesInFrame>): void;
+  addGeometries(value?: common_v1_common_pb.GeometriesInFrame, index?: number): common_v1_common_pb.GeometriesInFrame;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PlanningFailure.AsObject;
+  static toObject(includeInstance: boolean, msg: PlanningFailure): PlanningFailure.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: PlanningFailure, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PlanningFailure;
+  static deserializeBinaryFromReader(message: PlanningFailure, reader: jspb.BinaryReader): PlanningFailure;
+}
+
+export namespace PlanningFailure {
+  export type AsObject = {
+    reason: PlanningFailureReasonMap[keyof PlanningFailureReasonMap],
+    description: string,
+    framesList: Array<string>,
+    geometriesList: Array<common_v1_common_pb.GeometriesInFrame.AsObject>,
+  }
+}
+
 export class Constraints extends jspb.Message {
   clearLinearConstraintList(): void;
   getLinearConstraintList(): Array<LinearConstraint>;
@@ -354,6 +820,26 @@ export class Constraints extends jspb.Message {
   setCollisionSpecificationList(value: Array<CollisionSpecification>): void;
   addCollisionSpecification(value?: CollisionSpecification, index?: number): CollisionSpecification;
 
+  clearPseudolinearConstraintList(): void;
+  getPseudolinearConstraintList(): Array<PseudolinearConstraint>;
+  setPseudolinearConstraintList(value: Array<PseudolinearConstraint>): void;
+  addPseudolinearConstraint(value?: PseudolinearConstraint, index?: number): PseudolinearConstraint;
+
+  clearKeepOutZoneConstraintList(): void;
+  getKeepOutZoneConstraintList(): Array<KeepOutZoneConstraint>;
+  setKeepOutZoneConstraintList(value: Array<KeepOutZoneConstraint>): void;
+  addKeepOutZoneConstraint(value?: KeepOutZoneConstraint, index?: number): KeepOutZoneConstraint;
+
+  clearJointRangeConstraintList(): void;
+  getJointRangeConstraintList(): Array<JointRangeConstraint>;
+  setJointRangeConstraintList(value: Array<JointRangeConstraint>): void;
+  addJointRangeConstraint(value?: JointRangeConstraint, index?: number): JointRangeConstraint;
+
+  clearEndEffectorSpeedConstraintList(): void;
+  getEndEffectorSpeedConstraintList(): Array<EndEffectorSpeedConstraint>;
+  setEndEffectorSpeedConstraintList(value: Array<EndEffectorSpeedConstraint>): void;
+  addEndEffectorSpeedConstraint(value?: EndEffectorSpeedConstraint, index?: number): EndEffectorSpeedConstraint;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): Constraints.AsObject;
   static toObject(includeInstance: boolean, msg: Constraints): Constraints.AsObject;
@@ -369,6 +855,10 @@ export namespace Constraints {
     linearConstraintList: Array<LinearConstraint.AsObject>,
     orientationConstraintList: Array<OrientationConstraint.AsObject>,
     collisionSpecificationList: Array<CollisionSpecification.AsObject>,
+    pseudolinearConstraintList: Array<PseudolinearConstraint.AsObject>,
+    keepOutZoneConstraintList: Array<KeepOutZoneConstraint.AsObject>,
+    jointRangeConstraintList: Array<JointRangeConstraint.AsObject>,
+    endEffectorSpeedConstraintList: Array<EndEffectorSpeedConstraint.AsObject>,
   }
 }
 
@@ -468,3 +958,166 @@ export namespace CollisionSpecification {
   }
 }
 
+export class PseudolinearConstraint extends jspb.Message {
+  hasLineToleranceFactor(): boolean;
+  clearLineToleranceFactor(): void;
+  getLineToleranceFactor(): number;
+  setLineToleranceFactor(value: number): void;
+
+  hasOrientationToleranceFactor(): boolean;
+  clearOrientationToleranceFactor(): void;
+  getOrientationToleranceFactor(): number;
+  setOrientationToleranceFactor(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PseudolinearConstraint.AsObject;
+  static toObject(includeInstance: boolean, msg: PseudolinearConstraint): PseudolinearConstraint.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: PseudolinearConstraint, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PseudolinearConstraint;
+  static deserializeBinaryFromReader(message: PseudolinearConstraint, reader: jspb.BinaryReader): PseudolinearConstraint;
+}
+
+export namespace PseudolinearConstraint {
+  export type AsObject = {
+    lineToleranceFactor: number,
+    orientationToleranceFactor: number,
+  }
+}
+
+export class KeepOutZoneConstraint extends jspb.Message {
+  hasZones(): boolean;
+  clearZones(): void;
+  getZones(): common_v1_common_pb.GeometriesInFrame | undefined;
+  setZones(value?: common_v1_common_pb.GeometriesInFrame): void;
+
+  clearAllowedFramesList(): void;
+  getAllowedFramesList(): Array<string>;
+  setAllowedFramesList(value: Array<string>): void;
+  addAllowedFrames(value: string, index?: number): string;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): KeepOutZoneConstraint.AsObject;
+  static toObject(includeInstance: boolean, msg: KeepOutZoneConstraint): KeepOutZoneConstraint.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: KeepOutZoneConstraint, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): KeepOutZoneConstraint;
+  static deserializeBinaryFromReader(message: KeepOutZoneConstraint, reader: jspb.BinaryReader): KeepOutZoneConstraint;
+}
+
+export namespace KeepOutZoneConstraint {
+  export type AsObject = {
+    zones?: common_v1_common_pb.GeometriesInFrame.AsObject,
+    allowedFramesList: Array<string>,
+  }
+}
+
+export class JointRangeConstraint extends jspb.Message {
+  hasComponentName(): boolean;
+  clearComponentName(): void;
+  getComponentName(): common_v1_common_pb.ResourceName | undefined;
+  setComponentName(value?: common_v1_common_pb.ResourceName): void;
+
+  clearRangesList(): void;
+  getRangesList(): Array<JointRange>;
+  setRangesList(value: Array<JointRange>): void;
+  addRanges(value?: JointRange, index?: number): JointRange;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): JointRangeConstraint.AsObject;
+  static toObject(includeInstance: boolean, msg: JointRangeConstraint): JointRangeConstraint.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: JointRangeConstraint, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): JointRangeConstraint;
+  static deserializeBinaryFromReader(message: JointRangeConstraint, reader: jspb.BinaryReader): JointRangeConstraint;
+}
+
+export namespace JointRangeConstraint {
+  export type AsObject = {
+    componentName?: common_v1_common_pb.ResourceName.AsObject,
+    rangesList: Array<JointRange.AsObject>,
+  }
+}
+
+export class JointRange extends jspb.Message {
+  hasMin(): boolean;
+  clearMin(): void;
+  getMin(): number;
+  setMin(value: number): void;
+
+  hasMax(): boolean;
+  clearMax(): void;
+  getMax(): number;
+  setMax(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): JointRange.AsObject;
+  static toObject(includeInstance: boolean, msg: JointRange): JointRange.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: JointRange, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): JointRange;
+  static deserializeBinaryFromReader(message: JointRange, reader: jspb.BinaryReader): JointRange;
+}
+
+export namespace JointRange {
+  export type AsObject = {
+    min: number,
+    max: number,
+  }
+}
+
+export class EndEffectorSpeedConstraint extends jspb.Message {
+  hasMaxLinearMmPerSec(): boolean;
+  clearMaxLinearMmPerSec(): void;
+  getMaxLinearMmPerSec(): number;
+  setMaxLinearMmPerSec(value: number): void;
+
+  hasMaxAngularDegsPerSec(): boolean;
+  clearMaxAngularDegsPerSec(): void;
+  getMaxAngularDegsPerSec(): number;
+  setMaxAngularDegsPerSec(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): EndEffectorSpeedConstraint.AsObject;
+  static toObject(includeInstance: boolean, msg: EndEffectorSpeedConstraint): EndEffectorSpeedConstraint.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: EndEffectorSpeedConstraint, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): EndEffectorSpeedConstraint;
+  static deserializeBinaryFromReader(message: EndEffectorSpeedConstraint, reader: jspb.BinaryReader): EndEffectorSpeedConstraint;
+}
+
+export namespace EndEffectorSpeedConstraint {
+  export type AsObject = {
+    maxLinearMmPerSec: number,
+    maxAngularDegsPerSec: number,
+  }
+}
+
+export interface ExecutionStateMap {
+  EXECUTION_STATE_UNSPECIFIED: 0;
+  EXECUTION_STATE_PLANNING: 1;
+  EXECUTION_STATE_IN_PROGRESS: 2;
+  EXECUTION_STATE_STOPPED: 3;
+  EXECUTION_STATE_SUCCEEDED: 4;
+  EXECUTION_STATE_FAILED: 5;
+}
+
+export const ExecutionState: ExecutionStateMap;
+
+export interface PlanningFailureReasonMap {
+  PLANNING_FAILURE_REASON_UNSPECIFIED: 0;
+  PLANNING_FAILURE_REASON_GOAL_UNREACHABLE: 1;
+  PLANNING_FAILURE_REASON_COLLISION_AT_START: 2;
+  PLANNING_FAILURE_REASON_COLLISION_AT_GOAL: 3;
+  PLANNING_FAILURE_REASON_CONSTRAINT_INFEASIBLE: 4;
+  PLANNING_FAILURE_REASON_TIMEOUT: 5;
+  PLANNING_FAILURE_REASON_IK_FAILURE: 6;
+}
+
+export const PlanningFailureReason: PlanningFailureReasonMap;
+
@@ -21,11 +21,24 @@ var google_api_annotations_pb = require('../../../google/api/annotations_pb.js')
 goog.object.extend(proto, google_api_annotations_pb);
 var google_protobuf_struct_pb = require('google-protobuf/google/protobuf/struct_pb.js');
 goog.object.extend(proto, google_protobuf_struct_pb);
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
+goog.object.extend(proto, google_protobuf_timestamp_pb);
 goog.exportSymbol('proto.viam.service.motion.v1.CollisionSpecification', null, global);
 goog.exportSymbol('proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions', null, global);
+goog.exportSymbol('proto.viam.service.motion.v1.ComponentState', null, global);
 goog.exportSymbol('proto.viam.service.motion.v1.Constraints', null, global);
+goog.exportSymbol('proto.viam.service.motion.v1.EndEffectorSpeedConstraint', null, global);
+goog.exportSymbol('proto.viam.service.motion.v1.ExecutionState', null, global);
+goog.exportSymbol('proto.viam.service.motion.v1.ExecutionStatus', null, global);
+goog.exportSymbol('proto.viam.service.motion.v1.GetExecutionStatusRequest', null, global);
+goog.exportSymbol('proto.viam.service.motion.v1.GetExecutionStatusResponse', null, global);
+goog.exportSymbol('proto.viam.service.motion.v1.GetPlanRequest', null, global);
+goog.exportSymbol('proto.viam.service.motion.v1.GetPlanResponse', null, global);
 goog.exportSymbol('proto.viam.service.motion.v1.GetPoseRequest', null, global);
 goog.exportSymbol('proto.viam.service.motion.v1.GetPoseResponse', null, global);
+goog.exportSymbol('proto.viam.service.motion.v1.JointRange', null, global);
+goog.exportSymbol('proto.viam.service.motion.v1.JointRangeConstraint', null, global);
+goog.exportSymbol('proto.viam.service.motion.v1.KeepOutZoneConstraint', null, global);
 goog.exportSymbol('proto.viam.service.motion.v1.LinearConstraint', null, global);
 goog.exportSymbol('proto.viam.service.motion.v1.MotionConfiguration', null, global);
 goog.exportSymbol('proto.viam.service.motion.v1.MoveOnGlobeRequest', null, global);
@@ -35,6 +48,15 @@ goog.exportSymbol('proto.viam.service.motion.v1.MoveOnMapResponse', null, global
 goog.exportSymbol('proto.viam.service.motion.v1.MoveRequest', null, global);
 goog.exportSymbol('proto.viam.service.motion.v1.MoveResponse', null, global);
 goog.exportSymbol('proto.viam.service.motion.v1.OrientationConstraint', null, global);
+goog.exportSymbol('proto.viam.service.motion.v1.Plan', null, global);
+goog.exportSymbol('proto.viam.service.motion.v1.PlanMoveRequest', null, global);
+goog.exportSymbol('proto.viam.service.motion.v1.PlanMoveResponse', null, global);
+goog.exportSymbol('proto.viam.service.motion.v1.PlanStep', null, global);
+goog.exportSymbol('proto.viam.service.motion.v1.PlanningFailure', null, global);
+goog.exportSymbol('proto.viam.service.motion.v1.PlanningFailureReason', null, global);
+goog.exportSymbol('proto.viam.service.motion.v1.PseudolinearConstraint', null, global);
+goog.exportSymbol('proto.viam.service.motion.v1.StopExecutionRequest', null, global);
+goog.exportSymbol('proto.viam.service.motion.v1.StopExecutionResponse', null, global);
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -77,6 +99,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.viam.service.motion.v1.MoveResponse.displayName = 'proto.viam.service.motion.v1.MoveResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.service.motion.v1.PlanMoveRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.service.motion.v1.PlanMoveRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.service.motion.v1.PlanMoveRequest.displayName = 'proto.viam.service.motion.v1.PlanMoveRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.service.motion.v1.PlanMoveResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.service.motion.v1.PlanMoveResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.service.motion.v1.PlanMoveResponse.displayName = 'proto.viam.service.motion.v1.PlanMoveResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -224,6 +288,237 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.viam.service.motion.v1.GetPoseResponse.displayName = 'proto.viam.service.motion.v1.GetPoseResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.service.motion.v1.GetPlanRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.service.motion.v1.GetPlanRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.service.motion.v1.GetPlanRequest.displayName = 'proto.viam.service.motion.v1.GetPlanRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.service.motion.v1.GetPlanResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.viam.service.motion.v1.GetPlanResponse.repeatedFields_, null);
+};
+goog.inherits(proto.viam.service.motion.v1.GetPlanResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.service.motion.v1.GetPlanResponse.displayName = 'proto.viam.service.motion.v1.GetPlanResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.service.motion.v1.GetExecutionStatusRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.service.motion.v1.GetExecutionStatusRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.service.motion.v1.GetExecutionStatusRequest.displayName = 'proto.viam.service.motion.v1.GetExecutionStatusRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.service.motion.v1.GetExecutionStatusResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.service.motion.v1.GetExecutionStatusResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.service.motion.v1.GetExecutionStatusResponse.displayName = 'proto.viam.service.motion.v1.GetExecutionStatusResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.service.motion.v1.StopExecutionRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.service.motion.v1.StopExecutionRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.service.motion.v1.StopExecutionRequest.displayName = 'proto.viam.service.motion.v1.StopExecutionRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.service.motion.v1.StopExecutionResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.service.motion.v1.StopExecutionResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.service.motion.v1.StopExecutionResponse.displayName = 'proto.viam.service.motion.v1.StopExecutionResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.service.motion.v1.Plan = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.viam.service.motion.v1.Plan.repeatedFields_, null);
+};
+goog.inherits(proto.viam.service.motion.v1.Plan, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.service.motion.v1.Plan.displayName = 'proto.viam.service.motion.v1.Plan';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.service.motion.v1.PlanStep = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.service.motion.v1.PlanStep, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.service.motion.v1.PlanStep.displayName = 'proto.viam.service.motion.v1.PlanStep';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.service.motion.v1.ComponentState = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.viam.service.motion.v1.ComponentState.repeatedFields_, null);
+};
+goog.inherits(proto.viam.service.motion.v1.ComponentState, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.service.motion.v1.ComponentState.displayName = 'proto.viam.service.motion.v1.ComponentState';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.service.motion.v1.ExecutionStatus = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.service.motion.v1.ExecutionStatus, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.service.motion.v1.ExecutionStatus.displayName = 'proto.viam.service.motion.v1.ExecutionStatus';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.service.motion.v1.PlanningFailure = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.viam.service.motion.v1.PlanningFailure.repeatedFields_, null);
+};
+goog.inherits(proto.viam.service.motion.v1.PlanningFailure, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.service.motion.v1.PlanningFailure.displayName = 'proto.viam.service.motion.v1.PlanningFailure';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -329,26 +624,131 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.displayName = 'proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions';
 }
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.viam.service.motion.v1.MoveRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.service.motion.v1.MoveRequest.toObject(opt_includeInstance, this);
+proto.viam.service.motion.v1.PseudolinearConstraint = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
+goog.inherits(proto.viam.service.motion.v1.PseudolinearConstraint, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.service.motion.v1.PseudolinearConstraint.displayName = 'proto.viam.service.motion.v1.PseudolinearConstraint';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.service.motion.v1.KeepOutZoneConstraint = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.viam.service.motion.v1.KeepOutZoneConstraint.repeatedFields_, null);
+};
+goog.inherits(proto.viam.service.motion.v1.KeepOutZoneConstraint, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.service.motion.v1.KeepOutZoneConstraint.displayName = 'proto.viam.service.motion.v1.KeepOutZoneConstraint';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.service.motion.v1.JointRangeConstraint = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.viam.service.motion.v1.JointRangeConstraint.repeatedFields_, null);
+};
+goog.inherits(proto.viam.service.motion.v1.JointRangeConstraint, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.service.motion.v1.JointRangeConstraint.displayName = 'proto.viam.service.motion.v1.JointRangeConstraint';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.service.motion.v1.JointRange = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.service.motion.v1.JointRange, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.service.motion.v1.JointRange.displayName = 'proto.viam.service.motion.v1.JointRange';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.service.motion.v1.EndEffectorSpeedConstraint = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.service.motion.v1.EndEffectorSpeedConstraint, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.service.motion.v1.EndEffectorSpeedConstraint.displayName = 'proto.viam.service.motion.v1.EndEffectorSpeedConstraint';
+}
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.motion.v1.MoveRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.motion.v1.MoveRequest.toObject(opt_includeInstance, this);
+};
+
 
 /**
  * Static version of the {@see toObject} method.
@@ -366,6 +766,7 @@ proto.viam.service.motion.v1.MoveRequest.toObject = function(includeInstance, ms
     componentName: (f = msg.getComponentName()) && common_v1_common_pb.ResourceName.toObject(includeInstance, f),
     worldState: (f = msg.getWorldState()) && common_v1_common_pb.WorldState.toObject(includeInstance, f),
     constraints: (f = msg.getConstraints()) && proto.viam.service.motion.v1.Constraints.toObject(includeInstance, f),
+    returnExecutionId: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
     extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
   };
 
@@ -427,6 +828,10 @@ proto.viam.service.motion.v1.MoveRequest.deserializeBinaryFromReader = function(
       reader.readMessage(value,proto.viam.service.motion.v1.Constraints.deserializeBinaryFromReader);
       msg.setConstraints(value);
       break;
+    case 6:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setReturnExecutionId(value);
+      break;
     case 99:
       var value = new google_protobuf_struct_pb.Struct;
       reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
@@ -500,6 +905,13 @@ proto.viam.service.motion.v1.MoveRequest.serializeBinaryToWriter = function(mess
       proto.viam.service.motion.v1.Constraints.serializeBinaryToWriter
     );
   }
+  f = message.getReturnExecutionId();
+  if (f) {
+    writer.writeBool(
+      6,
+      f
+    );
+  }
   f = message.getExtra();
   if (f != null) {
     writer.writeMessage(
@@ -677,6 +1089,24 @@ proto.viam.service.motion.v1.MoveRequest.prototype.hasConstraints = function() {
 };
 
 
+/**
+ * optional bool return_execution_id = 6;
+ * @return {boolean}
+ */
+proto.viam.service.motion.v1.MoveRequest.prototype.getReturnExecutionId = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.viam.service.motion.v1.MoveRequest} returns this
+ */
+proto.viam.service.motion.v1.MoveRequest.prototype.setReturnExecutionId = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 6, value);
+};
+
+
 /**
  * optional google.protobuf.Struct extra = 99;
  * @return {?proto.google.protobuf.Struct}
@@ -746,7 +1176,9 @@ proto.viam.service.motion.v1.MoveResponse.prototype.toObject = function(opt_incl
  */
 proto.viam.service.motion.v1.MoveResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+    success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+    executionId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    planningFailure: (f = msg.getPlanningFailure()) && proto.viam.service.motion.v1.PlanningFailure.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -787,6 +1219,15 @@ proto.viam.service.motion.v1.MoveResponse.deserializeBinaryFromReader = function
       var value = /** @type {boolean} */ (reader.readBool());
       msg.setSuccess(value);
       break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setExecutionId(value);
+      break;
+    case 3:
+      var value = new proto.viam.service.motion.v1.PlanningFailure;
+      reader.readMessage(value,proto.viam.service.motion.v1.PlanningFailure.deserializeBinaryFromReader);
+      msg.setPlanningFailure(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -823,6 +1264,21 @@ proto.viam.service.motion.v1.MoveResponse.serializeBinaryToWriter = function(mes
       f
     );
   }
+  f = message.getExecutionId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getPlanningFailure();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.viam.service.motion.v1.PlanningFailure.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -844,6 +1300,61 @@ proto.viam.service.motion.v1.MoveResponse.prototype.setSuccess = function(value)
 };
 
 
+/**
+ * optional string execution_id = 2;
+ * @return {string}
+ */
+proto.viam.service.motion.v1.MoveResponse.prototype.getExecutionId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.service.motion.v1.MoveResponse} returns this
+ */
+proto.viam.service.motion.v1.MoveResponse.prototype.setExecutionId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional PlanningFailure planning_failure = 3;
+ * @return {?proto.viam.service.motion.v1.PlanningFailure}
+ */
+proto.viam.service.motion.v1.MoveResponse.prototype.getPlanningFailure = function() {
+  return /** @type{?proto.viam.service.motion.v1.PlanningFailure} */ (
+    jspb.Message.getWrapperField(this, proto.viam.service.motion.v1.PlanningFailure, 3));
+};
+
+
+/**
+ * @param {?proto.viam.service.motion.v1.PlanningFailure|undefined} value
+ * @return {!proto.viam.service.motion.v1.MoveResponse} returns this
+*/
+proto.viam.service.motion.v1.MoveResponse.prototype.setPlanningFailure = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.motion.v1.MoveResponse} returns this
+ */
+proto.viam.service.motion.v1.MoveResponse.prototype.clearPlanningFailure = function() {
+  return this.setPlanningFailure(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.motion.v1.MoveResponse.prototype.hasPlanningFailure = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
 
 
 
@@ -860,8 +1371,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.service.motion.v1.MoveOnMapRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.service.motion.v1.MoveOnMapRequest.toObject(opt_includeInstance, this);
+proto.viam.service.motion.v1.PlanMoveRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.motion.v1.PlanMoveRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -870,16 +1381,17 @@ proto.viam.service.motion.v1.MoveOnMapRequest.prototype.toObject = function(opt_
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.service.motion.v1.MoveOnMapRequest} msg The msg instance to transform.
+ * @param {!proto.viam.service.motion.v1.PlanMoveRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.motion.v1.MoveOnMapRequest.toObject = function(includeInstance, msg) {
+proto.viam.service.motion.v1.PlanMoveRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    destination: (f = msg.getDestination()) && common_v1_common_pb.Pose.toObject(includeInstance, f),
+    destination: (f = msg.getDestination()) && common_v1_common_pb.PoseInFrame.toObject(includeInstance, f),
     componentName: (f = msg.getComponentName()) && common_v1_common_pb.ResourceName.toObject(includeInstance, f),
-    slamServiceName: (f = msg.getSlamServiceName()) && common_v1_common_pb.ResourceName.toObject(includeInstance, f),
+    worldState: (f = msg.getWorldState()) && common_v1_common_pb.WorldState.toObject(includeInstance, f),
+    constraints: (f = msg.getConstraints()) && proto.viam.service.motion.v1.Constraints.toObject(includeInstance, f),
     extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
   };
 
@@ -894,23 +1406,23 @@ proto.viam.service.motion.v1.MoveOnMapRequest.toObject = function(includeInstanc
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.service.motion.v1.MoveOnMapRequest}
+ * @return {!proto.viam.service.motion.v1.PlanMoveRequest}
  */
-proto.viam.service.motion.v1.MoveOnMapRequest.deserializeBinary = function(bytes) {
+proto.viam.service.motion.v1.PlanMoveRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.service.motion.v1.MoveOnMapRequest;
-  return proto.viam.service.motion.v1.MoveOnMapRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.service.motion.v1.PlanMoveRequest;
+  return proto.viam.service.motion.v1.PlanMoveRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.service.motion.v1.MoveOnMapRequest} msg The message object to deserialize into.
+ * @param {!proto.viam.service.motion.v1.PlanMoveRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.service.motion.v1.MoveOnMapRequest}
+ * @return {!proto.viam.service.motion.v1.PlanMoveRequest}
  */
-proto.viam.service.motion.v1.MoveOnMapRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.service.motion.v1.PlanMoveRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -922,8 +1434,8 @@ proto.viam.service.motion.v1.MoveOnMapRequest.deserializeBinaryFromReader = func
       msg.setName(value);
       break;
     case 2:
-      var value = new common_v1_common_pb.Pose;
-      reader.readMessage(value,common_v1_common_pb.Pose.deserializeBinaryFromReader);
+      var value = new common_v1_common_pb.PoseInFrame;
+      reader.readMessage(value,common_v1_common_pb.PoseInFrame.deserializeBinaryFromReader);
       msg.setDestination(value);
       break;
     case 3:
@@ -932,9 +1444,14 @@ proto.viam.service.motion.v1.MoveOnMapRequest.deserializeBinaryFromReader = func
       msg.setComponentName(value);
       break;
     case 4:
-      var value = new common_v1_common_pb.ResourceName;
-      reader.readMessage(value,common_v1_common_pb.ResourceName.deserializeBinaryFromReader);
-      msg.setSlamServiceName(value);
+      var value = new common_v1_common_pb.WorldState;
+      reader.readMessage(value,common_v1_common_pb.WorldState.deserializeBinaryFromReader);
+      msg.setWorldState(value);
+      break;
+    case 5:
+      var value = new proto.viam.service.motion.v1.Constraints;
+      reader.readMessage(value,proto.viam.service.motion.v1.Constraints.deserializeBinaryFromReader);
+      msg.setConstraints(value);
       break;
     case 99:
       var value = new google_protobuf_struct_pb.Struct;
@@ -954,9 +1471,9 @@ proto.viam.service.motion.v1.MoveOnMapRequest.deserializeBinaryFromReader = func
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.service.motion.v1.MoveOnMapRequest.prototype.serializeBinary = function() {
+proto.viam.service.motion.v1.PlanMoveRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.service.motion.v1.MoveOnMapRequest.serializeBinaryToWriter(this, writer);
+  proto.viam.service.motion.v1.PlanMoveRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -964,11 +1481,11 @@ proto.viam.service.motion.v1.MoveOnMapRequest.prototype.serializeBinary = functi
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.service.motion.v1.MoveOnMapRequest} message
+ * @param {!proto.viam.service.motion.v1.PlanMoveRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.motion.v1.MoveOnMapRequest.serializeBinaryToWriter = function(message, writer) {
+proto.viam.service.motion.v1.PlanMoveRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getName();
   if (f.length > 0) {
@@ -982,7 +1499,7 @@ proto.viam.service.motion.v1.MoveOnMapRequest.serializeBinaryToWriter = function
     writer.writeMessage(
       2,
       f,
-      common_v1_common_pb.Pose.serializeBinaryToWriter
+      common_v1_common_pb.PoseInFrame.serializeBinaryToWriter
     );
   }
   f = message.getComponentName();
@@ -993,12 +1510,20 @@ proto.viam.service.motion.v1.MoveOnMapRequest.serializeBinaryToWriter = function
       common_v1_common_pb.ResourceName.serializeBinaryToWriter
     );
   }
-  f = message.getSlamServiceName();
+  f = message.getWorldState();
   if (f != null) {
     writer.writeMessage(
       4,
       f,
-      common_v1_common_pb.ResourceName.serializeBinaryToWriter
+      common_v1_common_pb.WorldState.serializeBinaryToWriter
+    );
+  }
+  f = message.getConstraints();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      proto.viam.service.motion.v1.Constraints.serializeBinaryToWriter
     );
   }
   f = message.getExtra();
@@ -1016,44 +1541,44 @@ proto.viam.service.motion.v1.MoveOnMapRequest.serializeBinaryToWriter = function
  * optional string name = 1;
  * @return {string}
  */
-proto.viam.service.motion.v1.MoveOnMapRequest.prototype.getName = function() {
+proto.viam.service.motion.v1.PlanMoveRequest.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.viam.service.motion.v1.MoveOnMapRequest} returns this
+ * @return {!proto.viam.service.motion.v1.PlanMoveRequest} returns this
  */
-proto.viam.service.motion.v1.MoveOnMapRequest.prototype.setName = function(value) {
+proto.viam.service.motion.v1.PlanMoveRequest.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional viam.common.v1.Pose destination = 2;
- * @return {?proto.viam.common.v1.Pose}
+ * optional viam.common.v1.PoseInFrame destination = 2;
+ * @return {?proto.viam.common.v1.PoseInFrame}
  */
-proto.viam.service.motion.v1.MoveOnMapRequest.prototype.getDestination = function() {
-  return /** @type{?proto.viam.common.v1.Pose} */ (
-    jspb.Message.getWrapperField(this, common_v1_common_pb.Pose, 2));
+proto.viam.service.motion.v1.PlanMoveRequest.prototype.getDestination = function() {
+  return /** @type{?proto.viam.common.v1.PoseInFrame} */ (
+    jspb.Message.getWrapperField(this, common_v1_common_pb.PoseInFrame, 2));
 };
 
 
 /**
- * @param {?proto.viam.common.v1.Pose|undefined} value
- * @return {!proto.viam.service.motion.v1.MoveOnMapRequest} returns this
+ * @param {?proto.viam.common.v1.PoseInFrame|undefined} value
+ * @return {!proto.viam.service.motion.v1.PlanMoveRequest} returns this
 */
-proto.viam.service.motion.v1.MoveOnMapRequest.prototype.setDestination = function(value) {
+proto.viam.service.motion.v1.PlanMoveRequest.prototype.setDestination = function(value) {
   return jspb.Message.setWrapperField(this, 2, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.viam.service.motion.v1.MoveOnMapRequest} returns this
+ * @return {!proto.viam.service.motion.v1.PlanMoveRequest} returns this
  */
-proto.viam.service.motion.v1.MoveOnMapRequest.prototype.clearDestination = function() {
+proto.viam.service.motion.v1.PlanMoveRequest.prototype.clearDestination = function() {
   return this.setDestination(undefined);
 };
 
@@ -1062,7 +1587,7 @@ proto.viam.service.motion.v1.MoveOnMapRequest.prototype.clearDestination = funct
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.service.motion.v1.MoveOnMapRequest.prototype.hasDestination = function() {
+proto.viam.service.motion.v1.PlanMoveRequest.prototype.hasDestination = function() {
   return jspb.Message.getField(this, 2) != null;
 };
 
@@ -1071,7 +1596,7 @@ proto.viam.service.motion.v1.MoveOnMapRequest.prototype.hasDestination = functio
  * optional viam.common.v1.ResourceName component_name = 3;
  * @return {?proto.viam.common.v1.ResourceName}
  */
-proto.viam.service.motion.v1.MoveOnMapRequest.prototype.getComponentName = function() {
+proto.viam.service.motion.v1.PlanMoveRequest.prototype.getComponentName = function() {
   return /** @type{?proto.viam.common.v1.ResourceName} */ (
     jspb.Message.getWrapperField(this, common_v1_common_pb.ResourceName, 3));
 };
@@ -1079,18 +1604,18 @@ proto.viam.service.motion.v1.MoveOnMapRequest.prototype.getComponentName = funct
 
 /**
  * @param {?proto.viam.common.v1.ResourceName|undefined} value
- * @return {!proto.viam.service.motion.v1.MoveOnMapRequest} returns this
+ * @return {!proto.viam.service.motion.v1.PlanMoveRequest} returns this
 */
-proto.viam.service.motion.v1.MoveOnMapRequest.prototype.setComponentName = function(value) {
+proto.viam.service.motion.v1.PlanMoveRequest.prototype.setComponentName = function(value) {
   return jspb.Message.setWrapperField(this, 3, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.viam.service.motion.v1.MoveOnMapRequest} returns this
+ * @return {!proto.viam.service.motion.v1.PlanMoveRequest} returns this
  */
-proto.viam.service.motion.v1.MoveOnMapRequest.prototype.clearComponentName = function() {
+proto.viam.service.motion.v1.PlanMoveRequest.prototype.clearComponentName = function() {
   return this.setComponentName(undefined);
 };
 
@@ -1099,36 +1624,36 @@ proto.viam.service.motion.v1.MoveOnMapRequest.prototype.clearComponentName = fun
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.service.motion.v1.MoveOnMapRequest.prototype.hasComponentName = function() {
+proto.viam.service.motion.v1.PlanMoveRequest.prototype.hasComponentName = function() {
   return jspb.Message.getField(this, 3) != null;
 };
 
 
 /**
- * optional viam.common.v1.ResourceName slam_service_name = 4;
- * @return {?proto.viam.common.v1.ResourceName}
+ * optional viam.common.v1.WorldState world_state = 4;
+ * @return {?proto.viam.common.v1.WorldState}
  */
-proto.viam.service.motion.v1.MoveOnMapRequest.prototype.getSlamServiceName = function() {
-  return /** @type{?proto.viam.common.v1.ResourceName} */ (
-    jspb.Message.getWrapperField(this, common_v1_common_pb.ResourceName, 4));
+proto.viam.service.motion.v1.PlanMoveRequest.prototype.getWorldState = function() {
+  return /** @type{?proto.viam.common.v1.WorldState} */ (
+    jspb.Message.getWrapperField(this, common_v1_common_pb.WorldState, 4));
 };
 
 
 /**
- * @param {?proto.viam.common.v1.ResourceName|undefined} value
- * @return {!proto.viam.service.motion.v1.MoveOnMapRequest} returns this
+ * @param {?proto.viam.common.v1.WorldState|undefined} value
+ * @return {!proto.viam.service.motion.v1.PlanMoveRequest} returns this
 */
-proto.viam.service.motion.v1.MoveOnMapRequest.prototype.setSlamServiceName = function(value) {
+proto.viam.service.motion.v1.PlanMoveRequest.prototype.setWorldState = function(value) {
   return jspb.Message.setWrapperField(this, 4, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.viam.service.motion.v1.MoveOnMapRequest} returns this
+ * @return {!proto.viam.service.motion.v1.PlanMoveRequest} returns this
  */
-proto.viam.service.motion.v1.MoveOnMapRequest.prototype.clearSlamServiceName = function() {
-  return this.setSlamServiceName(undefined);
+proto.viam.service.motion.v1.PlanMoveRequest.prototype.clearWorldState = function() {
+  return this.setWorldState(undefined);
 };
 
 
@@ -1136,16 +1661,53 @@ proto.viam.service.motion.v1.MoveOnMapRequest.prototype.clearSlamServiceName = f
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.service.motion.v1.MoveOnMapRequest.prototype.hasSlamServiceName = function() {
+proto.viam.service.motion.v1.PlanMoveRequest.prototype.hasWorldState = function() {
   return jspb.Message.getField(this, 4) != null;
 };
 
 
+/**
+ * optional Constraints constraints = 5;
+ * @return {?proto.viam.service.motion.v1.Constraints}
+ */
+proto.viam.service.motion.v1.PlanMoveRequest.prototype.getConstraints = function() {
+  return /** @type{?proto.viam.service.motion.v1.Constraints} */ (
+    jspb.Message.getWrapperField(this, proto.viam.service.motion.v1.Constraints, 5));
+};
+
+
+/**
+ * @param {?proto.viam.service.motion.v1.Constraints|undefined} value
+ * @return {!proto.viam.service.motion.v1.PlanMoveRequest} returns this
+*/
+proto.viam.service.motion.v1.PlanMoveRequest.prototype.setConstraints = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.motion.v1.PlanMoveRequest} returns this
+ */
+proto.viam.service.motion.v1.PlanMoveRequest.prototype.clearConstraints = function() {
+  return this.setConstraints(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.motion.v1.PlanMoveRequest.prototype.hasConstraints = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
 /**
  * optional google.protobuf.Struct extra = 99;
  * @return {?proto.google.protobuf.Struct}
  */
-proto.viam.service.motion.v1.MoveOnMapRequest.prototype.getExtra = function() {
+proto.viam.service.motion.v1.PlanMoveRequest.prototype.getExtra = function() {
   return /** @type{?proto.google.protobuf.Struct} */ (
     jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
 };
@@ -1153,18 +1715,18 @@ proto.viam.service.motion.v1.MoveOnMapRequest.prototype.getExtra = function() {
 
 /**
  * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.viam.service.motion.v1.MoveOnMapRequest} returns this
+ * @return {!proto.viam.service.motion.v1.PlanMoveRequest} returns this
 */
-proto.viam.service.motion.v1.MoveOnMapRequest.prototype.setExtra = function(value) {
+proto.viam.service.motion.v1.PlanMoveRequest.prototype.setExtra = function(value) {
   return jspb.Message.setWrapperField(this, 99, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.viam.service.motion.v1.MoveOnMapRequest} returns this
+ * @return {!proto.viam.service.motion.v1.PlanMoveRequest} returns this
  */
-proto.viam.service.motion.v1.MoveOnMapRequest.prototype.clearExtra = function() {
+proto.viam.service.motion.v1.PlanMoveRequest.prototype.clearExtra = function() {
   return this.setExtra(undefined);
 };
 
@@ -1173,7 +1735,7 @@ proto.viam.service.motion.v1.MoveOnMapRequest.prototype.clearExtra = function()
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.service.motion.v1.MoveOnMapRequest.prototype.hasExtra = function() {
+proto.viam.service.motion.v1.PlanMoveRequest.prototype.hasExtra = function() {
   return jspb.Message.getField(this, 99) != null;
 };
 
@@ -1194,8 +1756,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.service.motion.v1.MoveOnMapResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.service.motion.v1.MoveOnMapResponse.toObject(opt_includeInstance, this);
+proto.viam.service.motion.v1.PlanMoveResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.motion.v1.PlanMoveResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -1204,13 +1766,14 @@ proto.viam.service.motion.v1.MoveOnMapResponse.prototype.toObject = function(opt
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.service.motion.v1.MoveOnMapResponse} msg The msg instance to transform.
+ * @param {!proto.viam.service.motion.v1.PlanMoveResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.motion.v1.MoveOnMapResponse.toObject = function(includeInstance, msg) {
+proto.viam.service.motion.v1.PlanMoveResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+    plan: (f = msg.getPlan()) && proto.viam.service.motion.v1.Plan.toObject(includeInstance, f),
+    planningFailure: (f = msg.getPlanningFailure()) && proto.viam.service.motion.v1.PlanningFailure.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -1224,23 +1787,23 @@ proto.viam.service.motion.v1.MoveOnMapResponse.toObject = function(includeInstan
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.service.motion.v1.MoveOnMapResponse}
+ * @return {!proto.viam.service.motion.v1.PlanMoveResponse}
  */
-proto.viam.service.motion.v1.MoveOnMapResponse.deserializeBinary = function(bytes) {
+proto.viam.service.motion.v1.PlanMoveResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.service.motion.v1.MoveOnMapResponse;
-  return proto.viam.service.motion.v1.MoveOnMapResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.service.motion.v1.PlanMoveResponse;
+  return proto.viam.service.motion.v1.PlanMoveResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.service.motion.v1.MoveOnMapResponse} msg The message object to deserialize into.
+ * @param {!proto.viam.service.motion.v1.PlanMoveResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.service.motion.v1.MoveOnMapResponse}
+ * @return {!proto.viam.service.motion.v1.PlanMoveResponse}
  */
-proto.viam.service.motion.v1.MoveOnMapResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.service.motion.v1.PlanMoveResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1248,8 +1811,14 @@ proto.viam.service.motion.v1.MoveOnMapResponse.deserializeBinaryFromReader = fun
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setSuccess(value);
+      var value = new proto.viam.service.motion.v1.Plan;
+      reader.readMessage(value,proto.viam.service.motion.v1.Plan.deserializeBinaryFromReader);
+      msg.setPlan(value);
+      break;
+    case 2:
+      var value = new proto.viam.service.motion.v1.PlanningFailure;
+      reader.readMessage(value,proto.viam.service.motion.v1.PlanningFailure.deserializeBinaryFromReader);
+      msg.setPlanningFailure(value);
       break;
     default:
       reader.skipField();
@@ -1264,9 +1833,9 @@ proto.viam.service.motion.v1.MoveOnMapResponse.deserializeBinaryFromReader = fun
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.service.motion.v1.MoveOnMapResponse.prototype.serializeBinary = function() {
+proto.viam.service.motion.v1.PlanMoveResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.service.motion.v1.MoveOnMapResponse.serializeBinaryToWriter(this, writer);
+  proto.viam.service.motion.v1.PlanMoveResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1274,47 +1843,105 @@ proto.viam.service.motion.v1.MoveOnMapResponse.prototype.serializeBinary = funct
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.service.motion.v1.MoveOnMapResponse} message
+ * @param {!proto.viam.service.motion.v1.PlanMoveResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.motion.v1.MoveOnMapResponse.serializeBinaryToWriter = function(message, writer) {
+proto.viam.service.motion.v1.PlanMoveResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSuccess();
-  if (f) {
-    writer.writeBool(
+  f = message.getPlan();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      proto.viam.service.motion.v1.Plan.serializeBinaryToWriter
+    );
+  }
+  f = message.getPlanningFailure();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.viam.service.motion.v1.PlanningFailure.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional bool success = 1;
+ * optional Plan plan = 1;
+ * @return {?proto.viam.service.motion.v1.Plan}
+ */
+proto.viam.service.motion.v1.PlanMoveResponse.prototype.getPlan = function() {
+  return /** @type{?proto.viam.service.motion.v1.Plan} */ (
+    jspb.Message.getWrapperField(this, proto.viam.service.motion.v1.Plan, 1));
+};
+
+
+/**
+ * @param {?proto.viam.service.motion.v1.Plan|undefined} value
+ * @return {!proto.viam.service.motion.v1.PlanMoveResponse} returns this
+*/
+proto.viam.service.motion.v1.PlanMoveResponse.prototype.setPlan = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.motion.v1.PlanMoveResponse} returns this
+ */
+proto.viam.service.motion.v1.PlanMoveResponse.prototype.clearPlan = function() {
+  return this.setPlan(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.service.motion.v1.MoveOnMapResponse.prototype.getSuccess = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+proto.viam.service.motion.v1.PlanMoveResponse.prototype.hasPlan = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.viam.service.motion.v1.MoveOnMapResponse} returns this
+ * optional PlanningFailure planning_failure = 2;
+ * @return {?proto.viam.service.motion.v1.PlanningFailure}
  */
-proto.viam.service.motion.v1.MoveOnMapResponse.prototype.setSuccess = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
+proto.viam.service.motion.v1.PlanMoveResponse.prototype.getPlanningFailure = function() {
+  return /** @type{?proto.viam.service.motion.v1.PlanningFailure} */ (
+    jspb.Message.getWrapperField(this, proto.viam.service.motion.v1.PlanningFailure, 2));
+};
+
+
+/**
+ * @param {?proto.viam.service.motion.v1.PlanningFailure|undefined} value
+ * @return {!proto.viam.service.motion.v1.PlanMoveResponse} returns this
+*/
+proto.viam.service.motion.v1.PlanMoveResponse.prototype.setPlanningFailure = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
 };
 
 
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.motion.v1.PlanMoveResponse} returns this
+ */
+proto.viam.service.motion.v1.PlanMoveResponse.prototype.clearPlanningFailure = function() {
+  return this.setPlanningFailure(undefined);
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.viam.service.motion.v1.MotionConfiguration.repeatedFields_ = [1];
+proto.viam.service.motion.v1.PlanMoveResponse.prototype.hasPlanningFailure = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
 
 
 
@@ -1331,8 +1958,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.service.motion.v1.MotionConfiguration.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.service.motion.v1.MotionConfiguration.toObject(opt_includeInstance, this);
+proto.viam.service.motion.v1.MoveOnMapRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.motion.v1.MoveOnMapRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -1341,19 +1968,18 @@ proto.viam.service.motion.v1.MotionConfiguration.prototype.toObject = function(o
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.service.motion.v1.MotionConfiguration} msg The msg instance to transform.
+ * @param {!proto.viam.service.motion.v1.MoveOnMapRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.motion.v1.MotionConfiguration.toObject = function(includeInstance, msg) {
+proto.viam.service.motion.v1.MoveOnMapRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    visionServicesList: jspb.Message.toObjectList(msg.getVisionServicesList(),
-    common_v1_common_pb.ResourceName.toObject, includeInstance),
-    positionPollingFrequencyHz: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0),
-    obstaclePollingFrequencyHz: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0),
-    planDeviationM: jspb.Message.getFloatingPointFieldWithDefault(msg, 4, 0.0),
-    linearMPerSec: jspb.Message.getFloatingPointFieldWithDefault(msg, 5, 0.0),
-    angularDegsPerSec: jspb.Message.getFloatingPointFieldWithDefault(msg, 6, 0.0)
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    destination: (f = msg.getDestination()) && common_v1_common_pb.Pose.toObject(includeInstance, f),
+    componentName: (f = msg.getComponentName()) && common_v1_common_pb.ResourceName.toObject(includeInstance, f),
+    slamServiceName: (f = msg.getSlamServiceName()) && common_v1_common_pb.ResourceName.toObject(includeInstance, f),
+    returnExecutionId: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -1367,23 +1993,23 @@ proto.viam.service.motion.v1.MotionConfiguration.toObject = function(includeInst
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.service.motion.v1.MotionConfiguration}
+ * @return {!proto.viam.service.motion.v1.MoveOnMapRequest}
  */
-proto.viam.service.motion.v1.MotionConfiguration.deserializeBinary = function(bytes) {
+proto.viam.service.motion.v1.MoveOnMapRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.service.motion.v1.MotionConfiguration;
-  return proto.viam.service.motion.v1.MotionConfiguration.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.service.motion.v1.MoveOnMapRequest;
+  return proto.viam.service.motion.v1.MoveOnMapRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.service.motion.v1.MotionConfiguration} msg The message object to deserialize into.
+ * @param {!proto.viam.service.motion.v1.MoveOnMapRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.service.motion.v1.MotionConfiguration}
+ * @return {!proto.viam.service.motion.v1.MoveOnMapRequest}
  */
-proto.viam.service.motion.v1.MotionConfiguration.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.service.motion.v1.MoveOnMapRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1391,29 +2017,32 @@ proto.viam.service.motion.v1.MotionConfiguration.deserializeBinaryFromReader = f
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new common_v1_common_pb.ResourceName;
-      reader.readMessage(value,common_v1_common_pb.ResourceName.deserializeBinaryFromReader);
-      msg.addVisionServices(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readDouble());
-      msg.setPositionPollingFrequencyHz(value);
+      var value = new common_v1_common_pb.Pose;
+      reader.readMessage(value,common_v1_common_pb.Pose.deserializeBinaryFromReader);
+      msg.setDestination(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readDouble());
-      msg.setObstaclePollingFrequencyHz(value);
+      var value = new common_v1_common_pb.ResourceName;
+      reader.readMessage(value,common_v1_common_pb.ResourceName.deserializeBinaryFromReader);
+      msg.setComponentName(value);
       break;
     case 4:
-      var value = /** @type {number} */ (reader.readDouble());
-      msg.setPlanDeviationM(value);
+      var value = new common_v1_common_pb.ResourceName;
+      reader.readMessage(value,common_v1_common_pb.ResourceName.deserializeBinaryFromReader);
+      msg.setSlamServiceName(value);
       break;
     case 5:
-      var value = /** @type {number} */ (reader.readDouble());
-      msg.setLinearMPerSec(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setReturnExecutionId(value);
       break;
-    case 6:
-      var value = /** @type {number} */ (reader.readDouble());
-      msg.setAngularDegsPerSec(value);
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
       break;
     default:
       reader.skipField();
@@ -1428,9 +2057,9 @@ proto.viam.service.motion.v1.MotionConfiguration.deserializeBinaryFromReader = f
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.service.motion.v1.MotionConfiguration.prototype.serializeBinary = function() {
+proto.viam.service.motion.v1.MoveOnMapRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.service.motion.v1.MotionConfiguration.serializeBinaryToWriter(this, writer);
+  proto.viam.service.motion.v1.MoveOnMapRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1438,120 +2067,104 @@ proto.viam.service.motion.v1.MotionConfiguration.prototype.serializeBinary = fun
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.service.motion.v1.MotionConfiguration} message
+ * @param {!proto.viam.service.motion.v1.MoveOnMapRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.motion.v1.MotionConfiguration.serializeBinaryToWriter = function(message, writer) {
+proto.viam.service.motion.v1.MoveOnMapRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getVisionServicesList();
+  f = message.getName();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       1,
-      f,
-      common_v1_common_pb.ResourceName.serializeBinaryToWriter
+      f
     );
   }
-  f = /** @type {number} */ (jspb.Message.getField(message, 2));
+  f = message.getDestination();
   if (f != null) {
-    writer.writeDouble(
+    writer.writeMessage(
       2,
-      f
+      f,
+      common_v1_common_pb.Pose.serializeBinaryToWriter
     );
   }
-  f = /** @type {number} */ (jspb.Message.getField(message, 3));
+  f = message.getComponentName();
   if (f != null) {
-    writer.writeDouble(
+    writer.writeMessage(
       3,
-      f
+      f,
+      common_v1_common_pb.ResourceName.serializeBinaryToWriter
     );
   }
-  f = /** @type {number} */ (jspb.Message.getField(message, 4));
+  f = message.getSlamServiceName();
   if (f != null) {
-    writer.writeDouble(
+    writer.writeMessage(
       4,
-      f
+      f,
+      common_v1_common_pb.ResourceName.serializeBinaryToWriter
     );
   }
-  f = /** @type {number} */ (jspb.Message.getField(message, 5));
-  if (f != null) {
-    writer.writeDouble(
+  f = message.getReturnExecutionId();
+  if (f) {
+    writer.writeBool(
       5,
       f
     );
   }
-  f = /** @type {number} */ (jspb.Message.getField(message, 6));
+  f = message.getExtra();
   if (f != null) {
-    writer.writeDouble(
-      6,
-      f
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * repeated viam.common.v1.ResourceName vision_services = 1;
- * @return {!Array<!proto.viam.common.v1.ResourceName>}
+ * optional string name = 1;
+ * @return {string}
  */
-proto.viam.service.motion.v1.MotionConfiguration.prototype.getVisionServicesList = function() {
-  return /** @type{!Array<!proto.viam.common.v1.ResourceName>} */ (
-    jspb.Message.getRepeatedWrapperField(this, common_v1_common_pb.ResourceName, 1));
+proto.viam.service.motion.v1.MoveOnMapRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!Array<!proto.viam.common.v1.ResourceName>} value
- * @return {!proto.viam.service.motion.v1.MotionConfiguration} returns this
-*/
-proto.viam.service.motion.v1.MotionConfiguration.prototype.setVisionServicesList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
-};
-
-
-/**
- * @param {!proto.viam.common.v1.ResourceName=} opt_value
- * @param {number=} opt_index
- * @return {!proto.viam.common.v1.ResourceName}
- */
-proto.viam.service.motion.v1.MotionConfiguration.prototype.addVisionServices = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.viam.common.v1.ResourceName, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.viam.service.motion.v1.MotionConfiguration} returns this
+ * @param {string} value
+ * @return {!proto.viam.service.motion.v1.MoveOnMapRequest} returns this
  */
-proto.viam.service.motion.v1.MotionConfiguration.prototype.clearVisionServicesList = function() {
-  return this.setVisionServicesList([]);
+proto.viam.service.motion.v1.MoveOnMapRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional double position_polling_frequency_hz = 2;
- * @return {number}
+ * optional viam.common.v1.Pose destination = 2;
+ * @return {?proto.viam.common.v1.Pose}
  */
-proto.viam.service.motion.v1.MotionConfiguration.prototype.getPositionPollingFrequencyHz = function() {
-  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
+proto.viam.service.motion.v1.MoveOnMapRequest.prototype.getDestination = function() {
+  return /** @type{?proto.viam.common.v1.Pose} */ (
+    jspb.Message.getWrapperField(this, common_v1_common_pb.Pose, 2));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.viam.service.motion.v1.MotionConfiguration} returns this
- */
-proto.viam.service.motion.v1.MotionConfiguration.prototype.setPositionPollingFrequencyHz = function(value) {
-  return jspb.Message.setField(this, 2, value);
+ * @param {?proto.viam.common.v1.Pose|undefined} value
+ * @return {!proto.viam.service.motion.v1.MoveOnMapRequest} returns this
+*/
+proto.viam.service.motion.v1.MoveOnMapRequest.prototype.setDestination = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.viam.service.motion.v1.MotionConfiguration} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.motion.v1.MoveOnMapRequest} returns this
  */
-proto.viam.service.motion.v1.MotionConfiguration.prototype.clearPositionPollingFrequencyHz = function() {
-  return jspb.Message.setField(this, 2, undefined);
+proto.viam.service.motion.v1.MoveOnMapRequest.prototype.clearDestination = function() {
+  return this.setDestination(undefined);
 };
 
 
@@ -1559,35 +2172,36 @@ proto.viam.service.motion.v1.MotionConfiguration.prototype.clearPositionPollingF
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.service.motion.v1.MotionConfiguration.prototype.hasPositionPollingFrequencyHz = function() {
+proto.viam.service.motion.v1.MoveOnMapRequest.prototype.hasDestination = function() {
   return jspb.Message.getField(this, 2) != null;
 };
 
 
 /**
- * optional double obstacle_polling_frequency_hz = 3;
- * @return {number}
+ * optional viam.common.v1.ResourceName component_name = 3;
+ * @return {?proto.viam.common.v1.ResourceName}
  */
-proto.viam.service.motion.v1.MotionConfiguration.prototype.getObstaclePollingFrequencyHz = function() {
-  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 3, 0.0));
+proto.viam.service.motion.v1.MoveOnMapRequest.prototype.getComponentName = function() {
+  return /** @type{?proto.viam.common.v1.ResourceName} */ (
+    jspb.Message.getWrapperField(this, common_v1_common_pb.ResourceName, 3));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.viam.service.motion.v1.MotionConfiguration} returns this
- */
-proto.viam.service.motion.v1.MotionConfiguration.prototype.setObstaclePollingFrequencyHz = function(value) {
-  return jspb.Message.setField(this, 3, value);
+ * @param {?proto.viam.common.v1.ResourceName|undefined} value
+ * @return {!proto.viam.service.motion.v1.MoveOnMapRequest} returns this
+*/
+proto.viam.service.motion.v1.MoveOnMapRequest.prototype.setComponentName = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.viam.service.motion.v1.MotionConfiguration} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.motion.v1.MoveOnMapRequest} returns this
  */
-proto.viam.service.motion.v1.MotionConfiguration.prototype.clearObstaclePollingFrequencyHz = function() {
-  return jspb.Message.setField(this, 3, undefined);
+proto.viam.service.motion.v1.MoveOnMapRequest.prototype.clearComponentName = function() {
+  return this.setComponentName(undefined);
 };
 
 
@@ -1595,35 +2209,36 @@ proto.viam.service.motion.v1.MotionConfiguration.prototype.clearObstaclePollingF
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.service.motion.v1.MotionConfiguration.prototype.hasObstaclePollingFrequencyHz = function() {
+proto.viam.service.motion.v1.MoveOnMapRequest.prototype.hasComponentName = function() {
   return jspb.Message.getField(this, 3) != null;
 };
 
 
 /**
- * optional double plan_deviation_m = 4;
- * @return {number}
+ * optional viam.common.v1.ResourceName slam_service_name = 4;
+ * @return {?proto.viam.common.v1.ResourceName}
  */
-proto.viam.service.motion.v1.MotionConfiguration.prototype.getPlanDeviationM = function() {
-  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 4, 0.0));
+proto.viam.service.motion.v1.MoveOnMapRequest.prototype.getSlamServiceName = function() {
+  return /** @type{?proto.viam.common.v1.ResourceName} */ (
+    jspb.Message.getWrapperField(this, common_v1_common_pb.ResourceName, 4));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.viam.service.motion.v1.MotionConfiguration} returns this
- */
-proto.viam.service.motion.v1.MotionConfiguration.prototype.setPlanDeviationM = function(value) {
-  return jspb.Message.setField(this, 4, value);
+ * @param {?proto.viam.common.v1.ResourceName|undefined} value
+ * @return {!proto.viam.service.motion.v1.MoveOnMapRequest} returns this
+*/
+proto.viam.service.motion.v1.MoveOnMapRequest.prototype.setSlamServiceName = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.viam.service.motion.v1.MotionConfiguration} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.motion.v1.MoveOnMapRequest} returns this
  */
-proto.viam.service.motion.v1.MotionConfiguration.prototype.clearPlanDeviationM = function() {
-  return jspb.Message.setField(this, 4, undefined);
+proto.viam.service.motion.v1.MoveOnMapRequest.prototype.clearSlamServiceName = function() {
+  return this.setSlamServiceName(undefined);
 };
 
 
@@ -1631,71 +2246,54 @@ proto.viam.service.motion.v1.MotionConfiguration.prototype.clearPlanDeviationM =
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.service.motion.v1.MotionConfiguration.prototype.hasPlanDeviationM = function() {
+proto.viam.service.motion.v1.MoveOnMapRequest.prototype.hasSlamServiceName = function() {
   return jspb.Message.getField(this, 4) != null;
 };
 
 
 /**
- * optional double linear_m_per_sec = 5;
- * @return {number}
- */
-proto.viam.service.motion.v1.MotionConfiguration.prototype.getLinearMPerSec = function() {
-  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 5, 0.0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.viam.service.motion.v1.MotionConfiguration} returns this
- */
-proto.viam.service.motion.v1.MotionConfiguration.prototype.setLinearMPerSec = function(value) {
-  return jspb.Message.setField(this, 5, value);
-};
-
-
-/**
- * Clears the field making it undefined.
- * @return {!proto.viam.service.motion.v1.MotionConfiguration} returns this
+ * optional bool return_execution_id = 5;
+ * @return {boolean}
  */
-proto.viam.service.motion.v1.MotionConfiguration.prototype.clearLinearMPerSec = function() {
-  return jspb.Message.setField(this, 5, undefined);
+proto.viam.service.motion.v1.MoveOnMapRequest.prototype.getReturnExecutionId = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {boolean} value
+ * @return {!proto.viam.service.motion.v1.MoveOnMapRequest} returns this
  */
-proto.viam.service.motion.v1.MotionConfiguration.prototype.hasLinearMPerSec = function() {
-  return jspb.Message.getField(this, 5) != null;
+proto.viam.service.motion.v1.MoveOnMapRequest.prototype.setReturnExecutionId = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
 };
 
 
 /**
- * optional double angular_degs_per_sec = 6;
- * @return {number}
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
  */
-proto.viam.service.motion.v1.MotionConfiguration.prototype.getAngularDegsPerSec = function() {
-  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 6, 0.0));
+proto.viam.service.motion.v1.MoveOnMapRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.viam.service.motion.v1.MotionConfiguration} returns this
- */
-proto.viam.service.motion.v1.MotionConfiguration.prototype.setAngularDegsPerSec = function(value) {
-  return jspb.Message.setField(this, 6, value);
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.service.motion.v1.MoveOnMapRequest} returns this
+*/
+proto.viam.service.motion.v1.MoveOnMapRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.viam.service.motion.v1.MotionConfiguration} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.motion.v1.MoveOnMapRequest} returns this
  */
-proto.viam.service.motion.v1.MotionConfiguration.prototype.clearAngularDegsPerSec = function() {
-  return jspb.Message.setField(this, 6, undefined);
+proto.viam.service.motion.v1.MoveOnMapRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
 };
 
 
@@ -1703,19 +2301,12 @@ proto.viam.service.motion.v1.MotionConfiguration.prototype.clearAngularDegsPerSe
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.service.motion.v1.MotionConfiguration.prototype.hasAngularDegsPerSec = function() {
-  return jspb.Message.getField(this, 6) != null;
+proto.viam.service.motion.v1.MoveOnMapRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.viam.service.motion.v1.MoveOnGlobeRequest.repeatedFields_ = [6];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -1731,8 +2322,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.service.motion.v1.MoveOnGlobeRequest.toObject(opt_includeInstance, this);
+proto.viam.service.motion.v1.MoveOnMapResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.motion.v1.MoveOnMapResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -1741,21 +2332,15 @@ proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.toObject = function(op
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.service.motion.v1.MoveOnGlobeRequest} msg The msg instance to transform.
+ * @param {!proto.viam.service.motion.v1.MoveOnMapResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.motion.v1.MoveOnGlobeRequest.toObject = function(includeInstance, msg) {
+proto.viam.service.motion.v1.MoveOnMapResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    destination: (f = msg.getDestination()) && common_v1_common_pb.GeoPoint.toObject(includeInstance, f),
-    heading: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0),
-    componentName: (f = msg.getComponentName()) && common_v1_common_pb.ResourceName.toObject(includeInstance, f),
-    movementSensorName: (f = msg.getMovementSensorName()) && common_v1_common_pb.ResourceName.toObject(includeInstance, f),
-    obstaclesList: jspb.Message.toObjectList(msg.getObstaclesList(),
-    common_v1_common_pb.GeoObstacle.toObject, includeInstance),
-    motionConfiguration: (f = msg.getMotionConfiguration()) && proto.viam.service.motion.v1.MotionConfiguration.toObject(includeInstance, f),
-    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+    success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+    executionId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    planningFailure: (f = msg.getPlanningFailure()) && proto.viam.service.motion.v1.PlanningFailure.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -1769,23 +2354,23 @@ proto.viam.service.motion.v1.MoveOnGlobeRequest.toObject = function(includeInsta
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.service.motion.v1.MoveOnGlobeRequest}
+ * @return {!proto.viam.service.motion.v1.MoveOnMapResponse}
  */
-proto.viam.service.motion.v1.MoveOnGlobeRequest.deserializeBinary = function(bytes) {
+proto.viam.service.motion.v1.MoveOnMapResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.service.motion.v1.MoveOnGlobeRequest;
-  return proto.viam.service.motion.v1.MoveOnGlobeRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.service.motion.v1.MoveOnMapResponse;
+  return proto.viam.service.motion.v1.MoveOnMapResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.service.motion.v1.MoveOnGlobeRequest} msg The message object to deserialize into.
+ * @param {!proto.viam.service.motion.v1.MoveOnMapResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.service.motion.v1.MoveOnGlobeRequest}
+ * @return {!proto.viam.service.motion.v1.MoveOnMapResponse}
  */
-proto.viam.service.motion.v1.MoveOnGlobeRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.service.motion.v1.MoveOnMapResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1793,42 +2378,17 @@ proto.viam.service.motion.v1.MoveOnGlobeRequest.deserializeBinaryFromReader = fu
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSuccess(value);
       break;
     case 2:
-      var value = new common_v1_common_pb.GeoPoint;
-      reader.readMessage(value,common_v1_common_pb.GeoPoint.deserializeBinaryFromReader);
-      msg.setDestination(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setExecutionId(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readDouble());
-      msg.setHeading(value);
-      break;
-    case 4:
-      var value = new common_v1_common_pb.ResourceName;
-      reader.readMessage(value,common_v1_common_pb.ResourceName.deserializeBinaryFromReader);
-      msg.setComponentName(value);
-      break;
-    case 5:
-      var value = new common_v1_common_pb.ResourceName;
-      reader.readMessage(value,common_v1_common_pb.ResourceName.deserializeBinaryFromReader);
-      msg.setMovementSensorName(value);
-      break;
-    case 6:
-      var value = new common_v1_common_pb.GeoObstacle;
-      reader.readMessage(value,common_v1_common_pb.GeoObstacle.deserializeBinaryFromReader);
-      msg.addObstacles(value);
-      break;
-    case 7:
-      var value = new proto.viam.service.motion.v1.MotionConfiguration;
-      reader.readMessage(value,proto.viam.service.motion.v1.MotionConfiguration.deserializeBinaryFromReader);
-      msg.setMotionConfiguration(value);
-      break;
-    case 99:
-      var value = new google_protobuf_struct_pb.Struct;
-      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setExtra(value);
+      var value = new proto.viam.service.motion.v1.PlanningFailure;
+      reader.readMessage(value,proto.viam.service.motion.v1.PlanningFailure.deserializeBinaryFromReader);
+      msg.setPlanningFailure(value);
       break;
     default:
       reader.skipField();
@@ -1843,9 +2403,9 @@ proto.viam.service.motion.v1.MoveOnGlobeRequest.deserializeBinaryFromReader = fu
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.serializeBinary = function() {
+proto.viam.service.motion.v1.MoveOnMapResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.service.motion.v1.MoveOnGlobeRequest.serializeBinaryToWriter(this, writer);
+  proto.viam.service.motion.v1.MoveOnMapResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1853,193 +2413,4853 @@ proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.serializeBinary = func
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.service.motion.v1.MoveOnGlobeRequest} message
+ * @param {!proto.viam.service.motion.v1.MoveOnMapResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.motion.v1.MoveOnGlobeRequest.serializeBinaryToWriter = function(message, writer) {
+proto.viam.service.motion.v1.MoveOnMapResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
+  f = message.getSuccess();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+  f = message.getExecutionId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getPlanningFailure();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.viam.service.motion.v1.PlanningFailure.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional bool success = 1;
+ * @return {boolean}
+ */
+proto.viam.service.motion.v1.MoveOnMapResponse.prototype.getSuccess = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.viam.service.motion.v1.MoveOnMapResponse} returns this
+ */
+proto.viam.service.motion.v1.MoveOnMapResponse.prototype.setSuccess = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+/**
+ * optional string execution_id = 2;
+ * @return {string}
+ */
+proto.viam.service.motion.v1.MoveOnMapResponse.prototype.getExecutionId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.service.motion.v1.MoveOnMapResponse} returns this
+ */
+proto.viam.service.motion.v1.MoveOnMapResponse.prototype.setExecutionId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional PlanningFailure planning_failure = 3;
+ * @return {?proto.viam.service.motion.v1.PlanningFailure}
+ */
+proto.viam.service.motion.v1.MoveOnMapResponse.prototype.getPlanningFailure = function() {
+  return /** @type{?proto.viam.service.motion.v1.PlanningFailure} */ (
+    jspb.Message.getWrapperField(this, proto.viam.service.motion.v1.PlanningFailure, 3));
+};
+
+
+/**
+ * @param {?proto.viam.service.motion.v1.PlanningFailure|undefined} value
+ * @return {!proto.viam.service.motion.v1.MoveOnMapResponse} returns this
+*/
+proto.viam.service.motion.v1.MoveOnMapResponse.prototype.setPlanningFailure = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.motion.v1.MoveOnMapResponse} returns this
+ */
+proto.viam.service.motion.v1.MoveOnMapResponse.prototype.clearPlanningFailure = function() {
+  return this.setPlanningFailure(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.motion.v1.MoveOnMapResponse.prototype.hasPlanningFailure = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.service.motion.v1.MotionConfiguration.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.motion.v1.MotionConfiguration.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.motion.v1.MotionConfiguration.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.motion.v1.MotionConfiguration} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.motion.v1.MotionConfiguration.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    visionServicesList: jspb.Message.toObjectList(msg.getVisionServicesList(),
+    common_v1_common_pb.ResourceName.toObject, includeInstance),
+    positionPollingFrequencyHz: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0),
+    obstaclePollingFrequencyHz: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0),
+    planDeviationM: jspb.Message.getFloatingPointFieldWithDefault(msg, 4, 0.0),
+    linearMPerSec: jspb.Message.getFloatingPointFieldWithDefault(msg, 5, 0.0),
+    angularDegsPerSec: jspb.Message.getFloatingPointFieldWithDefault(msg, 6, 0.0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.motion.v1.MotionConfiguration}
+ */
+proto.viam.service.motion.v1.MotionConfiguration.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.motion.v1.MotionConfiguration;
+  return proto.viam.service.motion.v1.MotionConfiguration.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.motion.v1.MotionConfiguration} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.motion.v1.MotionConfiguration}
+ */
+proto.viam.service.motion.v1.MotionConfiguration.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new common_v1_common_pb.ResourceName;
+      reader.readMessage(value,common_v1_common_pb.ResourceName.deserializeBinaryFromReader);
+      msg.addVisionServices(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setPositionPollingFrequencyHz(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setObstaclePollingFrequencyHz(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setPlanDeviationM(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setLinearMPerSec(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setAngularDegsPerSec(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.service.motion.v1.MotionConfiguration.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.motion.v1.MotionConfiguration.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.motion.v1.MotionConfiguration} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.motion.v1.MotionConfiguration.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getVisionServicesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      common_v1_common_pb.ResourceName.serializeBinaryToWriter
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeDouble(
+      2,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeDouble(
+      3,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 4));
+  if (f != null) {
+    writer.writeDouble(
+      4,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 5));
+  if (f != null) {
+    writer.writeDouble(
+      5,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 6));
+  if (f != null) {
+    writer.writeDouble(
+      6,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated viam.common.v1.ResourceName vision_services = 1;
+ * @return {!Array<!proto.viam.common.v1.ResourceName>}
+ */
+proto.viam.service.motion.v1.MotionConfiguration.prototype.getVisionServicesList = function() {
+  return /** @type{!Array<!proto.viam.common.v1.ResourceName>} */ (
+    jspb.Message.getRepeatedWrapperField(this, common_v1_common_pb.ResourceName, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.viam.common.v1.ResourceName>} value
+ * @return {!proto.viam.service.motion.v1.MotionConfiguration} returns this
+*/
+proto.viam.service.motion.v1.MotionConfiguration.prototype.setVisionServicesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.viam.common.v1.ResourceName=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.common.v1.ResourceName}
+ */
+proto.viam.service.motion.v1.MotionConfiguration.prototype.addVisionServices = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.viam.common.v1.ResourceName, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.service.motion.v1.MotionConfiguration} returns this
+ */
+proto.viam.service.motion.v1.MotionConfiguration.prototype.clearVisionServicesList = function() {
+  return this.setVisionServicesList([]);
+};
+
+
+/**
+ * optional double position_polling_frequency_hz = 2;
+ * @return {number}
+ */
+proto.viam.service.motion.v1.MotionConfiguration.prototype.getPositionPollingFrequencyHz = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.service.motion.v1.MotionConfiguration} returns this
+ */
+proto.viam.service.motion.v1.MotionConfiguration.prototype.setPositionPollingFrequencyHz = function(value) {
+  return jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.viam.service.motion.v1.MotionConfiguration} returns this
+ */
+proto.viam.service.motion.v1.MotionConfiguration.prototype.clearPositionPollingFrequencyHz = function() {
+  return jspb.Message.setField(this, 2, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.motion.v1.MotionConfiguration.prototype.hasPositionPollingFrequencyHz = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional double obstacle_polling_frequency_hz = 3;
+ * @return {number}
+ */
+proto.viam.service.motion.v1.MotionConfiguration.prototype.getObstaclePollingFrequencyHz = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 3, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.service.motion.v1.MotionConfiguration} returns this
+ */
+proto.viam.service.motion.v1.MotionConfiguration.prototype.setObstaclePollingFrequencyHz = function(value) {
+  return jspb.Message.setField(this, 3, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.viam.service.motion.v1.MotionConfiguration} returns this
+ */
+proto.viam.service.motion.v1.MotionConfiguration.prototype.clearObstaclePollingFrequencyHz = function() {
+  return jspb.Message.setField(this, 3, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.motion.v1.MotionConfiguration.prototype.hasObstaclePollingFrequencyHz = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional double plan_deviation_m = 4;
+ * @return {number}
+ */
+proto.viam.service.motion.v1.MotionConfiguration.prototype.getPlanDeviationM = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 4, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.service.motion.v1.MotionConfiguration} returns this
+ */
+proto.viam.service.motion.v1.MotionConfiguration.prototype.setPlanDeviationM = function(value) {
+  return jspb.Message.setField(this, 4, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.viam.service.motion.v1.MotionConfiguration} returns this
+ */
+proto.viam.service.motion.v1.MotionConfiguration.prototype.clearPlanDeviationM = function() {
+  return jspb.Message.setField(this, 4, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.motion.v1.MotionConfiguration.prototype.hasPlanDeviationM = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional double linear_m_per_sec = 5;
+ * @return {number}
+ */
+proto.viam.service.motion.v1.MotionConfiguration.prototype.getLinearMPerSec = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 5, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.service.motion.v1.MotionConfiguration} returns this
+ */
+proto.viam.service.motion.v1.MotionConfiguration.prototype.setLinearMPerSec = function(value) {
+  return jspb.Message.setField(this, 5, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.viam.service.motion.v1.MotionConfiguration} returns this
+ */
+proto.viam.service.motion.v1.MotionConfiguration.prototype.clearLinearMPerSec = function() {
+  return jspb.Message.setField(this, 5, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.motion.v1.MotionConfiguration.prototype.hasLinearMPerSec = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional double angular_degs_per_sec = 6;
+ * @return {number}
+ */
+proto.viam.service.motion.v1.MotionConfiguration.prototype.getAngularDegsPerSec = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 6, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.service.motion.v1.MotionConfiguration} returns this
+ */
+proto.viam.service.motion.v1.MotionConfiguration.prototype.setAngularDegsPerSec = function(value) {
+  return jspb.Message.setField(this, 6, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.viam.service.motion.v1.MotionConfiguration} returns this
+ */
+proto.viam.service.motion.v1.MotionConfiguration.prototype.clearAngularDegsPerSec = function() {
+  return jspb.Message.setField(this, 6, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.motion.v1.MotionConfiguration.prototype.hasAngularDegsPerSec = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.service.motion.v1.MoveOnGlobeRequest.repeatedFields_ = [6];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.motion.v1.MoveOnGlobeRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.motion.v1.MoveOnGlobeRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.motion.v1.MoveOnGlobeRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    destination: (f = msg.getDestination()) && common_v1_common_pb.GeoPoint.toObject(includeInstance, f),
+    heading: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0),
+    componentName: (f = msg.getComponentName()) && common_v1_common_pb.ResourceName.toObject(includeInstance, f),
+    movementSensorName: (f = msg.getMovementSensorName()) && common_v1_common_pb.ResourceName.toObject(includeInstance, f),
+    obstaclesList: jspb.Message.toObjectList(msg.getObstaclesList(),
+    common_v1_common_pb.GeoObstacle.toObject, includeInstance),
+    motionConfiguration: (f = msg.getMotionConfiguration()) && proto.viam.service.motion.v1.MotionConfiguration.toObject(includeInstance, f),
+    returnExecutionId: jspb.Message.getBooleanFieldWithDefault(msg, 8, false),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.motion.v1.MoveOnGlobeRequest}
+ */
+proto.viam.service.motion.v1.MoveOnGlobeRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.motion.v1.MoveOnGlobeRequest;
+  return proto.viam.service.motion.v1.MoveOnGlobeRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.motion.v1.MoveOnGlobeRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.motion.v1.MoveOnGlobeRequest}
+ */
+proto.viam.service.motion.v1.MoveOnGlobeRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = new common_v1_common_pb.GeoPoint;
+      reader.readMessage(value,common_v1_common_pb.GeoPoint.deserializeBinaryFromReader);
+      msg.setDestination(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setHeading(value);
+      break;
+    case 4:
+      var value = new common_v1_common_pb.ResourceName;
+      reader.readMessage(value,common_v1_common_pb.ResourceName.deserializeBinaryFromReader);
+      msg.setComponentName(value);
+      break;
+    case 5:
+      var value = new common_v1_common_pb.ResourceName;
+      reader.readMessage(value,common_v1_common_pb.ResourceName.deserializeBinaryFromReader);
+      msg.setMovementSensorName(value);
+      break;
+    case 6:
+      var value = new common_v1_common_pb.GeoObstacle;
+      reader.readMessage(value,common_v1_common_pb.GeoObstacle.deserializeBinaryFromReader);
+      msg.addObstacles(value);
+      break;
+    case 7:
+      var value = new proto.viam.service.motion.v1.MotionConfiguration;
+      reader.readMessage(value,proto.viam.service.motion.v1.MotionConfiguration.deserializeBinaryFromReader);
+      msg.setMotionConfiguration(value);
+      break;
+    case 8:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setReturnExecutionId(value);
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.motion.v1.MoveOnGlobeRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.motion.v1.MoveOnGlobeRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.motion.v1.MoveOnGlobeRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDestination();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      common_v1_common_pb.GeoPoint.serializeBinaryToWriter
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeDouble(
+      3,
+      f
+    );
+  }
+  f = message.getComponentName();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      common_v1_common_pb.ResourceName.serializeBinaryToWriter
+    );
+  }
+  f = message.getMovementSensorName();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      common_v1_common_pb.ResourceName.serializeBinaryToWriter
+    );
+  }
+  f = message.getObstaclesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      6,
+      f,
+      common_v1_common_pb.GeoObstacle.serializeBinaryToWriter
+    );
+  }
+  f = message.getMotionConfiguration();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      proto.viam.service.motion.v1.MotionConfiguration.serializeBinaryToWriter
+    );
+  }
+  f = message.getReturnExecutionId();
+  if (f) {
+    writer.writeBool(
+      8,
+      f
+    );
+  }
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.service.motion.v1.MoveOnGlobeRequest} returns this
+ */
+proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional viam.common.v1.GeoPoint destination = 2;
+ * @return {?proto.viam.common.v1.GeoPoint}
+ */
+proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.getDestination = function() {
+  return /** @type{?proto.viam.common.v1.GeoPoint} */ (
+    jspb.Message.getWrapperField(this, common_v1_common_pb.GeoPoint, 2));
+};
+
+
+/**
+ * @param {?proto.viam.common.v1.GeoPoint|undefined} value
+ * @return {!proto.viam.service.motion.v1.MoveOnGlobeRequest} returns this
+*/
+proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.setDestination = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.motion.v1.MoveOnGlobeRequest} returns this
+ */
+proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.clearDestination = function() {
+  return this.setDestination(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.hasDestination = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional double heading = 3;
+ * @return {number}
+ */
+proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.getHeading = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 3, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.service.motion.v1.MoveOnGlobeRequest} returns this
+ */
+proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.setHeading = function(value) {
+  return jspb.Message.setField(this, 3, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.viam.service.motion.v1.MoveOnGlobeRequest} returns this
+ */
+proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.clearHeading = function() {
+  return jspb.Message.setField(this, 3, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.hasHeading = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional viam.common.v1.ResourceName component_name = 4;
+ * @return {?proto.viam.common.v1.ResourceName}
+ */
+proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.getComponentName = function() {
+  return /** @type{?proto.viam.common.v1.ResourceName} */ (
+    jspb.Message.getWrapperField(this, common_v1_common_pb.ResourceName, 4));
+};
+
+
+/**
+ * @param {?proto.viam.common.v1.ResourceName|undefined} value
+ * @return {!proto.viam.service.motion.v1.MoveOnGlobeRequest} returns this
+*/
+proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.setComponentName = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.motion.v1.MoveOnGlobeRequest} returns this
+ */
+proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.clearComponentName = function() {
+  return this.setComponentName(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.hasComponentName = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional viam.common.v1.ResourceName movement_sensor_name = 5;
+ * @return {?proto.viam.common.v1.ResourceName}
+ */
+proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.getMovementSensorName = function() {
+  return /** @type{?proto.viam.common.v1.ResourceName} */ (
+    jspb.Message.getWrapperField(this, common_v1_common_pb.ResourceName, 5));
+};
+
+
+/**
+ * @param {?proto.viam.common.v1.ResourceName|undefined} value
+ * @return {!proto.viam.service.motion.v1.MoveOnGlobeRequest} returns this
+*/
+proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.setMovementSensorName = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.motion.v1.MoveOnGlobeRequest} returns this
+ */
+proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.clearMovementSensorName = function() {
+  return this.setMovementSensorName(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.hasMovementSensorName = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * repeated viam.common.v1.GeoObstacle obstacles = 6;
+ * @return {!Array<!proto.viam.common.v1.GeoObstacle>}
+ */
+proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.getObstaclesList = function() {
+  return /** @type{!Array<!proto.viam.common.v1.GeoObstacle>} */ (
+    jspb.Message.getRepeatedWrapperField(this, common_v1_common_pb.GeoObstacle, 6));
+};
+
+
+/**
+ * @param {!Array<!proto.viam.common.v1.GeoObstacle>} value
+ * @return {!proto.viam.service.motion.v1.MoveOnGlobeRequest} returns this
+*/
+proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.setObstaclesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 6, value);
+};
+
+
+/**
+ * @param {!proto.viam.common.v1.GeoObstacle=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.common.v1.GeoObstacle}
+ */
+proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.addObstacles = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 6, opt_value, proto.viam.common.v1.GeoObstacle, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.service.motion.v1.MoveOnGlobeRequest} returns this
+ */
+proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.clearObstaclesList = function() {
+  return this.setObstaclesList([]);
+};
+
+
+/**
+ * optional MotionConfiguration motion_configuration = 7;
+ * @return {?proto.viam.service.motion.v1.MotionConfiguration}
+ */
+proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.getMotionConfiguration = function() {
+  return /** @type{?proto.viam.service.motion.v1.MotionConfiguration} */ (
+    jspb.Message.getWrapperField(this, proto.viam.service.motion.v1.MotionConfiguration, 7));
+};
+
+
+/**
+ * @param {?proto.viam.service.motion.v1.MotionConfiguration|undefined} value
+ * @return {!proto.viam.service.motion.v1.MoveOnGlobeRequest} returns this
+*/
+proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.setMotionConfiguration = function(value) {
+  return jspb.Message.setWrapperField(this, 7, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.motion.v1.MoveOnGlobeRequest} returns this
+ */
+proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.clearMotionConfiguration = function() {
+  return this.setMotionConfiguration(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.hasMotionConfiguration = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+/**
+ * optional bool return_execution_id = 8;
+ * @return {boolean}
+ */
+proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.getReturnExecutionId = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 8, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.viam.service.motion.v1.MoveOnGlobeRequest} returns this
+ */
+proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.setReturnExecutionId = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 8, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.service.motion.v1.MoveOnGlobeRequest} returns this
+*/
+proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.motion.v1.MoveOnGlobeRequest} returns this
+ */
+proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.motion.v1.MoveOnGlobeResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.motion.v1.MoveOnGlobeResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.motion.v1.MoveOnGlobeResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.motion.v1.MoveOnGlobeResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+    executionId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    planningFailure: (f = msg.getPlanningFailure()) && proto.viam.service.motion.v1.PlanningFailure.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.motion.v1.MoveOnGlobeResponse}
+ */
+proto.viam.service.motion.v1.MoveOnGlobeResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.motion.v1.MoveOnGlobeResponse;
+  return proto.viam.service.motion.v1.MoveOnGlobeResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.motion.v1.MoveOnGlobeResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.motion.v1.MoveOnGlobeResponse}
+ */
+proto.viam.service.motion.v1.MoveOnGlobeResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSuccess(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setExecutionId(value);
+      break;
+    case 3:
+      var value = new proto.viam.service.motion.v1.PlanningFailure;
+      reader.readMessage(value,proto.viam.service.motion.v1.PlanningFailure.deserializeBinaryFromReader);
+      msg.setPlanningFailure(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.service.motion.v1.MoveOnGlobeResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.motion.v1.MoveOnGlobeResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.motion.v1.MoveOnGlobeResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.motion.v1.MoveOnGlobeResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSuccess();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+  f = message.getExecutionId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getPlanningFailure();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.viam.service.motion.v1.PlanningFailure.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional bool success = 1;
+ * @return {boolean}
+ */
+proto.viam.service.motion.v1.MoveOnGlobeResponse.prototype.getSuccess = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.viam.service.motion.v1.MoveOnGlobeResponse} returns this
+ */
+proto.viam.service.motion.v1.MoveOnGlobeResponse.prototype.setSuccess = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+/**
+ * optional string execution_id = 2;
+ * @return {string}
+ */
+proto.viam.service.motion.v1.MoveOnGlobeResponse.prototype.getExecutionId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.service.motion.v1.MoveOnGlobeResponse} returns this
+ */
+proto.viam.service.motion.v1.MoveOnGlobeResponse.prototype.setExecutionId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional PlanningFailure planning_failure = 3;
+ * @return {?proto.viam.service.motion.v1.PlanningFailure}
+ */
+proto.viam.service.motion.v1.MoveOnGlobeResponse.prototype.getPlanningFailure = function() {
+  return /** @type{?proto.viam.service.motion.v1.PlanningFailure} */ (
+    jspb.Message.getWrapperField(this, proto.viam.service.motion.v1.PlanningFailure, 3));
+};
+
+
+/**
+ * @param {?proto.viam.service.motion.v1.PlanningFailure|undefined} value
+ * @return {!proto.viam.service.motion.v1.MoveOnGlobeResponse} returns this
+*/
+proto.viam.service.motion.v1.MoveOnGlobeResponse.prototype.setPlanningFailure = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.motion.v1.MoveOnGlobeResponse} returns this
+ */
+proto.viam.service.motion.v1.MoveOnGlobeResponse.prototype.clearPlanningFailure = function() {
+  return this.setPlanningFailure(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.motion.v1.MoveOnGlobeResponse.prototype.hasPlanningFailure = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.service.motion.v1.GetPoseRequest.repeatedFields_ = [4];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.motion.v1.GetPoseRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.motion.v1.GetPoseRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.motion.v1.GetPoseRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.motion.v1.GetPoseRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    componentName: (f = msg.getComponentName()) && common_v1_common_pb.ResourceName.toObject(includeInstance, f),
+    destinationFrame: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    supplementalTransformsList: jspb.Message.toObjectList(msg.getSupplementalTransformsList(),
+    common_v1_common_pb.Transform.toObject, includeInstance),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.motion.v1.GetPoseRequest}
+ */
+proto.viam.service.motion.v1.GetPoseRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.motion.v1.GetPoseRequest;
+  return proto.viam.service.motion.v1.GetPoseRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.motion.v1.GetPoseRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.motion.v1.GetPoseRequest}
+ */
+proto.viam.service.motion.v1.GetPoseRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = new common_v1_common_pb.ResourceName;
+      reader.readMessage(value,common_v1_common_pb.ResourceName.deserializeBinaryFromReader);
+      msg.setComponentName(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDestinationFrame(value);
+      break;
+    case 4:
+      var value = new common_v1_common_pb.Transform;
+      reader.readMessage(value,common_v1_common_pb.Transform.deserializeBinaryFromReader);
+      msg.addSupplementalTransforms(value);
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.service.motion.v1.GetPoseRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.motion.v1.GetPoseRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.motion.v1.GetPoseRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.motion.v1.GetPoseRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getComponentName();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      common_v1_common_pb.ResourceName.serializeBinaryToWriter
+    );
+  }
+  f = message.getDestinationFrame();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getSupplementalTransformsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      common_v1_common_pb.Transform.serializeBinaryToWriter
+    );
+  }
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.viam.service.motion.v1.GetPoseRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.service.motion.v1.GetPoseRequest} returns this
+ */
+proto.viam.service.motion.v1.GetPoseRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional viam.common.v1.ResourceName component_name = 2;
+ * @return {?proto.viam.common.v1.ResourceName}
+ */
+proto.viam.service.motion.v1.GetPoseRequest.prototype.getComponentName = function() {
+  return /** @type{?proto.viam.common.v1.ResourceName} */ (
+    jspb.Message.getWrapperField(this, common_v1_common_pb.ResourceName, 2));
+};
+
+
+/**
+ * @param {?proto.viam.common.v1.ResourceName|undefined} value
+ * @return {!proto.viam.service.motion.v1.GetPoseRequest} returns this
+*/
+proto.viam.service.motion.v1.GetPoseRequest.prototype.setComponentName = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.motion.v1.GetPoseRequest} returns this
+ */
+proto.viam.service.motion.v1.GetPoseRequest.prototype.clearComponentName = function() {
+  return this.setComponentName(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.motion.v1.GetPoseRequest.prototype.hasComponentName = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional string destination_frame = 3;
+ * @return {string}
+ */
+proto.viam.service.motion.v1.GetPoseRequest.prototype.getDestinationFrame = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.service.motion.v1.GetPoseRequest} returns this
+ */
+proto.viam.service.motion.v1.GetPoseRequest.prototype.setDestinationFrame = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * repeated viam.common.v1.Transform supplemental_transforms = 4;
+ * @return {!Array<!proto.viam.common.v1.Transform>}
+ */
+proto.viam.service.motion.v1.GetPoseRequest.prototype.getSupplementalTransformsList = function() {
+  return /** @type{!Array<!proto.viam.common.v1.Transform>} */ (
+    jspb.Message.getRepeatedWrapperField(this, common_v1_common_pb.Transform, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.viam.common.v1.Transform>} value
+ * @return {!proto.viam.service.motion.v1.GetPoseRequest} returns this
+*/
+proto.viam.service.motion.v1.GetPoseRequest.prototype.setSupplementalTransformsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.viam.common.v1.Transform=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.common.v1.Transform}
+ */
+proto.viam.service.motion.v1.GetPoseRequest.prototype.addSupplementalTransforms = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.viam.common.v1.Transform, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.service.motion.v1.GetPoseRequest} returns this
+ */
+proto.viam.service.motion.v1.GetPoseRequest.prototype.clearSupplementalTransformsList = function() {
+  return this.setSupplementalTransformsList([]);
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.service.motion.v1.GetPoseRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.service.motion.v1.GetPoseRequest} returns this
+*/
+proto.viam.service.motion.v1.GetPoseRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.motion.v1.GetPoseRequest} returns this
+ */
+proto.viam.service.motion.v1.GetPoseRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.motion.v1.GetPoseRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.motion.v1.GetPoseResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.motion.v1.GetPoseResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.motion.v1.GetPoseResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.motion.v1.GetPoseResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    pose: (f = msg.getPose()) && common_v1_common_pb.PoseInFrame.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.motion.v1.GetPoseResponse}
+ */
+proto.viam.service.motion.v1.GetPoseResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.motion.v1.GetPoseResponse;
+  return proto.viam.service.motion.v1.GetPoseResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.motion.v1.GetPoseResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.motion.v1.GetPoseResponse}
+ */
+proto.viam.service.motion.v1.GetPoseResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new common_v1_common_pb.PoseInFrame;
+      reader.readMessage(value,common_v1_common_pb.PoseInFrame.deserializeBinaryFromReader);
+      msg.setPose(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.service.motion.v1.GetPoseResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.motion.v1.GetPoseResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.motion.v1.GetPoseResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.motion.v1.GetPoseResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPose();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      common_v1_common_pb.PoseInFrame.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional viam.common.v1.PoseInFrame pose = 1;
+ * @return {?proto.viam.common.v1.PoseInFrame}
+ */
+proto.viam.service.motion.v1.GetPoseResponse.prototype.getPose = function() {
+  return /** @type{?proto.viam.common.v1.PoseInFrame} */ (
+    jspb.Message.getWrapperField(this, common_v1_common_pb.PoseInFrame, 1));
+};
+
+
+/**
+ * @param {?proto.viam.common.v1.PoseInFrame|undefined} value
+ * @return {!proto.viam.service.motion.v1.GetPoseResponse} returns this
+*/
+proto.viam.service.motion.v1.GetPoseResponse.prototype.setPose = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.motion.v1.GetPoseResponse} returns this
+ */
+proto.viam.service.motion.v1.GetPoseResponse.prototype.clearPose = function() {
+  return this.setPose(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.motion.v1.GetPoseResponse.prototype.hasPose = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.motion.v1.GetPlanRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.motion.v1.GetPlanRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.motion.v1.GetPlanRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.motion.v1.GetPlanRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    executionId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    includeReplans: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.motion.v1.GetPlanRequest}
+ */
+proto.viam.service.motion.v1.GetPlanRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.motion.v1.GetPlanRequest;
+  return proto.viam.service.motion.v1.GetPlanRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.motion.v1.GetPlanRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.motion.v1.GetPlanRequest}
+ */
+proto.viam.service.motion.v1.GetPlanRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setExecutionId(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIncludeReplans(value);
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.service.motion.v1.GetPlanRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.motion.v1.GetPlanRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.motion.v1.GetPlanRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.motion.v1.GetPlanRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getExecutionId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getIncludeReplans();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.viam.service.motion.v1.GetPlanRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.service.motion.v1.GetPlanRequest} returns this
+ */
+proto.viam.service.motion.v1.GetPlanRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string execution_id = 2;
+ * @return {string}
+ */
+proto.viam.service.motion.v1.GetPlanRequest.prototype.getExecutionId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.service.motion.v1.GetPlanRequest} returns this
+ */
+proto.viam.service.motion.v1.GetPlanRequest.prototype.setExecutionId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional bool include_replans = 3;
+ * @return {boolean}
+ */
+proto.viam.service.motion.v1.GetPlanRequest.prototype.getIncludeReplans = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.viam.service.motion.v1.GetPlanRequest} returns this
+ */
+proto.viam.service.motion.v1.GetPlanRequest.prototype.setIncludeReplans = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.service.motion.v1.GetPlanRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.service.motion.v1.GetPlanRequest} returns this
+*/
+proto.viam.service.motion.v1.GetPlanRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.motion.v1.GetPlanRequest} returns this
+ */
+proto.viam.service.motion.v1.GetPlanRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.motion.v1.GetPlanRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.service.motion.v1.GetPlanResponse.repeatedFields_ = [3];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.motion.v1.GetPlanResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.motion.v1.GetPlanResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.motion.v1.GetPlanResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.motion.v1.GetPlanResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    currentPlan: (f = msg.getCurrentPlan()) && proto.viam.service.motion.v1.Plan.toObject(includeInstance, f),
+    status: (f = msg.getStatus()) && proto.viam.service.motion.v1.ExecutionStatus.toObject(includeInstance, f),
+    replansList: jspb.Message.toObjectList(msg.getReplansList(),
+    proto.viam.service.motion.v1.Plan.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.motion.v1.GetPlanResponse}
+ */
+proto.viam.service.motion.v1.GetPlanResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.motion.v1.GetPlanResponse;
+  return proto.viam.service.motion.v1.GetPlanResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.motion.v1.GetPlanResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.motion.v1.GetPlanResponse}
+ */
+proto.viam.service.motion.v1.GetPlanResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.viam.service.motion.v1.Plan;
+      reader.readMessage(value,proto.viam.service.motion.v1.Plan.deserializeBinaryFromReader);
+      msg.setCurrentPlan(value);
+      break;
+    case 2:
+      var value = new proto.viam.service.motion.v1.ExecutionStatus;
+      reader.readMessage(value,proto.viam.service.motion.v1.ExecutionStatus.deserializeBinaryFromReader);
+      msg.setStatus(value);
+      break;
+    case 3:
+      var value = new proto.viam.service.motion.v1.Plan;
+      reader.readMessage(value,proto.viam.service.motion.v1.Plan.deserializeBinaryFromReader);
+      msg.addReplans(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.service.motion.v1.GetPlanResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.motion.v1.GetPlanResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.motion.v1.GetPlanResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.motion.v1.GetPlanResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCurrentPlan();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.viam.service.motion.v1.Plan.serializeBinaryToWriter
+    );
+  }
+  f = message.getStatus();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.viam.service.motion.v1.ExecutionStatus.serializeBinaryToWriter
+    );
+  }
+  f = message.getReplansList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.viam.service.motion.v1.Plan.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional Plan current_plan = 1;
+ * @return {?proto.viam.service.motion.v1.Plan}
+ */
+proto.viam.service.motion.v1.GetPlanResponse.prototype.getCurrentPlan = function() {
+  return /** @type{?proto.viam.service.motion.v1.Plan} */ (
+    jspb.Message.getWrapperField(this, proto.viam.service.motion.v1.Plan, 1));
+};
+
+
+/**
+ * @param {?proto.viam.service.motion.v1.Plan|undefined} value
+ * @return {!proto.viam.service.motion.v1.GetPlanResponse} returns this
+*/
+proto.viam.service.motion.v1.GetPlanResponse.prototype.setCurrentPlan = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.motion.v1.GetPlanResponse} returns this
+ */
+proto.viam.service.motion.v1.GetPlanResponse.prototype.clearCurrentPlan = function() {
+  return this.setCurrentPlan(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.motion.v1.GetPlanResponse.prototype.hasCurrentPlan = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional ExecutionStatus status = 2;
+ * @return {?proto.viam.service.motion.v1.ExecutionStatus}
+ */
+proto.viam.service.motion.v1.GetPlanResponse.prototype.getStatus = function() {
+  return /** @type{?proto.viam.service.motion.v1.ExecutionStatus} */ (
+    jspb.Message.getWrapperField(this, proto.viam.service.motion.v1.ExecutionStatus, 2));
+};
+
+
+/**
+ * @param {?proto.viam.service.motion.v1.ExecutionStatus|undefined} value
+ * @return {!proto.viam.service.motion.v1.GetPlanResponse} returns this
+*/
+proto.viam.service.motion.v1.GetPlanResponse.prototype.setStatus = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.motion.v1.GetPlanResponse} returns this
+ */
+proto.viam.service.motion.v1.GetPlanResponse.prototype.clearStatus = function() {
+  return this.setStatus(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.motion.v1.GetPlanResponse.prototype.hasStatus = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * repeated Plan replans = 3;
+ * @return {!Array<!proto.viam.service.motion.v1.Plan>}
+ */
+proto.viam.service.motion.v1.GetPlanResponse.prototype.getReplansList = function() {
+  return /** @type{!Array<!proto.viam.service.motion.v1.Plan>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.viam.service.motion.v1.Plan, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.viam.service.motion.v1.Plan>} value
+ * @return {!proto.viam.service.motion.v1.GetPlanResponse} returns this
+*/
+proto.viam.service.motion.v1.GetPlanResponse.prototype.setReplansList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.viam.service.motion.v1.Plan=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.service.motion.v1.Plan}
+ */
+proto.viam.service.motion.v1.GetPlanResponse.prototype.addReplans = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.viam.service.motion.v1.Plan, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.service.motion.v1.GetPlanResponse} returns this
+ */
+proto.viam.service.motion.v1.GetPlanResponse.prototype.clearReplansList = function() {
+  return this.setReplansList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.motion.v1.GetExecutionStatusRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.motion.v1.GetExecutionStatusRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.motion.v1.GetExecutionStatusRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.motion.v1.GetExecutionStatusRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    executionId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.motion.v1.GetExecutionStatusRequest}
+ */
+proto.viam.service.motion.v1.GetExecutionStatusRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.motion.v1.GetExecutionStatusRequest;
+  return proto.viam.service.motion.v1.GetExecutionStatusRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.motion.v1.GetExecutionStatusRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.motion.v1.GetExecutionStatusRequest}
+ */
+proto.viam.service.motion.v1.GetExecutionStatusRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setExecutionId(value);
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.service.motion.v1.GetExecutionStatusRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.motion.v1.GetExecutionStatusRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.motion.v1.GetExecutionStatusRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.motion.v1.GetExecutionStatusRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getExecutionId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.viam.service.motion.v1.GetExecutionStatusRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.service.motion.v1.GetExecutionStatusRequest} returns this
+ */
+proto.viam.service.motion.v1.GetExecutionStatusRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string execution_id = 2;
+ * @return {string}
+ */
+proto.viam.service.motion.v1.GetExecutionStatusRequest.prototype.getExecutionId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.service.motion.v1.GetExecutionStatusRequest} returns this
+ */
+proto.viam.service.motion.v1.GetExecutionStatusRequest.prototype.setExecutionId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.service.motion.v1.GetExecutionStatusRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.service.motion.v1.GetExecutionStatusRequest} returns this
+*/
+proto.viam.service.motion.v1.GetExecutionStatusRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.motion.v1.GetExecutionStatusRequest} returns this
+ */
+proto.viam.service.motion.v1.GetExecutionStatusRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.motion.v1.GetExecutionStatusRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.motion.v1.GetExecutionStatusResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.motion.v1.GetExecutionStatusResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.motion.v1.GetExecutionStatusResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.motion.v1.GetExecutionStatusResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    status: (f = msg.getStatus()) && proto.viam.service.motion.v1.ExecutionStatus.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.motion.v1.GetExecutionStatusResponse}
+ */
+proto.viam.service.motion.v1.GetExecutionStatusResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.motion.v1.GetExecutionStatusResponse;
+  return proto.viam.service.motion.v1.GetExecutionStatusResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.motion.v1.GetExecutionStatusResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.motion.v1.GetExecutionStatusResponse}
+ */
+proto.viam.service.motion.v1.GetExecutionStatusResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.viam.service.motion.v1.ExecutionStatus;
+      reader.readMessage(value,proto.viam.service.motion.v1.ExecutionStatus.deserializeBinaryFromReader);
+      msg.setStatus(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.service.motion.v1.GetExecutionStatusResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.motion.v1.GetExecutionStatusResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.motion.v1.GetExecutionStatusResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.motion.v1.GetExecutionStatusResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getStatus();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.viam.service.motion.v1.ExecutionStatus.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional ExecutionStatus status = 1;
+ * @return {?proto.viam.service.motion.v1.ExecutionStatus}
+ */
+proto.viam.service.motion.v1.GetExecutionStatusResponse.prototype.getStatus = function() {
+  return /** @type{?proto.viam.service.motion.v1.ExecutionStatus} */ (
+    jspb.Message.getWrapperField(this, proto.viam.service.motion.v1.ExecutionStatus, 1));
+};
+
+
+/**
+ * @param {?proto.viam.service.motion.v1.ExecutionStatus|undefined} value
+ * @return {!proto.viam.service.motion.v1.GetExecutionStatusResponse} returns this
+*/
+proto.viam.service.motion.v1.GetExecutionStatusResponse.prototype.setStatus = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.motion.v1.GetExecutionStatusResponse} returns this
+ */
+proto.viam.service.motion.v1.GetExecutionStatusResponse.prototype.clearStatus = function() {
+  return this.setStatus(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.motion.v1.GetExecutionStatusResponse.prototype.hasStatus = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.motion.v1.StopExecutionRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.motion.v1.StopExecutionRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.motion.v1.StopExecutionRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.motion.v1.StopExecutionRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    executionId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.motion.v1.StopExecutionRequest}
+ */
+proto.viam.service.motion.v1.StopExecutionRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.motion.v1.StopExecutionRequest;
+  return proto.viam.service.motion.v1.StopExecutionRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.motion.v1.StopExecutionRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.motion.v1.StopExecutionRequest}
+ */
+proto.viam.service.motion.v1.StopExecutionRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setExecutionId(value);
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.service.motion.v1.StopExecutionRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.motion.v1.StopExecutionRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.motion.v1.StopExecutionRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.motion.v1.StopExecutionRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getExecutionId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.viam.service.motion.v1.StopExecutionRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.service.motion.v1.StopExecutionRequest} returns this
+ */
+proto.viam.service.motion.v1.StopExecutionRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string execution_id = 2;
+ * @return {string}
+ */
+proto.viam.service.motion.v1.StopExecutionRequest.prototype.getExecutionId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.service.motion.v1.StopExecutionRequest} returns this
+ */
+proto.viam.service.motion.v1.StopExecutionRequest.prototype.setExecutionId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.service.motion.v1.StopExecutionRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.service.motion.v1.StopExecutionRequest} returns this
+*/
+proto.viam.service.motion.v1.StopExecutionRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.motion.v1.StopExecutionRequest} returns this
+ */
+proto.viam.service.motion.v1.StopExecutionRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.motion.v1.StopExecutionRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.motion.v1.StopExecutionResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.motion.v1.StopExecutionResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.motion.v1.StopExecutionResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.motion.v1.StopExecutionResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.motion.v1.StopExecutionResponse}
+ */
+proto.viam.service.motion.v1.StopExecutionResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.motion.v1.StopExecutionResponse;
+  return proto.viam.service.motion.v1.StopExecutionResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.motion.v1.StopExecutionResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.motion.v1.StopExecutionResponse}
+ */
+proto.viam.service.motion.v1.StopExecutionResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.service.motion.v1.StopExecutionResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.motion.v1.StopExecutionResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.motion.v1.StopExecutionResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.motion.v1.StopExecutionResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.service.motion.v1.Plan.repeatedFields_ = [4];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.motion.v1.Plan.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.motion.v1.Plan.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.motion.v1.Plan} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.motion.v1.Plan.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    executionId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    componentName: (f = msg.getComponentName()) && common_v1_common_pb.ResourceName.toObject(includeInstance, f),
+    stepsList: jspb.Message.toObjectList(msg.getStepsList(),
+    proto.viam.service.motion.v1.PlanStep.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.motion.v1.Plan}
+ */
+proto.viam.service.motion.v1.Plan.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.motion.v1.Plan;
+  return proto.viam.service.motion.v1.Plan.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.motion.v1.Plan} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.motion.v1.Plan}
+ */
+proto.viam.service.motion.v1.Plan.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setExecutionId(value);
+      break;
+    case 3:
+      var value = new common_v1_common_pb.ResourceName;
+      reader.readMessage(value,common_v1_common_pb.ResourceName.deserializeBinaryFromReader);
+      msg.setComponentName(value);
+      break;
+    case 4:
+      var value = new proto.viam.service.motion.v1.PlanStep;
+      reader.readMessage(value,proto.viam.service.motion.v1.PlanStep.deserializeBinaryFromReader);
+      msg.addSteps(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.service.motion.v1.Plan.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.motion.v1.Plan.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.motion.v1.Plan} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.motion.v1.Plan.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getExecutionId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getComponentName();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      common_v1_common_pb.ResourceName.serializeBinaryToWriter
+    );
+  }
+  f = message.getStepsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.viam.service.motion.v1.PlanStep.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.viam.service.motion.v1.Plan.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.service.motion.v1.Plan} returns this
+ */
+proto.viam.service.motion.v1.Plan.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string execution_id = 2;
+ * @return {string}
+ */
+proto.viam.service.motion.v1.Plan.prototype.getExecutionId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.service.motion.v1.Plan} returns this
+ */
+proto.viam.service.motion.v1.Plan.prototype.setExecutionId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional viam.common.v1.ResourceName component_name = 3;
+ * @return {?proto.viam.common.v1.ResourceName}
+ */
+proto.viam.service.motion.v1.Plan.prototype.getComponentName = function() {
+  return /** @type{?proto.viam.common.v1.ResourceName} */ (
+    jspb.Message.getWrapperField(this, common_v1_common_pb.ResourceName, 3));
+};
+
+
+/**
+ * @param {?proto.viam.common.v1.ResourceName|undefined} value
+ * @return {!proto.viam.service.motion.v1.Plan} returns this
+*/
+proto.viam.service.motion.v1.Plan.prototype.setComponentName = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.motion.v1.Plan} returns this
+ */
+proto.viam.service.motion.v1.Plan.prototype.clearComponentName = function() {
+  return this.setComponentName(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.motion.v1.Plan.prototype.hasComponentName = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * repeated PlanStep steps = 4;
+ * @return {!Array<!proto.viam.service.motion.v1.PlanStep>}
+ */
+proto.viam.service.motion.v1.Plan.prototype.getStepsList = function() {
+  return /** @type{!Array<!proto.viam.service.motion.v1.PlanStep>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.viam.service.motion.v1.PlanStep, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.viam.service.motion.v1.PlanStep>} value
+ * @return {!proto.viam.service.motion.v1.Plan} returns this
+*/
+proto.viam.service.motion.v1.Plan.prototype.setStepsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.viam.service.motion.v1.PlanStep=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.service.motion.v1.PlanStep}
+ */
+proto.viam.service.motion.v1.Plan.prototype.addSteps = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.viam.service.motion.v1.PlanStep, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.service.motion.v1.Plan} returns this
+ */
+proto.viam.service.motion.v1.Plan.prototype.clearStepsList = function() {
+  return this.setStepsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.motion.v1.PlanStep.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.motion.v1.PlanStep.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.motion.v1.PlanStep} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.motion.v1.PlanStep.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    stepMap: (f = msg.getStepMap()) ? f.toObject(includeInstance, proto.viam.service.motion.v1.ComponentState.toObject) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.motion.v1.PlanStep}
+ */
+proto.viam.service.motion.v1.PlanStep.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.motion.v1.PlanStep;
+  return proto.viam.service.motion.v1.PlanStep.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.motion.v1.PlanStep} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.motion.v1.PlanStep}
+ */
+proto.viam.service.motion.v1.PlanStep.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getStepMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.viam.service.motion.v1.ComponentState.deserializeBinaryFromReader, "", new proto.viam.service.motion.v1.ComponentState());
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.service.motion.v1.PlanStep.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.motion.v1.PlanStep.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.motion.v1.PlanStep} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.motion.v1.PlanStep.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getStepMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.viam.service.motion.v1.ComponentState.serializeBinaryToWriter);
+  }
+};
+
+
+/**
+ * map<string, ComponentState> step = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.viam.service.motion.v1.ComponentState>}
+ */
+proto.viam.service.motion.v1.PlanStep.prototype.getStepMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.viam.service.motion.v1.ComponentState>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      proto.viam.service.motion.v1.ComponentState));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.viam.service.motion.v1.PlanStep} returns this
+ */
+proto.viam.service.motion.v1.PlanStep.prototype.clearStepMap = function() {
+  this.getStepMap().clear();
+  return this;};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.service.motion.v1.ComponentState.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.motion.v1.ComponentState.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.motion.v1.ComponentState.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.motion.v1.ComponentState} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.motion.v1.ComponentState.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    pose: (f = msg.getPose()) && common_v1_common_pb.Pose.toObject(includeInstance, f),
+    inputsList: (f = jspb.Message.getRepeatedFloatingPointField(msg, 2)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.motion.v1.ComponentState}
+ */
+proto.viam.service.motion.v1.ComponentState.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.motion.v1.ComponentState;
+  return proto.viam.service.motion.v1.ComponentState.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.motion.v1.ComponentState} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.motion.v1.ComponentState}
+ */
+proto.viam.service.motion.v1.ComponentState.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new common_v1_common_pb.Pose;
+      reader.readMessage(value,common_v1_common_pb.Pose.deserializeBinaryFromReader);
+      msg.setPose(value);
+      break;
+    case 2:
+      var values = /** @type {!Array<number>} */ (reader.isDelimited() ? reader.readPackedDouble() : [reader.readDouble()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addInputs(values[i]);
+      }
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.service.motion.v1.ComponentState.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.motion.v1.ComponentState.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.motion.v1.ComponentState} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.motion.v1.ComponentState.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPose();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      common_v1_common_pb.Pose.serializeBinaryToWriter
+    );
+  }
+  f = message.getInputsList();
+  if (f.length > 0) {
+    writer.writePackedDouble(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional viam.common.v1.Pose pose = 1;
+ * @return {?proto.viam.common.v1.Pose}
+ */
+proto.viam.service.motion.v1.ComponentState.prototype.getPose = function() {
+  return /** @type{?proto.viam.common.v1.Pose} */ (
+    jspb.Message.getWrapperField(this, common_v1_common_pb.Pose, 1));
+};
+
+
+/**
+ * @param {?proto.viam.common.v1.Pose|undefined} value
+ * @return {!proto.viam.service.motion.v1.ComponentState} returns this
+*/
+proto.viam.service.motion.v1.ComponentState.prototype.setPose = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.motion.v1.ComponentState} returns this
+ */
+proto.viam.service.motion.v1.ComponentState.prototype.clearPose = function() {
+  return this.setPose(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.motion.v1.ComponentState.prototype.hasPose = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * repeated double inputs = 2;
+ * @return {!Array<number>}
+ */
+proto.viam.service.motion.v1.ComponentState.prototype.getInputsList = function() {
+  return /** @type {!Array<number>} */ (jspb.Message.getRepeatedFloatingPointField(this, 2));
+};
+
+
+/**
+ * @param {!Array<number>} value
+ * @return {!proto.viam.service.motion.v1.ComponentState} returns this
+ */
+proto.viam.service.motion.v1.ComponentState.prototype.setInputsList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {number} value
+ * @param {number=} opt_index
+ * @return {!proto.viam.service.motion.v1.ComponentState} returns this
+ */
+proto.viam.service.motion.v1.ComponentState.prototype.addInputs = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.service.motion.v1.ComponentState} returns this
+ */
+proto.viam.service.motion.v1.ComponentState.prototype.clearInputsList = function() {
+  return this.setInputsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.motion.v1.ExecutionStatus.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.motion.v1.ExecutionStatus.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.motion.v1.ExecutionStatus} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.motion.v1.ExecutionStatus.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    executionId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    componentName: (f = msg.getComponentName()) && common_v1_common_pb.ResourceName.toObject(includeInstance, f),
+    state: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    planId: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    currentStepIndex: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    replanCount: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    reason: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    timestamp: (f = msg.getTimestamp()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    planningFailure: (f = msg.getPlanningFailure()) && proto.viam.service.motion.v1.PlanningFailure.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.motion.v1.ExecutionStatus}
+ */
+proto.viam.service.motion.v1.ExecutionStatus.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.motion.v1.ExecutionStatus;
+  return proto.viam.service.motion.v1.ExecutionStatus.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.motion.v1.ExecutionStatus} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.motion.v1.ExecutionStatus}
+ */
+proto.viam.service.motion.v1.ExecutionStatus.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setExecutionId(value);
+      break;
+    case 2:
+      var value = new common_v1_common_pb.ResourceName;
+      reader.readMessage(value,common_v1_common_pb.ResourceName.deserializeBinaryFromReader);
+      msg.setComponentName(value);
+      break;
+    case 3:
+      var value = /** @type {!proto.viam.service.motion.v1.ExecutionState} */ (reader.readEnum());
+      msg.setState(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPlanId(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setCurrentStepIndex(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setReplanCount(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setReason(value);
+      break;
+    case 8:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setTimestamp(value);
+      break;
+    case 9:
+      var value = new proto.viam.service.motion.v1.PlanningFailure;
+      reader.readMessage(value,proto.viam.service.motion.v1.PlanningFailure.deserializeBinaryFromReader);
+      msg.setPlanningFailure(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.service.motion.v1.ExecutionStatus.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.motion.v1.ExecutionStatus.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.motion.v1.ExecutionStatus} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.motion.v1.ExecutionStatus.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getExecutionId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getComponentName();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      common_v1_common_pb.ResourceName.serializeBinaryToWriter
+    );
+  }
+  f = message.getState();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      3,
+      f
+    );
+  }
+  f = message.getPlanId();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getCurrentStepIndex();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
+  f = message.getReplanCount();
+  if (f !== 0) {
+    writer.writeUint32(
+      6,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 7));
+  if (f != null) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getTimestamp();
+  if (f != null) {
+    writer.writeMessage(
+      8,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getPlanningFailure();
+  if (f != null) {
+    writer.writeMessage(
+      9,
+      f,
+      proto.viam.service.motion.v1.PlanningFailure.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string execution_id = 1;
+ * @return {string}
+ */
+proto.viam.service.motion.v1.ExecutionStatus.prototype.getExecutionId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.service.motion.v1.ExecutionStatus} returns this
+ */
+proto.viam.service.motion.v1.ExecutionStatus.prototype.setExecutionId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional viam.common.v1.ResourceName component_name = 2;
+ * @return {?proto.viam.common.v1.ResourceName}
+ */
+proto.viam.service.motion.v1.ExecutionStatus.prototype.getComponentName = function() {
+  return /** @type{?proto.viam.common.v1.ResourceName} */ (
+    jspb.Message.getWrapperField(this, common_v1_common_pb.ResourceName, 2));
+};
+
+
+/**
+ * @param {?proto.viam.common.v1.ResourceName|undefined} value
+ * @return {!proto.viam.service.motion.v1.ExecutionStatus} returns this
+*/
+proto.viam.service.motion.v1.ExecutionStatus.prototype.setComponentName = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.motion.v1.ExecutionStatus} returns this
+ */
+proto.viam.service.motion.v1.ExecutionStatus.prototype.clearComponentName = function() {
+  return this.setComponentName(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.motion.v1.ExecutionStatus.prototype.hasComponentName = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional ExecutionState state = 3;
+ * @return {!proto.viam.service.motion.v1.ExecutionState}
+ */
+proto.viam.service.motion.v1.ExecutionStatus.prototype.getState = function() {
+  return /** @type {!proto.viam.service.motion.v1.ExecutionState} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {!proto.viam.service.motion.v1.ExecutionState} value
+ * @return {!proto.viam.service.motion.v1.ExecutionStatus} returns this
+ */
+proto.viam.service.motion.v1.ExecutionStatus.prototype.setState = function(value) {
+  return jspb.Message.setProto3EnumField(this, 3, value);
+};
+
+
+/**
+ * optional string plan_id = 4;
+ * @return {string}
+ */
+proto.viam.service.motion.v1.ExecutionStatus.prototype.getPlanId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.service.motion.v1.ExecutionStatus} returns this
+ */
+proto.viam.service.motion.v1.ExecutionStatus.prototype.setPlanId = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional uint32 current_step_index = 5;
+ * @return {number}
+ */
+proto.viam.service.motion.v1.ExecutionStatus.prototype.getCurrentStepIndex = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.service.motion.v1.ExecutionStatus} returns this
+ */
+proto.viam.service.motion.v1.ExecutionStatus.prototype.setCurrentStepIndex = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional uint32 replan_count = 6;
+ * @return {number}
+ */
+proto.viam.service.motion.v1.ExecutionStatus.prototype.getReplanCount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.service.motion.v1.ExecutionStatus} returns this
+ */
+proto.viam.service.motion.v1.ExecutionStatus.prototype.setReplanCount = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * optional string reason = 7;
+ * @return {string}
+ */
+proto.viam.service.motion.v1.ExecutionStatus.prototype.getReason = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.service.motion.v1.ExecutionStatus} returns this
+ */
+proto.viam.service.motion.v1.ExecutionStatus.prototype.setReason = function(value) {
+  return jspb.Message.setField(this, 7, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.viam.service.motion.v1.ExecutionStatus} returns this
+ */
+proto.viam.service.motion.v1.ExecutionStatus.prototype.clearReason = function() {
+  return jspb.Message.setField(this, 7, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.motion.v1.ExecutionStatus.prototype.hasReason = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+/**
+ * optional google.protobuf.Timestamp timestamp = 8;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.viam.service.motion.v1.ExecutionStatus.prototype.getTimestamp = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 8));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.viam.service.motion.v1.ExecutionStatus} returns this
+*/
+proto.viam.service.motion.v1.ExecutionStatus.prototype.setTimestamp = function(value) {
+  return jspb.Message.setWrapperField(this, 8, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.motion.v1.ExecutionStatus} returns this
+ */
+proto.viam.service.motion.v1.ExecutionStatus.prototype.clearTimestamp = function() {
+  return this.setTimestamp(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.motion.v1.ExecutionStatus.prototype.hasTimestamp = function() {
+  return jspb.Message.getField(this, 8) != null;
+};
+
+
+/**
+ * optional PlanningFailure planning_failure = 9;
+ * @return {?proto.viam.service.motion.v1.PlanningFailure}
+ */
+proto.viam.service.motion.v1.ExecutionStatus.prototype.getPlanningFailure = function() {
+  return /** @type{?proto.viam.service.motion.v1.PlanningFailure} */ (
+    jspb.Message.getWrapperField(this, proto.viam.service.motion.v1.PlanningFailure, 9));
+};
+
+
+/**
+ * @param {?proto.viam.service.motion.v1.PlanningFailure|undefined} value
+ * @return {!proto.viam.service.motion.v1.ExecutionStatus} returns this
+*/
+proto.viam.service.motion.v1.ExecutionStatus.prototype.setPlanningFailure = function(value) {
+  return jspb.Message.setWrapperField(this, 9, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.motion.v1.ExecutionStatus} returns this
+ */
+proto.viam.service.motion.v1.ExecutionStatus.prototype.clearPlanningFailure = function() {
+  return this.setPlanningFailure(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.motion.v1.ExecutionStatus.prototype.hasPlanningFailure = function() {
+  return jspb.Message.getField(this, 9) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.service.motion.v1.PlanningFailure.repeatedFields_ = [3,4];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.motion.v1.PlanningFailure.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.motion.v1.PlanningFailure.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.motion.v1.PlanningFailure} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.motion.v1.PlanningFailure.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    reason: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    description: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    framesList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+    geometriesList: jspb.Message.toObjectList(msg.getGeometriesList(),
+    common_v1_common_pb.GeometriesInFrame.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.motion.v1.PlanningFailure}
+ */
+proto.viam.service.motion.v1.PlanningFailure.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.motion.v1.PlanningFailure;
+  return proto.viam.service.motion.v1.PlanningFailure.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.motion.v1.PlanningFailure} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.motion.v1.PlanningFailure}
+ */
+proto.viam.service.motion.v1.PlanningFailure.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.viam.service.motion.v1.PlanningFailureReason} */ (reader.readEnum());
+      msg.setReason(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDescription(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addFrames(value);
+      break;
+    case 4:
+      var value = new common_v1_common_pb.GeometriesInFrame;
+      reader.readMessage(value,common_v1_common_pb.GeometriesInFrame.deserializeBinaryFromReader);
+      msg.addGeometries(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.service.motion.v1.PlanningFailure.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.motion.v1.PlanningFailure.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.motion.v1.PlanningFailure} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.motion.v1.PlanningFailure.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getReason();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getDescription();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getFramesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      3,
+      f
+    );
+  }
+  f = message.getGeometriesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      common_v1_common_pb.GeometriesInFrame.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional PlanningFailureReason reason = 1;
+ * @return {!proto.viam.service.motion.v1.PlanningFailureReason}
+ */
+proto.viam.service.motion.v1.PlanningFailure.prototype.getReason = function() {
+  return /** @type {!proto.viam.service.motion.v1.PlanningFailureReason} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.viam.service.motion.v1.PlanningFailureReason} value
+ * @return {!proto.viam.service.motion.v1.PlanningFailure} returns this
+ */
+proto.viam.service.motion.v1.PlanningFailure.prototype.setReason = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional string description = 2;
+ * @return {string}
+ */
+proto.viam.service.motion.v1.PlanningFailure.prototype.getDescription = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.service.motion.v1.PlanningFailure} returns this
+ */
+proto.viam.service.motion.v1.PlanningFailure.prototype.setDescription = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * repeated string frames = 3;
+ * @return {!Array<string>}
+ */
+proto.viam.service.motion.v1.PlanningFailure.prototype.getFramesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.viam.service.motion.v1.PlanningFailure} returns this
+ */
+proto.viam.service.motion.v1.PlanningFailure.prototype.setFramesList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.viam.service.motion.v1.PlanningFailure} returns this
+ */
+proto.viam.service.motion.v1.PlanningFailure.prototype.addFrames = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.service.motion.v1.PlanningFailure} returns this
+ */
+proto.viam.service.motion.v1.PlanningFailure.prototype.clearFramesList = function() {
+  return this.setFramesList([]);
+};
+
+
+/**
+ * repeated viam.common.v1.GeometriesInFrame geometries = 4;
+ * @return {!Array<!proto.viam.common.v1.GeometriesInFrame>}
+ */
+proto.viam.service.motion.v1.PlanningFailure.prototype.getGeometriesList = function() {
+  return /** @type{!Array<!proto.viam.common.v1.GeometriesInFrame>} */ (
+    jspb.Message.getRepeatedWrapperField(this, common_v1_common_pb.GeometriesInFrame, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.viam.common.v1.GeometriesInFrame>} value
+ * @return {!proto.viam.service.motion.v1.PlanningFailure} returns this
+*/
+proto.viam.service.motion.v1.PlanningFailure.prototype.setGeometriesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.viam.common.v1.GeometriesInFrame=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.common.v1.GeometriesInFrame}
+ */
+proto.viam.service.motion.v1.PlanningFailure.prototype.addGeometries = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.viam.common.v1.GeometriesInFrame, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.service.motion.v1.PlanningFailure} returns this
+ */
+proto.viam.service.motion.v1.PlanningFailure.prototype.clearGeometriesList = function() {
+  return this.setGeometriesList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.service.motion.v1.Constraints.repeatedFields_ = [1,2,3,4,5,6,7];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.motion.v1.Constraints.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.motion.v1.Constraints.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.motion.v1.Constraints} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.motion.v1.Constraints.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    linearConstraintList: jspb.Message.toObjectList(msg.getLinearConstraintList(),
+    proto.viam.service.motion.v1.LinearConstraint.toObject, includeInstance),
+    orientationConstraintList: jspb.Message.toObjectList(msg.getOrientationConstraintList(),
+    proto.viam.service.motion.v1.OrientationConstraint.toObject, includeInstance),
+    collisionSpecificationList: jspb.Message.toObjectList(msg.getCollisionSpecificationList(),
+    proto.viam.service.motion.v1.CollisionSpecification.toObject, includeInstance),
+    pseudolinearConstraintList: jspb.Message.toObjectList(msg.getPseudolinearConstraintList(),
+    proto.viam.service.motion.v1.PseudolinearConstraint.toObject, includeInstance),
+    keepOutZoneConstraintList: jspb.Message.toObjectList(msg.getKeepOutZoneConstraintList(),
+    proto.viam.service.motion.v1.KeepOutZoneConstraint.toObject, includeInstance),
+    jointRangeConstraintList: jspb.Message.toObjectList(msg.getJointRangeConstraintList(),
+    proto.viam.service.motion.v1.JointRangeConstraint.toObject, includeInstance),
+    endEffectorSpeedConstraintList: jspb.Message.toObjectList(msg.getEndEffectorSpeedConstraintList(),
+    proto.viam.service.motion.v1.EndEffectorSpeedConstraint.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.motion.v1.Constraints}
+ */
+proto.viam.service.motion.v1.Constraints.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.motion.v1.Constraints;
+  return proto.viam.service.motion.v1.Constraints.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.motion.v1.Constraints} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.motion.v1.Constraints}
+ */
+proto.viam.service.motion.v1.Constraints.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.viam.service.motion.v1.LinearConstraint;
+      reader.readMessage(value,proto.viam.service.motion.v1.LinearConstraint.deserializeBinaryFromReader);
+      msg.addLinearConstraint(value);
+      break;
+    case 2:
+      var value = new proto.viam.service.motion.v1.OrientationConstraint;
+      reader.readMessage(value,proto.viam.service.motion.v1.OrientationConstraint.deserializeBinaryFromReader);
+      msg.addOrientationConstraint(value);
+      break;
+    case 3:
+      var value = new proto.viam.service.motion.v1.CollisionSpecification;
+      reader.readMessage(value,proto.viam.service.motion.v1.CollisionSpecification.deserializeBinaryFromReader);
+      msg.addCollisionSpecification(value);
+      break;
+    case 4:
+      var value = new proto.viam.service.motion.v1.PseudolinearConstraint;
+      reader.readMessage(value,proto.viam.service.motion.v1.PseudolinearConstraint.deserializeBinaryFromReader);
+      msg.addPseudolinearConstraint(value);
+      break;
+    case 5:
+      var value = new proto.viam.service.motion.v1.KeepOutZoneConstraint;
+      reader.readMessage(value,proto.viam.service.motion.v1.KeepOutZoneConstraint.deserializeBinaryFromReader);
+      msg.addKeepOutZoneConstraint(value);
+      break;
+    case 6:
+      var value = new proto.viam.service.motion.v1.JointRangeConstraint;
+      reader.readMessage(value,proto.viam.service.motion.v1.JointRangeConstraint.deserializeBinaryFromReader);
+      msg.addJointRangeConstraint(value);
+      break;
+    case 7:
+      var value = new proto.viam.service.motion.v1.EndEffectorSpeedConstraint;
+      reader.readMessage(value,proto.viam.service.motion.v1.EndEffectorSpeedConstraint.deserializeBinaryFromReader);
+      msg.addEndEffectorSpeedConstraint(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.service.motion.v1.Constraints.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.motion.v1.Constraints.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.motion.v1.Constraints} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.motion.v1.Constraints.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getLinearConstraintList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.viam.service.motion.v1.LinearConstraint.serializeBinaryToWriter
     );
   }
-  f = message.getDestination();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getOrientationConstraintList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       2,
       f,
-      common_v1_common_pb.GeoPoint.serializeBinaryToWriter
+      proto.viam.service.motion.v1.OrientationConstraint.serializeBinaryToWriter
     );
   }
-  f = /** @type {number} */ (jspb.Message.getField(message, 3));
-  if (f != null) {
-    writer.writeDouble(
+  f = message.getCollisionSpecificationList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       3,
-      f
+      f,
+      proto.viam.service.motion.v1.CollisionSpecification.serializeBinaryToWriter
     );
   }
-  f = message.getComponentName();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getPseudolinearConstraintList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       4,
       f,
-      common_v1_common_pb.ResourceName.serializeBinaryToWriter
+      proto.viam.service.motion.v1.PseudolinearConstraint.serializeBinaryToWriter
     );
   }
-  f = message.getMovementSensorName();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getKeepOutZoneConstraintList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       5,
       f,
-      common_v1_common_pb.ResourceName.serializeBinaryToWriter
+      proto.viam.service.motion.v1.KeepOutZoneConstraint.serializeBinaryToWriter
     );
   }
-  f = message.getObstaclesList();
+  f = message.getJointRangeConstraintList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
       6,
       f,
-      common_v1_common_pb.GeoObstacle.serializeBinaryToWriter
+      proto.viam.service.motion.v1.JointRangeConstraint.serializeBinaryToWriter
     );
   }
-  f = message.getMotionConfiguration();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getEndEffectorSpeedConstraintList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       7,
       f,
-      proto.viam.service.motion.v1.MotionConfiguration.serializeBinaryToWriter
-    );
-  }
-  f = message.getExtra();
-  if (f != null) {
-    writer.writeMessage(
-      99,
-      f,
-      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+      proto.viam.service.motion.v1.EndEffectorSpeedConstraint.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string name = 1;
- * @return {string}
+ * repeated LinearConstraint linear_constraint = 1;
+ * @return {!Array<!proto.viam.service.motion.v1.LinearConstraint>}
+ */
+proto.viam.service.motion.v1.Constraints.prototype.getLinearConstraintList = function() {
+  return /** @type{!Array<!proto.viam.service.motion.v1.LinearConstraint>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.viam.service.motion.v1.LinearConstraint, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.viam.service.motion.v1.LinearConstraint>} value
+ * @return {!proto.viam.service.motion.v1.Constraints} returns this
+*/
+proto.viam.service.motion.v1.Constraints.prototype.setLinearConstraintList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.viam.service.motion.v1.LinearConstraint=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.service.motion.v1.LinearConstraint}
+ */
+proto.viam.service.motion.v1.Constraints.prototype.addLinearConstraint = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.viam.service.motion.v1.LinearConstraint, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.service.motion.v1.Constraints} returns this
+ */
+proto.viam.service.motion.v1.Constraints.prototype.clearLinearConstraintList = function() {
+  return this.setLinearConstraintList([]);
+};
+
+
+/**
+ * repeated OrientationConstraint orientation_constraint = 2;
+ * @return {!Array<!proto.viam.service.motion.v1.OrientationConstraint>}
+ */
+proto.viam.service.motion.v1.Constraints.prototype.getOrientationConstraintList = function() {
+  return /** @type{!Array<!proto.viam.service.motion.v1.OrientationConstraint>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.viam.service.motion.v1.OrientationConstraint, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.viam.service.motion.v1.OrientationConstraint>} value
+ * @return {!proto.viam.service.motion.v1.Constraints} returns this
+*/
+proto.viam.service.motion.v1.Constraints.prototype.setOrientationConstraintList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.viam.service.motion.v1.OrientationConstraint=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.service.motion.v1.OrientationConstraint}
+ */
+proto.viam.service.motion.v1.Constraints.prototype.addOrientationConstraint = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.viam.service.motion.v1.OrientationConstraint, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.service.motion.v1.Constraints} returns this
+ */
+proto.viam.service.motion.v1.Constraints.prototype.clearOrientationConstraintList = function() {
+  return this.setOrientationConstraintList([]);
+};
+
+
+/**
+ * repeated CollisionSpecification collision_specification = 3;
+ * @return {!Array<!proto.viam.service.motion.v1.CollisionSpecification>}
+ */
+proto.viam.service.motion.v1.Constraints.prototype.getCollisionSpecificationList = function() {
+  return /** @type{!Array<!proto.viam.service.motion.v1.CollisionSpecification>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.viam.service.motion.v1.CollisionSpecification, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.viam.service.motion.v1.CollisionSpecification>} value
+ * @return {!proto.viam.service.motion.v1.Constraints} returns this
+*/
+proto.viam.service.motion.v1.Constraints.prototype.setCollisionSpecificationList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.viam.service.motion.v1.CollisionSpecification=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.service.motion.v1.CollisionSpecification}
+ */
+proto.viam.service.motion.v1.Constraints.prototype.addCollisionSpecification = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.viam.service.motion.v1.CollisionSpecification, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.service.motion.v1.Constraints} returns this
+ */
+proto.viam.service.motion.v1.Constraints.prototype.clearCollisionSpecificationList = function() {
+  return this.setCollisionSpecificationList([]);
+};
+
+
+/**
+ * repeated PseudolinearConstraint pseudolinear_constraint = 4;
+ * @return {!Array<!proto.viam.service.motion.v1.PseudolinearConstraint>}
+ */
+proto.viam.service.motion.v1.Constraints.prototype.getPseudolinearConstraintList = function() {
+  return /** @type{!Array<!proto.viam.service.motion.v1.PseudolinearConstraint>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.viam.service.motion.v1.PseudolinearConstraint, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.viam.service.motion.v1.PseudolinearConstraint>} value
+ * @return {!proto.viam.service.motion.v1.Constraints} returns this
+*/
+proto.viam.service.motion.v1.Constraints.prototype.setPseudolinearConstraintList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.viam.service.motion.v1.PseudolinearConstraint=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.service.motion.v1.PseudolinearConstraint}
+ */
+proto.viam.service.motion.v1.Constraints.prototype.addPseudolinearConstraint = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.viam.service.motion.v1.PseudolinearConstraint, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.service.motion.v1.Constraints} returns this
  */
-proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.viam.service.motion.v1.Constraints.prototype.clearPseudolinearConstraintList = function() {
+  return this.setPseudolinearConstraintList([]);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.viam.service.motion.v1.MoveOnGlobeRequest} returns this
+ * repeated KeepOutZoneConstraint keep_out_zone_constraint = 5;
+ * @return {!Array<!proto.viam.service.motion.v1.KeepOutZoneConstraint>}
  */
-proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.viam.service.motion.v1.Constraints.prototype.getKeepOutZoneConstraintList = function() {
+  return /** @type{!Array<!proto.viam.service.motion.v1.KeepOutZoneConstraint>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.viam.service.motion.v1.KeepOutZoneConstraint, 5));
 };
 
 
 /**
- * optional viam.common.v1.GeoPoint destination = 2;
- * @return {?proto.viam.common.v1.GeoPoint}
+ * @param {!Array<!proto.viam.service.motion.v1.KeepOutZoneConstraint>} value
+ * @return {!proto.viam.service.motion.v1.Constraints} returns this
+*/
+proto.viam.service.motion.v1.Constraints.prototype.setKeepOutZoneConstraintList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 5, value);
+};
+
+
+/**
+ * @param {!proto.viam.service.motion.v1.KeepOutZoneConstraint=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.service.motion.v1.KeepOutZoneConstraint}
  */
-proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.getDestination = function() {
-  return /** @type{?proto.viam.common.v1.GeoPoint} */ (
-    jspb.Message.getWrapperField(this, common_v1_common_pb.GeoPoint, 2));
+proto.viam.service.motion.v1.Constraints.prototype.addKeepOutZoneConstraint = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 5, opt_value, proto.viam.service.motion.v1.KeepOutZoneConstraint, opt_index);
 };
 
 
 /**
- * @param {?proto.viam.common.v1.GeoPoint|undefined} value
- * @return {!proto.viam.service.motion.v1.MoveOnGlobeRequest} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.service.motion.v1.Constraints} returns this
+ */
+proto.viam.service.motion.v1.Constraints.prototype.clearKeepOutZoneConstraintList = function() {
+  return this.setKeepOutZoneConstraintList([]);
+};
+
+
+/**
+ * repeated JointRangeConstraint joint_range_constraint = 6;
+ * @return {!Array<!proto.viam.service.motion.v1.JointRangeConstraint>}
+ */
+proto.viam.service.motion.v1.Constraints.prototype.getJointRangeConstraintList = function() {
+  return /** @type{!Array<!proto.viam.service.motion.v1.JointRangeConstraint>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.viam.service.motion.v1.JointRangeConstraint, 6));
+};
+
+
+/**
+ * @param {!Array<!proto.viam.service.motion.v1.JointRangeConstraint>} value
+ * @return {!proto.viam.service.motion.v1.Constraints} returns this
 */
-proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.setDestination = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+proto.viam.service.motion.v1.Constraints.prototype.setJointRangeConstraintList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 6, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.viam.service.motion.v1.MoveOnGlobeRequest} returns this
+ * @param {!proto.viam.service.motion.v1.JointRangeConstraint=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.service.motion.v1.JointRangeConstraint}
  */
-proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.clearDestination = function() {
-  return this.setDestination(undefined);
+proto.viam.service.motion.v1.Constraints.prototype.addJointRangeConstraint = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 6, opt_value, proto.viam.service.motion.v1.JointRangeConstraint, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.service.motion.v1.Constraints} returns this
  */
-proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.hasDestination = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.viam.service.motion.v1.Constraints.prototype.clearJointRangeConstraintList = function() {
+  return this.setJointRangeConstraintList([]);
 };
 
 
 /**
- * optional double heading = 3;
- * @return {number}
+ * repeated EndEffectorSpeedConstraint end_effector_speed_constraint = 7;
+ * @return {!Array<!proto.viam.service.motion.v1.EndEffectorSpeedConstraint>}
  */
-proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.getHeading = function() {
-  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 3, 0.0));
+proto.viam.service.motion.v1.Constraints.prototype.getEndEffectorSpeedConstraintList = function() {
+  return /** @type{!Array<!proto.viam.service.motion.v1.EndEffectorSpeedConstraint>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.viam.service.motion.v1.EndEffectorSpeedConstraint, 7));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.viam.service.motion.v1.MoveOnGlobeRequest} returns this
+ * @param {!Array<!proto.viam.service.motion.v1.EndEffectorSpeedConstraint>} value
+ * @return {!proto.viam.service.motion.v1.Constraints} returns this
+*/
+proto.viam.service.motion.v1.Constraints.prototype.setEndEffectorSpeedConstraintList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 7, value);
+};
+
+
+/**
+ * @param {!proto.viam.service.motion.v1.EndEffectorSpeedConstraint=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.service.motion.v1.EndEffectorSpeedConstraint}
  */
-proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.setHeading = function(value) {
-  return jspb.Message.setField(this, 3, value);
+proto.viam.service.motion.v1.Constraints.prototype.addEndEffectorSpeedConstraint = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 7, opt_value, proto.viam.service.motion.v1.EndEffectorSpeedConstraint, opt_index);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.viam.service.motion.v1.MoveOnGlobeRequest} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.service.motion.v1.Constraints} returns this
  */
-proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.clearHeading = function() {
-  return jspb.Message.setField(this, 3, undefined);
+proto.viam.service.motion.v1.Constraints.prototype.clearEndEffectorSpeedConstraintList = function() {
+  return this.setEndEffectorSpeedConstraintList([]);
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.hasHeading = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.viam.service.motion.v1.LinearConstraint.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.motion.v1.LinearConstraint.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * optional viam.common.v1.ResourceName component_name = 4;
- * @return {?proto.viam.common.v1.ResourceName}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.motion.v1.LinearConstraint} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.getComponentName = function() {
-  return /** @type{?proto.viam.common.v1.ResourceName} */ (
-    jspb.Message.getWrapperField(this, common_v1_common_pb.ResourceName, 4));
+proto.viam.service.motion.v1.LinearConstraint.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    lineToleranceMm: jspb.Message.getFloatingPointFieldWithDefault(msg, 1, 0.0),
+    orientationToleranceDegs: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * @param {?proto.viam.common.v1.ResourceName|undefined} value
- * @return {!proto.viam.service.motion.v1.MoveOnGlobeRequest} returns this
-*/
-proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.setComponentName = function(value) {
-  return jspb.Message.setWrapperField(this, 4, value);
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.motion.v1.LinearConstraint}
+ */
+proto.viam.service.motion.v1.LinearConstraint.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.motion.v1.LinearConstraint;
+  return proto.viam.service.motion.v1.LinearConstraint.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.viam.service.motion.v1.MoveOnGlobeRequest} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.motion.v1.LinearConstraint} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.motion.v1.LinearConstraint}
  */
-proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.clearComponentName = function() {
-  return this.setComponentName(undefined);
+proto.viam.service.motion.v1.LinearConstraint.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readFloat());
+      msg.setLineToleranceMm(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readFloat());
+      msg.setOrientationToleranceDegs(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.service.motion.v1.LinearConstraint.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.motion.v1.LinearConstraint.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.motion.v1.LinearConstraint} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.motion.v1.LinearConstraint.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = /** @type {number} */ (jspb.Message.getField(message, 1));
+  if (f != null) {
+    writer.writeFloat(
+      1,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeFloat(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional float line_tolerance_mm = 1;
+ * @return {number}
+ */
+proto.viam.service.motion.v1.LinearConstraint.prototype.getLineToleranceMm = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 1, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.service.motion.v1.LinearConstraint} returns this
+ */
+proto.viam.service.motion.v1.LinearConstraint.prototype.setLineToleranceMm = function(value) {
+  return jspb.Message.setField(this, 1, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.viam.service.motion.v1.LinearConstraint} returns this
+ */
+proto.viam.service.motion.v1.LinearConstraint.prototype.clearLineToleranceMm = function() {
+  return jspb.Message.setField(this, 1, undefined);
 };
 
 
@@ -2047,36 +7267,35 @@ proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.clearComponentName = f
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.hasComponentName = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.viam.service.motion.v1.LinearConstraint.prototype.hasLineToleranceMm = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * optional viam.common.v1.ResourceName movement_sensor_name = 5;
- * @return {?proto.viam.common.v1.ResourceName}
+ * optional float orientation_tolerance_degs = 2;
+ * @return {number}
  */
-proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.getMovementSensorName = function() {
-  return /** @type{?proto.viam.common.v1.ResourceName} */ (
-    jspb.Message.getWrapperField(this, common_v1_common_pb.ResourceName, 5));
+proto.viam.service.motion.v1.LinearConstraint.prototype.getOrientationToleranceDegs = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
 };
 
 
 /**
- * @param {?proto.viam.common.v1.ResourceName|undefined} value
- * @return {!proto.viam.service.motion.v1.MoveOnGlobeRequest} returns this
-*/
-proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.setMovementSensorName = function(value) {
-  return jspb.Message.setWrapperField(this, 5, value);
+ * @param {number} value
+ * @return {!proto.viam.service.motion.v1.LinearConstraint} returns this
+ */
+proto.viam.service.motion.v1.LinearConstraint.prototype.setOrientationToleranceDegs = function(value) {
+  return jspb.Message.setField(this, 2, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.viam.service.motion.v1.MoveOnGlobeRequest} returns this
+ * Clears the field making it undefined.
+ * @return {!proto.viam.service.motion.v1.LinearConstraint} returns this
  */
-proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.clearMovementSensorName = function() {
-  return this.setMovementSensorName(undefined);
+proto.viam.service.motion.v1.LinearConstraint.prototype.clearOrientationToleranceDegs = function() {
+  return jspb.Message.setField(this, 2, undefined);
 };
 
 
@@ -2084,111 +7303,147 @@ proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.clearMovementSensorNam
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.hasMovementSensorName = function() {
-  return jspb.Message.getField(this, 5) != null;
+proto.viam.service.motion.v1.LinearConstraint.prototype.hasOrientationToleranceDegs = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
-/**
- * repeated viam.common.v1.GeoObstacle obstacles = 6;
- * @return {!Array<!proto.viam.common.v1.GeoObstacle>}
- */
-proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.getObstaclesList = function() {
-  return /** @type{!Array<!proto.viam.common.v1.GeoObstacle>} */ (
-    jspb.Message.getRepeatedWrapperField(this, common_v1_common_pb.GeoObstacle, 6));
-};
-
 
-/**
- * @param {!Array<!proto.viam.common.v1.GeoObstacle>} value
- * @return {!proto.viam.service.motion.v1.MoveOnGlobeRequest} returns this
-*/
-proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.setObstaclesList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 6, value);
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {!proto.viam.common.v1.GeoObstacle=} opt_value
- * @param {number=} opt_index
- * @return {!proto.viam.common.v1.GeoObstacle}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.addObstacles = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 6, opt_value, proto.viam.common.v1.GeoObstacle, opt_index);
+proto.viam.service.motion.v1.OrientationConstraint.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.motion.v1.OrientationConstraint.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.viam.service.motion.v1.MoveOnGlobeRequest} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.motion.v1.OrientationConstraint} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.clearObstaclesList = function() {
-  return this.setObstaclesList([]);
+proto.viam.service.motion.v1.OrientationConstraint.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    orientationToleranceDegs: jspb.Message.getFloatingPointFieldWithDefault(msg, 1, 0.0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional MotionConfiguration motion_configuration = 7;
- * @return {?proto.viam.service.motion.v1.MotionConfiguration}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.motion.v1.OrientationConstraint}
  */
-proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.getMotionConfiguration = function() {
-  return /** @type{?proto.viam.service.motion.v1.MotionConfiguration} */ (
-    jspb.Message.getWrapperField(this, proto.viam.service.motion.v1.MotionConfiguration, 7));
+proto.viam.service.motion.v1.OrientationConstraint.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.motion.v1.OrientationConstraint;
+  return proto.viam.service.motion.v1.OrientationConstraint.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {?proto.viam.service.motion.v1.MotionConfiguration|undefined} value
- * @return {!proto.viam.service.motion.v1.MoveOnGlobeRequest} returns this
-*/
-proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.setMotionConfiguration = function(value) {
-  return jspb.Message.setWrapperField(this, 7, value);
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.motion.v1.OrientationConstraint} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.motion.v1.OrientationConstraint}
+ */
+proto.viam.service.motion.v1.OrientationConstraint.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readFloat());
+      msg.setOrientationToleranceDegs(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.viam.service.motion.v1.MoveOnGlobeRequest} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.clearMotionConfiguration = function() {
-  return this.setMotionConfiguration(undefined);
+proto.viam.service.motion.v1.OrientationConstraint.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.motion.v1.OrientationConstraint.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.motion.v1.OrientationConstraint} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.hasMotionConfiguration = function() {
-  return jspb.Message.getField(this, 7) != null;
+proto.viam.service.motion.v1.OrientationConstraint.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = /** @type {number} */ (jspb.Message.getField(message, 1));
+  if (f != null) {
+    writer.writeFloat(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * optional google.protobuf.Struct extra = 99;
- * @return {?proto.google.protobuf.Struct}
+ * optional float orientation_tolerance_degs = 1;
+ * @return {number}
  */
-proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.getExtra = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+proto.viam.service.motion.v1.OrientationConstraint.prototype.getOrientationToleranceDegs = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 1, 0.0));
 };
 
 
 /**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.viam.service.motion.v1.MoveOnGlobeRequest} returns this
-*/
-proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.setExtra = function(value) {
-  return jspb.Message.setWrapperField(this, 99, value);
+ * @param {number} value
+ * @return {!proto.viam.service.motion.v1.OrientationConstraint} returns this
+ */
+proto.viam.service.motion.v1.OrientationConstraint.prototype.setOrientationToleranceDegs = function(value) {
+  return jspb.Message.setField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.viam.service.motion.v1.MoveOnGlobeRequest} returns this
+ * Clears the field making it undefined.
+ * @return {!proto.viam.service.motion.v1.OrientationConstraint} returns this
  */
-proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.clearExtra = function() {
-  return this.setExtra(undefined);
+proto.viam.service.motion.v1.OrientationConstraint.prototype.clearOrientationToleranceDegs = function() {
+  return jspb.Message.setField(this, 1, undefined);
 };
 
 
@@ -2196,12 +7451,19 @@ proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.clearExtra = function(
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.service.motion.v1.MoveOnGlobeRequest.prototype.hasExtra = function() {
-  return jspb.Message.getField(this, 99) != null;
+proto.viam.service.motion.v1.OrientationConstraint.prototype.hasOrientationToleranceDegs = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.service.motion.v1.CollisionSpecification.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -2217,8 +7479,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.service.motion.v1.MoveOnGlobeResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.service.motion.v1.MoveOnGlobeResponse.toObject(opt_includeInstance, this);
+proto.viam.service.motion.v1.CollisionSpecification.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.motion.v1.CollisionSpecification.toObject(opt_includeInstance, this);
 };
 
 
@@ -2227,13 +7489,14 @@ proto.viam.service.motion.v1.MoveOnGlobeResponse.prototype.toObject = function(o
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.service.motion.v1.MoveOnGlobeResponse} msg The msg instance to transform.
+ * @param {!proto.viam.service.motion.v1.CollisionSpecification} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.motion.v1.MoveOnGlobeResponse.toObject = function(includeInstance, msg) {
+proto.viam.service.motion.v1.CollisionSpecification.toObject = function(includeInstance, msg) {
   var f, obj = {
-    success: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+    allowsList: jspb.Message.toObjectList(msg.getAllowsList(),
+    proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -2247,23 +7510,23 @@ proto.viam.service.motion.v1.MoveOnGlobeResponse.toObject = function(includeInst
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.service.motion.v1.MoveOnGlobeResponse}
+ * @return {!proto.viam.service.motion.v1.CollisionSpecification}
  */
-proto.viam.service.motion.v1.MoveOnGlobeResponse.deserializeBinary = function(bytes) {
+proto.viam.service.motion.v1.CollisionSpecification.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.service.motion.v1.MoveOnGlobeResponse;
-  return proto.viam.service.motion.v1.MoveOnGlobeResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.service.motion.v1.CollisionSpecification;
+  return proto.viam.service.motion.v1.CollisionSpecification.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.service.motion.v1.MoveOnGlobeResponse} msg The message object to deserialize into.
+ * @param {!proto.viam.service.motion.v1.CollisionSpecification} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.service.motion.v1.MoveOnGlobeResponse}
+ * @return {!proto.viam.service.motion.v1.CollisionSpecification}
  */
-proto.viam.service.motion.v1.MoveOnGlobeResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.service.motion.v1.CollisionSpecification.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2271,8 +7534,9 @@ proto.viam.service.motion.v1.MoveOnGlobeResponse.deserializeBinaryFromReader = f
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setSuccess(value);
+      var value = new proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions;
+      reader.readMessage(value,proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.deserializeBinaryFromReader);
+      msg.addAllows(value);
       break;
     default:
       reader.skipField();
@@ -2287,9 +7551,9 @@ proto.viam.service.motion.v1.MoveOnGlobeResponse.deserializeBinaryFromReader = f
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.service.motion.v1.MoveOnGlobeResponse.prototype.serializeBinary = function() {
+proto.viam.service.motion.v1.CollisionSpecification.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.service.motion.v1.MoveOnGlobeResponse.serializeBinaryToWriter(this, writer);
+  proto.viam.service.motion.v1.CollisionSpecification.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2297,47 +7561,23 @@ proto.viam.service.motion.v1.MoveOnGlobeResponse.prototype.serializeBinary = fun
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.service.motion.v1.MoveOnGlobeResponse} message
+ * @param {!proto.viam.service.motion.v1.CollisionSpecification} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.motion.v1.MoveOnGlobeResponse.serializeBinaryToWriter = function(message, writer) {
+proto.viam.service.motion.v1.CollisionSpecification.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSuccess();
-  if (f) {
-    writer.writeBool(
+  f = message.getAllowsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       1,
-      f
+      f,
+      proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.serializeBinaryToWriter
     );
   }
 };
 
 
-/**
- * optional bool success = 1;
- * @return {boolean}
- */
-proto.viam.service.motion.v1.MoveOnGlobeResponse.prototype.getSuccess = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.viam.service.motion.v1.MoveOnGlobeResponse} returns this
- */
-proto.viam.service.motion.v1.MoveOnGlobeResponse.prototype.setSuccess = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
-};
-
-
-
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.viam.service.motion.v1.GetPoseRequest.repeatedFields_ = [4];
 
 
 
@@ -2354,8 +7594,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.service.motion.v1.GetPoseRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.service.motion.v1.GetPoseRequest.toObject(opt_includeInstance, this);
+proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.toObject(opt_includeInstance, this);
 };
 
 
@@ -2364,18 +7604,14 @@ proto.viam.service.motion.v1.GetPoseRequest.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.service.motion.v1.GetPoseRequest} msg The msg instance to transform.
+ * @param {!proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.motion.v1.GetPoseRequest.toObject = function(includeInstance, msg) {
+proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.toObject = function(includeInstance, msg) {
   var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    componentName: (f = msg.getComponentName()) && common_v1_common_pb.ResourceName.toObject(includeInstance, f),
-    destinationFrame: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    supplementalTransformsList: jspb.Message.toObjectList(msg.getSupplementalTransformsList(),
-    common_v1_common_pb.Transform.toObject, includeInstance),
-    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+    frame1: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    frame2: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -2389,23 +7625,23 @@ proto.viam.service.motion.v1.GetPoseRequest.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.service.motion.v1.GetPoseRequest}
+ * @return {!proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions}
  */
-proto.viam.service.motion.v1.GetPoseRequest.deserializeBinary = function(bytes) {
+proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.service.motion.v1.GetPoseRequest;
-  return proto.viam.service.motion.v1.GetPoseRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions;
+  return proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.service.motion.v1.GetPoseRequest} msg The message object to deserialize into.
+ * @param {!proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.service.motion.v1.GetPoseRequest}
+ * @return {!proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions}
  */
-proto.viam.service.motion.v1.GetPoseRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2414,26 +7650,11 @@ proto.viam.service.motion.v1.GetPoseRequest.deserializeBinaryFromReader = functi
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setFrame1(value);
       break;
     case 2:
-      var value = new common_v1_common_pb.ResourceName;
-      reader.readMessage(value,common_v1_common_pb.ResourceName.deserializeBinaryFromReader);
-      msg.setComponentName(value);
-      break;
-    case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.setDestinationFrame(value);
-      break;
-    case 4:
-      var value = new common_v1_common_pb.Transform;
-      reader.readMessage(value,common_v1_common_pb.Transform.deserializeBinaryFromReader);
-      msg.addSupplementalTransforms(value);
-      break;
-    case 99:
-      var value = new google_protobuf_struct_pb.Struct;
-      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setExtra(value);
+      msg.setFrame2(value);
       break;
     default:
       reader.skipField();
@@ -2448,9 +7669,9 @@ proto.viam.service.motion.v1.GetPoseRequest.deserializeBinaryFromReader = functi
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.service.motion.v1.GetPoseRequest.prototype.serializeBinary = function() {
+proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.service.motion.v1.GetPoseRequest.serializeBinaryToWriter(this, writer);
+  proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2458,198 +7679,100 @@ proto.viam.service.motion.v1.GetPoseRequest.prototype.serializeBinary = function
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.service.motion.v1.GetPoseRequest} message
+ * @param {!proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.motion.v1.GetPoseRequest.serializeBinaryToWriter = function(message, writer) {
+proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
+  f = message.getFrame1();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getComponentName();
-  if (f != null) {
-    writer.writeMessage(
-      2,
-      f,
-      common_v1_common_pb.ResourceName.serializeBinaryToWriter
-    );
-  }
-  f = message.getDestinationFrame();
+  f = message.getFrame2();
   if (f.length > 0) {
     writer.writeString(
-      3,
+      2,
       f
     );
   }
-  f = message.getSupplementalTransformsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      4,
-      f,
-      common_v1_common_pb.Transform.serializeBinaryToWriter
-    );
-  }
-  f = message.getExtra();
-  if (f != null) {
-    writer.writeMessage(
-      99,
-      f,
-      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
-    );
-  }
 };
 
 
 /**
- * optional string name = 1;
+ * optional string frame1 = 1;
  * @return {string}
  */
-proto.viam.service.motion.v1.GetPoseRequest.prototype.getName = function() {
+proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.prototype.getFrame1 = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.viam.service.motion.v1.GetPoseRequest} returns this
+ * @return {!proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions} returns this
  */
-proto.viam.service.motion.v1.GetPoseRequest.prototype.setName = function(value) {
+proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.prototype.setFrame1 = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional viam.common.v1.ResourceName component_name = 2;
- * @return {?proto.viam.common.v1.ResourceName}
- */
-proto.viam.service.motion.v1.GetPoseRequest.prototype.getComponentName = function() {
-  return /** @type{?proto.viam.common.v1.ResourceName} */ (
-    jspb.Message.getWrapperField(this, common_v1_common_pb.ResourceName, 2));
-};
-
-
-/**
- * @param {?proto.viam.common.v1.ResourceName|undefined} value
- * @return {!proto.viam.service.motion.v1.GetPoseRequest} returns this
-*/
-proto.viam.service.motion.v1.GetPoseRequest.prototype.setComponentName = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.viam.service.motion.v1.GetPoseRequest} returns this
- */
-proto.viam.service.motion.v1.GetPoseRequest.prototype.clearComponentName = function() {
-  return this.setComponentName(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.viam.service.motion.v1.GetPoseRequest.prototype.hasComponentName = function() {
-  return jspb.Message.getField(this, 2) != null;
-};
-
-
-/**
- * optional string destination_frame = 3;
+ * optional string frame2 = 2;
  * @return {string}
  */
-proto.viam.service.motion.v1.GetPoseRequest.prototype.getDestinationFrame = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.prototype.getFrame2 = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.viam.service.motion.v1.GetPoseRequest} returns this
+ * @return {!proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions} returns this
  */
-proto.viam.service.motion.v1.GetPoseRequest.prototype.setDestinationFrame = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.prototype.setFrame2 = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * repeated viam.common.v1.Transform supplemental_transforms = 4;
- * @return {!Array<!proto.viam.common.v1.Transform>}
+ * repeated AllowedFrameCollisions allows = 1;
+ * @return {!Array<!proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions>}
  */
-proto.viam.service.motion.v1.GetPoseRequest.prototype.getSupplementalTransformsList = function() {
-  return /** @type{!Array<!proto.viam.common.v1.Transform>} */ (
-    jspb.Message.getRepeatedWrapperField(this, common_v1_common_pb.Transform, 4));
+proto.viam.service.motion.v1.CollisionSpecification.prototype.getAllowsList = function() {
+  return /** @type{!Array<!proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions, 1));
 };
 
 
 /**
- * @param {!Array<!proto.viam.common.v1.Transform>} value
- * @return {!proto.viam.service.motion.v1.GetPoseRequest} returns this
+ * @param {!Array<!proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions>} value
+ * @return {!proto.viam.service.motion.v1.CollisionSpecification} returns this
 */
-proto.viam.service.motion.v1.GetPoseRequest.prototype.setSupplementalTransformsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+proto.viam.service.motion.v1.CollisionSpecification.prototype.setAllowsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.viam.common.v1.Transform=} opt_value
+ * @param {!proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions=} opt_value
  * @param {number=} opt_index
- * @return {!proto.viam.common.v1.Transform}
+ * @return {!proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions}
  */
-proto.viam.service.motion.v1.GetPoseRequest.prototype.addSupplementalTransforms = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.viam.common.v1.Transform, opt_index);
+proto.viam.service.motion.v1.CollisionSpecification.prototype.addAllows = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.viam.service.motion.v1.GetPoseRequest} returns this
- */
-proto.viam.service.motion.v1.GetPoseRequest.prototype.clearSupplementalTransformsList = function() {
-  return this.setSupplementalTransformsList([]);
-};
-
-
-/**
- * optional google.protobuf.Struct extra = 99;
- * @return {?proto.google.protobuf.Struct}
- */
-proto.viam.service.motion.v1.GetPoseRequest.prototype.getExtra = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
-};
-
-
-/**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.viam.service.motion.v1.GetPoseRequest} returns this
-*/
-proto.viam.service.motion.v1.GetPoseRequest.prototype.setExtra = function(value) {
-  return jspb.Message.setWrapperField(this, 99, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.viam.service.motion.v1.GetPoseRequest} returns this
- */
-proto.viam.service.motion.v1.GetPoseRequest.prototype.clearExtra = function() {
-  return this.setExtra(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
+ * @return {!proto.viam.service.motion.v1.CollisionSpecification} returns this
  */
-proto.viam.service.motion.v1.GetPoseRequest.prototype.hasExtra = function() {
-  return jspb.Message.getField(this, 99) != null;
+proto.viam.service.motion.v1.CollisionSpecification.prototype.clearAllowsList = function() {
+  return this.setAllowsList([]);
 };
 
 
@@ -2669,8 +7792,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.service.motion.v1.GetPoseResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.service.motion.v1.GetPoseResponse.toObject(opt_includeInstance, this);
+proto.viam.service.motion.v1.PseudolinearConstraint.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.motion.v1.PseudolinearConstraint.toObject(opt_includeInstance, this);
 };
 
 
@@ -2679,13 +7802,14 @@ proto.viam.service.motion.v1.GetPoseResponse.prototype.toObject = function(opt_i
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.service.motion.v1.GetPoseResponse} msg The msg instance to transform.
+ * @param {!proto.viam.service.motion.v1.PseudolinearConstraint} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.motion.v1.GetPoseResponse.toObject = function(includeInstance, msg) {
+proto.viam.service.motion.v1.PseudolinearConstraint.toObject = function(includeInstance, msg) {
   var f, obj = {
-    pose: (f = msg.getPose()) && common_v1_common_pb.PoseInFrame.toObject(includeInstance, f)
+    lineToleranceFactor: jspb.Message.getFloatingPointFieldWithDefault(msg, 1, 0.0),
+    orientationToleranceFactor: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0)
   };
 
   if (includeInstance) {
@@ -2699,23 +7823,23 @@ proto.viam.service.motion.v1.GetPoseResponse.toObject = function(includeInstance
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.service.motion.v1.GetPoseResponse}
+ * @return {!proto.viam.service.motion.v1.PseudolinearConstraint}
  */
-proto.viam.service.motion.v1.GetPoseResponse.deserializeBinary = function(bytes) {
+proto.viam.service.motion.v1.PseudolinearConstraint.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.service.motion.v1.GetPoseResponse;
-  return proto.viam.service.motion.v1.GetPoseResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.service.motion.v1.PseudolinearConstraint;
+  return proto.viam.service.motion.v1.PseudolinearConstraint.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.service.motion.v1.GetPoseResponse} msg The message object to deserialize into.
+ * @param {!proto.viam.service.motion.v1.PseudolinearConstraint} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.service.motion.v1.GetPoseResponse}
+ * @return {!proto.viam.service.motion.v1.PseudolinearConstraint}
  */
-proto.viam.service.motion.v1.GetPoseResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.service.motion.v1.PseudolinearConstraint.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2723,9 +7847,12 @@ proto.viam.service.motion.v1.GetPoseResponse.deserializeBinaryFromReader = funct
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new common_v1_common_pb.PoseInFrame;
-      reader.readMessage(value,common_v1_common_pb.PoseInFrame.deserializeBinaryFromReader);
-      msg.setPose(value);
+      var value = /** @type {number} */ (reader.readFloat());
+      msg.setLineToleranceFactor(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readFloat());
+      msg.setOrientationToleranceFactor(value);
       break;
     default:
       reader.skipField();
@@ -2737,61 +7864,102 @@ proto.viam.service.motion.v1.GetPoseResponse.deserializeBinaryFromReader = funct
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.service.motion.v1.PseudolinearConstraint.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.motion.v1.PseudolinearConstraint.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.motion.v1.PseudolinearConstraint} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.motion.v1.PseudolinearConstraint.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = /** @type {number} */ (jspb.Message.getField(message, 1));
+  if (f != null) {
+    writer.writeFloat(
+      1,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeFloat(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional float line_tolerance_factor = 1;
+ * @return {number}
+ */
+proto.viam.service.motion.v1.PseudolinearConstraint.prototype.getLineToleranceFactor = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 1, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.service.motion.v1.PseudolinearConstraint} returns this
+ */
+proto.viam.service.motion.v1.PseudolinearConstraint.prototype.setLineToleranceFactor = function(value) {
+  return jspb.Message.setField(this, 1, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.viam.service.motion.v1.PseudolinearConstraint} returns this
  */
-proto.viam.service.motion.v1.GetPoseResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.viam.service.motion.v1.GetPoseResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.viam.service.motion.v1.PseudolinearConstraint.prototype.clearLineToleranceFactor = function() {
+  return jspb.Message.setField(this, 1, undefined);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.viam.service.motion.v1.GetPoseResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.viam.service.motion.v1.GetPoseResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getPose();
-  if (f != null) {
-    writer.writeMessage(
-      1,
-      f,
-      common_v1_common_pb.PoseInFrame.serializeBinaryToWriter
-    );
-  }
+proto.viam.service.motion.v1.PseudolinearConstraint.prototype.hasLineToleranceFactor = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * optional viam.common.v1.PoseInFrame pose = 1;
- * @return {?proto.viam.common.v1.PoseInFrame}
+ * optional float orientation_tolerance_factor = 2;
+ * @return {number}
  */
-proto.viam.service.motion.v1.GetPoseResponse.prototype.getPose = function() {
-  return /** @type{?proto.viam.common.v1.PoseInFrame} */ (
-    jspb.Message.getWrapperField(this, common_v1_common_pb.PoseInFrame, 1));
+proto.viam.service.motion.v1.PseudolinearConstraint.prototype.getOrientationToleranceFactor = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
 };
 
 
 /**
- * @param {?proto.viam.common.v1.PoseInFrame|undefined} value
- * @return {!proto.viam.service.motion.v1.GetPoseResponse} returns this
-*/
-proto.viam.service.motion.v1.GetPoseResponse.prototype.setPose = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+ * @param {number} value
+ * @return {!proto.viam.service.motion.v1.PseudolinearConstraint} returns this
+ */
+proto.viam.service.motion.v1.PseudolinearConstraint.prototype.setOrientationToleranceFactor = function(value) {
+  return jspb.Message.setField(this, 2, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.viam.service.motion.v1.GetPoseResponse} returns this
+ * Clears the field making it undefined.
+ * @return {!proto.viam.service.motion.v1.PseudolinearConstraint} returns this
  */
-proto.viam.service.motion.v1.GetPoseResponse.prototype.clearPose = function() {
-  return this.setPose(undefined);
+proto.viam.service.motion.v1.PseudolinearConstraint.prototype.clearOrientationToleranceFactor = function() {
+  return jspb.Message.setField(this, 2, undefined);
 };
 
 
@@ -2799,8 +7967,8 @@ proto.viam.service.motion.v1.GetPoseResponse.prototype.clearPose = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.service.motion.v1.GetPoseResponse.prototype.hasPose = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.viam.service.motion.v1.PseudolinearConstraint.prototype.hasOrientationToleranceFactor = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
@@ -2810,7 +7978,7 @@ proto.viam.service.motion.v1.GetPoseResponse.prototype.hasPose = function() {
  * @private {!Array<number>}
  * @const
  */
-proto.viam.service.motion.v1.Constraints.repeatedFields_ = [1,2,3];
+proto.viam.service.motion.v1.KeepOutZoneConstraint.repeatedFields_ = [2];
 
 
 
@@ -2827,8 +7995,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.service.motion.v1.Constraints.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.service.motion.v1.Constraints.toObject(opt_includeInstance, this);
+proto.viam.service.motion.v1.KeepOutZoneConstraint.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.motion.v1.KeepOutZoneConstraint.toObject(opt_includeInstance, this);
 };
 
 
@@ -2837,18 +8005,14 @@ proto.viam.service.motion.v1.Constraints.prototype.toObject = function(opt_inclu
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.service.motion.v1.Constraints} msg The msg instance to transform.
+ * @param {!proto.viam.service.motion.v1.KeepOutZoneConstraint} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.motion.v1.Constraints.toObject = function(includeInstance, msg) {
+proto.viam.service.motion.v1.KeepOutZoneConstraint.toObject = function(includeInstance, msg) {
   var f, obj = {
-    linearConstraintList: jspb.Message.toObjectList(msg.getLinearConstraintList(),
-    proto.viam.service.motion.v1.LinearConstraint.toObject, includeInstance),
-    orientationConstraintList: jspb.Message.toObjectList(msg.getOrientationConstraintList(),
-    proto.viam.service.motion.v1.OrientationConstraint.toObject, includeInstance),
-    collisionSpecificationList: jspb.Message.toObjectList(msg.getCollisionSpecificationList(),
-    proto.viam.service.motion.v1.CollisionSpecification.toObject, includeInstance)
+    zones: (f = msg.getZones()) && common_v1_common_pb.GeometriesInFrame.toObject(includeInstance, f),
+    allowedFramesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -2862,23 +8026,23 @@ proto.viam.service.motion.v1.Constraints.toObject = function(includeInstance, ms
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.service.motion.v1.Constraints}
+ * @return {!proto.viam.service.motion.v1.KeepOutZoneConstraint}
  */
-proto.viam.service.motion.v1.Constraints.deserializeBinary = function(bytes) {
+proto.viam.service.motion.v1.KeepOutZoneConstraint.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.service.motion.v1.Constraints;
-  return proto.viam.service.motion.v1.Constraints.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.service.motion.v1.KeepOutZoneConstraint;
+  return proto.viam.service.motion.v1.KeepOutZoneConstraint.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.service.motion.v1.Constraints} msg The message object to deserialize into.
+ * @param {!proto.viam.service.motion.v1.KeepOutZoneConstraint} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.service.motion.v1.Constraints}
+ * @return {!proto.viam.service.motion.v1.KeepOutZoneConstraint}
  */
-proto.viam.service.motion.v1.Constraints.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.service.motion.v1.KeepOutZoneConstraint.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2886,19 +8050,13 @@ proto.viam.service.motion.v1.Constraints.deserializeBinaryFromReader = function(
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.viam.service.motion.v1.LinearConstraint;
-      reader.readMessage(value,proto.viam.service.motion.v1.LinearConstraint.deserializeBinaryFromReader);
-      msg.addLinearConstraint(value);
+      var value = new common_v1_common_pb.GeometriesInFrame;
+      reader.readMessage(value,common_v1_common_pb.GeometriesInFrame.deserializeBinaryFromReader);
+      msg.setZones(value);
       break;
     case 2:
-      var value = new proto.viam.service.motion.v1.OrientationConstraint;
-      reader.readMessage(value,proto.viam.service.motion.v1.OrientationConstraint.deserializeBinaryFromReader);
-      msg.addOrientationConstraint(value);
-      break;
-    case 3:
-      var value = new proto.viam.service.motion.v1.CollisionSpecification;
-      reader.readMessage(value,proto.viam.service.motion.v1.CollisionSpecification.deserializeBinaryFromReader);
-      msg.addCollisionSpecification(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.addAllowedFrames(value);
       break;
     default:
       reader.skipField();
@@ -2913,9 +8071,9 @@ proto.viam.service.motion.v1.Constraints.deserializeBinaryFromReader = function(
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.service.motion.v1.Constraints.prototype.serializeBinary = function() {
+proto.viam.service.motion.v1.KeepOutZoneConstraint.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.service.motion.v1.Constraints.serializeBinaryToWriter(this, writer);
+  proto.viam.service.motion.v1.KeepOutZoneConstraint.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2923,153 +8081,111 @@ proto.viam.service.motion.v1.Constraints.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.service.motion.v1.Constraints} message
+ * @param {!proto.viam.service.motion.v1.KeepOutZoneConstraint} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.motion.v1.Constraints.serializeBinaryToWriter = function(message, writer) {
+proto.viam.service.motion.v1.KeepOutZoneConstraint.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getLinearConstraintList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = message.getZones();
+  if (f != null) {
+    writer.writeMessage(
       1,
       f,
-      proto.viam.service.motion.v1.LinearConstraint.serializeBinaryToWriter
+      common_v1_common_pb.GeometriesInFrame.serializeBinaryToWriter
     );
   }
-  f = message.getOrientationConstraintList();
+  f = message.getAllowedFramesList();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeRepeatedString(
       2,
-      f,
-      proto.viam.service.motion.v1.OrientationConstraint.serializeBinaryToWriter
-    );
-  }
-  f = message.getCollisionSpecificationList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      3,
-      f,
-      proto.viam.service.motion.v1.CollisionSpecification.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * repeated LinearConstraint linear_constraint = 1;
- * @return {!Array<!proto.viam.service.motion.v1.LinearConstraint>}
+ * optional viam.common.v1.GeometriesInFrame zones = 1;
+ * @return {?proto.viam.common.v1.GeometriesInFrame}
  */
-proto.viam.service.motion.v1.Constraints.prototype.getLinearConstraintList = function() {
-  return /** @type{!Array<!proto.viam.service.motion.v1.LinearConstraint>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.viam.service.motion.v1.LinearConstraint, 1));
+proto.viam.service.motion.v1.KeepOutZoneConstraint.prototype.getZones = function() {
+  return /** @type{?proto.viam.common.v1.GeometriesInFrame} */ (
+    jspb.Message.getWrapperField(this, common_v1_common_pb.GeometriesInFrame, 1));
 };
 
 
 /**
- * @param {!Array<!proto.viam.service.motion.v1.LinearConstraint>} value
- * @return {!proto.viam.service.motion.v1.Constraints} returns this
+ * @param {?proto.viam.common.v1.GeometriesInFrame|undefined} value
+ * @return {!proto.viam.service.motion.v1.KeepOutZoneConstraint} returns this
 */
-proto.viam.service.motion.v1.Constraints.prototype.setLinearConstraintList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+proto.viam.service.motion.v1.KeepOutZoneConstraint.prototype.setZones = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.viam.service.motion.v1.LinearConstraint=} opt_value
- * @param {number=} opt_index
- * @return {!proto.viam.service.motion.v1.LinearConstraint}
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.motion.v1.KeepOutZoneConstraint} returns this
  */
-proto.viam.service.motion.v1.Constraints.prototype.addLinearConstraint = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.viam.service.motion.v1.LinearConstraint, opt_index);
+proto.viam.service.motion.v1.KeepOutZoneConstraint.prototype.clearZones = function() {
+  return this.setZones(undefined);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.viam.service.motion.v1.Constraints} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.viam.service.motion.v1.Constraints.prototype.clearLinearConstraintList = function() {
-  return this.setLinearConstraintList([]);
+proto.viam.service.motion.v1.KeepOutZoneConstraint.prototype.hasZones = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * repeated OrientationConstraint orientation_constraint = 2;
- * @return {!Array<!proto.viam.service.motion.v1.OrientationConstraint>}
+ * repeated string allowed_frames = 2;
+ * @return {!Array<string>}
  */
-proto.viam.service.motion.v1.Constraints.prototype.getOrientationConstraintList = function() {
-  return /** @type{!Array<!proto.viam.service.motion.v1.OrientationConstraint>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.viam.service.motion.v1.OrientationConstraint, 2));
+proto.viam.service.motion.v1.KeepOutZoneConstraint.prototype.getAllowedFramesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
 };
 
 
 /**
- * @param {!Array<!proto.viam.service.motion.v1.OrientationConstraint>} value
- * @return {!proto.viam.service.motion.v1.Constraints} returns this
-*/
-proto.viam.service.motion.v1.Constraints.prototype.setOrientationConstraintList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+ * @param {!Array<string>} value
+ * @return {!proto.viam.service.motion.v1.KeepOutZoneConstraint} returns this
+ */
+proto.viam.service.motion.v1.KeepOutZoneConstraint.prototype.setAllowedFramesList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
 };
 
 
 /**
- * @param {!proto.viam.service.motion.v1.OrientationConstraint=} opt_value
+ * @param {string} value
  * @param {number=} opt_index
- * @return {!proto.viam.service.motion.v1.OrientationConstraint}
+ * @return {!proto.viam.service.motion.v1.KeepOutZoneConstraint} returns this
  */
-proto.viam.service.motion.v1.Constraints.prototype.addOrientationConstraint = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.viam.service.motion.v1.OrientationConstraint, opt_index);
+proto.viam.service.motion.v1.KeepOutZoneConstraint.prototype.addAllowedFrames = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.viam.service.motion.v1.Constraints} returns this
- */
-proto.viam.service.motion.v1.Constraints.prototype.clearOrientationConstraintList = function() {
-  return this.setOrientationConstraintList([]);
-};
-
-
-/**
- * repeated CollisionSpecification collision_specification = 3;
- * @return {!Array<!proto.viam.service.motion.v1.CollisionSpecification>}
+ * @return {!proto.viam.service.motion.v1.KeepOutZoneConstraint} returns this
  */
-proto.viam.service.motion.v1.Constraints.prototype.getCollisionSpecificationList = function() {
-  return /** @type{!Array<!proto.viam.service.motion.v1.CollisionSpecification>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.viam.service.motion.v1.CollisionSpecification, 3));
-};
-
-
-/**
- * @param {!Array<!proto.viam.service.motion.v1.CollisionSpecification>} value
- * @return {!proto.viam.service.motion.v1.Constraints} returns this
-*/
-proto.viam.service.motion.v1.Constraints.prototype.setCollisionSpecificationList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+proto.viam.service.motion.v1.KeepOutZoneConstraint.prototype.clearAllowedFramesList = function() {
+  return this.setAllowedFramesList([]);
 };
 
 
-/**
- * @param {!proto.viam.service.motion.v1.CollisionSpecification=} opt_value
- * @param {number=} opt_index
- * @return {!proto.viam.service.motion.v1.CollisionSpecification}
- */
-proto.viam.service.motion.v1.Constraints.prototype.addCollisionSpecification = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.viam.service.motion.v1.CollisionSpecification, opt_index);
-};
-
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.viam.service.motion.v1.Constraints} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.viam.service.motion.v1.Constraints.prototype.clearCollisionSpecificationList = function() {
-  return this.setCollisionSpecificationList([]);
-};
-
-
+proto.viam.service.motion.v1.JointRangeConstraint.repeatedFields_ = [2];
 
 
 
@@ -3086,8 +8202,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.service.motion.v1.LinearConstraint.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.service.motion.v1.LinearConstraint.toObject(opt_includeInstance, this);
+proto.viam.service.motion.v1.JointRangeConstraint.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.motion.v1.JointRangeConstraint.toObject(opt_includeInstance, this);
 };
 
 
@@ -3096,14 +8212,15 @@ proto.viam.service.motion.v1.LinearConstraint.prototype.toObject = function(opt_
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.service.motion.v1.LinearConstraint} msg The msg instance to transform.
+ * @param {!proto.viam.service.motion.v1.JointRangeConstraint} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.motion.v1.LinearConstraint.toObject = function(includeInstance, msg) {
+proto.viam.service.motion.v1.JointRangeConstraint.toObject = function(includeInstance, msg) {
   var f, obj = {
-    lineToleranceMm: jspb.Message.getFloatingPointFieldWithDefault(msg, 1, 0.0),
-    orientationToleranceDegs: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0)
+    componentName: (f = msg.getComponentName()) && common_v1_common_pb.ResourceName.toObject(includeInstance, f),
+    rangesList: jspb.Message.toObjectList(msg.getRangesList(),
+    proto.viam.service.motion.v1.JointRange.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -3117,23 +8234,23 @@ proto.viam.service.motion.v1.LinearConstraint.toObject = function(includeInstanc
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.service.motion.v1.LinearConstraint}
+ * @return {!proto.viam.service.motion.v1.JointRangeConstraint}
  */
-proto.viam.service.motion.v1.LinearConstraint.deserializeBinary = function(bytes) {
+proto.viam.service.motion.v1.JointRangeConstraint.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.service.motion.v1.LinearConstraint;
-  return proto.viam.service.motion.v1.LinearConstraint.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.service.motion.v1.JointRangeConstraint;
+  return proto.viam.service.motion.v1.JointRangeConstraint.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.service.motion.v1.LinearConstraint} msg The message object to deserialize into.
+ * @param {!proto.viam.service.motion.v1.JointRangeConstraint} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.service.motion.v1.LinearConstraint}
+ * @return {!proto.viam.service.motion.v1.JointRangeConstraint}
  */
-proto.viam.service.motion.v1.LinearConstraint.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.service.motion.v1.JointRangeConstraint.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3141,12 +8258,14 @@ proto.viam.service.motion.v1.LinearConstraint.deserializeBinaryFromReader = func
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readFloat());
-      msg.setLineToleranceMm(value);
+      var value = new common_v1_common_pb.ResourceName;
+      reader.readMessage(value,common_v1_common_pb.ResourceName.deserializeBinaryFromReader);
+      msg.setComponentName(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readFloat());
-      msg.setOrientationToleranceDegs(value);
+      var value = new proto.viam.service.motion.v1.JointRange;
+      reader.readMessage(value,proto.viam.service.motion.v1.JointRange.deserializeBinaryFromReader);
+      msg.addRanges(value);
       break;
     default:
       reader.skipField();
@@ -3161,9 +8280,9 @@ proto.viam.service.motion.v1.LinearConstraint.deserializeBinaryFromReader = func
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.service.motion.v1.LinearConstraint.prototype.serializeBinary = function() {
+proto.viam.service.motion.v1.JointRangeConstraint.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.service.motion.v1.LinearConstraint.serializeBinaryToWriter(this, writer);
+  proto.viam.service.motion.v1.JointRangeConstraint.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3171,53 +8290,56 @@ proto.viam.service.motion.v1.LinearConstraint.prototype.serializeBinary = functi
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.service.motion.v1.LinearConstraint} message
+ * @param {!proto.viam.service.motion.v1.JointRangeConstraint} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.motion.v1.LinearConstraint.serializeBinaryToWriter = function(message, writer) {
+proto.viam.service.motion.v1.JointRangeConstraint.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = /** @type {number} */ (jspb.Message.getField(message, 1));
+  f = message.getComponentName();
   if (f != null) {
-    writer.writeFloat(
+    writer.writeMessage(
       1,
-      f
+      f,
+      common_v1_common_pb.ResourceName.serializeBinaryToWriter
     );
   }
-  f = /** @type {number} */ (jspb.Message.getField(message, 2));
-  if (f != null) {
-    writer.writeFloat(
+  f = message.getRangesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       2,
-      f
+      f,
+      proto.viam.service.motion.v1.JointRange.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional float line_tolerance_mm = 1;
- * @return {number}
+ * optional viam.common.v1.ResourceName component_name = 1;
+ * @return {?proto.viam.common.v1.ResourceName}
  */
-proto.viam.service.motion.v1.LinearConstraint.prototype.getLineToleranceMm = function() {
-  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 1, 0.0));
+proto.viam.service.motion.v1.JointRangeConstraint.prototype.getComponentName = function() {
+  return /** @type{?proto.viam.common.v1.ResourceName} */ (
+    jspb.Message.getWrapperField(this, common_v1_common_pb.ResourceName, 1));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.viam.service.motion.v1.LinearConstraint} returns this
- */
-proto.viam.service.motion.v1.LinearConstraint.prototype.setLineToleranceMm = function(value) {
-  return jspb.Message.setField(this, 1, value);
+ * @param {?proto.viam.common.v1.ResourceName|undefined} value
+ * @return {!proto.viam.service.motion.v1.JointRangeConstraint} returns this
+*/
+proto.viam.service.motion.v1.JointRangeConstraint.prototype.setComponentName = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.viam.service.motion.v1.LinearConstraint} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.motion.v1.JointRangeConstraint} returns this
  */
-proto.viam.service.motion.v1.LinearConstraint.prototype.clearLineToleranceMm = function() {
-  return jspb.Message.setField(this, 1, undefined);
+proto.viam.service.motion.v1.JointRangeConstraint.prototype.clearComponentName = function() {
+  return this.setComponentName(undefined);
 };
 
 
@@ -3225,44 +8347,46 @@ proto.viam.service.motion.v1.LinearConstraint.prototype.clearLineToleranceMm = f
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.service.motion.v1.LinearConstraint.prototype.hasLineToleranceMm = function() {
+proto.viam.service.motion.v1.JointRangeConstraint.prototype.hasComponentName = function() {
   return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * optional float orientation_tolerance_degs = 2;
- * @return {number}
+ * repeated JointRange ranges = 2;
+ * @return {!Array<!proto.viam.service.motion.v1.JointRange>}
  */
-proto.viam.service.motion.v1.LinearConstraint.prototype.getOrientationToleranceDegs = function() {
-  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
+proto.viam.service.motion.v1.JointRangeConstraint.prototype.getRangesList = function() {
+  return /** @type{!Array<!proto.viam.service.motion.v1.JointRange>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.viam.service.motion.v1.JointRange, 2));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.viam.service.motion.v1.LinearConstraint} returns this
- */
-proto.viam.service.motion.v1.LinearConstraint.prototype.setOrientationToleranceDegs = function(value) {
-  return jspb.Message.setField(this, 2, value);
+ * @param {!Array<!proto.viam.service.motion.v1.JointRange>} value
+ * @return {!proto.viam.service.motion.v1.JointRangeConstraint} returns this
+*/
+proto.viam.service.motion.v1.JointRangeConstraint.prototype.setRangesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.viam.service.motion.v1.LinearConstraint} returns this
+ * @param {!proto.viam.service.motion.v1.JointRange=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.service.motion.v1.JointRange}
  */
-proto.viam.service.motion.v1.LinearConstraint.prototype.clearOrientationToleranceDegs = function() {
-  return jspb.Message.setField(this, 2, undefined);
+proto.viam.service.motion.v1.JointRangeConstraint.prototype.addRanges = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.viam.service.motion.v1.JointRange, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.service.motion.v1.JointRangeConstraint} returns this
  */
-proto.viam.service.motion.v1.LinearConstraint.prototype.hasOrientationToleranceDegs = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.viam.service.motion.v1.JointRangeConstraint.prototype.clearRangesList = function() {
+  return this.setRangesList([]);
 };
 
 
@@ -3282,8 +8406,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.service.motion.v1.OrientationConstraint.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.service.motion.v1.OrientationConstraint.toObject(opt_includeInstance, this);
+proto.viam.service.motion.v1.JointRange.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.motion.v1.JointRange.toObject(opt_includeInstance, this);
 };
 
 
@@ -3292,13 +8416,14 @@ proto.viam.service.motion.v1.OrientationConstraint.prototype.toObject = function
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.service.motion.v1.OrientationConstraint} msg The msg instance to transform.
+ * @param {!proto.viam.service.motion.v1.JointRange} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.motion.v1.OrientationConstraint.toObject = function(includeInstance, msg) {
+proto.viam.service.motion.v1.JointRange.toObject = function(includeInstance, msg) {
   var f, obj = {
-    orientationToleranceDegs: jspb.Message.getFloatingPointFieldWithDefault(msg, 1, 0.0)
+    min: jspb.Message.getFloatingPointFieldWithDefault(msg, 1, 0.0),
+    max: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0)
   };
 
   if (includeInstance) {
@@ -3312,23 +8437,23 @@ proto.viam.service.motion.v1.OrientationConstraint.toObject = function(includeIn
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.service.motion.v1.OrientationConstraint}
+ * @return {!proto.viam.service.motion.v1.JointRange}
  */
-proto.viam.service.motion.v1.OrientationConstraint.deserializeBinary = function(bytes) {
+proto.viam.service.motion.v1.JointRange.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.service.motion.v1.OrientationConstraint;
-  return proto.viam.service.motion.v1.OrientationConstraint.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.service.motion.v1.JointRange;
+  return proto.viam.service.motion.v1.JointRange.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.service.motion.v1.OrientationConstraint} msg The message object to deserialize into.
+ * @param {!proto.viam.service.motion.v1.JointRange} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.service.motion.v1.OrientationConstraint}
+ * @return {!proto.viam.service.motion.v1.JointRange}
  */
-proto.viam.service.motion.v1.OrientationConstraint.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.service.motion.v1.JointRange.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3336,8 +8461,12 @@ proto.viam.service.motion.v1.OrientationConstraint.deserializeBinaryFromReader =
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readFloat());
-      msg.setOrientationToleranceDegs(value);
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setMin(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setMax(value);
       break;
     default:
       reader.skipField();
@@ -3352,9 +8481,9 @@ proto.viam.service.motion.v1.OrientationConstraint.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.service.motion.v1.OrientationConstraint.prototype.serializeBinary = function() {
+proto.viam.service.motion.v1.JointRange.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.service.motion.v1.OrientationConstraint.serializeBinaryToWriter(this, writer);
+  proto.viam.service.motion.v1.JointRange.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3362,45 +8491,52 @@ proto.viam.service.motion.v1.OrientationConstraint.prototype.serializeBinary = f
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.service.motion.v1.OrientationConstraint} message
+ * @param {!proto.viam.service.motion.v1.JointRange} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.motion.v1.OrientationConstraint.serializeBinaryToWriter = function(message, writer) {
+proto.viam.service.motion.v1.JointRange.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = /** @type {number} */ (jspb.Message.getField(message, 1));
   if (f != null) {
-    writer.writeFloat(
+    writer.writeDouble(
       1,
       f
     );
   }
+  f = /** @type {number} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeDouble(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional float orientation_tolerance_degs = 1;
+ * optional double min = 1;
  * @return {number}
  */
-proto.viam.service.motion.v1.OrientationConstraint.prototype.getOrientationToleranceDegs = function() {
+proto.viam.service.motion.v1.JointRange.prototype.getMin = function() {
   return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 1, 0.0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.viam.service.motion.v1.OrientationConstraint} returns this
+ * @return {!proto.viam.service.motion.v1.JointRange} returns this
  */
-proto.viam.service.motion.v1.OrientationConstraint.prototype.setOrientationToleranceDegs = function(value) {
+proto.viam.service.motion.v1.JointRange.prototype.setMin = function(value) {
   return jspb.Message.setField(this, 1, value);
 };
 
 
 /**
  * Clears the field making it undefined.
- * @return {!proto.viam.service.motion.v1.OrientationConstraint} returns this
+ * @return {!proto.viam.service.motion.v1.JointRange} returns this
  */
-proto.viam.service.motion.v1.OrientationConstraint.prototype.clearOrientationToleranceDegs = function() {
+proto.viam.service.motion.v1.JointRange.prototype.clearMin = function() {
   return jspb.Message.setField(this, 1, undefined);
 };
 
@@ -3409,130 +8545,44 @@ proto.viam.service.motion.v1.OrientationConstraint.prototype.clearOrientationTol
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.service.motion.v1.OrientationConstraint.prototype.hasOrientationToleranceDegs = function() {
+proto.viam.service.motion.v1.JointRange.prototype.hasMin = function() {
   return jspb.Message.getField(this, 1) != null;
 };
 
 
-
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.viam.service.motion.v1.CollisionSpecification.repeatedFields_ = [1];
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
-/**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
- */
-proto.viam.service.motion.v1.CollisionSpecification.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.service.motion.v1.CollisionSpecification.toObject(opt_includeInstance, this);
-};
-
-
-/**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.viam.service.motion.v1.CollisionSpecification} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.viam.service.motion.v1.CollisionSpecification.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    allowsList: jspb.Message.toObjectList(msg.getAllowsList(),
-    proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.toObject, includeInstance)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
-};
-}
-
-
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.service.motion.v1.CollisionSpecification}
+ * optional double max = 2;
+ * @return {number}
  */
-proto.viam.service.motion.v1.CollisionSpecification.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.service.motion.v1.CollisionSpecification;
-  return proto.viam.service.motion.v1.CollisionSpecification.deserializeBinaryFromReader(msg, reader);
+proto.viam.service.motion.v1.JointRange.prototype.getMax = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.viam.service.motion.v1.CollisionSpecification} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.service.motion.v1.CollisionSpecification}
+ * @param {number} value
+ * @return {!proto.viam.service.motion.v1.JointRange} returns this
  */
-proto.viam.service.motion.v1.CollisionSpecification.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = new proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions;
-      reader.readMessage(value,proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.deserializeBinaryFromReader);
-      msg.addAllows(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.viam.service.motion.v1.JointRange.prototype.setMax = function(value) {
+  return jspb.Message.setField(this, 2, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Clears the field making it undefined.
+ * @return {!proto.viam.service.motion.v1.JointRange} returns this
  */
-proto.viam.service.motion.v1.CollisionSpecification.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.viam.service.motion.v1.CollisionSpecification.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.viam.service.motion.v1.JointRange.prototype.clearMax = function() {
+  return jspb.Message.setField(this, 2, undefined);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.viam.service.motion.v1.CollisionSpecification} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.viam.service.motion.v1.CollisionSpecification.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getAllowsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      1,
-      f,
-      proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.serializeBinaryToWriter
-    );
-  }
+proto.viam.service.motion.v1.JointRange.prototype.hasMax = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
@@ -3552,8 +8602,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.toObject(opt_includeInstance, this);
+proto.viam.service.motion.v1.EndEffectorSpeedConstraint.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.motion.v1.EndEffectorSpeedConstraint.toObject(opt_includeInstance, this);
 };
 
 
@@ -3562,14 +8612,14 @@ proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.proto
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions} msg The msg instance to transform.
+ * @param {!proto.viam.service.motion.v1.EndEffectorSpeedConstraint} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.toObject = function(includeInstance, msg) {
+proto.viam.service.motion.v1.EndEffectorSpeedConstraint.toObject = function(includeInstance, msg) {
   var f, obj = {
-    frame1: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    frame2: jspb.Message.getFieldWithDefault(msg, 2, "")
+    maxLinearMmPerSec: jspb.Message.getFloatingPointFieldWithDefault(msg, 1, 0.0),
+    maxAngularDegsPerSec: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0)
   };
 
   if (includeInstance) {
@@ -3583,23 +8633,23 @@ proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.toObj
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions}
+ * @return {!proto.viam.service.motion.v1.EndEffectorSpeedConstraint}
  */
-proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.deserializeBinary = function(bytes) {
+proto.viam.service.motion.v1.EndEffectorSpeedConstraint.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions;
-  return proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.service.motion.v1.EndEffectorSpeedConstraint;
+  return proto.viam.service.motion.v1.EndEffectorSpeedConstraint.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions} msg The message object to deserialize into.
+ * @param {!proto.viam.service.motion.v1.EndEffectorSpeedConstraint} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions}
+ * @return {!proto.viam.service.motion.v1.EndEffectorSpeedConstraint}
  */
-proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.service.motion.v1.EndEffectorSpeedConstraint.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3607,12 +8657,12 @@ proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.deser
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setFrame1(value);
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setMaxLinearMmPerSec(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setFrame2(value);
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setMaxAngularDegsPerSec(value);
       break;
     default:
       reader.skipField();
@@ -3627,9 +8677,9 @@ proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.deser
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.prototype.serializeBinary = function() {
+proto.viam.service.motion.v1.EndEffectorSpeedConstraint.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.serializeBinaryToWriter(this, writer);
+  proto.viam.service.motion.v1.EndEffectorSpeedConstraint.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3637,22 +8687,22 @@ proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.proto
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions} message
+ * @param {!proto.viam.service.motion.v1.EndEffectorSpeedConstraint} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.serializeBinaryToWriter = function(message, writer) {
+proto.viam.service.motion.v1.EndEffectorSpeedConstraint.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getFrame1();
-  if (f.length > 0) {
-    writer.writeString(
+  f = /** @type {number} */ (jspb.Message.getField(message, 1));
+  if (f != null) {
+    writer.writeDouble(
       1,
       f
     );
   }
-  f = message.getFrame2();
-  if (f.length > 0) {
-    writer.writeString(
+  f = /** @type {number} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeDouble(
       2,
       f
     );
@@ -3661,77 +8711,100 @@ proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.seria
 
 
 /**
- * optional string frame1 = 1;
- * @return {string}
+ * optional double max_linear_mm_per_sec = 1;
+ * @return {number}
  */
-proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.prototype.getFrame1 = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.viam.service.motion.v1.EndEffectorSpeedConstraint.prototype.getMaxLinearMmPerSec = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 1, 0.0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions} returns this
+ * @param {number} value
+ * @return {!proto.viam.service.motion.v1.EndEffectorSpeedConstraint} returns this
  */
-proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.prototype.setFrame1 = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.viam.service.motion.v1.EndEffectorSpeedConstraint.prototype.setMaxLinearMmPerSec = function(value) {
+  return jspb.Message.setField(this, 1, value);
 };
 
 
 /**
- * optional string frame2 = 2;
- * @return {string}
+ * Clears the field making it undefined.
+ * @return {!proto.viam.service.motion.v1.EndEffectorSpeedConstraint} returns this
  */
-proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.prototype.getFrame2 = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.viam.service.motion.v1.EndEffectorSpeedConstraint.prototype.clearMaxLinearMmPerSec = function() {
+  return jspb.Message.setField(this, 1, undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions.prototype.setFrame2 = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.viam.service.motion.v1.EndEffectorSpeedConstraint.prototype.hasMaxLinearMmPerSec = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * repeated AllowedFrameCollisions allows = 1;
- * @return {!Array<!proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions>}
+ * optional double max_angular_degs_per_sec = 2;
+ * @return {number}
  */
-proto.viam.service.motion.v1.CollisionSpecification.prototype.getAllowsList = function() {
-  return /** @type{!Array<!proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions, 1));
+proto.viam.service.motion.v1.EndEffectorSpeedConstraint.prototype.getMaxAngularDegsPerSec = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
 };
 
 
 /**
- * @param {!Array<!proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions>} value
- * @return {!proto.viam.service.motion.v1.CollisionSpecification} returns this
-*/
-proto.viam.service.motion.v1.CollisionSpecification.prototype.setAllowsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {number} value
+ * @return {!proto.viam.service.motion.v1.EndEffectorSpeedConstraint} returns this
+ */
+proto.viam.service.motion.v1.EndEffectorSpeedConstraint.prototype.setMaxAngularDegsPerSec = function(value) {
+  return jspb.Message.setField(this, 2, value);
 };
 
 
 /**
- * @param {!proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions=} opt_value
- * @param {number=} opt_index
- * @return {!proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions}
+ * Clears the field making it undefined.
+ * @return {!proto.viam.service.motion.v1.EndEffectorSpeedConstraint} returns this
  */
-proto.viam.service.motion.v1.CollisionSpecification.prototype.addAllows = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions, opt_index);
+proto.viam.service.motion.v1.EndEffectorSpeedConstraint.prototype.clearMaxAngularDegsPerSec = function() {
+  return jspb.Message.setField(this, 2, undefined);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.viam.service.motion.v1.CollisionSpecification} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.viam.service.motion.v1.CollisionSpecification.prototype.clearAllowsList = function() {
-  return this.setAllowsList([]);
+proto.viam.service.motion.v1.EndEffectorSpeedConstraint.prototype.hasMaxAngularDegsPerSec = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
+/**
+ * @enum {number}
+ */
+proto.viam.service.motion.v1.ExecutionState = {
+  EXECUTION_STATE_UNSPECIFIED: 0,
+  EXECUTION_STATE_PLANNING: 1,
+  EXECUTION_STATE_IN_PROGRESS: 2,
+  EXECUTION_STATE_STOPPED: 3,
+  EXECUTION_STATE_SUCCEEDED: 4,
+  EXECUTION_STATE_FAILED: 5
+};
+
+/**
+ * @enum {number}
+ */
+proto.viam.service.motion.v1.PlanningFailureReason = {
+  PLANNING_FAILURE_REASON_UNSPECIFIED: 0,
+  PLANNING_FAILURE_REASON_GOAL_UNREACHABLE: 1,
+  PLANNING_FAILURE_REASON_COLLISION_AT_START: 2,
+  PLANNING_FAILURE_REASON_COLLISION_AT_GOAL: 3,
+  PLANNING_FAILURE_REASON_CONSTRAINT_INFEASIBLE: 4,
+  PLANNING_FAILURE_REASON_TIMEOUT: 5,
+  PLANNING_FAILURE_REASON_IK_FAILURE: 6
+};
+
 goog.object.extend(exports, proto.viam.service.motion.v1);
@@ -32,6 +32,42 @@ type MotionServiceMoveOnGlobe = {
   readonly responseType: typeof service_motion_v1_motion_pb.MoveOnGlobeResponse;
 };
 
+type MotionServicePlanMove = {
+  readonly methodName: string;
+  readonly service: typeof MotionService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof service_motion_v1_motion_pb.PlanMoveRequest;
+  readonly responseType: typeof service_motion_v1_motion_pb.PlanMoveResponse;
+};
+
+type MotionServiceGetPlan = {
+  readonly methodName: string;
+  readonly service: typeof MotionService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof service_motion_v1_motion_pb.GetPlanRequest;
+  readonly responseType: typeof service_motion_v1_motion_pb.GetPlanResponse;
+};
+
+type MotionServiceGetExecutionStatus = {
+  readonly methodName: string;
+  readonly service: typeof MotionService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof service_motion_v1_motion_pb.GetExecutionStatusRequest;
+  readonly responseType: typeof service_motion_v1_motion_pb.GetExecutionStatusResponse;
+};
+
+type MotionServiceStopExecution = {
+  readonly methodName: string;
+  readonly service: typeof MotionService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof service_motion_v1_motion_pb.StopExecutionRequest;
+  readonly responseType: typeof service_motion_v1_motion_pb.StopExecutionResponse;
+};
+
 type MotionServiceGetPose = {
   readonly methodName: string;
   readonly service: typeof MotionService;
@@ -55,6 +91,10 @@ export class MotionService {
   static readonly Move: MotionServiceMove;
   static readonly MoveOnMap: MotionServiceMoveOnMap;
   static readonly MoveOnGlobe: MotionServiceMoveOnGlobe;
+  static readonly PlanMove: MotionServicePlanMove;
+  static readonly GetPlan: MotionServiceGetPlan;
+  static readonly GetExecutionStatus: MotionServiceGetExecutionStatus;
+  static readonly StopExecution: MotionServiceStopExecution;
   static readonly GetPose: MotionServiceGetPose;
   static readonly DoCommand: MotionServiceDoCommand;
 }
@@ -118,6 +158,42 @@ export class MotionServiceClient {
     requestMessage: service_motion_v1_motion_pb.MoveOnGlobeRequest,
     callback: (error: ServiceError|null, responseMessage: service_motion_v1_motion_pb.MoveOnGlobeResponse|null) => void
   ): UnaryResponse;
+  planMove(
+    requestMessage: service_motion_v1_motion_pb.PlanMoveRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: service_motion_v1_motion_pb.PlanMoveResponse|null) => void
+  ): UnaryResponse;
+  planMove(
+    requestMessage: service_motion_v1_motion_pb.PlanMoveRequest,
+    callback: (error: ServiceError|null, responseMessage: service_motion_v1_motion_pb.PlanMoveResponse|null) => void
+  ): UnaryResponse;
+  getPlan(
+    requestMessage: service_motion_v1_motion_pb.GetPlanRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: service_motion_v1_motion_pb.GetPlanResponse|null) => void
+  ): UnaryResponse;
+  getPlan(
+    requestMessage: service_motion_v1_motion_pb.GetPlanRequest,
+    callback: (error: ServiceError|null, responseMessage: service_motion_v1_motion_pb.GetPlanResponse|null) => void
+  ): UnaryResponse;
+  getExecutionStatus(
+    requestMessage: service_motion_v1_motion_pb.GetExecutionStatusRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: service_motion_v1_motion_pb.GetExecutionStatusResponse|null) => void
+  ): UnaryResponse;
+  getExecutionStatus(
+    requestMessage: service_motion_v1_motion_pb.GetExecutionStatusRequest,
+    callback: (error: ServiceError|null, responseMessage: service_motion_v1_motion_pb.GetExecutionStatusResponse|null) => void
+  ): UnaryResponse;
+  stopExecution(
+    requestMessage: service_motion_v1_motion_pb.StopExecutionRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: service_motion_v1_motion_pb.StopExecutionResponse|null) => void
+  ): UnaryResponse;
+  stopExecution(
+    requestMessage: service_motion_v1_motion_pb.StopExecutionRequest,
+    callback: (error: ServiceError|null, responseMessage: service_motion_v1_motion_pb.StopExecutionResponse|null) => void
+  ): UnaryResponse;
   getPose(
     requestMessage: service_motion_v1_motion_pb.GetPoseRequest,
     metadata: grpc.Metadata,
@@ -38,6 +38,42 @@ MotionService.MoveOnGlobe = {
   responseType: service_motion_v1_motion_pb.MoveOnGlobeResponse
 };
 
+MotionService.PlanMove = {
+  methodName: "PlanMove",
+  service: MotionService,
+  requestStream: false,
+  responseStream: false,
+  requestType: service_motion_v1_motion_pb.PlanMoveRequest,
+  responseType: service_motion_v1_motion_pb.PlanMoveResponse
+};
+
+MotionService.GetPlan = {
+  methodName: "GetPlan",
+  service: MotionService,
+  requestStream: false,
+  responseStream: false,
+  requestType: service_motion_v1_motion_pb.GetPlanRequest,
+  responseType: service_motion_v1_motion_pb.GetPlanResponse
+};
+
+MotionService.GetExecutionStatus = {
+  methodName: "GetExecutionStatus",
+  service: MotionService,
+  requestStream: false,
+  responseStream: false,
+  requestType: service_motion_v1_motion_pb.GetExecutionStatusRequest,
+  responseType: service_motion_v1_motion_pb.GetExecutionStatusResponse
+};
+
+MotionService.StopExecution = {
+  methodName: "StopExecution",
+  service: MotionService,
+  requestStream: false,
+  responseStream: false,
+  requestType: service_motion_v1_motion_pb.StopExecutionRequest,
+  responseType: service_motion_v1_motion_pb.StopExecutionResponse
+};
+
 MotionService.GetPose = {
   methodName: "GetPose",
   service: MotionService,
@@ -156,6 +192,130 @@ MotionServiceClient.prototype.moveOnGlobe = function moveOnGlobe(requestMessage,
   };
 };
 
+MotionServiceClient.prototype.planMove = function planMove(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(MotionService.PlanMove, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+MotionServiceClient.prototype.getPlan = function getPlan(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(MotionService.GetPlan, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+MotionServiceClient.prototype.getExecutionStatus = function getExecutionStatus(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(MotionService.GetExecutionStatus, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+MotionServiceClient.prototype.stopExecution = function stopExecution(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(MotionService.StopExecution, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
 MotionServiceClient.prototype.getPose = function getPose(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
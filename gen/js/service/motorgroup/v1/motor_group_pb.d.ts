@@ -0,0 +1,184 @@
+// package: viam.service.motorgroup.v1
+// file: service/motorgroup/v1/motor_group.proto
+
+import * as jspb from "google-protobuf";
+import * as common_v1_common_pb from "../../../common/v1/common_pb";
+import * as google_api_annotations_pb from "../../../google/api/annotations_pb";
+import * as google_protobuf_struct_pb from "google-protobuf/google/protobuf/struct_pb";
+import * as google_protobuf_timestamp_pb from "google-protobuf/google/protobuf/timestamp_pb";
+
+export class MoveRequest extends jspb.Message {
+  getName(): string;
+  setName(value: string): void;
+
+  getTargetsMap(): jspb.Map<string, MotorTarget>;
+  clearTargetsMap(): void;
+  hasStartTime(): boolean;
+  clearStartTime(): void;
+  getStartTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setStartTime(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  getSynchronizeArrival(): boolean;
+  setSynchronizeArrival(value: boolean): void;
+
+  hasExtra(): boolean;
+  clearExtra(): void;
+  getExtra(): google_protobuf_struct_pb.Struct | undefined;
+  setExtra(value?: google_protobuf_struct_pb.Struct): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): MoveRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: MoveRequest): MoveRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: MoveRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): MoveRequest;
+  static deserializeBinaryFromReader(message: MoveRequest, reader: jspb.BinaryReader): MoveRequest;
+}
+
+export namespace MoveRequest {
+  export type AsObject = {
+    name: string,
+    targetsMap: Array<[string, MotorTarget.AsObject]>,
+    startTime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    synchronizeArrival: boolean,
+    extra?: google_protobuf_struct_pb.Struct.AsObject,
+  }
+}
+
+export class MoveResponse extends jspb.Message {
+  hasStartedAt(): boolean;
+  clearStartedAt(): void;
+  getStartedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setStartedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  hasCompletedAt(): boolean;
+  clearCompletedAt(): void;
+  getCompletedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setCompletedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): MoveResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: MoveResponse): MoveResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: MoveResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): MoveResponse;
+  static deserializeBinaryFromReader(message: MoveResponse, reader: jspb.BinaryReader): MoveResponse;
+}
+
+export namespace MoveResponse {
+  export type AsObject = {
+    startedAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    completedAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+  }
+}
+
+export class MotorTarget extends jspb.Message {
+  getRpm(): number;
+  setRpm(value: number): void;
+
+  hasPositionRevolutions(): boolean;
+  clearPositionRevolutions(): void;
+  getPositionRevolutions(): number;
+  setPositionRevolutions(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): MotorTarget.AsObject;
+  static toObject(includeInstance: boolean, msg: MotorTarget): MotorTarget.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: MotorTarget, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): MotorTarget;
+  static deserializeBinaryFromReader(message: MotorTarget, reader: jspb.BinaryReader): MotorTarget;
+}
+
+export namespace MotorTarget {
+  export type AsObject = {
+    rpm: number,
+    positionRevolutions: number,
+  }
+}
+
+export class StopRequest extends jspb.Message {
+  getName(): string;
+  setName(value: string): void;
+
+  hasExtra(): boolean;
+  clearExtra(): void;
+  getExtra(): google_protobuf_struct_pb.Struct | undefined;
+  setExtra(value?: google_protobuf_struct_pb.Struct): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StopRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: StopRequest): StopRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: StopRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StopRequest;
+  static deserializeBinaryFromReader(message: StopRequest, reader: jspb.BinaryReader): StopRequest;
+}
+
+export namespace StopRequest {
+  export type AsObject = {
+    name: string,
+    extra?: google_protobuf_struct_pb.Struct.AsObject,
+  }
+}
+
+export class StopResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StopResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: StopResponse): StopResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: StopResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StopResponse;
+  static deserializeBinaryFromReader(message: StopResponse, reader: jspb.BinaryReader): StopResponse;
+}
+
+export namespace StopResponse {
+  export type AsObject = {
+  }
+}
+
+export class IsMovingRequest extends jspb.Message {
+  getName(): string;
+  setName(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): IsMovingRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: IsMovingRequest): IsMovingRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: IsMovingRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): IsMovingRequest;
+  static deserializeBinaryFromReader(message: IsMovingRequest, reader: jspb.BinaryReader): IsMovingRequest;
+}
+
+export namespace IsMovingRequest {
+  export type AsObject = {
+    name: string,
+  }
+}
+
+export class IsMovingResponse extends jspb.Message {
+  getIsMoving(): boolean;
+  setIsMoving(value: boolean): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): IsMovingResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: IsMovingResponse): IsMovingResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: IsMovingResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): IsMovingResponse;
+  static deserializeBinaryFromReader(message: IsMovingResponse, reader: jspb.BinaryReader): IsMovingResponse;
+}
+
+export namespace IsMovingResponse {
+  export type AsObject = {
+    isMoving: boolean,
+  }
+}
+
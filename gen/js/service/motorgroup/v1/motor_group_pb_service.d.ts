@@ -0,0 +1,121 @@
+// package: viam.service.motorgroup.v1
+// file: service/motorgroup/v1/motor_group.proto
+
+import * as service_motorgroup_v1_motor_group_pb from "../../../service/motorgroup/v1/motor_group_pb";
+import * as common_v1_common_pb from "../../../common/v1/common_pb";
+import {grpc} from "@improbable-eng/grpc-web";
+
+type MotorGroupServiceMove = {
+  readonly methodName: string;
+  readonly service: typeof MotorGroupService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof service_motorgroup_v1_motor_group_pb.MoveRequest;
+  readonly responseType: typeof service_motorgroup_v1_motor_group_pb.MoveResponse;
+};
+
+type MotorGroupServiceStop = {
+  readonly methodName: string;
+  readonly service: typeof MotorGroupService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof service_motorgroup_v1_motor_group_pb.StopRequest;
+  readonly responseType: typeof service_motorgroup_v1_motor_group_pb.StopResponse;
+};
+
+type MotorGroupServiceIsMoving = {
+  readonly methodName: string;
+  readonly service: typeof MotorGroupService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof service_motorgroup_v1_motor_group_pb.IsMovingRequest;
+  readonly responseType: typeof service_motorgroup_v1_motor_group_pb.IsMovingResponse;
+};
+
+type MotorGroupServiceDoCommand = {
+  readonly methodName: string;
+  readonly service: typeof MotorGroupService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof common_v1_common_pb.DoCommandRequest;
+  readonly responseType: typeof common_v1_common_pb.DoCommandResponse;
+};
+
+export class MotorGroupService {
+  static readonly serviceName: string;
+  static readonly Move: MotorGroupServiceMove;
+  static readonly Stop: MotorGroupServiceStop;
+  static readonly IsMoving: MotorGroupServiceIsMoving;
+  static readonly DoCommand: MotorGroupServiceDoCommand;
+}
+
+export type ServiceError = { message: string, code: number; metadata: grpc.Metadata }
+export type Status = { details: string, code: number; metadata: grpc.Metadata }
+
+interface UnaryResponse {
+  cancel(): void;
+}
+interface ResponseStream<T> {
+  cancel(): void;
+  on(type: 'data', handler: (message: T) => void): ResponseStream<T>;
+  on(type: 'end', handler: (status?: Status) => void): ResponseStream<T>;
+  on(type: 'status', handler: (status: Status) => void): ResponseStream<T>;
+}
+interface RequestStream<T> {
+  write(message: T): RequestStream<T>;
+  end(): void;
+  cancel(): void;
+  on(type: 'end', handler: (status?: Status) => void): RequestStream<T>;
+  on(type: 'status', handler: (status: Status) => void): RequestStream<T>;
+}
+interface BidirectionalStream<ReqT, ResT> {
+  write(message: ReqT): BidirectionalStream<ReqT, ResT>;
+  end(): void;
+  cancel(): void;
+  on(type: 'data', handler: (message: ResT) => void): BidirectionalStream<ReqT, ResT>;
+  on(type: 'end', handler: (status?: Status) => void): BidirectionalStream<ReqT, ResT>;
+  on(type: 'status', handler: (status: Status) => void): BidirectionalStream<ReqT, ResT>;
+}
+
+export class MotorGroupServiceClient {
+  readonly serviceHost: string;
+
+  constructor(serviceHost: string, options?: grpc.RpcOptions);
+  move(
+    requestMessage: service_motorgroup_v1_motor_group_pb.MoveRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: service_motorgroup_v1_motor_group_pb.MoveResponse|null) => void
+  ): UnaryResponse;
+  move(
+    requestMessage: service_motorgroup_v1_motor_group_pb.MoveRequest,
+    callback: (error: ServiceError|null, responseMessage: service_motorgroup_v1_motor_group_pb.MoveResponse|null) => void
+  ): UnaryResponse;
+  stop(
+    requestMessage: service_motorgroup_v1_motor_group_pb.StopRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: service_motorgroup_v1_motor_group_pb.StopResponse|null) => void
+  ): UnaryResponse;
+  stop(
+    requestMessage: service_motorgroup_v1_motor_group_pb.StopRequest,
+    callback: (error: ServiceError|null, responseMessage: service_motorgroup_v1_motor_group_pb.StopResponse|null) => void
+  ): UnaryResponse;
+  isMoving(
+    requestMessage: service_motorgroup_v1_motor_group_pb.IsMovingRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: service_motorgroup_v1_motor_group_pb.IsMovingResponse|null) => void
+  ): UnaryResponse;
+  isMoving(
+    requestMessage: service_motorgroup_v1_motor_group_pb.IsMovingRequest,
+    callback: (error: ServiceError|null, responseMessage: service_motorgroup_v1_motor_group_pb.IsMovingResponse|null) => void
+  ): UnaryResponse;
+  doCommand(
+    requestMessage: common_v1_common_pb.DoCommandRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: common_v1_common_pb.DoCommandResponse|null) => void
+  ): UnaryResponse;
+  doCommand(
+    requestMessage: common_v1_common_pb.DoCommandRequest,
+    callback: (error: ServiceError|null, responseMessage: common_v1_common_pb.DoCommandResponse|null) => void
+  ): UnaryResponse;
+}
+
@@ -0,0 +1,182 @@
+// package: viam.service.motorgroup.v1
+// file: service/motorgroup/v1/motor_group.proto
+
+var service_motorgroup_v1_motor_group_pb = require("../../../service/motorgroup/v1/motor_group_pb");
+var common_v1_common_pb = require("../../../common/v1/common_pb");
+var grpc = require("@improbable-eng/grpc-web").grpc;
+
+var MotorGroupService = (function () {
+  function MotorGroupService() {}
+  MotorGroupService.serviceName = "viam.service.motorgroup.v1.MotorGroupService";
+  return MotorGroupService;
+}());
+
+MotorGroupService.Move = {
+  methodName: "Move",
+  service: MotorGroupService,
+  requestStream: false,
+  responseStream: false,
+  requestType: service_motorgroup_v1_motor_group_pb.MoveRequest,
+  responseType: service_motorgroup_v1_motor_group_pb.MoveResponse
+};
+
+MotorGroupService.Stop = {
+  methodName: "Stop",
+  service: MotorGroupService,
+  requestStream: false,
+  responseStream: false,
+  requestType: service_motorgroup_v1_motor_group_pb.StopRequest,
+  responseType: service_motorgroup_v1_motor_group_pb.StopResponse
+};
+
+MotorGroupService.IsMoving = {
+  methodName: "IsMoving",
+  service: MotorGroupService,
+  requestStream: false,
+  responseStream: false,
+  requestType: service_motorgroup_v1_motor_group_pb.IsMovingRequest,
+  responseType: service_motorgroup_v1_motor_group_pb.IsMovingResponse
+};
+
+MotorGroupService.DoCommand = {
+  methodName: "DoCommand",
+  service: MotorGroupService,
+  requestStream: false,
+  responseStream: false,
+  requestType: common_v1_common_pb.DoCommandRequest,
+  responseType: common_v1_common_pb.DoCommandResponse
+};
+
+exports.MotorGroupService = MotorGroupService;
+
+function MotorGroupServiceClient(serviceHost, options) {
+  this.serviceHost = serviceHost;
+  this.options = options || {};
+}
+
+MotorGroupServiceClient.prototype.move = function move(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(MotorGroupService.Move, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+MotorGroupServiceClient.prototype.stop = function stop(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(MotorGroupService.Stop, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+MotorGroupServiceClient.prototype.isMoving = function isMoving(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(MotorGroupService.IsMoving, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+MotorGroupServiceClient.prototype.doCommand = function doCommand(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(MotorGroupService.DoCommand, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+exports.MotorGroupServiceClient = MotorGroupServiceClient;
+
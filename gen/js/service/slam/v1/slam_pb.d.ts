@@ -63,6 +63,9 @@ export class GetPointCloudMapRequest extends jspb.Message {
   getName(): string;
   setName(value: string): void;
 
+  getMimeType(): string;
+  setMimeType(value: string): void;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): GetPointCloudMapRequest.AsObject;
   static toObject(includeInstance: boolean, msg: GetPointCloudMapRequest): GetPointCloudMapRequest.AsObject;
@@ -76,6 +79,7 @@ export class GetPointCloudMapRequest extends jspb.Message {
 export namespace GetPointCloudMapRequest {
   export type AsObject = {
     name: string,
+    mimeType: string,
   }
 }
 
@@ -85,6 +89,9 @@ export class GetPointCloudMapResponse extends jspb.Message {
   getPointCloudPcdChunk_asB64(): string;
   setPointCloudPcdChunk(value: Uint8Array | string): void;
 
+  getMimeType(): string;
+  setMimeType(value: string): void;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): GetPointCloudMapResponse.AsObject;
   static toObject(includeInstance: boolean, msg: GetPointCloudMapResponse): GetPointCloudMapResponse.AsObject;
@@ -98,6 +105,7 @@ export class GetPointCloudMapResponse extends jspb.Message {
 export namespace GetPointCloudMapResponse {
   export type AsObject = {
     pointCloudPcdChunk: Uint8Array | string,
+    mimeType: string,
   }
 }
 
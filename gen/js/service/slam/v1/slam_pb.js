@@ -593,7 +593,8 @@ proto.viam.service.slam.v1.GetPointCloudMapRequest.prototype.toObject = function
  */
 proto.viam.service.slam.v1.GetPointCloudMapRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, "")
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    mimeType: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -634,6 +635,10 @@ proto.viam.service.slam.v1.GetPointCloudMapRequest.deserializeBinaryFromReader =
       var value = /** @type {string} */ (reader.readString());
       msg.setName(value);
       break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMimeType(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -670,6 +675,13 @@ proto.viam.service.slam.v1.GetPointCloudMapRequest.serializeBinaryToWriter = fun
       f
     );
   }
+  f = message.getMimeType();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
@@ -691,6 +703,24 @@ proto.viam.service.slam.v1.GetPointCloudMapRequest.prototype.setName = function(
 };
 
 
+/**
+ * optional string mime_type = 2;
+ * @return {string}
+ */
+proto.viam.service.slam.v1.GetPointCloudMapRequest.prototype.getMimeType = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.service.slam.v1.GetPointCloudMapRequest} returns this
+ */
+proto.viam.service.slam.v1.GetPointCloudMapRequest.prototype.setMimeType = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
 
 
 
@@ -723,7 +753,8 @@ proto.viam.service.slam.v1.GetPointCloudMapResponse.prototype.toObject = functio
  */
 proto.viam.service.slam.v1.GetPointCloudMapResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    pointCloudPcdChunk: msg.getPointCloudPcdChunk_asB64()
+    pointCloudPcdChunk: msg.getPointCloudPcdChunk_asB64(),
+    mimeType: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -764,6 +795,10 @@ proto.viam.service.slam.v1.GetPointCloudMapResponse.deserializeBinaryFromReader
       var value = /** @type {!Uint8Array} */ (reader.readBytes());
       msg.setPointCloudPcdChunk(value);
       break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMimeType(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -800,6 +835,13 @@ proto.viam.service.slam.v1.GetPointCloudMapResponse.serializeBinaryToWriter = fu
       f
     );
   }
+  f = message.getMimeType();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
@@ -845,6 +887,24 @@ proto.viam.service.slam.v1.GetPointCloudMapResponse.prototype.setPointCloudPcdCh
 };
 
 
+/**
+ * optional string mime_type = 2;
+ * @return {string}
+ */
+proto.viam.service.slam.v1.GetPointCloudMapResponse.prototype.getMimeType = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.service.slam.v1.GetPointCloudMapResponse} returns this
+ */
+proto.viam.service.slam.v1.GetPointCloudMapResponse.prototype.setMimeType = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
 
 
 
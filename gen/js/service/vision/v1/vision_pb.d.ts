@@ -3,7 +3,9 @@
 
 import * as jspb from "google-protobuf";
 import * as common_v1_common_pb from "../../../common/v1/common_pb";
+import * as component_camera_v1_camera_pb from "../../../component/camera/v1/camera_pb";
 import * as google_api_annotations_pb from "../../../google/api/annotations_pb";
+import * as google_protobuf_duration_pb from "google-protobuf/google/protobuf/duration_pb";
 import * as google_protobuf_struct_pb from "google-protobuf/google/protobuf/struct_pb";
 
 export class GetDetectionsRequest extends jspb.Message {
@@ -124,6 +126,144 @@ export namespace GetDetectionsFromCameraResponse {
   }
 }
 
+export class TrackObjectsFromCameraRequest extends jspb.Message {
+  getName(): string;
+  setName(value: string): void;
+
+  getCameraName(): string;
+  setCameraName(value: string): void;
+
+  getMaxFps(): number;
+  setMaxFps(value: number): void;
+
+  hasExtra(): boolean;
+  clearExtra(): void;
+  getExtra(): google_protobuf_struct_pb.Struct | undefined;
+  setExtra(value?: google_protobuf_struct_pb.Struct): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): TrackObjectsFromCameraRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: TrackObjectsFromCameraRequest): TrackObjectsFromCameraRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: TrackObjectsFromCameraRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TrackObjectsFromCameraRequest;
+  static deserializeBinaryFromReader(message: TrackObjectsFromCameraRequest, reader: jspb.BinaryReader): TrackObjectsFromCameraRequest;
+}
+
+export namespace TrackObjectsFromCameraRequest {
+  export type AsObject = {
+    name: string,
+    cameraName: string,
+    maxFps: number,
+    extra?: google_protobuf_struct_pb.Struct.AsObject,
+  }
+}
+
+export class TrackObjectsFromCameraResponse extends jspb.Message {
+  clearTracksList(): void;
+  getTracksList(): Array<Track>;
+  setTracksList(value: Array<Track>): void;
+  addTracks(value?: Track, index?: number): Track;
+
+  clearEventsList(): void;
+  getEventsList(): Array<TrackEvent>;
+  setEventsList(value: Array<TrackEvent>): void;
+  addEvents(value?: TrackEvent, index?: number): TrackEvent;
+
+  hasResponseMetadata(): boolean;
+  clearResponseMetadata(): void;
+  getResponseMetadata(): common_v1_common_pb.ResponseMetadata | undefined;
+  setResponseMetadata(value?: common_v1_common_pb.ResponseMetadata): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): TrackObjectsFromCameraResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: TrackObjectsFromCameraResponse): TrackObjectsFromCameraResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: TrackObjectsFromCameraResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TrackObjectsFromCameraResponse;
+  static deserializeBinaryFromReader(message: TrackObjectsFromCameraResponse, reader: jspb.BinaryReader): TrackObjectsFromCameraResponse;
+}
+
+export namespace TrackObjectsFromCameraResponse {
+  export type AsObject = {
+    tracksList: Array<Track.AsObject>,
+    eventsList: Array<TrackEvent.AsObject>,
+    responseMetadata?: common_v1_common_pb.ResponseMetadata.AsObject,
+  }
+}
+
+export class Track extends jspb.Message {
+  getTrackId(): number;
+  setTrackId(value: number): void;
+
+  hasDetection(): boolean;
+  clearDetection(): void;
+  getDetection(): Detection | undefined;
+  setDetection(value?: Detection): void;
+
+  hasAge(): boolean;
+  clearAge(): void;
+  getAge(): google_protobuf_duration_pb.Duration | undefined;
+  setAge(value?: google_protobuf_duration_pb.Duration): void;
+
+  getAgeFrames(): number;
+  setAgeFrames(value: number): void;
+
+  hasVelocityPxPerSec(): boolean;
+  clearVelocityPxPerSec(): void;
+  getVelocityPxPerSec(): Point2D | undefined;
+  setVelocityPxPerSec(value?: Point2D): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Track.AsObject;
+  static toObject(includeInstance: boolean, msg: Track): Track.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: Track, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Track;
+  static deserializeBinaryFromReader(message: Track, reader: jspb.BinaryReader): Track;
+}
+
+export namespace Track {
+  export type AsObject = {
+    trackId: number,
+    detection?: Detection.AsObject,
+    age?: google_protobuf_duration_pb.Duration.AsObject,
+    ageFrames: number,
+    velocityPxPerSec?: Point2D.AsObject,
+  }
+}
+
+export class TrackEvent extends jspb.Message {
+  getTrackId(): number;
+  setTrackId(value: number): void;
+
+  getType(): TrackEventTypeMap[keyof TrackEventTypeMap];
+  setType(value: TrackEventTypeMap[keyof TrackEventTypeMap]): void;
+
+  getClassName(): string;
+  setClassName(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): TrackEvent.AsObject;
+  static toObject(includeInstance: boolean, msg: TrackEvent): TrackEvent.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: TrackEvent, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TrackEvent;
+  static deserializeBinaryFromReader(message: TrackEvent, reader: jspb.BinaryReader): TrackEvent;
+}
+
+export namespace TrackEvent {
+  export type AsObject = {
+    trackId: number,
+    type: TrackEventTypeMap[keyof TrackEventTypeMap],
+    className: string,
+  }
+}
+
 export class Detection extends jspb.Message {
   hasXMin(): boolean;
   clearXMin(): void;
@@ -151,6 +291,21 @@ export class Detection extends jspb.Message {
   getClassName(): string;
   setClassName(value: string): void;
 
+  hasMask(): boolean;
+  clearMask(): void;
+  getMask(): Mask | undefined;
+  setMask(value?: Mask): void;
+
+  clearKeypointsList(): void;
+  getKeypointsList(): Array<Keypoint>;
+  setKeypointsList(value: Array<Keypoint>): void;
+  addKeypoints(value?: Keypoint, index?: number): Keypoint;
+
+  hasOrientedBox(): boolean;
+  clearOrientedBox(): void;
+  getOrientedBox(): OrientedBoundingBox | undefined;
+  setOrientedBox(value?: OrientedBoundingBox): void;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): Detection.AsObject;
   static toObject(includeInstance: boolean, msg: Detection): Detection.AsObject;
@@ -169,6 +324,206 @@ export namespace Detection {
     yMax: number,
     confidence: number,
     className: string,
+    mask?: Mask.AsObject,
+    keypointsList: Array<Keypoint.AsObject>,
+    orientedBox?: OrientedBoundingBox.AsObject,
+  }
+}
+
+export class Mask extends jspb.Message {
+  hasRle(): boolean;
+  clearRle(): void;
+  getRle(): RunLengthMask | undefined;
+  setRle(value?: RunLengthMask): void;
+
+  hasPolygon(): boolean;
+  clearPolygon(): void;
+  getPolygon(): PolygonMask | undefined;
+  setPolygon(value?: PolygonMask): void;
+
+  getMaskCase(): Mask.MaskCase;
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Mask.AsObject;
+  static toObject(includeInstance: boolean, msg: Mask): Mask.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: Mask, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Mask;
+  static deserializeBinaryFromReader(message: Mask, reader: jspb.BinaryReader): Mask;
+}
+
+export namespace Mask {
+  export type AsObject = {
+    rle?: RunLengthMask.AsObject,
+    polygon?: PolygonMask.AsObject,
+  }
+
+  export enum MaskCase {
+    MASK_NOT_SET = 0,
+    RLE = 1,
+    POLYGON = 2,
+  }
+}
+
+export class RunLengthMask extends jspb.Message {
+  getWidth(): number;
+  setWidth(value: number): void;
+
+  getHeight(): number;
+  setHeight(value: number): void;
+
+  clearCountsList(): void;
+  getCountsList(): Array<number>;
+  setCountsList(value: Array<number>): void;
+  addCounts(value: number, index?: number): number;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RunLengthMask.AsObject;
+  static toObject(includeInstance: boolean, msg: RunLengthMask): RunLengthMask.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: RunLengthMask, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RunLengthMask;
+  static deserializeBinaryFromReader(message: RunLengthMask, reader: jspb.BinaryReader): RunLengthMask;
+}
+
+export namespace RunLengthMask {
+  export type AsObject = {
+    width: number,
+    height: number,
+    countsList: Array<number>,
+  }
+}
+
+export class PolygonMask extends jspb.Message {
+  clearPolygonsList(): void;
+  getPolygonsList(): Array<Polygon>;
+  setPolygonsList(value: Array<Polygon>): void;
+  addPolygons(value?: Polygon, index?: number): Polygon;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PolygonMask.AsObject;
+  static toObject(includeInstance: boolean, msg: PolygonMask): PolygonMask.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: PolygonMask, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PolygonMask;
+  static deserializeBinaryFromReader(message: PolygonMask, reader: jspb.BinaryReader): PolygonMask;
+}
+
+export namespace PolygonMask {
+  export type AsObject = {
+    polygonsList: Array<Polygon.AsObject>,
+  }
+}
+
+export class Polygon extends jspb.Message {
+  clearPointsList(): void;
+  getPointsList(): Array<Point2D>;
+  setPointsList(value: Array<Point2D>): void;
+  addPoints(value?: Point2D, index?: number): Point2D;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Polygon.AsObject;
+  static toObject(includeInstance: boolean, msg: Polygon): Polygon.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: Polygon, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Polygon;
+  static deserializeBinaryFromReader(message: Polygon, reader: jspb.BinaryReader): Polygon;
+}
+
+export namespace Polygon {
+  export type AsObject = {
+    pointsList: Array<Point2D.AsObject>,
+  }
+}
+
+export class Point2D extends jspb.Message {
+  getX(): number;
+  setX(value: number): void;
+
+  getY(): number;
+  setY(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Point2D.AsObject;
+  static toObject(includeInstance: boolean, msg: Point2D): Point2D.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: Point2D, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Point2D;
+  static deserializeBinaryFromReader(message: Point2D, reader: jspb.BinaryReader): Point2D;
+}
+
+export namespace Point2D {
+  export type AsObject = {
+    x: number,
+    y: number,
+  }
+}
+
+export class Keypoint extends jspb.Message {
+  hasPoint(): boolean;
+  clearPoint(): void;
+  getPoint(): Point2D | undefined;
+  setPoint(value?: Point2D): void;
+
+  getConfidence(): number;
+  setConfidence(value: number): void;
+
+  getVisible(): boolean;
+  setVisible(value: boolean): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Keypoint.AsObject;
+  static toObject(includeInstance: boolean, msg: Keypoint): Keypoint.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: Keypoint, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Keypoint;
+  static deserializeBinaryFromReader(message: Keypoint, reader: jspb.BinaryReader): Keypoint;
+}
+
+export namespace Keypoint {
+  export type AsObject = {
+    point?: Point2D.AsObject,
+    confidence: number,
+    visible: boolean,
+  }
+}
+
+export class OrientedBoundingBox extends jspb.Message {
+  hasCenter(): boolean;
+  clearCenter(): void;
+  getCenter(): Point2D | undefined;
+  setCenter(value?: Point2D): void;
+
+  getWidth(): number;
+  setWidth(value: number): void;
+
+  getHeight(): number;
+  setHeight(value: number): void;
+
+  getAngleDeg(): number;
+  setAngleDeg(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): OrientedBoundingBox.AsObject;
+  static toObject(includeInstance: boolean, msg: OrientedBoundingBox): OrientedBoundingBox.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: OrientedBoundingBox, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): OrientedBoundingBox;
+  static deserializeBinaryFromReader(message: OrientedBoundingBox, reader: jspb.BinaryReader): OrientedBoundingBox;
+}
+
+export namespace OrientedBoundingBox {
+  export type AsObject = {
+    center?: Point2D.AsObject,
+    width: number,
+    height: number,
+    angleDeg: number,
   }
 }
 
@@ -382,3 +737,217 @@ export namespace GetObjectPointCloudsResponse {
   }
 }
 
+export class CaptureAllFromCameraRequest extends jspb.Message {
+  getName(): string;
+  setName(value: string): void;
+
+  getCameraName(): string;
+  setCameraName(value: string): void;
+
+  getReturnImage(): boolean;
+  setReturnImage(value: boolean): void;
+
+  getReturnDetections(): boolean;
+  setReturnDetections(value: boolean): void;
+
+  getReturnClassifications(): boolean;
+  setReturnClassifications(value: boolean): void;
+
+  getReturnObjectPointClouds(): boolean;
+  setReturnObjectPointClouds(value: boolean): void;
+
+  getN(): number;
+  setN(value: number): void;
+
+  getPointCloudMimeType(): string;
+  setPointCloudMimeType(value: string): void;
+
+  hasExtra(): boolean;
+  clearExtra(): void;
+  getExtra(): google_protobuf_struct_pb.Struct | undefined;
+  setExtra(value?: google_protobuf_struct_pb.Struct): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CaptureAllFromCameraRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: CaptureAllFromCameraRequest): CaptureAllFromCameraRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: CaptureAllFromCameraRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CaptureAllFromCameraRequest;
+  static deserializeBinaryFromReader(message: CaptureAllFromCameraRequest, reader: jspb.BinaryReader): CaptureAllFromCameraRequest;
+}
+
+export namespace CaptureAllFromCameraRequest {
+  export type AsObject = {
+    name: string,
+    cameraName: string,
+    returnImage: boolean,
+    returnDetections: boolean,
+    returnClassifications: boolean,
+    returnObjectPointClouds: boolean,
+    n: number,
+    pointCloudMimeType: string,
+    extra?: google_protobuf_struct_pb.Struct.AsObject,
+  }
+}
+
+export class CaptureAllFromCameraResponse extends jspb.Message {
+  hasImage(): boolean;
+  clearImage(): void;
+  getImage(): component_camera_v1_camera_pb.Image | undefined;
+  setImage(value?: component_camera_v1_camera_pb.Image): void;
+
+  clearDetectionsList(): void;
+  getDetectionsList(): Array<Detection>;
+  setDetectionsList(value: Array<Detection>): void;
+  addDetections(value?: Detection, index?: number): Detection;
+
+  clearClassificationsList(): void;
+  getClassificationsList(): Array<Classification>;
+  setClassificationsList(value: Array<Classification>): void;
+  addClassifications(value?: Classification, index?: number): Classification;
+
+  getPointCloudMimeType(): string;
+  setPointCloudMimeType(value: string): void;
+
+  clearObjectsList(): void;
+  getObjectsList(): Array<common_v1_common_pb.PointCloudObject>;
+  setObjectsList(value: Array<common_v1_common_pb.PointCloudObject>): void;
+  addObjects(value?: common_v1_common_pb.PointCloudObject, index?: number): common_v1_common_pb.PointCloudObject;
+
+  hasResponseMetadata(): boolean;
+  clearResponseMetadata(): void;
+  getResponseMetadata(): common_v1_common_pb.ResponseMetadata | undefined;
+  setResponseMetadata(value?: common_v1_common_pb.ResponseMetadata): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CaptureAllFromCameraResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: CaptureAllFromCameraResponse): CaptureAllFromCameraResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: CaptureAllFromCameraResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CaptureAllFromCameraResponse;
+  static deserializeBinaryFromReader(message: CaptureAllFromCameraResponse, reader: jspb.BinaryReader): CaptureAllFromCameraResponse;
+}
+
+export namespace CaptureAllFromCameraResponse {
+  export type AsObject = {
+    image?: component_camera_v1_camera_pb.Image.AsObject,
+    detectionsList: Array<Detection.AsObject>,
+    classificationsList: Array<Classification.AsObject>,
+    pointCloudMimeType: string,
+    objectsList: Array<common_v1_common_pb.PointCloudObject.AsObject>,
+    responseMetadata?: common_v1_common_pb.ResponseMetadata.AsObject,
+  }
+}
+
+export class GetPropertiesRequest extends jspb.Message {
+  getName(): string;
+  setName(value: string): void;
+
+  hasExtra(): boolean;
+  clearExtra(): void;
+  getExtra(): google_protobuf_struct_pb.Struct | undefined;
+  setExtra(value?: google_protobuf_struct_pb.Struct): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetPropertiesRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: GetPropertiesRequest): GetPropertiesRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetPropertiesRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetPropertiesRequest;
+  static deserializeBinaryFromReader(message: GetPropertiesRequest, reader: jspb.BinaryReader): GetPropertiesRequest;
+}
+
+export namespace GetPropertiesRequest {
+  export type AsObject = {
+    name: string,
+    extra?: google_protobuf_struct_pb.Struct.AsObject,
+  }
+}
+
+export class GetPropertiesResponse extends jspb.Message {
+  getDetectionsSupported(): boolean;
+  setDetectionsSupported(value: boolean): void;
+
+  getClassificationsSupported(): boolean;
+  setClassificationsSupported(value: boolean): void;
+
+  getObjectPointCloudsSupported(): boolean;
+  setObjectPointCloudsSupported(value: boolean): void;
+
+  getMasksSupported(): boolean;
+  setMasksSupported(value: boolean): void;
+
+  getKeypointsSupported(): boolean;
+  setKeypointsSupported(value: boolean): void;
+
+  getOrientedBoxesSupported(): boolean;
+  setOrientedBoxesSupported(value: boolean): void;
+
+  clearKeypointNamesList(): void;
+  getKeypointNamesList(): Array<string>;
+  setKeypointNamesList(value: Array<string>): void;
+  addKeypointNames(value: string, index?: number): string;
+
+  clearSkeletonList(): void;
+  getSkeletonList(): Array<KeypointEdge>;
+  setSkeletonList(value: Array<KeypointEdge>): void;
+  addSkeleton(value?: KeypointEdge, index?: number): KeypointEdge;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetPropertiesResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetPropertiesResponse): GetPropertiesResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetPropertiesResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetPropertiesResponse;
+  static deserializeBinaryFromReader(message: GetPropertiesResponse, reader: jspb.BinaryReader): GetPropertiesResponse;
+}
+
+export namespace GetPropertiesResponse {
+  export type AsObject = {
+    detectionsSupported: boolean,
+    classificationsSupported: boolean,
+    objectPointCloudsSupported: boolean,
+    masksSupported: boolean,
+    keypointsSupported: boolean,
+    orientedBoxesSupported: boolean,
+    keypointNamesList: Array<string>,
+    skeletonList: Array<KeypointEdge.AsObject>,
+  }
+}
+
+export class KeypointEdge extends jspb.Message {
+  getFromIndex(): number;
+  setFromIndex(value: number): void;
+
+  getToIndex(): number;
+  setToIndex(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): KeypointEdge.AsObject;
+  static toObject(includeInstance: boolean, msg: KeypointEdge): KeypointEdge.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: KeypointEdge, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): KeypointEdge;
+  static deserializeBinaryFromReader(message: KeypointEdge, reader: jspb.BinaryReader): KeypointEdge;
+}
+
+export namespace KeypointEdge {
+  export type AsObject = {
+    fromIndex: number,
+    toIndex: number,
+  }
+}
+
+export interface TrackEventTypeMap {
+  TRACK_EVENT_TYPE_UNSPECIFIED: 0;
+  TRACK_EVENT_TYPE_ENTER: 1;
+  TRACK_EVENT_TYPE_EXIT: 2;
+}
+
+export const TrackEventType: TrackEventTypeMap;
+
@@ -17,10 +17,16 @@ var global = (function() { return this || window || global || self || Function('
 
 var common_v1_common_pb = require('../../../common/v1/common_pb.js');
 goog.object.extend(proto, common_v1_common_pb);
+var component_camera_v1_camera_pb = require('../../../component/camera/v1/camera_pb.js');
+goog.object.extend(proto, component_camera_v1_camera_pb);
 var google_api_annotations_pb = require('../../../google/api/annotations_pb.js');
 goog.object.extend(proto, google_api_annotations_pb);
+var google_protobuf_duration_pb = require('google-protobuf/google/protobuf/duration_pb.js');
+goog.object.extend(proto, google_protobuf_duration_pb);
 var google_protobuf_struct_pb = require('google-protobuf/google/protobuf/struct_pb.js');
 goog.object.extend(proto, google_protobuf_struct_pb);
+goog.exportSymbol('proto.viam.service.vision.v1.CaptureAllFromCameraRequest', null, global);
+goog.exportSymbol('proto.viam.service.vision.v1.CaptureAllFromCameraResponse', null, global);
 goog.exportSymbol('proto.viam.service.vision.v1.Classification', null, global);
 goog.exportSymbol('proto.viam.service.vision.v1.Detection', null, global);
 goog.exportSymbol('proto.viam.service.vision.v1.GetClassificationsFromCameraRequest', null, global);
@@ -33,6 +39,22 @@ goog.exportSymbol('proto.viam.service.vision.v1.GetDetectionsRequest', null, glo
 goog.exportSymbol('proto.viam.service.vision.v1.GetDetectionsResponse', null, global);
 goog.exportSymbol('proto.viam.service.vision.v1.GetObjectPointCloudsRequest', null, global);
 goog.exportSymbol('proto.viam.service.vision.v1.GetObjectPointCloudsResponse', null, global);
+goog.exportSymbol('proto.viam.service.vision.v1.GetPropertiesRequest', null, global);
+goog.exportSymbol('proto.viam.service.vision.v1.GetPropertiesResponse', null, global);
+goog.exportSymbol('proto.viam.service.vision.v1.Keypoint', null, global);
+goog.exportSymbol('proto.viam.service.vision.v1.KeypointEdge', null, global);
+goog.exportSymbol('proto.viam.service.vision.v1.Mask', null, global);
+goog.exportSymbol('proto.viam.service.vision.v1.Mask.MaskCase', null, global);
+goog.exportSymbol('proto.viam.service.vision.v1.OrientedBoundingBox', null, global);
+goog.exportSymbol('proto.viam.service.vision.v1.Point2D', null, global);
+goog.exportSymbol('proto.viam.service.vision.v1.Polygon', null, global);
+goog.exportSymbol('proto.viam.service.vision.v1.PolygonMask', null, global);
+goog.exportSymbol('proto.viam.service.vision.v1.RunLengthMask', null, global);
+goog.exportSymbol('proto.viam.service.vision.v1.Track', null, global);
+goog.exportSymbol('proto.viam.service.vision.v1.TrackEvent', null, global);
+goog.exportSymbol('proto.viam.service.vision.v1.TrackEventType', null, global);
+goog.exportSymbol('proto.viam.service.vision.v1.TrackObjectsFromCameraRequest', null, global);
+goog.exportSymbol('proto.viam.service.vision.v1.TrackObjectsFromCameraResponse', null, global);
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -127,9 +149,93 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.viam.service.vision.v1.Detection = function(opt_data) {
+proto.viam.service.vision.v1.TrackObjectsFromCameraRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.service.vision.v1.TrackObjectsFromCameraRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.service.vision.v1.TrackObjectsFromCameraRequest.displayName = 'proto.viam.service.vision.v1.TrackObjectsFromCameraRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.service.vision.v1.TrackObjectsFromCameraResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, 500, proto.viam.service.vision.v1.TrackObjectsFromCameraResponse.repeatedFields_, null);
+};
+goog.inherits(proto.viam.service.vision.v1.TrackObjectsFromCameraResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.service.vision.v1.TrackObjectsFromCameraResponse.displayName = 'proto.viam.service.vision.v1.TrackObjectsFromCameraResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.service.vision.v1.Track = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.service.vision.v1.Track, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.service.vision.v1.Track.displayName = 'proto.viam.service.vision.v1.Track';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.service.vision.v1.TrackEvent = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
+goog.inherits(proto.viam.service.vision.v1.TrackEvent, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.service.vision.v1.TrackEvent.displayName = 'proto.viam.service.vision.v1.TrackEvent';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.service.vision.v1.Detection = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.viam.service.vision.v1.Detection.repeatedFields_, null);
+};
 goog.inherits(proto.viam.service.vision.v1.Detection, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
@@ -138,6 +244,153 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.viam.service.vision.v1.Detection.displayName = 'proto.viam.service.vision.v1.Detection';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.service.vision.v1.Mask = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, proto.viam.service.vision.v1.Mask.oneofGroups_);
+};
+goog.inherits(proto.viam.service.vision.v1.Mask, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.service.vision.v1.Mask.displayName = 'proto.viam.service.vision.v1.Mask';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.service.vision.v1.RunLengthMask = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.viam.service.vision.v1.RunLengthMask.repeatedFields_, null);
+};
+goog.inherits(proto.viam.service.vision.v1.RunLengthMask, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.service.vision.v1.RunLengthMask.displayName = 'proto.viam.service.vision.v1.RunLengthMask';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.service.vision.v1.PolygonMask = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.viam.service.vision.v1.PolygonMask.repeatedFields_, null);
+};
+goog.inherits(proto.viam.service.vision.v1.PolygonMask, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.service.vision.v1.PolygonMask.displayName = 'proto.viam.service.vision.v1.PolygonMask';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.service.vision.v1.Polygon = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.viam.service.vision.v1.Polygon.repeatedFields_, null);
+};
+goog.inherits(proto.viam.service.vision.v1.Polygon, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.service.vision.v1.Polygon.displayName = 'proto.viam.service.vision.v1.Polygon';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.service.vision.v1.Point2D = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.service.vision.v1.Point2D, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.service.vision.v1.Point2D.displayName = 'proto.viam.service.vision.v1.Point2D';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.service.vision.v1.Keypoint = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.service.vision.v1.Keypoint, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.service.vision.v1.Keypoint.displayName = 'proto.viam.service.vision.v1.Keypoint';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.service.vision.v1.OrientedBoundingBox = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.service.vision.v1.OrientedBoundingBox, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.service.vision.v1.OrientedBoundingBox.displayName = 'proto.viam.service.vision.v1.OrientedBoundingBox';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -285,50 +538,155 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.viam.service.vision.v1.GetObjectPointCloudsResponse.displayName = 'proto.viam.service.vision.v1.GetObjectPointCloudsResponse';
 }
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.viam.service.vision.v1.GetDetectionsRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.service.vision.v1.GetDetectionsRequest.toObject(opt_includeInstance, this);
+proto.viam.service.vision.v1.CaptureAllFromCameraRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.viam.service.vision.v1.CaptureAllFromCameraRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.service.vision.v1.CaptureAllFromCameraRequest.displayName = 'proto.viam.service.vision.v1.CaptureAllFromCameraRequest';
+}
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.viam.service.vision.v1.GetDetectionsRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.viam.service.vision.v1.GetDetectionsRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    image: msg.getImage_asB64(),
-    width: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    height: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    mimeType: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.viam.service.vision.v1.CaptureAllFromCameraResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, 500, proto.viam.service.vision.v1.CaptureAllFromCameraResponse.repeatedFields_, null);
+};
+goog.inherits(proto.viam.service.vision.v1.CaptureAllFromCameraResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.service.vision.v1.CaptureAllFromCameraResponse.displayName = 'proto.viam.service.vision.v1.CaptureAllFromCameraResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.service.vision.v1.GetPropertiesRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.service.vision.v1.GetPropertiesRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.service.vision.v1.GetPropertiesRequest.displayName = 'proto.viam.service.vision.v1.GetPropertiesRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.service.vision.v1.GetPropertiesResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.viam.service.vision.v1.GetPropertiesResponse.repeatedFields_, null);
+};
+goog.inherits(proto.viam.service.vision.v1.GetPropertiesResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.service.vision.v1.GetPropertiesResponse.displayName = 'proto.viam.service.vision.v1.GetPropertiesResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.viam.service.vision.v1.KeypointEdge = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.viam.service.vision.v1.KeypointEdge, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.viam.service.vision.v1.KeypointEdge.displayName = 'proto.viam.service.vision.v1.KeypointEdge';
+}
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.vision.v1.GetDetectionsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.vision.v1.GetDetectionsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.vision.v1.GetDetectionsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.vision.v1.GetDetectionsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    image: msg.getImage_asB64(),
+    width: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    height: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    mimeType: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
 }
 
@@ -1157,8 +1515,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.service.vision.v1.Detection.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.service.vision.v1.Detection.toObject(opt_includeInstance, this);
+proto.viam.service.vision.v1.TrackObjectsFromCameraRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.vision.v1.TrackObjectsFromCameraRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -1167,18 +1525,16 @@ proto.viam.service.vision.v1.Detection.prototype.toObject = function(opt_include
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.service.vision.v1.Detection} msg The msg instance to transform.
+ * @param {!proto.viam.service.vision.v1.TrackObjectsFromCameraRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.vision.v1.Detection.toObject = function(includeInstance, msg) {
+proto.viam.service.vision.v1.TrackObjectsFromCameraRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    xMin: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    yMin: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    xMax: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    yMax: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    confidence: jspb.Message.getFloatingPointFieldWithDefault(msg, 5, 0.0),
-    className: jspb.Message.getFieldWithDefault(msg, 6, "")
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    cameraName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    maxFps: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -1192,23 +1548,23 @@ proto.viam.service.vision.v1.Detection.toObject = function(includeInstance, msg)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.service.vision.v1.Detection}
+ * @return {!proto.viam.service.vision.v1.TrackObjectsFromCameraRequest}
  */
-proto.viam.service.vision.v1.Detection.deserializeBinary = function(bytes) {
+proto.viam.service.vision.v1.TrackObjectsFromCameraRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.service.vision.v1.Detection;
-  return proto.viam.service.vision.v1.Detection.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.service.vision.v1.TrackObjectsFromCameraRequest;
+  return proto.viam.service.vision.v1.TrackObjectsFromCameraRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.service.vision.v1.Detection} msg The message object to deserialize into.
+ * @param {!proto.viam.service.vision.v1.TrackObjectsFromCameraRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.service.vision.v1.Detection}
+ * @return {!proto.viam.service.vision.v1.TrackObjectsFromCameraRequest}
  */
-proto.viam.service.vision.v1.Detection.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.service.vision.v1.TrackObjectsFromCameraRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1216,28 +1572,21 @@ proto.viam.service.vision.v1.Detection.deserializeBinaryFromReader = function(ms
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setXMin(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setYMin(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCameraName(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setXMax(value);
-      break;
-    case 4:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setYMax(value);
-      break;
-    case 5:
       var value = /** @type {number} */ (reader.readDouble());
-      msg.setConfidence(value);
+      msg.setMaxFps(value);
       break;
-    case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setClassName(value);
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
       break;
     default:
       reader.skipField();
@@ -1252,9 +1601,9 @@ proto.viam.service.vision.v1.Detection.deserializeBinaryFromReader = function(ms
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.service.vision.v1.Detection.prototype.serializeBinary = function() {
+proto.viam.service.vision.v1.TrackObjectsFromCameraRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.service.vision.v1.Detection.serializeBinaryToWriter(this, writer);
+  proto.viam.service.vision.v1.TrackObjectsFromCameraRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1262,151 +1611,1218 @@ proto.viam.service.vision.v1.Detection.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.service.vision.v1.Detection} message
+ * @param {!proto.viam.service.vision.v1.TrackObjectsFromCameraRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.vision.v1.Detection.serializeBinaryToWriter = function(message, writer) {
+proto.viam.service.vision.v1.TrackObjectsFromCameraRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = /** @type {number} */ (jspb.Message.getField(message, 1));
-  if (f != null) {
-    writer.writeInt64(
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
-  f = /** @type {number} */ (jspb.Message.getField(message, 2));
-  if (f != null) {
-    writer.writeInt64(
+  f = message.getCameraName();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
   }
-  f = /** @type {number} */ (jspb.Message.getField(message, 3));
-  if (f != null) {
-    writer.writeInt64(
-      3,
-      f
-    );
-  }
-  f = /** @type {number} */ (jspb.Message.getField(message, 4));
-  if (f != null) {
-    writer.writeInt64(
-      4,
-      f
-    );
-  }
-  f = message.getConfidence();
+  f = message.getMaxFps();
   if (f !== 0.0) {
     writer.writeDouble(
-      5,
+      3,
       f
     );
   }
-  f = message.getClassName();
-  if (f.length > 0) {
-    writer.writeString(
-      6,
-      f
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional int64 x_min = 1;
- * @return {number}
+ * optional string name = 1;
+ * @return {string}
  */
-proto.viam.service.vision.v1.Detection.prototype.getXMin = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.viam.service.vision.v1.TrackObjectsFromCameraRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.viam.service.vision.v1.Detection} returns this
+ * @param {string} value
+ * @return {!proto.viam.service.vision.v1.TrackObjectsFromCameraRequest} returns this
  */
-proto.viam.service.vision.v1.Detection.prototype.setXMin = function(value) {
-  return jspb.Message.setField(this, 1, value);
+proto.viam.service.vision.v1.TrackObjectsFromCameraRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.viam.service.vision.v1.Detection} returns this
+ * optional string camera_name = 2;
+ * @return {string}
  */
-proto.viam.service.vision.v1.Detection.prototype.clearXMin = function() {
-  return jspb.Message.setField(this, 1, undefined);
+proto.viam.service.vision.v1.TrackObjectsFromCameraRequest.prototype.getCameraName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.viam.service.vision.v1.TrackObjectsFromCameraRequest} returns this
  */
-proto.viam.service.vision.v1.Detection.prototype.hasXMin = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.viam.service.vision.v1.TrackObjectsFromCameraRequest.prototype.setCameraName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional int64 y_min = 2;
+ * optional double max_fps = 3;
  * @return {number}
  */
-proto.viam.service.vision.v1.Detection.prototype.getYMin = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.viam.service.vision.v1.TrackObjectsFromCameraRequest.prototype.getMaxFps = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 3, 0.0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.viam.service.vision.v1.Detection} returns this
+ * @return {!proto.viam.service.vision.v1.TrackObjectsFromCameraRequest} returns this
  */
-proto.viam.service.vision.v1.Detection.prototype.setYMin = function(value) {
-  return jspb.Message.setField(this, 2, value);
+proto.viam.service.vision.v1.TrackObjectsFromCameraRequest.prototype.setMaxFps = function(value) {
+  return jspb.Message.setProto3FloatField(this, 3, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.viam.service.vision.v1.Detection} returns this
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
  */
-proto.viam.service.vision.v1.Detection.prototype.clearYMin = function() {
-  return jspb.Message.setField(this, 2, undefined);
+proto.viam.service.vision.v1.TrackObjectsFromCameraRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.viam.service.vision.v1.Detection.prototype.hasYMin = function() {
-  return jspb.Message.getField(this, 2) != null;
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.service.vision.v1.TrackObjectsFromCameraRequest} returns this
+*/
+proto.viam.service.vision.v1.TrackObjectsFromCameraRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
 };
 
 
 /**
- * optional int64 x_max = 3;
- * @return {number}
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.vision.v1.TrackObjectsFromCameraRequest} returns this
  */
-proto.viam.service.vision.v1.Detection.prototype.getXMax = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.viam.service.vision.v1.TrackObjectsFromCameraRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.viam.service.vision.v1.Detection} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.viam.service.vision.v1.Detection.prototype.setXMax = function(value) {
-  return jspb.Message.setField(this, 3, value);
+proto.viam.service.vision.v1.TrackObjectsFromCameraRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
 };
 
 
+
 /**
- * Clears the field making it undefined.
- * @return {!proto.viam.service.vision.v1.Detection} returns this
- */
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.service.vision.v1.TrackObjectsFromCameraResponse.repeatedFields_ = [1,2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.vision.v1.TrackObjectsFromCameraResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.vision.v1.TrackObjectsFromCameraResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.vision.v1.TrackObjectsFromCameraResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.vision.v1.TrackObjectsFromCameraResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    tracksList: jspb.Message.toObjectList(msg.getTracksList(),
+    proto.viam.service.vision.v1.Track.toObject, includeInstance),
+    eventsList: jspb.Message.toObjectList(msg.getEventsList(),
+    proto.viam.service.vision.v1.TrackEvent.toObject, includeInstance),
+    responseMetadata: (f = msg.getResponseMetadata()) && common_v1_common_pb.ResponseMetadata.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.vision.v1.TrackObjectsFromCameraResponse}
+ */
+proto.viam.service.vision.v1.TrackObjectsFromCameraResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.vision.v1.TrackObjectsFromCameraResponse;
+  return proto.viam.service.vision.v1.TrackObjectsFromCameraResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.vision.v1.TrackObjectsFromCameraResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.vision.v1.TrackObjectsFromCameraResponse}
+ */
+proto.viam.service.vision.v1.TrackObjectsFromCameraResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.viam.service.vision.v1.Track;
+      reader.readMessage(value,proto.viam.service.vision.v1.Track.deserializeBinaryFromReader);
+      msg.addTracks(value);
+      break;
+    case 2:
+      var value = new proto.viam.service.vision.v1.TrackEvent;
+      reader.readMessage(value,proto.viam.service.vision.v1.TrackEvent.deserializeBinaryFromReader);
+      msg.addEvents(value);
+      break;
+    case 84260:
+      var value = new common_v1_common_pb.ResponseMetadata;
+      reader.readMessage(value,common_v1_common_pb.ResponseMetadata.deserializeBinaryFromReader);
+      msg.setResponseMetadata(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.service.vision.v1.TrackObjectsFromCameraResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.vision.v1.TrackObjectsFromCameraResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.vision.v1.TrackObjectsFromCameraResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.vision.v1.TrackObjectsFromCameraResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTracksList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.viam.service.vision.v1.Track.serializeBinaryToWriter
+    );
+  }
+  f = message.getEventsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.viam.service.vision.v1.TrackEvent.serializeBinaryToWriter
+    );
+  }
+  f = message.getResponseMetadata();
+  if (f != null) {
+    writer.writeMessage(
+      84260,
+      f,
+      common_v1_common_pb.ResponseMetadata.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated Track tracks = 1;
+ * @return {!Array<!proto.viam.service.vision.v1.Track>}
+ */
+proto.viam.service.vision.v1.TrackObjectsFromCameraResponse.prototype.getTracksList = function() {
+  return /** @type{!Array<!proto.viam.service.vision.v1.Track>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.viam.service.vision.v1.Track, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.viam.service.vision.v1.Track>} value
+ * @return {!proto.viam.service.vision.v1.TrackObjectsFromCameraResponse} returns this
+*/
+proto.viam.service.vision.v1.TrackObjectsFromCameraResponse.prototype.setTracksList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.viam.service.vision.v1.Track=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.service.vision.v1.Track}
+ */
+proto.viam.service.vision.v1.TrackObjectsFromCameraResponse.prototype.addTracks = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.viam.service.vision.v1.Track, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.service.vision.v1.TrackObjectsFromCameraResponse} returns this
+ */
+proto.viam.service.vision.v1.TrackObjectsFromCameraResponse.prototype.clearTracksList = function() {
+  return this.setTracksList([]);
+};
+
+
+/**
+ * repeated TrackEvent events = 2;
+ * @return {!Array<!proto.viam.service.vision.v1.TrackEvent>}
+ */
+proto.viam.service.vision.v1.TrackObjectsFromCameraResponse.prototype.getEventsList = function() {
+  return /** @type{!Array<!proto.viam.service.vision.v1.TrackEvent>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.viam.service.vision.v1.TrackEvent, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.viam.service.vision.v1.TrackEvent>} value
+ * @return {!proto.viam.service.vision.v1.TrackObjectsFromCameraResponse} returns this
+*/
+proto.viam.service.vision.v1.TrackObjectsFromCameraResponse.prototype.setEventsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.viam.service.vision.v1.TrackEvent=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.service.vision.v1.TrackEvent}
+ */
+proto.viam.service.vision.v1.TrackObjectsFromCameraResponse.prototype.addEvents = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.viam.service.vision.v1.TrackEvent, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.service.vision.v1.TrackObjectsFromCameraResponse} returns this
+ */
+proto.viam.service.vision.v1.TrackObjectsFromCameraResponse.prototype.clearEventsList = function() {
+  return this.setEventsList([]);
+};
+
+
+/**
+ * optional viam.common.v1.ResponseMetadata response_metadata = 84260;
+ * @return {?proto.viam.common.v1.ResponseMetadata}
+ */
+proto.viam.service.vision.v1.TrackObjectsFromCameraResponse.prototype.getResponseMetadata = function() {
+  return /** @type{?proto.viam.common.v1.ResponseMetadata} */ (
+    jspb.Message.getWrapperField(this, common_v1_common_pb.ResponseMetadata, 84260));
+};
+
+
+/**
+ * @param {?proto.viam.common.v1.ResponseMetadata|undefined} value
+ * @return {!proto.viam.service.vision.v1.TrackObjectsFromCameraResponse} returns this
+*/
+proto.viam.service.vision.v1.TrackObjectsFromCameraResponse.prototype.setResponseMetadata = function(value) {
+  return jspb.Message.setWrapperField(this, 84260, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.vision.v1.TrackObjectsFromCameraResponse} returns this
+ */
+proto.viam.service.vision.v1.TrackObjectsFromCameraResponse.prototype.clearResponseMetadata = function() {
+  return this.setResponseMetadata(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.vision.v1.TrackObjectsFromCameraResponse.prototype.hasResponseMetadata = function() {
+  return jspb.Message.getField(this, 84260) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.vision.v1.Track.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.vision.v1.Track.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.vision.v1.Track} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.vision.v1.Track.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    trackId: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    detection: (f = msg.getDetection()) && proto.viam.service.vision.v1.Detection.toObject(includeInstance, f),
+    age: (f = msg.getAge()) && google_protobuf_duration_pb.Duration.toObject(includeInstance, f),
+    ageFrames: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    velocityPxPerSec: (f = msg.getVelocityPxPerSec()) && proto.viam.service.vision.v1.Point2D.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.vision.v1.Track}
+ */
+proto.viam.service.vision.v1.Track.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.vision.v1.Track;
+  return proto.viam.service.vision.v1.Track.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.vision.v1.Track} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.vision.v1.Track}
+ */
+proto.viam.service.vision.v1.Track.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setTrackId(value);
+      break;
+    case 2:
+      var value = new proto.viam.service.vision.v1.Detection;
+      reader.readMessage(value,proto.viam.service.vision.v1.Detection.deserializeBinaryFromReader);
+      msg.setDetection(value);
+      break;
+    case 3:
+      var value = new google_protobuf_duration_pb.Duration;
+      reader.readMessage(value,google_protobuf_duration_pb.Duration.deserializeBinaryFromReader);
+      msg.setAge(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setAgeFrames(value);
+      break;
+    case 5:
+      var value = new proto.viam.service.vision.v1.Point2D;
+      reader.readMessage(value,proto.viam.service.vision.v1.Point2D.deserializeBinaryFromReader);
+      msg.setVelocityPxPerSec(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.service.vision.v1.Track.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.vision.v1.Track.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.vision.v1.Track} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.vision.v1.Track.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTrackId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getDetection();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.viam.service.vision.v1.Detection.serializeBinaryToWriter
+    );
+  }
+  f = message.getAge();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_duration_pb.Duration.serializeBinaryToWriter
+    );
+  }
+  f = message.getAgeFrames();
+  if (f !== 0) {
+    writer.writeUint32(
+      4,
+      f
+    );
+  }
+  f = message.getVelocityPxPerSec();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      proto.viam.service.vision.v1.Point2D.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional uint64 track_id = 1;
+ * @return {number}
+ */
+proto.viam.service.vision.v1.Track.prototype.getTrackId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.service.vision.v1.Track} returns this
+ */
+proto.viam.service.vision.v1.Track.prototype.setTrackId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional Detection detection = 2;
+ * @return {?proto.viam.service.vision.v1.Detection}
+ */
+proto.viam.service.vision.v1.Track.prototype.getDetection = function() {
+  return /** @type{?proto.viam.service.vision.v1.Detection} */ (
+    jspb.Message.getWrapperField(this, proto.viam.service.vision.v1.Detection, 2));
+};
+
+
+/**
+ * @param {?proto.viam.service.vision.v1.Detection|undefined} value
+ * @return {!proto.viam.service.vision.v1.Track} returns this
+*/
+proto.viam.service.vision.v1.Track.prototype.setDetection = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.vision.v1.Track} returns this
+ */
+proto.viam.service.vision.v1.Track.prototype.clearDetection = function() {
+  return this.setDetection(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.vision.v1.Track.prototype.hasDetection = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional google.protobuf.Duration age = 3;
+ * @return {?proto.google.protobuf.Duration}
+ */
+proto.viam.service.vision.v1.Track.prototype.getAge = function() {
+  return /** @type{?proto.google.protobuf.Duration} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_duration_pb.Duration, 3));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Duration|undefined} value
+ * @return {!proto.viam.service.vision.v1.Track} returns this
+*/
+proto.viam.service.vision.v1.Track.prototype.setAge = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.vision.v1.Track} returns this
+ */
+proto.viam.service.vision.v1.Track.prototype.clearAge = function() {
+  return this.setAge(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.vision.v1.Track.prototype.hasAge = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional uint32 age_frames = 4;
+ * @return {number}
+ */
+proto.viam.service.vision.v1.Track.prototype.getAgeFrames = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.service.vision.v1.Track} returns this
+ */
+proto.viam.service.vision.v1.Track.prototype.setAgeFrames = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional Point2D velocity_px_per_sec = 5;
+ * @return {?proto.viam.service.vision.v1.Point2D}
+ */
+proto.viam.service.vision.v1.Track.prototype.getVelocityPxPerSec = function() {
+  return /** @type{?proto.viam.service.vision.v1.Point2D} */ (
+    jspb.Message.getWrapperField(this, proto.viam.service.vision.v1.Point2D, 5));
+};
+
+
+/**
+ * @param {?proto.viam.service.vision.v1.Point2D|undefined} value
+ * @return {!proto.viam.service.vision.v1.Track} returns this
+*/
+proto.viam.service.vision.v1.Track.prototype.setVelocityPxPerSec = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.vision.v1.Track} returns this
+ */
+proto.viam.service.vision.v1.Track.prototype.clearVelocityPxPerSec = function() {
+  return this.setVelocityPxPerSec(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.vision.v1.Track.prototype.hasVelocityPxPerSec = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.vision.v1.TrackEvent.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.vision.v1.TrackEvent.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.vision.v1.TrackEvent} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.vision.v1.TrackEvent.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    trackId: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    type: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    className: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.vision.v1.TrackEvent}
+ */
+proto.viam.service.vision.v1.TrackEvent.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.vision.v1.TrackEvent;
+  return proto.viam.service.vision.v1.TrackEvent.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.vision.v1.TrackEvent} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.vision.v1.TrackEvent}
+ */
+proto.viam.service.vision.v1.TrackEvent.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setTrackId(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.viam.service.vision.v1.TrackEventType} */ (reader.readEnum());
+      msg.setType(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setClassName(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.service.vision.v1.TrackEvent.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.vision.v1.TrackEvent.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.vision.v1.TrackEvent} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.vision.v1.TrackEvent.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTrackId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getType();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+  f = message.getClassName();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 track_id = 1;
+ * @return {number}
+ */
+proto.viam.service.vision.v1.TrackEvent.prototype.getTrackId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.service.vision.v1.TrackEvent} returns this
+ */
+proto.viam.service.vision.v1.TrackEvent.prototype.setTrackId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional TrackEventType type = 2;
+ * @return {!proto.viam.service.vision.v1.TrackEventType}
+ */
+proto.viam.service.vision.v1.TrackEvent.prototype.getType = function() {
+  return /** @type {!proto.viam.service.vision.v1.TrackEventType} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {!proto.viam.service.vision.v1.TrackEventType} value
+ * @return {!proto.viam.service.vision.v1.TrackEvent} returns this
+ */
+proto.viam.service.vision.v1.TrackEvent.prototype.setType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
+};
+
+
+/**
+ * optional string class_name = 3;
+ * @return {string}
+ */
+proto.viam.service.vision.v1.TrackEvent.prototype.getClassName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.service.vision.v1.TrackEvent} returns this
+ */
+proto.viam.service.vision.v1.TrackEvent.prototype.setClassName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.service.vision.v1.Detection.repeatedFields_ = [8];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.vision.v1.Detection.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.vision.v1.Detection.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.vision.v1.Detection} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.vision.v1.Detection.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    xMin: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    yMin: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    xMax: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    yMax: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    confidence: jspb.Message.getFloatingPointFieldWithDefault(msg, 5, 0.0),
+    className: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    mask: (f = msg.getMask()) && proto.viam.service.vision.v1.Mask.toObject(includeInstance, f),
+    keypointsList: jspb.Message.toObjectList(msg.getKeypointsList(),
+    proto.viam.service.vision.v1.Keypoint.toObject, includeInstance),
+    orientedBox: (f = msg.getOrientedBox()) && proto.viam.service.vision.v1.OrientedBoundingBox.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.vision.v1.Detection}
+ */
+proto.viam.service.vision.v1.Detection.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.vision.v1.Detection;
+  return proto.viam.service.vision.v1.Detection.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.vision.v1.Detection} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.vision.v1.Detection}
+ */
+proto.viam.service.vision.v1.Detection.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setXMin(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setYMin(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setXMax(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setYMax(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setConfidence(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setClassName(value);
+      break;
+    case 7:
+      var value = new proto.viam.service.vision.v1.Mask;
+      reader.readMessage(value,proto.viam.service.vision.v1.Mask.deserializeBinaryFromReader);
+      msg.setMask(value);
+      break;
+    case 8:
+      var value = new proto.viam.service.vision.v1.Keypoint;
+      reader.readMessage(value,proto.viam.service.vision.v1.Keypoint.deserializeBinaryFromReader);
+      msg.addKeypoints(value);
+      break;
+    case 9:
+      var value = new proto.viam.service.vision.v1.OrientedBoundingBox;
+      reader.readMessage(value,proto.viam.service.vision.v1.OrientedBoundingBox.deserializeBinaryFromReader);
+      msg.setOrientedBox(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.service.vision.v1.Detection.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.vision.v1.Detection.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.vision.v1.Detection} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.vision.v1.Detection.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = /** @type {number} */ (jspb.Message.getField(message, 1));
+  if (f != null) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeInt64(
+      3,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 4));
+  if (f != null) {
+    writer.writeInt64(
+      4,
+      f
+    );
+  }
+  f = message.getConfidence();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      5,
+      f
+    );
+  }
+  f = message.getClassName();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getMask();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      proto.viam.service.vision.v1.Mask.serializeBinaryToWriter
+    );
+  }
+  f = message.getKeypointsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      8,
+      f,
+      proto.viam.service.vision.v1.Keypoint.serializeBinaryToWriter
+    );
+  }
+  f = message.getOrientedBox();
+  if (f != null) {
+    writer.writeMessage(
+      9,
+      f,
+      proto.viam.service.vision.v1.OrientedBoundingBox.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional int64 x_min = 1;
+ * @return {number}
+ */
+proto.viam.service.vision.v1.Detection.prototype.getXMin = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.service.vision.v1.Detection} returns this
+ */
+proto.viam.service.vision.v1.Detection.prototype.setXMin = function(value) {
+  return jspb.Message.setField(this, 1, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.viam.service.vision.v1.Detection} returns this
+ */
+proto.viam.service.vision.v1.Detection.prototype.clearXMin = function() {
+  return jspb.Message.setField(this, 1, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.vision.v1.Detection.prototype.hasXMin = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional int64 y_min = 2;
+ * @return {number}
+ */
+proto.viam.service.vision.v1.Detection.prototype.getYMin = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.service.vision.v1.Detection} returns this
+ */
+proto.viam.service.vision.v1.Detection.prototype.setYMin = function(value) {
+  return jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.viam.service.vision.v1.Detection} returns this
+ */
+proto.viam.service.vision.v1.Detection.prototype.clearYMin = function() {
+  return jspb.Message.setField(this, 2, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.vision.v1.Detection.prototype.hasYMin = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional int64 x_max = 3;
+ * @return {number}
+ */
+proto.viam.service.vision.v1.Detection.prototype.getXMax = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.service.vision.v1.Detection} returns this
+ */
+proto.viam.service.vision.v1.Detection.prototype.setXMax = function(value) {
+  return jspb.Message.setField(this, 3, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.viam.service.vision.v1.Detection} returns this
+ */
 proto.viam.service.vision.v1.Detection.prototype.clearXMax = function() {
   return jspb.Message.setField(this, 3, undefined);
 };
@@ -1416,80 +2832,3077 @@ proto.viam.service.vision.v1.Detection.prototype.clearXMax = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.viam.service.vision.v1.Detection.prototype.hasXMax = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.viam.service.vision.v1.Detection.prototype.hasXMax = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional int64 y_max = 4;
+ * @return {number}
+ */
+proto.viam.service.vision.v1.Detection.prototype.getYMax = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.service.vision.v1.Detection} returns this
+ */
+proto.viam.service.vision.v1.Detection.prototype.setYMax = function(value) {
+  return jspb.Message.setField(this, 4, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.viam.service.vision.v1.Detection} returns this
+ */
+proto.viam.service.vision.v1.Detection.prototype.clearYMax = function() {
+  return jspb.Message.setField(this, 4, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.vision.v1.Detection.prototype.hasYMax = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional double confidence = 5;
+ * @return {number}
+ */
+proto.viam.service.vision.v1.Detection.prototype.getConfidence = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 5, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.service.vision.v1.Detection} returns this
+ */
+proto.viam.service.vision.v1.Detection.prototype.setConfidence = function(value) {
+  return jspb.Message.setProto3FloatField(this, 5, value);
+};
+
+
+/**
+ * optional string class_name = 6;
+ * @return {string}
+ */
+proto.viam.service.vision.v1.Detection.prototype.getClassName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.service.vision.v1.Detection} returns this
+ */
+proto.viam.service.vision.v1.Detection.prototype.setClassName = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional Mask mask = 7;
+ * @return {?proto.viam.service.vision.v1.Mask}
+ */
+proto.viam.service.vision.v1.Detection.prototype.getMask = function() {
+  return /** @type{?proto.viam.service.vision.v1.Mask} */ (
+    jspb.Message.getWrapperField(this, proto.viam.service.vision.v1.Mask, 7));
+};
+
+
+/**
+ * @param {?proto.viam.service.vision.v1.Mask|undefined} value
+ * @return {!proto.viam.service.vision.v1.Detection} returns this
+*/
+proto.viam.service.vision.v1.Detection.prototype.setMask = function(value) {
+  return jspb.Message.setWrapperField(this, 7, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.vision.v1.Detection} returns this
+ */
+proto.viam.service.vision.v1.Detection.prototype.clearMask = function() {
+  return this.setMask(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.vision.v1.Detection.prototype.hasMask = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+/**
+ * repeated Keypoint keypoints = 8;
+ * @return {!Array<!proto.viam.service.vision.v1.Keypoint>}
+ */
+proto.viam.service.vision.v1.Detection.prototype.getKeypointsList = function() {
+  return /** @type{!Array<!proto.viam.service.vision.v1.Keypoint>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.viam.service.vision.v1.Keypoint, 8));
+};
+
+
+/**
+ * @param {!Array<!proto.viam.service.vision.v1.Keypoint>} value
+ * @return {!proto.viam.service.vision.v1.Detection} returns this
+*/
+proto.viam.service.vision.v1.Detection.prototype.setKeypointsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 8, value);
+};
+
+
+/**
+ * @param {!proto.viam.service.vision.v1.Keypoint=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.service.vision.v1.Keypoint}
+ */
+proto.viam.service.vision.v1.Detection.prototype.addKeypoints = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 8, opt_value, proto.viam.service.vision.v1.Keypoint, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.service.vision.v1.Detection} returns this
+ */
+proto.viam.service.vision.v1.Detection.prototype.clearKeypointsList = function() {
+  return this.setKeypointsList([]);
+};
+
+
+/**
+ * optional OrientedBoundingBox oriented_box = 9;
+ * @return {?proto.viam.service.vision.v1.OrientedBoundingBox}
+ */
+proto.viam.service.vision.v1.Detection.prototype.getOrientedBox = function() {
+  return /** @type{?proto.viam.service.vision.v1.OrientedBoundingBox} */ (
+    jspb.Message.getWrapperField(this, proto.viam.service.vision.v1.OrientedBoundingBox, 9));
+};
+
+
+/**
+ * @param {?proto.viam.service.vision.v1.OrientedBoundingBox|undefined} value
+ * @return {!proto.viam.service.vision.v1.Detection} returns this
+*/
+proto.viam.service.vision.v1.Detection.prototype.setOrientedBox = function(value) {
+  return jspb.Message.setWrapperField(this, 9, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.vision.v1.Detection} returns this
+ */
+proto.viam.service.vision.v1.Detection.prototype.clearOrientedBox = function() {
+  return this.setOrientedBox(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.vision.v1.Detection.prototype.hasOrientedBox = function() {
+  return jspb.Message.getField(this, 9) != null;
+};
+
+
+
+/**
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
+ * @const
+ */
+proto.viam.service.vision.v1.Mask.oneofGroups_ = [[1,2]];
+
+/**
+ * @enum {number}
+ */
+proto.viam.service.vision.v1.Mask.MaskCase = {
+  MASK_NOT_SET: 0,
+  RLE: 1,
+  POLYGON: 2
+};
+
+/**
+ * @return {proto.viam.service.vision.v1.Mask.MaskCase}
+ */
+proto.viam.service.vision.v1.Mask.prototype.getMaskCase = function() {
+  return /** @type {proto.viam.service.vision.v1.Mask.MaskCase} */(jspb.Message.computeOneofCase(this, proto.viam.service.vision.v1.Mask.oneofGroups_[0]));
+};
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.vision.v1.Mask.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.vision.v1.Mask.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.vision.v1.Mask} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.vision.v1.Mask.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    rle: (f = msg.getRle()) && proto.viam.service.vision.v1.RunLengthMask.toObject(includeInstance, f),
+    polygon: (f = msg.getPolygon()) && proto.viam.service.vision.v1.PolygonMask.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.vision.v1.Mask}
+ */
+proto.viam.service.vision.v1.Mask.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.vision.v1.Mask;
+  return proto.viam.service.vision.v1.Mask.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.vision.v1.Mask} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.vision.v1.Mask}
+ */
+proto.viam.service.vision.v1.Mask.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.viam.service.vision.v1.RunLengthMask;
+      reader.readMessage(value,proto.viam.service.vision.v1.RunLengthMask.deserializeBinaryFromReader);
+      msg.setRle(value);
+      break;
+    case 2:
+      var value = new proto.viam.service.vision.v1.PolygonMask;
+      reader.readMessage(value,proto.viam.service.vision.v1.PolygonMask.deserializeBinaryFromReader);
+      msg.setPolygon(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.service.vision.v1.Mask.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.vision.v1.Mask.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.vision.v1.Mask} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.vision.v1.Mask.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRle();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.viam.service.vision.v1.RunLengthMask.serializeBinaryToWriter
+    );
+  }
+  f = message.getPolygon();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.viam.service.vision.v1.PolygonMask.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional RunLengthMask rle = 1;
+ * @return {?proto.viam.service.vision.v1.RunLengthMask}
+ */
+proto.viam.service.vision.v1.Mask.prototype.getRle = function() {
+  return /** @type{?proto.viam.service.vision.v1.RunLengthMask} */ (
+    jspb.Message.getWrapperField(this, proto.viam.service.vision.v1.RunLengthMask, 1));
+};
+
+
+/**
+ * @param {?proto.viam.service.vision.v1.RunLengthMask|undefined} value
+ * @return {!proto.viam.service.vision.v1.Mask} returns this
+*/
+proto.viam.service.vision.v1.Mask.prototype.setRle = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 1, proto.viam.service.vision.v1.Mask.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.vision.v1.Mask} returns this
+ */
+proto.viam.service.vision.v1.Mask.prototype.clearRle = function() {
+  return this.setRle(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.vision.v1.Mask.prototype.hasRle = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional PolygonMask polygon = 2;
+ * @return {?proto.viam.service.vision.v1.PolygonMask}
+ */
+proto.viam.service.vision.v1.Mask.prototype.getPolygon = function() {
+  return /** @type{?proto.viam.service.vision.v1.PolygonMask} */ (
+    jspb.Message.getWrapperField(this, proto.viam.service.vision.v1.PolygonMask, 2));
+};
+
+
+/**
+ * @param {?proto.viam.service.vision.v1.PolygonMask|undefined} value
+ * @return {!proto.viam.service.vision.v1.Mask} returns this
+*/
+proto.viam.service.vision.v1.Mask.prototype.setPolygon = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 2, proto.viam.service.vision.v1.Mask.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.vision.v1.Mask} returns this
+ */
+proto.viam.service.vision.v1.Mask.prototype.clearPolygon = function() {
+  return this.setPolygon(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.vision.v1.Mask.prototype.hasPolygon = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.service.vision.v1.RunLengthMask.repeatedFields_ = [3];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.vision.v1.RunLengthMask.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.vision.v1.RunLengthMask.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.vision.v1.RunLengthMask} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.vision.v1.RunLengthMask.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    width: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    height: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    countsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.vision.v1.RunLengthMask}
+ */
+proto.viam.service.vision.v1.RunLengthMask.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.vision.v1.RunLengthMask;
+  return proto.viam.service.vision.v1.RunLengthMask.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.vision.v1.RunLengthMask} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.vision.v1.RunLengthMask}
+ */
+proto.viam.service.vision.v1.RunLengthMask.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setWidth(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setHeight(value);
+      break;
+    case 3:
+      var values = /** @type {!Array<number>} */ (reader.isDelimited() ? reader.readPackedUint32() : [reader.readUint32()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addCounts(values[i]);
+      }
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.service.vision.v1.RunLengthMask.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.vision.v1.RunLengthMask.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.vision.v1.RunLengthMask} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.vision.v1.RunLengthMask.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getWidth();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = message.getHeight();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+  f = message.getCountsList();
+  if (f.length > 0) {
+    writer.writePackedUint32(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int64 width = 1;
+ * @return {number}
+ */
+proto.viam.service.vision.v1.RunLengthMask.prototype.getWidth = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.service.vision.v1.RunLengthMask} returns this
+ */
+proto.viam.service.vision.v1.RunLengthMask.prototype.setWidth = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional int64 height = 2;
+ * @return {number}
+ */
+proto.viam.service.vision.v1.RunLengthMask.prototype.getHeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.service.vision.v1.RunLengthMask} returns this
+ */
+proto.viam.service.vision.v1.RunLengthMask.prototype.setHeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * repeated uint32 counts = 3;
+ * @return {!Array<number>}
+ */
+proto.viam.service.vision.v1.RunLengthMask.prototype.getCountsList = function() {
+  return /** @type {!Array<number>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/**
+ * @param {!Array<number>} value
+ * @return {!proto.viam.service.vision.v1.RunLengthMask} returns this
+ */
+proto.viam.service.vision.v1.RunLengthMask.prototype.setCountsList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {number} value
+ * @param {number=} opt_index
+ * @return {!proto.viam.service.vision.v1.RunLengthMask} returns this
+ */
+proto.viam.service.vision.v1.RunLengthMask.prototype.addCounts = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.service.vision.v1.RunLengthMask} returns this
+ */
+proto.viam.service.vision.v1.RunLengthMask.prototype.clearCountsList = function() {
+  return this.setCountsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.service.vision.v1.PolygonMask.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.vision.v1.PolygonMask.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.vision.v1.PolygonMask.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.vision.v1.PolygonMask} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.vision.v1.PolygonMask.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    polygonsList: jspb.Message.toObjectList(msg.getPolygonsList(),
+    proto.viam.service.vision.v1.Polygon.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.vision.v1.PolygonMask}
+ */
+proto.viam.service.vision.v1.PolygonMask.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.vision.v1.PolygonMask;
+  return proto.viam.service.vision.v1.PolygonMask.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.vision.v1.PolygonMask} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.vision.v1.PolygonMask}
+ */
+proto.viam.service.vision.v1.PolygonMask.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.viam.service.vision.v1.Polygon;
+      reader.readMessage(value,proto.viam.service.vision.v1.Polygon.deserializeBinaryFromReader);
+      msg.addPolygons(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.service.vision.v1.PolygonMask.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.vision.v1.PolygonMask.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.vision.v1.PolygonMask} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.vision.v1.PolygonMask.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPolygonsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.viam.service.vision.v1.Polygon.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated Polygon polygons = 1;
+ * @return {!Array<!proto.viam.service.vision.v1.Polygon>}
+ */
+proto.viam.service.vision.v1.PolygonMask.prototype.getPolygonsList = function() {
+  return /** @type{!Array<!proto.viam.service.vision.v1.Polygon>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.viam.service.vision.v1.Polygon, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.viam.service.vision.v1.Polygon>} value
+ * @return {!proto.viam.service.vision.v1.PolygonMask} returns this
+*/
+proto.viam.service.vision.v1.PolygonMask.prototype.setPolygonsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.viam.service.vision.v1.Polygon=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.service.vision.v1.Polygon}
+ */
+proto.viam.service.vision.v1.PolygonMask.prototype.addPolygons = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.viam.service.vision.v1.Polygon, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.service.vision.v1.PolygonMask} returns this
+ */
+proto.viam.service.vision.v1.PolygonMask.prototype.clearPolygonsList = function() {
+  return this.setPolygonsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.service.vision.v1.Polygon.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.vision.v1.Polygon.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.vision.v1.Polygon.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.vision.v1.Polygon} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.vision.v1.Polygon.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    pointsList: jspb.Message.toObjectList(msg.getPointsList(),
+    proto.viam.service.vision.v1.Point2D.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.vision.v1.Polygon}
+ */
+proto.viam.service.vision.v1.Polygon.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.vision.v1.Polygon;
+  return proto.viam.service.vision.v1.Polygon.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.vision.v1.Polygon} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.vision.v1.Polygon}
+ */
+proto.viam.service.vision.v1.Polygon.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.viam.service.vision.v1.Point2D;
+      reader.readMessage(value,proto.viam.service.vision.v1.Point2D.deserializeBinaryFromReader);
+      msg.addPoints(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.service.vision.v1.Polygon.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.vision.v1.Polygon.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.vision.v1.Polygon} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.vision.v1.Polygon.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPointsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.viam.service.vision.v1.Point2D.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated Point2D points = 1;
+ * @return {!Array<!proto.viam.service.vision.v1.Point2D>}
+ */
+proto.viam.service.vision.v1.Polygon.prototype.getPointsList = function() {
+  return /** @type{!Array<!proto.viam.service.vision.v1.Point2D>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.viam.service.vision.v1.Point2D, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.viam.service.vision.v1.Point2D>} value
+ * @return {!proto.viam.service.vision.v1.Polygon} returns this
+*/
+proto.viam.service.vision.v1.Polygon.prototype.setPointsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.viam.service.vision.v1.Point2D=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.service.vision.v1.Point2D}
+ */
+proto.viam.service.vision.v1.Polygon.prototype.addPoints = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.viam.service.vision.v1.Point2D, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.service.vision.v1.Polygon} returns this
+ */
+proto.viam.service.vision.v1.Polygon.prototype.clearPointsList = function() {
+  return this.setPointsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.vision.v1.Point2D.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.vision.v1.Point2D.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.vision.v1.Point2D} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.vision.v1.Point2D.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    x: jspb.Message.getFloatingPointFieldWithDefault(msg, 1, 0.0),
+    y: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.vision.v1.Point2D}
+ */
+proto.viam.service.vision.v1.Point2D.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.vision.v1.Point2D;
+  return proto.viam.service.vision.v1.Point2D.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.vision.v1.Point2D} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.vision.v1.Point2D}
+ */
+proto.viam.service.vision.v1.Point2D.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setX(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setY(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.service.vision.v1.Point2D.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.vision.v1.Point2D.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.vision.v1.Point2D} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.vision.v1.Point2D.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getX();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      1,
+      f
+    );
+  }
+  f = message.getY();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional double x = 1;
+ * @return {number}
+ */
+proto.viam.service.vision.v1.Point2D.prototype.getX = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 1, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.service.vision.v1.Point2D} returns this
+ */
+proto.viam.service.vision.v1.Point2D.prototype.setX = function(value) {
+  return jspb.Message.setProto3FloatField(this, 1, value);
+};
+
+
+/**
+ * optional double y = 2;
+ * @return {number}
+ */
+proto.viam.service.vision.v1.Point2D.prototype.getY = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.service.vision.v1.Point2D} returns this
+ */
+proto.viam.service.vision.v1.Point2D.prototype.setY = function(value) {
+  return jspb.Message.setProto3FloatField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.vision.v1.Keypoint.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.vision.v1.Keypoint.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.vision.v1.Keypoint} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.vision.v1.Keypoint.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    point: (f = msg.getPoint()) && proto.viam.service.vision.v1.Point2D.toObject(includeInstance, f),
+    confidence: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0),
+    visible: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.vision.v1.Keypoint}
+ */
+proto.viam.service.vision.v1.Keypoint.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.vision.v1.Keypoint;
+  return proto.viam.service.vision.v1.Keypoint.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.vision.v1.Keypoint} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.vision.v1.Keypoint}
+ */
+proto.viam.service.vision.v1.Keypoint.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.viam.service.vision.v1.Point2D;
+      reader.readMessage(value,proto.viam.service.vision.v1.Point2D.deserializeBinaryFromReader);
+      msg.setPoint(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setConfidence(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setVisible(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.service.vision.v1.Keypoint.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.vision.v1.Keypoint.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.vision.v1.Keypoint} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.vision.v1.Keypoint.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPoint();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.viam.service.vision.v1.Point2D.serializeBinaryToWriter
+    );
+  }
+  f = message.getConfidence();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      2,
+      f
+    );
+  }
+  f = message.getVisible();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional Point2D point = 1;
+ * @return {?proto.viam.service.vision.v1.Point2D}
+ */
+proto.viam.service.vision.v1.Keypoint.prototype.getPoint = function() {
+  return /** @type{?proto.viam.service.vision.v1.Point2D} */ (
+    jspb.Message.getWrapperField(this, proto.viam.service.vision.v1.Point2D, 1));
+};
+
+
+/**
+ * @param {?proto.viam.service.vision.v1.Point2D|undefined} value
+ * @return {!proto.viam.service.vision.v1.Keypoint} returns this
+*/
+proto.viam.service.vision.v1.Keypoint.prototype.setPoint = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.vision.v1.Keypoint} returns this
+ */
+proto.viam.service.vision.v1.Keypoint.prototype.clearPoint = function() {
+  return this.setPoint(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.vision.v1.Keypoint.prototype.hasPoint = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional double confidence = 2;
+ * @return {number}
+ */
+proto.viam.service.vision.v1.Keypoint.prototype.getConfidence = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.service.vision.v1.Keypoint} returns this
+ */
+proto.viam.service.vision.v1.Keypoint.prototype.setConfidence = function(value) {
+  return jspb.Message.setProto3FloatField(this, 2, value);
+};
+
+
+/**
+ * optional bool visible = 3;
+ * @return {boolean}
+ */
+proto.viam.service.vision.v1.Keypoint.prototype.getVisible = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.viam.service.vision.v1.Keypoint} returns this
+ */
+proto.viam.service.vision.v1.Keypoint.prototype.setVisible = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.vision.v1.OrientedBoundingBox.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.vision.v1.OrientedBoundingBox.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.vision.v1.OrientedBoundingBox} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.vision.v1.OrientedBoundingBox.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    center: (f = msg.getCenter()) && proto.viam.service.vision.v1.Point2D.toObject(includeInstance, f),
+    width: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0),
+    height: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0),
+    angleDeg: jspb.Message.getFloatingPointFieldWithDefault(msg, 4, 0.0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.vision.v1.OrientedBoundingBox}
+ */
+proto.viam.service.vision.v1.OrientedBoundingBox.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.vision.v1.OrientedBoundingBox;
+  return proto.viam.service.vision.v1.OrientedBoundingBox.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.vision.v1.OrientedBoundingBox} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.vision.v1.OrientedBoundingBox}
+ */
+proto.viam.service.vision.v1.OrientedBoundingBox.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.viam.service.vision.v1.Point2D;
+      reader.readMessage(value,proto.viam.service.vision.v1.Point2D.deserializeBinaryFromReader);
+      msg.setCenter(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setWidth(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setHeight(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setAngleDeg(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.service.vision.v1.OrientedBoundingBox.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.vision.v1.OrientedBoundingBox.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.vision.v1.OrientedBoundingBox} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.vision.v1.OrientedBoundingBox.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCenter();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.viam.service.vision.v1.Point2D.serializeBinaryToWriter
+    );
+  }
+  f = message.getWidth();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      2,
+      f
+    );
+  }
+  f = message.getHeight();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      3,
+      f
+    );
+  }
+  f = message.getAngleDeg();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional Point2D center = 1;
+ * @return {?proto.viam.service.vision.v1.Point2D}
+ */
+proto.viam.service.vision.v1.OrientedBoundingBox.prototype.getCenter = function() {
+  return /** @type{?proto.viam.service.vision.v1.Point2D} */ (
+    jspb.Message.getWrapperField(this, proto.viam.service.vision.v1.Point2D, 1));
+};
+
+
+/**
+ * @param {?proto.viam.service.vision.v1.Point2D|undefined} value
+ * @return {!proto.viam.service.vision.v1.OrientedBoundingBox} returns this
+*/
+proto.viam.service.vision.v1.OrientedBoundingBox.prototype.setCenter = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.vision.v1.OrientedBoundingBox} returns this
+ */
+proto.viam.service.vision.v1.OrientedBoundingBox.prototype.clearCenter = function() {
+  return this.setCenter(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.vision.v1.OrientedBoundingBox.prototype.hasCenter = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional double width = 2;
+ * @return {number}
+ */
+proto.viam.service.vision.v1.OrientedBoundingBox.prototype.getWidth = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.service.vision.v1.OrientedBoundingBox} returns this
+ */
+proto.viam.service.vision.v1.OrientedBoundingBox.prototype.setWidth = function(value) {
+  return jspb.Message.setProto3FloatField(this, 2, value);
+};
+
+
+/**
+ * optional double height = 3;
+ * @return {number}
+ */
+proto.viam.service.vision.v1.OrientedBoundingBox.prototype.getHeight = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 3, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.service.vision.v1.OrientedBoundingBox} returns this
+ */
+proto.viam.service.vision.v1.OrientedBoundingBox.prototype.setHeight = function(value) {
+  return jspb.Message.setProto3FloatField(this, 3, value);
+};
+
+
+/**
+ * optional double angle_deg = 4;
+ * @return {number}
+ */
+proto.viam.service.vision.v1.OrientedBoundingBox.prototype.getAngleDeg = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 4, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.service.vision.v1.OrientedBoundingBox} returns this
+ */
+proto.viam.service.vision.v1.OrientedBoundingBox.prototype.setAngleDeg = function(value) {
+  return jspb.Message.setProto3FloatField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.vision.v1.GetClassificationsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.vision.v1.GetClassificationsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.vision.v1.GetClassificationsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.vision.v1.GetClassificationsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    image: msg.getImage_asB64(),
+    width: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    height: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    mimeType: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    n: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.vision.v1.GetClassificationsRequest}
+ */
+proto.viam.service.vision.v1.GetClassificationsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.vision.v1.GetClassificationsRequest;
+  return proto.viam.service.vision.v1.GetClassificationsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.vision.v1.GetClassificationsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.vision.v1.GetClassificationsRequest}
+ */
+proto.viam.service.vision.v1.GetClassificationsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setImage(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setWidth(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setHeight(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMimeType(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setN(value);
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.service.vision.v1.GetClassificationsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.vision.v1.GetClassificationsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.vision.v1.GetClassificationsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.vision.v1.GetClassificationsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getImage_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      2,
+      f
+    );
+  }
+  f = message.getWidth();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getHeight();
+  if (f !== 0) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
+  f = message.getMimeType();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getN();
+  if (f !== 0) {
+    writer.writeInt32(
+      6,
+      f
+    );
+  }
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.viam.service.vision.v1.GetClassificationsRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.service.vision.v1.GetClassificationsRequest} returns this
+ */
+proto.viam.service.vision.v1.GetClassificationsRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional bytes image = 2;
+ * @return {string}
+ */
+proto.viam.service.vision.v1.GetClassificationsRequest.prototype.getImage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * optional bytes image = 2;
+ * This is a type-conversion wrapper around `getImage()`
+ * @return {string}
+ */
+proto.viam.service.vision.v1.GetClassificationsRequest.prototype.getImage_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getImage()));
+};
+
+
+/**
+ * optional bytes image = 2;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getImage()`
+ * @return {!Uint8Array}
+ */
+proto.viam.service.vision.v1.GetClassificationsRequest.prototype.getImage_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getImage()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.viam.service.vision.v1.GetClassificationsRequest} returns this
+ */
+proto.viam.service.vision.v1.GetClassificationsRequest.prototype.setImage = function(value) {
+  return jspb.Message.setProto3BytesField(this, 2, value);
+};
+
+
+/**
+ * optional int32 width = 3;
+ * @return {number}
+ */
+proto.viam.service.vision.v1.GetClassificationsRequest.prototype.getWidth = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.service.vision.v1.GetClassificationsRequest} returns this
+ */
+proto.viam.service.vision.v1.GetClassificationsRequest.prototype.setWidth = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional int32 height = 4;
+ * @return {number}
+ */
+proto.viam.service.vision.v1.GetClassificationsRequest.prototype.getHeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.service.vision.v1.GetClassificationsRequest} returns this
+ */
+proto.viam.service.vision.v1.GetClassificationsRequest.prototype.setHeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional string mime_type = 5;
+ * @return {string}
+ */
+proto.viam.service.vision.v1.GetClassificationsRequest.prototype.getMimeType = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.service.vision.v1.GetClassificationsRequest} returns this
+ */
+proto.viam.service.vision.v1.GetClassificationsRequest.prototype.setMimeType = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional int32 n = 6;
+ * @return {number}
+ */
+proto.viam.service.vision.v1.GetClassificationsRequest.prototype.getN = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.service.vision.v1.GetClassificationsRequest} returns this
+ */
+proto.viam.service.vision.v1.GetClassificationsRequest.prototype.setN = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.service.vision.v1.GetClassificationsRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.service.vision.v1.GetClassificationsRequest} returns this
+*/
+proto.viam.service.vision.v1.GetClassificationsRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.vision.v1.GetClassificationsRequest} returns this
+ */
+proto.viam.service.vision.v1.GetClassificationsRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.vision.v1.GetClassificationsRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.service.vision.v1.GetClassificationsResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.vision.v1.GetClassificationsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.vision.v1.GetClassificationsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.vision.v1.GetClassificationsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.vision.v1.GetClassificationsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    classificationsList: jspb.Message.toObjectList(msg.getClassificationsList(),
+    proto.viam.service.vision.v1.Classification.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.vision.v1.GetClassificationsResponse}
+ */
+proto.viam.service.vision.v1.GetClassificationsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.vision.v1.GetClassificationsResponse;
+  return proto.viam.service.vision.v1.GetClassificationsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.vision.v1.GetClassificationsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.vision.v1.GetClassificationsResponse}
+ */
+proto.viam.service.vision.v1.GetClassificationsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.viam.service.vision.v1.Classification;
+      reader.readMessage(value,proto.viam.service.vision.v1.Classification.deserializeBinaryFromReader);
+      msg.addClassifications(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.service.vision.v1.GetClassificationsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.vision.v1.GetClassificationsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.vision.v1.GetClassificationsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.vision.v1.GetClassificationsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getClassificationsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.viam.service.vision.v1.Classification.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated Classification classifications = 1;
+ * @return {!Array<!proto.viam.service.vision.v1.Classification>}
+ */
+proto.viam.service.vision.v1.GetClassificationsResponse.prototype.getClassificationsList = function() {
+  return /** @type{!Array<!proto.viam.service.vision.v1.Classification>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.viam.service.vision.v1.Classification, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.viam.service.vision.v1.Classification>} value
+ * @return {!proto.viam.service.vision.v1.GetClassificationsResponse} returns this
+*/
+proto.viam.service.vision.v1.GetClassificationsResponse.prototype.setClassificationsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.viam.service.vision.v1.Classification=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.service.vision.v1.Classification}
+ */
+proto.viam.service.vision.v1.GetClassificationsResponse.prototype.addClassifications = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.viam.service.vision.v1.Classification, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.service.vision.v1.GetClassificationsResponse} returns this
+ */
+proto.viam.service.vision.v1.GetClassificationsResponse.prototype.clearClassificationsList = function() {
+  return this.setClassificationsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.vision.v1.GetClassificationsFromCameraRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    cameraName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    n: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.vision.v1.GetClassificationsFromCameraRequest}
+ */
+proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.vision.v1.GetClassificationsFromCameraRequest;
+  return proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.vision.v1.GetClassificationsFromCameraRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.vision.v1.GetClassificationsFromCameraRequest}
+ */
+proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCameraName(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setN(value);
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.vision.v1.GetClassificationsFromCameraRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getCameraName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getN();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.service.vision.v1.GetClassificationsFromCameraRequest} returns this
+ */
+proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string camera_name = 2;
+ * @return {string}
+ */
+proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.prototype.getCameraName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.service.vision.v1.GetClassificationsFromCameraRequest} returns this
+ */
+proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.prototype.setCameraName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional int32 n = 3;
+ * @return {number}
+ */
+proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.prototype.getN = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.service.vision.v1.GetClassificationsFromCameraRequest} returns this
+ */
+proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.prototype.setN = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.service.vision.v1.GetClassificationsFromCameraRequest} returns this
+*/
+proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.vision.v1.GetClassificationsFromCameraRequest} returns this
+ */
+proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.service.vision.v1.GetClassificationsFromCameraResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.vision.v1.GetClassificationsFromCameraResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.vision.v1.GetClassificationsFromCameraResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.vision.v1.GetClassificationsFromCameraResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.vision.v1.GetClassificationsFromCameraResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    classificationsList: jspb.Message.toObjectList(msg.getClassificationsList(),
+    proto.viam.service.vision.v1.Classification.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.vision.v1.GetClassificationsFromCameraResponse}
+ */
+proto.viam.service.vision.v1.GetClassificationsFromCameraResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.vision.v1.GetClassificationsFromCameraResponse;
+  return proto.viam.service.vision.v1.GetClassificationsFromCameraResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.vision.v1.GetClassificationsFromCameraResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.vision.v1.GetClassificationsFromCameraResponse}
+ */
+proto.viam.service.vision.v1.GetClassificationsFromCameraResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.viam.service.vision.v1.Classification;
+      reader.readMessage(value,proto.viam.service.vision.v1.Classification.deserializeBinaryFromReader);
+      msg.addClassifications(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.service.vision.v1.GetClassificationsFromCameraResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.vision.v1.GetClassificationsFromCameraResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.vision.v1.GetClassificationsFromCameraResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.vision.v1.GetClassificationsFromCameraResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getClassificationsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.viam.service.vision.v1.Classification.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated Classification classifications = 1;
+ * @return {!Array<!proto.viam.service.vision.v1.Classification>}
+ */
+proto.viam.service.vision.v1.GetClassificationsFromCameraResponse.prototype.getClassificationsList = function() {
+  return /** @type{!Array<!proto.viam.service.vision.v1.Classification>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.viam.service.vision.v1.Classification, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.viam.service.vision.v1.Classification>} value
+ * @return {!proto.viam.service.vision.v1.GetClassificationsFromCameraResponse} returns this
+*/
+proto.viam.service.vision.v1.GetClassificationsFromCameraResponse.prototype.setClassificationsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.viam.service.vision.v1.Classification=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.service.vision.v1.Classification}
+ */
+proto.viam.service.vision.v1.GetClassificationsFromCameraResponse.prototype.addClassifications = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.viam.service.vision.v1.Classification, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.service.vision.v1.GetClassificationsFromCameraResponse} returns this
+ */
+proto.viam.service.vision.v1.GetClassificationsFromCameraResponse.prototype.clearClassificationsList = function() {
+  return this.setClassificationsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.vision.v1.Classification.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.vision.v1.Classification.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.vision.v1.Classification} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.vision.v1.Classification.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    className: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    confidence: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.vision.v1.Classification}
+ */
+proto.viam.service.vision.v1.Classification.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.vision.v1.Classification;
+  return proto.viam.service.vision.v1.Classification.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.vision.v1.Classification} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.vision.v1.Classification}
+ */
+proto.viam.service.vision.v1.Classification.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setClassName(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setConfidence(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.service.vision.v1.Classification.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.vision.v1.Classification.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.vision.v1.Classification} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.vision.v1.Classification.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getClassName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getConfidence();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string class_name = 1;
+ * @return {string}
+ */
+proto.viam.service.vision.v1.Classification.prototype.getClassName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.service.vision.v1.Classification} returns this
+ */
+proto.viam.service.vision.v1.Classification.prototype.setClassName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional double confidence = 2;
+ * @return {number}
+ */
+proto.viam.service.vision.v1.Classification.prototype.getConfidence = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.viam.service.vision.v1.Classification} returns this
+ */
+proto.viam.service.vision.v1.Classification.prototype.setConfidence = function(value) {
+  return jspb.Message.setProto3FloatField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.vision.v1.GetObjectPointCloudsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.vision.v1.GetObjectPointCloudsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.vision.v1.GetObjectPointCloudsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.vision.v1.GetObjectPointCloudsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    cameraName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    mimeType: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.vision.v1.GetObjectPointCloudsRequest}
+ */
+proto.viam.service.vision.v1.GetObjectPointCloudsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.vision.v1.GetObjectPointCloudsRequest;
+  return proto.viam.service.vision.v1.GetObjectPointCloudsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.vision.v1.GetObjectPointCloudsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.vision.v1.GetObjectPointCloudsRequest}
+ */
+proto.viam.service.vision.v1.GetObjectPointCloudsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCameraName(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMimeType(value);
+      break;
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.viam.service.vision.v1.GetObjectPointCloudsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.vision.v1.GetObjectPointCloudsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.vision.v1.GetObjectPointCloudsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.vision.v1.GetObjectPointCloudsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getCameraName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getMimeType();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.viam.service.vision.v1.GetObjectPointCloudsRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.service.vision.v1.GetObjectPointCloudsRequest} returns this
+ */
+proto.viam.service.vision.v1.GetObjectPointCloudsRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string camera_name = 2;
+ * @return {string}
+ */
+proto.viam.service.vision.v1.GetObjectPointCloudsRequest.prototype.getCameraName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.service.vision.v1.GetObjectPointCloudsRequest} returns this
+ */
+proto.viam.service.vision.v1.GetObjectPointCloudsRequest.prototype.setCameraName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string mime_type = 3;
+ * @return {string}
+ */
+proto.viam.service.vision.v1.GetObjectPointCloudsRequest.prototype.getMimeType = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.viam.service.vision.v1.GetObjectPointCloudsRequest} returns this
+ */
+proto.viam.service.vision.v1.GetObjectPointCloudsRequest.prototype.setMimeType = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.viam.service.vision.v1.GetObjectPointCloudsRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.service.vision.v1.GetObjectPointCloudsRequest} returns this
+*/
+proto.viam.service.vision.v1.GetObjectPointCloudsRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.vision.v1.GetObjectPointCloudsRequest} returns this
+ */
+proto.viam.service.vision.v1.GetObjectPointCloudsRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.viam.service.vision.v1.GetObjectPointCloudsRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.service.vision.v1.GetObjectPointCloudsResponse.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.viam.service.vision.v1.GetObjectPointCloudsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.vision.v1.GetObjectPointCloudsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.viam.service.vision.v1.GetObjectPointCloudsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.viam.service.vision.v1.GetObjectPointCloudsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    mimeType: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    objectsList: jspb.Message.toObjectList(msg.getObjectsList(),
+    common_v1_common_pb.PointCloudObject.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.viam.service.vision.v1.GetObjectPointCloudsResponse}
+ */
+proto.viam.service.vision.v1.GetObjectPointCloudsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.viam.service.vision.v1.GetObjectPointCloudsResponse;
+  return proto.viam.service.vision.v1.GetObjectPointCloudsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.viam.service.vision.v1.GetObjectPointCloudsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.viam.service.vision.v1.GetObjectPointCloudsResponse}
+ */
+proto.viam.service.vision.v1.GetObjectPointCloudsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMimeType(value);
+      break;
+    case 2:
+      var value = new common_v1_common_pb.PointCloudObject;
+      reader.readMessage(value,common_v1_common_pb.PointCloudObject.deserializeBinaryFromReader);
+      msg.addObjects(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional int64 y_max = 4;
- * @return {number}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.viam.service.vision.v1.Detection.prototype.getYMax = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.viam.service.vision.v1.GetObjectPointCloudsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.viam.service.vision.v1.GetObjectPointCloudsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.viam.service.vision.v1.Detection} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.viam.service.vision.v1.GetObjectPointCloudsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.vision.v1.Detection.prototype.setYMax = function(value) {
-  return jspb.Message.setField(this, 4, value);
+proto.viam.service.vision.v1.GetObjectPointCloudsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getMimeType();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getObjectsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      common_v1_common_pb.PointCloudObject.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.viam.service.vision.v1.Detection} returns this
+ * optional string mime_type = 1;
+ * @return {string}
  */
-proto.viam.service.vision.v1.Detection.prototype.clearYMax = function() {
-  return jspb.Message.setField(this, 4, undefined);
+proto.viam.service.vision.v1.GetObjectPointCloudsResponse.prototype.getMimeType = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.viam.service.vision.v1.GetObjectPointCloudsResponse} returns this
  */
-proto.viam.service.vision.v1.Detection.prototype.hasYMax = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.viam.service.vision.v1.GetObjectPointCloudsResponse.prototype.setMimeType = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional double confidence = 5;
- * @return {number}
+ * repeated viam.common.v1.PointCloudObject objects = 2;
+ * @return {!Array<!proto.viam.common.v1.PointCloudObject>}
  */
-proto.viam.service.vision.v1.Detection.prototype.getConfidence = function() {
-  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 5, 0.0));
+proto.viam.service.vision.v1.GetObjectPointCloudsResponse.prototype.getObjectsList = function() {
+  return /** @type{!Array<!proto.viam.common.v1.PointCloudObject>} */ (
+    jspb.Message.getRepeatedWrapperField(this, common_v1_common_pb.PointCloudObject, 2));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.viam.service.vision.v1.Detection} returns this
- */
-proto.viam.service.vision.v1.Detection.prototype.setConfidence = function(value) {
-  return jspb.Message.setProto3FloatField(this, 5, value);
+ * @param {!Array<!proto.viam.common.v1.PointCloudObject>} value
+ * @return {!proto.viam.service.vision.v1.GetObjectPointCloudsResponse} returns this
+*/
+proto.viam.service.vision.v1.GetObjectPointCloudsResponse.prototype.setObjectsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
 };
 
 
 /**
- * optional string class_name = 6;
- * @return {string}
+ * @param {!proto.viam.common.v1.PointCloudObject=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.common.v1.PointCloudObject}
  */
-proto.viam.service.vision.v1.Detection.prototype.getClassName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.viam.service.vision.v1.GetObjectPointCloudsResponse.prototype.addObjects = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.viam.common.v1.PointCloudObject, opt_index);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.viam.service.vision.v1.Detection} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.service.vision.v1.GetObjectPointCloudsResponse} returns this
  */
-proto.viam.service.vision.v1.Detection.prototype.setClassName = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+proto.viam.service.vision.v1.GetObjectPointCloudsResponse.prototype.clearObjectsList = function() {
+  return this.setObjectsList([]);
 };
 
 
@@ -1509,8 +5922,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.service.vision.v1.GetClassificationsRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.service.vision.v1.GetClassificationsRequest.toObject(opt_includeInstance, this);
+proto.viam.service.vision.v1.CaptureAllFromCameraRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.vision.v1.CaptureAllFromCameraRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -1519,18 +5932,20 @@ proto.viam.service.vision.v1.GetClassificationsRequest.prototype.toObject = func
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.service.vision.v1.GetClassificationsRequest} msg The msg instance to transform.
+ * @param {!proto.viam.service.vision.v1.CaptureAllFromCameraRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.vision.v1.GetClassificationsRequest.toObject = function(includeInstance, msg) {
+proto.viam.service.vision.v1.CaptureAllFromCameraRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    image: msg.getImage_asB64(),
-    width: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    height: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    mimeType: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    n: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    cameraName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    returnImage: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    returnDetections: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+    returnClassifications: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
+    returnObjectPointClouds: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
+    n: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    pointCloudMimeType: jspb.Message.getFieldWithDefault(msg, 8, ""),
     extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
   };
 
@@ -1545,23 +5960,23 @@ proto.viam.service.vision.v1.GetClassificationsRequest.toObject = function(inclu
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.service.vision.v1.GetClassificationsRequest}
+ * @return {!proto.viam.service.vision.v1.CaptureAllFromCameraRequest}
  */
-proto.viam.service.vision.v1.GetClassificationsRequest.deserializeBinary = function(bytes) {
+proto.viam.service.vision.v1.CaptureAllFromCameraRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.service.vision.v1.GetClassificationsRequest;
-  return proto.viam.service.vision.v1.GetClassificationsRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.service.vision.v1.CaptureAllFromCameraRequest;
+  return proto.viam.service.vision.v1.CaptureAllFromCameraRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.service.vision.v1.GetClassificationsRequest} msg The message object to deserialize into.
+ * @param {!proto.viam.service.vision.v1.CaptureAllFromCameraRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.service.vision.v1.GetClassificationsRequest}
+ * @return {!proto.viam.service.vision.v1.CaptureAllFromCameraRequest}
  */
-proto.viam.service.vision.v1.GetClassificationsRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.service.vision.v1.CaptureAllFromCameraRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1573,25 +5988,33 @@ proto.viam.service.vision.v1.GetClassificationsRequest.deserializeBinaryFromRead
       msg.setName(value);
       break;
     case 2:
-      var value = /** @type {!Uint8Array} */ (reader.readBytes());
-      msg.setImage(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCameraName(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setWidth(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setReturnImage(value);
       break;
     case 4:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setHeight(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setReturnDetections(value);
       break;
     case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setMimeType(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setReturnClassifications(value);
       break;
     case 6:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setReturnObjectPointClouds(value);
+      break;
+    case 7:
       var value = /** @type {number} */ (reader.readInt32());
       msg.setN(value);
       break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPointCloudMimeType(value);
+      break;
     case 99:
       var value = new google_protobuf_struct_pb.Struct;
       reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
@@ -1610,9 +6033,9 @@ proto.viam.service.vision.v1.GetClassificationsRequest.deserializeBinaryFromRead
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.service.vision.v1.GetClassificationsRequest.prototype.serializeBinary = function() {
+proto.viam.service.vision.v1.CaptureAllFromCameraRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.service.vision.v1.GetClassificationsRequest.serializeBinaryToWriter(this, writer);
+  proto.viam.service.vision.v1.CaptureAllFromCameraRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1620,11 +6043,11 @@ proto.viam.service.vision.v1.GetClassificationsRequest.prototype.serializeBinary
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.service.vision.v1.GetClassificationsRequest} message
+ * @param {!proto.viam.service.vision.v1.CaptureAllFromCameraRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.vision.v1.GetClassificationsRequest.serializeBinaryToWriter = function(message, writer) {
+proto.viam.service.vision.v1.CaptureAllFromCameraRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getName();
   if (f.length > 0) {
@@ -1633,38 +6056,52 @@ proto.viam.service.vision.v1.GetClassificationsRequest.serializeBinaryToWriter =
       f
     );
   }
-  f = message.getImage_asU8();
+  f = message.getCameraName();
   if (f.length > 0) {
-    writer.writeBytes(
+    writer.writeString(
       2,
       f
     );
   }
-  f = message.getWidth();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = message.getReturnImage();
+  if (f) {
+    writer.writeBool(
       3,
       f
     );
   }
-  f = message.getHeight();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = message.getReturnDetections();
+  if (f) {
+    writer.writeBool(
       4,
       f
     );
   }
-  f = message.getMimeType();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getReturnClassifications();
+  if (f) {
+    writer.writeBool(
       5,
       f
     );
   }
+  f = message.getReturnObjectPointClouds();
+  if (f) {
+    writer.writeBool(
+      6,
+      f
+    );
+  }
   f = message.getN();
   if (f !== 0) {
     writer.writeInt32(
-      6,
+      7,
+      f
+    );
+  }
+  f = message.getPointCloudMimeType();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
       f
     );
   }
@@ -1683,332 +6120,191 @@ proto.viam.service.vision.v1.GetClassificationsRequest.serializeBinaryToWriter =
  * optional string name = 1;
  * @return {string}
  */
-proto.viam.service.vision.v1.GetClassificationsRequest.prototype.getName = function() {
+proto.viam.service.vision.v1.CaptureAllFromCameraRequest.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.viam.service.vision.v1.GetClassificationsRequest} returns this
+ * @return {!proto.viam.service.vision.v1.CaptureAllFromCameraRequest} returns this
  */
-proto.viam.service.vision.v1.GetClassificationsRequest.prototype.setName = function(value) {
+proto.viam.service.vision.v1.CaptureAllFromCameraRequest.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional bytes image = 2;
- * @return {string}
- */
-proto.viam.service.vision.v1.GetClassificationsRequest.prototype.getImage = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * optional bytes image = 2;
- * This is a type-conversion wrapper around `getImage()`
- * @return {string}
- */
-proto.viam.service.vision.v1.GetClassificationsRequest.prototype.getImage_asB64 = function() {
-  return /** @type {string} */ (jspb.Message.bytesAsB64(
-      this.getImage()));
-};
-
-
-/**
- * optional bytes image = 2;
- * Note that Uint8Array is not supported on all browsers.
- * @see http://caniuse.com/Uint8Array
- * This is a type-conversion wrapper around `getImage()`
- * @return {!Uint8Array}
- */
-proto.viam.service.vision.v1.GetClassificationsRequest.prototype.getImage_asU8 = function() {
-  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
-      this.getImage()));
-};
-
-
-/**
- * @param {!(string|Uint8Array)} value
- * @return {!proto.viam.service.vision.v1.GetClassificationsRequest} returns this
- */
-proto.viam.service.vision.v1.GetClassificationsRequest.prototype.setImage = function(value) {
-  return jspb.Message.setProto3BytesField(this, 2, value);
-};
-
-
-/**
- * optional int32 width = 3;
- * @return {number}
- */
-proto.viam.service.vision.v1.GetClassificationsRequest.prototype.getWidth = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.viam.service.vision.v1.GetClassificationsRequest} returns this
- */
-proto.viam.service.vision.v1.GetClassificationsRequest.prototype.setWidth = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
-};
-
-
-/**
- * optional int32 height = 4;
- * @return {number}
- */
-proto.viam.service.vision.v1.GetClassificationsRequest.prototype.getHeight = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.viam.service.vision.v1.GetClassificationsRequest} returns this
- */
-proto.viam.service.vision.v1.GetClassificationsRequest.prototype.setHeight = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
-};
-
-
-/**
- * optional string mime_type = 5;
+ * optional string camera_name = 2;
  * @return {string}
- */
-proto.viam.service.vision.v1.GetClassificationsRequest.prototype.getMimeType = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.viam.service.vision.v1.GetClassificationsRequest} returns this
- */
-proto.viam.service.vision.v1.GetClassificationsRequest.prototype.setMimeType = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
+ */
+proto.viam.service.vision.v1.CaptureAllFromCameraRequest.prototype.getCameraName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * optional int32 n = 6;
- * @return {number}
+ * @param {string} value
+ * @return {!proto.viam.service.vision.v1.CaptureAllFromCameraRequest} returns this
  */
-proto.viam.service.vision.v1.GetClassificationsRequest.prototype.getN = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+proto.viam.service.vision.v1.CaptureAllFromCameraRequest.prototype.setCameraName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.viam.service.vision.v1.GetClassificationsRequest} returns this
+ * optional bool return_image = 3;
+ * @return {boolean}
  */
-proto.viam.service.vision.v1.GetClassificationsRequest.prototype.setN = function(value) {
-  return jspb.Message.setProto3IntField(this, 6, value);
+proto.viam.service.vision.v1.CaptureAllFromCameraRequest.prototype.getReturnImage = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
 };
 
 
 /**
- * optional google.protobuf.Struct extra = 99;
- * @return {?proto.google.protobuf.Struct}
+ * @param {boolean} value
+ * @return {!proto.viam.service.vision.v1.CaptureAllFromCameraRequest} returns this
  */
-proto.viam.service.vision.v1.GetClassificationsRequest.prototype.getExtra = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+proto.viam.service.vision.v1.CaptureAllFromCameraRequest.prototype.setReturnImage = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
 };
 
 
 /**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.viam.service.vision.v1.GetClassificationsRequest} returns this
-*/
-proto.viam.service.vision.v1.GetClassificationsRequest.prototype.setExtra = function(value) {
-  return jspb.Message.setWrapperField(this, 99, value);
+ * optional bool return_detections = 4;
+ * @return {boolean}
+ */
+proto.viam.service.vision.v1.CaptureAllFromCameraRequest.prototype.getReturnDetections = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.viam.service.vision.v1.GetClassificationsRequest} returns this
+ * @param {boolean} value
+ * @return {!proto.viam.service.vision.v1.CaptureAllFromCameraRequest} returns this
  */
-proto.viam.service.vision.v1.GetClassificationsRequest.prototype.clearExtra = function() {
-  return this.setExtra(undefined);
+proto.viam.service.vision.v1.CaptureAllFromCameraRequest.prototype.setReturnDetections = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
 };
 
 
 /**
- * Returns whether this field is set.
+ * optional bool return_classifications = 5;
  * @return {boolean}
  */
-proto.viam.service.vision.v1.GetClassificationsRequest.prototype.hasExtra = function() {
-  return jspb.Message.getField(this, 99) != null;
+proto.viam.service.vision.v1.CaptureAllFromCameraRequest.prototype.getReturnClassifications = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
 };
 
 
-
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {boolean} value
+ * @return {!proto.viam.service.vision.v1.CaptureAllFromCameraRequest} returns this
  */
-proto.viam.service.vision.v1.GetClassificationsResponse.repeatedFields_ = [1];
-
+proto.viam.service.vision.v1.CaptureAllFromCameraRequest.prototype.setReturnClassifications = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * optional bool return_object_point_clouds = 6;
+ * @return {boolean}
  */
-proto.viam.service.vision.v1.GetClassificationsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.service.vision.v1.GetClassificationsResponse.toObject(opt_includeInstance, this);
+proto.viam.service.vision.v1.CaptureAllFromCameraRequest.prototype.getReturnObjectPointClouds = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.viam.service.vision.v1.GetClassificationsResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {boolean} value
+ * @return {!proto.viam.service.vision.v1.CaptureAllFromCameraRequest} returns this
  */
-proto.viam.service.vision.v1.GetClassificationsResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    classificationsList: jspb.Message.toObjectList(msg.getClassificationsList(),
-    proto.viam.service.vision.v1.Classification.toObject, includeInstance)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.viam.service.vision.v1.CaptureAllFromCameraRequest.prototype.setReturnObjectPointClouds = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 6, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.service.vision.v1.GetClassificationsResponse}
+ * optional int32 n = 7;
+ * @return {number}
  */
-proto.viam.service.vision.v1.GetClassificationsResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.service.vision.v1.GetClassificationsResponse;
-  return proto.viam.service.vision.v1.GetClassificationsResponse.deserializeBinaryFromReader(msg, reader);
+proto.viam.service.vision.v1.CaptureAllFromCameraRequest.prototype.getN = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.viam.service.vision.v1.GetClassificationsResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.service.vision.v1.GetClassificationsResponse}
+ * @param {number} value
+ * @return {!proto.viam.service.vision.v1.CaptureAllFromCameraRequest} returns this
  */
-proto.viam.service.vision.v1.GetClassificationsResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = new proto.viam.service.vision.v1.Classification;
-      reader.readMessage(value,proto.viam.service.vision.v1.Classification.deserializeBinaryFromReader);
-      msg.addClassifications(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.viam.service.vision.v1.CaptureAllFromCameraRequest.prototype.setN = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional string point_cloud_mime_type = 8;
+ * @return {string}
  */
-proto.viam.service.vision.v1.GetClassificationsResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.viam.service.vision.v1.GetClassificationsResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.viam.service.vision.v1.CaptureAllFromCameraRequest.prototype.getPointCloudMimeType = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.viam.service.vision.v1.GetClassificationsResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {string} value
+ * @return {!proto.viam.service.vision.v1.CaptureAllFromCameraRequest} returns this
  */
-proto.viam.service.vision.v1.GetClassificationsResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getClassificationsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      1,
-      f,
-      proto.viam.service.vision.v1.Classification.serializeBinaryToWriter
-    );
-  }
+proto.viam.service.vision.v1.CaptureAllFromCameraRequest.prototype.setPointCloudMimeType = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
 };
 
 
 /**
- * repeated Classification classifications = 1;
- * @return {!Array<!proto.viam.service.vision.v1.Classification>}
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
  */
-proto.viam.service.vision.v1.GetClassificationsResponse.prototype.getClassificationsList = function() {
-  return /** @type{!Array<!proto.viam.service.vision.v1.Classification>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.viam.service.vision.v1.Classification, 1));
+proto.viam.service.vision.v1.CaptureAllFromCameraRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
 };
 
 
 /**
- * @param {!Array<!proto.viam.service.vision.v1.Classification>} value
- * @return {!proto.viam.service.vision.v1.GetClassificationsResponse} returns this
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.service.vision.v1.CaptureAllFromCameraRequest} returns this
 */
-proto.viam.service.vision.v1.GetClassificationsResponse.prototype.setClassificationsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+proto.viam.service.vision.v1.CaptureAllFromCameraRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
 };
 
 
 /**
- * @param {!proto.viam.service.vision.v1.Classification=} opt_value
- * @param {number=} opt_index
- * @return {!proto.viam.service.vision.v1.Classification}
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.vision.v1.CaptureAllFromCameraRequest} returns this
  */
-proto.viam.service.vision.v1.GetClassificationsResponse.prototype.addClassifications = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.viam.service.vision.v1.Classification, opt_index);
+proto.viam.service.vision.v1.CaptureAllFromCameraRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.viam.service.vision.v1.GetClassificationsResponse} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.viam.service.vision.v1.GetClassificationsResponse.prototype.clearClassificationsList = function() {
-  return this.setClassificationsList([]);
+proto.viam.service.vision.v1.CaptureAllFromCameraRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.service.vision.v1.CaptureAllFromCameraResponse.repeatedFields_ = [2,3,5];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -2024,8 +6320,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.toObject(opt_includeInstance, this);
+proto.viam.service.vision.v1.CaptureAllFromCameraResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.vision.v1.CaptureAllFromCameraResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2034,16 +6330,21 @@ proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.prototype.toObj
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.service.vision.v1.GetClassificationsFromCameraRequest} msg The msg instance to transform.
+ * @param {!proto.viam.service.vision.v1.CaptureAllFromCameraResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.toObject = function(includeInstance, msg) {
+proto.viam.service.vision.v1.CaptureAllFromCameraResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    cameraName: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    n: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+    image: (f = msg.getImage()) && component_camera_v1_camera_pb.Image.toObject(includeInstance, f),
+    detectionsList: jspb.Message.toObjectList(msg.getDetectionsList(),
+    proto.viam.service.vision.v1.Detection.toObject, includeInstance),
+    classificationsList: jspb.Message.toObjectList(msg.getClassificationsList(),
+    proto.viam.service.vision.v1.Classification.toObject, includeInstance),
+    pointCloudMimeType: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    objectsList: jspb.Message.toObjectList(msg.getObjectsList(),
+    common_v1_common_pb.PointCloudObject.toObject, includeInstance),
+    responseMetadata: (f = msg.getResponseMetadata()) && common_v1_common_pb.ResponseMetadata.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -2057,23 +6358,23 @@ proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.toObject = func
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.service.vision.v1.GetClassificationsFromCameraRequest}
+ * @return {!proto.viam.service.vision.v1.CaptureAllFromCameraResponse}
  */
-proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.deserializeBinary = function(bytes) {
+proto.viam.service.vision.v1.CaptureAllFromCameraResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.service.vision.v1.GetClassificationsFromCameraRequest;
-  return proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.service.vision.v1.CaptureAllFromCameraResponse;
+  return proto.viam.service.vision.v1.CaptureAllFromCameraResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.service.vision.v1.GetClassificationsFromCameraRequest} msg The message object to deserialize into.
+ * @param {!proto.viam.service.vision.v1.CaptureAllFromCameraResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.service.vision.v1.GetClassificationsFromCameraRequest}
+ * @return {!proto.viam.service.vision.v1.CaptureAllFromCameraResponse}
  */
-proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.service.vision.v1.CaptureAllFromCameraResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2081,21 +6382,33 @@ proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.deserializeBina
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      var value = new component_camera_v1_camera_pb.Image;
+      reader.readMessage(value,component_camera_v1_camera_pb.Image.deserializeBinaryFromReader);
+      msg.setImage(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setCameraName(value);
+      var value = new proto.viam.service.vision.v1.Detection;
+      reader.readMessage(value,proto.viam.service.vision.v1.Detection.deserializeBinaryFromReader);
+      msg.addDetections(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setN(value);
+      var value = new proto.viam.service.vision.v1.Classification;
+      reader.readMessage(value,proto.viam.service.vision.v1.Classification.deserializeBinaryFromReader);
+      msg.addClassifications(value);
       break;
-    case 99:
-      var value = new google_protobuf_struct_pb.Struct;
-      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setExtra(value);
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPointCloudMimeType(value);
+      break;
+    case 5:
+      var value = new common_v1_common_pb.PointCloudObject;
+      reader.readMessage(value,common_v1_common_pb.PointCloudObject.deserializeBinaryFromReader);
+      msg.addObjects(value);
+      break;
+    case 84260:
+      var value = new common_v1_common_pb.ResponseMetadata;
+      reader.readMessage(value,common_v1_common_pb.ResponseMetadata.deserializeBinaryFromReader);
+      msg.setResponseMetadata(value);
       break;
     default:
       reader.skipField();
@@ -2110,9 +6423,9 @@ proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.deserializeBina
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.prototype.serializeBinary = function() {
+proto.viam.service.vision.v1.CaptureAllFromCameraResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.serializeBinaryToWriter(this, writer);
+  proto.viam.service.vision.v1.CaptureAllFromCameraResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2120,292 +6433,265 @@ proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.prototype.seria
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.service.vision.v1.GetClassificationsFromCameraRequest} message
+ * @param {!proto.viam.service.vision.v1.CaptureAllFromCameraResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.serializeBinaryToWriter = function(message, writer) {
+proto.viam.service.vision.v1.CaptureAllFromCameraResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getImage();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      component_camera_v1_camera_pb.Image.serializeBinaryToWriter
     );
   }
-  f = message.getCameraName();
+  f = message.getDetectionsList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedMessage(
       2,
-      f
+      f,
+      proto.viam.service.vision.v1.Detection.serializeBinaryToWriter
     );
   }
-  f = message.getN();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = message.getClassificationsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       3,
+      f,
+      proto.viam.service.vision.v1.Classification.serializeBinaryToWriter
+    );
+  }
+  f = message.getPointCloudMimeType();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
       f
     );
   }
-  f = message.getExtra();
+  f = message.getObjectsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      5,
+      f,
+      common_v1_common_pb.PointCloudObject.serializeBinaryToWriter
+    );
+  }
+  f = message.getResponseMetadata();
   if (f != null) {
     writer.writeMessage(
-      99,
+      84260,
       f,
-      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+      common_v1_common_pb.ResponseMetadata.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string name = 1;
- * @return {string}
+ * optional viam.component.camera.v1.Image image = 1;
+ * @return {?proto.viam.component.camera.v1.Image}
+ */
+proto.viam.service.vision.v1.CaptureAllFromCameraResponse.prototype.getImage = function() {
+  return /** @type{?proto.viam.component.camera.v1.Image} */ (
+    jspb.Message.getWrapperField(this, component_camera_v1_camera_pb.Image, 1));
+};
+
+
+/**
+ * @param {?proto.viam.component.camera.v1.Image|undefined} value
+ * @return {!proto.viam.service.vision.v1.CaptureAllFromCameraResponse} returns this
+*/
+proto.viam.service.vision.v1.CaptureAllFromCameraResponse.prototype.setImage = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.vision.v1.CaptureAllFromCameraResponse} returns this
  */
-proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.viam.service.vision.v1.CaptureAllFromCameraResponse.prototype.clearImage = function() {
+  return this.setImage(undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.viam.service.vision.v1.GetClassificationsFromCameraRequest} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.viam.service.vision.v1.CaptureAllFromCameraResponse.prototype.hasImage = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * optional string camera_name = 2;
- * @return {string}
+ * repeated Detection detections = 2;
+ * @return {!Array<!proto.viam.service.vision.v1.Detection>}
  */
-proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.prototype.getCameraName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.viam.service.vision.v1.CaptureAllFromCameraResponse.prototype.getDetectionsList = function() {
+  return /** @type{!Array<!proto.viam.service.vision.v1.Detection>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.viam.service.vision.v1.Detection, 2));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.viam.service.vision.v1.GetClassificationsFromCameraRequest} returns this
- */
-proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.prototype.setCameraName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+ * @param {!Array<!proto.viam.service.vision.v1.Detection>} value
+ * @return {!proto.viam.service.vision.v1.CaptureAllFromCameraResponse} returns this
+*/
+proto.viam.service.vision.v1.CaptureAllFromCameraResponse.prototype.setDetectionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
 };
 
 
 /**
- * optional int32 n = 3;
- * @return {number}
+ * @param {!proto.viam.service.vision.v1.Detection=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.service.vision.v1.Detection}
  */
-proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.prototype.getN = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.viam.service.vision.v1.CaptureAllFromCameraResponse.prototype.addDetections = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.viam.service.vision.v1.Detection, opt_index);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.viam.service.vision.v1.GetClassificationsFromCameraRequest} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.service.vision.v1.CaptureAllFromCameraResponse} returns this
  */
-proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.prototype.setN = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.viam.service.vision.v1.CaptureAllFromCameraResponse.prototype.clearDetectionsList = function() {
+  return this.setDetectionsList([]);
 };
 
 
 /**
- * optional google.protobuf.Struct extra = 99;
- * @return {?proto.google.protobuf.Struct}
+ * repeated Classification classifications = 3;
+ * @return {!Array<!proto.viam.service.vision.v1.Classification>}
  */
-proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.prototype.getExtra = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+proto.viam.service.vision.v1.CaptureAllFromCameraResponse.prototype.getClassificationsList = function() {
+  return /** @type{!Array<!proto.viam.service.vision.v1.Classification>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.viam.service.vision.v1.Classification, 3));
 };
 
 
 /**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.viam.service.vision.v1.GetClassificationsFromCameraRequest} returns this
+ * @param {!Array<!proto.viam.service.vision.v1.Classification>} value
+ * @return {!proto.viam.service.vision.v1.CaptureAllFromCameraResponse} returns this
 */
-proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.prototype.setExtra = function(value) {
-  return jspb.Message.setWrapperField(this, 99, value);
+proto.viam.service.vision.v1.CaptureAllFromCameraResponse.prototype.setClassificationsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.viam.service.vision.v1.GetClassificationsFromCameraRequest} returns this
+ * @param {!proto.viam.service.vision.v1.Classification=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.service.vision.v1.Classification}
  */
-proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.prototype.clearExtra = function() {
-  return this.setExtra(undefined);
+proto.viam.service.vision.v1.CaptureAllFromCameraResponse.prototype.addClassifications = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.viam.service.vision.v1.Classification, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.service.vision.v1.CaptureAllFromCameraResponse} returns this
  */
-proto.viam.service.vision.v1.GetClassificationsFromCameraRequest.prototype.hasExtra = function() {
-  return jspb.Message.getField(this, 99) != null;
+proto.viam.service.vision.v1.CaptureAllFromCameraResponse.prototype.clearClassificationsList = function() {
+  return this.setClassificationsList([]);
 };
 
 
-
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.viam.service.vision.v1.GetClassificationsFromCameraResponse.repeatedFields_ = [1];
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * optional string point_cloud_mime_type = 4;
+ * @return {string}
  */
-proto.viam.service.vision.v1.GetClassificationsFromCameraResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.service.vision.v1.GetClassificationsFromCameraResponse.toObject(opt_includeInstance, this);
+proto.viam.service.vision.v1.CaptureAllFromCameraResponse.prototype.getPointCloudMimeType = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.viam.service.vision.v1.GetClassificationsFromCameraResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {string} value
+ * @return {!proto.viam.service.vision.v1.CaptureAllFromCameraResponse} returns this
  */
-proto.viam.service.vision.v1.GetClassificationsFromCameraResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    classificationsList: jspb.Message.toObjectList(msg.getClassificationsList(),
-    proto.viam.service.vision.v1.Classification.toObject, includeInstance)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.viam.service.vision.v1.CaptureAllFromCameraResponse.prototype.setPointCloudMimeType = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.service.vision.v1.GetClassificationsFromCameraResponse}
+ * repeated viam.common.v1.PointCloudObject objects = 5;
+ * @return {!Array<!proto.viam.common.v1.PointCloudObject>}
  */
-proto.viam.service.vision.v1.GetClassificationsFromCameraResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.service.vision.v1.GetClassificationsFromCameraResponse;
-  return proto.viam.service.vision.v1.GetClassificationsFromCameraResponse.deserializeBinaryFromReader(msg, reader);
+proto.viam.service.vision.v1.CaptureAllFromCameraResponse.prototype.getObjectsList = function() {
+  return /** @type{!Array<!proto.viam.common.v1.PointCloudObject>} */ (
+    jspb.Message.getRepeatedWrapperField(this, common_v1_common_pb.PointCloudObject, 5));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.viam.service.vision.v1.GetClassificationsFromCameraResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.service.vision.v1.GetClassificationsFromCameraResponse}
- */
-proto.viam.service.vision.v1.GetClassificationsFromCameraResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = new proto.viam.service.vision.v1.Classification;
-      reader.readMessage(value,proto.viam.service.vision.v1.Classification.deserializeBinaryFromReader);
-      msg.addClassifications(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+ * @param {!Array<!proto.viam.common.v1.PointCloudObject>} value
+ * @return {!proto.viam.service.vision.v1.CaptureAllFromCameraResponse} returns this
+*/
+proto.viam.service.vision.v1.CaptureAllFromCameraResponse.prototype.setObjectsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 5, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * @param {!proto.viam.common.v1.PointCloudObject=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.common.v1.PointCloudObject}
  */
-proto.viam.service.vision.v1.GetClassificationsFromCameraResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.viam.service.vision.v1.GetClassificationsFromCameraResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.viam.service.vision.v1.CaptureAllFromCameraResponse.prototype.addObjects = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 5, opt_value, proto.viam.common.v1.PointCloudObject, opt_index);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.viam.service.vision.v1.GetClassificationsFromCameraResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.service.vision.v1.CaptureAllFromCameraResponse} returns this
  */
-proto.viam.service.vision.v1.GetClassificationsFromCameraResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getClassificationsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      1,
-      f,
-      proto.viam.service.vision.v1.Classification.serializeBinaryToWriter
-    );
-  }
+proto.viam.service.vision.v1.CaptureAllFromCameraResponse.prototype.clearObjectsList = function() {
+  return this.setObjectsList([]);
 };
 
 
 /**
- * repeated Classification classifications = 1;
- * @return {!Array<!proto.viam.service.vision.v1.Classification>}
+ * optional viam.common.v1.ResponseMetadata response_metadata = 84260;
+ * @return {?proto.viam.common.v1.ResponseMetadata}
  */
-proto.viam.service.vision.v1.GetClassificationsFromCameraResponse.prototype.getClassificationsList = function() {
-  return /** @type{!Array<!proto.viam.service.vision.v1.Classification>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.viam.service.vision.v1.Classification, 1));
+proto.viam.service.vision.v1.CaptureAllFromCameraResponse.prototype.getResponseMetadata = function() {
+  return /** @type{?proto.viam.common.v1.ResponseMetadata} */ (
+    jspb.Message.getWrapperField(this, common_v1_common_pb.ResponseMetadata, 84260));
 };
 
 
 /**
- * @param {!Array<!proto.viam.service.vision.v1.Classification>} value
- * @return {!proto.viam.service.vision.v1.GetClassificationsFromCameraResponse} returns this
+ * @param {?proto.viam.common.v1.ResponseMetadata|undefined} value
+ * @return {!proto.viam.service.vision.v1.CaptureAllFromCameraResponse} returns this
 */
-proto.viam.service.vision.v1.GetClassificationsFromCameraResponse.prototype.setClassificationsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+proto.viam.service.vision.v1.CaptureAllFromCameraResponse.prototype.setResponseMetadata = function(value) {
+  return jspb.Message.setWrapperField(this, 84260, value);
 };
 
 
 /**
- * @param {!proto.viam.service.vision.v1.Classification=} opt_value
- * @param {number=} opt_index
- * @return {!proto.viam.service.vision.v1.Classification}
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.vision.v1.CaptureAllFromCameraResponse} returns this
  */
-proto.viam.service.vision.v1.GetClassificationsFromCameraResponse.prototype.addClassifications = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.viam.service.vision.v1.Classification, opt_index);
+proto.viam.service.vision.v1.CaptureAllFromCameraResponse.prototype.clearResponseMetadata = function() {
+  return this.setResponseMetadata(undefined);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.viam.service.vision.v1.GetClassificationsFromCameraResponse} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.viam.service.vision.v1.GetClassificationsFromCameraResponse.prototype.clearClassificationsList = function() {
-  return this.setClassificationsList([]);
+proto.viam.service.vision.v1.CaptureAllFromCameraResponse.prototype.hasResponseMetadata = function() {
+  return jspb.Message.getField(this, 84260) != null;
 };
 
 
@@ -2425,8 +6711,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.service.vision.v1.Classification.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.service.vision.v1.Classification.toObject(opt_includeInstance, this);
+proto.viam.service.vision.v1.GetPropertiesRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.vision.v1.GetPropertiesRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -2435,14 +6721,14 @@ proto.viam.service.vision.v1.Classification.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.service.vision.v1.Classification} msg The msg instance to transform.
+ * @param {!proto.viam.service.vision.v1.GetPropertiesRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.vision.v1.Classification.toObject = function(includeInstance, msg) {
+proto.viam.service.vision.v1.GetPropertiesRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    className: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    confidence: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0)
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -2456,23 +6742,23 @@ proto.viam.service.vision.v1.Classification.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.service.vision.v1.Classification}
+ * @return {!proto.viam.service.vision.v1.GetPropertiesRequest}
  */
-proto.viam.service.vision.v1.Classification.deserializeBinary = function(bytes) {
+proto.viam.service.vision.v1.GetPropertiesRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.service.vision.v1.Classification;
-  return proto.viam.service.vision.v1.Classification.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.service.vision.v1.GetPropertiesRequest;
+  return proto.viam.service.vision.v1.GetPropertiesRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.service.vision.v1.Classification} msg The message object to deserialize into.
+ * @param {!proto.viam.service.vision.v1.GetPropertiesRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.service.vision.v1.Classification}
+ * @return {!proto.viam.service.vision.v1.GetPropertiesRequest}
  */
-proto.viam.service.vision.v1.Classification.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.service.vision.v1.GetPropertiesRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2481,11 +6767,12 @@ proto.viam.service.vision.v1.Classification.deserializeBinaryFromReader = functi
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setClassName(value);
+      msg.setName(value);
       break;
-    case 2:
-      var value = /** @type {number} */ (reader.readDouble());
-      msg.setConfidence(value);
+    case 99:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setExtra(value);
       break;
     default:
       reader.skipField();
@@ -2500,9 +6787,9 @@ proto.viam.service.vision.v1.Classification.deserializeBinaryFromReader = functi
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.service.vision.v1.Classification.prototype.serializeBinary = function() {
+proto.viam.service.vision.v1.GetPropertiesRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.service.vision.v1.Classification.serializeBinaryToWriter(this, writer);
+  proto.viam.service.vision.v1.GetPropertiesRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2510,66 +6797,93 @@ proto.viam.service.vision.v1.Classification.prototype.serializeBinary = function
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.service.vision.v1.Classification} message
+ * @param {!proto.viam.service.vision.v1.GetPropertiesRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.vision.v1.Classification.serializeBinaryToWriter = function(message, writer) {
+proto.viam.service.vision.v1.GetPropertiesRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getClassName();
+  f = message.getName();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getConfidence();
-  if (f !== 0.0) {
-    writer.writeDouble(
-      2,
-      f
+  f = message.getExtra();
+  if (f != null) {
+    writer.writeMessage(
+      99,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string class_name = 1;
+ * optional string name = 1;
  * @return {string}
  */
-proto.viam.service.vision.v1.Classification.prototype.getClassName = function() {
+proto.viam.service.vision.v1.GetPropertiesRequest.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.viam.service.vision.v1.Classification} returns this
+ * @return {!proto.viam.service.vision.v1.GetPropertiesRequest} returns this
  */
-proto.viam.service.vision.v1.Classification.prototype.setClassName = function(value) {
+proto.viam.service.vision.v1.GetPropertiesRequest.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional double confidence = 2;
- * @return {number}
+ * optional google.protobuf.Struct extra = 99;
+ * @return {?proto.google.protobuf.Struct}
  */
-proto.viam.service.vision.v1.Classification.prototype.getConfidence = function() {
-  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
+proto.viam.service.vision.v1.GetPropertiesRequest.prototype.getExtra = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.viam.service.vision.v1.GetPropertiesRequest} returns this
+*/
+proto.viam.service.vision.v1.GetPropertiesRequest.prototype.setExtra = function(value) {
+  return jspb.Message.setWrapperField(this, 99, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.viam.service.vision.v1.GetPropertiesRequest} returns this
+ */
+proto.viam.service.vision.v1.GetPropertiesRequest.prototype.clearExtra = function() {
+  return this.setExtra(undefined);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.viam.service.vision.v1.Classification} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.viam.service.vision.v1.Classification.prototype.setConfidence = function(value) {
-  return jspb.Message.setProto3FloatField(this, 2, value);
+proto.viam.service.vision.v1.GetPropertiesRequest.prototype.hasExtra = function() {
+  return jspb.Message.getField(this, 99) != null;
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.viam.service.vision.v1.GetPropertiesResponse.repeatedFields_ = [7,8];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -2585,8 +6899,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.service.vision.v1.GetObjectPointCloudsRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.service.vision.v1.GetObjectPointCloudsRequest.toObject(opt_includeInstance, this);
+proto.viam.service.vision.v1.GetPropertiesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.vision.v1.GetPropertiesResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2595,16 +6909,21 @@ proto.viam.service.vision.v1.GetObjectPointCloudsRequest.prototype.toObject = fu
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.service.vision.v1.GetObjectPointCloudsRequest} msg The msg instance to transform.
+ * @param {!proto.viam.service.vision.v1.GetPropertiesResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.vision.v1.GetObjectPointCloudsRequest.toObject = function(includeInstance, msg) {
+proto.viam.service.vision.v1.GetPropertiesResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    cameraName: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    mimeType: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    extra: (f = msg.getExtra()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+    detectionsSupported: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+    classificationsSupported: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    objectPointCloudsSupported: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    masksSupported: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+    keypointsSupported: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
+    orientedBoxesSupported: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
+    keypointNamesList: (f = jspb.Message.getRepeatedField(msg, 7)) == null ? undefined : f,
+    skeletonList: jspb.Message.toObjectList(msg.getSkeletonList(),
+    proto.viam.service.vision.v1.KeypointEdge.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -2618,23 +6937,23 @@ proto.viam.service.vision.v1.GetObjectPointCloudsRequest.toObject = function(inc
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.service.vision.v1.GetObjectPointCloudsRequest}
+ * @return {!proto.viam.service.vision.v1.GetPropertiesResponse}
  */
-proto.viam.service.vision.v1.GetObjectPointCloudsRequest.deserializeBinary = function(bytes) {
+proto.viam.service.vision.v1.GetPropertiesResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.service.vision.v1.GetObjectPointCloudsRequest;
-  return proto.viam.service.vision.v1.GetObjectPointCloudsRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.service.vision.v1.GetPropertiesResponse;
+  return proto.viam.service.vision.v1.GetPropertiesResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.service.vision.v1.GetObjectPointCloudsRequest} msg The message object to deserialize into.
+ * @param {!proto.viam.service.vision.v1.GetPropertiesResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.service.vision.v1.GetObjectPointCloudsRequest}
+ * @return {!proto.viam.service.vision.v1.GetPropertiesResponse}
  */
-proto.viam.service.vision.v1.GetObjectPointCloudsRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.service.vision.v1.GetPropertiesResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2642,21 +6961,37 @@ proto.viam.service.vision.v1.GetObjectPointCloudsRequest.deserializeBinaryFromRe
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setDetectionsSupported(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setCameraName(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setClassificationsSupported(value);
       break;
     case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setObjectPointCloudsSupported(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setMasksSupported(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setKeypointsSupported(value);
+      break;
+    case 6:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setOrientedBoxesSupported(value);
+      break;
+    case 7:
       var value = /** @type {string} */ (reader.readString());
-      msg.setMimeType(value);
+      msg.addKeypointNames(value);
       break;
-    case 99:
-      var value = new google_protobuf_struct_pb.Struct;
-      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setExtra(value);
+    case 8:
+      var value = new proto.viam.service.vision.v1.KeypointEdge;
+      reader.readMessage(value,proto.viam.service.vision.v1.KeypointEdge.deserializeBinaryFromReader);
+      msg.addSkeleton(value);
       break;
     default:
       reader.skipField();
@@ -2671,9 +7006,9 @@ proto.viam.service.vision.v1.GetObjectPointCloudsRequest.deserializeBinaryFromRe
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.service.vision.v1.GetObjectPointCloudsRequest.prototype.serializeBinary = function() {
+proto.viam.service.vision.v1.GetPropertiesResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.service.vision.v1.GetObjectPointCloudsRequest.serializeBinaryToWriter(this, writer);
+  proto.viam.service.vision.v1.GetPropertiesResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2681,142 +7016,255 @@ proto.viam.service.vision.v1.GetObjectPointCloudsRequest.prototype.serializeBina
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.service.vision.v1.GetObjectPointCloudsRequest} message
+ * @param {!proto.viam.service.vision.v1.GetPropertiesResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.vision.v1.GetObjectPointCloudsRequest.serializeBinaryToWriter = function(message, writer) {
+proto.viam.service.vision.v1.GetPropertiesResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getDetectionsSupported();
+  if (f) {
+    writer.writeBool(
       1,
       f
     );
   }
-  f = message.getCameraName();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getClassificationsSupported();
+  if (f) {
+    writer.writeBool(
       2,
       f
     );
   }
-  f = message.getMimeType();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getObjectPointCloudsSupported();
+  if (f) {
+    writer.writeBool(
       3,
       f
     );
   }
-  f = message.getExtra();
-  if (f != null) {
-    writer.writeMessage(
-      99,
+  f = message.getMasksSupported();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+  f = message.getKeypointsSupported();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+  f = message.getOrientedBoxesSupported();
+  if (f) {
+    writer.writeBool(
+      6,
+      f
+    );
+  }
+  f = message.getKeypointNamesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      7,
+      f
+    );
+  }
+  f = message.getSkeletonList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      8,
       f,
-      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+      proto.viam.service.vision.v1.KeypointEdge.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string name = 1;
- * @return {string}
+ * optional bool detections_supported = 1;
+ * @return {boolean}
  */
-proto.viam.service.vision.v1.GetObjectPointCloudsRequest.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.viam.service.vision.v1.GetPropertiesResponse.prototype.getDetectionsSupported = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.viam.service.vision.v1.GetObjectPointCloudsRequest} returns this
+ * @param {boolean} value
+ * @return {!proto.viam.service.vision.v1.GetPropertiesResponse} returns this
  */
-proto.viam.service.vision.v1.GetObjectPointCloudsRequest.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.viam.service.vision.v1.GetPropertiesResponse.prototype.setDetectionsSupported = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
 };
 
 
 /**
- * optional string camera_name = 2;
- * @return {string}
+ * optional bool classifications_supported = 2;
+ * @return {boolean}
  */
-proto.viam.service.vision.v1.GetObjectPointCloudsRequest.prototype.getCameraName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.viam.service.vision.v1.GetPropertiesResponse.prototype.getClassificationsSupported = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.viam.service.vision.v1.GetObjectPointCloudsRequest} returns this
+ * @param {boolean} value
+ * @return {!proto.viam.service.vision.v1.GetPropertiesResponse} returns this
  */
-proto.viam.service.vision.v1.GetObjectPointCloudsRequest.prototype.setCameraName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.viam.service.vision.v1.GetPropertiesResponse.prototype.setClassificationsSupported = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
 };
 
 
 /**
- * optional string mime_type = 3;
- * @return {string}
+ * optional bool object_point_clouds_supported = 3;
+ * @return {boolean}
  */
-proto.viam.service.vision.v1.GetObjectPointCloudsRequest.prototype.getMimeType = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.viam.service.vision.v1.GetPropertiesResponse.prototype.getObjectPointCloudsSupported = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.viam.service.vision.v1.GetObjectPointCloudsRequest} returns this
+ * @param {boolean} value
+ * @return {!proto.viam.service.vision.v1.GetPropertiesResponse} returns this
  */
-proto.viam.service.vision.v1.GetObjectPointCloudsRequest.prototype.setMimeType = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.viam.service.vision.v1.GetPropertiesResponse.prototype.setObjectPointCloudsSupported = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
 };
 
 
 /**
- * optional google.protobuf.Struct extra = 99;
- * @return {?proto.google.protobuf.Struct}
+ * optional bool masks_supported = 4;
+ * @return {boolean}
  */
-proto.viam.service.vision.v1.GetObjectPointCloudsRequest.prototype.getExtra = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 99));
+proto.viam.service.vision.v1.GetPropertiesResponse.prototype.getMasksSupported = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
 };
 
 
 /**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.viam.service.vision.v1.GetObjectPointCloudsRequest} returns this
-*/
-proto.viam.service.vision.v1.GetObjectPointCloudsRequest.prototype.setExtra = function(value) {
-  return jspb.Message.setWrapperField(this, 99, value);
+ * @param {boolean} value
+ * @return {!proto.viam.service.vision.v1.GetPropertiesResponse} returns this
+ */
+proto.viam.service.vision.v1.GetPropertiesResponse.prototype.setMasksSupported = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.viam.service.vision.v1.GetObjectPointCloudsRequest} returns this
+ * optional bool keypoints_supported = 5;
+ * @return {boolean}
  */
-proto.viam.service.vision.v1.GetObjectPointCloudsRequest.prototype.clearExtra = function() {
-  return this.setExtra(undefined);
+proto.viam.service.vision.v1.GetPropertiesResponse.prototype.getKeypointsSupported = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
 };
 
 
 /**
- * Returns whether this field is set.
+ * @param {boolean} value
+ * @return {!proto.viam.service.vision.v1.GetPropertiesResponse} returns this
+ */
+proto.viam.service.vision.v1.GetPropertiesResponse.prototype.setKeypointsSupported = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
+};
+
+
+/**
+ * optional bool oriented_boxes_supported = 6;
  * @return {boolean}
  */
-proto.viam.service.vision.v1.GetObjectPointCloudsRequest.prototype.hasExtra = function() {
-  return jspb.Message.getField(this, 99) != null;
+proto.viam.service.vision.v1.GetPropertiesResponse.prototype.getOrientedBoxesSupported = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
 };
 
 
+/**
+ * @param {boolean} value
+ * @return {!proto.viam.service.vision.v1.GetPropertiesResponse} returns this
+ */
+proto.viam.service.vision.v1.GetPropertiesResponse.prototype.setOrientedBoxesSupported = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 6, value);
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * repeated string keypoint_names = 7;
+ * @return {!Array<string>}
  */
-proto.viam.service.vision.v1.GetObjectPointCloudsResponse.repeatedFields_ = [2];
+proto.viam.service.vision.v1.GetPropertiesResponse.prototype.getKeypointNamesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 7));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.viam.service.vision.v1.GetPropertiesResponse} returns this
+ */
+proto.viam.service.vision.v1.GetPropertiesResponse.prototype.setKeypointNamesList = function(value) {
+  return jspb.Message.setField(this, 7, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.viam.service.vision.v1.GetPropertiesResponse} returns this
+ */
+proto.viam.service.vision.v1.GetPropertiesResponse.prototype.addKeypointNames = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 7, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.service.vision.v1.GetPropertiesResponse} returns this
+ */
+proto.viam.service.vision.v1.GetPropertiesResponse.prototype.clearKeypointNamesList = function() {
+  return this.setKeypointNamesList([]);
+};
+
+
+/**
+ * repeated KeypointEdge skeleton = 8;
+ * @return {!Array<!proto.viam.service.vision.v1.KeypointEdge>}
+ */
+proto.viam.service.vision.v1.GetPropertiesResponse.prototype.getSkeletonList = function() {
+  return /** @type{!Array<!proto.viam.service.vision.v1.KeypointEdge>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.viam.service.vision.v1.KeypointEdge, 8));
+};
+
+
+/**
+ * @param {!Array<!proto.viam.service.vision.v1.KeypointEdge>} value
+ * @return {!proto.viam.service.vision.v1.GetPropertiesResponse} returns this
+*/
+proto.viam.service.vision.v1.GetPropertiesResponse.prototype.setSkeletonList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 8, value);
+};
+
+
+/**
+ * @param {!proto.viam.service.vision.v1.KeypointEdge=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.viam.service.vision.v1.KeypointEdge}
+ */
+proto.viam.service.vision.v1.GetPropertiesResponse.prototype.addSkeleton = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 8, opt_value, proto.viam.service.vision.v1.KeypointEdge, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.viam.service.vision.v1.GetPropertiesResponse} returns this
+ */
+proto.viam.service.vision.v1.GetPropertiesResponse.prototype.clearSkeletonList = function() {
+  return this.setSkeletonList([]);
+};
+
+
 
 
 
@@ -2833,8 +7281,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.viam.service.vision.v1.GetObjectPointCloudsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.viam.service.vision.v1.GetObjectPointCloudsResponse.toObject(opt_includeInstance, this);
+proto.viam.service.vision.v1.KeypointEdge.prototype.toObject = function(opt_includeInstance) {
+  return proto.viam.service.vision.v1.KeypointEdge.toObject(opt_includeInstance, this);
 };
 
 
@@ -2843,15 +7291,14 @@ proto.viam.service.vision.v1.GetObjectPointCloudsResponse.prototype.toObject = f
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.viam.service.vision.v1.GetObjectPointCloudsResponse} msg The msg instance to transform.
+ * @param {!proto.viam.service.vision.v1.KeypointEdge} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.vision.v1.GetObjectPointCloudsResponse.toObject = function(includeInstance, msg) {
+proto.viam.service.vision.v1.KeypointEdge.toObject = function(includeInstance, msg) {
   var f, obj = {
-    mimeType: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    objectsList: jspb.Message.toObjectList(msg.getObjectsList(),
-    common_v1_common_pb.PointCloudObject.toObject, includeInstance)
+    fromIndex: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    toIndex: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -2865,23 +7312,23 @@ proto.viam.service.vision.v1.GetObjectPointCloudsResponse.toObject = function(in
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.viam.service.vision.v1.GetObjectPointCloudsResponse}
+ * @return {!proto.viam.service.vision.v1.KeypointEdge}
  */
-proto.viam.service.vision.v1.GetObjectPointCloudsResponse.deserializeBinary = function(bytes) {
+proto.viam.service.vision.v1.KeypointEdge.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.viam.service.vision.v1.GetObjectPointCloudsResponse;
-  return proto.viam.service.vision.v1.GetObjectPointCloudsResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.viam.service.vision.v1.KeypointEdge;
+  return proto.viam.service.vision.v1.KeypointEdge.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.viam.service.vision.v1.GetObjectPointCloudsResponse} msg The message object to deserialize into.
+ * @param {!proto.viam.service.vision.v1.KeypointEdge} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.viam.service.vision.v1.GetObjectPointCloudsResponse}
+ * @return {!proto.viam.service.vision.v1.KeypointEdge}
  */
-proto.viam.service.vision.v1.GetObjectPointCloudsResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.viam.service.vision.v1.KeypointEdge.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2889,13 +7336,12 @@ proto.viam.service.vision.v1.GetObjectPointCloudsResponse.deserializeBinaryFromR
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setMimeType(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setFromIndex(value);
       break;
     case 2:
-      var value = new common_v1_common_pb.PointCloudObject;
-      reader.readMessage(value,common_v1_common_pb.PointCloudObject.deserializeBinaryFromReader);
-      msg.addObjects(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setToIndex(value);
       break;
     default:
       reader.skipField();
@@ -2910,9 +7356,9 @@ proto.viam.service.vision.v1.GetObjectPointCloudsResponse.deserializeBinaryFromR
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.viam.service.vision.v1.GetObjectPointCloudsResponse.prototype.serializeBinary = function() {
+proto.viam.service.vision.v1.KeypointEdge.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.viam.service.vision.v1.GetObjectPointCloudsResponse.serializeBinaryToWriter(this, writer);
+  proto.viam.service.vision.v1.KeypointEdge.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2920,84 +7366,72 @@ proto.viam.service.vision.v1.GetObjectPointCloudsResponse.prototype.serializeBin
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.viam.service.vision.v1.GetObjectPointCloudsResponse} message
+ * @param {!proto.viam.service.vision.v1.KeypointEdge} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.viam.service.vision.v1.GetObjectPointCloudsResponse.serializeBinaryToWriter = function(message, writer) {
+proto.viam.service.vision.v1.KeypointEdge.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getMimeType();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getFromIndex();
+  if (f !== 0) {
+    writer.writeUint32(
       1,
       f
     );
   }
-  f = message.getObjectsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = message.getToIndex();
+  if (f !== 0) {
+    writer.writeUint32(
       2,
-      f,
-      common_v1_common_pb.PointCloudObject.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * optional string mime_type = 1;
- * @return {string}
+ * optional uint32 from_index = 1;
+ * @return {number}
  */
-proto.viam.service.vision.v1.GetObjectPointCloudsResponse.prototype.getMimeType = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.viam.service.vision.v1.KeypointEdge.prototype.getFromIndex = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.viam.service.vision.v1.GetObjectPointCloudsResponse} returns this
+ * @param {number} value
+ * @return {!proto.viam.service.vision.v1.KeypointEdge} returns this
  */
-proto.viam.service.vision.v1.GetObjectPointCloudsResponse.prototype.setMimeType = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.viam.service.vision.v1.KeypointEdge.prototype.setFromIndex = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * repeated viam.common.v1.PointCloudObject objects = 2;
- * @return {!Array<!proto.viam.common.v1.PointCloudObject>}
+ * optional uint32 to_index = 2;
+ * @return {number}
  */
-proto.viam.service.vision.v1.GetObjectPointCloudsResponse.prototype.getObjectsList = function() {
-  return /** @type{!Array<!proto.viam.common.v1.PointCloudObject>} */ (
-    jspb.Message.getRepeatedWrapperField(this, common_v1_common_pb.PointCloudObject, 2));
-};
-
-
-/**
- * @param {!Array<!proto.viam.common.v1.PointCloudObject>} value
- * @return {!proto.viam.service.vision.v1.GetObjectPointCloudsResponse} returns this
-*/
-proto.viam.service.vision.v1.GetObjectPointCloudsResponse.prototype.setObjectsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+proto.viam.service.vision.v1.KeypointEdge.prototype.getToIndex = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {!proto.viam.common.v1.PointCloudObject=} opt_value
- * @param {number=} opt_index
- * @return {!proto.viam.common.v1.PointCloudObject}
+ * @param {number} value
+ * @return {!proto.viam.service.vision.v1.KeypointEdge} returns this
  */
-proto.viam.service.vision.v1.GetObjectPointCloudsResponse.prototype.addObjects = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.viam.common.v1.PointCloudObject, opt_index);
+proto.viam.service.vision.v1.KeypointEdge.prototype.setToIndex = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.viam.service.vision.v1.GetObjectPointCloudsResponse} returns this
+ * @enum {number}
  */
-proto.viam.service.vision.v1.GetObjectPointCloudsResponse.prototype.clearObjectsList = function() {
-  return this.setObjectsList([]);
+proto.viam.service.vision.v1.TrackEventType = {
+  TRACK_EVENT_TYPE_UNSPECIFIED: 0,
+  TRACK_EVENT_TYPE_ENTER: 1,
+  TRACK_EVENT_TYPE_EXIT: 2
 };
 
-
 goog.object.extend(exports, proto.viam.service.vision.v1);
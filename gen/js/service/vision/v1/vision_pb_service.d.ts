@@ -14,6 +14,15 @@ type VisionServiceGetDetectionsFromCamera = {
   readonly responseType: typeof service_vision_v1_vision_pb.GetDetectionsFromCameraResponse;
 };
 
+type VisionServiceTrackObjectsFromCamera = {
+  readonly methodName: string;
+  readonly service: typeof VisionService;
+  readonly requestStream: false;
+  readonly responseStream: true;
+  readonly requestType: typeof service_vision_v1_vision_pb.TrackObjectsFromCameraRequest;
+  readonly responseType: typeof service_vision_v1_vision_pb.TrackObjectsFromCameraResponse;
+};
+
 type VisionServiceGetDetections = {
   readonly methodName: string;
   readonly service: typeof VisionService;
@@ -50,6 +59,24 @@ type VisionServiceGetObjectPointClouds = {
   readonly responseType: typeof service_vision_v1_vision_pb.GetObjectPointCloudsResponse;
 };
 
+type VisionServiceCaptureAllFromCamera = {
+  readonly methodName: string;
+  readonly service: typeof VisionService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof service_vision_v1_vision_pb.CaptureAllFromCameraRequest;
+  readonly responseType: typeof service_vision_v1_vision_pb.CaptureAllFromCameraResponse;
+};
+
+type VisionServiceGetProperties = {
+  readonly methodName: string;
+  readonly service: typeof VisionService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof service_vision_v1_vision_pb.GetPropertiesRequest;
+  readonly responseType: typeof service_vision_v1_vision_pb.GetPropertiesResponse;
+};
+
 type VisionServiceDoCommand = {
   readonly methodName: string;
   readonly service: typeof VisionService;
@@ -62,10 +89,13 @@ type VisionServiceDoCommand = {
 export class VisionService {
   static readonly serviceName: string;
   static readonly GetDetectionsFromCamera: VisionServiceGetDetectionsFromCamera;
+  static readonly TrackObjectsFromCamera: VisionServiceTrackObjectsFromCamera;
   static readonly GetDetections: VisionServiceGetDetections;
   static readonly GetClassificationsFromCamera: VisionServiceGetClassificationsFromCamera;
   static readonly GetClassifications: VisionServiceGetClassifications;
   static readonly GetObjectPointClouds: VisionServiceGetObjectPointClouds;
+  static readonly CaptureAllFromCamera: VisionServiceCaptureAllFromCamera;
+  static readonly GetProperties: VisionServiceGetProperties;
   static readonly DoCommand: VisionServiceDoCommand;
 }
 
@@ -110,6 +140,7 @@ export class VisionServiceClient {
     requestMessage: service_vision_v1_vision_pb.GetDetectionsFromCameraRequest,
     callback: (error: ServiceError|null, responseMessage: service_vision_v1_vision_pb.GetDetectionsFromCameraResponse|null) => void
   ): UnaryResponse;
+  trackObjectsFromCamera(requestMessage: service_vision_v1_vision_pb.TrackObjectsFromCameraRequest, metadata?: grpc.Metadata): ResponseStream<service_vision_v1_vision_pb.TrackObjectsFromCameraResponse>;
   getDetections(
     requestMessage: service_vision_v1_vision_pb.GetDetectionsRequest,
     metadata: grpc.Metadata,
@@ -146,6 +177,24 @@ export class VisionServiceClient {
     requestMessage: service_vision_v1_vision_pb.GetObjectPointCloudsRequest,
     callback: (error: ServiceError|null, responseMessage: service_vision_v1_vision_pb.GetObjectPointCloudsResponse|null) => void
   ): UnaryResponse;
+  captureAllFromCamera(
+    requestMessage: service_vision_v1_vision_pb.CaptureAllFromCameraRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: service_vision_v1_vision_pb.CaptureAllFromCameraResponse|null) => void
+  ): UnaryResponse;
+  captureAllFromCamera(
+    requestMessage: service_vision_v1_vision_pb.CaptureAllFromCameraRequest,
+    callback: (error: ServiceError|null, responseMessage: service_vision_v1_vision_pb.CaptureAllFromCameraResponse|null) => void
+  ): UnaryResponse;
+  getProperties(
+    requestMessage: service_vision_v1_vision_pb.GetPropertiesRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: service_vision_v1_vision_pb.GetPropertiesResponse|null) => void
+  ): UnaryResponse;
+  getProperties(
+    requestMessage: service_vision_v1_vision_pb.GetPropertiesRequest,
+    callback: (error: ServiceError|null, responseMessage: service_vision_v1_vision_pb.GetPropertiesResponse|null) => void
+  ): UnaryResponse;
   doCommand(
     requestMessage: common_v1_common_pb.DoCommandRequest,
     metadata: grpc.Metadata,
@@ -20,6 +20,15 @@ VisionService.GetDetectionsFromCamera = {
   responseType: service_vision_v1_vision_pb.GetDetectionsFromCameraResponse
 };
 
+VisionService.TrackObjectsFromCamera = {
+  methodName: "TrackObjectsFromCamera",
+  service: VisionService,
+  requestStream: false,
+  responseStream: true,
+  requestType: service_vision_v1_vision_pb.TrackObjectsFromCameraRequest,
+  responseType: service_vision_v1_vision_pb.TrackObjectsFromCameraResponse
+};
+
 VisionService.GetDetections = {
   methodName: "GetDetections",
   service: VisionService,
@@ -56,6 +65,24 @@ VisionService.GetObjectPointClouds = {
   responseType: service_vision_v1_vision_pb.GetObjectPointCloudsResponse
 };
 
+VisionService.CaptureAllFromCamera = {
+  methodName: "CaptureAllFromCamera",
+  service: VisionService,
+  requestStream: false,
+  responseStream: false,
+  requestType: service_vision_v1_vision_pb.CaptureAllFromCameraRequest,
+  responseType: service_vision_v1_vision_pb.CaptureAllFromCameraResponse
+};
+
+VisionService.GetProperties = {
+  methodName: "GetProperties",
+  service: VisionService,
+  requestStream: false,
+  responseStream: false,
+  requestType: service_vision_v1_vision_pb.GetPropertiesRequest,
+  responseType: service_vision_v1_vision_pb.GetPropertiesResponse
+};
+
 VisionService.DoCommand = {
   methodName: "DoCommand",
   service: VisionService,
@@ -103,6 +130,45 @@ VisionServiceClient.prototype.getDetectionsFromCamera = function getDetectionsFr
   };
 };
 
+VisionServiceClient.prototype.trackObjectsFromCamera = function trackObjectsFromCamera(requestMessage, metadata) {
+  var listeners = {
+    data: [],
+    end: [],
+    status: []
+  };
+  var client = grpc.invoke(VisionService.TrackObjectsFromCamera, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onMessage: function (responseMessage) {
+      listeners.data.forEach(function (handler) {
+        handler(responseMessage);
+      });
+    },
+    onEnd: function (status, statusMessage, trailers) {
+      listeners.status.forEach(function (handler) {
+        handler({ code: status, details: statusMessage, metadata: trailers });
+      });
+      listeners.end.forEach(function (handler) {
+        handler({ code: status, details: statusMessage, metadata: trailers });
+      });
+      listeners = null;
+    }
+  });
+  return {
+    on: function (type, handler) {
+      listeners[type].push(handler);
+      return this;
+    },
+    cancel: function () {
+      listeners = null;
+      client.close();
+    }
+  };
+};
+
 VisionServiceClient.prototype.getDetections = function getDetections(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
@@ -227,6 +293,68 @@ VisionServiceClient.prototype.getObjectPointClouds = function getObjectPointClou
   };
 };
 
+VisionServiceClient.prototype.captureAllFromCamera = function captureAllFromCamera(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(VisionService.CaptureAllFromCamera, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+VisionServiceClient.prototype.getProperties = function getProperties(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(VisionService.GetProperties, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
 VisionServiceClient.prototype.doCommand = function doCommand(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
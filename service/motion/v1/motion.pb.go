@@ -12,6 +12,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -23,6 +24,136 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ExecutionState int32
+
+const (
+	ExecutionState_EXECUTION_STATE_UNSPECIFIED ExecutionState = 0
+	// The motion is being planned
+	ExecutionState_EXECUTION_STATE_PLANNING ExecutionState = 1
+	// The motion is in progress
+	ExecutionState_EXECUTION_STATE_IN_PROGRESS ExecutionState = 2
+	// The motion was stopped by StopExecution, CancelOperation or a new motion on the same component
+	ExecutionState_EXECUTION_STATE_STOPPED ExecutionState = 3
+	// The component reached its destination
+	ExecutionState_EXECUTION_STATE_SUCCEEDED ExecutionState = 4
+	// The motion could not be planned or completed
+	ExecutionState_EXECUTION_STATE_FAILED ExecutionState = 5
+)
+
+// Enum value maps for ExecutionState.
+var (
+	ExecutionState_name = map[int32]string{
+		0: "EXECUTION_STATE_UNSPECIFIED",
+		1: "EXECUTION_STATE_PLANNING",
+		2: "EXECUTION_STATE_IN_PROGRESS",
+		3: "EXECUTION_STATE_STOPPED",
+		4: "EXECUTION_STATE_SUCCEEDED",
+		5: "EXECUTION_STATE_FAILED",
+	}
+	ExecutionState_value = map[string]int32{
+		"EXECUTION_STATE_UNSPECIFIED": 0,
+		"EXECUTION_STATE_PLANNING":    1,
+		"EXECUTION_STATE_IN_PROGRESS": 2,
+		"EXECUTION_STATE_STOPPED":     3,
+		"EXECUTION_STATE_SUCCEEDED":   4,
+		"EXECUTION_STATE_FAILED":      5,
+	}
+)
+
+func (x ExecutionState) Enum() *ExecutionState {
+	p := new(ExecutionState)
+	*p = x
+	return p
+}
+
+func (x ExecutionState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExecutionState) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_motion_v1_motion_proto_enumTypes[0].Descriptor()
+}
+
+func (ExecutionState) Type() protoreflect.EnumType {
+	return &file_service_motion_v1_motion_proto_enumTypes[0]
+}
+
+func (x ExecutionState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExecutionState.Descriptor instead.
+func (ExecutionState) EnumDescriptor() ([]byte, []int) {
+	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{0}
+}
+
+type PlanningFailureReason int32
+
+const (
+	PlanningFailureReason_PLANNING_FAILURE_REASON_UNSPECIFIED PlanningFailureReason = 0
+	// No path to the destination exists
+	PlanningFailureReason_PLANNING_FAILURE_REASON_GOAL_UNREACHABLE PlanningFailureReason = 1
+	// The robot is already in collision at its starting position
+	PlanningFailureReason_PLANNING_FAILURE_REASON_COLLISION_AT_START PlanningFailureReason = 2
+	// The robot would be in collision at its destination
+	PlanningFailureReason_PLANNING_FAILURE_REASON_COLLISION_AT_GOAL PlanningFailureReason = 3
+	// No path to the destination satisfies the requested constraints
+	PlanningFailureReason_PLANNING_FAILURE_REASON_CONSTRAINT_INFEASIBLE PlanningFailureReason = 4
+	// Planning did not finish within the allotted time
+	PlanningFailureReason_PLANNING_FAILURE_REASON_TIMEOUT PlanningFailureReason = 5
+	// No inverse kinematics solution exists for the destination
+	PlanningFailureReason_PLANNING_FAILURE_REASON_IK_FAILURE PlanningFailureReason = 6
+)
+
+// Enum value maps for PlanningFailureReason.
+var (
+	PlanningFailureReason_name = map[int32]string{
+		0: "PLANNING_FAILURE_REASON_UNSPECIFIED",
+		1: "PLANNING_FAILURE_REASON_GOAL_UNREACHABLE",
+		2: "PLANNING_FAILURE_REASON_COLLISION_AT_START",
+		3: "PLANNING_FAILURE_REASON_COLLISION_AT_GOAL",
+		4: "PLANNING_FAILURE_REASON_CONSTRAINT_INFEASIBLE",
+		5: "PLANNING_FAILURE_REASON_TIMEOUT",
+		6: "PLANNING_FAILURE_REASON_IK_FAILURE",
+	}
+	PlanningFailureReason_value = map[string]int32{
+		"PLANNING_FAILURE_REASON_UNSPECIFIED":           0,
+		"PLANNING_FAILURE_REASON_GOAL_UNREACHABLE":      1,
+		"PLANNING_FAILURE_REASON_COLLISION_AT_START":    2,
+		"PLANNING_FAILURE_REASON_COLLISION_AT_GOAL":     3,
+		"PLANNING_FAILURE_REASON_CONSTRAINT_INFEASIBLE": 4,
+		"PLANNING_FAILURE_REASON_TIMEOUT":               5,
+		"PLANNING_FAILURE_REASON_IK_FAILURE":            6,
+	}
+)
+
+func (x PlanningFailureReason) Enum() *PlanningFailureReason {
+	p := new(PlanningFailureReason)
+	*p = x
+	return p
+}
+
+func (x PlanningFailureReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlanningFailureReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_motion_v1_motion_proto_enumTypes[1].Descriptor()
+}
+
+func (PlanningFailureReason) Type() protoreflect.EnumType {
+	return &file_service_motion_v1_motion_proto_enumTypes[1]
+}
+
+func (x PlanningFailureReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlanningFailureReason.Descriptor instead.
+func (PlanningFailureReason) EnumDescriptor() ([]byte, []int) {
+	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{1}
+}
+
 // Moves any component on the robot to a specified destination which can be from the reference frame of any other component on the robot.
 type MoveRequest struct {
 	state         protoimpl.MessageState
@@ -40,6 +171,10 @@ type MoveRequest struct {
 	WorldState *v1.WorldState `protobuf:"bytes,4,opt,name=world_state,json=worldState,proto3,oneof" json:"world_state,omitempty"`
 	// Constrain the way the robot will move
 	Constraints *Constraints `protobuf:"bytes,5,opt,name=constraints,proto3,oneof" json:"constraints,omitempty"`
+	// If true, return as soon as the execution has been registered, before planning starts, rather than blocking until
+	// the motion completes. The returned execution_id can be used to monitor planning and execution with
+	// GetExecutionStatus, or to stop the motion
+	ReturnExecutionId bool `protobuf:"varint,6,opt,name=return_execution_id,json=returnExecutionId,proto3" json:"return_execution_id,omitempty"`
 	// Additional arguments to the method
 	Extra *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
 }
@@ -111,6 +246,13 @@ func (x *MoveRequest) GetConstraints() *Constraints {
 	return nil
 }
 
+func (x *MoveRequest) GetReturnExecutionId() bool {
+	if x != nil {
+		return x.ReturnExecutionId
+	}
+	return false
+}
+
 func (x *MoveRequest) GetExtra() *structpb.Struct {
 	if x != nil {
 		return x.Extra
@@ -123,7 +265,14 @@ type MoveResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// Whether the component reached its destination
+	// If return_execution_id was requested, whether the execution was registered; its outcome is reported by GetExecutionStatus
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when return_execution_id was requested
+	// This is the id of the robot Operation executing the motion, so it may also be cancelled with CancelOperation
+	ExecutionId string `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	// Set when success is false because no plan to the destination could be found
+	PlanningFailure *PlanningFailure `protobuf:"bytes,3,opt,name=planning_failure,json=planningFailure,proto3,oneof" json:"planning_failure,omitempty"`
 }
 
 func (x *MoveResponse) Reset() {
@@ -165,6 +314,168 @@ func (x *MoveResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *MoveResponse) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+func (x *MoveResponse) GetPlanningFailure() *PlanningFailure {
+	if x != nil {
+		return x.PlanningFailure
+	}
+	return nil
+}
+
+// Plans the motion of any component on the robot to a specified destination without executing it
+// The fields have the same meaning as in MoveRequest
+type PlanMoveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name          string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Destination   *v1.PoseInFrame  `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	ComponentName *v1.ResourceName `protobuf:"bytes,3,opt,name=component_name,json=componentName,proto3" json:"component_name,omitempty"`
+	// Transforms added to the frame system only apply for the duration of planning
+	WorldState  *v1.WorldState `protobuf:"bytes,4,opt,name=world_state,json=worldState,proto3,oneof" json:"world_state,omitempty"`
+	Constraints *Constraints   `protobuf:"bytes,5,opt,name=constraints,proto3,oneof" json:"constraints,omitempty"`
+	// Additional arguments to the method
+	Extra *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
+}
+
+func (x *PlanMoveRequest) Reset() {
+	*x = PlanMoveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_motion_v1_motion_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanMoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanMoveRequest) ProtoMessage() {}
+
+func (x *PlanMoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_motion_v1_motion_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanMoveRequest.ProtoReflect.Descriptor instead.
+func (*PlanMoveRequest) Descriptor() ([]byte, []int) {
+	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PlanMoveRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlanMoveRequest) GetDestination() *v1.PoseInFrame {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *PlanMoveRequest) GetComponentName() *v1.ResourceName {
+	if x != nil {
+		return x.ComponentName
+	}
+	return nil
+}
+
+func (x *PlanMoveRequest) GetWorldState() *v1.WorldState {
+	if x != nil {
+		return x.WorldState
+	}
+	return nil
+}
+
+func (x *PlanMoveRequest) GetConstraints() *Constraints {
+	if x != nil {
+		return x.Constraints
+	}
+	return nil
+}
+
+func (x *PlanMoveRequest) GetExtra() *structpb.Struct {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+type PlanMoveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The planned motion. Its execution_id is unset as the plan is not executed
+	Plan *Plan `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	// Set when no plan to the destination could be found
+	PlanningFailure *PlanningFailure `protobuf:"bytes,2,opt,name=planning_failure,json=planningFailure,proto3,oneof" json:"planning_failure,omitempty"`
+}
+
+func (x *PlanMoveResponse) Reset() {
+	*x = PlanMoveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_motion_v1_motion_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanMoveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanMoveResponse) ProtoMessage() {}
+
+func (x *PlanMoveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_motion_v1_motion_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanMoveResponse.ProtoReflect.Descriptor instead.
+func (*PlanMoveResponse) Descriptor() ([]byte, []int) {
+	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PlanMoveResponse) GetPlan() *Plan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+func (x *PlanMoveResponse) GetPlanningFailure() *PlanningFailure {
+	if x != nil {
+		return x.PlanningFailure
+	}
+	return nil
+}
+
 type MoveOnMapRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -178,6 +489,10 @@ type MoveOnMapRequest struct {
 	ComponentName *v1.ResourceName `protobuf:"bytes,3,opt,name=component_name,json=componentName,proto3" json:"component_name,omitempty"`
 	// Name of the slam service from which the SLAM map is requested
 	SlamServiceName *v1.ResourceName `protobuf:"bytes,4,opt,name=slam_service_name,json=slamServiceName,proto3" json:"slam_service_name,omitempty"`
+	// If true, return as soon as the execution has been registered, before planning starts, rather than blocking until
+	// the motion completes. The returned execution_id can be used to monitor planning and execution with
+	// GetExecutionStatus, or to stop the motion
+	ReturnExecutionId bool `protobuf:"varint,5,opt,name=return_execution_id,json=returnExecutionId,proto3" json:"return_execution_id,omitempty"`
 	// Additional arguments to the method
 	Extra *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
 }
@@ -185,7 +500,7 @@ type MoveOnMapRequest struct {
 func (x *MoveOnMapRequest) Reset() {
 	*x = MoveOnMapRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_motion_v1_motion_proto_msgTypes[2]
+		mi := &file_service_motion_v1_motion_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -198,7 +513,7 @@ func (x *MoveOnMapRequest) String() string {
 func (*MoveOnMapRequest) ProtoMessage() {}
 
 func (x *MoveOnMapRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_motion_v1_motion_proto_msgTypes[2]
+	mi := &file_service_motion_v1_motion_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -211,7 +526,7 @@ func (x *MoveOnMapRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MoveOnMapRequest.ProtoReflect.Descriptor instead.
 func (*MoveOnMapRequest) Descriptor() ([]byte, []int) {
-	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{2}
+	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *MoveOnMapRequest) GetName() string {
@@ -242,6 +557,13 @@ func (x *MoveOnMapRequest) GetSlamServiceName() *v1.ResourceName {
 	return nil
 }
 
+func (x *MoveOnMapRequest) GetReturnExecutionId() bool {
+	if x != nil {
+		return x.ReturnExecutionId
+	}
+	return false
+}
+
 func (x *MoveOnMapRequest) GetExtra() *structpb.Struct {
 	if x != nil {
 		return x.Extra
@@ -254,13 +576,20 @@ type MoveOnMapResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// Whether the component reached its destination
+	// If return_execution_id was requested, whether the execution was registered; its outcome is reported by GetExecutionStatus
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when return_execution_id was requested
+	// This is the id of the robot Operation executing the motion, so it may also be cancelled with CancelOperation
+	ExecutionId string `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	// Set when success is false because no plan to the destination could be found
+	PlanningFailure *PlanningFailure `protobuf:"bytes,3,opt,name=planning_failure,json=planningFailure,proto3,oneof" json:"planning_failure,omitempty"`
 }
 
 func (x *MoveOnMapResponse) Reset() {
 	*x = MoveOnMapResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_motion_v1_motion_proto_msgTypes[3]
+		mi := &file_service_motion_v1_motion_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -273,7 +602,7 @@ func (x *MoveOnMapResponse) String() string {
 func (*MoveOnMapResponse) ProtoMessage() {}
 
 func (x *MoveOnMapResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_motion_v1_motion_proto_msgTypes[3]
+	mi := &file_service_motion_v1_motion_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -286,7 +615,7 @@ func (x *MoveOnMapResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MoveOnMapResponse.ProtoReflect.Descriptor instead.
 func (*MoveOnMapResponse) Descriptor() ([]byte, []int) {
-	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{3}
+	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *MoveOnMapResponse) GetSuccess() bool {
@@ -296,6 +625,20 @@ func (x *MoveOnMapResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *MoveOnMapResponse) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+func (x *MoveOnMapResponse) GetPlanningFailure() *PlanningFailure {
+	if x != nil {
+		return x.PlanningFailure
+	}
+	return nil
+}
+
 type MotionConfiguration struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -318,7 +661,7 @@ type MotionConfiguration struct {
 func (x *MotionConfiguration) Reset() {
 	*x = MotionConfiguration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_motion_v1_motion_proto_msgTypes[4]
+		mi := &file_service_motion_v1_motion_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -331,7 +674,7 @@ func (x *MotionConfiguration) String() string {
 func (*MotionConfiguration) ProtoMessage() {}
 
 func (x *MotionConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_service_motion_v1_motion_proto_msgTypes[4]
+	mi := &file_service_motion_v1_motion_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -344,7 +687,7 @@ func (x *MotionConfiguration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MotionConfiguration.ProtoReflect.Descriptor instead.
 func (*MotionConfiguration) Descriptor() ([]byte, []int) {
-	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{4}
+	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *MotionConfiguration) GetVisionServices() []*v1.ResourceName {
@@ -408,6 +751,10 @@ type MoveOnGlobeRequest struct {
 	Obstacles []*v1.GeoObstacle `protobuf:"bytes,6,rep,name=obstacles,proto3" json:"obstacles,omitempty"`
 	// Optional set of motion configuration options
 	MotionConfiguration *MotionConfiguration `protobuf:"bytes,7,opt,name=motion_configuration,json=motionConfiguration,proto3,oneof" json:"motion_configuration,omitempty"`
+	// If true, return as soon as the execution has been registered, before planning starts, rather than blocking until
+	// the motion completes. The returned execution_id can be used to monitor planning and execution with
+	// GetExecutionStatus, or to stop the motion
+	ReturnExecutionId bool `protobuf:"varint,8,opt,name=return_execution_id,json=returnExecutionId,proto3" json:"return_execution_id,omitempty"`
 	// Additional arguments to the method
 	Extra *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
 }
@@ -415,7 +762,7 @@ type MoveOnGlobeRequest struct {
 func (x *MoveOnGlobeRequest) Reset() {
 	*x = MoveOnGlobeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_motion_v1_motion_proto_msgTypes[5]
+		mi := &file_service_motion_v1_motion_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -428,7 +775,7 @@ func (x *MoveOnGlobeRequest) String() string {
 func (*MoveOnGlobeRequest) ProtoMessage() {}
 
 func (x *MoveOnGlobeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_motion_v1_motion_proto_msgTypes[5]
+	mi := &file_service_motion_v1_motion_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -441,7 +788,7 @@ func (x *MoveOnGlobeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MoveOnGlobeRequest.ProtoReflect.Descriptor instead.
 func (*MoveOnGlobeRequest) Descriptor() ([]byte, []int) {
-	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{5}
+	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *MoveOnGlobeRequest) GetName() string {
@@ -493,6 +840,13 @@ func (x *MoveOnGlobeRequest) GetMotionConfiguration() *MotionConfiguration {
 	return nil
 }
 
+func (x *MoveOnGlobeRequest) GetReturnExecutionId() bool {
+	if x != nil {
+		return x.ReturnExecutionId
+	}
+	return false
+}
+
 func (x *MoveOnGlobeRequest) GetExtra() *structpb.Struct {
 	if x != nil {
 		return x.Extra
@@ -505,13 +859,20 @@ type MoveOnGlobeResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// Whether the component reached its destination
+	// If return_execution_id was requested, whether the execution was registered; its outcome is reported by GetExecutionStatus
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when return_execution_id was requested
+	// This is the id of the robot Operation executing the motion, so it may also be cancelled with CancelOperation
+	ExecutionId string `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	// Set when success is false because no plan to the destination could be found
+	PlanningFailure *PlanningFailure `protobuf:"bytes,3,opt,name=planning_failure,json=planningFailure,proto3,oneof" json:"planning_failure,omitempty"`
 }
 
 func (x *MoveOnGlobeResponse) Reset() {
 	*x = MoveOnGlobeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_motion_v1_motion_proto_msgTypes[6]
+		mi := &file_service_motion_v1_motion_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -524,7 +885,7 @@ func (x *MoveOnGlobeResponse) String() string {
 func (*MoveOnGlobeResponse) ProtoMessage() {}
 
 func (x *MoveOnGlobeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_motion_v1_motion_proto_msgTypes[6]
+	mi := &file_service_motion_v1_motion_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -537,7 +898,7 @@ func (x *MoveOnGlobeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MoveOnGlobeResponse.ProtoReflect.Descriptor instead.
 func (*MoveOnGlobeResponse) Descriptor() ([]byte, []int) {
-	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{6}
+	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *MoveOnGlobeResponse) GetSuccess() bool {
@@ -547,6 +908,20 @@ func (x *MoveOnGlobeResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *MoveOnGlobeResponse) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+func (x *MoveOnGlobeResponse) GetPlanningFailure() *PlanningFailure {
+	if x != nil {
+		return x.PlanningFailure
+	}
+	return nil
+}
+
 type GetPoseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -569,7 +944,7 @@ type GetPoseRequest struct {
 func (x *GetPoseRequest) Reset() {
 	*x = GetPoseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_motion_v1_motion_proto_msgTypes[7]
+		mi := &file_service_motion_v1_motion_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -582,7 +957,7 @@ func (x *GetPoseRequest) String() string {
 func (*GetPoseRequest) ProtoMessage() {}
 
 func (x *GetPoseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_motion_v1_motion_proto_msgTypes[7]
+	mi := &file_service_motion_v1_motion_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -595,7 +970,7 @@ func (x *GetPoseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPoseRequest.ProtoReflect.Descriptor instead.
 func (*GetPoseRequest) Descriptor() ([]byte, []int) {
-	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{7}
+	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetPoseRequest) GetName() string {
@@ -644,7 +1019,7 @@ type GetPoseResponse struct {
 func (x *GetPoseResponse) Reset() {
 	*x = GetPoseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_motion_v1_motion_proto_msgTypes[8]
+		mi := &file_service_motion_v1_motion_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -657,7 +1032,7 @@ func (x *GetPoseResponse) String() string {
 func (*GetPoseResponse) ProtoMessage() {}
 
 func (x *GetPoseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_motion_v1_motion_proto_msgTypes[8]
+	mi := &file_service_motion_v1_motion_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -670,7 +1045,7 @@ func (x *GetPoseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPoseResponse.ProtoReflect.Descriptor instead.
 func (*GetPoseResponse) Descriptor() ([]byte, []int) {
-	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{8}
+	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetPoseResponse) GetPose() *v1.PoseInFrame {
@@ -680,35 +1055,774 @@ func (x *GetPoseResponse) GetPose() *v1.PoseInFrame {
 	return nil
 }
 
-// Constraints specifies all enumerated constraints to be passed to Viam's motion planning, along with any optional parameters
-type Constraints struct {
+type GetPlanRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Typed message for a specific constraint
-	LinearConstraint       []*LinearConstraint       `protobuf:"bytes,1,rep,name=linear_constraint,json=linearConstraint,proto3" json:"linear_constraint,omitempty"`
-	OrientationConstraint  []*OrientationConstraint  `protobuf:"bytes,2,rep,name=orientation_constraint,json=orientationConstraint,proto3" json:"orientation_constraint,omitempty"`
-	CollisionSpecification []*CollisionSpecification `protobuf:"bytes,3,rep,name=collision_specification,json=collisionSpecification,proto3" json:"collision_specification,omitempty"` // Arc constraint, Time constraint, and others will be added here when they are supported
+	// Name of the motion service
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The execution to return the plan of
+	ExecutionId string `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	// If true, also return the plans which were replaced by replanning
+	IncludeReplans bool `protobuf:"varint,3,opt,name=include_replans,json=includeReplans,proto3" json:"include_replans,omitempty"`
+	// Additional arguments to the method
+	Extra *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
 }
 
-func (x *Constraints) Reset() {
-	*x = Constraints{}
+func (x *GetPlanRequest) Reset() {
+	*x = GetPlanRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_motion_v1_motion_proto_msgTypes[9]
+		mi := &file_service_motion_v1_motion_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Constraints) String() string {
+func (x *GetPlanRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Constraints) ProtoMessage() {}
+func (*GetPlanRequest) ProtoMessage() {}
 
-func (x *Constraints) ProtoReflect() protoreflect.Message {
-	mi := &file_service_motion_v1_motion_proto_msgTypes[9]
+func (x *GetPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_motion_v1_motion_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlanRequest.ProtoReflect.Descriptor instead.
+func (*GetPlanRequest) Descriptor() ([]byte, []int) {
+	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetPlanRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetPlanRequest) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+func (x *GetPlanRequest) GetIncludeReplans() bool {
+	if x != nil {
+		return x.IncludeReplans
+	}
+	return false
+}
+
+func (x *GetPlanRequest) GetExtra() *structpb.Struct {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+type GetPlanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The plan currently being executed, or the last plan executed if the execution has finished
+	CurrentPlan *Plan `protobuf:"bytes,1,opt,name=current_plan,json=currentPlan,proto3" json:"current_plan,omitempty"`
+	// Status of the execution
+	Status *ExecutionStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Plans which were replaced by replanning, ordered from oldest to newest
+	// Only set when include_replans is true
+	Replans []*Plan `protobuf:"bytes,3,rep,name=replans,proto3" json:"replans,omitempty"`
+}
+
+func (x *GetPlanResponse) Reset() {
+	*x = GetPlanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_motion_v1_motion_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlanResponse) ProtoMessage() {}
+
+func (x *GetPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_motion_v1_motion_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlanResponse.ProtoReflect.Descriptor instead.
+func (*GetPlanResponse) Descriptor() ([]byte, []int) {
+	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetPlanResponse) GetCurrentPlan() *Plan {
+	if x != nil {
+		return x.CurrentPlan
+	}
+	return nil
+}
+
+func (x *GetPlanResponse) GetStatus() *ExecutionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GetPlanResponse) GetReplans() []*Plan {
+	if x != nil {
+		return x.Replans
+	}
+	return nil
+}
+
+type GetExecutionStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the motion service
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The execution to return the status of
+	ExecutionId string `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	// Additional arguments to the method
+	Extra *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
+}
+
+func (x *GetExecutionStatusRequest) Reset() {
+	*x = GetExecutionStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_motion_v1_motion_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetExecutionStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExecutionStatusRequest) ProtoMessage() {}
+
+func (x *GetExecutionStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_motion_v1_motion_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExecutionStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetExecutionStatusRequest) Descriptor() ([]byte, []int) {
+	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetExecutionStatusRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetExecutionStatusRequest) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+func (x *GetExecutionStatusRequest) GetExtra() *structpb.Struct {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+type GetExecutionStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *ExecutionStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *GetExecutionStatusResponse) Reset() {
+	*x = GetExecutionStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_motion_v1_motion_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetExecutionStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExecutionStatusResponse) ProtoMessage() {}
+
+func (x *GetExecutionStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_motion_v1_motion_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExecutionStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetExecutionStatusResponse) Descriptor() ([]byte, []int) {
+	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetExecutionStatusResponse) GetStatus() *ExecutionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type StopExecutionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the motion service
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The execution to stop
+	ExecutionId string `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	// Additional arguments to the method
+	Extra *structpb.Struct `protobuf:"bytes,99,opt,name=extra,proto3" json:"extra,omitempty"`
+}
+
+func (x *StopExecutionRequest) Reset() {
+	*x = StopExecutionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_motion_v1_motion_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopExecutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopExecutionRequest) ProtoMessage() {}
+
+func (x *StopExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_motion_v1_motion_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopExecutionRequest.ProtoReflect.Descriptor instead.
+func (*StopExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *StopExecutionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StopExecutionRequest) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+func (x *StopExecutionRequest) GetExtra() *structpb.Struct {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+type StopExecutionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StopExecutionResponse) Reset() {
+	*x = StopExecutionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_motion_v1_motion_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopExecutionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopExecutionResponse) ProtoMessage() {}
+
+func (x *StopExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_motion_v1_motion_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopExecutionResponse.ProtoReflect.Descriptor instead.
+func (*StopExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{16}
+}
+
+// Plan describes the motion the motion service will perform to move a component to its destination
+type Plan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unique id of the plan
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The execution this plan belongs to
+	ExecutionId string `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	// Component on the robot being moved by the plan
+	ComponentName *v1.ResourceName `protobuf:"bytes,3,opt,name=component_name,json=componentName,proto3" json:"component_name,omitempty"`
+	// The ordered steps of the plan
+	Steps []*PlanStep `protobuf:"bytes,4,rep,name=steps,proto3" json:"steps,omitempty"`
+}
+
+func (x *Plan) Reset() {
+	*x = Plan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_motion_v1_motion_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Plan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Plan) ProtoMessage() {}
+
+func (x *Plan) ProtoReflect() protoreflect.Message {
+	mi := &file_service_motion_v1_motion_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Plan.ProtoReflect.Descriptor instead.
+func (*Plan) Descriptor() ([]byte, []int) {
+	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Plan) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Plan) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+func (x *Plan) GetComponentName() *v1.ResourceName {
+	if x != nil {
+		return x.ComponentName
+	}
+	return nil
+}
+
+func (x *Plan) GetSteps() []*PlanStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+// PlanStep describes the state of each component of the robot at one step of a plan
+type PlanStep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A map of component name to the state of that component at this step
+	Step map[string]*ComponentState `protobuf:"bytes,1,rep,name=step,proto3" json:"step,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *PlanStep) Reset() {
+	*x = PlanStep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_motion_v1_motion_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanStep) ProtoMessage() {}
+
+func (x *PlanStep) ProtoReflect() protoreflect.Message {
+	mi := &file_service_motion_v1_motion_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanStep.ProtoReflect.Descriptor instead.
+func (*PlanStep) Descriptor() ([]byte, []int) {
+	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PlanStep) GetStep() map[string]*ComponentState {
+	if x != nil {
+		return x.Step
+	}
+	return nil
+}
+
+type ComponentState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Pose of the component in the world frame
+	Pose *v1.Pose `protobuf:"bytes,1,opt,name=pose,proto3" json:"pose,omitempty"`
+	// Inputs of the component at this step, such as arm joint positions in degrees or gantry positions in mm
+	Inputs []float64 `protobuf:"fixed64,2,rep,packed,name=inputs,proto3" json:"inputs,omitempty"`
+}
+
+func (x *ComponentState) Reset() {
+	*x = ComponentState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_motion_v1_motion_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ComponentState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComponentState) ProtoMessage() {}
+
+func (x *ComponentState) ProtoReflect() protoreflect.Message {
+	mi := &file_service_motion_v1_motion_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComponentState.ProtoReflect.Descriptor instead.
+func (*ComponentState) Descriptor() ([]byte, []int) {
+	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ComponentState) GetPose() *v1.Pose {
+	if x != nil {
+		return x.Pose
+	}
+	return nil
+}
+
+func (x *ComponentState) GetInputs() []float64 {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+type ExecutionStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The execution this status belongs to
+	ExecutionId string `protobuf:"bytes,1,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	// Component on the robot being moved
+	ComponentName *v1.ResourceName `protobuf:"bytes,2,opt,name=component_name,json=componentName,proto3" json:"component_name,omitempty"`
+	State         ExecutionState   `protobuf:"varint,3,opt,name=state,proto3,enum=viam.service.motion.v1.ExecutionState" json:"state,omitempty"`
+	// Id of the plan currently being executed
+	PlanId string `protobuf:"bytes,4,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// Index into the steps of the current plan of the step being executed
+	CurrentStepIndex uint32 `protobuf:"varint,5,opt,name=current_step_index,json=currentStepIndex,proto3" json:"current_step_index,omitempty"`
+	// Number of times the motion has been replanned
+	ReplanCount uint32 `protobuf:"varint,6,opt,name=replan_count,json=replanCount,proto3" json:"replan_count,omitempty"`
+	// Reason for the last replan or, once the execution has finished, for it stopping or failing
+	Reason *string `protobuf:"bytes,7,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	// Time at which the execution entered its current state
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Set when the execution failed because no plan, or replan, to the destination could be found
+	PlanningFailure *PlanningFailure `protobuf:"bytes,9,opt,name=planning_failure,json=planningFailure,proto3,oneof" json:"planning_failure,omitempty"`
+}
+
+func (x *ExecutionStatus) Reset() {
+	*x = ExecutionStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_motion_v1_motion_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecutionStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionStatus) ProtoMessage() {}
+
+func (x *ExecutionStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_service_motion_v1_motion_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutionStatus.ProtoReflect.Descriptor instead.
+func (*ExecutionStatus) Descriptor() ([]byte, []int) {
+	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ExecutionStatus) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+func (x *ExecutionStatus) GetComponentName() *v1.ResourceName {
+	if x != nil {
+		return x.ComponentName
+	}
+	return nil
+}
+
+func (x *ExecutionStatus) GetState() ExecutionState {
+	if x != nil {
+		return x.State
+	}
+	return ExecutionState_EXECUTION_STATE_UNSPECIFIED
+}
+
+func (x *ExecutionStatus) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *ExecutionStatus) GetCurrentStepIndex() uint32 {
+	if x != nil {
+		return x.CurrentStepIndex
+	}
+	return 0
+}
+
+func (x *ExecutionStatus) GetReplanCount() uint32 {
+	if x != nil {
+		return x.ReplanCount
+	}
+	return 0
+}
+
+func (x *ExecutionStatus) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *ExecutionStatus) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *ExecutionStatus) GetPlanningFailure() *PlanningFailure {
+	if x != nil {
+		return x.PlanningFailure
+	}
+	return nil
+}
+
+// PlanningFailure describes why the motion service was unable to plan a motion
+type PlanningFailure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason PlanningFailureReason `protobuf:"varint,1,opt,name=reason,proto3,enum=viam.service.motion.v1.PlanningFailureReason" json:"reason,omitempty"`
+	// Human readable description of the failure
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Names of the frames involved in the failure, such as the frames in collision or the frame a constraint was violated on
+	Frames []string `protobuf:"bytes,3,rep,name=frames,proto3" json:"frames,omitempty"`
+	// Geometries involved in the failure, such as the obstacles in collision
+	Geometries []*v1.GeometriesInFrame `protobuf:"bytes,4,rep,name=geometries,proto3" json:"geometries,omitempty"`
+}
+
+func (x *PlanningFailure) Reset() {
+	*x = PlanningFailure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_motion_v1_motion_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanningFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanningFailure) ProtoMessage() {}
+
+func (x *PlanningFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_service_motion_v1_motion_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanningFailure.ProtoReflect.Descriptor instead.
+func (*PlanningFailure) Descriptor() ([]byte, []int) {
+	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PlanningFailure) GetReason() PlanningFailureReason {
+	if x != nil {
+		return x.Reason
+	}
+	return PlanningFailureReason_PLANNING_FAILURE_REASON_UNSPECIFIED
+}
+
+func (x *PlanningFailure) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PlanningFailure) GetFrames() []string {
+	if x != nil {
+		return x.Frames
+	}
+	return nil
+}
+
+func (x *PlanningFailure) GetGeometries() []*v1.GeometriesInFrame {
+	if x != nil {
+		return x.Geometries
+	}
+	return nil
+}
+
+// Constraints specifies all enumerated constraints to be passed to Viam's motion planning, along with any optional parameters
+type Constraints struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Typed message for a specific constraint
+	LinearConstraint           []*LinearConstraint           `protobuf:"bytes,1,rep,name=linear_constraint,json=linearConstraint,proto3" json:"linear_constraint,omitempty"`
+	OrientationConstraint      []*OrientationConstraint      `protobuf:"bytes,2,rep,name=orientation_constraint,json=orientationConstraint,proto3" json:"orientation_constraint,omitempty"`
+	CollisionSpecification     []*CollisionSpecification     `protobuf:"bytes,3,rep,name=collision_specification,json=collisionSpecification,proto3" json:"collision_specification,omitempty"`
+	PseudolinearConstraint     []*PseudolinearConstraint     `protobuf:"bytes,4,rep,name=pseudolinear_constraint,json=pseudolinearConstraint,proto3" json:"pseudolinear_constraint,omitempty"`
+	KeepOutZoneConstraint      []*KeepOutZoneConstraint      `protobuf:"bytes,5,rep,name=keep_out_zone_constraint,json=keepOutZoneConstraint,proto3" json:"keep_out_zone_constraint,omitempty"`
+	JointRangeConstraint       []*JointRangeConstraint       `protobuf:"bytes,6,rep,name=joint_range_constraint,json=jointRangeConstraint,proto3" json:"joint_range_constraint,omitempty"`
+	EndEffectorSpeedConstraint []*EndEffectorSpeedConstraint `protobuf:"bytes,7,rep,name=end_effector_speed_constraint,json=endEffectorSpeedConstraint,proto3" json:"end_effector_speed_constraint,omitempty"` // Arc constraint, Time constraint, and others will be added here when they are supported
+}
+
+func (x *Constraints) Reset() {
+	*x = Constraints{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_motion_v1_motion_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Constraints) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Constraints) ProtoMessage() {}
+
+func (x *Constraints) ProtoReflect() protoreflect.Message {
+	mi := &file_service_motion_v1_motion_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -721,7 +1835,7 @@ func (x *Constraints) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Constraints.ProtoReflect.Descriptor instead.
 func (*Constraints) Descriptor() ([]byte, []int) {
-	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{9}
+	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *Constraints) GetLinearConstraint() []*LinearConstraint {
@@ -745,6 +1859,34 @@ func (x *Constraints) GetCollisionSpecification() []*CollisionSpecification {
 	return nil
 }
 
+func (x *Constraints) GetPseudolinearConstraint() []*PseudolinearConstraint {
+	if x != nil {
+		return x.PseudolinearConstraint
+	}
+	return nil
+}
+
+func (x *Constraints) GetKeepOutZoneConstraint() []*KeepOutZoneConstraint {
+	if x != nil {
+		return x.KeepOutZoneConstraint
+	}
+	return nil
+}
+
+func (x *Constraints) GetJointRangeConstraint() []*JointRangeConstraint {
+	if x != nil {
+		return x.JointRangeConstraint
+	}
+	return nil
+}
+
+func (x *Constraints) GetEndEffectorSpeedConstraint() []*EndEffectorSpeedConstraint {
+	if x != nil {
+		return x.EndEffectorSpeedConstraint
+	}
+	return nil
+}
+
 // LinearConstraint specifies that the component being moved should move linearly relative to its goal.
 // It does not constrain the motion of components other than the `component_name` specified in motion.Move
 type LinearConstraint struct {
@@ -760,7 +1902,7 @@ type LinearConstraint struct {
 func (x *LinearConstraint) Reset() {
 	*x = LinearConstraint{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_motion_v1_motion_proto_msgTypes[10]
+		mi := &file_service_motion_v1_motion_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -773,7 +1915,7 @@ func (x *LinearConstraint) String() string {
 func (*LinearConstraint) ProtoMessage() {}
 
 func (x *LinearConstraint) ProtoReflect() protoreflect.Message {
-	mi := &file_service_motion_v1_motion_proto_msgTypes[10]
+	mi := &file_service_motion_v1_motion_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -786,7 +1928,7 @@ func (x *LinearConstraint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LinearConstraint.ProtoReflect.Descriptor instead.
 func (*LinearConstraint) Descriptor() ([]byte, []int) {
-	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{10}
+	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *LinearConstraint) GetLineToleranceMm() float32 {
@@ -817,7 +1959,7 @@ type OrientationConstraint struct {
 func (x *OrientationConstraint) Reset() {
 	*x = OrientationConstraint{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_motion_v1_motion_proto_msgTypes[11]
+		mi := &file_service_motion_v1_motion_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -830,7 +1972,7 @@ func (x *OrientationConstraint) String() string {
 func (*OrientationConstraint) ProtoMessage() {}
 
 func (x *OrientationConstraint) ProtoReflect() protoreflect.Message {
-	mi := &file_service_motion_v1_motion_proto_msgTypes[11]
+	mi := &file_service_motion_v1_motion_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -843,7 +1985,7 @@ func (x *OrientationConstraint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrientationConstraint.ProtoReflect.Descriptor instead.
 func (*OrientationConstraint) Descriptor() ([]byte, []int) {
-	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{11}
+	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *OrientationConstraint) GetOrientationToleranceDegs() float32 {
@@ -866,20 +2008,306 @@ type CollisionSpecification struct {
 func (x *CollisionSpecification) Reset() {
 	*x = CollisionSpecification{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_motion_v1_motion_proto_msgTypes[12]
+		mi := &file_service_motion_v1_motion_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollisionSpecification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollisionSpecification) ProtoMessage() {}
+
+func (x *CollisionSpecification) ProtoReflect() protoreflect.Message {
+	mi := &file_service_motion_v1_motion_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollisionSpecification.ProtoReflect.Descriptor instead.
+func (*CollisionSpecification) Descriptor() ([]byte, []int) {
+	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CollisionSpecification) GetAllows() []*CollisionSpecification_AllowedFrameCollisions {
+	if x != nil {
+		return x.Allows
+	}
+	return nil
+}
+
+// PseudolinearConstraint specifies that the component being moved should move approximately linearly relative to its goal.
+// Tolerances are scaled by the distance between start and goal, so that longer motions are allowed proportionally more deviation.
+// It does not constrain the motion of components other than the `component_name` specified in motion.Move
+type PseudolinearConstraint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Max linear deviation from straight-line between start and goal, as a fraction of the distance between them
+	LineToleranceFactor *float32 `protobuf:"fixed32,1,opt,name=line_tolerance_factor,json=lineToleranceFactor,proto3,oneof" json:"line_tolerance_factor,omitempty"`
+	// Max orientation deviation from the shortest path between start and goal orientations, as a fraction of the angle between them
+	OrientationToleranceFactor *float32 `protobuf:"fixed32,2,opt,name=orientation_tolerance_factor,json=orientationToleranceFactor,proto3,oneof" json:"orientation_tolerance_factor,omitempty"`
+}
+
+func (x *PseudolinearConstraint) Reset() {
+	*x = PseudolinearConstraint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_motion_v1_motion_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PseudolinearConstraint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PseudolinearConstraint) ProtoMessage() {}
+
+func (x *PseudolinearConstraint) ProtoReflect() protoreflect.Message {
+	mi := &file_service_motion_v1_motion_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PseudolinearConstraint.ProtoReflect.Descriptor instead.
+func (*PseudolinearConstraint) Descriptor() ([]byte, []int) {
+	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PseudolinearConstraint) GetLineToleranceFactor() float32 {
+	if x != nil && x.LineToleranceFactor != nil {
+		return *x.LineToleranceFactor
+	}
+	return 0
+}
+
+func (x *PseudolinearConstraint) GetOrientationToleranceFactor() float32 {
+	if x != nil && x.OrientationToleranceFactor != nil {
+		return *x.OrientationToleranceFactor
+	}
+	return 0
+}
+
+// KeepOutZoneConstraint specifies regions of space which no part of the robot may enter while moving
+// Unlike obstacles in the WorldState, the zones may be empty space, and apply for the whole motion
+type KeepOutZoneConstraint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Geometries of the zones and the reference frame they are defined in
+	Zones *v1.GeometriesInFrame `protobuf:"bytes,1,opt,name=zones,proto3" json:"zones,omitempty"`
+	// Frames of the robot allowed to enter the zones. If empty, no frame may enter them
+	AllowedFrames []string `protobuf:"bytes,2,rep,name=allowed_frames,json=allowedFrames,proto3" json:"allowed_frames,omitempty"`
+}
+
+func (x *KeepOutZoneConstraint) Reset() {
+	*x = KeepOutZoneConstraint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_motion_v1_motion_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeepOutZoneConstraint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeepOutZoneConstraint) ProtoMessage() {}
+
+func (x *KeepOutZoneConstraint) ProtoReflect() protoreflect.Message {
+	mi := &file_service_motion_v1_motion_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeepOutZoneConstraint.ProtoReflect.Descriptor instead.
+func (*KeepOutZoneConstraint) Descriptor() ([]byte, []int) {
+	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *KeepOutZoneConstraint) GetZones() *v1.GeometriesInFrame {
+	if x != nil {
+		return x.Zones
+	}
+	return nil
+}
+
+func (x *KeepOutZoneConstraint) GetAllowedFrames() []string {
+	if x != nil {
+		return x.AllowedFrames
+	}
+	return nil
+}
+
+// JointRangeConstraint limits the inputs of a component, such as arm joint positions, to a range narrower than its own limits
+type JointRangeConstraint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Component whose inputs are constrained
+	ComponentName *v1.ResourceName `protobuf:"bytes,1,opt,name=component_name,json=componentName,proto3" json:"component_name,omitempty"`
+	// Ranges of the inputs, with 1 entry per input of the component in the same order as its inputs
+	Ranges []*JointRange `protobuf:"bytes,2,rep,name=ranges,proto3" json:"ranges,omitempty"`
+}
+
+func (x *JointRangeConstraint) Reset() {
+	*x = JointRangeConstraint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_motion_v1_motion_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JointRangeConstraint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JointRangeConstraint) ProtoMessage() {}
+
+func (x *JointRangeConstraint) ProtoReflect() protoreflect.Message {
+	mi := &file_service_motion_v1_motion_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JointRangeConstraint.ProtoReflect.Descriptor instead.
+func (*JointRangeConstraint) Descriptor() ([]byte, []int) {
+	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *JointRangeConstraint) GetComponentName() *v1.ResourceName {
+	if x != nil {
+		return x.ComponentName
+	}
+	return nil
+}
+
+func (x *JointRangeConstraint) GetRanges() []*JointRange {
+	if x != nil {
+		return x.Ranges
+	}
+	return nil
+}
+
+type JointRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Minimum allowed value of the input. Rotation values are in degrees, translational values in mm
+	Min *float64 `protobuf:"fixed64,1,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	// Maximum allowed value of the input. Rotation values are in degrees, translational values in mm
+	Max *float64 `protobuf:"fixed64,2,opt,name=max,proto3,oneof" json:"max,omitempty"`
+}
+
+func (x *JointRange) Reset() {
+	*x = JointRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_motion_v1_motion_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JointRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JointRange) ProtoMessage() {}
+
+func (x *JointRange) ProtoReflect() protoreflect.Message {
+	mi := &file_service_motion_v1_motion_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JointRange.ProtoReflect.Descriptor instead.
+func (*JointRange) Descriptor() ([]byte, []int) {
+	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *JointRange) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *JointRange) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+// EndEffectorSpeedConstraint limits how fast the component being moved may travel
+// It does not constrain the motion of components other than the `component_name` specified in motion.Move
+type EndEffectorSpeedConstraint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Max linear speed of the component, in mm per second
+	MaxLinearMmPerSec *float64 `protobuf:"fixed64,1,opt,name=max_linear_mm_per_sec,json=maxLinearMmPerSec,proto3,oneof" json:"max_linear_mm_per_sec,omitempty"`
+	// Max angular speed of the component, in degrees per second
+	MaxAngularDegsPerSec *float64 `protobuf:"fixed64,2,opt,name=max_angular_degs_per_sec,json=maxAngularDegsPerSec,proto3,oneof" json:"max_angular_degs_per_sec,omitempty"`
+}
+
+func (x *EndEffectorSpeedConstraint) Reset() {
+	*x = EndEffectorSpeedConstraint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_motion_v1_motion_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CollisionSpecification) String() string {
+func (x *EndEffectorSpeedConstraint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CollisionSpecification) ProtoMessage() {}
+func (*EndEffectorSpeedConstraint) ProtoMessage() {}
 
-func (x *CollisionSpecification) ProtoReflect() protoreflect.Message {
-	mi := &file_service_motion_v1_motion_proto_msgTypes[12]
+func (x *EndEffectorSpeedConstraint) ProtoReflect() protoreflect.Message {
+	mi := &file_service_motion_v1_motion_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -890,16 +2318,23 @@ func (x *CollisionSpecification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CollisionSpecification.ProtoReflect.Descriptor instead.
-func (*CollisionSpecification) Descriptor() ([]byte, []int) {
-	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use EndEffectorSpeedConstraint.ProtoReflect.Descriptor instead.
+func (*EndEffectorSpeedConstraint) Descriptor() ([]byte, []int) {
+	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *CollisionSpecification) GetAllows() []*CollisionSpecification_AllowedFrameCollisions {
-	if x != nil {
-		return x.Allows
+func (x *EndEffectorSpeedConstraint) GetMaxLinearMmPerSec() float64 {
+	if x != nil && x.MaxLinearMmPerSec != nil {
+		return *x.MaxLinearMmPerSec
 	}
-	return nil
+	return 0
+}
+
+func (x *EndEffectorSpeedConstraint) GetMaxAngularDegsPerSec() float64 {
+	if x != nil && x.MaxAngularDegsPerSec != nil {
+		return *x.MaxAngularDegsPerSec
+	}
+	return 0
 }
 
 type CollisionSpecification_AllowedFrameCollisions struct {
@@ -914,7 +2349,7 @@ type CollisionSpecification_AllowedFrameCollisions struct {
 func (x *CollisionSpecification_AllowedFrameCollisions) Reset() {
 	*x = CollisionSpecification_AllowedFrameCollisions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_service_motion_v1_motion_proto_msgTypes[13]
+		mi := &file_service_motion_v1_motion_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -927,7 +2362,7 @@ func (x *CollisionSpecification_AllowedFrameCollisions) String() string {
 func (*CollisionSpecification_AllowedFrameCollisions) ProtoMessage() {}
 
 func (x *CollisionSpecification_AllowedFrameCollisions) ProtoReflect() protoreflect.Message {
-	mi := &file_service_motion_v1_motion_proto_msgTypes[13]
+	mi := &file_service_motion_v1_motion_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -940,7 +2375,7 @@ func (x *CollisionSpecification_AllowedFrameCollisions) ProtoReflect() protorefl
 
 // Deprecated: Use CollisionSpecification_AllowedFrameCollisions.ProtoReflect.Descriptor instead.
 func (*CollisionSpecification_AllowedFrameCollisions) Descriptor() ([]byte, []int) {
-	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{12, 0}
+	return file_service_motion_v1_motion_proto_rawDescGZIP(), []int{25, 0}
 }
 
 func (x *CollisionSpecification_AllowedFrameCollisions) GetFrame1() string {
@@ -968,248 +2403,590 @@ var file_service_motion_v1_motion_proto_rawDesc = []byte{
 	0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e,
 	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
-	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x82, 0x03, 0x0a,
-	0x0b, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x3d, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x65, 0x49, 0x6e, 0x46, 0x72, 0x61,
-	0x6d, 0x65, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x43, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x61, 0x6d,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6c, 0x64,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x4a, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72,
-	0x61, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x76, 0x69,
-	0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73,
-	0x48, 0x01, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x88,
-	0x01, 0x01, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72,
-	0x61, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74,
-	0x73, 0x22, 0x28, 0x0a, 0x0c, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb2, 0x03,
+	0x0a, 0x0b, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x3d, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x65, 0x49, 0x6e, 0x46, 0x72,
+	0x61, 0x6d, 0x65, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x43, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x61,
+	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6c,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x4a, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x76,
+	0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74,
+	0x73, 0x48, 0x01, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x11, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74,
+	0x72, 0x61, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x74, 0x73, 0x22, 0xb9, 0x01, 0x0a, 0x0c, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x57, 0x0a, 0x10, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x76, 0x69, 0x61,
+	0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x46,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x88, 0x01, 0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x22, 0x86,
+	0x03, 0x0a, 0x0f, 0x50, 0x6c, 0x61, 0x6e, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x69,
+	0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73,
+	0x65, 0x49, 0x6e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x0d, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x77, 0x6f,
+	0x72, 0x6c, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x77,
+	0x6f, 0x72, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x4a, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x48, 0x01, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72,
+	0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x77, 0x6f, 0x72, 0x6c,
+	0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x6f, 0x6e, 0x73,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xb2, 0x01, 0x0a, 0x10, 0x50, 0x6c, 0x61, 0x6e,
+	0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x04,
+	0x70, 0x6c, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x76, 0x69, 0x61,
+	0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x12, 0x57,
+	0x0a, 0x10, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x48, 0x00, 0x52, 0x0f, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x88, 0x01, 0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x22, 0xcc, 0x02, 0x0a,
+	0x10, 0x4d, 0x6f, 0x76, 0x65, 0x4f, 0x6e, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x69, 0x61,
+	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x65,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a,
+	0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x48, 0x0a, 0x11, 0x73, 0x6c, 0x61, 0x6d, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x0f, 0x73, 0x6c, 0x61,
+	0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x13,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x72, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x05,
+	0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0xbe, 0x01, 0x0a, 0x11,
+	0x4d, 0x6f, 0x76, 0x65, 0x4f, 0x6e, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x9c, 0x02, 0x0a, 0x10,
-	0x4d, 0x6f, 0x76, 0x65, 0x4f, 0x6e, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x69, 0x61, 0x6d,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x65, 0x52,
-	0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x0e,
-	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x48, 0x0a, 0x11, 0x73, 0x6c, 0x61, 0x6d, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x76,
-	0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x0f, 0x73, 0x6c, 0x61, 0x6d,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x65,
-	0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x2d, 0x0a, 0x11, 0x4d, 0x6f,
-	0x76, 0x65, 0x4f, 0x6e, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x86, 0x04, 0x0a, 0x13, 0x4d, 0x6f,
-	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x45, 0x0a, 0x0f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x76, 0x69, 0x61,
-	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x0e, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x1d, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x68, 0x7a, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48,
-	0x00, 0x52, 0x1a, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x6c, 0x69,
-	0x6e, 0x67, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x48, 0x7a, 0x88, 0x01, 0x01,
-	0x12, 0x46, 0x0a, 0x1d, 0x6f, 0x62, 0x73, 0x74, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x70, 0x6f, 0x6c,
-	0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x68,
-	0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x1a, 0x6f, 0x62, 0x73, 0x74, 0x61,
-	0x63, 0x6c, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x6e, 0x63, 0x79, 0x48, 0x7a, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x10, 0x70, 0x6c, 0x61, 0x6e,
-	0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x01, 0x48, 0x02, 0x52, 0x0e, 0x70, 0x6c, 0x61, 0x6e, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x4d, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x10, 0x6c, 0x69, 0x6e, 0x65, 0x61,
-	0x72, 0x5f, 0x6d, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x01, 0x48, 0x03, 0x52, 0x0d, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x4d, 0x50, 0x65, 0x72, 0x53,
-	0x65, 0x63, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x14, 0x61, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72,
-	0x5f, 0x64, 0x65, 0x67, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x01, 0x48, 0x04, 0x52, 0x11, 0x61, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x44, 0x65,
-	0x67, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x88, 0x01, 0x01, 0x42, 0x20, 0x0a, 0x1e, 0x5f,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
-	0x5f, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x68, 0x7a, 0x42, 0x20, 0x0a,
-	0x1e, 0x5f, 0x6f, 0x62, 0x73, 0x74, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x6c, 0x69,
-	0x6e, 0x67, 0x5f, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x68, 0x7a, 0x42,
-	0x13, 0x0a, 0x11, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x6d, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x5f,
-	0x6d, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x61, 0x6e,
-	0x67, 0x75, 0x6c, 0x61, 0x72, 0x5f, 0x64, 0x65, 0x67, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73,
-	0x65, 0x63, 0x22, 0x8c, 0x04, 0x0a, 0x12, 0x4d, 0x6f, 0x76, 0x65, 0x4f, 0x6e, 0x47, 0x6c, 0x6f,
-	0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a,
-	0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x07, 0x68, 0x65, 0x61,
-	0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x07, 0x68, 0x65,
-	0x61, 0x64, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x0d,
-	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4e, 0x0a,
-	0x14, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x6e, 0x73, 0x6f, 0x72,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x76, 0x69,
-	0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x12, 0x6d, 0x6f, 0x76, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x53, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a,
-	0x09, 0x6f, 0x62, 0x73, 0x74, 0x61, 0x63, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x6f, 0x4f, 0x62, 0x73, 0x74, 0x61, 0x63, 0x6c, 0x65, 0x52, 0x09, 0x6f,
-	0x62, 0x73, 0x74, 0x61, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x63, 0x0a, 0x14, 0x6d, 0x6f, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x4d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x13, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a,
-	0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x42, 0x0a, 0x0a, 0x08,
-	0x5f, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x6d, 0x6f, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x2f, 0x0a, 0x13, 0x4d, 0x6f, 0x76, 0x65, 0x4f, 0x6e, 0x47, 0x6c, 0x6f, 0x62, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x22, 0x99, 0x02, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x52, 0x65,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x57,
+	0x0a, 0x10, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x48, 0x00, 0x52, 0x0f, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x88, 0x01, 0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x22, 0x86, 0x04, 0x0a,
+	0x13, 0x4d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x0f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x0e, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x1d, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f,
+	0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x68, 0x7a, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x00, 0x52, 0x1a, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x48, 0x7a,
+	0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x1d, 0x6f, 0x62, 0x73, 0x74, 0x61, 0x63, 0x6c, 0x65, 0x5f,
+	0x70, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x79, 0x5f, 0x68, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x1a, 0x6f, 0x62,
+	0x73, 0x74, 0x61, 0x63, 0x6c, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x46, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x48, 0x7a, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x10, 0x70,
+	0x6c, 0x61, 0x6e, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x02, 0x52, 0x0e, 0x70, 0x6c, 0x61, 0x6e, 0x44, 0x65, 0x76,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x10, 0x6c, 0x69,
+	0x6e, 0x65, 0x61, 0x72, 0x5f, 0x6d, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x0d, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x4d, 0x50,
+	0x65, 0x72, 0x53, 0x65, 0x63, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x14, 0x61, 0x6e, 0x67, 0x75,
+	0x6c, 0x61, 0x72, 0x5f, 0x64, 0x65, 0x67, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x04, 0x52, 0x11, 0x61, 0x6e, 0x67, 0x75, 0x6c, 0x61,
+	0x72, 0x44, 0x65, 0x67, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x88, 0x01, 0x01, 0x42, 0x20,
+	0x0a, 0x1e, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x5f, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x68, 0x7a,
+	0x42, 0x20, 0x0a, 0x1e, 0x5f, 0x6f, 0x62, 0x73, 0x74, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x70, 0x6f,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x68, 0x7a, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x64, 0x65, 0x76, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6c, 0x69, 0x6e, 0x65,
+	0x61, 0x72, 0x5f, 0x6d, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x42, 0x17, 0x0a, 0x15,
+	0x5f, 0x61, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x5f, 0x64, 0x65, 0x67, 0x73, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x73, 0x65, 0x63, 0x22, 0xbc, 0x04, 0x0a, 0x12, 0x4d, 0x6f, 0x76, 0x65, 0x4f, 0x6e,
+	0x47, 0x6c, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x3a, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x07,
+	0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52,
+	0x07, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x0e, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x4e, 0x0a, 0x14, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x6e,
+	0x73, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x12, 0x6d, 0x6f,
+	0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x39, 0x0a, 0x09, 0x6f, 0x62, 0x73, 0x74, 0x61, 0x63, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6f, 0x4f, 0x62, 0x73, 0x74, 0x61, 0x63, 0x6c, 0x65,
+	0x52, 0x09, 0x6f, 0x62, 0x73, 0x74, 0x61, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x63, 0x0a, 0x14, 0x6d,
+	0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x76, 0x69, 0x61, 0x6d,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x13, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01,
+	0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x72,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x42,
+	0x0a, 0x0a, 0x08, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x17, 0x0a, 0x15, 0x5f,
+	0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc0, 0x01, 0x0a, 0x13, 0x4d, 0x6f, 0x76, 0x65, 0x4f, 0x6e, 0x47,
+	0x6c, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x57, 0x0a, 0x10, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x48, 0x00, 0x52, 0x0f,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x88,
+	0x01, 0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
+	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x22, 0x99, 0x02, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50,
+	0x6f, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x43,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x61, 0x6d, 0x65,
+	0x12, 0x52, 0x0a, 0x17, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c,
+	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x16, 0x73, 0x75,
+	0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x6f, 0x72, 0x6d, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x22, 0x42, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x65, 0x49, 0x6e, 0x46, 0x72, 0x61, 0x6d,
+	0x65, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x65, 0x22, 0x9f, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50,
+	0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x70,
+	0x6c, 0x61, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0xcb, 0x01, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a,
+	0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61,
+	0x6e, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x3f,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d, 0x6f,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x36, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x07,
+	0x72, 0x65, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0e, 0x63, 0x6f, 0x6d,
-	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52,
-	0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b,
-	0x0a, 0x11, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x72,
-	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x65, 0x73, 0x74, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x52, 0x0a, 0x17, 0x73,
-	0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x76,
-	0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x16, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x12,
-	0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x42,
-	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2f, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x6f, 0x73, 0x65, 0x49, 0x6e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x70, 0x6f,
-	0x73, 0x65, 0x22, 0xb3, 0x02, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e,
-	0x74, 0x73, 0x12, 0x55, 0x0a, 0x11, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x63, 0x6f, 0x6e,
-	0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e,
-	0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x43, 0x6f, 0x6e,
-	0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x10, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x43,
-	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x64, 0x0a, 0x16, 0x6f, 0x72, 0x69,
-	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61,
-	0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x76, 0x69, 0x61, 0x6d,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x05,
+	0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x5d, 0x0a, 0x1a, 0x47,
+	0x65, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x76, 0x69, 0x61, 0x6d,
 	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x4f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
-	0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x15, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x12,
-	0x67, 0x0a, 0x17, 0x63, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x70, 0x65,
-	0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x2e, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x69, 0x73,
-	0x69, 0x6f, 0x6e, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x16, 0x63, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x70, 0x65, 0x63, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xbb, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x6e,
-	0x65, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x2f, 0x0a,
-	0x11, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x5f,
-	0x6d, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x0f, 0x6c, 0x69, 0x6e, 0x65,
-	0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x41,
-	0x0a, 0x1a, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f,
-	0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x65, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x02, 0x48, 0x01, 0x52, 0x18, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x67, 0x73, 0x88, 0x01,
-	0x01, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72,
-	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x6d, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x6f, 0x72, 0x69, 0x65,
-	0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63,
-	0x65, 0x5f, 0x64, 0x65, 0x67, 0x73, 0x22, 0x79, 0x0a, 0x15, 0x4f, 0x72, 0x69, 0x65, 0x6e, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x12,
-	0x41, 0x0a, 0x1a, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
-	0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x65, 0x67, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x18, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x67, 0x73, 0x88,
-	0x01, 0x01, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x65, 0x67,
-	0x73, 0x22, 0xc1, 0x01, 0x0a, 0x16, 0x43, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53,
-	0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5d, 0x0a, 0x06,
-	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x76,
-	0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53,
-	0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x6c, 0x6c,
-	0x6f, 0x77, 0x65, 0x64, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x06, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x1a, 0x48, 0x0a, 0x16, 0x41,
-	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x69,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x31, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x31, 0x12, 0x16, 0x0a,
-	0x06, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66,
-	0x72, 0x61, 0x6d, 0x65, 0x32, 0x32, 0xea, 0x05, 0x0a, 0x0d, 0x4d, 0x6f, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x04, 0x4d, 0x6f, 0x76, 0x65,
-	0x12, 0x23, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x29, 0x22, 0x27, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
-	0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e,
-	0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x98, 0x01, 0x0a,
-	0x09, 0x4d, 0x6f, 0x76, 0x65, 0x4f, 0x6e, 0x4d, 0x61, 0x70, 0x12, 0x28, 0x2e, 0x76, 0x69, 0x61,
-	0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x4f, 0x6e, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f,
-	0x76, 0x65, 0x4f, 0x6e, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x22, 0x2e, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x6d, 0x6f,
-	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6d, 0x6f, 0x76, 0x65,
-	0x5f, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x70, 0x12, 0xa0, 0x01, 0x0a, 0x0b, 0x4d, 0x6f, 0x76, 0x65,
-	0x4f, 0x6e, 0x47, 0x6c, 0x6f, 0x62, 0x65, 0x12, 0x2a, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73,
+	0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x7c, 0x0a, 0x14, 0x53, 0x74,
+	0x6f, 0x70, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74,
+	0x72, 0x61, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x17, 0x0a, 0x15, 0x53, 0x74, 0x6f, 0x70,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0xb6, 0x01, 0x0a, 0x04, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x43, 0x0a,
+	0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x53,
+	0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x08, 0x50,
+	0x6c, 0x61, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x12, 0x3e, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x6c, 0x61, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x2e, 0x53, 0x74, 0x65, 0x70, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x1a, 0x5f, 0x0a, 0x09, 0x53, 0x74, 0x65, 0x70, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x52, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x70, 0x6f,
+	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x65, 0x52, 0x04,
+	0x70, 0x6f, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x22, 0xf1, 0x03, 0x0a,
+	0x0f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x76, 0x69,
+	0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73,
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x4f, 0x6e, 0x47, 0x6c, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76,
-	0x65, 0x4f, 0x6e, 0x47, 0x6c, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x22, 0x30, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x6d,
-	0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6d, 0x6f, 0x76,
-	0x65, 0x5f, 0x6f, 0x6e, 0x5f, 0x67, 0x6c, 0x6f, 0x62, 0x65, 0x12, 0x8b, 0x01, 0x0a, 0x07, 0x47,
-	0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x12, 0x26, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65,
+	0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12,
+	0x2c, 0x0a, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21, 0x0a,
+	0x0c, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1b, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x57, 0x0a, 0x10, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x48, 0x01, 0x52, 0x0f, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x88, 0x01, 0x01,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42, 0x13, 0x0a, 0x11, 0x5f,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x22, 0xd5, 0x01, 0x0a, 0x0f, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x12, 0x45, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c,
+	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66,
+	0x72, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x0a, 0x67, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x76, 0x69, 0x61, 0x6d,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6f, 0x6d, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x0a, 0x67, 0x65,
+	0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0xdf, 0x05, 0x0a, 0x0b, 0x43, 0x6f, 0x6e,
+	0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x55, 0x0a, 0x11, 0x6c, 0x69, 0x6e, 0x65,
+	0x61, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e,
+	0x65, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x10, 0x6c,
+	0x69, 0x6e, 0x65, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x12,
+	0x64, 0x0a, 0x16, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63,
+	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d,
+	0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x15,
+	0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x67, 0x0a, 0x17, 0x63, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65,
 	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x43, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x63, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x67,
+	0x0a, 0x17, 0x70, 0x73, 0x65, 0x75, 0x64, 0x6f, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x63,
+	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2e, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d,
+	0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x73, 0x65, 0x75, 0x64, 0x6f, 0x6c,
+	0x69, 0x6e, 0x65, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52,
+	0x16, 0x70, 0x73, 0x65, 0x75, 0x64, 0x6f, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x43, 0x6f, 0x6e,
+	0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x66, 0x0a, 0x18, 0x6b, 0x65, 0x65, 0x70, 0x5f,
+	0x6f, 0x75, 0x74, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x76, 0x69, 0x61, 0x6d,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x4b, 0x65, 0x65, 0x70, 0x4f, 0x75, 0x74, 0x5a, 0x6f, 0x6e, 0x65, 0x43, 0x6f,
+	0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x15, 0x6b, 0x65, 0x65, 0x70, 0x4f, 0x75,
+	0x74, 0x5a, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x12,
+	0x62, 0x0a, 0x16, 0x6a, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x63,
+	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2c, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d,
+	0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x14, 0x6a,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x74, 0x12, 0x75, 0x0a, 0x1d, 0x65, 0x6e, 0x64, 0x5f, 0x65, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x76, 0x69, 0x61,
+	0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x64, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53,
+	0x70, 0x65, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x1a,
+	0x65, 0x6e, 0x64, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x70, 0x65, 0x65, 0x64,
+	0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x22, 0xbb, 0x01, 0x0a, 0x10, 0x4c,
+	0x69, 0x6e, 0x65, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x12,
+	0x2f, 0x0a, 0x11, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x6d, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x0f, 0x6c, 0x69,
+	0x6e, 0x65, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6d, 0x88, 0x01, 0x01,
+	0x12, 0x41, 0x0a, 0x1a, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x65, 0x67, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x02, 0x48, 0x01, 0x52, 0x18, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x67, 0x73,
+	0x88, 0x01, 0x01, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x6f, 0x6c,
+	0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x6d, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x6f, 0x72,
+	0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x64, 0x65, 0x67, 0x73, 0x22, 0x79, 0x0a, 0x15, 0x4f, 0x72, 0x69, 0x65,
+	0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x74, 0x12, 0x41, 0x0a, 0x1a, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x65, 0x67, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x18, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x67,
+	0x73, 0x88, 0x01, 0x01, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x64,
+	0x65, 0x67, 0x73, 0x22, 0xc1, 0x01, 0x0a, 0x16, 0x43, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5d,
+	0x0a, 0x06, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x45,
 	0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d, 0x6f,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x06, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x1a, 0x48, 0x0a,
+	0x16, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6c,
+	0x6c, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x72, 0x61, 0x6d, 0x65,
+	0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x31, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x32, 0x22, 0xd3, 0x01, 0x0a, 0x16, 0x50, 0x73, 0x65, 0x75,
+	0x64, 0x6f, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x74, 0x12, 0x37, 0x0a, 0x15, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x02, 0x48, 0x00, 0x52, 0x13, 0x6c, 0x69, 0x6e, 0x65, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e,
+	0x63, 0x65, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x1c, 0x6f,
+	0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x02, 0x48, 0x01, 0x52, 0x1a, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x88,
+	0x01, 0x01, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x6f, 0x6c, 0x65,
+	0x72, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x1f, 0x0a, 0x1d,
+	0x5f, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6c,
+	0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x77, 0x0a,
+	0x15, 0x4b, 0x65, 0x65, 0x70, 0x4f, 0x75, 0x74, 0x5a, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x73,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x05, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x49, 0x6e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x05, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x61, 0x6d, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x46, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x14, 0x4a, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x12,
+	0x43, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x22, 0x4a, 0x0a, 0x0a, 0x4a, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x15,
+	0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x03, 0x6d,
+	0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x01, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x88, 0x01, 0x01, 0x42, 0x06, 0x0a, 0x04,
+	0x5f, 0x6d, 0x69, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d, 0x61, 0x78, 0x22, 0xc7, 0x01, 0x0a,
+	0x1a, 0x45, 0x6e, 0x64, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x70, 0x65, 0x65,
+	0x64, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x15, 0x6d,
+	0x61, 0x78, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x6d, 0x6d, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x73, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x11, 0x6d, 0x61,
+	0x78, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x4d, 0x6d, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x88,
+	0x01, 0x01, 0x12, 0x3b, 0x0a, 0x18, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6e, 0x67, 0x75, 0x6c, 0x61,
+	0x72, 0x5f, 0x64, 0x65, 0x67, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x41, 0x6e, 0x67, 0x75, 0x6c,
+	0x61, 0x72, 0x44, 0x65, 0x67, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x88, 0x01, 0x01, 0x42,
+	0x18, 0x0a, 0x16, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x6d,
+	0x6d, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x42, 0x1b, 0x0a, 0x19, 0x5f, 0x6d, 0x61,
+	0x78, 0x5f, 0x61, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x5f, 0x64, 0x65, 0x67, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x2a, 0xc8, 0x01, 0x0a, 0x0e, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x58, 0x45,
+	0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x58,
+	0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x4c,
+	0x41, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x58, 0x45, 0x43,
+	0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x50,
+	0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x58, 0x45,
+	0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x4f,
+	0x50, 0x50, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45,
+	0x44, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10,
+	0x05, 0x2a, 0xcd, 0x02, 0x0a, 0x15, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x23, 0x50,
+	0x4c, 0x41, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x2c, 0x0a, 0x28, 0x50, 0x4c, 0x41, 0x4e, 0x4e, 0x49, 0x4e, 0x47,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x47, 0x4f, 0x41, 0x4c, 0x5f, 0x55, 0x4e, 0x52, 0x45, 0x41, 0x43, 0x48, 0x41, 0x42, 0x4c, 0x45,
+	0x10, 0x01, 0x12, 0x2e, 0x0a, 0x2a, 0x50, 0x4c, 0x41, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x46,
+	0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x43, 0x4f,
+	0x4c, 0x4c, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54,
+	0x10, 0x02, 0x12, 0x2d, 0x0a, 0x29, 0x50, 0x4c, 0x41, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x46,
+	0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x43, 0x4f,
+	0x4c, 0x4c, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x54, 0x5f, 0x47, 0x4f, 0x41, 0x4c, 0x10,
+	0x03, 0x12, 0x31, 0x0a, 0x2d, 0x50, 0x4c, 0x41, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x46, 0x41,
+	0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4e,
+	0x53, 0x54, 0x52, 0x41, 0x49, 0x4e, 0x54, 0x5f, 0x49, 0x4e, 0x46, 0x45, 0x41, 0x53, 0x49, 0x42,
+	0x4c, 0x45, 0x10, 0x04, 0x12, 0x23, 0x0a, 0x1f, 0x50, 0x4c, 0x41, 0x4e, 0x4e, 0x49, 0x4e, 0x47,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x05, 0x12, 0x26, 0x0a, 0x22, 0x50, 0x4c, 0x41,
+	0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4b, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10,
+	0x06, 0x32, 0xf3, 0x0a, 0x0a, 0x0d, 0x4d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x04, 0x4d, 0x6f, 0x76, 0x65, 0x12, 0x23, 0x2e, 0x76,
+	0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x22,
 	0x27, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65,
 	0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x6e, 0x61,
-	0x6d, 0x65, 0x7d, 0x2f, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x87, 0x01, 0x0a, 0x09, 0x44, 0x6f, 0x43,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x20, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x2f, 0x22, 0x2d, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x6d, 0x65, 0x7d, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x98, 0x01, 0x0a, 0x09, 0x4d, 0x6f, 0x76,
+	0x65, 0x4f, 0x6e, 0x4d, 0x61, 0x70, 0x12, 0x28, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x6f, 0x76, 0x65, 0x4f, 0x6e, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x29, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x4f, 0x6e,
+	0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x30, 0x22, 0x2e, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
 	0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e,
-	0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x6f, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
-	0x6e, 0x64, 0x42, 0x3f, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73,
+	0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x6f, 0x6e, 0x5f,
+	0x6d, 0x61, 0x70, 0x12, 0xa0, 0x01, 0x0a, 0x0b, 0x4d, 0x6f, 0x76, 0x65, 0x4f, 0x6e, 0x47, 0x6c,
+	0x6f, 0x62, 0x65, 0x12, 0x2a, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76,
+	0x65, 0x4f, 0x6e, 0x47, 0x6c, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2b, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d,
+	0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x4f, 0x6e, 0x47,
+	0x6c, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x32, 0x22, 0x30, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x6d, 0x6f, 0x74, 0x69, 0x6f,
+	0x6e, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x6f, 0x6e,
+	0x5f, 0x67, 0x6c, 0x6f, 0x62, 0x65, 0x12, 0x93, 0x01, 0x0a, 0x08, 0x50, 0x6c, 0x61, 0x6e, 0x4d,
+	0x6f, 0x76, 0x65, 0x12, 0x27, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61,
+	0x6e, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x76,
+	0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x22, 0x2c,
+	0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x6e, 0x61, 0x6d,
+	0x65, 0x7d, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x8b, 0x01, 0x0a,
+	0x07, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x26, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x27, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x29, 0x12, 0x27, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b,
+	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x12, 0xb8, 0x01, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x31, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35,
+	0x12, 0x33, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x6e,
+	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0xa7, 0x01, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73,
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x5a, 0x21, 0x67, 0x6f, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e,
-	0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x6f, 0x70, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x22, 0x31, 0x2f, 0x76,
+	0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
+	0x2f, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x8b, 0x01, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x12, 0x26, 0x2e, 0x76, 0x69,
+	0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x6d, 0x6f, 0x74, 0x69, 0x6f,
+	0x6e, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x87, 0x01,
+	0x0a, 0x09, 0x44, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x20, 0x2e, 0x76, 0x69,
+	0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x22, 0x2d, 0x2f, 0x76, 0x69, 0x61, 0x6d, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x6d,
+	0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x6f, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x42, 0x3f, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x76,
+	0x69, 0x61, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d, 0x6f, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x5a, 0x21, 0x67, 0x6f, 0x2e, 0x76, 0x69, 0x61, 0x6d, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x6d,
+	0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1224,73 +3001,140 @@ func file_service_motion_v1_motion_proto_rawDescGZIP() []byte {
 	return file_service_motion_v1_motion_proto_rawDescData
 }
 
-var file_service_motion_v1_motion_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_service_motion_v1_motion_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_service_motion_v1_motion_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_service_motion_v1_motion_proto_goTypes = []interface{}{
-	(*MoveRequest)(nil),                                   // 0: viam.service.motion.v1.MoveRequest
-	(*MoveResponse)(nil),                                  // 1: viam.service.motion.v1.MoveResponse
-	(*MoveOnMapRequest)(nil),                              // 2: viam.service.motion.v1.MoveOnMapRequest
-	(*MoveOnMapResponse)(nil),                             // 3: viam.service.motion.v1.MoveOnMapResponse
-	(*MotionConfiguration)(nil),                           // 4: viam.service.motion.v1.MotionConfiguration
-	(*MoveOnGlobeRequest)(nil),                            // 5: viam.service.motion.v1.MoveOnGlobeRequest
-	(*MoveOnGlobeResponse)(nil),                           // 6: viam.service.motion.v1.MoveOnGlobeResponse
-	(*GetPoseRequest)(nil),                                // 7: viam.service.motion.v1.GetPoseRequest
-	(*GetPoseResponse)(nil),                               // 8: viam.service.motion.v1.GetPoseResponse
-	(*Constraints)(nil),                                   // 9: viam.service.motion.v1.Constraints
-	(*LinearConstraint)(nil),                              // 10: viam.service.motion.v1.LinearConstraint
-	(*OrientationConstraint)(nil),                         // 11: viam.service.motion.v1.OrientationConstraint
-	(*CollisionSpecification)(nil),                        // 12: viam.service.motion.v1.CollisionSpecification
-	(*CollisionSpecification_AllowedFrameCollisions)(nil), // 13: viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions
-	(*v1.PoseInFrame)(nil),                                // 14: viam.common.v1.PoseInFrame
-	(*v1.ResourceName)(nil),                               // 15: viam.common.v1.ResourceName
-	(*v1.WorldState)(nil),                                 // 16: viam.common.v1.WorldState
-	(*structpb.Struct)(nil),                               // 17: google.protobuf.Struct
-	(*v1.Pose)(nil),                                       // 18: viam.common.v1.Pose
-	(*v1.GeoPoint)(nil),                                   // 19: viam.common.v1.GeoPoint
-	(*v1.GeoObstacle)(nil),                                // 20: viam.common.v1.GeoObstacle
-	(*v1.Transform)(nil),                                  // 21: viam.common.v1.Transform
-	(*v1.DoCommandRequest)(nil),                           // 22: viam.common.v1.DoCommandRequest
-	(*v1.DoCommandResponse)(nil),                          // 23: viam.common.v1.DoCommandResponse
+	(ExecutionState)(0),                                   // 0: viam.service.motion.v1.ExecutionState
+	(PlanningFailureReason)(0),                            // 1: viam.service.motion.v1.PlanningFailureReason
+	(*MoveRequest)(nil),                                   // 2: viam.service.motion.v1.MoveRequest
+	(*MoveResponse)(nil),                                  // 3: viam.service.motion.v1.MoveResponse
+	(*PlanMoveRequest)(nil),                               // 4: viam.service.motion.v1.PlanMoveRequest
+	(*PlanMoveResponse)(nil),                              // 5: viam.service.motion.v1.PlanMoveResponse
+	(*MoveOnMapRequest)(nil),                              // 6: viam.service.motion.v1.MoveOnMapRequest
+	(*MoveOnMapResponse)(nil),                             // 7: viam.service.motion.v1.MoveOnMapResponse
+	(*MotionConfiguration)(nil),                           // 8: viam.service.motion.v1.MotionConfiguration
+	(*MoveOnGlobeRequest)(nil),                            // 9: viam.service.motion.v1.MoveOnGlobeRequest
+	(*MoveOnGlobeResponse)(nil),                           // 10: viam.service.motion.v1.MoveOnGlobeResponse
+	(*GetPoseRequest)(nil),                                // 11: viam.service.motion.v1.GetPoseRequest
+	(*GetPoseResponse)(nil),                               // 12: viam.service.motion.v1.GetPoseResponse
+	(*GetPlanRequest)(nil),                                // 13: viam.service.motion.v1.GetPlanRequest
+	(*GetPlanResponse)(nil),                               // 14: viam.service.motion.v1.GetPlanResponse
+	(*GetExecutionStatusRequest)(nil),                     // 15: viam.service.motion.v1.GetExecutionStatusRequest
+	(*GetExecutionStatusResponse)(nil),                    // 16: viam.service.motion.v1.GetExecutionStatusResponse
+	(*StopExecutionRequest)(nil),                          // 17: viam.service.motion.v1.StopExecutionRequest
+	(*StopExecutionResponse)(nil),                         // 18: viam.service.motion.v1.StopExecutionResponse
+	(*Plan)(nil),                                          // 19: viam.service.motion.v1.Plan
+	(*PlanStep)(nil),                                      // 20: viam.service.motion.v1.PlanStep
+	(*ComponentState)(nil),                                // 21: viam.service.motion.v1.ComponentState
+	(*ExecutionStatus)(nil),                               // 22: viam.service.motion.v1.ExecutionStatus
+	(*PlanningFailure)(nil),                               // 23: viam.service.motion.v1.PlanningFailure
+	(*Constraints)(nil),                                   // 24: viam.service.motion.v1.Constraints
+	(*LinearConstraint)(nil),                              // 25: viam.service.motion.v1.LinearConstraint
+	(*OrientationConstraint)(nil),                         // 26: viam.service.motion.v1.OrientationConstraint
+	(*CollisionSpecification)(nil),                        // 27: viam.service.motion.v1.CollisionSpecification
+	(*PseudolinearConstraint)(nil),                        // 28: viam.service.motion.v1.PseudolinearConstraint
+	(*KeepOutZoneConstraint)(nil),                         // 29: viam.service.motion.v1.KeepOutZoneConstraint
+	(*JointRangeConstraint)(nil),                          // 30: viam.service.motion.v1.JointRangeConstraint
+	(*JointRange)(nil),                                    // 31: viam.service.motion.v1.JointRange
+	(*EndEffectorSpeedConstraint)(nil),                    // 32: viam.service.motion.v1.EndEffectorSpeedConstraint
+	nil,                                                   // 33: viam.service.motion.v1.PlanStep.StepEntry
+	(*CollisionSpecification_AllowedFrameCollisions)(nil), // 34: viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions
+	(*v1.PoseInFrame)(nil),                                // 35: viam.common.v1.PoseInFrame
+	(*v1.ResourceName)(nil),                               // 36: viam.common.v1.ResourceName
+	(*v1.WorldState)(nil),                                 // 37: viam.common.v1.WorldState
+	(*structpb.Struct)(nil),                               // 38: google.protobuf.Struct
+	(*v1.Pose)(nil),                                       // 39: viam.common.v1.Pose
+	(*v1.GeoPoint)(nil),                                   // 40: viam.common.v1.GeoPoint
+	(*v1.GeoObstacle)(nil),                                // 41: viam.common.v1.GeoObstacle
+	(*v1.Transform)(nil),                                  // 42: viam.common.v1.Transform
+	(*timestamppb.Timestamp)(nil),                         // 43: google.protobuf.Timestamp
+	(*v1.GeometriesInFrame)(nil),                          // 44: viam.common.v1.GeometriesInFrame
+	(*v1.DoCommandRequest)(nil),                           // 45: viam.common.v1.DoCommandRequest
+	(*v1.DoCommandResponse)(nil),                          // 46: viam.common.v1.DoCommandResponse
 }
 var file_service_motion_v1_motion_proto_depIdxs = []int32{
-	14, // 0: viam.service.motion.v1.MoveRequest.destination:type_name -> viam.common.v1.PoseInFrame
-	15, // 1: viam.service.motion.v1.MoveRequest.component_name:type_name -> viam.common.v1.ResourceName
-	16, // 2: viam.service.motion.v1.MoveRequest.world_state:type_name -> viam.common.v1.WorldState
-	9,  // 3: viam.service.motion.v1.MoveRequest.constraints:type_name -> viam.service.motion.v1.Constraints
-	17, // 4: viam.service.motion.v1.MoveRequest.extra:type_name -> google.protobuf.Struct
-	18, // 5: viam.service.motion.v1.MoveOnMapRequest.destination:type_name -> viam.common.v1.Pose
-	15, // 6: viam.service.motion.v1.MoveOnMapRequest.component_name:type_name -> viam.common.v1.ResourceName
-	15, // 7: viam.service.motion.v1.MoveOnMapRequest.slam_service_name:type_name -> viam.common.v1.ResourceName
-	17, // 8: viam.service.motion.v1.MoveOnMapRequest.extra:type_name -> google.protobuf.Struct
-	15, // 9: viam.service.motion.v1.MotionConfiguration.vision_services:type_name -> viam.common.v1.ResourceName
-	19, // 10: viam.service.motion.v1.MoveOnGlobeRequest.destination:type_name -> viam.common.v1.GeoPoint
-	15, // 11: viam.service.motion.v1.MoveOnGlobeRequest.component_name:type_name -> viam.common.v1.ResourceName
-	15, // 12: viam.service.motion.v1.MoveOnGlobeRequest.movement_sensor_name:type_name -> viam.common.v1.ResourceName
-	20, // 13: viam.service.motion.v1.MoveOnGlobeRequest.obstacles:type_name -> viam.common.v1.GeoObstacle
-	4,  // 14: viam.service.motion.v1.MoveOnGlobeRequest.motion_configuration:type_name -> viam.service.motion.v1.MotionConfiguration
-	17, // 15: viam.service.motion.v1.MoveOnGlobeRequest.extra:type_name -> google.protobuf.Struct
-	15, // 16: viam.service.motion.v1.GetPoseRequest.component_name:type_name -> viam.common.v1.ResourceName
-	21, // 17: viam.service.motion.v1.GetPoseRequest.supplemental_transforms:type_name -> viam.common.v1.Transform
-	17, // 18: viam.service.motion.v1.GetPoseRequest.extra:type_name -> google.protobuf.Struct
-	14, // 19: viam.service.motion.v1.GetPoseResponse.pose:type_name -> viam.common.v1.PoseInFrame
-	10, // 20: viam.service.motion.v1.Constraints.linear_constraint:type_name -> viam.service.motion.v1.LinearConstraint
-	11, // 21: viam.service.motion.v1.Constraints.orientation_constraint:type_name -> viam.service.motion.v1.OrientationConstraint
-	12, // 22: viam.service.motion.v1.Constraints.collision_specification:type_name -> viam.service.motion.v1.CollisionSpecification
-	13, // 23: viam.service.motion.v1.CollisionSpecification.allows:type_name -> viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions
-	0,  // 24: viam.service.motion.v1.MotionService.Move:input_type -> viam.service.motion.v1.MoveRequest
-	2,  // 25: viam.service.motion.v1.MotionService.MoveOnMap:input_type -> viam.service.motion.v1.MoveOnMapRequest
-	5,  // 26: viam.service.motion.v1.MotionService.MoveOnGlobe:input_type -> viam.service.motion.v1.MoveOnGlobeRequest
-	7,  // 27: viam.service.motion.v1.MotionService.GetPose:input_type -> viam.service.motion.v1.GetPoseRequest
-	22, // 28: viam.service.motion.v1.MotionService.DoCommand:input_type -> viam.common.v1.DoCommandRequest
-	1,  // 29: viam.service.motion.v1.MotionService.Move:output_type -> viam.service.motion.v1.MoveResponse
-	3,  // 30: viam.service.motion.v1.MotionService.MoveOnMap:output_type -> viam.service.motion.v1.MoveOnMapResponse
-	6,  // 31: viam.service.motion.v1.MotionService.MoveOnGlobe:output_type -> viam.service.motion.v1.MoveOnGlobeResponse
-	8,  // 32: viam.service.motion.v1.MotionService.GetPose:output_type -> viam.service.motion.v1.GetPoseResponse
-	23, // 33: viam.service.motion.v1.MotionService.DoCommand:output_type -> viam.common.v1.DoCommandResponse
-	29, // [29:34] is the sub-list for method output_type
-	24, // [24:29] is the sub-list for method input_type
-	24, // [24:24] is the sub-list for extension type_name
-	24, // [24:24] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
+	35, // 0: viam.service.motion.v1.MoveRequest.destination:type_name -> viam.common.v1.PoseInFrame
+	36, // 1: viam.service.motion.v1.MoveRequest.component_name:type_name -> viam.common.v1.ResourceName
+	37, // 2: viam.service.motion.v1.MoveRequest.world_state:type_name -> viam.common.v1.WorldState
+	24, // 3: viam.service.motion.v1.MoveRequest.constraints:type_name -> viam.service.motion.v1.Constraints
+	38, // 4: viam.service.motion.v1.MoveRequest.extra:type_name -> google.protobuf.Struct
+	23, // 5: viam.service.motion.v1.MoveResponse.planning_failure:type_name -> viam.service.motion.v1.PlanningFailure
+	35, // 6: viam.service.motion.v1.PlanMoveRequest.destination:type_name -> viam.common.v1.PoseInFrame
+	36, // 7: viam.service.motion.v1.PlanMoveRequest.component_name:type_name -> viam.common.v1.ResourceName
+	37, // 8: viam.service.motion.v1.PlanMoveRequest.world_state:type_name -> viam.common.v1.WorldState
+	24, // 9: viam.service.motion.v1.PlanMoveRequest.constraints:type_name -> viam.service.motion.v1.Constraints
+	38, // 10: viam.service.motion.v1.PlanMoveRequest.extra:type_name -> google.protobuf.Struct
+	19, // 11: viam.service.motion.v1.PlanMoveResponse.plan:type_name -> viam.service.motion.v1.Plan
+	23, // 12: viam.service.motion.v1.PlanMoveResponse.planning_failure:type_name -> viam.service.motion.v1.PlanningFailure
+	39, // 13: viam.service.motion.v1.MoveOnMapRequest.destination:type_name -> viam.common.v1.Pose
+	36, // 14: viam.service.motion.v1.MoveOnMapRequest.component_name:type_name -> viam.common.v1.ResourceName
+	36, // 15: viam.service.motion.v1.MoveOnMapRequest.slam_service_name:type_name -> viam.common.v1.ResourceName
+	38, // 16: viam.service.motion.v1.MoveOnMapRequest.extra:type_name -> google.protobuf.Struct
+	23, // 17: viam.service.motion.v1.MoveOnMapResponse.planning_failure:type_name -> viam.service.motion.v1.PlanningFailure
+	36, // 18: viam.service.motion.v1.MotionConfiguration.vision_services:type_name -> viam.common.v1.ResourceName
+	40, // 19: viam.service.motion.v1.MoveOnGlobeRequest.destination:type_name -> viam.common.v1.GeoPoint
+	36, // 20: viam.service.motion.v1.MoveOnGlobeRequest.component_name:type_name -> viam.common.v1.ResourceName
+	36, // 21: viam.service.motion.v1.MoveOnGlobeRequest.movement_sensor_name:type_name -> viam.common.v1.ResourceName
+	41, // 22: viam.service.motion.v1.MoveOnGlobeRequest.obstacles:type_name -> viam.common.v1.GeoObstacle
+	8,  // 23: viam.service.motion.v1.MoveOnGlobeRequest.motion_configuration:type_name -> viam.service.motion.v1.MotionConfiguration
+	38, // 24: viam.service.motion.v1.MoveOnGlobeRequest.extra:type_name -> google.protobuf.Struct
+	23, // 25: viam.service.motion.v1.MoveOnGlobeResponse.planning_failure:type_name -> viam.service.motion.v1.PlanningFailure
+	36, // 26: viam.service.motion.v1.GetPoseRequest.component_name:type_name -> viam.common.v1.ResourceName
+	42, // 27: viam.service.motion.v1.GetPoseRequest.supplemental_transforms:type_name -> viam.common.v1.Transform
+	38, // 28: viam.service.motion.v1.GetPoseRequest.extra:type_name -> google.protobuf.Struct
+	35, // 29: viam.service.motion.v1.GetPoseResponse.pose:type_name -> viam.common.v1.PoseInFrame
+	38, // 30: viam.service.motion.v1.GetPlanRequest.extra:type_name -> google.protobuf.Struct
+	19, // 31: viam.service.motion.v1.GetPlanResponse.current_plan:type_name -> viam.service.motion.v1.Plan
+	22, // 32: viam.service.motion.v1.GetPlanResponse.status:type_name -> viam.service.motion.v1.ExecutionStatus
+	19, // 33: viam.service.motion.v1.GetPlanResponse.replans:type_name -> viam.service.motion.v1.Plan
+	38, // 34: viam.service.motion.v1.GetExecutionStatusRequest.extra:type_name -> google.protobuf.Struct
+	22, // 35: viam.service.motion.v1.GetExecutionStatusResponse.status:type_name -> viam.service.motion.v1.ExecutionStatus
+	38, // 36: viam.service.motion.v1.StopExecutionRequest.extra:type_name -> google.protobuf.Struct
+	36, // 37: viam.service.motion.v1.Plan.component_name:type_name -> viam.common.v1.ResourceName
+	20, // 38: viam.service.motion.v1.Plan.steps:type_name -> viam.service.motion.v1.PlanStep
+	33, // 39: viam.service.motion.v1.PlanStep.step:type_name -> viam.service.motion.v1.PlanStep.StepEntry
+	39, // 40: viam.service.motion.v1.ComponentState.pose:type_name -> viam.common.v1.Pose
+	36, // 41: viam.service.motion.v1.ExecutionStatus.component_name:type_name -> viam.common.v1.ResourceName
+	0,  // 42: viam.service.motion.v1.ExecutionStatus.state:type_name -> viam.service.motion.v1.ExecutionState
+	43, // 43: viam.service.motion.v1.ExecutionStatus.timestamp:type_name -> google.protobuf.Timestamp
+	23, // 44: viam.service.motion.v1.ExecutionStatus.planning_failure:type_name -> viam.service.motion.v1.PlanningFailure
+	1,  // 45: viam.service.motion.v1.PlanningFailure.reason:type_name -> viam.service.motion.v1.PlanningFailureReason
+	44, // 46: viam.service.motion.v1.PlanningFailure.geometries:type_name -> viam.common.v1.GeometriesInFrame
+	25, // 47: viam.service.motion.v1.Constraints.linear_constraint:type_name -> viam.service.motion.v1.LinearConstraint
+	26, // 48: viam.service.motion.v1.Constraints.orientation_constraint:type_name -> viam.service.motion.v1.OrientationConstraint
+	27, // 49: viam.service.motion.v1.Constraints.collision_specification:type_name -> viam.service.motion.v1.CollisionSpecification
+	28, // 50: viam.service.motion.v1.Constraints.pseudolinear_constraint:type_name -> viam.service.motion.v1.PseudolinearConstraint
+	29, // 51: viam.service.motion.v1.Constraints.keep_out_zone_constraint:type_name -> viam.service.motion.v1.KeepOutZoneConstraint
+	30, // 52: viam.service.motion.v1.Constraints.joint_range_constraint:type_name -> viam.service.motion.v1.JointRangeConstraint
+	32, // 53: viam.service.motion.v1.Constraints.end_effector_speed_constraint:type_name -> viam.service.motion.v1.EndEffectorSpeedConstraint
+	34, // 54: viam.service.motion.v1.CollisionSpecification.allows:type_name -> viam.service.motion.v1.CollisionSpecification.AllowedFrameCollisions
+	44, // 55: viam.service.motion.v1.KeepOutZoneConstraint.zones:type_name -> viam.common.v1.GeometriesInFrame
+	36, // 56: viam.service.motion.v1.JointRangeConstraint.component_name:type_name -> viam.common.v1.ResourceName
+	31, // 57: viam.service.motion.v1.JointRangeConstraint.ranges:type_name -> viam.service.motion.v1.JointRange
+	21, // 58: viam.service.motion.v1.PlanStep.StepEntry.value:type_name -> viam.service.motion.v1.ComponentState
+	2,  // 59: viam.service.motion.v1.MotionService.Move:input_type -> viam.service.motion.v1.MoveRequest
+	6,  // 60: viam.service.motion.v1.MotionService.MoveOnMap:input_type -> viam.service.motion.v1.MoveOnMapRequest
+	9,  // 61: viam.service.motion.v1.MotionService.MoveOnGlobe:input_type -> viam.service.motion.v1.MoveOnGlobeRequest
+	4,  // 62: viam.service.motion.v1.MotionService.PlanMove:input_type -> viam.service.motion.v1.PlanMoveRequest
+	13, // 63: viam.service.motion.v1.MotionService.GetPlan:input_type -> viam.service.motion.v1.GetPlanRequest
+	15, // 64: viam.service.motion.v1.MotionService.GetExecutionStatus:input_type -> viam.service.motion.v1.GetExecutionStatusRequest
+	17, // 65: viam.service.motion.v1.MotionService.StopExecution:input_type -> viam.service.motion.v1.StopExecutionRequest
+	11, // 66: viam.service.motion.v1.MotionService.GetPose:input_type -> viam.service.motion.v1.GetPoseRequest
+	45, // 67: viam.service.motion.v1.MotionService.DoCommand:input_type -> viam.common.v1.DoCommandRequest
+	3,  // 68: viam.service.motion.v1.MotionService.Move:output_type -> viam.service.motion.v1.MoveResponse
+	7,  // 69: viam.service.motion.v1.MotionService.MoveOnMap:output_type -> viam.service.motion.v1.MoveOnMapResponse
+	10, // 70: viam.service.motion.v1.MotionService.MoveOnGlobe:output_type -> viam.service.motion.v1.MoveOnGlobeResponse
+	5,  // 71: viam.service.motion.v1.MotionService.PlanMove:output_type -> viam.service.motion.v1.PlanMoveResponse
+	14, // 72: viam.service.motion.v1.MotionService.GetPlan:output_type -> viam.service.motion.v1.GetPlanResponse
+	16, // 73: viam.service.motion.v1.MotionService.GetExecutionStatus:output_type -> viam.service.motion.v1.GetExecutionStatusResponse
+	18, // 74: viam.service.motion.v1.MotionService.StopExecution:output_type -> viam.service.motion.v1.StopExecutionResponse
+	12, // 75: viam.service.motion.v1.MotionService.GetPose:output_type -> viam.service.motion.v1.GetPoseResponse
+	46, // 76: viam.service.motion.v1.MotionService.DoCommand:output_type -> viam.common.v1.DoCommandResponse
+	68, // [68:77] is the sub-list for method output_type
+	59, // [59:68] is the sub-list for method input_type
+	59, // [59:59] is the sub-list for extension type_name
+	59, // [59:59] is the sub-list for extension extendee
+	0,  // [0:59] is the sub-list for field type_name
 }
 
 func init() { file_service_motion_v1_motion_proto_init() }
@@ -1324,7 +3168,7 @@ func file_service_motion_v1_motion_proto_init() {
 			}
 		}
 		file_service_motion_v1_motion_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MoveOnMapRequest); i {
+			switch v := v.(*PlanMoveRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1336,7 +3180,7 @@ func file_service_motion_v1_motion_proto_init() {
 			}
 		}
 		file_service_motion_v1_motion_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MoveOnMapResponse); i {
+			switch v := v.(*PlanMoveResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1348,7 +3192,7 @@ func file_service_motion_v1_motion_proto_init() {
 			}
 		}
 		file_service_motion_v1_motion_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MotionConfiguration); i {
+			switch v := v.(*MoveOnMapRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1360,7 +3204,7 @@ func file_service_motion_v1_motion_proto_init() {
 			}
 		}
 		file_service_motion_v1_motion_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MoveOnGlobeRequest); i {
+			switch v := v.(*MoveOnMapResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1372,7 +3216,7 @@ func file_service_motion_v1_motion_proto_init() {
 			}
 		}
 		file_service_motion_v1_motion_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MoveOnGlobeResponse); i {
+			switch v := v.(*MotionConfiguration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1384,7 +3228,7 @@ func file_service_motion_v1_motion_proto_init() {
 			}
 		}
 		file_service_motion_v1_motion_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPoseRequest); i {
+			switch v := v.(*MoveOnGlobeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1396,7 +3240,7 @@ func file_service_motion_v1_motion_proto_init() {
 			}
 		}
 		file_service_motion_v1_motion_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPoseResponse); i {
+			switch v := v.(*MoveOnGlobeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1408,7 +3252,7 @@ func file_service_motion_v1_motion_proto_init() {
 			}
 		}
 		file_service_motion_v1_motion_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Constraints); i {
+			switch v := v.(*GetPoseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1420,7 +3264,7 @@ func file_service_motion_v1_motion_proto_init() {
 			}
 		}
 		file_service_motion_v1_motion_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LinearConstraint); i {
+			switch v := v.(*GetPoseResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1432,7 +3276,7 @@ func file_service_motion_v1_motion_proto_init() {
 			}
 		}
 		file_service_motion_v1_motion_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrientationConstraint); i {
+			switch v := v.(*GetPlanRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1444,7 +3288,7 @@ func file_service_motion_v1_motion_proto_init() {
 			}
 		}
 		file_service_motion_v1_motion_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CollisionSpecification); i {
+			switch v := v.(*GetPlanResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1456,6 +3300,222 @@ func file_service_motion_v1_motion_proto_init() {
 			}
 		}
 		file_service_motion_v1_motion_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetExecutionStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_motion_v1_motion_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetExecutionStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_motion_v1_motion_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopExecutionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_motion_v1_motion_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopExecutionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_motion_v1_motion_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Plan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_motion_v1_motion_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlanStep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_motion_v1_motion_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ComponentState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_motion_v1_motion_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecutionStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_motion_v1_motion_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlanningFailure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_motion_v1_motion_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Constraints); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_motion_v1_motion_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinearConstraint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_motion_v1_motion_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrientationConstraint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_motion_v1_motion_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollisionSpecification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_motion_v1_motion_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PseudolinearConstraint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_motion_v1_motion_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeepOutZoneConstraint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_motion_v1_motion_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JointRangeConstraint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_motion_v1_motion_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JointRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_motion_v1_motion_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EndEffectorSpeedConstraint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_motion_v1_motion_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CollisionSpecification_AllowedFrameCollisions); i {
 			case 0:
 				return &v.state
@@ -1469,22 +3529,32 @@ func file_service_motion_v1_motion_proto_init() {
 		}
 	}
 	file_service_motion_v1_motion_proto_msgTypes[0].OneofWrappers = []interface{}{}
-	file_service_motion_v1_motion_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_service_motion_v1_motion_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	file_service_motion_v1_motion_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_service_motion_v1_motion_proto_msgTypes[3].OneofWrappers = []interface{}{}
 	file_service_motion_v1_motion_proto_msgTypes[5].OneofWrappers = []interface{}{}
-	file_service_motion_v1_motion_proto_msgTypes[10].OneofWrappers = []interface{}{}
-	file_service_motion_v1_motion_proto_msgTypes[11].OneofWrappers = []interface{}{}
+	file_service_motion_v1_motion_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	file_service_motion_v1_motion_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_service_motion_v1_motion_proto_msgTypes[8].OneofWrappers = []interface{}{}
+	file_service_motion_v1_motion_proto_msgTypes[20].OneofWrappers = []interface{}{}
+	file_service_motion_v1_motion_proto_msgTypes[23].OneofWrappers = []interface{}{}
+	file_service_motion_v1_motion_proto_msgTypes[24].OneofWrappers = []interface{}{}
+	file_service_motion_v1_motion_proto_msgTypes[26].OneofWrappers = []interface{}{}
+	file_service_motion_v1_motion_proto_msgTypes[29].OneofWrappers = []interface{}{}
+	file_service_motion_v1_motion_proto_msgTypes[30].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_service_motion_v1_motion_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   14,
+			NumEnums:      2,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_service_motion_v1_motion_proto_goTypes,
 		DependencyIndexes: file_service_motion_v1_motion_proto_depIdxs,
+		EnumInfos:         file_service_motion_v1_motion_proto_enumTypes,
 		MessageInfos:      file_service_motion_v1_motion_proto_msgTypes,
 	}.Build()
 	File_service_motion_v1_motion_proto = out.File
@@ -26,6 +26,15 @@ type MotionServiceClient interface {
 	Move(ctx context.Context, in *MoveRequest, opts ...grpc.CallOption) (*MoveResponse, error)
 	MoveOnMap(ctx context.Context, in *MoveOnMapRequest, opts ...grpc.CallOption) (*MoveOnMapResponse, error)
 	MoveOnGlobe(ctx context.Context, in *MoveOnGlobeRequest, opts ...grpc.CallOption) (*MoveOnGlobeResponse, error)
+	// PlanMove plans a motion in the same way as Move, without moving anything, and returns the planned path
+	PlanMove(ctx context.Context, in *PlanMoveRequest, opts ...grpc.CallOption) (*PlanMoveResponse, error)
+	// GetPlan returns the plan(s) of an execution started by Move, MoveOnMap or MoveOnGlobe,
+	// including any replans that have occurred
+	GetPlan(ctx context.Context, in *GetPlanRequest, opts ...grpc.CallOption) (*GetPlanResponse, error)
+	// GetExecutionStatus returns the current status of an execution started by Move, MoveOnMap or MoveOnGlobe
+	GetExecutionStatus(ctx context.Context, in *GetExecutionStatusRequest, opts ...grpc.CallOption) (*GetExecutionStatusResponse, error)
+	// StopExecution stops an in progress execution started by Move, MoveOnMap or MoveOnGlobe
+	StopExecution(ctx context.Context, in *StopExecutionRequest, opts ...grpc.CallOption) (*StopExecutionResponse, error)
 	GetPose(ctx context.Context, in *GetPoseRequest, opts ...grpc.CallOption) (*GetPoseResponse, error)
 	// DoCommand sends/receives arbitrary commands
 	DoCommand(ctx context.Context, in *v1.DoCommandRequest, opts ...grpc.CallOption) (*v1.DoCommandResponse, error)
@@ -66,6 +75,42 @@ func (c *motionServiceClient) MoveOnGlobe(ctx context.Context, in *MoveOnGlobeRe
 	return out, nil
 }
 
+func (c *motionServiceClient) PlanMove(ctx context.Context, in *PlanMoveRequest, opts ...grpc.CallOption) (*PlanMoveResponse, error) {
+	out := new(PlanMoveResponse)
+	err := c.cc.Invoke(ctx, "/viam.service.motion.v1.MotionService/PlanMove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *motionServiceClient) GetPlan(ctx context.Context, in *GetPlanRequest, opts ...grpc.CallOption) (*GetPlanResponse, error) {
+	out := new(GetPlanResponse)
+	err := c.cc.Invoke(ctx, "/viam.service.motion.v1.MotionService/GetPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *motionServiceClient) GetExecutionStatus(ctx context.Context, in *GetExecutionStatusRequest, opts ...grpc.CallOption) (*GetExecutionStatusResponse, error) {
+	out := new(GetExecutionStatusResponse)
+	err := c.cc.Invoke(ctx, "/viam.service.motion.v1.MotionService/GetExecutionStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *motionServiceClient) StopExecution(ctx context.Context, in *StopExecutionRequest, opts ...grpc.CallOption) (*StopExecutionResponse, error) {
+	out := new(StopExecutionResponse)
+	err := c.cc.Invoke(ctx, "/viam.service.motion.v1.MotionService/StopExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *motionServiceClient) GetPose(ctx context.Context, in *GetPoseRequest, opts ...grpc.CallOption) (*GetPoseResponse, error) {
 	out := new(GetPoseResponse)
 	err := c.cc.Invoke(ctx, "/viam.service.motion.v1.MotionService/GetPose", in, out, opts...)
@@ -91,6 +136,15 @@ type MotionServiceServer interface {
 	Move(context.Context, *MoveRequest) (*MoveResponse, error)
 	MoveOnMap(context.Context, *MoveOnMapRequest) (*MoveOnMapResponse, error)
 	MoveOnGlobe(context.Context, *MoveOnGlobeRequest) (*MoveOnGlobeResponse, error)
+	// PlanMove plans a motion in the same way as Move, without moving anything, and returns the planned path
+	PlanMove(context.Context, *PlanMoveRequest) (*PlanMoveResponse, error)
+	// GetPlan returns the plan(s) of an execution started by Move, MoveOnMap or MoveOnGlobe,
+	// including any replans that have occurred
+	GetPlan(context.Context, *GetPlanRequest) (*GetPlanResponse, error)
+	// GetExecutionStatus returns the current status of an execution started by Move, MoveOnMap or MoveOnGlobe
+	GetExecutionStatus(context.Context, *GetExecutionStatusRequest) (*GetExecutionStatusResponse, error)
+	// StopExecution stops an in progress execution started by Move, MoveOnMap or MoveOnGlobe
+	StopExecution(context.Context, *StopExecutionRequest) (*StopExecutionResponse, error)
 	GetPose(context.Context, *GetPoseRequest) (*GetPoseResponse, error)
 	// DoCommand sends/receives arbitrary commands
 	DoCommand(context.Context, *v1.DoCommandRequest) (*v1.DoCommandResponse, error)
@@ -110,6 +164,18 @@ func (UnimplementedMotionServiceServer) MoveOnMap(context.Context, *MoveOnMapReq
 func (UnimplementedMotionServiceServer) MoveOnGlobe(context.Context, *MoveOnGlobeRequest) (*MoveOnGlobeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MoveOnGlobe not implemented")
 }
+func (UnimplementedMotionServiceServer) PlanMove(context.Context, *PlanMoveRequest) (*PlanMoveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanMove not implemented")
+}
+func (UnimplementedMotionServiceServer) GetPlan(context.Context, *GetPlanRequest) (*GetPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlan not implemented")
+}
+func (UnimplementedMotionServiceServer) GetExecutionStatus(context.Context, *GetExecutionStatusRequest) (*GetExecutionStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExecutionStatus not implemented")
+}
+func (UnimplementedMotionServiceServer) StopExecution(context.Context, *StopExecutionRequest) (*StopExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopExecution not implemented")
+}
 func (UnimplementedMotionServiceServer) GetPose(context.Context, *GetPoseRequest) (*GetPoseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPose not implemented")
 }
@@ -183,6 +249,78 @@ func _MotionService_MoveOnGlobe_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MotionService_PlanMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanMoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MotionServiceServer).PlanMove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/viam.service.motion.v1.MotionService/PlanMove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MotionServiceServer).PlanMove(ctx, req.(*PlanMoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MotionService_GetPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MotionServiceServer).GetPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/viam.service.motion.v1.MotionService/GetPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MotionServiceServer).GetPlan(ctx, req.(*GetPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MotionService_GetExecutionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExecutionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MotionServiceServer).GetExecutionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/viam.service.motion.v1.MotionService/GetExecutionStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MotionServiceServer).GetExecutionStatus(ctx, req.(*GetExecutionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MotionService_StopExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MotionServiceServer).StopExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/viam.service.motion.v1.MotionService/StopExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MotionServiceServer).StopExecution(ctx, req.(*StopExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MotionService_GetPose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPoseRequest)
 	if err := dec(in); err != nil {
@@ -238,6 +376,22 @@ var MotionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MoveOnGlobe",
 			Handler:    _MotionService_MoveOnGlobe_Handler,
 		},
+		{
+			MethodName: "PlanMove",
+			Handler:    _MotionService_PlanMove_Handler,
+		},
+		{
+			MethodName: "GetPlan",
+			Handler:    _MotionService_GetPlan_Handler,
+		},
+		{
+			MethodName: "GetExecutionStatus",
+			Handler:    _MotionService_GetExecutionStatus_Handler,
+		},
+		{
+			MethodName: "StopExecution",
+			Handler:    _MotionService_StopExecution_Handler,
+		},
 		{
 			MethodName: "GetPose",
 			Handler:    _MotionService_GetPose_Handler,
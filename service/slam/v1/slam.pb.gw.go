@@ -84,6 +84,10 @@ func local_request_SLAMService_GetPosition_0(ctx context.Context, marshaler runt
 
 }
 
+var (
+	filter_SLAMService_GetPointCloudMap_0 = &utilities.DoubleArray{Encoding: map[string]int{"name": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
+)
+
 func request_SLAMService_GetPointCloudMap_0(ctx context.Context, marshaler runtime.Marshaler, client SLAMServiceClient, req *http.Request, pathParams map[string]string) (SLAMService_GetPointCloudMapClient, runtime.ServerMetadata, error) {
 	var protoReq GetPointCloudMapRequest
 	var metadata runtime.ServerMetadata
@@ -105,6 +109,13 @@ func request_SLAMService_GetPointCloudMap_0(ctx context.Context, marshaler runti
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "name", err)
 	}
 
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_SLAMService_GetPointCloudMap_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
 	stream, err := client.GetPointCloudMap(ctx, &protoReq)
 	if err != nil {
 		return nil, metadata, err
@@ -25,6 +25,9 @@ const _ = grpc.SupportPackageIsVersion7
 type VisionServiceClient interface {
 	// GetDetectionsFromCamera will return a list of detections in the next image given a camera and a detector
 	GetDetectionsFromCamera(ctx context.Context, in *GetDetectionsFromCameraRequest, opts ...grpc.CallOption) (*GetDetectionsFromCameraResponse, error)
+	// TrackObjectsFromCamera will stream the tracked objects in each successive image given a camera and a detector,
+	// assigning each object a track id which persists across images
+	TrackObjectsFromCamera(ctx context.Context, in *TrackObjectsFromCameraRequest, opts ...grpc.CallOption) (VisionService_TrackObjectsFromCameraClient, error)
 	// GetDetections will return a list of detections in the next image given the image bytes and a detector
 	GetDetections(ctx context.Context, in *GetDetectionsRequest, opts ...grpc.CallOption) (*GetDetectionsResponse, error)
 	// GetClassificationsFromCamera will return a list of classifications in the next image given a camera and a classifier
@@ -35,6 +38,11 @@ type VisionServiceClient interface {
 	// as well as the 3-vector center of each of the found objects.
 	// A specific MIME type can be requested but may not necessarily be the same one returned.
 	GetObjectPointClouds(ctx context.Context, in *GetObjectPointCloudsRequest, opts ...grpc.CallOption) (*GetObjectPointCloudsResponse, error)
+	// CaptureAllFromCamera will capture a single image from a camera and return it along with the detections,
+	// classifications and object point clouds computed from that same capture
+	CaptureAllFromCamera(ctx context.Context, in *CaptureAllFromCameraRequest, opts ...grpc.CallOption) (*CaptureAllFromCameraResponse, error)
+	// GetProperties will return which kinds of results the vision service supports
+	GetProperties(ctx context.Context, in *GetPropertiesRequest, opts ...grpc.CallOption) (*GetPropertiesResponse, error)
 	// DoCommand sends/receives arbitrary commands
 	DoCommand(ctx context.Context, in *v1.DoCommandRequest, opts ...grpc.CallOption) (*v1.DoCommandResponse, error)
 }
@@ -56,6 +64,38 @@ func (c *visionServiceClient) GetDetectionsFromCamera(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *visionServiceClient) TrackObjectsFromCamera(ctx context.Context, in *TrackObjectsFromCameraRequest, opts ...grpc.CallOption) (VisionService_TrackObjectsFromCameraClient, error) {
+	stream, err := c.cc.NewStream(ctx, &VisionService_ServiceDesc.Streams[0], "/viam.service.vision.v1.VisionService/TrackObjectsFromCamera", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &visionServiceTrackObjectsFromCameraClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type VisionService_TrackObjectsFromCameraClient interface {
+	Recv() (*TrackObjectsFromCameraResponse, error)
+	grpc.ClientStream
+}
+
+type visionServiceTrackObjectsFromCameraClient struct {
+	grpc.ClientStream
+}
+
+func (x *visionServiceTrackObjectsFromCameraClient) Recv() (*TrackObjectsFromCameraResponse, error) {
+	m := new(TrackObjectsFromCameraResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *visionServiceClient) GetDetections(ctx context.Context, in *GetDetectionsRequest, opts ...grpc.CallOption) (*GetDetectionsResponse, error) {
 	out := new(GetDetectionsResponse)
 	err := c.cc.Invoke(ctx, "/viam.service.vision.v1.VisionService/GetDetections", in, out, opts...)
@@ -92,6 +132,24 @@ func (c *visionServiceClient) GetObjectPointClouds(ctx context.Context, in *GetO
 	return out, nil
 }
 
+func (c *visionServiceClient) CaptureAllFromCamera(ctx context.Context, in *CaptureAllFromCameraRequest, opts ...grpc.CallOption) (*CaptureAllFromCameraResponse, error) {
+	out := new(CaptureAllFromCameraResponse)
+	err := c.cc.Invoke(ctx, "/viam.service.vision.v1.VisionService/CaptureAllFromCamera", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *visionServiceClient) GetProperties(ctx context.Context, in *GetPropertiesRequest, opts ...grpc.CallOption) (*GetPropertiesResponse, error) {
+	out := new(GetPropertiesResponse)
+	err := c.cc.Invoke(ctx, "/viam.service.vision.v1.VisionService/GetProperties", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *visionServiceClient) DoCommand(ctx context.Context, in *v1.DoCommandRequest, opts ...grpc.CallOption) (*v1.DoCommandResponse, error) {
 	out := new(v1.DoCommandResponse)
 	err := c.cc.Invoke(ctx, "/viam.service.vision.v1.VisionService/DoCommand", in, out, opts...)
@@ -107,6 +165,9 @@ func (c *visionServiceClient) DoCommand(ctx context.Context, in *v1.DoCommandReq
 type VisionServiceServer interface {
 	// GetDetectionsFromCamera will return a list of detections in the next image given a camera and a detector
 	GetDetectionsFromCamera(context.Context, *GetDetectionsFromCameraRequest) (*GetDetectionsFromCameraResponse, error)
+	// TrackObjectsFromCamera will stream the tracked objects in each successive image given a camera and a detector,
+	// assigning each object a track id which persists across images
+	TrackObjectsFromCamera(*TrackObjectsFromCameraRequest, VisionService_TrackObjectsFromCameraServer) error
 	// GetDetections will return a list of detections in the next image given the image bytes and a detector
 	GetDetections(context.Context, *GetDetectionsRequest) (*GetDetectionsResponse, error)
 	// GetClassificationsFromCamera will return a list of classifications in the next image given a camera and a classifier
@@ -117,6 +178,11 @@ type VisionServiceServer interface {
 	// as well as the 3-vector center of each of the found objects.
 	// A specific MIME type can be requested but may not necessarily be the same one returned.
 	GetObjectPointClouds(context.Context, *GetObjectPointCloudsRequest) (*GetObjectPointCloudsResponse, error)
+	// CaptureAllFromCamera will capture a single image from a camera and return it along with the detections,
+	// classifications and object point clouds computed from that same capture
+	CaptureAllFromCamera(context.Context, *CaptureAllFromCameraRequest) (*CaptureAllFromCameraResponse, error)
+	// GetProperties will return which kinds of results the vision service supports
+	GetProperties(context.Context, *GetPropertiesRequest) (*GetPropertiesResponse, error)
 	// DoCommand sends/receives arbitrary commands
 	DoCommand(context.Context, *v1.DoCommandRequest) (*v1.DoCommandResponse, error)
 	mustEmbedUnimplementedVisionServiceServer()
@@ -129,6 +195,9 @@ type UnimplementedVisionServiceServer struct {
 func (UnimplementedVisionServiceServer) GetDetectionsFromCamera(context.Context, *GetDetectionsFromCameraRequest) (*GetDetectionsFromCameraResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDetectionsFromCamera not implemented")
 }
+func (UnimplementedVisionServiceServer) TrackObjectsFromCamera(*TrackObjectsFromCameraRequest, VisionService_TrackObjectsFromCameraServer) error {
+	return status.Errorf(codes.Unimplemented, "method TrackObjectsFromCamera not implemented")
+}
 func (UnimplementedVisionServiceServer) GetDetections(context.Context, *GetDetectionsRequest) (*GetDetectionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDetections not implemented")
 }
@@ -141,6 +210,12 @@ func (UnimplementedVisionServiceServer) GetClassifications(context.Context, *Get
 func (UnimplementedVisionServiceServer) GetObjectPointClouds(context.Context, *GetObjectPointCloudsRequest) (*GetObjectPointCloudsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetObjectPointClouds not implemented")
 }
+func (UnimplementedVisionServiceServer) CaptureAllFromCamera(context.Context, *CaptureAllFromCameraRequest) (*CaptureAllFromCameraResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CaptureAllFromCamera not implemented")
+}
+func (UnimplementedVisionServiceServer) GetProperties(context.Context, *GetPropertiesRequest) (*GetPropertiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProperties not implemented")
+}
 func (UnimplementedVisionServiceServer) DoCommand(context.Context, *v1.DoCommandRequest) (*v1.DoCommandResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DoCommand not implemented")
 }
@@ -175,6 +250,27 @@ func _VisionService_GetDetectionsFromCamera_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VisionService_TrackObjectsFromCamera_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TrackObjectsFromCameraRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VisionServiceServer).TrackObjectsFromCamera(m, &visionServiceTrackObjectsFromCameraServer{stream})
+}
+
+type VisionService_TrackObjectsFromCameraServer interface {
+	Send(*TrackObjectsFromCameraResponse) error
+	grpc.ServerStream
+}
+
+type visionServiceTrackObjectsFromCameraServer struct {
+	grpc.ServerStream
+}
+
+func (x *visionServiceTrackObjectsFromCameraServer) Send(m *TrackObjectsFromCameraResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _VisionService_GetDetections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetDetectionsRequest)
 	if err := dec(in); err != nil {
@@ -247,6 +343,42 @@ func _VisionService_GetObjectPointClouds_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VisionService_CaptureAllFromCamera_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaptureAllFromCameraRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VisionServiceServer).CaptureAllFromCamera(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/viam.service.vision.v1.VisionService/CaptureAllFromCamera",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VisionServiceServer).CaptureAllFromCamera(ctx, req.(*CaptureAllFromCameraRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VisionService_GetProperties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPropertiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VisionServiceServer).GetProperties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/viam.service.vision.v1.VisionService/GetProperties",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VisionServiceServer).GetProperties(ctx, req.(*GetPropertiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VisionService_DoCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v1.DoCommandRequest)
 	if err := dec(in); err != nil {
@@ -292,11 +424,25 @@ var VisionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetObjectPointClouds",
 			Handler:    _VisionService_GetObjectPointClouds_Handler,
 		},
+		{
+			MethodName: "CaptureAllFromCamera",
+			Handler:    _VisionService_CaptureAllFromCamera_Handler,
+		},
+		{
+			MethodName: "GetProperties",
+			Handler:    _VisionService_GetProperties_Handler,
+		},
 		{
 			MethodName: "DoCommand",
 			Handler:    _VisionService_DoCommand_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "TrackObjectsFromCamera",
+			Handler:       _VisionService_TrackObjectsFromCamera_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "service/vision/v1/vision.proto",
 }